@@ -12,13 +12,21 @@ import (
 	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
 )
 
+// PrivKey is the key the engine used to sign the txs it derived from payloads.
+//
+// Deprecated: payload txs are no longer signed, so that every node derives the same block. Nothing in monomer uses it.
 var PrivKey = secp256k1.GenPrivKeyFromSecret([]byte("monomer"))
 
 var errL1AttributesNotFound = errors.New("L1 attributes tx not found")
 
+// TxSigner signs a cosmos tx with msgs.
+//
+// Deprecated: AdaptPayloadTxsToCosmosTxs ignores its signer. Nothing in monomer uses it.
 type TxSigner func([]sdktypes.Msg) (bfttypes.Tx, error)
 
 // AdaptPayloadTxsToCosmosTxs assumes the deposit transactions come first.
+// The cosmos txs are derived from the payload alone, so every node that imports a payload builds the same block. The
+// signer and address are ignored and kept for compatibility; pass nil and "".
 func AdaptPayloadTxsToCosmosTxs(ethTxs []hexutil.Bytes, _ TxSigner, _ string) (bfttypes.Txs, error) {
 	if len(ethTxs) == 0 {
 		return bfttypes.Txs{}, nil
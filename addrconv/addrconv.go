@@ -0,0 +1,111 @@
+// Package addrconv maps between the 0x addresses of L1 and the OP Stack and the bech32 accounts of a Monomer chain.
+//
+// The mapping is the identity on the 20 address bytes: the deposits an 0x address receives are minted to the Cosmos
+// account with the same bytes, and the withdrawals a 20-byte Cosmos account initiates are sent from the 0x address with
+// the same bytes. Only the bech32 prefix, which is the app's, differs between chains.
+//
+// Deposits sent by an L1 contract are credited to the contract's L2 alias rather than to its own address, like on
+// every OP Stack chain, so a contract without code that handles the alias can't move the funds it deposited.
+package addrconv
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ToCosmos returns the Cosmos account with the same bytes as addr, e.g., the account deposits to addr are minted to.
+func ToCosmos(addr common.Address) sdk.AccAddress {
+	return addr.Bytes()
+}
+
+// ToEth returns the 0x address with the same bytes as the Cosmos account, e.g., the address the account's withdrawals
+// are sent from. Accounts that aren't 20 bytes long, like the 32-byte accounts of modules and CosmWasm contracts, have
+// no such address.
+func ToEth(account sdk.AccAddress) (common.Address, error) {
+	if len(account) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("account is %d bytes long, but only %d-byte accounts have an 0x address", len(account), common.AddressLength)
+	}
+	return common.BytesToAddress(account), nil
+}
+
+// L1ToL2Alias returns the L2 alias of an L1 contract, which the deposits the contract sends are credited to.
+func L1ToL2Alias(addr common.Address) common.Address {
+	return crossdomain.ApplyL1ToL2Alias(addr)
+}
+
+// Bech32 encodes the Cosmos account of addr with prefix.
+func Bech32(addr common.Address, prefix string) (string, error) {
+	return bech32.ConvertAndEncode(prefix, ToCosmos(addr))
+}
+
+// Parse parses an 0x address or the bech32 account of one with prefix. Accounts with another prefix belong to another
+// chain and are rejected, since sending funds to them would lose them.
+func Parse(address, prefix string) (common.Address, error) {
+	if strings.HasPrefix(address, "0x") || strings.HasPrefix(address, "0X") {
+		return ParseHex(address)
+	}
+	return ParseBech32(address, prefix)
+}
+
+// ParseHex parses an 0x address. Mixed-case addresses must have a valid EIP-55 checksum, since a mistyped character
+// in a checksummed address would otherwise silently yield another address. All-lowercase and all-uppercase addresses
+// have no checksum and are accepted.
+func ParseHex(address string) (common.Address, error) {
+	if !strings.HasPrefix(address, "0x") && !strings.HasPrefix(address, "0X") {
+		return common.Address{}, fmt.Errorf("0x address %q is missing the 0x prefix", address)
+	}
+	if !common.IsHexAddress(address) {
+		return common.Address{}, fmt.Errorf("invalid 0x address %q", address)
+	}
+	addr := common.HexToAddress(address)
+	digits := address[2:]
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) && digits != addr.Hex()[2:] {
+		return common.Address{}, fmt.Errorf("0x address %q has an invalid EIP-55 checksum", address)
+	}
+	return addr, nil
+}
+
+// ParseBech32 parses the bech32 account of an 0x address with prefix. See Parse.
+func ParseBech32(address, prefix string) (common.Address, error) {
+	hrp, account, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("decode bech32 account: %v", err)
+	}
+	if hrp != prefix {
+		return common.Address{}, fmt.Errorf("account has prefix %q, but the chain's prefix is %q", hrp, prefix)
+	}
+	return ToEth(account)
+}
+
+// Conversion is an address in both forms, and the L2 alias deposits from an L1 contract at the address are credited to.
+type Conversion struct {
+	Hex           common.Address `json:"hex"`
+	Bech32        string         `json:"bech32"`
+	L2AliasHex    common.Address `json:"l2AliasHex"`
+	L2AliasBech32 string         `json:"l2AliasBech32"`
+}
+
+// Convert parses an 0x address or bech32 account like Parse and returns it in both forms.
+func Convert(address, prefix string) (*Conversion, error) {
+	addr, err := Parse(address, prefix)
+	if err != nil {
+		return nil, err
+	}
+	alias := L1ToL2Alias(addr)
+	conversion := &Conversion{
+		Hex:        addr,
+		L2AliasHex: alias,
+	}
+	if conversion.Bech32, err = Bech32(addr, prefix); err != nil {
+		return nil, fmt.Errorf("encode bech32 account: %v", err)
+	}
+	if conversion.L2AliasBech32, err = Bech32(alias, prefix); err != nil {
+		return nil, fmt.Errorf("encode bech32 account of l2 alias: %v", err)
+	}
+	return conversion, nil
+}
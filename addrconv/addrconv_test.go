@@ -0,0 +1,69 @@
+package addrconv_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/addrconv"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+const prefix = "cosmos"
+
+var addr = common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+
+func TestRoundTrip(t *testing.T) {
+	account := addrconv.ToCosmos(addr)
+	require.Equal(t, addr.Bytes(), account.Bytes())
+	got, err := addrconv.ToEth(account)
+	require.NoError(t, err)
+	require.Equal(t, addr, got)
+
+	encoded, err := addrconv.Bech32(addr, prefix)
+	require.NoError(t, err)
+	for _, address := range []string{addr.Hex(), encoded} {
+		got, err := addrconv.Parse(address, prefix)
+		require.NoError(t, err)
+		require.Equal(t, addr, got)
+	}
+}
+
+func TestToEthLongAccount(t *testing.T) {
+	_, err := addrconv.ToEth(make([]byte, 32))
+	require.ErrorContains(t, err, "32 bytes")
+}
+
+func TestParseInvalid(t *testing.T) {
+	otherChain, err := addrconv.Bech32(addr, "osmo")
+	require.NoError(t, err)
+	moduleAccount, err := bech32.ConvertAndEncode(prefix, make([]byte, 32))
+	require.NoError(t, err)
+	for name, address := range map[string]string{
+		"short hex":      "0x70997970C51812dc3A010C7d01b50e0d17dc79",
+		"invalid bech32": "cosmos1invalid",
+		"other prefix":   otherChain,
+		"32-byte":        moduleAccount,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := addrconv.Parse(address, prefix)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	l1CrossDomainMessenger := common.HexToAddress("0x9A9f2CCfdE556A7E9Ff0848998Aa4a0CFD8863AE")
+	encoded, err := addrconv.Bech32(l1CrossDomainMessenger, prefix)
+	require.NoError(t, err)
+	conversion, err := addrconv.Convert(encoded, prefix)
+	require.NoError(t, err)
+	require.Equal(t, l1CrossDomainMessenger, conversion.Hex)
+	require.Equal(t, encoded, conversion.Bech32)
+	// Deposits from the L1CrossDomainMessenger are sent from its alias.
+	require.Equal(t, rolluptypes.AliasedL1CrossDomainMessengerAddress, conversion.L2AliasHex)
+	aliasEncoded, err := addrconv.Bech32(rolluptypes.AliasedL1CrossDomainMessengerAddress, prefix)
+	require.NoError(t, err)
+	require.Equal(t, aliasEncoded, conversion.L2AliasBech32)
+}
@@ -0,0 +1,17 @@
+package addrconv
+
+// API serves the conversion of addresses with the chain's bech32 prefix.
+type API struct {
+	prefix string
+}
+
+func NewAPI(prefix string) *API {
+	return &API{
+		prefix: prefix,
+	}
+}
+
+// ConvertAddress returns an 0x address or a bech32 account of the chain in both forms. See Convert.
+func (a *API) ConvertAddress(address string) (*Conversion, error) {
+	return Convert(address, a.prefix)
+}
@@ -0,0 +1,84 @@
+package addrconv
+
+import (
+	"context"
+	"fmt"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+)
+
+const (
+	// Codespace is the codespace of CheckTx responses for txs whose addresses don't map between the chains.
+	Codespace = "addrconv"
+	// CodeUnmappedAddress is the code of CheckTx responses for txs whose addresses don't map between the chains.
+	CodeUnmappedAddress uint32 = 1
+)
+
+// CheckTx checks that the addresses in tx map unambiguously between the chains: every signer must be a 20-byte account,
+// so the eth namespace reports the 0x sender its withdrawals are sent from, and the addresses in the rollup module's
+// msgs must be bech32 accounts with prefix or 0x addresses with a valid EIP-55 checksum, if any.
+func CheckTx(tx sdk.Tx, prefix string) error {
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			return fmt.Errorf("get signers: %v", err)
+		}
+		for _, signer := range signers {
+			if _, err := ToEth(signer); err != nil {
+				return fmt.Errorf("signer %s: %v", sdk.AccAddress(signer), err)
+			}
+		}
+	}
+	for _, msg := range tx.GetMsgs() {
+		if withdrawal, ok := msg.(*rolluptypes.MsgInitiateWithdrawal); ok {
+			if _, err := ParseBech32(withdrawal.GetSender(), prefix); err != nil {
+				return fmt.Errorf("withdrawal sender: %v", err)
+			}
+			if _, err := ParseHex(withdrawal.GetTarget()); err != nil {
+				return fmt.Errorf("withdrawal target: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+type AppMempool interface {
+	CheckTx(context.Context, *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error)
+}
+
+// App checks the addresses of txs with CheckTx before the wrapped app's CheckTx.
+type App struct {
+	app       AppMempool
+	txDecoder sdk.TxDecoder
+	prefix    string
+}
+
+func NewApp(app AppMempool, txDecoder sdk.TxDecoder, prefix string) *App {
+	return &App{
+		app:       app,
+		txDecoder: txDecoder,
+		prefix:    prefix,
+	}
+}
+
+// CheckTx rejects new txs whose addresses don't map between the chains and passes everything else to the wrapped app.
+// Rechecks skip the addresses, since they were already checked.
+func (a *App) CheckTx(ctx context.Context, req *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error) {
+	if req.GetType() == abcitypes.CheckTxType_New {
+		tx, err := a.txDecoder(req.GetTx())
+		// The app reports the decoding error.
+		if err == nil {
+			if err := CheckTx(tx, a.prefix); err != nil {
+				return &abcitypes.ResponseCheckTx{
+					Code:      CodeUnmappedAddress,
+					Codespace: Codespace,
+					Log:       err.Error(),
+				}, nil
+			}
+		}
+	}
+	return a.app.CheckTx(ctx, req)
+}
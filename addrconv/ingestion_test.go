@@ -0,0 +1,126 @@
+package addrconv_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/polymerdao/monomer/addrconv"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHex(t *testing.T) {
+	checksummed := addr.Hex()
+	for name, address := range map[string]string{
+		"eip-55":    checksummed,
+		"lowercase": strings.ToLower(checksummed),
+		"uppercase": "0x" + strings.ToUpper(checksummed[2:]),
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := addrconv.ParseHex(address)
+			require.NoError(t, err)
+			require.Equal(t, addr, got)
+		})
+	}
+
+	// Flip the case of one letter of the checksummed address.
+	badChecksum := strings.Replace(checksummed, "C", "c", 1)
+	_, err := addrconv.ParseHex(badChecksum)
+	require.ErrorContains(t, err, "EIP-55")
+	_, err = addrconv.Parse(badChecksum, prefix)
+	require.ErrorContains(t, err, "EIP-55")
+	_, err = addrconv.ParseHex(checksummed[2:])
+	require.ErrorContains(t, err, "0x prefix")
+}
+
+type recordingApp struct {
+	checked int
+}
+
+func (a *recordingApp) CheckTx(context.Context, *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error) {
+	a.checked++
+	return &abcitypes.ResponseCheckTx{}, nil
+}
+
+func TestCheckTx(t *testing.T) {
+	encodingCfg := moduletestutil.MakeTestEncodingConfig()
+	rolluptypes.RegisterInterfaces(encodingCfg.InterfaceRegistry)
+	newTx := func(sender, target string) []byte {
+		txBuilder := encodingCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(&rolluptypes.MsgInitiateWithdrawal{
+			Sender:   sender,
+			Target:   target,
+			GasLimit: []byte{0x01},
+			Value:    math.NewInt(1),
+		}))
+		txBytes, err := encodingCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	sender, err := addrconv.Bech32(addr, prefix)
+	require.NoError(t, err)
+	otherChain, err := addrconv.Bech32(addr, "osmo")
+	require.NoError(t, err)
+	moduleAccount, err := bech32.ConvertAndEncode(prefix, make([]byte, 32))
+	require.NoError(t, err)
+	target := addr.Hex()
+
+	tests := map[string]struct {
+		tx     []byte
+		reject string
+	}{
+		"eip-55 target": {
+			tx: newTx(sender, target),
+		},
+		"lowercase target": {
+			tx: newTx(sender, strings.ToLower(target)),
+		},
+		"bad checksum target": {
+			tx:     newTx(sender, strings.Replace(target, "C", "c", 1)),
+			reject: "EIP-55",
+		},
+		"sender with other prefix": {
+			tx:     newTx(otherChain, target),
+			reject: "prefix",
+		},
+		"32-byte sender": {
+			tx:     newTx(moduleAccount, target),
+			reject: "32 bytes",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			app := new(recordingApp)
+			checkTxApp := addrconv.NewApp(app, encodingCfg.TxConfig.TxDecoder(), prefix)
+			resp, err := checkTxApp.CheckTx(context.Background(), &abcitypes.RequestCheckTx{
+				Tx:   test.tx,
+				Type: abcitypes.CheckTxType_New,
+			})
+			require.NoError(t, err)
+			if test.reject == "" {
+				require.True(t, resp.IsOK())
+				require.Equal(t, 1, app.checked)
+				return
+			}
+			require.Equal(t, addrconv.CodeUnmappedAddress, resp.GetCode())
+			require.Equal(t, addrconv.Codespace, resp.GetCodespace())
+			require.Contains(t, resp.GetLog(), test.reject)
+			require.Zero(t, app.checked)
+
+			// Rechecks skip the addresses.
+			_, err = checkTxApp.CheckTx(context.Background(), &abcitypes.RequestCheckTx{
+				Tx:   test.tx,
+				Type: abcitypes.CheckTxType_Recheck,
+			})
+			require.NoError(t, err)
+			require.Equal(t, 1, app.checked)
+		})
+	}
+}
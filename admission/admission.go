@@ -0,0 +1,193 @@
+// Package admission evaluates an operator-provided WASM policy program on every tx submitted to the mempool, so spam
+// rules and allowlists can change without recompiling the node.
+//
+// A policy is a WASM module that exports:
+//
+//	memory
+//	alloc(size i32) i32           returns a pointer to size bytes of memory the node writes the input to
+//	admit(ptr i32, len i32) i32   returns 0 to admit the tx described by the JSON-encoded Input, or a code to reject it
+//
+// Policies may import WASI, so they can be written with ordinary WASM toolchains, but they have no access to the
+// filesystem, network, environment, or real clocks. Each tx is evaluated by a fresh instance of the module, so
+// policies can't keep state between txs.
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+const (
+	// Codespace is the codespace of CheckTx responses for txs the policy rejects. The code is the one the policy returned.
+	Codespace = "admission"
+
+	// evaluationTimeout bounds how long a policy may run for a single tx.
+	evaluationTimeout = 100 * time.Millisecond
+	// memoryLimitPages caps a policy's memory at 16 MiB.
+	memoryLimitPages = 256
+)
+
+// Input describes a tx to a policy.
+type Input struct {
+	// Sender is the bech32 address of the tx's first signer. It is empty if the tx has no signers.
+	Sender string `json:"sender"`
+	// MsgTypeURLs are the type URLs of the tx's msgs, in order.
+	MsgTypeURLs []string `json:"msg_type_urls"`
+	// Fee is the fee the tx pays. It is an empty list, not null, if the tx pays no fee.
+	Fee sdk.Coins `json:"fee"`
+}
+
+// Policy is a compiled policy program. It is safe for concurrent use.
+type Policy struct {
+	runtime wazero.Runtime
+	module  wazero.CompiledModule
+}
+
+// NewPolicy compiles the WASM policy program. The policy must be closed to release its resources.
+func NewPolicy(ctx context.Context, wasm []byte) (*Policy, error) {
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true).
+		WithMemoryLimitPages(memoryLimitPages))
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		return nil, errors.Join(fmt.Errorf("instantiate wasi: %v", err), runtime.Close(ctx))
+	}
+	module, err := runtime.CompileModule(ctx, wasm)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("compile policy: %v", err), runtime.Close(ctx))
+	}
+	if err := checkExports(module); err != nil {
+		return nil, errors.Join(err, runtime.Close(ctx))
+	}
+	return &Policy{
+		runtime: runtime,
+		module:  module,
+	}, nil
+}
+
+func checkExports(module wazero.CompiledModule) error {
+	if _, ok := module.ExportedMemories()["memory"]; !ok {
+		return errors.New("policy does not export memory")
+	}
+	for _, name := range []string{"alloc", "admit"} {
+		if _, ok := module.ExportedFunctions()[name]; !ok {
+			return fmt.Errorf("policy does not export %s", name)
+		}
+	}
+	return nil
+}
+
+// Evaluate runs the policy on in. It returns 0 if the policy admits the tx and the rejection code otherwise.
+// An error means the policy could not be evaluated, e.g., because it trapped or timed out.
+func (p *Policy) Evaluate(ctx context.Context, in *Input) (uint32, error) {
+	inputBytes, err := json.Marshal(in)
+	if err != nil {
+		return 0, fmt.Errorf("marshal input: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, evaluationTimeout)
+	defer cancel()
+	// Reactor modules built with WASI toolchains must be initialized with _initialize instead of _start.
+	instance, err := p.runtime.InstantiateModule(ctx, p.module, wazero.NewModuleConfig().WithStartFunctions("_initialize"))
+	if err != nil {
+		return 0, fmt.Errorf("instantiate policy: %v", err)
+	}
+	defer instance.Close(ctx) //nolint:errcheck
+
+	results, err := instance.ExportedFunction("alloc").Call(ctx, uint64(len(inputBytes)))
+	if err != nil {
+		return 0, fmt.Errorf("alloc: %v", err)
+	}
+	ptr := uint32(results[0])
+	if !instance.Memory().Write(ptr, inputBytes) {
+		return 0, fmt.Errorf("write input: %d bytes at %d is out of range", len(inputBytes), ptr)
+	}
+	results, err = instance.ExportedFunction("admit").Call(ctx, uint64(ptr), uint64(len(inputBytes)))
+	if err != nil {
+		return 0, fmt.Errorf("admit: %v", err)
+	}
+	return uint32(results[0]), nil
+}
+
+// Close releases the policy's resources.
+func (p *Policy) Close(ctx context.Context) error {
+	return p.runtime.Close(ctx)
+}
+
+type AppMempool interface {
+	CheckTx(context.Context, *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error)
+}
+
+// App evaluates the policy before the wrapped app's CheckTx.
+type App struct {
+	app       AppMempool
+	txDecoder sdk.TxDecoder
+	policy    *Policy
+}
+
+func NewApp(app AppMempool, txDecoder sdk.TxDecoder, policy *Policy) *App {
+	return &App{
+		app:       app,
+		txDecoder: txDecoder,
+		policy:    policy,
+	}
+}
+
+// CheckTx rejects new txs the policy doesn't admit and passes everything else to the wrapped app.
+// Rechecks skip the policy, since the tx was already admitted.
+func (a *App) CheckTx(ctx context.Context, req *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error) {
+	if req.GetType() == abcitypes.CheckTxType_New {
+		tx, err := a.txDecoder(req.GetTx())
+		// The app reports the decoding error.
+		if err == nil {
+			in, err := NewInput(tx)
+			if err != nil {
+				return nil, fmt.Errorf("new policy input: %v", err)
+			}
+			code, err := a.policy.Evaluate(ctx, in)
+			if err != nil {
+				return nil, fmt.Errorf("evaluate admission policy: %v", err)
+			}
+			if code != 0 {
+				return &abcitypes.ResponseCheckTx{
+					Code:      code,
+					Codespace: Codespace,
+					Log:       fmt.Sprintf("rejected by admission policy with code %d", code),
+				}, nil
+			}
+		}
+	}
+	return a.app.CheckTx(ctx, req)
+}
+
+// NewInput describes tx to a policy.
+func NewInput(tx sdk.Tx) (*Input, error) {
+	in := &Input{
+		MsgTypeURLs: make([]string, 0, len(tx.GetMsgs())),
+		Fee:         sdk.Coins{},
+	}
+	for _, msg := range tx.GetMsgs() {
+		in.MsgTypeURLs = append(in.MsgTypeURLs, sdk.MsgTypeURL(msg))
+	}
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			return nil, fmt.Errorf("get signers: %v", err)
+		}
+		if len(signers) > 0 {
+			in.Sender = sdk.AccAddress(signers[0]).String()
+		}
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.GetFee() != nil {
+		in.Fee = feeTx.GetFee()
+	}
+	return in, nil
+}
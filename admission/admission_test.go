@@ -0,0 +1,127 @@
+package admission_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/polymerdao/monomer/admission"
+	"github.com/stretchr/testify/require"
+)
+
+func newPolicy(t *testing.T, name string) *admission.Policy {
+	wasm, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	policy, err := admission.NewPolicy(context.Background(), wasm)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, policy.Close(context.Background()))
+	})
+	return policy
+}
+
+func TestEvaluate(t *testing.T) {
+	policy := newPolicy(t, "require_fee.wasm")
+
+	code, err := policy.Evaluate(context.Background(), &admission.Input{
+		MsgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgSend"},
+		Fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+	})
+	require.NoError(t, err)
+	require.Zero(t, code)
+
+	code, err = policy.Evaluate(context.Background(), &admission.Input{
+		MsgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgSend"},
+		Fee:         sdk.Coins{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), code)
+}
+
+func TestEvaluateTimeout(t *testing.T) {
+	policy := newPolicy(t, "loop.wasm")
+	_, err := policy.Evaluate(context.Background(), &admission.Input{Fee: sdk.Coins{}})
+	require.Error(t, err)
+}
+
+func TestNewPolicyMissingExports(t *testing.T) {
+	// The smallest valid module, which exports nothing.
+	_, err := admission.NewPolicy(context.Background(), []byte("\x00asm\x01\x00\x00\x00"))
+	require.ErrorContains(t, err, "does not export")
+}
+
+type mockApp struct {
+	calls int
+}
+
+func (a *mockApp) CheckTx(context.Context, *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error) {
+	a.calls++
+	return &abcitypes.ResponseCheckTx{}, nil
+}
+
+func TestApp(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	txConfig := encodingConfig.TxConfig
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	makeTx := func(fee sdk.Coins) []byte {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.OneInt())))))
+		txBuilder.SetFeeAmount(fee)
+		txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	tx, err := txConfig.TxDecoder()(makeTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	require.NoError(t, err)
+	in, err := admission.NewInput(tx)
+	require.NoError(t, err)
+	require.Equal(t, &admission.Input{
+		Sender:      from.String(),
+		MsgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgSend"},
+		Fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+	}, in)
+
+	app := &mockApp{}
+	admissionApp := admission.NewApp(app, txConfig.TxDecoder(), newPolicy(t, "require_fee.wasm"))
+
+	// Admitted.
+	resp, err := admissionApp.CheckTx(context.Background(), &abcitypes.RequestCheckTx{
+		Tx: makeTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+	})
+	require.NoError(t, err)
+	require.True(t, resp.IsOK())
+	require.Equal(t, 1, app.calls)
+
+	// Rejected.
+	resp, err = admissionApp.CheckTx(context.Background(), &abcitypes.RequestCheckTx{
+		Tx: makeTx(nil),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), resp.Code)
+	require.Equal(t, admission.Codespace, resp.Codespace)
+	require.Equal(t, 1, app.calls)
+
+	// Rechecks skip the policy.
+	resp, err = admissionApp.CheckTx(context.Background(), &abcitypes.RequestCheckTx{
+		Tx:   makeTx(nil),
+		Type: abcitypes.CheckTxType_Recheck,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.IsOK())
+	require.Equal(t, 2, app.calls)
+
+	// The app reports decoding errors.
+	_, err = admissionApp.CheckTx(context.Background(), &abcitypes.RequestCheckTx{
+		Tx: []byte("not a tx"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, app.calls)
+}
@@ -0,0 +1,18 @@
+;; loop.wasm never returns.
+(module
+  (memory (export "memory") 1)
+  (global $next (mut i32) (i32.const 1024))
+  (func (export "alloc") (param $size i32) (result i32)
+    (local $ptr i32)
+    global.get $next
+    local.set $ptr
+    global.get $next
+    local.get $size
+    i32.add
+    global.set $next
+    local.get $ptr)
+  (func (export "admit") (param $ptr i32) (param $len i32) (result i32)
+    loop $forever
+      br $forever
+    end
+    i32.const 0))
@@ -0,0 +1,61 @@
+;; require_fee.wasm rejects txs that don't pay a fee.
+(module
+  (memory (export "memory") 1)
+  (global $next (mut i32) (i32.const 1024))
+  (func (export "alloc") (param $size i32) (result i32)
+    (local $ptr i32)
+    global.get $next
+    local.set $ptr
+    global.get $next
+    local.get $size
+    i32.add
+    global.set $next
+    local.get $ptr)
+  ;; admit returns 1 if the input contains the pattern at offset 0, i.e., the fee is empty, and 0 otherwise.
+  (func (export "admit") (param $ptr i32) (param $len i32) (result i32)
+    (local $i i32) (local $j i32)
+    block $done
+      loop $outer
+        local.get $i
+        i32.const 8
+        i32.add
+        local.get $len
+        i32.gt_u
+        br_if $done
+        i32.const 0
+        local.set $j
+        block $mismatch
+          loop $inner
+            local.get $j
+            i32.const 8
+            i32.eq
+            if
+              i32.const 1
+              return
+            end
+            local.get $ptr
+            local.get $i
+            i32.add
+            local.get $j
+            i32.add
+            i32.load8_u
+            local.get $j
+            i32.load8_u
+            i32.ne
+            br_if $mismatch
+            local.get $j
+            i32.const 1
+            i32.add
+            local.set $j
+            br $inner
+          end
+        end
+        local.get $i
+        i32.const 1
+        i32.add
+        local.set $i
+        br $outer
+      end
+    end
+    i32.const 0)
+  (data (i32.const 0) "\"fee\":[]"))
@@ -0,0 +1,181 @@
+package txstore
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/polymerdao/monomer/monomerdb"
+)
+
+// compressedMarker starts the tx results compressedDB compresses. The indexer stores tx results as protobuf messages,
+// which start with a field tag, and 0 is never a valid tag.
+const compressedMarker = 0
+
+// eventSeqSeparator is in every key of the indexer's event and height indexes.
+const eventSeqSeparator = "$es$"
+
+// recompressBatchSize is the number of tx results Recompress rewrites in each batch.
+const recompressBatchSize = 1000
+
+// compressedDB compresses the tx results the indexer stores by hash. The indexes' values are hashes, which aren't worth
+// compressing, and the indexer reads them with iterators, which compressedDB doesn't wrap.
+type compressedDB struct {
+	dbm.DB
+	compression monomerdb.Compression
+}
+
+// newCompressedDB returns a compressedDB that compresses with c. Tx results compressed before are read with any c.
+func newCompressedDB(db dbm.DB, c monomerdb.Compression) dbm.DB {
+	if c == "" {
+		c = monomerdb.CompressionNone
+	}
+	return &compressedDB{
+		DB:          db,
+		compression: c,
+	}
+}
+
+// isTxResultKey reports whether the key is the hash of a tx, rather than a key of the indexes or an Ethereum tx hash
+// mapping.
+func isTxResultKey(key []byte) bool {
+	return len(key) == tmhash.Size && !bytes.Contains(key, []byte(eventSeqSeparator))
+}
+
+func (db *compressedDB) Get(key []byte) ([]byte, error) {
+	value, err := db.DB.Get(key)
+	if err != nil || value == nil || !isTxResultKey(key) {
+		return value, err
+	}
+	return decodeTxResult(value)
+}
+
+func (db *compressedDB) Set(key, value []byte) error {
+	value, err := encodeTxResult(db.compression, key, value)
+	if err != nil {
+		return err
+	}
+	return db.DB.Set(key, value)
+}
+
+func (db *compressedDB) SetSync(key, value []byte) error {
+	value, err := encodeTxResult(db.compression, key, value)
+	if err != nil {
+		return err
+	}
+	return db.DB.SetSync(key, value)
+}
+
+func (db *compressedDB) NewBatch() dbm.Batch {
+	return &compressedBatch{
+		Batch:       db.DB.NewBatch(),
+		compression: db.compression,
+	}
+}
+
+type compressedBatch struct {
+	dbm.Batch
+	compression monomerdb.Compression
+}
+
+func (b *compressedBatch) Set(key, value []byte) error {
+	value, err := encodeTxResult(b.compression, key, value)
+	if err != nil {
+		return err
+	}
+	return b.Batch.Set(key, value)
+}
+
+// encodeTxResult compresses the value if the key is a tx result key.
+func encodeTxResult(c monomerdb.Compression, key, value []byte) ([]byte, error) {
+	if c == monomerdb.CompressionNone || !isTxResultKey(key) {
+		return value, nil
+	}
+	compressed, err := monomerdb.Compress(c, value)
+	if err != nil {
+		return nil, fmt.Errorf("compress tx result: %v", err)
+	}
+	return append([]byte{compressedMarker}, compressed...), nil
+}
+
+// decodeTxResult returns the tx result in the value of a tx result key, which may be uncompressed.
+func decodeTxResult(value []byte) ([]byte, error) {
+	if len(value) == 0 || value[0] != compressedMarker {
+		return value, nil
+	}
+	txResult, err := monomerdb.Decompress(value[1:])
+	if err != nil {
+		return nil, fmt.Errorf("decompress tx result: %v", err)
+	}
+	return txResult, nil
+}
+
+// Recompress rewrites the tx results in db with the compression c, e.g., to compress the tx results written before
+// compression was enabled. It must not be called while txs are added. An interrupted Recompress can be called again.
+func Recompress(db dbm.DB, c monomerdb.Compression) error {
+	if _, err := monomerdb.Compress(c, nil); err != nil {
+		return err
+	}
+	if c == "" {
+		c = monomerdb.CompressionNone
+	}
+	// Some DBs, e.g., MemDB, can't be written to while they are iterated, so each batch is read before it's written.
+	var start []byte
+	for {
+		keys, values, err := readTxResults(db, start)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		if err := writeTxResults(db, c, keys, values); err != nil {
+			return err
+		}
+		start = append(slices.Clone(keys[len(keys)-1]), 0)
+	}
+}
+
+// readTxResults returns up to recompressBatchSize tx result keys from start and their decompressed values.
+func readTxResults(db dbm.DB, start []byte) (keys, values [][]byte, err error) {
+	iter, err := db.Iterator(start, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("new iterator: %v", err)
+	}
+	defer iter.Close()
+	for ; iter.Valid() && len(keys) < recompressBatchSize; iter.Next() {
+		if !isTxResultKey(iter.Key()) {
+			continue
+		}
+		value, err := decodeTxResult(iter.Value())
+		if err != nil {
+			return nil, nil, fmt.Errorf("decode tx result %X: %v", iter.Key(), err)
+		}
+		keys = append(keys, slices.Clone(iter.Key()))
+		values = append(values, slices.Clone(value))
+	}
+	if err := iter.Error(); err != nil {
+		return nil, nil, fmt.Errorf("iterate: %v", err)
+	}
+	return keys, values, nil
+}
+
+func writeTxResults(db dbm.DB, c monomerdb.Compression, keys, values [][]byte) error {
+	batch := db.NewBatch()
+	defer batch.Close()
+	for i, key := range keys {
+		value, err := encodeTxResult(c, key, values[i])
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(key, value); err != nil {
+			return fmt.Errorf("set tx result: %v", err)
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return fmt.Errorf("write batch: %v", err)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package txstore
 
 import (
 	"context"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -10,10 +12,14 @@ import (
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/kv"
 	"github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer/monomerdb"
 )
 
 type TxStore interface {
-	// Retrieves a transaction by hash from the indexer
+	// Retrieves a transaction by hash from the indexer. The hash may be the canonical hash or the hash of one of the
+	// Ethereum txs that represent the transaction in the eth namespace.
 	Get(hash []byte) (*abcitypes.TxResult, error)
 
 	// Searches transactions that follow the provided query
@@ -22,7 +28,16 @@ type TxStore interface {
 	// Adds a list of transactions to the indexer
 	Add(txs []*abcitypes.TxResult) error
 
-	// Removes all transactions from the indexer that belong to blocks after height.
+	// Maps Ethereum tx hashes to the canonical hashes of the transactions that contain them, so Get accepts both.
+	AddEthTxHashes(hashes map[common.Hash]common.Hash) error
+
+	// Stores the logs bloom of the block at height, which the eth namespace checks before deriving the block's logs.
+	AddLogsBloom(height uint64, bloom ethtypes.Bloom) error
+
+	// Retrieves the logs bloom of the block at height. It returns false if the block's bloom isn't indexed.
+	LogsBloom(height uint64) (ethtypes.Bloom, bool, error)
+
+	// Removes all transactions and logs blooms from the indexer that belong to blocks after height.
 	RollbackToHeight(rollbackHeight, currentHeight uint64) error
 }
 
@@ -34,17 +49,66 @@ type txstore struct {
 var _ TxStore = (*txstore)(nil)
 
 func NewTxStore(db dbm.DB) TxStore {
+	return NewTxStoreWithCompression(db, monomerdb.CompressionNone)
+}
+
+// NewTxStoreWithCompression returns a TxStore that compresses the tx results it adds with c. Tx results added with any
+// compression are read.
+func NewTxStoreWithCompression(db dbm.DB, c monomerdb.Compression) TxStore {
+	db = newCompressedDB(db, c)
 	return &txstore{
 		db:  db,
 		idx: kv.NewTxIndex(db),
 	}
 }
 
+// ethTxHashPrefix prefixes the keys that map Ethereum tx hashes to canonical hashes.
+// The indexer's keys are either raw hashes or start with an event key, so they don't collide.
+const ethTxHashPrefix = "ethtx/"
+
+func ethTxHashKey(hash []byte) []byte {
+	return append([]byte(ethTxHashPrefix), hash...)
+}
+
+// logsBloomPrefix prefixes the keys of the logs blooms, which are followed by the big-endian block height.
+const logsBloomPrefix = "logsbloom/"
+
+func logsBloomKey(height uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(logsBloomPrefix), height)
+}
+
 func (t *txstore) Get(hash []byte) (*abcitypes.TxResult, error) {
-	return t.idx.Get(hash)
+	result, err := t.idx.Get(hash)
+	if err != nil || result != nil {
+		return result, err
+	}
+	canonicalHash, err := t.db.Get(ethTxHashKey(hash))
+	if err != nil {
+		return nil, fmt.Errorf("get canonical hash: %v", err)
+	} else if canonicalHash == nil {
+		return nil, nil
+	}
+	return t.idx.Get(canonicalHash)
 }
 
 func (t *txstore) Search(ctx context.Context, q *cmtquery.Query) ([]*abcitypes.TxResult, error) {
+	// Like the indexer, return the tx immediately if there is a hash condition, but accept both hash forms.
+	for _, condition := range q.Syntax() {
+		if condition.Tag != types.TxHashKey {
+			continue
+		}
+		hash, err := hex.DecodeString(condition.Arg.Value())
+		if err != nil {
+			return nil, fmt.Errorf("decode hash: %v", err)
+		}
+		result, err := t.Get(hash)
+		if err != nil {
+			return nil, fmt.Errorf("get tx: %v", err)
+		} else if result == nil {
+			return []*abcitypes.TxResult{}, nil
+		}
+		return []*abcitypes.TxResult{result}, nil
+	}
 	return t.idx.Search(ctx, q)
 }
 
@@ -64,6 +128,39 @@ func (t *txstore) Add(txs []*abcitypes.TxResult) error {
 	return nil
 }
 
+// AddEthTxHashes is not undone by RollbackToHeight. Ethereum tx hashes are derived from the transaction bytes, so stale
+// mappings either point to a transaction that is no longer indexed or are rewritten with the same value.
+func (t *txstore) AddEthTxHashes(hashes map[common.Hash]common.Hash) error {
+	batch := t.db.NewBatch()
+	defer batch.Close()
+	for ethTxHash, canonicalHash := range hashes {
+		if err := batch.Set(ethTxHashKey(ethTxHash.Bytes()), canonicalHash.Bytes()); err != nil {
+			return fmt.Errorf("set canonical hash: %v", err)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("write batch: %v", err)
+	}
+	return nil
+}
+
+func (t *txstore) AddLogsBloom(height uint64, bloom ethtypes.Bloom) error {
+	if err := t.db.Set(logsBloomKey(height), bloom.Bytes()); err != nil {
+		return fmt.Errorf("set logs bloom: %v", err)
+	}
+	return nil
+}
+
+func (t *txstore) LogsBloom(height uint64) (ethtypes.Bloom, bool, error) {
+	bloom, err := t.db.Get(logsBloomKey(height))
+	if err != nil {
+		return ethtypes.Bloom{}, false, fmt.Errorf("get logs bloom: %v", err)
+	} else if bloom == nil {
+		return ethtypes.Bloom{}, false, nil
+	}
+	return ethtypes.BytesToBloom(bloom), true, nil
+}
+
 func (t *txstore) rollbackOneBlock(batch dbm.Batch, height uint64) error {
 	// This is a bit hacky but it's the only way we have to remove txs from the indexer.
 	// The indexer stores txs in its underlying DB by constructing a key that uses a combination
@@ -94,7 +191,7 @@ func (t *txstore) rollbackOneBlock(batch dbm.Batch, height uint64) error {
 			return err
 		}
 	}
-	return nil
+	return batch.Delete(logsBloomKey(height))
 }
 
 // Access the underlying db used by the txindexer and removes all transactions. It needs
@@ -1,6 +1,7 @@
 package txstore
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -8,7 +9,12 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer/monomerdb"
 	"github.com/stretchr/testify/require"
 )
 
@@ -88,3 +94,74 @@ func TestRollback(t *testing.T) {
 		})
 	}
 }
+
+func TestCompression(t *testing.T) {
+	db := dbm.NewMemDB()
+	uncompressed := NewTxStore(db)
+	compressed := NewTxStoreWithCompression(db, monomerdb.CompressionZstd)
+	require.NoError(t, uncompressed.Add(dummyTxs(1, 2)))
+	require.NoError(t, compressed.Add(dummyTxs(2, 2)))
+	ethTxHash := common.Hash{1}
+	canonicalHash := bfttypes.Tx(dummyTxs(2, 1)[0].Tx).Hash()
+	require.NoError(t, compressed.AddEthTxHashes(map[common.Hash]common.Hash{ethTxHash: common.Hash(canonicalHash)}))
+
+	requireTxResults := func(store TxStore) {
+		for height := uint64(1); height <= 2; height++ {
+			for _, want := range dummyTxs(height, 2) {
+				got, err := store.Get(bfttypes.Tx(want.Tx).Hash())
+				require.NoError(t, err)
+				require.NotNil(t, got)
+				require.Equal(t, want.Tx, got.Tx)
+			}
+			results, err := store.Search(context.Background(), cmtquery.MustCompile(fmt.Sprintf("tx.height=%d", height)))
+			require.NoError(t, err)
+			require.Len(t, results, 2)
+		}
+		got, err := store.Get(ethTxHash.Bytes())
+		require.NoError(t, err)
+		require.NotNil(t, got)
+	}
+	// Both stores read tx results stored with any compression.
+	requireTxResults(uncompressed)
+	requireTxResults(compressed)
+	value, err := db.Get(canonicalHash)
+	require.NoError(t, err)
+	require.Equal(t, byte(compressedMarker), value[0])
+
+	// Recompressing without compression restores the indexer's format.
+	require.NoError(t, Recompress(db, monomerdb.CompressionNone))
+	value, err = db.Get(canonicalHash)
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(value, new(abcitypes.TxResult)))
+	requireTxResults(uncompressed)
+
+	require.NoError(t, Recompress(db, monomerdb.CompressionSnappy))
+	requireTxResults(uncompressed)
+	require.NoError(t, compressed.RollbackToHeight(1, 2))
+	got, err := compressed.Get(canonicalHash)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestLogsBloom(t *testing.T) {
+	txs := NewTxStore(dbm.NewMemDB())
+	var bloom ethtypes.Bloom
+	bloom.Add([]byte("topic"))
+	for height := uint64(1); height <= 3; height++ {
+		require.NoError(t, txs.AddLogsBloom(height, bloom))
+	}
+
+	got, ok, err := txs.LogsBloom(2)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, bloom, got)
+
+	// The blooms of blocks after the rollback height are removed with their txs.
+	require.NoError(t, txs.RollbackToHeight(1, 3))
+	_, ok, err = txs.LogsBloom(1)
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, ok, err = txs.LogsBloom(2)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,245 @@
+// Package audit records an append-only log of the operations that change a node's chain or lifecycle, e.g.,
+// forkchoice updates, for post-mortems and compliance.
+//
+// The log is a file of JSON entries, one per line. Each entry includes the hash of the previous one, so Export can
+// detect entries that were edited or removed.
+package audit
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	ActionNodeStart        = "node_start"
+	ActionNodeStop         = "node_stop"
+	ActionForkchoiceUpdate = "forkchoice_update"
+	ActionRollback         = "rollback"
+	// ActionReplay records a block rebuilt from the write-ahead log after a crash.
+	ActionReplay = "replay"
+	// ActionStatePatch records a node started with a state patch, and ActionStatePatchApplied a block built with it.
+	ActionStatePatch        = "state_patch"
+	ActionStatePatchApplied = "state_patch_applied"
+
+	// ActorNode is the actor of entries the node records on its own, rather than on behalf of an RPC caller.
+	ActorNode = "node"
+
+	// maxEntrySize bounds the length of a line when reading the log.
+	maxEntrySize = 1 << 20
+)
+
+// Entry records who did what and when.
+type Entry struct {
+	Time time.Time `json:"time"`
+	// Actor is the RPC caller, e.g., "ws://127.0.0.1:1234", or ActorNode.
+	Actor   string            `json:"actor"`
+	Action  string            `json:"action"`
+	Details map[string]string `json:"details,omitempty"`
+	// PrevHash is the Hash of the previous entry. It is empty for the first entry.
+	PrevHash string `json:"prev_hash"`
+	// Hash is the hex-encoded SHA-256 hash of the entry's JSON encoding with Hash unset.
+	Hash string `json:"hash"`
+}
+
+func (e *Entry) computeHash() (string, error) {
+	entry := *e
+	entry.Hash = ""
+	entryBytes, err := json.Marshal(&entry)
+	if err != nil {
+		return "", fmt.Errorf("marshal entry: %v", err)
+	}
+	hash := sha256.Sum256(entryBytes)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Log appends entries to a writer. It is safe for concurrent use.
+type Log struct {
+	mu       sync.Mutex
+	w        io.Writer
+	prevHash string
+	now      func() time.Time
+}
+
+// New creates a log that writes to w. The first entry starts a new hash chain.
+func New(w io.Writer) *Log {
+	return &Log{
+		w:   w,
+		now: time.Now,
+	}
+}
+
+// Open opens the log file at path, creating it if needed. New entries are appended to the existing ones.
+// The log must be closed.
+func Open(path string) (*Log, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %v", err)
+	}
+	var prevHash string
+	if err := readEntries(f, func(entry *Entry) error {
+		prevHash = entry.Hash
+		return nil
+	}); err != nil {
+		return nil, errors.Join(fmt.Errorf("read audit log: %v", err), f.Close())
+	}
+	l := New(f)
+	l.prevHash = prevHash
+	return l, nil
+}
+
+// Record appends an entry. The actor is the RPC caller in ctx, if any, and ActorNode otherwise.
+func (l *Log) Record(ctx context.Context, action string, details map[string]string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	entry := &Entry{
+		Time:     l.now().UTC(),
+		Actor:    actor(ctx),
+		Action:   action,
+		Details:  details,
+		PrevHash: l.prevHash,
+	}
+	hash, err := entry.computeHash()
+	if err != nil {
+		return err
+	}
+	entry.Hash = hash
+	entryBytes, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal entry: %v", err)
+	}
+	if _, err := l.w.Write(append(entryBytes, '\n')); err != nil {
+		return fmt.Errorf("write entry: %v", err)
+	}
+	if f, ok := l.w.(*os.File); ok {
+		if err := f.Sync(); err != nil {
+			return fmt.Errorf("sync audit log: %v", err)
+		}
+	}
+	l.prevHash = hash
+	return nil
+}
+
+// Close closes the underlying writer if it is an io.Closer.
+func (l *Log) Close() error {
+	if closer, ok := l.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func actor(ctx context.Context) string {
+	peer := rpc.PeerInfoFromContext(ctx)
+	if peer.RemoteAddr == "" {
+		return ActorNode
+	}
+	return peer.Transport + "://" + peer.RemoteAddr
+}
+
+// readEntries calls fn on every entry in r in order, verifying the hash chain.
+func readEntries(r io.Reader, fn func(*Entry) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxEntrySize)
+	var prevHash string
+	for line := 1; scanner.Scan(); line++ {
+		entry := new(Entry)
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return fmt.Errorf("unmarshal entry on line %d: %v", line, err)
+		}
+		if entry.PrevHash != prevHash {
+			return fmt.Errorf("entry on line %d does not follow the previous entry", line)
+		}
+		if hash, err := entry.computeHash(); err != nil {
+			return err
+		} else if hash != entry.Hash {
+			return fmt.Errorf("entry on line %d has been modified", line)
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+		prevHash = entry.Hash
+	}
+	return scanner.Err()
+}
+
+// Format is an export format.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatCSV  Format = "csv"
+)
+
+// Export verifies the log in r and writes the entries recorded in [since, until) to w. A zero since or until is
+// unbounded. JSON exports are the entries as recorded, one per line. CSV exports have a header and a column per field,
+// with details encoded as space-separated key=value pairs.
+func Export(r io.Reader, w io.Writer, format Format, since, until time.Time) error {
+	var writeEntry func(*Entry) error
+	flush := func() error { return nil }
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		writeEntry = func(entry *Entry) error {
+			return encoder.Encode(entry)
+		}
+	case FormatCSV:
+		csvWriter := csv.NewWriter(w)
+		flush = func() error {
+			csvWriter.Flush()
+			return csvWriter.Error()
+		}
+		if err := csvWriter.Write([]string{"time", "actor", "action", "details", "prev_hash", "hash"}); err != nil {
+			return fmt.Errorf("write header: %v", err)
+		}
+		writeEntry = func(entry *Entry) error {
+			return csvWriter.Write([]string{
+				entry.Time.Format(time.RFC3339Nano),
+				entry.Actor,
+				entry.Action,
+				formatDetails(entry.Details),
+				entry.PrevHash,
+				entry.Hash,
+			})
+		}
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+
+	if err := readEntries(r, func(entry *Entry) error {
+		if (!since.IsZero() && entry.Time.Before(since)) || (!until.IsZero() && !entry.Time.Before(until)) {
+			return nil
+		}
+		if err := writeEntry(entry); err != nil {
+			return fmt.Errorf("write entry: %v", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return fmt.Errorf("flush: %v", err)
+	}
+	return nil
+}
+
+func formatDetails(details map[string]string) string {
+	pairs := make([]string, 0, len(details))
+	for k, v := range details {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, " ")
+}
@@ -0,0 +1,73 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/polymerdao/monomer/audit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := audit.Open(path)
+	require.NoError(t, err)
+	require.NoError(t, l.Record(context.Background(), audit.ActionNodeStart, map[string]string{"height": "1"}))
+	require.NoError(t, l.Close())
+
+	// Entries recorded after reopening continue the hash chain.
+	l, err = audit.Open(path)
+	require.NoError(t, err)
+	require.NoError(t, l.Record(context.Background(), audit.ActionNodeStop, nil))
+	require.NoError(t, l.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var out bytes.Buffer
+	require.NoError(t, audit.Export(f, &out, audit.FormatCSV, time.Time{}, time.Time{}))
+	records, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, []string{"time", "actor", "action", "details", "prev_hash", "hash"}, records[0])
+	require.Equal(t, []string{audit.ActorNode, audit.ActionNodeStart, "height=1", ""}, records[1][1:5])
+	require.Equal(t, []string{audit.ActorNode, audit.ActionNodeStop, "", records[1][5]}, records[2][1:5])
+}
+
+func TestExport(t *testing.T) {
+	var log bytes.Buffer
+	l := audit.New(&log)
+	start := time.Now()
+	require.NoError(t, l.Record(context.Background(), audit.ActionForkchoiceUpdate, map[string]string{"unsafe": "0x1"}))
+	require.NoError(t, l.Record(context.Background(), audit.ActionRollback, map[string]string{"to_height": "1"}))
+	logBytes := log.Bytes()
+
+	var out bytes.Buffer
+	require.NoError(t, audit.Export(bytes.NewReader(logBytes), &out, audit.FormatJSON, time.Time{}, time.Time{}))
+	require.Equal(t, string(logBytes), out.String())
+
+	out.Reset()
+	require.NoError(t, audit.Export(bytes.NewReader(logBytes), &out, audit.FormatJSON, start.Add(time.Hour), time.Time{}))
+	require.Empty(t, out.String())
+	require.NoError(t, audit.Export(bytes.NewReader(logBytes), &out, audit.FormatJSON, time.Time{}, start.Add(-time.Hour)))
+	require.Empty(t, out.String())
+
+	require.ErrorContains(t, audit.Export(bytes.NewReader(logBytes), &out, "xml", time.Time{}, time.Time{}), "unknown format")
+
+	lines := strings.SplitAfter(string(logBytes), "\n")
+	for name, tampered := range map[string]string{
+		"modified":  lines[0] + strings.Replace(lines[1], `"to_height":"1"`, `"to_height":"2"`, 1),
+		"removed":   lines[1],
+		"reordered": lines[1] + lines[0],
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, audit.Export(strings.NewReader(tampered), &out, audit.FormatJSON, time.Time{}, time.Time{}))
+		})
+	}
+}
@@ -1,9 +1,21 @@
 package bindings
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"math/big"
+	"reflect"
 
+	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	bindings "github.com/polymerdao/monomer/bindings/generated"
+)
+
+const (
+	relayMessageMethodName        = "relayMessage"
+	finalizeBridgeERC20MethodName = "finalizeBridgeERC20"
 )
 
 type RelayMessageArgs struct {
@@ -15,6 +27,31 @@ type RelayMessageArgs struct {
 	Message     []byte
 }
 
+// Pack encodes the args as CrossDomainMessenger relayMessage calldata.
+func (a *RelayMessageArgs) Pack() ([]byte, error) {
+	crossDomainMessengerABI, err := opbindings.CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("get CrossDomainMessenger ABI: %v", err)
+	}
+	data, err := crossDomainMessengerABI.Pack(relayMessageMethodName, a.Nonce, a.Sender, a.Target, a.Value, a.MinGasLimit, a.Message)
+	if err != nil {
+		return nil, fmt.Errorf("pack relayMessage: %v", err)
+	}
+	return data, nil
+}
+
+// Unpack decodes CrossDomainMessenger relayMessage calldata into the args.
+func (a *RelayMessageArgs) Unpack(data []byte) error {
+	crossDomainMessengerABI, err := opbindings.CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("get CrossDomainMessenger ABI: %v", err)
+	}
+	if err := unpackInputsIntoInterface(crossDomainMessengerABI.Methods[relayMessageMethodName], data, a); err != nil {
+		return fmt.Errorf("unpack relayMessage: %w", err)
+	}
+	return nil
+}
+
 type FinalizeBridgeERC20Args struct {
 	RemoteToken common.Address
 	LocalToken  common.Address
@@ -23,3 +60,72 @@ type FinalizeBridgeERC20Args struct {
 	Amount      *big.Int
 	ExtraData   []byte
 }
+
+// Pack encodes the args as StandardBridge finalizeBridgeERC20 calldata.
+func (a *FinalizeBridgeERC20Args) Pack() ([]byte, error) {
+	standardBridgeABI, err := bindings.L1StandardBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("get L1StandardBridge ABI: %v", err)
+	}
+	data, err := standardBridgeABI.Pack(finalizeBridgeERC20MethodName, a.RemoteToken, a.LocalToken, a.From, a.To, a.Amount, a.ExtraData)
+	if err != nil {
+		return nil, fmt.Errorf("pack finalizeBridgeERC20: %v", err)
+	}
+	return data, nil
+}
+
+// Unpack decodes StandardBridge finalizeBridgeERC20 calldata into the args.
+func (a *FinalizeBridgeERC20Args) Unpack(data []byte) error {
+	standardBridgeABI, err := bindings.L1StandardBridgeMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("get L1StandardBridge ABI: %v", err)
+	}
+	if err := unpackInputsIntoInterface(standardBridgeABI.Methods[finalizeBridgeERC20MethodName], data, a); err != nil {
+		return fmt.Errorf("unpack finalizeBridgeERC20: %w", err)
+	}
+	return nil
+}
+
+// UnpackRelayedFinalizeBridgeERC20 decodes relayMessage calldata relaying a finalizeBridgeERC20 message, which is how
+// the L1StandardBridge deposits ERC-20 tokens, into the relayed message and its finalizeBridgeERC20 args. It wraps
+// ErrUnexpectedSelector if the calldata relays anything else.
+func UnpackRelayedFinalizeBridgeERC20(data []byte) (*RelayMessageArgs, *FinalizeBridgeERC20Args, error) {
+	relayMessage := new(RelayMessageArgs)
+	if err := relayMessage.Unpack(data); err != nil {
+		return nil, nil, err
+	}
+	finalizeBridgeERC20 := new(FinalizeBridgeERC20Args)
+	if err := finalizeBridgeERC20.Unpack(relayMessage.Message); err != nil {
+		return nil, nil, err
+	}
+	return relayMessage, finalizeBridgeERC20, nil
+}
+
+// ErrUnexpectedSelector is returned when unpacking calldata for a different method.
+var ErrUnexpectedSelector = errors.New("unexpected function selector")
+
+// unpackInputsIntoInterface unpacks the input data of a function call into an interface. This function behaves
+// similarly to the geth abi UnpackIntoInterface function but unpacks method inputs instead of outputs.
+func unpackInputsIntoInterface(method abi.Method, inputData []byte, outputInterface interface{}) error { //nolint:gocritic // hugeParam
+	// Check if the function selector matches the method ID
+	if len(inputData) < len(method.ID) || !bytes.Equal(inputData[:len(method.ID)], method.ID) {
+		return fmt.Errorf("%w: expected %x", ErrUnexpectedSelector, method.ID)
+	}
+
+	inputs, err := method.Inputs.Unpack(inputData[len(method.ID):])
+	if err != nil {
+		return fmt.Errorf("failed to unpack input data: %v", err)
+	}
+
+	outputVal := reflect.ValueOf(outputInterface).Elem()
+	for i, input := range inputs {
+		field := outputVal.Field(i)
+		if field.CanSet() {
+			val := reflect.ValueOf(input)
+			field.Set(val)
+		} else {
+			return fmt.Errorf("field %d can not be set for method %v", i, method.Name)
+		}
+	}
+	return nil
+}
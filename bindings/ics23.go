@@ -0,0 +1,54 @@
+package bindings
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	ics23 "github.com/cosmos/ics23/go"
+	bindings "github.com/polymerdao/monomer/bindings/generated"
+)
+
+// NewICS23ExistenceProofs converts the proof ops returned by a proven ABCI store query into the existence proofs
+// accepted by the CosmosWithdrawalPortal contract. The first proof is for the key in the module store and the second
+// proof is for the module store root in the multistore.
+func NewICS23ExistenceProofs(proofOps *crypto.ProofOps) (*bindings.ICS23ExistenceProof, *bindings.ICS23ExistenceProof, error) {
+	if proofOps == nil || len(proofOps.Ops) != 2 { //nolint:mnd
+		return nil, nil, errors.New("expected a module store proof and a multistore proof")
+	}
+
+	storeProof, err := newICS23ExistenceProof(proofOps.Ops[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("convert module store proof: %v", err)
+	}
+	appProof, err := newICS23ExistenceProof(proofOps.Ops[1])
+	if err != nil {
+		return nil, nil, fmt.Errorf("convert multistore proof: %v", err)
+	}
+	return storeProof, appProof, nil
+}
+
+func newICS23ExistenceProof(op crypto.ProofOp) (*bindings.ICS23ExistenceProof, error) {
+	commitmentProof := new(ics23.CommitmentProof)
+	if err := commitmentProof.Unmarshal(op.GetData()); err != nil {
+		return nil, fmt.Errorf("unmarshal commitment proof: %v", err)
+	}
+	existenceProof := commitmentProof.GetExist()
+	if existenceProof == nil {
+		return nil, fmt.Errorf("%s proof is not an existence proof", op.GetType())
+	}
+
+	path := make([]bindings.ICS23InnerOp, 0, len(existenceProof.GetPath()))
+	for _, innerOp := range existenceProof.GetPath() {
+		path = append(path, bindings.ICS23InnerOp{
+			Prefix: innerOp.GetPrefix(),
+			Suffix: innerOp.GetSuffix(),
+		})
+	}
+	return &bindings.ICS23ExistenceProof{
+		Key:        existenceProof.GetKey(),
+		Value:      existenceProof.GetValue(),
+		LeafPrefix: existenceProof.GetLeaf().GetPrefix(),
+		Path:       path,
+	}, nil
+}
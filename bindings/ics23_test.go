@@ -0,0 +1,82 @@
+package bindings_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/bindings"
+	generated "github.com/polymerdao/monomer/bindings/generated"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewICS23ExistenceProofs(t *testing.T) {
+	chainID := monomer.ChainID(0).String()
+	app := testapp.NewTest(t, chainID)
+	_, err := app.InitChain(context.Background(), &abcitypes.RequestInitChain{
+		ChainId: chainID,
+		AppStateBytes: func() []byte {
+			got, err := json.Marshal(app.DefaultGenesis())
+			require.NoError(t, err)
+			return got
+		}(),
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+	_, err = app.Commit(context.Background(), &abcitypes.RequestCommit{})
+	require.NoError(t, err)
+
+	key, value := "key", "value"
+	_, err = app.FinalizeBlock(context.Background(), &abcitypes.RequestFinalizeBlock{
+		Txs:    [][]byte{testapp.ToTestTx(t, key, value)},
+		Height: 2,
+	})
+	require.NoError(t, err)
+	_, err = app.Commit(context.Background(), &abcitypes.RequestCommit{})
+	require.NoError(t, err)
+
+	resp, err := app.Query(context.Background(), &abcitypes.RequestQuery{
+		Path:   "/store/testmodule/key",
+		Data:   []byte(key),
+		Height: 2,
+		Prove:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte(value), resp.GetValue())
+
+	storeProof, appProof, err := bindings.NewICS23ExistenceProofs(resp.GetProofOps())
+	require.NoError(t, err)
+	require.Equal(t, []byte(key), storeProof.Key)
+	require.Equal(t, []byte(value), storeProof.Value)
+	require.Equal(t, []byte("testmodule"), appProof.Key)
+
+	// Calculate the roots the same way as the ICS23 solidity library.
+	storeRoot := calculateRoot(storeProof)
+	require.Equal(t, storeRoot, appProof.Value)
+	info, err := app.Info(context.Background(), &abcitypes.RequestInfo{})
+	require.NoError(t, err)
+	require.Equal(t, info.GetLastBlockAppHash(), calculateRoot(appProof))
+
+	_, _, err = bindings.NewICS23ExistenceProofs(nil)
+	require.Error(t, err)
+}
+
+func calculateRoot(proof *generated.ICS23ExistenceProof) []byte {
+	hashedValue := sha256.Sum256(proof.Value)
+	leaf := append([]byte{}, proof.LeafPrefix...)
+	leaf = binary.AppendUvarint(leaf, uint64(len(proof.Key)))
+	leaf = append(leaf, proof.Key...)
+	leaf = binary.AppendUvarint(leaf, uint64(len(hashedValue)))
+	leaf = append(leaf, hashedValue[:]...)
+	node := sha256.Sum256(leaf)
+	for _, op := range proof.Path {
+		inner := append(append(append([]byte{}, op.Prefix...), node[:]...), op.Suffix...)
+		node = sha256.Sum256(inner)
+	}
+	return node[:]
+}
@@ -0,0 +1,43 @@
+package bindings
+
+import (
+	"fmt"
+
+	opgenesis "github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	bindings "github.com/polymerdao/monomer/bindings/generated"
+)
+
+// L1Contracts holds the bindings for the OP Stack L1 contracts that interact with Monomer.
+type L1Contracts struct {
+	OptimismPortal2    *bindings.OptimismPortal2
+	DisputeGameFactory *bindings.DisputeGameFactory
+	L1StandardBridge   *bindings.L1StandardBridge
+	SystemConfig       *bindings.SystemConfig
+}
+
+// NewL1Contracts binds the L1 contracts at their proxy addresses in the deployments.
+func NewL1Contracts(deployments *opgenesis.L1Deployments, backend bind.ContractBackend) (*L1Contracts, error) {
+	optimismPortal2, err := bindings.NewOptimismPortal2(deployments.OptimismPortalProxy, backend)
+	if err != nil {
+		return nil, fmt.Errorf("new optimism portal 2: %v", err)
+	}
+	disputeGameFactory, err := bindings.NewDisputeGameFactory(deployments.DisputeGameFactoryProxy, backend)
+	if err != nil {
+		return nil, fmt.Errorf("new dispute game factory: %v", err)
+	}
+	l1StandardBridge, err := bindings.NewL1StandardBridge(deployments.L1StandardBridgeProxy, backend)
+	if err != nil {
+		return nil, fmt.Errorf("new l1 standard bridge: %v", err)
+	}
+	systemConfig, err := bindings.NewSystemConfig(deployments.SystemConfigProxy, backend)
+	if err != nil {
+		return nil, fmt.Errorf("new system config: %v", err)
+	}
+	return &L1Contracts{
+		OptimismPortal2:    optimismPortal2,
+		DisputeGameFactory: disputeGameFactory,
+		L1StandardBridge:   l1StandardBridge,
+		SystemConfig:       systemConfig,
+	}, nil
+}
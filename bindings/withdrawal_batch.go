@@ -0,0 +1,115 @@
+package bindings
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	bindings "github.com/polymerdao/monomer/bindings/generated"
+)
+
+const registerBatchMethodName = "registerBatch"
+
+type RegisterBatchArgs struct {
+	BatchNonce *big.Int
+	Root       common.Hash
+}
+
+// Pack encodes the args as WithdrawalClaimer registerBatch calldata.
+func (a *RegisterBatchArgs) Pack() ([]byte, error) {
+	withdrawalClaimerABI, err := bindings.WithdrawalClaimerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("get WithdrawalClaimer ABI: %v", err)
+	}
+	data, err := withdrawalClaimerABI.Pack(registerBatchMethodName, a.BatchNonce, a.Root)
+	if err != nil {
+		return nil, fmt.Errorf("pack registerBatch: %v", err)
+	}
+	return data, nil
+}
+
+// Unpack decodes WithdrawalClaimer registerBatch calldata into the args.
+func (a *RegisterBatchArgs) Unpack(data []byte) error {
+	withdrawalClaimerABI, err := bindings.WithdrawalClaimerMetaData.GetAbi()
+	if err != nil {
+		return fmt.Errorf("get WithdrawalClaimer ABI: %v", err)
+	}
+	if err := unpackInputsIntoInterface(withdrawalClaimerABI.Methods[registerBatchMethodName], data, a); err != nil {
+		return fmt.Errorf("unpack registerBatch: %w", err)
+	}
+	return nil
+}
+
+// WithdrawalBatchLeaf returns the leaf hash of a batched withdrawal, keccak256(abi.encode(batchNonce, index, target, value)),
+// the same way as the WithdrawalClaimer.
+func WithdrawalBatchLeaf(batchNonce, index uint64, target common.Address, value *big.Int) common.Hash {
+	return crypto.Keccak256Hash(
+		common.LeftPadBytes(new(big.Int).SetUint64(batchNonce).Bytes(), common.HashLength),
+		common.LeftPadBytes(new(big.Int).SetUint64(index).Bytes(), common.HashLength),
+		common.LeftPadBytes(target.Bytes(), common.HashLength),
+		common.LeftPadBytes(value.Bytes(), common.HashLength),
+	)
+}
+
+// WithdrawalBatchRoot returns the merkle root of the leaves of a withdrawal batch. Inner nodes hash their children in
+// sorted order and a node without a sibling is promoted to the next level unchanged. The root of no leaves is the zero
+// hash.
+func WithdrawalBatchRoot(leaves []common.Hash) common.Hash {
+	if len(leaves) == 0 {
+		return common.Hash{}
+	}
+	level := leaves
+	for len(level) > 1 {
+		level = nextWithdrawalBatchLevel(level)
+	}
+	return level[0]
+}
+
+// WithdrawalBatchProof returns the sibling hashes from the leaf at index to the root, which the WithdrawalClaimer
+// verifies claims with.
+func WithdrawalBatchProof(leaves []common.Hash, index int) ([]common.Hash, error) {
+	if index < 0 || index >= len(leaves) {
+		return nil, fmt.Errorf("index %d out of range for %d leaves", index, len(leaves))
+	}
+	var proof []common.Hash
+	level := leaves
+	for len(level) > 1 {
+		if sibling := index ^ 1; sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = nextWithdrawalBatchLevel(level)
+		index /= 2
+	}
+	return proof, nil
+}
+
+// VerifyWithdrawalBatchProof reports whether the proof proves the leaf is in the batch with the root, the same way as
+// the WithdrawalClaimer.
+func VerifyWithdrawalBatchProof(root, leaf common.Hash, proof []common.Hash) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = hashWithdrawalBatchPair(node, sibling)
+	}
+	return node == root
+}
+
+func nextWithdrawalBatchLevel(level []common.Hash) []common.Hash {
+	next := make([]common.Hash, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+		} else {
+			next = append(next, hashWithdrawalBatchPair(level[i], level[i+1]))
+		}
+	}
+	return next
+}
+
+func hashWithdrawalBatchPair(a, b common.Hash) common.Hash {
+	if bytes.Compare(a.Bytes(), b.Bytes()) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a.Bytes(), b.Bytes())
+}
@@ -0,0 +1,66 @@
+package bindings_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/polymerdao/monomer/bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalBatchLeaf(t *testing.T) {
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+	addressType, err := abi.NewType("address", "", nil)
+	require.NoError(t, err)
+	encoded, err := abi.Arguments{
+		{Type: uint256Type},
+		{Type: uint256Type},
+		{Type: addressType},
+		{Type: uint256Type},
+	}.Pack(big.NewInt(3), big.NewInt(7), common.Address{1}, big.NewInt(1000))
+	require.NoError(t, err)
+
+	require.Equal(t, crypto.Keccak256Hash(encoded), bindings.WithdrawalBatchLeaf(3, 7, common.Address{1}, big.NewInt(1000)))
+}
+
+func TestWithdrawalBatchProofs(t *testing.T) {
+	require.Equal(t, common.Hash{}, bindings.WithdrawalBatchRoot(nil))
+
+	for size := 1; size <= 9; size++ {
+		leaves := make([]common.Hash, 0, size)
+		for i := range size {
+			leaves = append(leaves, bindings.WithdrawalBatchLeaf(0, uint64(i), common.Address{byte(i)}, big.NewInt(int64(i))))
+		}
+		root := bindings.WithdrawalBatchRoot(leaves)
+		if size == 1 {
+			require.Equal(t, leaves[0], root)
+		}
+
+		for i, leaf := range leaves {
+			proof, err := bindings.WithdrawalBatchProof(leaves, i)
+			require.NoError(t, err)
+			require.True(t, bindings.VerifyWithdrawalBatchProof(root, leaf, proof), "size %d, index %d", size, i)
+			require.False(t, bindings.VerifyWithdrawalBatchProof(root, common.Hash{1}, proof), "size %d, index %d", size, i)
+		}
+
+		_, err := bindings.WithdrawalBatchProof(leaves, size)
+		require.Error(t, err)
+	}
+}
+
+func TestRegisterBatchArgs(t *testing.T) {
+	args := &bindings.RegisterBatchArgs{
+		BatchNonce: big.NewInt(5),
+		Root:       common.Hash{1, 2, 3},
+	}
+	data, err := args.Pack()
+	require.NoError(t, err)
+
+	got := new(bindings.RegisterBatchArgs)
+	require.NoError(t, got.Unpack(data))
+	require.Equal(t, args, got)
+}
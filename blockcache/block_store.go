@@ -0,0 +1,194 @@
+package blockcache
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer"
+)
+
+type DB interface {
+	UpdateLabels(unsafe, safe, finalized common.Hash) error
+	Height() (uint64, error)
+	HeaderByHash(hash common.Hash) (*monomer.Header, error)
+	Rollback(unsafe, safe, finalized common.Hash) error
+	HeaderByHeight(height uint64) (*monomer.Header, error)
+	AppendBlock(*monomer.Block) error
+	HeadHeader() (*monomer.Header, error)
+	BlockByLabel(eth.BlockLabel) (*monomer.Block, error)
+	BlockByHeight(uint64) (*monomer.Block, error)
+	BlockByHash(hash common.Hash) (*monomer.Block, error)
+	HeadBlock() (*monomer.Block, error)
+	ArchivedHeaderByHeight(uint64) (*monomer.Header, error)
+}
+
+// BlockStore caches the blocks and headers read from a DB. All writes must go through it, so it can drop the entries
+// they change.
+type BlockStore struct {
+	db    DB
+	cache *Cache
+}
+
+func NewBlockStore(db DB, cache *Cache) *BlockStore {
+	return &BlockStore{
+		db:    db,
+		cache: cache,
+	}
+}
+
+func (s *BlockStore) AppendBlock(block *monomer.Block) error {
+	if err := s.db.AppendBlock(block); err != nil {
+		return err
+	}
+	s.cache.invalidate(kindHead)
+	return nil
+}
+
+func (s *BlockStore) UpdateLabels(unsafe, safe, finalized common.Hash) error {
+	if err := s.db.UpdateLabels(unsafe, safe, finalized); err != nil {
+		return err
+	}
+	s.cache.invalidate(kindLabel)
+	return nil
+}
+
+// Rollback drops every entry, since the rolled back blocks are gone and their heights may be reused.
+func (s *BlockStore) Rollback(unsafe, safe, finalized common.Hash) error {
+	if err := s.db.Rollback(unsafe, safe, finalized); err != nil {
+		return err
+	}
+	s.cache.invalidate()
+	return nil
+}
+
+// PruneBelow deletes the blocks below height if the DB supports pruning.
+func (s *BlockStore) PruneBelow(height uint64) error {
+	pruner, ok := s.db.(interface {
+		PruneBelow(height uint64) error
+	})
+	if !ok {
+		return errors.New("block db does not support pruning")
+	}
+	if err := pruner.PruneBelow(height); err != nil {
+		return err
+	}
+	s.cache.invalidate()
+	return nil
+}
+
+func (s *BlockStore) Height() (uint64, error) {
+	return s.db.Height()
+}
+
+func (s *BlockStore) ArchivedHeaderByHeight(height uint64) (*monomer.Header, error) {
+	return s.db.ArchivedHeaderByHeight(height)
+}
+
+func (s *BlockStore) HeadHeader() (*monomer.Header, error) {
+	k := key{kind: kindHead}
+	if header, ok := s.cache.get(k); ok {
+		return header.(*monomer.Header), nil
+	}
+	generation := s.cache.currentGeneration()
+	header, err := s.db.HeadHeader()
+	if err != nil {
+		return nil, err
+	}
+	s.cache.add(generation, k, header, headerSize)
+	return header, nil
+}
+
+func (s *BlockStore) HeadBlock() (*monomer.Block, error) {
+	header, err := s.HeadHeader()
+	if err != nil {
+		return nil, err
+	}
+	return s.BlockByHash(header.Hash)
+}
+
+func (s *BlockStore) BlockByHash(hash common.Hash) (*monomer.Block, error) {
+	k := key{kind: kindBlock, id: string(hash.Bytes())}
+	if block, ok := s.cache.get(k); ok {
+		return block.(*monomer.Block), nil
+	}
+	generation := s.cache.currentGeneration()
+	block, err := s.db.BlockByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	s.addBlock(generation, block)
+	return block, nil
+}
+
+func (s *BlockStore) BlockByHeight(height uint64) (*monomer.Block, error) {
+	k := heightKey(height)
+	if hash, ok := s.cache.get(k); ok {
+		return s.BlockByHash(hash.(common.Hash))
+	}
+	generation := s.cache.currentGeneration()
+	block, err := s.db.BlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.add(generation, k, block.Header.Hash, common.HashLength)
+	s.addBlock(generation, block)
+	return block, nil
+}
+
+func (s *BlockStore) BlockByLabel(label eth.BlockLabel) (*monomer.Block, error) {
+	k := key{kind: kindLabel, id: string(label)}
+	if hash, ok := s.cache.get(k); ok {
+		return s.BlockByHash(hash.(common.Hash))
+	}
+	generation := s.cache.currentGeneration()
+	block, err := s.db.BlockByLabel(label)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.add(generation, k, block.Header.Hash, common.HashLength)
+	s.addBlock(generation, block)
+	return block, nil
+}
+
+func (s *BlockStore) HeaderByHash(hash common.Hash) (*monomer.Header, error) {
+	k := key{kind: kindHeader, id: string(hash.Bytes())}
+	if header, ok := s.cache.get(k); ok {
+		return header.(*monomer.Header), nil
+	}
+	generation := s.cache.currentGeneration()
+	header, err := s.db.HeaderByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.add(generation, k, header, headerSize)
+	return header, nil
+}
+
+func (s *BlockStore) HeaderByHeight(height uint64) (*monomer.Header, error) {
+	k := heightKey(height)
+	if hash, ok := s.cache.get(k); ok {
+		return s.HeaderByHash(hash.(common.Hash))
+	}
+	generation := s.cache.currentGeneration()
+	header, err := s.db.HeaderByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.add(generation, k, header.Hash, common.HashLength)
+	s.cache.add(generation, key{kind: kindHeader, id: string(header.Hash.Bytes())}, header, headerSize)
+	return header, nil
+}
+
+func (s *BlockStore) addBlock(generation uint64, block *monomer.Block) {
+	size := headerSize
+	for _, tx := range block.Txs {
+		size += len(tx)
+	}
+	s.cache.add(generation, key{kind: kindBlock, id: string(block.Header.Hash.Bytes())}, block, size)
+}
+
+func heightKey(height uint64) key {
+	return key{kind: kindHeight, id: strconv.FormatUint(height, 10)}
+}
@@ -0,0 +1,128 @@
+// Package blockcache keeps recent blocks, headers, and tx results in memory, so RPC-heavy workloads don't read the same
+// recent blocks from disk over and over. The eth and comet namespaces share one cache through BlockStore and TxStore.
+package blockcache
+
+import (
+	"container/list"
+	"slices"
+	"sync"
+)
+
+// headerSize is roughly the size of a decoded header in memory.
+const headerSize = 512
+
+type kind string
+
+const (
+	kindBlock  kind = "block"
+	kindHeader kind = "header"
+	kindHeight kind = "height"
+	kindLabel  kind = "label"
+	kindHead   kind = "head"
+	kindTx     kind = "tx"
+)
+
+type key struct {
+	kind kind
+	id   string
+}
+
+type entry struct {
+	key   key
+	value any
+	size  int
+}
+
+// Cache is a least-recently-used cache with a memory budget in bytes. Cached blocks, headers, and tx results are
+// shared, so callers must not modify them.
+type Cache struct {
+	maxBytes int
+	metrics  Metrics
+
+	mu      sync.Mutex
+	bytes   int
+	entries *list.List
+	index   map[key]*list.Element
+	// generation is incremented when entries are invalidated, so values read from disk before the invalidation aren't
+	// added after it.
+	generation uint64
+}
+
+// New returns a cache that holds up to maxBytes of blocks, headers, and tx results.
+func New(maxBytes int, metrics Metrics) *Cache {
+	return &Cache{
+		maxBytes: maxBytes,
+		metrics:  metrics,
+		entries:  list.New(),
+		index:    make(map[key]*list.Element),
+	}
+}
+
+func (c *Cache) get(k key) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.index[k]
+	if !ok {
+		c.metrics.RecordMiss(string(k.kind))
+		return nil, false
+	}
+	c.metrics.RecordHit(string(k.kind))
+	c.entries.MoveToFront(element)
+	return element.Value.(*entry).value, true
+}
+
+// currentGeneration returns the generation to pass to add for a value about to be read from disk.
+func (c *Cache) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// add adds the value read from disk during generation, evicting the least recently used entries to stay within the
+// budget. Values larger than the budget aren't added.
+func (c *Cache) add(generation uint64, k key, value any, size int) {
+	size += len(k.id)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation || size > c.maxBytes {
+		return
+	}
+	if element, ok := c.index[k]; ok {
+		c.remove(element)
+	}
+	c.index[k] = c.entries.PushFront(&entry{
+		key:   k,
+		value: value,
+		size:  size,
+	})
+	c.bytes += size
+	for c.bytes > c.maxBytes {
+		c.remove(c.entries.Back())
+	}
+	c.metrics.SetBytes(c.bytes)
+}
+
+// invalidate removes the entries of the kinds, or every entry if there are none.
+func (c *Cache) invalidate(kinds ...kind) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for element := c.entries.Front(); element != nil; {
+		next := element.Next()
+		if len(kinds) == 0 || slices.Contains(kinds, element.Value.(*entry).key.kind) {
+			c.remove(element)
+		}
+		element = next
+	}
+	c.metrics.SetBytes(c.bytes)
+}
+
+// remove removes the element. The caller must hold mu.
+func (c *Cache) remove(element *list.Element) {
+	e := c.entries.Remove(element).(*entry)
+	delete(c.index, e.key)
+	c.bytes -= e.size
+}
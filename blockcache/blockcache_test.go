@@ -0,0 +1,56 @@
+package blockcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudget(t *testing.T) {
+	cache := New(100, NewNoopMetrics())
+
+	cache.add(0, key{kind: kindTx, id: "a"}, "a", 40)
+	cache.add(0, key{kind: kindTx, id: "b"}, "b", 40)
+	// Using a makes b the least recently used entry.
+	_, ok := cache.get(key{kind: kindTx, id: "a"})
+	require.True(t, ok)
+	cache.add(0, key{kind: kindTx, id: "c"}, "c", 40)
+	require.Equal(t, 82, cache.bytes)
+
+	_, ok = cache.get(key{kind: kindTx, id: "b"})
+	require.False(t, ok)
+	for _, id := range []string{"a", "c"} {
+		value, ok := cache.get(key{kind: kindTx, id: id})
+		require.True(t, ok)
+		require.Equal(t, id, value)
+	}
+
+	// Values larger than the budget aren't added.
+	cache.add(0, key{kind: kindTx, id: "d"}, "d", 100)
+	_, ok = cache.get(key{kind: kindTx, id: "d"})
+	require.False(t, ok)
+	require.Equal(t, 82, cache.bytes)
+}
+
+func TestInvalidate(t *testing.T) {
+	cache := New(1000, NewNoopMetrics())
+
+	generation := cache.currentGeneration()
+	cache.add(generation, key{kind: kindTx, id: "a"}, "a", 10)
+	cache.add(generation, key{kind: kindLabel, id: "a"}, "a", 10)
+	cache.invalidate(kindLabel)
+	_, ok := cache.get(key{kind: kindTx, id: "a"})
+	require.True(t, ok)
+	_, ok = cache.get(key{kind: kindLabel, id: "a"})
+	require.False(t, ok)
+
+	// Values read before an invalidation aren't added after it.
+	cache.add(generation, key{kind: kindLabel, id: "a"}, "a", 10)
+	_, ok = cache.get(key{kind: kindLabel, id: "a"})
+	require.False(t, ok)
+
+	cache.invalidate()
+	_, ok = cache.get(key{kind: kindTx, id: "a"})
+	require.False(t, ok)
+	require.Zero(t, cache.bytes)
+}
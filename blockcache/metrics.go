@@ -0,0 +1,75 @@
+package blockcache
+
+import (
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "block_cache"
+
+// Metrics contains metrics collected from the blockcache package.
+type Metrics interface {
+	RecordHit(kind string)
+	RecordMiss(kind string)
+	SetBytes(bytes int)
+}
+
+type metrics struct {
+	// Number of lookups served from the cache, by kind.
+	Hits *stdprometheus.CounterVec
+	// Number of lookups that were not in the cache, by kind.
+	Misses *stdprometheus.CounterVec
+	// Size of the cached entries in bytes.
+	Bytes stdprometheus.Gauge
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		Hits: promauto.NewCounterVec(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "hits",
+			Help:      "Number of lookups served from the cache, by kind",
+		}, []string{
+			"kind",
+		}),
+		Misses: promauto.NewCounterVec(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "misses",
+			Help:      "Number of lookups that were not in the cache, by kind",
+		}, []string{
+			"kind",
+		}),
+		Bytes: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "bytes",
+			Help:      "Size of the cached entries in bytes",
+		}),
+	}
+}
+
+func (m *metrics) RecordHit(kind string) {
+	m.Hits.WithLabelValues(kind).Inc()
+}
+
+func (m *metrics) RecordMiss(kind string) {
+	m.Misses.WithLabelValues(kind).Inc()
+}
+
+func (m *metrics) SetBytes(bytes int) {
+	m.Bytes.Set(float64(bytes))
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) RecordHit(string) {}
+
+func (*noopMetrics) RecordMiss(string) {}
+
+func (*noopMetrics) SetBytes(int) {}
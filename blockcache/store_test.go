@@ -0,0 +1,113 @@
+package blockcache_test
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer/app/peptide/txstore"
+	"github.com/polymerdao/monomer/blockcache"
+	"github.com/polymerdao/monomer/monomerdb"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+type countingMetrics struct {
+	hits   map[string]int
+	misses map[string]int
+	bytes  int
+}
+
+func newCountingMetrics() *countingMetrics {
+	return &countingMetrics{
+		hits:   make(map[string]int),
+		misses: make(map[string]int),
+	}
+}
+
+func (m *countingMetrics) RecordHit(kind string) {
+	m.hits[kind]++
+}
+
+func (m *countingMetrics) RecordMiss(kind string) {
+	m.misses[kind]++
+}
+
+func (m *countingMetrics) SetBytes(bytes int) {
+	m.bytes = bytes
+}
+
+func TestBlockStore(t *testing.T) {
+	metrics := newCountingMetrics()
+	db := testutils.NewLocalMemDB(t)
+	store := blockcache.NewBlockStore(db, blockcache.New(1<<20, metrics))
+
+	genesis := testutils.GenerateBlockWithParentAndTxs(t, nil)
+	require.NoError(t, store.AppendBlock(genesis))
+	block := testutils.GenerateBlockWithParentAndTxs(t, genesis.Header)
+	require.NoError(t, store.AppendBlock(block))
+	require.NoError(t, store.UpdateLabels(block.Header.Hash, genesis.Header.Hash, genesis.Header.Hash))
+
+	for range 2 {
+		head, err := store.HeadBlock()
+		require.NoError(t, err)
+		require.Equal(t, block, head)
+		got, err := store.BlockByHeight(block.Header.Height)
+		require.NoError(t, err)
+		require.Equal(t, block, got)
+	}
+	require.Equal(t, 1, metrics.misses["head"])
+	require.Equal(t, 1, metrics.hits["head"])
+	require.Equal(t, 1, metrics.hits["height"])
+
+	// The new head is read after it is appended.
+	next := testutils.GenerateBlockWithParentAndTxs(t, block.Header)
+	require.NoError(t, store.AppendBlock(next))
+	head, err := store.HeadHeader()
+	require.NoError(t, err)
+	require.Equal(t, next.Header, head)
+
+	// Rolled back blocks aren't read from the cache, and their heights are reused.
+	require.NoError(t, store.Rollback(genesis.Header.Hash, genesis.Header.Hash, genesis.Header.Hash))
+	_, err = store.BlockByHeight(block.Header.Height)
+	require.ErrorIs(t, err, monomerdb.ErrNotFound)
+	head, err = store.HeadHeader()
+	require.NoError(t, err)
+	require.Equal(t, genesis.Header, head)
+
+	reorged := testutils.GenerateBlockWithParentAndTxs(t, genesis.Header, bfttypes.Tx("reorged"))
+	require.NoError(t, store.AppendBlock(reorged))
+	got, err := store.BlockByHeight(reorged.Header.Height)
+	require.NoError(t, err)
+	require.Equal(t, reorged, got)
+}
+
+func TestTxStore(t *testing.T) {
+	metrics := newCountingMetrics()
+	store := blockcache.NewTxStore(txstore.NewTxStore(dbm.NewMemDB()), blockcache.New(1<<20, metrics))
+
+	tx := bfttypes.Tx("tx")
+	result := &abcitypes.TxResult{
+		Height: 2,
+		Tx:     tx,
+	}
+
+	// Results that aren't found aren't cached.
+	got, err := store.Get(tx.Hash())
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	require.NoError(t, store.Add([]*abcitypes.TxResult{result}))
+	for range 2 {
+		got, err = store.Get(tx.Hash())
+		require.NoError(t, err)
+		require.Equal(t, result.Tx, got.Tx)
+	}
+	require.Equal(t, 1, metrics.hits["tx"])
+
+	require.NoError(t, store.RollbackToHeight(1, 2))
+	got, err = store.Get(tx.Hash())
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
@@ -0,0 +1,70 @@
+package blockcache
+
+import (
+	"context"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer/app/peptide/txstore"
+)
+
+// TxStore caches the tx results, which receipts are made from, read from a txstore.TxStore. All writes must go through
+// it, so it can drop the entries they change.
+type TxStore struct {
+	txStore txstore.TxStore
+	cache   *Cache
+}
+
+var _ txstore.TxStore = (*TxStore)(nil)
+
+func NewTxStore(txStore txstore.TxStore, cache *Cache) *TxStore {
+	return &TxStore{
+		txStore: txStore,
+		cache:   cache,
+	}
+}
+
+// Get returns the tx result with the hash. Results that aren't found aren't cached, since the tx may be added later.
+func (s *TxStore) Get(hash []byte) (*abcitypes.TxResult, error) {
+	k := key{kind: kindTx, id: string(hash)}
+	if result, ok := s.cache.get(k); ok {
+		return result.(*abcitypes.TxResult), nil
+	}
+	generation := s.cache.currentGeneration()
+	result, err := s.txStore.Get(hash)
+	if err != nil || result == nil {
+		return result, err
+	}
+	s.cache.add(generation, k, result, result.Size())
+	return result, nil
+}
+
+func (s *TxStore) Search(ctx context.Context, q *cmtquery.Query) ([]*abcitypes.TxResult, error) {
+	return s.txStore.Search(ctx, q)
+}
+
+func (s *TxStore) Add(txs []*abcitypes.TxResult) error {
+	return s.txStore.Add(txs)
+}
+
+func (s *TxStore) AddEthTxHashes(hashes map[common.Hash]common.Hash) error {
+	return s.txStore.AddEthTxHashes(hashes)
+}
+
+func (s *TxStore) AddLogsBloom(height uint64, bloom ethtypes.Bloom) error {
+	return s.txStore.AddLogsBloom(height, bloom)
+}
+
+func (s *TxStore) LogsBloom(height uint64) (ethtypes.Bloom, bool, error) {
+	return s.txStore.LogsBloom(height)
+}
+
+func (s *TxStore) RollbackToHeight(rollbackHeight, currentHeight uint64) error {
+	if err := s.txStore.RollbackToHeight(rollbackHeight, currentHeight); err != nil {
+		return err
+	}
+	s.cache.invalidate(kindTx)
+	return nil
+}
@@ -1,24 +1,33 @@
 package builder
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"slices"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	bfttypes "github.com/cometbft/cometbft/types"
-	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/polymerdao/monomer"
 	"github.com/polymerdao/monomer/app/peptide/txstore"
 	"github.com/polymerdao/monomer/bindings"
+	"github.com/polymerdao/monomer/crash"
+	"github.com/polymerdao/monomer/ethlog"
 	"github.com/polymerdao/monomer/evm"
 	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/surgery"
+	"github.com/polymerdao/monomer/witness"
 	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
 )
 
@@ -28,7 +37,10 @@ type DB interface {
 	Rollback(unsafe, safe, finalized common.Hash) error
 	HeaderByHeight(height uint64) (*monomer.Header, error)
 	HeadHeader() (*monomer.Header, error)
+	BlockByHeight(height uint64) (*monomer.Block, error)
+	BlockByLabel(label eth.BlockLabel) (*monomer.Block, error)
 	AppendBlock(*monomer.Block) error
+	UpdateLabels(unsafe, safe, finalized common.Hash) error
 }
 
 type Builder struct {
@@ -39,8 +51,27 @@ type Builder struct {
 	eventBus   *bfttypes.EventBus
 	chainID    monomer.ChainID
 	ethstatedb state.Database
+	wal        *WAL
+	// interceptors are called in order.
+	interceptors []Interceptor
+	crash        *crash.Handler
+	witnesses    *witness.Store
+	freezeHeight uint64
+	statePatch   *statePatch
+	metrics      Metrics
+	// uncommitted is set when the app finalized a block it didn't commit, e.g., because Commit failed.
+	uncommitted bool
 }
 
+type statePatch struct {
+	patch   *surgery.Patch
+	state   surgery.State
+	applied func(context.Context, *monomer.Block) error
+}
+
+// ErrFrozen is returned by Build when the chain is frozen.
+var ErrFrozen = errors.New("chain is frozen")
+
 func New(
 	mpool *mempool.Pool,
 	app monomer.Application,
@@ -49,15 +80,53 @@ func New(
 	eventBus *bfttypes.EventBus,
 	chainID monomer.ChainID,
 	ethstatedb state.Database,
+	wal *WAL,
+	interceptors ...Interceptor,
 ) *Builder {
 	return &Builder{
-		mempool:    mpool,
-		app:        app,
-		blockStore: blockStore,
-		txStore:    txStore,
-		eventBus:   eventBus,
-		chainID:    chainID,
-		ethstatedb: ethstatedb,
+		mempool:      mpool,
+		app:          app,
+		blockStore:   blockStore,
+		txStore:      txStore,
+		eventBus:     eventBus,
+		chainID:      chainID,
+		ethstatedb:   ethstatedb,
+		wal:          wal,
+		interceptors: interceptors,
+		metrics:      NewNoopMetrics(),
+	}
+}
+
+// SetMetrics records the builder's metrics in m. By default, they aren't recorded.
+func (b *Builder) SetMetrics(m Metrics) {
+	b.metrics = m
+}
+
+// SetWitnessStore records the witness of every block the builder builds in s. By default, no witnesses are recorded.
+func (b *Builder) SetWitnessStore(s *witness.Store) {
+	b.witnesses = s
+}
+
+// SetCrashHandler makes Build and Rollback recover panics as crashes of the builder, which write a crash dump and fail
+// the node. By default, panics aren't recovered.
+func (b *Builder) SetCrashHandler(h *crash.Handler) {
+	b.crash = h
+}
+
+// SetFreezeHeight makes Build refuse to build blocks above height, so the chain halts there while the rest of the node
+// keeps serving it, e.g., until a state patch is ready. Zero, the default, doesn't freeze the chain.
+func (b *Builder) SetFreezeHeight(height uint64) {
+	b.freezeHeight = height
+}
+
+// SetStatePatch applies the patch to state before executing the txs of the block after the patch's height, including
+// when that block is rebuilt after a rollback. applied is called whenever a block with the patch is stored. Build fails
+// if the patch doesn't apply to the state at its height.
+func (b *Builder) SetStatePatch(patch *surgery.Patch, state surgery.State, applied func(context.Context, *monomer.Block) error) {
+	b.statePatch = &statePatch{
+		patch:   patch,
+		state:   state,
+		applied: applied,
 	}
 }
 
@@ -66,7 +135,9 @@ func New(
 // assumptions:
 //   - all hashes exist in the block store.
 //   - finalized.Height <= safe.Height <= head.Height
-func (b *Builder) Rollback(ctx context.Context, unsafe, safe, finalized common.Hash) error {
+func (b *Builder) Rollback(ctx context.Context, unsafe, safe, finalized common.Hash) (err error) {
+	defer b.crash.Recover(crash.SubsystemBuilder, &err)
+
 	currentHeight, err := b.blockStore.Height()
 	if err != nil {
 		return fmt.Errorf("get height: %v", err)
@@ -90,6 +161,73 @@ func (b *Builder) Rollback(ctx context.Context, unsafe, safe, finalized common.H
 		return fmt.Errorf("rollback app: %v", err)
 	}
 
+	if b.witnesses != nil {
+		if err := b.witnesses.RollbackToHeight(targetHeight); err != nil {
+			return fmt.Errorf("rollback witness store: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// Reorg rolls back like Rollback and adds the user txs of the rolled back blocks back to the mempool, in the order they
+// were included, so the blocks built on the new head include them again. It is used when op-node reorgs the unsafe chain,
+// e.g., after an L1 reorg removed the batches or L1 origins of its blocks. The deposit txs aren't added back, since
+// op-node derives them from L1 again. User txs that can't be added back, e.g., because the mempool is full, are rejected
+// with mempool.CodeReorged.
+func (b *Builder) Reorg(ctx context.Context, unsafe, safe, finalized common.Hash) (err error) {
+	defer b.crash.Recover(crash.SubsystemBuilder, &err)
+
+	currentHeight, err := b.blockStore.Height()
+	if err != nil {
+		return fmt.Errorf("get height: %v", err)
+	}
+	unsafeHeader, err := b.blockStore.HeaderByHash(unsafe)
+	if err != nil {
+		return fmt.Errorf("get unsafe header: %v", err)
+	}
+	var userTxs bfttypes.Txs
+	for height := unsafeHeader.Height + 1; height <= currentHeight; height++ {
+		block, err := b.blockStore.BlockByHeight(height)
+		if err != nil {
+			return fmt.Errorf("get block at height %d: %v", height, err)
+		}
+		for _, tx := range block.Txs {
+			if _, err := monomer.GetDepositTxs([][]byte{tx}); err != nil {
+				userTxs = append(userTxs, tx)
+			}
+		}
+	}
+
+	if err := b.Rollback(ctx, unsafe, safe, finalized); err != nil {
+		return err
+	}
+
+	// The blocks are already rolled back, so a tx that can't be added back is rejected rather than failing the reorg and
+	// dropping the txs after it.
+	for _, tx := range userTxs {
+		if err := b.mempool.Enqueue(tx); err != nil && !errors.Is(err, mempool.ErrAlreadyKnown) {
+			if err := b.mempool.Reject(&mempool.Rejection{
+				Tx:        tx,
+				Reason:    mempool.ReasonEvicted,
+				Code:      mempool.CodeReorged,
+				Codespace: mempool.Codespace,
+				Log:       fmt.Sprintf("add back to the mempool after a reorg: %v", err),
+			}); err != nil {
+				return fmt.Errorf("record rejection of rolled back tx %X: %v", tx.Hash(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// RecordReorg counts a reorg of the unsafe chain in the builder's totals and metrics. Rollback and Reorg don't count
+// reorgs themselves, since they also undo blocks that failed to build or didn't match their payload.
+func (b *Builder) RecordReorg() error {
+	if err := b.wal.recordReorg(); err != nil {
+		return fmt.Errorf("record reorg in wal: %v", err)
+	}
+	b.metrics.RecordReorg()
 	return nil
 }
 
@@ -101,11 +239,40 @@ type Payload struct {
 	GasLimit  uint64
 	Timestamp uint64
 	NoTxPool  bool
+	// ParentBeaconRoot is committed to in the block header. It is nil before Ecotone.
+	ParentBeaconRoot *common.Hash
 }
 
-func (b *Builder) Build(ctx context.Context, payload *Payload) (*monomer.Block, error) {
-	txs := slices.Clone(payload.InjectedTransactions) // Shallow clone is ok, we just don't want to modify the slice itself.
+func (b *Builder) Build(ctx context.Context, payload *Payload) (_ *monomer.Block, err error) {
+	defer b.crash.Recover(crash.SubsystemBuilder, &err)
+
+	currentHeader, err := b.blockStore.HeadHeader()
+	if err != nil {
+		return nil, fmt.Errorf("header by height: %v", err)
+	}
+	if b.freezeHeight != 0 && currentHeader.Height >= b.freezeHeight {
+		return nil, fmt.Errorf("%w at height %d", ErrFrozen, b.freezeHeight)
+	}
+
+	batches := []*mempool.Batch{{
+		Txs: slices.Clone(payload.InjectedTransactions), // Shallow clone is ok, we just don't want to modify the slice itself.
+	}}
 	if !payload.NoTxPool {
+		// Intercept before dequeuing so an error doesn't lose mempool txs.
+		for _, interceptor := range b.interceptors {
+			batch, err := intercept(ctx, interceptor, currentHeader.Height+1)
+			if err != nil {
+				return nil, fmt.Errorf("intercept: %v", err)
+			}
+			if batch != nil && len(batch.Txs) > 0 {
+				batches = append(batches, batch)
+			}
+		}
+		depth, err := b.mempool.Len()
+		if err != nil {
+			return nil, fmt.Errorf("get mempool length: %v", err)
+		}
+		b.metrics.SetMempoolDepth(depth)
 		for {
 			// TODO there is risk of losing txs if mempool db fails.
 			// we need to fix db consistency in general, so we're just panicing on errors for now.
@@ -117,68 +284,120 @@ func (b *Builder) Build(ctx context.Context, payload *Payload) (*monomer.Block,
 				break
 			}
 
-			tx, err := b.mempool.Dequeue()
+			batch, err := b.mempool.DequeueBatch()
 			if err != nil {
 				panic(fmt.Errorf("dequeue: %v", err))
 			}
-			txs = append(txs, tx)
+			batches = append(batches, batch)
 		}
 	}
 
-	// Build header.
-	currentHeader, err := b.blockStore.HeadHeader()
+	return b.build(ctx, currentHeader, &walPayload{
+		Height:           currentHeader.Height + 1,
+		ParentHash:       currentHeader.Hash,
+		Timestamp:        payload.Timestamp,
+		GasLimit:         payload.GasLimit,
+		ParentBeaconRoot: payload.ParentBeaconRoot,
+		Batches:          batches,
+	})
+}
+
+func intercept(ctx context.Context, interceptor Interceptor, height uint64) (*mempool.Batch, error) {
+	if batchInterceptor, ok := interceptor.(BatchInterceptor); ok {
+		return batchInterceptor.InterceptBatch(ctx, height)
+	}
+	txs, err := interceptor.Intercept(ctx, height)
 	if err != nil {
-		return nil, fmt.Errorf("header by height: %v", err)
+		return nil, err
 	}
-	header := &monomer.Header{
-		ChainID:    b.chainID,
-		Height:     currentHeader.Height + 1,
-		Time:       payload.Timestamp,
-		ParentHash: currentHeader.Hash,
-		GasLimit:   payload.GasLimit,
+	return &mempool.Batch{
+		Txs: txs,
+	}, nil
+}
+
+// build builds a block on top of currentHeader, logging the payload in the WAL until the block is stored.
+func (b *Builder) build(ctx context.Context, currentHeader *monomer.Header, payload *walPayload) (*monomer.Block, error) {
+	start := time.Now()
+	if err := b.wal.write(payload); err != nil {
+		return nil, fmt.Errorf("write payload to wal: %v", err)
 	}
+	batches := payload.Batches
 
-	cometHeader := header.ToComet()
+	// Build header.
 	info, err := b.app.Info(ctx, &abcitypes.RequestInfo{})
 	if err != nil {
 		return nil, fmt.Errorf("info: %v", err)
 	}
-	cometHeader.AppHash = info.GetLastBlockAppHash() // TODO maybe best to get this from the ethstatedb?
-	resp, err := b.app.FinalizeBlock(ctx, &abcitypes.RequestFinalizeBlock{
-		Txs:                txs.ToSliceOfBytes(),
-		Hash:               cometHeader.Hash(),
-		Height:             cometHeader.Height,
-		Time:               cometHeader.Time,
-		NextValidatorsHash: cometHeader.NextValidatorsHash,
-		ProposerAddress:    cometHeader.ProposerAddress,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("finalize block: %v", err)
-	}
-	_, err = b.app.Commit(ctx, &abcitypes.RequestCommit{})
-	if err != nil {
-		return nil, fmt.Errorf("commit: %v", err)
+	header := &monomer.Header{
+		ChainID:          b.chainID,
+		Height:           payload.Height,
+		Time:             payload.Timestamp,
+		ParentHash:       payload.ParentHash,
+		AppHash:          info.GetLastBlockAppHash(),
+		GasLimit:         payload.GasLimit,
+		ParentBeaconRoot: payload.ParentBeaconRoot,
+	}
+
+	patched := b.statePatch != nil && header.Height == b.statePatch.patch.Height+1
+	var txs bfttypes.Txs
+	var resp *abcitypes.ResponseFinalizeBlock
+	for {
+		// The patch is applied again after a failed atomic batch, since the rollback discards it.
+		if patched {
+			if err := surgery.Apply(b.statePatch.state, b.statePatch.patch, header.AppHash); err != nil {
+				return nil, fmt.Errorf("apply state patch: %v", err)
+			}
+		}
+		txs = flattenBatches(batches)
+		resp, err = b.finalizeAndCommit(ctx, header, txs)
+		if err != nil {
+			return nil, err
+		}
+		var rejections []*mempool.Rejection
+		batches, rejections = dropFailedAtomicBatches(batches, resp.GetTxResults())
+		if len(rejections) == 0 {
+			break
+		}
+		for _, rejection := range rejections {
+			if err := b.mempool.Reject(rejection); err != nil {
+				return nil, fmt.Errorf("record rejection: %v", err)
+			}
+		}
+		// Atomic batches are all-or-nothing, so roll back the block and build it again without the batches that failed.
+		// Every iteration drops at least one batch, so this terminates.
+		if err := b.app.RollbackToHeight(ctx, currentHeader.Height); err != nil {
+			return nil, fmt.Errorf("rollback app after failed atomic batch: %v", err)
+		}
 	}
 
 	ethState, err := state.New(currentHeader.StateRoot, b.ethstatedb, nil)
 	if err != nil {
 		return nil, fmt.Errorf("create ethereum state: %v", err)
 	}
+	var evmState vm.StateDB = ethState
+	var recorder *witness.Recorder
+	if b.witnesses != nil {
+		recorder = witness.NewRecorder(ethState)
+		evmState = recorder
+	}
 	// Store the updated cosmos app hash in the monomer EVM state db.
-	if err = b.storeAppHashInEVM(resp.AppHash, ethState, header); err != nil {
+	if err = b.storeAppHashInEVM(resp.AppHash, evmState, header); err != nil {
 		return nil, fmt.Errorf("store app hash in EVM: %v", err)
 	}
 
 	execTxResults := resp.GetTxResults()
 	txResults := make([]*abcitypes.TxResult, 0, len(execTxResults))
+	var withdrawals int
 	for i, execTxResult := range execTxResults {
 		tx := txs[i]
 
-		// Check for withdrawal messages in the tx.
-		execTxResult, err = b.parseWithdrawalMessages(tx, execTxResult, ethState, header)
+		// Register the withdrawals initiated by the tx.
+		var n int
+		execTxResult, n, err = b.parseWithdrawals(ctx, execTxResult, evmState, header)
 		if err != nil {
-			return nil, fmt.Errorf("parse withdrawal messages: %v", err)
+			return nil, fmt.Errorf("parse withdrawals: %v", err)
 		}
+		withdrawals += n
 
 		txResults = append(txResults, &abcitypes.TxResult{
 			Height: int64(header.Height),
@@ -202,40 +421,157 @@ func (b *Builder) Build(ctx context.Context, payload *Payload) (*monomer.Block,
 	}
 
 	// Append block.
+	commitStart := time.Now()
 	if err := b.blockStore.AppendBlock(block); err != nil {
 		return nil, fmt.Errorf("append block: %v", err)
 	}
+	b.metrics.RecordCommit(CommitStoreBlock, commitStart)
+
+	if b.witnesses != nil {
+		w, err := witness.New(b.ethstatedb, currentHeader, block, recorder)
+		if err != nil {
+			return nil, fmt.Errorf("new witness: %v", err)
+		}
+		if err := b.witnesses.Put(w); err != nil {
+			return nil, fmt.Errorf("put witness: %v", err)
+		}
+	}
 
 	// Index txs.
+	commitStart = time.Now()
 	if err := b.txStore.Add(txResults); err != nil {
 		return nil, fmt.Errorf("add tx results: %v", err)
 	}
+	ethTxHashes, err := block.EthTxHashes()
+	if err != nil {
+		return nil, fmt.Errorf("get eth tx hashes: %v", err)
+	}
+	if err := b.txStore.AddEthTxHashes(ethTxHashes); err != nil {
+		return nil, fmt.Errorf("add eth tx hashes: %v", err)
+	}
+	logsBloom, err := ethlog.Bloom(txResults)
+	if err != nil {
+		return nil, fmt.Errorf("logs bloom: %v", err)
+	}
+	if err := b.txStore.AddLogsBloom(header.Height, logsBloom); err != nil {
+		return nil, fmt.Errorf("add logs bloom: %v", err)
+	}
+	b.metrics.RecordCommit(CommitStoreTx, commitStart)
 
 	// Publish events.
 	if err := b.publishEvents(txResults, block, resp); err != nil {
 		return nil, fmt.Errorf("publish events: %v", err)
 	}
 
+	for _, interceptor := range b.interceptors {
+		if err := interceptor.OnBlock(ctx, block); err != nil {
+			return nil, fmt.Errorf("notify interceptor: %v", err)
+		}
+	}
+
+	if err := b.wal.clear(len(block.Txs)); err != nil {
+		return nil, fmt.Errorf("clear wal: %v", err)
+	}
+	if patched && b.statePatch.applied != nil {
+		if err := b.statePatch.applied(ctx, block); err != nil {
+			return nil, fmt.Errorf("state patch applied: %v", err)
+		}
+	}
+	b.recordBlock(block, withdrawals, start)
 	return block, nil
 }
 
-func (b *Builder) publishEvents(txResults []*abcitypes.TxResult, block *monomer.Block, resp *abcitypes.ResponseFinalizeBlock) error {
-	for _, txResult := range txResults {
-		if err := b.eventBus.PublishEventTx(bfttypes.EventDataTx{
-			TxResult: *txResult,
-		}); err != nil {
-			return fmt.Errorf("publish tx event: %v", err)
+// recordBlock records the metrics of a block that was built and stored.
+func (b *Builder) recordBlock(block *monomer.Block, withdrawals int, start time.Time) {
+	b.metrics.RecordBuild(start)
+	var size int
+	for _, tx := range block.Txs {
+		size += len(tx)
+	}
+	b.metrics.RecordBlock(len(block.Txs), size)
+	if len(block.Txs) > 0 {
+		// The first deposit is the L1 attributes tx.
+		if depositTxs, err := monomer.GetDepositTxs(block.Txs.ToSliceOfBytes()); err == nil {
+			b.metrics.RecordDeposits(len(depositTxs) - 1)
 		}
 	}
+	b.metrics.RecordWithdrawals(withdrawals)
+}
 
-	if err := b.eventBus.PublishEventNewBlockEvents(bfttypes.EventDataNewBlockEvents{
-		Height: int64(block.Header.Height),
-		Events: resp.Events,
-		NumTxs: int64(block.Txs.Len()),
-	}); err != nil {
-		return fmt.Errorf("publish new block events event: %v", err)
+// finalizeAndCommit executes txs in a block with the given header and commits the app state.
+func (b *Builder) finalizeAndCommit(
+	ctx context.Context,
+	header *monomer.Header,
+	txs bfttypes.Txs,
+) (*abcitypes.ResponseFinalizeBlock, error) {
+	cometHeader := header.ToComet()
+	resp, err := b.app.FinalizeBlock(ctx, &abcitypes.RequestFinalizeBlock{
+		Txs:                txs.ToSliceOfBytes(),
+		Hash:               cometHeader.Hash(),
+		Height:             cometHeader.Height,
+		Time:               cometHeader.Time,
+		NextValidatorsHash: cometHeader.NextValidatorsHash,
+		ProposerAddress:    cometHeader.ProposerAddress,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("finalize block: %v", err)
 	}
+	b.uncommitted = true
+	start := time.Now()
+	_, err = b.app.Commit(ctx, &abcitypes.RequestCommit{})
+	if err != nil {
+		return nil, fmt.Errorf("commit: %v", err)
+	}
+	b.uncommitted = false
+	b.metrics.RecordCommit(CommitStoreApp, start)
+	return resp, nil
+}
 
+func flattenBatches(batches []*mempool.Batch) bfttypes.Txs {
+	// Blocks without txs still need a non-nil slice.
+	txs := bfttypes.Txs{}
+	for _, batch := range batches {
+		txs = append(txs, batch.Txs...)
+	}
+	return txs
+}
+
+// dropFailedAtomicBatches returns the batches without the atomic batches that contain a failed tx, and a rejection for
+// every tx that was dropped. txResults are the results of the flattened batches.
+func dropFailedAtomicBatches(
+	batches []*mempool.Batch,
+	txResults []*abcitypes.ExecTxResult,
+) ([]*mempool.Batch, []*mempool.Rejection) {
+	kept := make([]*mempool.Batch, 0, len(batches))
+	var rejections []*mempool.Rejection
+	var start int
+	for _, batch := range batches {
+		end := start + len(batch.Txs)
+		batchResults := txResults[start:end]
+		start = end
+		failed := slices.IndexFunc(batchResults, func(result *abcitypes.ExecTxResult) bool {
+			return !result.IsOK()
+		})
+		if !batch.Atomic || failed == -1 {
+			kept = append(kept, batch)
+			continue
+		}
+		for _, tx := range batch.Txs {
+			rejections = append(rejections, &mempool.Rejection{
+				Tx:        tx,
+				Reason:    mempool.ReasonEvicted,
+				Code:      batchResults[failed].GetCode(),
+				Codespace: batchResults[failed].GetCodespace(),
+				Log:       fmt.Sprintf("dropped with its atomic batch because tx %d failed: %s", failed, batchResults[failed].GetLog()),
+			})
+		}
+	}
+	return kept, rejections
+}
+
+// publishEvents publishes the block's events in the order CometBFT fires them: NewBlock, NewBlockHeader,
+// NewBlockEvents, and then a Tx event per tx, so websocket clients such as relayers see the block before its txs.
+func (b *Builder) publishEvents(txResults []*abcitypes.TxResult, block *monomer.Block, resp *abcitypes.ResponseFinalizeBlock) error {
 	if err := b.eventBus.PublishEventNewBlock(bfttypes.EventDataNewBlock{
 		Block:               block.ToCometLikeBlock(),
 		ResultFinalizeBlock: *resp,
@@ -252,11 +588,27 @@ func (b *Builder) publishEvents(txResults []*abcitypes.TxResult, block *monomer.
 		return fmt.Errorf("publish new block header event: %v", err)
 	}
 
+	if err := b.eventBus.PublishEventNewBlockEvents(bfttypes.EventDataNewBlockEvents{
+		Height: int64(block.Header.Height),
+		Events: resp.Events,
+		NumTxs: int64(block.Txs.Len()),
+	}); err != nil {
+		return fmt.Errorf("publish new block events event: %v", err)
+	}
+
+	for _, txResult := range txResults {
+		if err := b.eventBus.PublishEventTx(bfttypes.EventDataTx{
+			TxResult: *txResult,
+		}); err != nil {
+			return fmt.Errorf("publish tx event: %v", err)
+		}
+	}
+
 	return nil
 }
 
 // storeAppHashInEVM stores the updated cosmos app hash in the monomer EVM state db. This is used for proving withdrawals.
-func (b *Builder) storeAppHashInEVM(appHash []byte, ethState *state.StateDB, header *monomer.Header) error {
+func (b *Builder) storeAppHashInEVM(appHash []byte, ethState vm.StateDB, header *monomer.Header) error {
 	monomerEVM, err := evm.NewEVM(ethState, header)
 	if err != nil {
 		return fmt.Errorf("new EVM: %v", err)
@@ -273,56 +625,148 @@ func (b *Builder) storeAppHashInEVM(appHash []byte, ethState *state.StateDB, hea
 	return nil
 }
 
-// parseWithdrawalMessages checks for withdrawal messages if the tx was successful. If a withdrawal message is found, the
-// message nonce is appended to the withdrawal message event attributes and the updated execTxResult is returned.
-func (b *Builder) parseWithdrawalMessages(
-	tx bfttypes.Tx,
+// parseWithdrawals registers the withdrawals initiated by a successful tx in the L2ToL1MessagePasser. Withdrawals are
+// found through their withdrawal_initiated events rather than the tx's messages, so withdrawals initiated by modules or
+// contracts on behalf of an account are registered as well. Any module can emit an event of that type, so a withdrawal is
+// only registered if the x/rollup module committed to it with the message passer's next nonce, which it only does after
+// burning the withdrawn funds. The message nonce is appended to each withdrawal's event attributes and the updated
+// execTxResult is returned with the number of withdrawals.
+func (b *Builder) parseWithdrawals(
+	ctx context.Context,
 	execTxResult *abcitypes.ExecTxResult,
-	ethState *state.StateDB,
+	ethState vm.StateDB,
 	header *monomer.Header,
-) (*abcitypes.ExecTxResult, error) {
-	if execTxResult.IsOK() {
-		cosmosTx := new(sdktx.Tx)
-		if err := cosmosTx.Unmarshal(tx); err != nil {
-			// TODO we should check for withdrawal messages on all transaction types.
-			// Unfortunately, we can't get the app's TxDecoder inside the builder.
-			// We may want to explore using a PostHandler to manage withdrawals instead.
-			return execTxResult, nil
+) (*abcitypes.ExecTxResult, int, error) {
+	if !execTxResult.IsOK() {
+		return execTxResult, 0, nil
+	}
+	var withdrawals int
+	for i := range execTxResult.Events {
+		event := &execTxResult.Events[i] // Get a pointer to the event, so we can modify it.
+		if event.Type != rolluptypes.EventTypeWithdrawalInitiated {
+			continue
 		}
-		for _, msg := range cosmosTx.GetBody().GetMessages() {
-			withdrawalMsg := new(rolluptypes.MsgInitiateWithdrawal)
-			if msg.TypeUrl == cdctypes.MsgTypeURL(withdrawalMsg) {
-				if err := withdrawalMsg.Unmarshal(msg.GetValue()); err != nil {
-					return nil, fmt.Errorf("unmarshal MsgInitiateWithdrawal: %v", err)
-				}
-
-				// Store the withdrawal message hash in the monomer EVM state db.
-				nonce, err := b.storeWithdrawalMsgInEVM(withdrawalMsg, ethState, header)
-				if err != nil {
-					return nil, fmt.Errorf("store withdrawal msg in EVM: %v", err)
-				}
-
-				// Populate the nonce in the tx event attributes.
-				for i := range execTxResult.Events {
-					event := &execTxResult.Events[i] // Get a pointer to the event, so we can modify it.
-					if event.Type == rolluptypes.EventTypeWithdrawalInitiated {
-						event.Attributes = append(event.Attributes, abcitypes.EventAttribute{
-							Key:   rolluptypes.AttributeKeyNonce,
-							Value: hexutil.Encode(nonce.Bytes()),
-						})
-					}
-				}
+		withdrawalMsg, withdrawalHash, err := withdrawalMsgFromEvent(event)
+		if err != nil {
+			return nil, 0, fmt.Errorf("parse %s event: %v", rolluptypes.EventTypeWithdrawalInitiated, err)
+		}
+		if committed, err := b.withdrawalCommitted(ctx, withdrawalMsg, withdrawalHash, ethState, header); err != nil {
+			return nil, 0, fmt.Errorf("check withdrawal commitment: %v", err)
+		} else if !committed {
+			continue
+		}
+
+		// Store the withdrawal message hash in the monomer EVM state db.
+		nonce, err := b.storeWithdrawalMsgInEVM(withdrawalMsg, ethState, header)
+		if err != nil {
+			return nil, 0, fmt.Errorf("store withdrawal msg in EVM: %v", err)
+		}
+		withdrawals++
+
+		// Populate the nonce in the tx event attributes.
+		event.Attributes = append(event.Attributes, abcitypes.EventAttribute{
+			Key:   rolluptypes.AttributeKeyNonce,
+			Value: hexutil.Encode(nonce.Bytes()),
+		})
+	}
+	return execTxResult, withdrawals, nil
+}
+
+// withdrawalCommitted reports whether withdrawalHash is the hash of the withdrawal with the L2ToL1MessagePasser's next
+// nonce and the x/rollup module's store commits to it.
+func (b *Builder) withdrawalCommitted(
+	ctx context.Context,
+	withdrawalMsg *rolluptypes.MsgInitiateWithdrawal,
+	withdrawalHash common.Hash,
+	ethState vm.StateDB,
+	header *monomer.Header,
+) (bool, error) {
+	monomerEVM, err := evm.NewEVM(ethState, header)
+	if err != nil {
+		return false, fmt.Errorf("new EVM: %v", err)
+	}
+	executer, err := bindings.NewL2ToL1MessagePasserExecuter(monomerEVM)
+	if err != nil {
+		return false, fmt.Errorf("new L2ToL1MessagePasserExecuter: %v", err)
+	}
+	messageNonce, err := executer.GetMessageNonce()
+	if err != nil {
+		return false, fmt.Errorf("get message nonce: %v", err)
+	}
+	senderCosmosAddress, err := sdk.AccAddressFromBech32(withdrawalMsg.GetSender())
+	if err != nil {
+		return false, fmt.Errorf("convert sender to cosmos address: %v", err)
+	}
+	senderAddr := common.BytesToAddress(senderCosmosAddress.Bytes())
+	targetAddr := common.HexToAddress(withdrawalMsg.GetTarget())
+	wantHash, err := crossdomain.NewWithdrawal(
+		messageNonce,
+		&senderAddr,
+		&targetAddr,
+		withdrawalMsg.Value.BigInt(),
+		new(big.Int).SetBytes(withdrawalMsg.GasLimit),
+		withdrawalMsg.GetData(),
+	).Hash()
+	if err != nil {
+		return false, fmt.Errorf("hash withdrawal: %v", err)
+	} else if wantHash != withdrawalHash {
+		return false, nil
+	}
+
+	// The app committed the block's state, so the commitment can be read from the latest height.
+	resp, err := b.app.Query(ctx, &abcitypes.RequestQuery{
+		Path: "/store/" + rolluptypes.StoreKey + "/key",
+		Data: rolluptypes.WithdrawalCommitmentKey(withdrawalHash),
+	})
+	if err != nil {
+		return false, fmt.Errorf("query withdrawal commitment: %v", err)
+	} else if !resp.IsOK() {
+		return false, fmt.Errorf("query withdrawal commitment: %s", resp.GetLog())
+	}
+	return bytes.Equal(resp.GetValue(), rolluptypes.WithdrawalCommitmentValue), nil
+}
+
+// withdrawalMsgFromEvent reconstructs the withdrawal message and its hash from the attributes of a withdrawal_initiated
+// event.
+func withdrawalMsgFromEvent(event *abcitypes.Event) (*rolluptypes.MsgInitiateWithdrawal, common.Hash, error) {
+	withdrawalMsg := new(rolluptypes.MsgInitiateWithdrawal)
+	var withdrawalHash []byte
+	for _, attr := range event.Attributes {
+		var err error
+		switch attr.Key {
+		case rolluptypes.AttributeKeySender:
+			withdrawalMsg.Sender = attr.Value
+		case rolluptypes.AttributeKeyL1Target:
+			withdrawalMsg.Target = attr.Value
+		case rolluptypes.AttributeKeyValue:
+			var value []byte
+			if value, err = hexutil.Decode(attr.Value); err == nil {
+				withdrawalMsg.Value = sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(value))
 			}
+		case rolluptypes.AttributeKeyGasLimit:
+			withdrawalMsg.GasLimit, err = hexutil.Decode(attr.Value)
+		case rolluptypes.AttributeKeyData:
+			withdrawalMsg.Data, err = hexutil.Decode(attr.Value)
+		case rolluptypes.AttributeKeyWithdrawalHash:
+			withdrawalHash, err = hexutil.Decode(attr.Value)
 		}
+		if err != nil {
+			return nil, common.Hash{}, fmt.Errorf("decode %s attribute: %v", attr.Key, err)
+		}
+	}
+	if withdrawalMsg.Value.IsNil() {
+		return nil, common.Hash{}, fmt.Errorf("missing %s attribute", rolluptypes.AttributeKeyValue)
+	} else if len(withdrawalHash) != common.HashLength {
+		return nil, common.Hash{}, fmt.Errorf("missing %s attribute", rolluptypes.AttributeKeyWithdrawalHash)
 	}
-	return execTxResult, nil
+	return withdrawalMsg, common.BytesToHash(withdrawalHash), nil
 }
 
 // storeWithdrawalMsgInEVM stores the withdrawal message hash in the monomer evm state db and returns the L2ToL1MessagePasser
 // message nonce used for the withdrawal. This is used for proving withdrawals.
 func (b *Builder) storeWithdrawalMsgInEVM(
 	withdrawalMsg *rolluptypes.MsgInitiateWithdrawal,
-	ethState *state.StateDB,
+	ethState vm.StateDB,
 	header *monomer.Header,
 ) (*big.Int, error) {
 	monomerEVM, err := evm.NewEVM(ethState, header)
@@ -2,31 +2,46 @@ package builder_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"testing"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	bfttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/state"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/polymerdao/monomer"
 	"github.com/polymerdao/monomer/app/peptide/txstore"
 	"github.com/polymerdao/monomer/bindings"
 	"github.com/polymerdao/monomer/builder"
 	"github.com/polymerdao/monomer/contracts"
+	"github.com/polymerdao/monomer/crash"
 	"github.com/polymerdao/monomer/evm"
+	"github.com/polymerdao/monomer/forcedinclusion"
 	"github.com/polymerdao/monomer/genesis"
 	"github.com/polymerdao/monomer/mempool"
 	"github.com/polymerdao/monomer/monomerdb/localdb"
+	"github.com/polymerdao/monomer/surgery"
 	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testapp/x/testmodule"
 	"github.com/polymerdao/monomer/testutils"
 	"github.com/polymerdao/monomer/utils"
+	"github.com/polymerdao/monomer/witness"
 	"github.com/polymerdao/monomer/x/rollup/types"
 	"github.com/stretchr/testify/require"
 )
@@ -116,6 +131,7 @@ func TestBuild(t *testing.T) {
 				env.eventBus,
 				env.g.ChainID,
 				env.ethstatedb,
+				builder.NewWAL(testutils.NewMemDB(t)),
 			)
 
 			payload := &builder.Payload{
@@ -155,6 +171,7 @@ func TestBuild(t *testing.T) {
 				Time:       payload.Timestamp,
 				ParentHash: genesisHeader.Header.Hash,
 				StateRoot:  ethStateRoot,
+				AppHash:    preBuildInfo.GetLastBlockAppHash(),
 				GasLimit:   payload.GasLimit,
 			}
 			wantBlock, err := monomer.MakeBlock(header, bfttypes.ToTxs(allTxs))
@@ -168,31 +185,20 @@ func TestBuild(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, appHash[:], postBuildInfo.GetLastBlockAppHash())
 
-			// Tx store and event bus.
-			expectedTxResults := make([]*abcitypes.ExecTxResult, 0, len(wantBlock.Txs))
+			// Tx store.
 			for i, tx := range wantBlock.Txs {
-				// Tx store.
 				got, err := env.txStore.Get(tx.Hash())
 				require.NoError(t, err)
 				checkTxResult(t, got, wantBlock, i, tx)
-
-				// Event bus.
-				eventDataTx := getEventData[bfttypes.EventDataTx](t, subscription)
-				checkTxResult(t, &eventDataTx.TxResult, wantBlock, i, tx)
-
-				expectedTxResults = append(expectedTxResults, &eventDataTx.TxResult.Result)
 			}
 
+			// Event bus. Events are published in the same order as CometBFT.
 			var expectedBlockEvents []abcitypes.Event
 
-			// Ensure that EventDataNewBlockEvents is emitted with the correct attributes.
-			require.Equal(t, bfttypes.EventDataNewBlockEvents{
-				Height: int64(wantBlock.Header.Height),
-				Events: expectedBlockEvents,
-				NumTxs: int64(len(wantBlock.Txs)),
-			}, getEventData[bfttypes.EventDataNewBlockEvents](t, subscription))
-
 			// Ensure that EventDataNewBlock is emitted with the correct attributes.
+			eventDataNewBlock := getEventData[bfttypes.EventDataNewBlock](t, subscription)
+			expectedTxResults := eventDataNewBlock.ResultFinalizeBlock.TxResults
+			require.Len(t, expectedTxResults, len(wantBlock.Txs))
 			require.Equal(t, bfttypes.EventDataNewBlock{
 				Block: wantBlock.ToCometLikeBlock(),
 				BlockID: bfttypes.BlockID{
@@ -204,18 +210,381 @@ func TestBuild(t *testing.T) {
 					AppHash:               postBuildInfo.GetLastBlockAppHash(),
 					ConsensusParamUpdates: &tmtypes.ConsensusParams{},
 				},
-			}, getEventData[bfttypes.EventDataNewBlock](t, subscription))
+			}, eventDataNewBlock)
 
 			// Ensure that EventDataNewBlockHeader is emitted with the correct attributes.
 			require.Equal(t, bfttypes.EventDataNewBlockHeader{
 				Header: *wantBlock.Header.ToComet(),
 			}, getEventData[bfttypes.EventDataNewBlockHeader](t, subscription))
 
+			// Ensure that EventDataNewBlockEvents is emitted with the correct attributes.
+			require.Equal(t, bfttypes.EventDataNewBlockEvents{
+				Height: int64(wantBlock.Header.Height),
+				Events: expectedBlockEvents,
+				NumTxs: int64(len(wantBlock.Txs)),
+			}, getEventData[bfttypes.EventDataNewBlockEvents](t, subscription))
+
+			// Tx events follow the block events, with the same results as the block.
+			for i, tx := range wantBlock.Txs {
+				eventDataTx := getEventData[bfttypes.EventDataTx](t, subscription)
+				checkTxResult(t, &eventDataTx.TxResult, wantBlock, i, tx)
+				require.Equal(t, expectedTxResults[i], &eventDataTx.TxResult.Result)
+			}
+
 			require.NoError(t, subscription.Err())
 		})
 	}
 }
 
+func TestBuildBatches(t *testing.T) {
+	env := setupTestEnvironment(t)
+	b := builder.New(
+		env.pool,
+		env.app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
+	)
+
+	// The pool rejects duplicate txs, so each batch has its own failing tx.
+	atomicFailingTx := bfttypes.Tx("not a cosmos tx")
+	failingTx := bfttypes.Tx("also not a cosmos tx")
+	atomicKVs := map[string]string{"atomic": "v"}
+	atomicTx := bfttypes.Tx(testapp.ToTestTx(t, "atomic", "v"))
+	nonAtomicKVs := map[string]string{"non-atomic": "v"}
+	nonAtomicTx := bfttypes.Tx(testapp.ToTestTx(t, "non-atomic", "v"))
+	loneKVs := map[string]string{"lone": "v"}
+	loneTx := bfttypes.Tx(testapp.ToTestTx(t, "lone", "v"))
+	require.NoError(t, env.pool.EnqueueBatch(&mempool.Batch{
+		Txs:    bfttypes.Txs{atomicTx, atomicFailingTx},
+		Atomic: true,
+	}))
+	require.NoError(t, env.pool.EnqueueBatch(&mempool.Batch{
+		Txs: bfttypes.Txs{nonAtomicTx, failingTx},
+	}))
+	require.NoError(t, env.pool.Enqueue(loneTx))
+
+	injectedTxs := bfttypes.Txs{testutils.GenerateBlock(t).Txs[0]}
+	block, _, postBuildInfo := buildBlock(t, b, env.app, &builder.Payload{
+		InjectedTransactions: injectedTxs,
+		Timestamp:            env.g.Time + 1,
+	})
+
+	// The atomic batch failed, so it is left out. The non-atomic batch is included contiguously even though it failed.
+	require.Equal(t, append(injectedTxs, nonAtomicTx, failingTx, loneTx), block.Txs)
+	height := uint64(postBuildInfo.GetLastBlockHeight())
+	require.Equal(t, block.Header.Height, height)
+	env.app.StateDoesNotContain(t, height, atomicKVs)
+	env.app.StateContains(t, height, nonAtomicKVs)
+	env.app.StateContains(t, height, loneKVs)
+	got, err := env.txStore.Get(atomicTx.Hash())
+	require.NoError(t, err)
+	require.Nil(t, got)
+	got, err = env.txStore.Get(failingTx.Hash())
+	require.NoError(t, err)
+	require.False(t, got.Result.IsOK())
+
+	// The dropped txs are recorded as rejected.
+	rejection, err := env.pool.Rejection(atomicTx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, atomicTx, rejection.Tx)
+	require.Equal(t, mempool.ReasonEvicted, rejection.Reason)
+	require.Equal(t, got.Result.Code, rejection.Code)
+	require.Contains(t, rejection.Log, "dropped with its atomic batch because tx 1 failed")
+}
+
+// recordingListener records what the app streams.
+type recordingListener struct {
+	finalizeBlocks []abcitypes.RequestFinalizeBlock
+	results        []abcitypes.ResponseFinalizeBlock
+	changeSets     [][]*storetypes.StoreKVPair
+}
+
+func (l *recordingListener) ListenFinalizeBlock(
+	_ context.Context,
+	req abcitypes.RequestFinalizeBlock,
+	res abcitypes.ResponseFinalizeBlock,
+) error {
+	l.finalizeBlocks = append(l.finalizeBlocks, req)
+	l.results = append(l.results, res)
+	return nil
+}
+
+func (l *recordingListener) ListenCommit(_ context.Context, _ abcitypes.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	l.changeSets = append(l.changeSets, changeSet)
+	return nil
+}
+
+func TestBuildStreaming(t *testing.T) {
+	env := setupTestEnvironment(t)
+	listener := new(recordingListener)
+	env.app.SetABCIListeners(listener)
+	b := builder.New(
+		env.pool,
+		env.app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
+	)
+
+	tx := bfttypes.Tx(testapp.ToTestTx(t, "streamed", "v"))
+	require.NoError(t, env.pool.Enqueue(tx))
+	injectedTxs := bfttypes.Txs{testutils.GenerateBlock(t).Txs[0]}
+	block, _, _ := buildBlock(t, b, env.app, &builder.Payload{
+		InjectedTransactions: injectedTxs,
+		Timestamp:            env.g.Time + 1,
+	})
+
+	// Streaming indexers see the block like they would see a CometBFT block.
+	require.Len(t, listener.finalizeBlocks, 1)
+	req := listener.finalizeBlocks[0]
+	require.Equal(t, int64(block.Header.Height), req.Height)
+	require.Equal(t, block.Txs.ToSliceOfBytes(), req.Txs)
+	require.Equal(t, block.Header.ToComet().Hash().Bytes(), req.Hash)
+	require.Len(t, listener.results[0].TxResults, len(block.Txs))
+	for _, txResult := range listener.results[0].TxResults {
+		require.True(t, txResult.IsOK(), txResult.GetLog())
+	}
+	require.Len(t, listener.changeSets, 1)
+	require.True(t, slices.ContainsFunc(listener.changeSets[0], func(pair *storetypes.StoreKVPair) bool {
+		return pair.StoreKey == testmodule.StoreKey && string(pair.Key) == "streamed" && string(pair.Value) == "v"
+	}))
+}
+
+func TestBuildInterceptors(t *testing.T) {
+	env := setupTestEnvironment(t)
+	forced := forcedinclusion.New(1, forcedinclusion.NewNoopMetrics())
+	b := builder.New(
+		env.pool,
+		env.app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
+		forced,
+	)
+
+	forcedKVs := map[string]string{"forced": "v"}
+	forcedTx := bfttypes.Tx(testapp.ToTestTx(t, "forced", "v"))
+	require.NoError(t, forced.Add(forcedTx, 1))
+	mempoolTx := bfttypes.Tx(testapp.ToTestTx(t, "mempool", "v"))
+	require.NoError(t, env.pool.Enqueue(mempoolTx))
+
+	// Blocks built without the tx pool don't include forced txs.
+	injectedTxs := bfttypes.Txs{testutils.GenerateBlock(t).Txs[0]}
+	block, _, _ := buildBlock(t, b, env.app, &builder.Payload{
+		InjectedTransactions: injectedTxs,
+		Timestamp:            env.g.Time + 1,
+		NoTxPool:             true,
+	})
+	require.Equal(t, injectedTxs, block.Txs)
+	require.Len(t, forced.Obligations(), 1)
+
+	// Forced txs are included after the injected txs and before the mempool txs.
+	block, _, postBuildInfo := buildBlock(t, b, env.app, &builder.Payload{
+		InjectedTransactions: injectedTxs,
+		Timestamp:            env.g.Time + 2,
+	})
+	require.Equal(t, append(injectedTxs, forcedTx, mempoolTx), block.Txs)
+	env.app.StateContains(t, uint64(postBuildInfo.GetLastBlockHeight()), forcedKVs)
+	require.Empty(t, forced.Obligations())
+}
+
+func TestBuildBatchInterceptors(t *testing.T) {
+	env := setupTestEnvironment(t)
+	interceptor := &batchInterceptor{}
+	b := builder.New(
+		env.pool,
+		env.app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
+		interceptor,
+	)
+
+	injectedTxs := bfttypes.Txs{testutils.GenerateBlock(t).Txs[0]}
+	bundleTx := bfttypes.Tx(testapp.ToTestTx(t, "bundle", "v"))
+	interceptor.batch = &mempool.Batch{
+		Txs:    bfttypes.Txs{bundleTx},
+		Atomic: true,
+	}
+	block, _, _ := buildBlock(t, b, env.app, &builder.Payload{
+		InjectedTransactions: injectedTxs,
+		Timestamp:            env.g.Time + 1,
+	})
+	require.Equal(t, append(injectedTxs, bundleTx), block.Txs)
+
+	// The batch stays atomic: a failing tx leaves the whole batch out.
+	atomicKVs := map[string]string{"atomic": "v"}
+	interceptor.batch = &mempool.Batch{
+		Txs:    bfttypes.Txs{bfttypes.Tx(testapp.ToTestTx(t, "atomic", "v")), bfttypes.Tx("not a cosmos tx")},
+		Atomic: true,
+	}
+	block, _, postBuildInfo := buildBlock(t, b, env.app, &builder.Payload{
+		InjectedTransactions: injectedTxs,
+		Timestamp:            env.g.Time + 2,
+	})
+	require.Equal(t, injectedTxs, block.Txs)
+	env.app.StateDoesNotContain(t, uint64(postBuildInfo.GetLastBlockHeight()), atomicKVs)
+}
+
+func TestBuildCrash(t *testing.T) {
+	env := setupTestEnvironment(t)
+	b := builder.New(
+		env.pool,
+		env.app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
+		panicInterceptor{},
+	)
+	var crashes []error
+	b.SetCrashHandler(crash.NewHandler("", "", func(err error) {
+		crashes = append(crashes, err)
+	}))
+
+	_, err := b.Build(context.Background(), &builder.Payload{
+		Timestamp: env.g.Time + 1,
+	})
+	var crashErr *crash.Error
+	require.ErrorAs(t, err, &crashErr)
+	require.Equal(t, crash.SubsystemBuilder, crashErr.Dump.Subsystem)
+	require.Equal(t, "intercept", crashErr.Dump.Panic)
+	require.Equal(t, []error{err}, crashes)
+}
+
+// panicInterceptor panics when it is called, like a buggy interceptor.
+type panicInterceptor struct{}
+
+func (panicInterceptor) Intercept(context.Context, uint64) (bfttypes.Txs, error) {
+	panic("intercept")
+}
+
+func (panicInterceptor) OnBlock(context.Context, *monomer.Block) error {
+	return nil
+}
+
+// batchInterceptor adds its batch to every block.
+type batchInterceptor struct {
+	batch *mempool.Batch
+}
+
+func (*batchInterceptor) Intercept(context.Context, uint64) (bfttypes.Txs, error) {
+	panic("Intercept called on a BatchInterceptor")
+}
+
+func (i *batchInterceptor) InterceptBatch(context.Context, uint64) (*mempool.Batch, error) {
+	return i.batch, nil
+}
+
+func (*batchInterceptor) OnBlock(context.Context, *monomer.Block) error {
+	return nil
+}
+
+// crashingBlockStore fails to append blocks while crash is set, like a crash after the app commits a block.
+type crashingBlockStore struct {
+	*localdb.DB
+	crash bool
+}
+
+func (db *crashingBlockStore) AppendBlock(block *monomer.Block) error {
+	if db.crash {
+		return errors.New("crash")
+	}
+	return db.DB.AppendBlock(block)
+}
+
+// crashingTxStore fails to add txs while crash is set, like a crash after the block store appends a block.
+type crashingTxStore struct {
+	txstore.TxStore
+	crash bool
+}
+
+func (s *crashingTxStore) Add(txs []*abcitypes.TxResult) error {
+	if s.crash {
+		return errors.New("crash")
+	}
+	return s.TxStore.Add(txs)
+}
+
+func TestReplay(t *testing.T) {
+	for name, crashAfterAppend := range map[string]bool{
+		"crash before storing the block": false,
+		"crash after storing the block":  true,
+	} {
+		t.Run(name, func(t *testing.T) {
+			env := setupTestEnvironment(t)
+			blockStore := &crashingBlockStore{DB: env.blockStore}
+			txStore := &crashingTxStore{TxStore: env.txStore}
+			wal := builder.NewWAL(testutils.NewMemDB(t))
+			// Every call simulates a restart.
+			newBuilder := func() *builder.Builder {
+				return builder.New(env.pool, env.app, blockStore, txStore, env.eventBus, env.g.ChainID, env.ethstatedb, wal)
+			}
+
+			block, err := newBuilder().Replay(context.Background())
+			require.NoError(t, err)
+			require.Nil(t, block)
+
+			kvs := map[string]string{"replayed": "v"}
+			tx := bfttypes.Tx(testapp.ToTestTx(t, "replayed", "v"))
+			require.NoError(t, env.pool.Enqueue(tx))
+			payload := &builder.Payload{
+				InjectedTransactions: bfttypes.Txs{testutils.GenerateBlock(t).Txs[0]},
+				Timestamp:            env.g.Time + 1,
+			}
+			if crashAfterAppend {
+				txStore.crash = true
+			} else {
+				blockStore.crash = true
+			}
+			_, err = newBuilder().Build(context.Background(), payload)
+			require.Error(t, err)
+			blockStore.crash, txStore.crash = false, false
+			crashedHead, err := env.blockStore.HeadBlock()
+			require.NoError(t, err)
+
+			block, err = newBuilder().Replay(context.Background())
+			require.NoError(t, err)
+			// The mempool tx was dequeued before the crash, but the WAL kept it.
+			require.Equal(t, append(payload.InjectedTransactions, tx), block.Txs)
+			if crashAfterAppend {
+				// Blocks are built deterministically.
+				require.Equal(t, crashedHead, block)
+			}
+			head, err := env.blockStore.HeadBlock()
+			require.NoError(t, err)
+			require.Equal(t, block, head)
+			unsafe, err := env.blockStore.BlockByLabel(eth.Unsafe)
+			require.NoError(t, err)
+			require.Equal(t, block.Header.Hash, unsafe.Header.Hash)
+			env.app.StateContains(t, block.Header.Height, kvs)
+			got, err := env.txStore.Get(tx.Hash())
+			require.NoError(t, err)
+			require.Equal(t, int64(block.Header.Height), got.Height)
+
+			// The WAL is empty after a replay.
+			block, err = newBuilder().Replay(context.Background())
+			require.NoError(t, err)
+			require.Nil(t, block)
+		})
+	}
+}
+
 func TestRollback(t *testing.T) {
 	env := setupTestEnvironment(t)
 	genesisHeader, err := env.blockStore.HeadHeader()
@@ -229,6 +598,7 @@ func TestRollback(t *testing.T) {
 		env.eventBus,
 		env.g.ChainID,
 		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
 	)
 
 	kvs := map[string]string{
@@ -279,6 +649,319 @@ func TestRollback(t *testing.T) {
 	// We trust that the other parts of a tx store rollback were done as well.
 }
 
+func TestReorg(t *testing.T) {
+	env := setupTestEnvironment(t)
+	genesisHeader, err := env.blockStore.HeadHeader()
+	require.NoError(t, err)
+
+	waldb := testutils.NewMemDB(t)
+	b := builder.New(
+		env.pool,
+		env.app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(waldb),
+	)
+
+	kvs := map[string]string{
+		"k1": "v1",
+		"k2": "v2",
+	}
+	userTxs := bfttypes.ToTxs(testapp.ToTxs(t, kvs))
+	block, err := b.Build(context.Background(), &builder.Payload{
+		Timestamp:            env.g.Time + 1,
+		InjectedTransactions: append(bfttypes.Txs{testutils.GenerateBlock(t).Txs[0]}, userTxs...),
+	})
+	require.NoError(t, err)
+	require.NoError(t, env.blockStore.UpdateLabels(block.Header.Hash, genesisHeader.Hash, genesisHeader.Hash))
+
+	require.NoError(t, b.Reorg(context.Background(), genesisHeader.Hash, genesisHeader.Hash, genesisHeader.Hash))
+	require.NoError(t, b.RecordReorg())
+	height, err := env.blockStore.Height()
+	require.NoError(t, err)
+	require.Equal(t, genesisHeader.Height, height)
+
+	// The user txs are back in the mempool in order, but the deposit tx isn't.
+	pending, err := env.pool.Txs(0)
+	require.NoError(t, err)
+	require.Equal(t, userTxs, pending)
+
+	// The block built on the new head includes them again.
+	block, err = b.Build(context.Background(), &builder.Payload{
+		Timestamp:            env.g.Time + 2,
+		InjectedTransactions: bfttypes.Txs{testutils.GenerateBlock(t).Txs[0]},
+	})
+	require.NoError(t, err)
+	require.Equal(t, userTxs, block.Txs[1:])
+	env.app.StateContains(t, block.Header.Height, kvs)
+
+	// The totals count both builds and survive a restart.
+	totals, err := builder.NewWAL(waldb).Totals()
+	require.NoError(t, err)
+	require.Equal(t, &builder.Totals{
+		Blocks: 2,
+		Txs:    uint64(2*len(userTxs) + 2),
+		Reorgs: 1,
+	}, totals)
+}
+
+func TestReorgRejectsTxsThatCantBeEnqueued(t *testing.T) {
+	env := setupTestEnvironment(t)
+	genesisHeader, err := env.blockStore.HeadHeader()
+	require.NoError(t, err)
+
+	b := builder.New(
+		env.pool,
+		env.app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
+	)
+
+	userTxs := bfttypes.ToTxs(testapp.ToTxs(t, map[string]string{
+		"k1": "v1",
+		"k2": "v2",
+		"k3": "v3",
+	}))
+	block, err := b.Build(context.Background(), &builder.Payload{
+		Timestamp:            env.g.Time + 1,
+		InjectedTransactions: append(bfttypes.Txs{testutils.GenerateBlock(t).Txs[0]}, userTxs...),
+	})
+	require.NoError(t, err)
+	require.NoError(t, env.blockStore.UpdateLabels(block.Header.Hash, genesisHeader.Hash, genesisHeader.Hash))
+
+	// The second tx doesn't fit in the pool, so enqueueing it fails. The third is already in the pool.
+	env.pool.SetMaxSize(2)
+	require.NoError(t, env.pool.Enqueue(userTxs[2]))
+
+	require.NoError(t, b.Reorg(context.Background(), genesisHeader.Hash, genesisHeader.Hash, genesisHeader.Hash))
+	height, err := env.blockStore.Height()
+	require.NoError(t, err)
+	require.Equal(t, genesisHeader.Height, height)
+
+	pending, err := env.pool.Txs(0)
+	require.NoError(t, err)
+	require.Equal(t, bfttypes.Txs{userTxs[2], userTxs[0]}, pending)
+
+	rejection, err := env.pool.Rejection(userTxs[1].Hash())
+	require.NoError(t, err)
+	require.NotNil(t, rejection)
+	require.Equal(t, mempool.ReasonEvicted, rejection.Reason)
+	require.Equal(t, mempool.CodeReorged, rejection.Code)
+	require.Equal(t, mempool.Codespace, rejection.Codespace)
+	require.Contains(t, rejection.Log, mempool.ErrFull.Error())
+
+	// The tx that was already in the pool isn't rejected.
+	rejection, err = env.pool.Rejection(userTxs[2].Hash())
+	require.NoError(t, err)
+	require.Nil(t, rejection)
+}
+
+func TestBuildFrozen(t *testing.T) {
+	env := setupTestEnvironment(t)
+	b := builder.New(
+		env.pool,
+		env.app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
+	)
+	genesisHeader, err := env.blockStore.HeadHeader()
+	require.NoError(t, err)
+	b.SetFreezeHeight(genesisHeader.Height + 1)
+
+	block, err := b.Build(context.Background(), &builder.Payload{
+		Timestamp: env.g.Time + 1,
+	})
+	require.NoError(t, err)
+	_, err = b.Build(context.Background(), &builder.Payload{
+		Timestamp: env.g.Time + 2,
+	})
+	require.ErrorIs(t, err, builder.ErrFrozen)
+
+	head, err := env.blockStore.HeadHeader()
+	require.NoError(t, err)
+	require.Equal(t, block.Header.Hash, head.Hash)
+}
+
+// patchState records the writes of a state patch.
+type patchState map[string][]byte
+
+func (s patchState) StateValue(store string, key []byte) ([]byte, error) {
+	return s[store+"/"+string(key)], nil
+}
+
+func (s patchState) SetStateValue(store string, key, value []byte) error {
+	s[store+"/"+string(key)] = value
+	return nil
+}
+
+func TestBuildStatePatch(t *testing.T) {
+	env := setupTestEnvironment(t)
+	b := builder.New(
+		env.pool,
+		env.app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
+	)
+	genesisHeader, err := env.blockStore.HeadHeader()
+	require.NoError(t, err)
+	block, err := b.Build(context.Background(), &builder.Payload{
+		Timestamp: env.g.Time + 1,
+	})
+	require.NoError(t, err)
+
+	info, err := env.app.Info(context.Background(), &abcitypes.RequestInfo{})
+	require.NoError(t, err)
+	patch := &surgery.Patch{
+		Height:  block.Header.Height,
+		AppHash: info.GetLastBlockAppHash(),
+		Reason:  "test",
+		Ops:     []surgery.Op{{Store: "test", Key: []byte("k"), Value: []byte("v")}},
+	}
+	require.NoError(t, patch.Seal())
+	s := make(patchState)
+	var applied []*monomer.Block
+	b.SetStatePatch(patch, s, func(_ context.Context, block *monomer.Block) error {
+		applied = append(applied, block)
+		return nil
+	})
+
+	// The patch is applied to the block after its height.
+	patched, err := b.Build(context.Background(), &builder.Payload{
+		Timestamp: env.g.Time + 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, patchState{"test/k": []byte("v")}, s)
+	require.Equal(t, []*monomer.Block{patched}, applied)
+	_, err = b.Build(context.Background(), &builder.Payload{
+		Timestamp: env.g.Time + 3,
+	})
+	require.NoError(t, err)
+	require.Len(t, applied, 1)
+
+	// Blocks at other heights don't apply it, and a block at its height can't be built on a state it wasn't created
+	// against.
+	require.NoError(t, b.Rollback(context.Background(), genesisHeader.Hash, genesisHeader.Hash, genesisHeader.Hash))
+	delete(s, "test/k")
+	_, err = b.Build(context.Background(), &builder.Payload{
+		Timestamp: env.g.Time + 1,
+		InjectedTransactions: bfttypes.ToTxs(append([][]byte{testutils.GenerateBlock(t).Txs[0]}, testapp.ToTxs(t, map[string]string{
+			"other": "block",
+		})...)),
+	})
+	require.NoError(t, err)
+	_, err = b.Build(context.Background(), &builder.Payload{
+		Timestamp: env.g.Time + 2,
+	})
+	require.ErrorContains(t, err, "app hash")
+	require.Empty(t, s)
+}
+
+func TestBuildWitness(t *testing.T) {
+	env := setupTestEnvironment(t)
+	genesisHeader, err := env.blockStore.HeadHeader()
+	require.NoError(t, err)
+	witnesses := witness.NewStore(testutils.NewMemDB(t))
+
+	b := builder.New(
+		env.pool,
+		env.app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
+	)
+	b.SetWitnessStore(witnesses)
+
+	// The app hash is stored in the EVM state with every block, so the second block reads storage the first one wrote.
+	depositTxs := testutils.GenerateBlock(t).Txs[0]
+	var parent, block *monomer.Block
+	for i := range uint64(2) {
+		parent = block
+		block, err = b.Build(context.Background(), &builder.Payload{
+			Timestamp:            env.g.Time + 1 + i,
+			InjectedTransactions: bfttypes.Txs{depositTxs},
+			NoTxPool:             true,
+		})
+		require.NoError(t, err)
+	}
+
+	encoded, err := witnesses.Get(block.Header.Height)
+	require.NoError(t, err)
+	w, err := witness.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, parent.Header, w.Parent)
+	require.Equal(t, block.Header, w.Header)
+	require.Equal(t, block.Txs.ToSliceOfBytes(), w.Txs)
+	ethTxs, err := monomer.GetDepositTxs([][]byte{depositTxs})
+	require.NoError(t, err)
+	l1Attributes, err := ethTxs[0].MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, l1Attributes, w.L1Attributes)
+
+	// The accessed accounts and slots are proven against the parent's state root.
+	require.True(t, slices.ContainsFunc(w.Accounts, func(account *witness.Account) bool {
+		return account.Address == contracts.L2ApplicationStateRootProviderAddr && len(account.Storage) > 0
+	}))
+	verifyProof := func(root common.Hash, key []byte, proof [][]byte) []byte {
+		proofDB := memorydb.New()
+		for _, node := range proof {
+			require.NoError(t, proofDB.Put(crypto.Keccak256(node), node))
+		}
+		value, err := trie.VerifyProof(root, crypto.Keccak256(key), proofDB)
+		require.NoError(t, err)
+		return value
+	}
+	var provenSlots int
+	for _, account := range w.Accounts {
+		accountRLP := verifyProof(w.Parent.StateRoot, account.Address.Bytes(), account.Proof)
+		if accountRLP == nil {
+			continue
+		}
+		stateAccount, err := gethtypes.FullAccount(accountRLP)
+		require.NoError(t, err)
+		require.Equal(t, common.BytesToHash(stateAccount.CodeHash), crypto.Keccak256Hash(account.Code))
+		for _, slot := range account.Storage {
+			if slot.Proof == nil {
+				continue
+			}
+			valueRLP := verifyProof(stateAccount.Root, slot.Key.Bytes(), slot.Proof)
+			if valueRLP != nil {
+				_, value, _, err := rlp.Split(valueRLP)
+				require.NoError(t, err)
+				require.Equal(t, common.BytesToHash(value), slot.Value)
+				provenSlots++
+			}
+		}
+	}
+	require.NotZero(t, provenSlots)
+
+	// Witnesses are rolled back with the blocks.
+	require.NoError(t, env.blockStore.UpdateLabels(block.Header.Hash, block.Header.Hash, block.Header.Hash))
+	require.NoError(t, b.Rollback(context.Background(), genesisHeader.Hash, genesisHeader.Hash, genesisHeader.Hash))
+	for _, height := range []uint64{parent.Header.Height, block.Header.Height} {
+		encoded, err = witnesses.Get(height)
+		require.NoError(t, err)
+		require.Nil(t, encoded)
+	}
+}
+
 // getAppHashFromEVM retrieves the updated cosmos app hash from the monomer EVM state db.
 func getAppHashFromEVM(ethState *state.StateDB, header *monomer.Header) (common.Hash, error) {
 	monomerEVM, err := evm.NewEVM(ethState, header)
@@ -336,6 +1019,7 @@ func TestBuildRollupTxs(t *testing.T) {
 		env.eventBus,
 		env.g.ChainID,
 		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
 	)
 
 	// Prepare the payload
@@ -371,6 +1055,7 @@ func TestBuildRollupTxs(t *testing.T) {
 		Time:       payload.Timestamp,
 		ParentHash: genesisBlock.Header.Hash,
 		StateRoot:  ethStateRoot,
+		AppHash:    preBuildInfo.GetLastBlockAppHash(),
 		GasLimit:   payload.GasLimit,
 	}
 	wantBlock, err := monomer.MakeBlock(&header, txs)
@@ -380,7 +1065,7 @@ func TestBuildRollupTxs(t *testing.T) {
 	// We expect two transactions: one combined transaction (l1InfoTx + depositTxs) and one withdrawal transaction (withdrawalTx).
 	require.Equal(t, 2, builtBlock.Txs.Len(), "Expected the built block to contain 2 transactions: depositTxs and withdrawalTx")
 
-	// Test parseWithdrawalMessages
+	// Test parseWithdrawals
 	checkWithdrawalTxResult(t, withdrawalTxResult)
 
 	expectedStateRoot := wantBlock.Header.StateRoot
@@ -393,6 +1078,10 @@ func TestBuildRollupTxs(t *testing.T) {
 		"/rollup.v1.MsgInitiateWithdrawal",
 	}
 
+	// The block events are published before the tx events.
+	getEventData[bfttypes.EventDataNewBlock](t, subscription)
+	getEventData[bfttypes.EventDataNewBlockHeader](t, subscription)
+	getEventData[bfttypes.EventDataNewBlockEvents](t, subscription)
 	for _, expectedEvent := range expectedEvents {
 		eventData := getEventData[bfttypes.EventDataTx](t, subscription)
 		require.Equal(t, expectedEvent, eventData.Result.Events[0].Attributes[0].Value,
@@ -400,6 +1089,99 @@ func TestBuildRollupTxs(t *testing.T) {
 	}
 }
 
+// forgingApp appends a withdrawal_initiated event to the result of every successful tx, like a module that emits an
+// event of that type without burning anything would.
+type forgingApp struct {
+	*testapp.App
+	event abcitypes.Event
+}
+
+func (a *forgingApp) FinalizeBlock(ctx context.Context, req *abcitypes.RequestFinalizeBlock) (*abcitypes.ResponseFinalizeBlock, error) {
+	resp, err := a.App.FinalizeBlock(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range resp.TxResults {
+		if result.IsOK() {
+			result.Events = append(result.Events, a.event)
+		}
+	}
+	return resp, nil
+}
+
+func TestBuildForgedWithdrawal(t *testing.T) {
+	env := setupTestEnvironment(t)
+	genesisHeader, err := env.blockStore.HeadHeader()
+	require.NoError(t, err)
+	genesisState, err := state.New(genesisHeader.StateRoot, env.ethstatedb, nil)
+	require.NoError(t, err)
+	messageNonce := getMessageNonceFromEVM(t, genesisState, genesisHeader)
+
+	// The forged event hashes like a withdrawal with the next nonce, but the x/rollup module didn't commit to it.
+	sender := common.Address{1}
+	target := common.HexToAddress("0x12345abcde")
+	value := big.NewInt(1_000_000)
+	gasLimit := big.NewInt(100_000)
+	withdrawalHash, err := crossdomain.NewWithdrawal(messageNonce, &sender, &target, value, gasLimit, []byte{}).Hash()
+	require.NoError(t, err)
+	app := &forgingApp{
+		App: env.app,
+		event: abcitypes.Event{
+			Type: types.EventTypeWithdrawalInitiated,
+			Attributes: []abcitypes.EventAttribute{
+				{Key: types.AttributeKeySender, Value: sdk.AccAddress(sender.Bytes()).String()},
+				{Key: types.AttributeKeyL1Target, Value: target.String()},
+				{Key: types.AttributeKeyValue, Value: hexutil.Encode(value.Bytes())},
+				{Key: types.AttributeKeyGasLimit, Value: hexutil.Encode(gasLimit.Bytes())},
+				{Key: types.AttributeKeyData, Value: hexutil.Encode([]byte{})},
+				{Key: types.AttributeKeyWithdrawalHash, Value: withdrawalHash.Hex()},
+			},
+		},
+	}
+
+	b := builder.New(
+		env.pool,
+		app,
+		env.blockStore,
+		env.txStore,
+		env.eventBus,
+		env.g.ChainID,
+		env.ethstatedb,
+		builder.NewWAL(testutils.NewMemDB(t)),
+	)
+	tx := bfttypes.Tx(testapp.ToTestTx(t, "k", "v"))
+	block, err := b.Build(context.Background(), &builder.Payload{
+		InjectedTransactions: bfttypes.Txs{testutils.GenerateBlock(t).Txs[0], tx},
+		Timestamp:            env.g.Time + 1,
+	})
+	require.NoError(t, err)
+
+	// The withdrawal wasn't registered in the L2ToL1MessagePasser.
+	ethState, err := state.New(block.Header.StateRoot, env.ethstatedb, nil)
+	require.NoError(t, err)
+	require.Equal(t, messageNonce, getMessageNonceFromEVM(t, ethState, block.Header))
+	txResult, err := env.txStore.Get(tx.Hash())
+	require.NoError(t, err)
+	for _, event := range txResult.Result.Events {
+		if event.Type == types.EventTypeWithdrawalInitiated {
+			for _, attr := range event.Attributes {
+				require.NotEqual(t, types.AttributeKeyNonce, attr.Key)
+			}
+		}
+	}
+}
+
+// getMessageNonceFromEVM retrieves the L2ToL1MessagePasser's next message nonce from the monomer EVM state db.
+func getMessageNonceFromEVM(t *testing.T, ethState *state.StateDB, header *monomer.Header) *big.Int {
+	monomerEVM, err := evm.NewEVM(ethState, header)
+	require.NoError(t, err)
+	executer, err := bindings.NewL2ToL1MessagePasserExecuter(monomerEVM)
+	require.NoError(t, err)
+	messageNonce, err := executer.GetMessageNonce()
+	require.NoError(t, err)
+	return messageNonce
+}
+
 func setupTestEnvironment(t *testing.T) testEnvironment {
 	var chainID monomer.ChainID
 	pool := mempool.New(testutils.NewMemDB(t))
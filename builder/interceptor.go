@@ -0,0 +1,29 @@
+package builder
+
+import (
+	"context"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/mempool"
+)
+
+// Interceptor adds txs to the blocks the builder builds, e.g., to enforce a forced inclusion list
+// (see the forcedinclusion package).
+type Interceptor interface {
+	// Intercept returns txs to include in the block at the given height. They are included after the payload's injected
+	// txs and before the mempool txs, and like mempool txs, they are included even if they fail.
+	// Intercept is only called for blocks built with the tx pool.
+	Intercept(ctx context.Context, height uint64) (bfttypes.Txs, error)
+	// OnBlock is called with every block the builder builds, including blocks built without the tx pool.
+	OnBlock(ctx context.Context, block *monomer.Block) error
+}
+
+// BatchInterceptor is an Interceptor that adds its txs as a mempool.Batch, e.g., to include them atomically (see the
+// bundles package).
+type BatchInterceptor interface {
+	Interceptor
+	// InterceptBatch is called in place of Intercept. It returns the batch to include in the block at the given height,
+	// or nil.
+	InterceptBatch(ctx context.Context, height uint64) (*mempool.Batch, error)
+}
@@ -0,0 +1,195 @@
+package builder
+
+import (
+	"time"
+
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	MetricsSubsystem = "builder"
+
+	// CommitStoreApp is the store label of the app's state commits.
+	CommitStoreApp = "app"
+	// CommitStoreBlock is the store label of the block store's commits.
+	CommitStoreBlock = "block"
+	// CommitStoreTx is the store label of the tx index's commits.
+	CommitStoreTx = "tx"
+)
+
+// BuildDurationBucketsSeconds are the buckets of the block build latency histogram. Blocks are built every 2 seconds
+// on most OP Stack chains, so builds should take a fraction of that.
+var BuildDurationBucketsSeconds = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 5}
+
+// CommitDurationBucketsSeconds are the buckets of the commit time histogram.
+var CommitDurationBucketsSeconds = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+
+// BlockSizeBucketsBytes are the buckets of the block size histogram.
+var BlockSizeBucketsBytes = stdprometheus.ExponentialBuckets(1<<10, 4, 8) //nolint:mnd // 1 KiB to 16 MiB.
+
+// Metrics contains metrics collected from the builder package.
+type Metrics interface {
+	RecordBuild(start time.Time)
+	RecordBlock(txs, bytes int)
+	RecordCommit(store string, start time.Time)
+	SetMempoolDepth(depth uint64)
+	RecordDeposits(n int)
+	RecordWithdrawals(n int)
+	RecordReorg()
+	// RecordTotals adds the totals persisted by earlier runs of the node, so the counters continue across restarts.
+	RecordTotals(totals *Totals)
+}
+
+type metrics struct {
+	// Time it took to build each block, from executing its txs to publishing its events.
+	BuildDuration stdprometheus.Histogram
+	// Number of txs in each block.
+	BlockTxs stdprometheus.Histogram
+	// Size of the txs in each block in bytes.
+	BlockBytes stdprometheus.Histogram
+	// Time it took to commit each block to each store.
+	CommitDuration *stdprometheus.HistogramVec
+	// Number of elements in the mempool when the last block built with it started.
+	MempoolDepth stdprometheus.Gauge
+	// Number of L1 deposits included, not counting the L1 attributes tx.
+	Deposits stdprometheus.Counter
+	// Number of withdrawals initiated.
+	Withdrawals stdprometheus.Counter
+	// Number of blocks built since genesis.
+	Blocks stdprometheus.Counter
+	// Number of txs in the blocks built since genesis.
+	Txs stdprometheus.Counter
+	// Number of reorgs of the unsafe chain since genesis.
+	Reorgs stdprometheus.Counter
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		BuildDuration: promauto.NewHistogram(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "build_duration_seconds",
+			Help:      "Time it took to build each block, from executing its txs to publishing its events",
+			Buckets:   BuildDurationBucketsSeconds,
+		}),
+		BlockTxs: promauto.NewHistogram(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_txs",
+			Help:      "Number of txs in each block, including the deposits tx",
+			Buckets:   stdprometheus.ExponentialBuckets(1, 2, 12), //nolint:mnd // 1 to 2048 txs.
+		}),
+		BlockBytes: promauto.NewHistogram(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "block_bytes",
+			Help:      "Size of the txs in each block in bytes",
+			Buckets:   BlockSizeBucketsBytes,
+		}),
+		CommitDuration: promauto.NewHistogramVec(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commit_duration_seconds",
+			Help:      "Time it took to commit each block, by store",
+			Buckets:   CommitDurationBucketsSeconds,
+		}, []string{
+			"store",
+		}),
+		MempoolDepth: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "mempool_depth",
+			Help:      "Number of elements in the mempool when the last block built with it started; a batch counts as one",
+		}),
+		Deposits: promauto.NewCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "deposits_total",
+			Help:      "Number of L1 deposits included, not counting the L1 attributes tx",
+		}),
+		Withdrawals: promauto.NewCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "withdrawals_total",
+			Help:      "Number of withdrawals initiated",
+		}),
+		Blocks: promauto.NewCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "blocks_total",
+			Help:      "Number of blocks built since genesis, including before the node last started",
+		}),
+		Txs: promauto.NewCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "txs_total",
+			Help:      "Number of txs in the blocks built since genesis, including before the node last started",
+		}),
+		Reorgs: promauto.NewCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reorgs_total",
+			Help:      "Number of reorgs of the unsafe chain since genesis, including before the node last started",
+		}),
+	}
+}
+
+func (m *metrics) RecordBuild(start time.Time) {
+	m.BuildDuration.Observe(time.Since(start).Seconds())
+}
+
+func (m *metrics) RecordBlock(txs, bytes int) {
+	m.BlockTxs.Observe(float64(txs))
+	m.BlockBytes.Observe(float64(bytes))
+	m.Blocks.Inc()
+	m.Txs.Add(float64(txs))
+}
+
+func (m *metrics) RecordCommit(store string, start time.Time) {
+	m.CommitDuration.WithLabelValues(store).Observe(time.Since(start).Seconds())
+}
+
+func (m *metrics) SetMempoolDepth(depth uint64) {
+	m.MempoolDepth.Set(float64(depth))
+}
+
+func (m *metrics) RecordDeposits(n int) {
+	m.Deposits.Add(float64(n))
+}
+
+func (m *metrics) RecordWithdrawals(n int) {
+	m.Withdrawals.Add(float64(n))
+}
+
+func (m *metrics) RecordReorg() {
+	m.Reorgs.Inc()
+}
+
+func (m *metrics) RecordTotals(totals *Totals) {
+	m.Blocks.Add(float64(totals.Blocks))
+	m.Txs.Add(float64(totals.Txs))
+	m.Reorgs.Add(float64(totals.Reorgs))
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) RecordBuild(time.Time) {}
+
+func (*noopMetrics) RecordBlock(int, int) {}
+
+func (*noopMetrics) RecordCommit(string, time.Time) {}
+
+func (*noopMetrics) SetMempoolDepth(uint64) {}
+
+func (*noopMetrics) RecordDeposits(int) {}
+
+func (*noopMetrics) RecordWithdrawals(int) {}
+
+func (*noopMetrics) RecordReorg() {}
+
+func (*noopMetrics) RecordTotals(*Totals) {}
@@ -0,0 +1,226 @@
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/polymerdao/monomer"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/mempool"
+)
+
+const (
+	walPayloadKey = "payload"
+	walTotalsKey  = "totals"
+)
+
+// WAL is a write-ahead log of the payload the builder is executing. The builder writes the payload before executing
+// it and clears it once the block is stored, so a payload left in the WAL was interrupted by a crash.
+type WAL struct {
+	db dbm.DB
+}
+
+func NewWAL(db dbm.DB) *WAL {
+	return &WAL{
+		db: db,
+	}
+}
+
+// walPayload is everything needed to rebuild a block deterministically.
+type walPayload struct {
+	Height     uint64      `json:"height"`
+	ParentHash common.Hash `json:"parentHash"`
+	Timestamp  uint64      `json:"timestamp"`
+	GasLimit   uint64      `json:"gasLimit"`
+	// ParentBeaconRoot is nil for payloads built before Ecotone.
+	ParentBeaconRoot *common.Hash `json:"parentBeaconRoot,omitempty"`
+	// Batches are the injected txs followed by the txs the interceptors and the mempool added, which are no longer in
+	// the mempool.
+	Batches []*mempool.Batch `json:"batches"`
+}
+
+func (w *WAL) write(payload *walPayload) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %v", err)
+	}
+	if err := w.db.SetSync([]byte(walPayloadKey), payloadBytes); err != nil {
+		return fmt.Errorf("set payload: %v", err)
+	}
+	return nil
+}
+
+// payload returns the payload in the WAL, or nil if it is empty.
+func (w *WAL) payload() (*walPayload, error) {
+	payloadBytes, err := w.db.Get([]byte(walPayloadKey))
+	if err != nil {
+		return nil, fmt.Errorf("get payload: %v", err)
+	} else if payloadBytes == nil {
+		return nil, nil
+	}
+	payload := new(walPayload)
+	if err := json.Unmarshal(payloadBytes, payload); err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %v", err)
+	}
+	return payload, nil
+}
+
+// Totals are the running totals of what the builder did since genesis. They live in the WAL's database, so they
+// survive restarts.
+type Totals struct {
+	// Blocks is the number of blocks built, counting a block built again after a rollback each time.
+	Blocks uint64 `json:"blocks"`
+	// Txs is the number of txs in those blocks.
+	Txs uint64 `json:"txs"`
+	// Reorgs is the number of times op-node reorged the unsafe chain.
+	Reorgs uint64 `json:"reorgs"`
+}
+
+// Totals returns the running totals, which are zero if the builder hasn't built a block yet.
+func (w *WAL) Totals() (*Totals, error) {
+	totalsBytes, err := w.db.Get([]byte(walTotalsKey))
+	if err != nil {
+		return nil, fmt.Errorf("get totals: %v", err)
+	}
+	totals := new(Totals)
+	if totalsBytes == nil {
+		return totals, nil
+	}
+	if err := json.Unmarshal(totalsBytes, totals); err != nil {
+		return nil, fmt.Errorf("unmarshal totals: %v", err)
+	}
+	return totals, nil
+}
+
+// clear clears the payload of a block with txs that was stored and adds the block to the totals in the same batch, so
+// a block is counted once even if the node crashes around clearing the WAL.
+func (w *WAL) clear(txs int) error {
+	totals, err := w.Totals()
+	if err != nil {
+		return err
+	}
+	totals.Blocks++
+	totals.Txs += uint64(txs)
+
+	batch := w.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Delete([]byte(walPayloadKey)); err != nil {
+		return fmt.Errorf("delete payload: %v", err)
+	}
+	if err := setTotals(batch, totals); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return fmt.Errorf("write batch: %v", err)
+	}
+	return nil
+}
+
+// recordReorg adds a reorg to the totals.
+func (w *WAL) recordReorg() error {
+	totals, err := w.Totals()
+	if err != nil {
+		return err
+	}
+	totals.Reorgs++
+
+	batch := w.db.NewBatch()
+	defer batch.Close()
+	if err := setTotals(batch, totals); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return fmt.Errorf("write batch: %v", err)
+	}
+	return nil
+}
+
+func setTotals(batch dbm.Batch, totals *Totals) error {
+	totalsBytes, err := json.Marshal(totals)
+	if err != nil {
+		return fmt.Errorf("marshal totals: %v", err)
+	}
+	if err := batch.Set([]byte(walTotalsKey), totalsBytes); err != nil {
+		return fmt.Errorf("set totals: %v", err)
+	}
+	return nil
+}
+
+// Replay rebuilds the block whose payload is in the WAL, if the node crashed while building it or building it failed.
+// Whatever was stored of the block is rolled back first, and since blocks are built deterministically, the rebuilt block
+// is the one the crash interrupted. The rebuilt block becomes the unsafe head, so op-node doesn't have to resend the
+// payload. Replay returns nil if there is nothing to replay. It must be called before the builder builds any other block.
+func (b *Builder) Replay(ctx context.Context) (*monomer.Block, error) {
+	payload, err := b.wal.payload()
+	if err != nil {
+		return nil, err
+	} else if payload == nil {
+		return nil, nil
+	}
+
+	parentHeader, err := b.blockStore.HeaderByHeight(payload.Height - 1)
+	if err != nil {
+		return nil, fmt.Errorf("get parent header: %v", err)
+	} else if parentHeader.Hash != payload.ParentHash {
+		return nil, fmt.Errorf("wal payload at height %d builds on %s, but the block store has %s",
+			payload.Height, payload.ParentHash, parentHeader.Hash)
+	}
+	safe, err := b.labelAtOrBelow(eth.Safe, parentHeader)
+	if err != nil {
+		return nil, err
+	}
+	finalized, err := b.labelAtOrBelow(eth.Finalized, parentHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.uncommitted {
+		// The app would execute the block again on top of its uncommitted state changes, so they are committed and
+		// rolled back below, like the builder does after a failed atomic batch.
+		if _, err := b.app.Commit(ctx, &abcitypes.RequestCommit{}); err != nil {
+			return nil, fmt.Errorf("commit uncommitted block: %v", err)
+		}
+		b.uncommitted = false
+	}
+
+	if height, err := b.blockStore.Height(); err != nil {
+		return nil, fmt.Errorf("get height: %v", err)
+	} else if height >= payload.Height {
+		// The block was stored, but the tx store may be missing its txs.
+		if err := b.Rollback(ctx, parentHeader.Hash, safe, finalized); err != nil {
+			return nil, fmt.Errorf("rollback: %v", err)
+		}
+	} else if info, err := b.app.Info(ctx, &abcitypes.RequestInfo{}); err != nil {
+		return nil, fmt.Errorf("info: %v", err)
+	} else if uint64(info.GetLastBlockHeight()) > parentHeader.Height {
+		// The app committed the block, but the block store didn't.
+		if err := b.app.RollbackToHeight(ctx, parentHeader.Height); err != nil {
+			return nil, fmt.Errorf("rollback app: %v", err)
+		}
+	}
+
+	block, err := b.build(ctx, parentHeader, payload)
+	if err != nil {
+		return nil, fmt.Errorf("build: %v", err)
+	}
+	if err := b.blockStore.UpdateLabels(block.Header.Hash, safe, finalized); err != nil {
+		return nil, fmt.Errorf("update labels: %v", err)
+	}
+	return block, nil
+}
+
+// labelAtOrBelow returns the hash of the labeled block, or of header if the labeled block is higher.
+func (b *Builder) labelAtOrBelow(label eth.BlockLabel, header *monomer.Header) (common.Hash, error) {
+	labeledBlock, err := b.blockStore.BlockByLabel(label)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("get %s block: %v", label, err)
+	} else if labeledBlock.Header.Height > header.Height {
+		return header.Hash, nil
+	}
+	return labeledBlock.Header.Hash, nil
+}
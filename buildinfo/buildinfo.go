@@ -0,0 +1,129 @@
+// Package buildinfo identifies the Monomer build a node runs, so operators can check that their binaries were built from
+// the same source, and lets chains require a minimum Monomer version for mandatory upgrades.
+package buildinfo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+
+	"golang.org/x/mod/semver"
+)
+
+// modulePath is the path of the Monomer module, whose version is reported.
+const modulePath = "github.com/polymerdao/monomer"
+
+// Unknown is the version of builds without module information and of Monomer builds that aren't from a tagged module
+// version, e.g., builds from a checkout of the Monomer repository.
+const Unknown = "unknown"
+
+// version overrides the version read from the build's module information. It is set with
+// -ldflags "-X github.com/polymerdao/monomer/buildinfo.version=v1.2.3", e.g., by release builds from a checkout.
+var version string
+
+// Info identifies a build.
+type Info struct {
+	// Version is the version of the Monomer module the binary was built with, or Unknown.
+	Version   string `json:"version"`
+	GoVersion string `json:"goVersion"`
+	// Revision is the VCS revision the main module was built from, and Modified reports whether the checkout had
+	// uncommitted changes. They are empty if the binary was built without VCS information.
+	Revision string `json:"revision,omitempty"`
+	Modified bool   `json:"modified"`
+	// Hash is the hex-encoded SHA-256 hash of the build's module versions and checksums, build settings, and VCS
+	// information. Builds from the same source with the same toolchain and flags have the same hash, e.g., builds with
+	// -trimpath on different machines.
+	Hash string `json:"hash"`
+}
+
+// Read returns the Info of the running binary.
+func Read() *Info {
+	info := &Info{
+		Version:   Version(),
+		GoVersion: runtime.Version(),
+	}
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	for _, setting := range buildInfo.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			info.Revision = setting.Value
+		case "vcs.modified":
+			info.Modified = setting.Value == "true"
+		}
+	}
+	hash := sha256.Sum256([]byte(buildInfo.String()))
+	info.Hash = hex.EncodeToString(hash[:])
+	return info
+}
+
+// Version returns the version of the Monomer module the binary was built with, or Unknown.
+func Version() string {
+	if version != "" {
+		return version
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return Unknown
+	}
+	if info.Main.Path == modulePath {
+		return moduleVersion(info.Main.Version)
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			if dep.Replace != nil {
+				return moduleVersion(dep.Replace.Version)
+			}
+			return moduleVersion(dep.Version)
+		}
+	}
+	return Unknown
+}
+
+// moduleVersion returns Unknown for the "(devel)" version of builds from a checkout and the empty version of local
+// replacements.
+func moduleVersion(v string) string {
+	if !semver.IsValid(v) {
+		return Unknown
+	}
+	return v
+}
+
+// CheckMinVersion returns an error if v is older than minVersion, a semantic version with a v prefix. An empty
+// minVersion accepts every version. Unknown versions are rejected when there is a minimum, since they can't be
+// compared; release builds from a checkout set the version with -ldflags.
+func CheckMinVersion(v, minVersion string) error {
+	if minVersion == "" {
+		return nil
+	}
+	if !semver.IsValid(minVersion) {
+		return fmt.Errorf("minimum version %q is not a semantic version", minVersion)
+	}
+	if !semver.IsValid(v) {
+		return fmt.Errorf("version %s can't be compared to the minimum version %s", v, minVersion)
+	}
+	if semver.Compare(v, minVersion) < 0 {
+		return fmt.Errorf("version %s is older than the minimum version %s", v, minVersion)
+	}
+	return nil
+}
+
+// API serves the Info of the running binary.
+type API struct {
+	info *Info
+}
+
+func NewAPI() *API {
+	return &API{
+		info: Read(),
+	}
+}
+
+// BuildInfo returns the Info of the running binary.
+func (a *API) BuildInfo() *Info {
+	return a.info
+}
@@ -0,0 +1,46 @@
+package buildinfo_test
+
+import (
+	"testing"
+
+	"github.com/polymerdao/monomer/buildinfo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckMinVersion(t *testing.T) {
+	tests := map[string]struct {
+		version    string
+		minVersion string
+		wantErr    string
+	}{
+		"no minimum":                 {version: buildinfo.Unknown},
+		"equal":                      {version: "v0.2.0", minVersion: "v0.2.0"},
+		"newer":                      {version: "v0.10.1", minVersion: "v0.2.0"},
+		"pseudo-version after tag":   {version: "v0.2.1-0.20240101000000-abcdefabcdef", minVersion: "v0.2.0"},
+		"older":                      {version: "v0.1.9", minVersion: "v0.2.0", wantErr: "older"},
+		"prerelease of the minimum":  {version: "v0.2.0-rc.1", minVersion: "v0.2.0", wantErr: "older"},
+		"unknown version":            {version: buildinfo.Unknown, minVersion: "v0.2.0", wantErr: "can't be compared"},
+		"invalid minimum":            {version: "v0.2.0", minVersion: "0.2.0", wantErr: "not a semantic version"},
+		"minimum without patch part": {version: "v0.2.0", minVersion: "v0.2"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := buildinfo.CheckMinVersion(test.version, test.minVersion)
+			if test.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	info := buildinfo.Read()
+	// Test binaries are built from a checkout, which has no module version.
+	require.Equal(t, buildinfo.Unknown, info.Version)
+	require.Equal(t, buildinfo.Version(), info.Version)
+	require.NotEmpty(t, info.GoVersion)
+	require.Len(t, info.Hash, 64)
+	require.Equal(t, info, buildinfo.NewAPI().BuildInfo())
+}
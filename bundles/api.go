@@ -0,0 +1,105 @@
+package bundles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/polymerdao/monomer/jwtauth"
+)
+
+// Namespace is the JSON-RPC namespace of the builder API.
+const Namespace = "builder"
+
+// SubmitBundleArgs are the arguments of builder_submitBundle.
+type SubmitBundleArgs struct {
+	// BlockNumber is the height of the block the bundle is for.
+	BlockNumber hexutil.Uint64  `json:"blockNumber"`
+	Txs         []hexutil.Bytes `json:"txs"`
+}
+
+// API serves the builder namespace. Requests must be authenticated by NewHandler.
+type API struct {
+	market *Market
+}
+
+func NewAPI(market *Market) *API {
+	return &API{
+		market: market,
+	}
+}
+
+// SubmitBundle submits a bundle for a block and returns its hash.
+func (a *API) SubmitBundle(ctx context.Context, args SubmitBundleArgs) (common.Hash, error) {
+	builder, ok := ctx.Value(builderContextKey{}).(string)
+	if !ok {
+		return common.Hash{}, errors.New("unauthenticated")
+	}
+	bundle := &Bundle{
+		Builder: builder,
+		Height:  uint64(args.BlockNumber),
+		Txs:     make(bfttypes.Txs, 0, len(args.Txs)),
+	}
+	for _, tx := range args.Txs {
+		bundle.Txs = append(bundle.Txs, bfttypes.Tx(tx))
+	}
+	if err := a.market.Submit(ctx, bundle); err != nil {
+		return common.Hash{}, err
+	}
+	return bundle.Hash(), nil
+}
+
+type builderContextKey struct{}
+
+// NewHandler serves the builder namespace over HTTP to the builders with the given JWT secrets, keyed by name.
+// Builders authenticate like op-node does with the Engine API: every request carries an HS256 token, signed with the
+// builder's secret, in its Authorization header. The token's id claim is the builder's name and its iat claim must be
+// within a minute of the current time. The secrets are checked with jwtauth, so a builder's previous secret is accepted
+// during the rotation window after its secret file changes.
+func NewHandler(market *Market, secrets map[string]*jwtauth.Secrets) (http.Handler, error) {
+	if len(secrets) == 0 {
+		return nil, errors.New("no builder secrets")
+	}
+	server := rpc.NewServer()
+	if err := server.RegisterName(Namespace, NewAPI(market)); err != nil {
+		return nil, fmt.Errorf("register %s API: %v", Namespace, err)
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		builder, err := authenticate(r, secrets)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		server.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), builderContextKey{}, builder)))
+	}), nil
+}
+
+// authenticate returns the name of the builder that signed the request's token.
+func authenticate(r *http.Request, secrets map[string]*jwtauth.Secrets) (string, error) {
+	authorization := r.Header.Get("Authorization")
+	tokenString, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return "", errors.New("missing token")
+	}
+	// The id claim only chooses the secrets; the token is verified with them.
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenString, claims); err != nil {
+		return "", fmt.Errorf("invalid token: %v", err)
+	}
+	builder, _ := claims["id"].(string)
+	builderSecrets, ok := secrets[builder]
+	if !ok {
+		return "", fmt.Errorf("unknown builder %q", builder)
+	}
+	if err := builderSecrets.Authenticate(authorization); err != nil {
+		return "", err
+	}
+	return builder, nil
+}
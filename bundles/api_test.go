@@ -0,0 +1,117 @@
+package bundles_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/polymerdao/monomer/bundles"
+	"github.com/polymerdao/monomer/jwtauth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	aliceSecrets, err := jwtauth.NewSecrets(&jwtauth.Config{Secret: secret})
+	require.NoError(t, err)
+	market := newMarket(bundles.FirstSubmitted{}, bundles.NewNoopMetrics())
+	handler, err := bundles.NewHandler(market, map[string]*jwtauth.Secrets{"alice": aliceSecrets})
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tx := newTx(t, 1, "")
+	submit := func(token string) (common.Hash, error) {
+		client, err := rpc.DialOptions(context.Background(), server.URL, rpc.WithHeader("Authorization", "Bearer "+token))
+		require.NoError(t, err)
+		defer client.Close()
+		var hash common.Hash
+		err = client.Call(&hash, "builder_submitBundle", bundles.SubmitBundleArgs{
+			BlockNumber: 1,
+			Txs:         []hexutil.Bytes{hexutil.Bytes(tx)},
+		})
+		return hash, err
+	}
+	sign := func(method jwt.SigningMethod, claims jwt.MapClaims, key []byte) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		require.NoError(t, err)
+		return token
+	}
+
+	for name, token := range map[string]string{
+		"no token":       "",
+		"wrong secret":   sign(jwt.SigningMethodHS256, jwt.MapClaims{"id": "alice", "iat": time.Now().Unix()}, []byte("wrong")),
+		"unknown id":     sign(jwt.SigningMethodHS256, jwt.MapClaims{"id": "bob", "iat": time.Now().Unix()}, secret),
+		"no iat":         sign(jwt.SigningMethodHS256, jwt.MapClaims{"id": "alice"}, secret),
+		"stale iat":      sign(jwt.SigningMethodHS256, jwt.MapClaims{"id": "alice", "iat": time.Now().Add(-time.Hour).Unix()}, secret),
+		"future iat":     sign(jwt.SigningMethodHS256, jwt.MapClaims{"id": "alice", "iat": time.Now().Add(time.Hour).Unix()}, secret),
+		"wrong alg":      sign(jwt.SigningMethodHS512, jwt.MapClaims{"id": "alice", "iat": time.Now().Unix()}, secret),
+		"malformed":      "not a token",
+		"missing claims": sign(jwt.SigningMethodHS256, jwt.MapClaims{}, secret),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := submit(token)
+			require.ErrorContains(t, err, "401")
+			require.Empty(t, market.Pending(1))
+		})
+	}
+
+	hash, err := submit(sign(jwt.SigningMethodHS256, jwt.MapClaims{"id": "alice", "iat": time.Now().Unix()}, secret))
+	require.NoError(t, err)
+	want := &bundles.Bundle{
+		Builder: "alice",
+		Height:  1,
+		Txs:     bfttypes.Txs{tx},
+	}
+	require.Equal(t, want.Hash(), hash)
+	pending := market.Pending(1)
+	require.Len(t, pending, 1)
+	require.Equal(t, want.Builder, pending[0].Builder)
+	require.Equal(t, want.Txs, pending[0].Txs)
+}
+
+func TestHandlerRotatesSecrets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alice.hex")
+	oldSecret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	require.NoError(t, jwtauth.WriteSecretFile(path, oldSecret))
+	aliceSecrets, err := jwtauth.NewSecrets(&jwtauth.Config{Path: path})
+	require.NoError(t, err)
+	market := newMarket(bundles.FirstSubmitted{}, bundles.NewNoopMetrics())
+	handler, err := bundles.NewHandler(market, map[string]*jwtauth.Secrets{"alice": aliceSecrets})
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	submit := func(secret []byte, height uint64) error {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"id": "alice", "iat": time.Now().Unix()}).SignedString(secret)
+		require.NoError(t, err)
+		client, err := rpc.DialOptions(context.Background(), server.URL, rpc.WithHeader("Authorization", "Bearer "+token))
+		require.NoError(t, err)
+		defer client.Close()
+		var hash common.Hash
+		return client.Call(&hash, "builder_submitBundle", bundles.SubmitBundleArgs{
+			BlockNumber: hexutil.Uint64(height),
+			Txs:         []hexutil.Bytes{hexutil.Bytes(newTx(t, 1, ""))},
+		})
+	}
+
+	newSecret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	require.ErrorContains(t, submit(newSecret, 1), "401")
+
+	// After the secret file changes, both secrets are accepted during the rotation window.
+	require.NoError(t, jwtauth.WriteSecretFile(path, newSecret))
+	changed, err := aliceSecrets.Reload()
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.NoError(t, submit(newSecret, 1))
+	require.NoError(t, submit(oldSecret, 2))
+}
@@ -0,0 +1,221 @@
+// Package bundles lets external block builders submit bundles of txs for upcoming blocks. The sequencer chooses one
+// bundle per block with a configurable policy and includes it atomically at the top of the block, as a path towards
+// PBS-style markets for app rollups.
+package bundles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/builder"
+	"github.com/polymerdao/monomer/comet"
+	"github.com/polymerdao/monomer/mempool"
+)
+
+const (
+	// MaxBundleTxs is the maximum number of txs in a bundle.
+	MaxBundleTxs = 256
+	// MaxPendingPerBuilder is the maximum number of bundles a builder can have waiting for their blocks.
+	MaxPendingPerBuilder = 64
+)
+
+// Bundle is a list of txs a builder wants included, in order and all or nothing, at the top of a block.
+type Bundle struct {
+	// Builder is the name of the builder that submitted the bundle.
+	Builder string
+	// Height is the height of the block the bundle is for.
+	Height uint64
+	Txs    bfttypes.Txs
+	// Fee is the sum of the fees the txs pay. Submit sets it.
+	Fee sdk.Coins
+}
+
+// Hash identifies the bundle by its txs.
+func (b *Bundle) Hash() common.Hash {
+	hashes := make([][]byte, 0, len(b.Txs))
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash())
+	}
+	return crypto.Keccak256Hash(hashes...)
+}
+
+// Market collects the bundles builders submit and includes the one its policy chooses in each block built with the tx
+// pool. The bundle is included after the L1 deposits, as an atomic batch: if any of its txs fails, none are included.
+// Blocks derived from L1 are built without the tx pool, so the bundles for them are dropped.
+type Market struct {
+	txDecoder  sdk.TxDecoder
+	policy     Policy
+	metrics    Metrics
+	checkTxApp comet.AppMempool
+
+	mu sync.Mutex
+	// height is the height of the last block built.
+	height  uint64
+	pending map[uint64][]*Bundle
+	// chosen is the bundle chosen for the block being built.
+	chosen *Bundle
+}
+
+var _ builder.BatchInterceptor = (*Market)(nil)
+
+func New(txDecoder sdk.TxDecoder, policy Policy, metrics Metrics) *Market {
+	return &Market{
+		txDecoder: txDecoder,
+		policy:    policy,
+		metrics:   metrics,
+		pending:   make(map[uint64][]*Bundle),
+	}
+}
+
+// SetCheckTxApp sets the app whose CheckTx every bundle tx must pass, like the txs submitted to the mempool. The node
+// sets it to the app it checks mempool txs with, so bundles get the same address mapping checks and admission policy.
+// Submit rejects every bundle until it is set.
+func (m *Market) SetCheckTxApp(app comet.AppMempool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checkTxApp = app
+}
+
+// Submit validates the bundle and adds it to the bundles for its block. It replaces the bundle its builder submitted
+// for the same block, if any. Every tx must pass CheckTx; like mempool txs, they are executed when the block is built.
+func (m *Market) Submit(ctx context.Context, bundle *Bundle) error {
+	if err := m.validate(ctx, bundle); err != nil {
+		m.metrics.RecordRejected(bundle.Builder)
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if bundle.Height <= m.height {
+		m.metrics.RecordRejected(bundle.Builder)
+		return fmt.Errorf("block %d was already built", bundle.Height)
+	}
+	bundles := m.pending[bundle.Height]
+	if i := slices.IndexFunc(bundles, func(b *Bundle) bool {
+		return b.Builder == bundle.Builder
+	}); i != -1 {
+		bundles = slices.Delete(bundles, i, i+1)
+	} else if m.numPending(bundle.Builder) >= MaxPendingPerBuilder {
+		m.metrics.RecordRejected(bundle.Builder)
+		return fmt.Errorf("builder has %d pending bundles, the maximum", MaxPendingPerBuilder)
+	}
+	m.pending[bundle.Height] = append(bundles, bundle)
+	m.metrics.RecordSubmitted(bundle.Builder)
+	return nil
+}
+
+func (m *Market) validate(ctx context.Context, bundle *Bundle) error {
+	m.mu.Lock()
+	checkTxApp := m.checkTxApp
+	m.mu.Unlock()
+	if checkTxApp == nil {
+		return errors.New("bundles are not accepted until the node starts")
+	}
+
+	if len(bundle.Txs) == 0 {
+		return errors.New("empty bundle")
+	} else if len(bundle.Txs) > MaxBundleTxs {
+		return fmt.Errorf("bundle has %d txs, the maximum is %d", len(bundle.Txs), MaxBundleTxs)
+	}
+	bundle.Fee = sdk.NewCoins()
+	for i, tx := range bundle.Txs {
+		// Deposit txs can only be submitted on L1.
+		if _, err := monomer.GetDepositTxs([][]byte{tx}); err == nil {
+			return fmt.Errorf("tx %d is a deposit tx", i)
+		}
+		sdkTx, err := m.txDecoder(tx)
+		if err != nil {
+			return fmt.Errorf("decode tx %d: %v", i, err)
+		}
+		resp, err := checkTxApp.CheckTx(ctx, &abcitypes.RequestCheckTx{
+			Tx:   tx,
+			Type: abcitypes.CheckTxType_New,
+		})
+		if err != nil {
+			return fmt.Errorf("check tx %d: %v", i, err)
+		} else if !resp.IsOK() {
+			return fmt.Errorf("tx %d failed CheckTx with code %d (codespace %q): %s", i, resp.GetCode(), resp.GetCodespace(), resp.GetLog())
+		}
+		if feeTx, ok := sdkTx.(sdk.FeeTx); ok {
+			bundle.Fee = bundle.Fee.Add(feeTx.GetFee()...)
+		}
+	}
+	return nil
+}
+
+func (m *Market) numPending(builder string) int {
+	var n int
+	for _, bundles := range m.pending {
+		for _, bundle := range bundles {
+			if bundle.Builder == builder {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// Pending returns the bundles submitted for the block at height, in the order they were submitted.
+func (m *Market) Pending(height uint64) []*Bundle {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.pending[height])
+}
+
+// Intercept is never called, since the builder calls InterceptBatch instead.
+func (m *Market) Intercept(context.Context, uint64) (bfttypes.Txs, error) {
+	return nil, nil
+}
+
+// InterceptBatch returns the bundle the policy chooses for the block at height as an atomic batch, or nil if no bundle
+// was submitted for it or the policy chooses none.
+func (m *Market) InterceptBatch(_ context.Context, height uint64) (*mempool.Batch, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.chosen = nil
+	bundles := m.pending[height]
+	if len(bundles) == 0 {
+		return nil, nil
+	}
+	m.chosen = m.policy.Choose(slices.Clone(bundles))
+	if m.chosen == nil {
+		return nil, nil
+	}
+	return &mempool.Batch{
+		Txs:    slices.Clone(m.chosen.Txs),
+		Atomic: true,
+	}, nil
+}
+
+// OnBlock records whether the chosen bundle was included and drops the bundles for the block and the blocks before it.
+func (m *Market) OnBlock(_ context.Context, block *monomer.Block) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.chosen != nil && m.chosen.Height == block.Header.Height {
+		hash := m.chosen.Txs[0].Hash()
+		if slices.ContainsFunc(block.Txs, func(tx bfttypes.Tx) bool {
+			return string(tx.Hash()) == string(hash)
+		}) {
+			m.metrics.RecordIncluded(m.chosen.Builder)
+		} else {
+			m.metrics.RecordFailed(m.chosen.Builder)
+		}
+	}
+	m.chosen = nil
+	m.height = block.Header.Height
+	for height := range m.pending {
+		if height <= m.height {
+			delete(m.pending, height)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package bundles_test
+
+import (
+	"context"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/bundles"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+const denom = "stake"
+
+func newTxDecoder() sdk.TxDecoder {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	return authtx.DefaultTxDecoder(codec.NewProtoCodec(interfaceRegistry))
+}
+
+// newTx returns a bank send tx that pays fee in denom. The memo makes txs with the same fee distinct.
+func newTx(t *testing.T, fee int64, memo string) bfttypes.Tx {
+	msg, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh",
+		ToAddress:   "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh",
+	})
+	require.NoError(t, err)
+	txBytes, err := (&sdktx.Tx{
+		Body: &sdktx.TxBody{
+			Messages: []*codectypes.Any{msg},
+			Memo:     memo,
+		},
+		AuthInfo: &sdktx.AuthInfo{
+			Fee: &sdktx.Fee{
+				Amount: sdk.NewCoins(sdk.NewInt64Coin(denom, fee)),
+			},
+		},
+	}).Marshal()
+	require.NoError(t, err)
+	return txBytes
+}
+
+// checkTxApp rejects the txs in rejected.
+type checkTxApp struct {
+	rejected bfttypes.Txs
+}
+
+func (a *checkTxApp) CheckTx(_ context.Context, req *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error) {
+	if a.rejected.Index(req.Tx) != -1 {
+		return &abcitypes.ResponseCheckTx{Code: 1, Codespace: "test", Log: "rejected"}, nil
+	}
+	return &abcitypes.ResponseCheckTx{}, nil
+}
+
+func newMarket(policy bundles.Policy, metrics bundles.Metrics, rejected ...bfttypes.Tx) *bundles.Market {
+	market := bundles.New(newTxDecoder(), policy, metrics)
+	market.SetCheckTxApp(&checkTxApp{rejected: rejected})
+	return market
+}
+
+func newBlock(t *testing.T, height uint64, txs bfttypes.Txs) *monomer.Block {
+	return testutils.GenerateBlockWithParentAndTxs(t, &monomer.Header{Height: height - 1}, txs...)
+}
+
+func TestSubmit(t *testing.T) {
+	rejectedTx := newTx(t, 1, "rejected")
+	market := newMarket(bundles.FirstSubmitted{}, bundles.NewNoopMetrics(), rejectedTx)
+
+	for name, bundle := range map[string]*bundles.Bundle{
+		"empty": {
+			Builder: "a",
+			Height:  1,
+		},
+		"too many txs": {
+			Builder: "a",
+			Height:  1,
+			Txs:     make(bfttypes.Txs, bundles.MaxBundleTxs+1),
+		},
+		"deposit tx": {
+			Builder: "a",
+			Height:  1,
+			Txs:     bfttypes.Txs{testutils.GenerateBlock(t).Txs[0]},
+		},
+		"undecodable tx": {
+			Builder: "a",
+			Height:  1,
+			Txs:     bfttypes.Txs{bfttypes.Tx("not a cosmos tx")},
+		},
+		"tx fails CheckTx": {
+			Builder: "a",
+			Height:  1,
+			Txs:     bfttypes.Txs{newTx(t, 1, ""), rejectedTx},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, market.Submit(context.Background(), bundle))
+			require.Empty(t, market.Pending(1))
+		})
+	}
+
+	t.Run("no CheckTx app", func(t *testing.T) {
+		market := bundles.New(newTxDecoder(), bundles.FirstSubmitted{}, bundles.NewNoopMetrics())
+		require.Error(t, market.Submit(context.Background(), &bundles.Bundle{
+			Builder: "a",
+			Height:  1,
+			Txs:     bfttypes.Txs{newTx(t, 1, "")},
+		}))
+		require.Empty(t, market.Pending(1))
+	})
+
+	t.Run("fee", func(t *testing.T) {
+		bundle := &bundles.Bundle{
+			Builder: "a",
+			Height:  1,
+			Txs:     bfttypes.Txs{newTx(t, 1, ""), newTx(t, 2, "")},
+		}
+		require.NoError(t, market.Submit(context.Background(), bundle))
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 3)), bundle.Fee)
+	})
+
+	t.Run("replacement", func(t *testing.T) {
+		b := &bundles.Bundle{
+			Builder: "b",
+			Height:  1,
+			Txs:     bfttypes.Txs{newTx(t, 1, "b")},
+		}
+		require.NoError(t, market.Submit(context.Background(), b))
+		replacement := &bundles.Bundle{
+			Builder: "a",
+			Height:  1,
+			Txs:     bfttypes.Txs{newTx(t, 1, "replacement")},
+		}
+		require.NoError(t, market.Submit(context.Background(), replacement))
+		// The replacement goes to the back of the line.
+		require.Equal(t, []*bundles.Bundle{b, replacement}, market.Pending(1))
+	})
+
+	t.Run("too many pending bundles", func(t *testing.T) {
+		for height := uint64(2); height <= bundles.MaxPendingPerBuilder; height++ {
+			require.NoError(t, market.Submit(context.Background(), &bundles.Bundle{
+				Builder: "a",
+				Height:  height,
+				Txs:     bfttypes.Txs{newTx(t, 1, "")},
+			}))
+		}
+		require.ErrorContains(t, market.Submit(context.Background(), &bundles.Bundle{
+			Builder: "a",
+			Height:  bundles.MaxPendingPerBuilder + 1,
+			Txs:     bfttypes.Txs{newTx(t, 1, "")},
+		}), "pending bundles")
+		// Other builders aren't affected.
+		require.NoError(t, market.Submit(context.Background(), &bundles.Bundle{
+			Builder: "b",
+			Height:  bundles.MaxPendingPerBuilder + 1,
+			Txs:     bfttypes.Txs{newTx(t, 1, "")},
+		}))
+	})
+
+	t.Run("built block", func(t *testing.T) {
+		require.NoError(t, market.OnBlock(context.Background(), newBlock(t, 2, bfttypes.Txs{})))
+		require.Empty(t, market.Pending(1))
+		require.Empty(t, market.Pending(2))
+		require.Len(t, market.Pending(3), 1)
+		require.ErrorContains(t, market.Submit(context.Background(), &bundles.Bundle{
+			Builder: "a",
+			Height:  2,
+			Txs:     bfttypes.Txs{newTx(t, 1, "")},
+		}), "already built")
+	})
+}
+
+func TestInterceptBatch(t *testing.T) {
+	metrics := &countingMetrics{}
+	market := newMarket(bundles.HighestFee{Denom: denom}, metrics)
+
+	cheap := &bundles.Bundle{Builder: "a", Height: 1, Txs: bfttypes.Txs{newTx(t, 1, "a")}}
+	expensive := &bundles.Bundle{Builder: "b", Height: 1, Txs: bfttypes.Txs{newTx(t, 2, "b"), newTx(t, 2, "b")}}
+	alsoExpensive := &bundles.Bundle{Builder: "c", Height: 1, Txs: bfttypes.Txs{newTx(t, 4, "c")}}
+	for _, bundle := range []*bundles.Bundle{cheap, expensive, alsoExpensive} {
+		require.NoError(t, market.Submit(context.Background(), bundle))
+	}
+
+	// Ties go to the bundle submitted first.
+	batch, err := market.InterceptBatch(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, &mempool.Batch{Txs: expensive.Txs, Atomic: true}, batch)
+	require.NoError(t, market.OnBlock(context.Background(), newBlock(t, 1, expensive.Txs)))
+	require.Equal(t, map[string]int{"b": 1}, metrics.included)
+
+	// A chosen bundle that isn't in the block failed.
+	require.NoError(t, market.Submit(context.Background(), &bundles.Bundle{Builder: "a", Height: 2, Txs: bfttypes.Txs{newTx(t, 1, "a")}}))
+	batch, err = market.InterceptBatch(context.Background(), 2)
+	require.NoError(t, err)
+	require.NotNil(t, batch)
+	require.NoError(t, market.OnBlock(context.Background(), newBlock(t, 2, bfttypes.Txs{})))
+	require.Equal(t, map[string]int{"a": 1}, metrics.failed)
+
+	// No bundles were submitted for the block.
+	batch, err = market.InterceptBatch(context.Background(), 3)
+	require.NoError(t, err)
+	require.Nil(t, batch)
+}
+
+func TestNewPolicy(t *testing.T) {
+	policy, err := bundles.NewPolicy(bundles.PolicyFirstSubmitted, "")
+	require.NoError(t, err)
+	require.Equal(t, bundles.FirstSubmitted{}, policy)
+
+	policy, err = bundles.NewPolicy(bundles.PolicyHighestFee, denom)
+	require.NoError(t, err)
+	require.Equal(t, bundles.HighestFee{Denom: denom}, policy)
+
+	_, err = bundles.NewPolicy(bundles.PolicyHighestFee, "")
+	require.Error(t, err)
+	_, err = bundles.NewPolicy("unknown", "")
+	require.Error(t, err)
+}
+
+type countingMetrics struct {
+	included map[string]int
+	failed   map[string]int
+}
+
+func (*countingMetrics) RecordSubmitted(string) {}
+
+func (*countingMetrics) RecordRejected(string) {}
+
+func (m *countingMetrics) RecordIncluded(builder string) {
+	if m.included == nil {
+		m.included = make(map[string]int)
+	}
+	m.included[builder]++
+}
+
+func (m *countingMetrics) RecordFailed(builder string) {
+	if m.failed == nil {
+		m.failed = make(map[string]int)
+	}
+	m.failed[builder]++
+}
@@ -0,0 +1,74 @@
+package bundles
+
+import (
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "bundles"
+
+// Metrics contains metrics collected from the bundles package. All metrics are labeled by builder.
+type Metrics interface {
+	RecordSubmitted(builder string)
+	RecordRejected(builder string)
+	RecordIncluded(builder string)
+	RecordFailed(builder string)
+}
+
+type metrics struct {
+	// Number of bundles accepted by Submit.
+	Submitted *stdprometheus.CounterVec
+	// Number of bundles Submit rejected.
+	Rejected *stdprometheus.CounterVec
+	// Number of chosen bundles that were included.
+	Included *stdprometheus.CounterVec
+	// Number of chosen bundles that were dropped because a tx failed.
+	Failed *stdprometheus.CounterVec
+}
+
+func NewMetrics(namespace string) Metrics {
+	newCounterVec := func(name, help string) *stdprometheus.CounterVec {
+		return promauto.NewCounterVec(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      name,
+			Help:      help,
+		}, []string{"builder"})
+	}
+	return &metrics{
+		Submitted: newCounterVec("submitted", "Number of bundles accepted from builders"),
+		Rejected:  newCounterVec("rejected", "Number of bundles rejected on submission"),
+		Included:  newCounterVec("included", "Number of chosen bundles that were included"),
+		Failed:    newCounterVec("failed", "Number of chosen bundles that were dropped because a tx failed"),
+	}
+}
+
+func (m *metrics) RecordSubmitted(builder string) {
+	m.Submitted.WithLabelValues(builder).Inc()
+}
+
+func (m *metrics) RecordRejected(builder string) {
+	m.Rejected.WithLabelValues(builder).Inc()
+}
+
+func (m *metrics) RecordIncluded(builder string) {
+	m.Included.WithLabelValues(builder).Inc()
+}
+
+func (m *metrics) RecordFailed(builder string) {
+	m.Failed.WithLabelValues(builder).Inc()
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) RecordSubmitted(_ string) {}
+
+func (*noopMetrics) RecordRejected(_ string) {}
+
+func (*noopMetrics) RecordIncluded(_ string) {}
+
+func (*noopMetrics) RecordFailed(_ string) {}
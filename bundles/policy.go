@@ -0,0 +1,56 @@
+package bundles
+
+import (
+	"fmt"
+)
+
+const (
+	// PolicyFirstSubmitted is the name of the FirstSubmitted policy.
+	PolicyFirstSubmitted = "first-submitted"
+	// PolicyHighestFee is the name of the HighestFee policy.
+	PolicyHighestFee = "highest-fee"
+)
+
+// Policy chooses the bundle to include in a block.
+type Policy interface {
+	// Choose returns one of the bundles submitted for a block, which are in the order they were submitted, or nil to
+	// include none of them.
+	Choose(bundles []*Bundle) *Bundle
+}
+
+// FirstSubmitted chooses the bundle submitted first. A builder replacing its bundle moves to the back of the line.
+type FirstSubmitted struct{}
+
+func (FirstSubmitted) Choose(bundles []*Bundle) *Bundle {
+	return bundles[0]
+}
+
+// HighestFee chooses the bundle whose txs pay the most fees in Denom. Ties go to the bundle submitted first.
+type HighestFee struct {
+	Denom string
+}
+
+func (p HighestFee) Choose(bundles []*Bundle) *Bundle {
+	chosen := bundles[0]
+	for _, bundle := range bundles[1:] {
+		if bundle.Fee.AmountOf(p.Denom).GT(chosen.Fee.AmountOf(p.Denom)) {
+			chosen = bundle
+		}
+	}
+	return chosen
+}
+
+// NewPolicy returns the policy with the given name. denom is the fee denom of the highest-fee policy.
+func NewPolicy(name, denom string) (Policy, error) {
+	switch name {
+	case PolicyFirstSubmitted:
+		return FirstSubmitted{}, nil
+	case PolicyHighestFee:
+		if denom == "" {
+			return nil, fmt.Errorf("the %s policy requires a fee denom", PolicyHighestFee)
+		}
+		return HighestFee{Denom: denom}, nil
+	default:
+		return nil, fmt.Errorf("unknown bundle policy %q", name)
+	}
+}
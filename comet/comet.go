@@ -2,9 +2,11 @@ package comet
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
@@ -18,6 +20,8 @@ import (
 	"github.com/cometbft/cometbft/version"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/txforward"
 	"github.com/sourcegraph/conc"
 )
 
@@ -29,12 +33,18 @@ type AppABCI interface {
 }
 
 type ABCI struct {
-	app AppABCI
+	app          AppABCI
+	queryTimeout time.Duration
+	queryCache   *QueryCache
 }
 
-func NewABCI(app AppABCI) *ABCI {
+// NewABCI creates an ABCI that stops queries after queryTimeout. Zero means queries have no deadline.
+// A nil queryCache disables caching.
+func NewABCI(app AppABCI, queryTimeout time.Duration, queryCache *QueryCache) *ABCI {
 	return &ABCI{
-		app: app,
+		app:          app,
+		queryTimeout: queryTimeout,
+		queryCache:   queryCache,
 	}
 }
 
@@ -45,12 +55,20 @@ func (s *ABCI) Query(
 	height int64,
 	prove bool,
 ) (*rpctypes.ResultABCIQuery, error) {
-	resp, err := s.app.Query(ctx.Context(), &abcitypes.RequestQuery{
+	req := &abcitypes.RequestQuery{
 		Path:   path,
 		Data:   data,
 		Height: height,
 		Prove:  prove,
-	})
+	}
+	// The request's context is done when the client disconnects.
+	queryCtx := ctx.Context()
+	if s.queryTimeout > 0 {
+		var cancel context.CancelFunc
+		queryCtx, cancel = context.WithTimeout(queryCtx, s.queryTimeout)
+		defer cancel()
+	}
+	resp, err := s.queryCache.Query(queryCtx, req, s.app.Query)
 	if err != nil {
 		return nil, fmt.Errorf("query: %v", err)
 	}
@@ -129,6 +147,8 @@ type AppMempool interface {
 
 type Mempool interface {
 	Enqueue(userTxn bfttypes.Tx) error
+	EnqueueBatch(userBatch *mempool.Batch) error
+	Reject(rejection *mempool.Rejection) error
 }
 
 type BroadcastTxAPI struct {
@@ -155,8 +175,19 @@ func (s *BroadcastTxAPI) BroadcastTx(ctx *jsonrpctypes.Context, tx bfttypes.Tx)
 	}
 	if checkTxResp.IsOK() {
 		if err := s.mempool.Enqueue(tx); err != nil {
-			return nil, fmt.Errorf("enqueue in mempool: %v", err)
+			// A node that forwards txs responds with the sequencer's CheckTx result.
+			var rejectedErr *txforward.RejectedError
+			if !errors.As(err, &rejectedErr) {
+				return nil, fmt.Errorf("enqueue in mempool: %v", err)
+			}
+			checkTxResp = &abcitypes.ResponseCheckTx{
+				Code:      rejectedErr.Code,
+				Log:       rejectedErr.Log,
+				Codespace: rejectedErr.Codespace,
+			}
 		}
+	} else if err := s.reject(tx, checkTxResp); err != nil {
+		return nil, err
 	}
 	return &rpctypes.ResultBroadcastTx{
 		Code:      checkTxResp.GetCode(),
@@ -166,11 +197,98 @@ func (s *BroadcastTxAPI) BroadcastTx(ctx *jsonrpctypes.Context, tx bfttypes.Tx)
 	}, nil
 }
 
+// ResultBroadcastTxBatch is the result of broadcast_tx_batch.
+type ResultBroadcastTxBatch struct {
+	// Code, Log, and Codespace are the CheckTx result of the first tx that failed, in which case Index is its index.
+	Code      uint32 `json:"code"`
+	Log       string `json:"log"`
+	Codespace string `json:"codespace"`
+	Index     int    `json:"index"`
+	// Hashes are the hashes of the txs in the batch, up to the one that failed CheckTx, if any.
+	Hashes []bftbytes.HexBytes `json:"hashes"`
+}
+
+// BroadcastTxBatch adds txs to the mempool as a batch, which is included contiguously in the same block, in order.
+// If atomic is true, the batch is only included if all of its txs succeed. Otherwise, the txs are included even if some
+// fail, just like txs submitted on their own.
+//
+// The txs are checked in order. If any fails CheckTx, none of them are added to the mempool.
+func (s *BroadcastTxAPI) BroadcastTxBatch(ctx *jsonrpctypes.Context, txs []bfttypes.Tx, atomic bool) (*ResultBroadcastTxBatch, error) {
+	if len(txs) == 0 {
+		return nil, errors.New("empty batch")
+	}
+	result := &ResultBroadcastTxBatch{
+		Hashes: make([]bftbytes.HexBytes, 0, len(txs)),
+	}
+	for i, tx := range txs {
+		checkTxResp, err := s.app.CheckTx(ctx.Context(), &abcitypes.RequestCheckTx{
+			Tx:   tx,
+			Type: abcitypes.CheckTxType_New,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("check tx %d: %v", i, err)
+		}
+		result.Hashes = append(result.Hashes, tx.Hash())
+		if !checkTxResp.IsOK() {
+			if err := s.reject(tx, checkTxResp); err != nil {
+				return nil, err
+			}
+			result.Code = checkTxResp.GetCode()
+			result.Log = checkTxResp.GetLog()
+			result.Codespace = checkTxResp.GetCodespace()
+			result.Index = i
+			return result, nil
+		}
+	}
+	if err := s.mempool.EnqueueBatch(&mempool.Batch{
+		Txs:    txs,
+		Atomic: atomic,
+	}); err != nil {
+		var rejectedErr *txforward.RejectedError
+		if !errors.As(err, &rejectedErr) {
+			return nil, fmt.Errorf("enqueue in mempool: %v", err)
+		}
+		result.Code = rejectedErr.Code
+		result.Log = rejectedErr.Log
+		result.Codespace = rejectedErr.Codespace
+		result.Index = rejectedErr.Index
+		result.Hashes = result.Hashes[:rejectedErr.Index+1]
+	}
+	return result, nil
+}
+
+// reject records why the tx failed CheckTx, so the tx_status endpoint can explain why it was never included.
+func (s *BroadcastTxAPI) reject(tx bfttypes.Tx, checkTxResp *abcitypes.ResponseCheckTx) error {
+	if err := s.mempool.Reject(&mempool.Rejection{
+		Tx:        tx,
+		Reason:    mempool.ReasonCheckTxFailed,
+		Code:      checkTxResp.GetCode(),
+		Codespace: checkTxResp.GetCodespace(),
+		Log:       checkTxResp.GetLog(),
+	}); err != nil {
+		return fmt.Errorf("record rejection: %v", err)
+	}
+	return nil
+}
+
 type EventBus interface {
 	Subscribe(ctx context.Context, subscriber string, query bftpubsub.Query, outCapacity ...int) (bfttypes.Subscription, error)
 	Unsubscribe(ctx context.Context, subscriber string, query bftpubsub.Query) error
 	UnsubscribeAll(ctx context.Context, subscriber string) error
-}
+	NumClients() int
+	NumClientSubscriptions(clientID string) int
+}
+
+// Subscription limits, matching CometBFT's defaults.
+const (
+	// MaxSubscriptionClients is the maximum number of websocket clients with subscriptions.
+	MaxSubscriptionClients = 100
+	// MaxSubscriptionsPerClient is the maximum number of queries a websocket client can subscribe to.
+	MaxSubscriptionsPerClient = 5
+	// SubscriptionBufferSize is the number of events buffered for a subscription before it is canceled for being too
+	// slow. A block with many txs publishes an event per tx at once.
+	SubscriptionBufferSize = 200
+)
 
 type SubscribeEventListener interface {
 	// err will never be nil.
@@ -193,12 +311,20 @@ func NewSubscriberAPI(eventBus EventBus, wg *conc.WaitGroup, eventListener Subsc
 	}
 }
 
-// Subscribe to events via websocket.
+// Subscribe to events via websocket, e.g., with the query "tm.event = 'Tx' AND message.sender = 'addr'".
+// More: https://docs.cometbft.com/main/rpc/#/Websocket/subscribe
 func (s *SubscriberAPI) Subscribe(ctx *jsonrpctypes.Context, query string) (*rpctypes.ResultSubscribe, error) {
 	parsedQuery, err := bftquery.New(query)
 	if err != nil {
 		return nil, fmt.Errorf("parse query: %w", err)
 	}
+	addr := ctx.RemoteAddr()
+	if s.eventBus.NumClients() >= MaxSubscriptionClients && s.eventBus.NumClientSubscriptions(addr) == 0 {
+		return nil, fmt.Errorf("max number of clients reached: %d", MaxSubscriptionClients)
+	}
+	if s.eventBus.NumClientSubscriptions(addr) >= MaxSubscriptionsPerClient {
+		return nil, fmt.Errorf("max number of subscriptions per client reached: %d", MaxSubscriptionsPerClient)
+	}
 
 	// From CometBFT:
 	//   The timeout is the maximum time we wait to subscribe for an event.
@@ -206,7 +332,7 @@ func (s *SubscriberAPI) Subscribe(ctx *jsonrpctypes.Context, query string) (*rpc
 	subCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second) //nolint:mnd
 	defer cancel()
 
-	sub, err := s.eventBus.Subscribe(subCtx, ctx.RemoteAddr(), parsedQuery)
+	sub, err := s.eventBus.Subscribe(subCtx, addr, parsedQuery, SubscriptionBufferSize)
 	if err != nil {
 		return nil, fmt.Errorf("subscribe to event bus: %w", err)
 	}
@@ -230,6 +356,10 @@ func (s *SubscriberAPI) Subscribe(ctx *jsonrpctypes.Context, query string) (*rpc
 					err := fmt.Errorf("subscription was canceled (reason: %v)", writeErr)
 					resp = jsonrpctypes.RPCServerError(subscriptionID, err)
 					ctx.WSConn.TryWriteRPCResponse(resp)
+					// Stop buffering events for a client that can't receive them.
+					if unsubErr := s.eventBus.Unsubscribe(context.Background(), addr, parsedQuery); unsubErr != nil {
+						err = errors.Join(err, fmt.Errorf("unsubscribe: %v", unsubErr))
+					}
 					s.eventListener.OnSubscriptionWriteErr(err)
 					return
 				}
@@ -280,6 +410,14 @@ func (s *SubscriberAPI) UnsubscribeAll(ctx *jsonrpctypes.Context) (*rpctypes.Res
 	return &rpctypes.ResultUnsubscribe{}, nil
 }
 
+// Disconnect unsubscribes a websocket client from all events after its connection closes.
+func (s *SubscriberAPI) Disconnect(remoteAddr string) {
+	// The client may not have subscribed to anything.
+	if err := s.eventBus.UnsubscribeAll(context.Background(), remoteAddr); err != nil && !errors.Is(err, bftpubsub.ErrSubscriptionNotFound) {
+		s.eventListener.OnSubscriptionCanceled(fmt.Errorf("unsubscribe disconnected client: %v", err))
+	}
+}
+
 type TxStore interface {
 	Get(hash []byte) (*abcitypes.TxResult, error)
 	Search(ctx context.Context, q *bftquery.Query) ([]*abcitypes.TxResult, error)
@@ -297,6 +435,8 @@ func NewTxAPI(txStore TxStore) *TxAPI {
 
 // https://docs.cometbft.com/main/rpc/#/Tx/tx
 // NOTE: arg `hash` should be a hex string without 0x prefix
+// The hash may be the canonical hash (see monomer.TxHash) or the hash of the tx in the eth namespace.
+// The result always contains the canonical hash.
 func (s *TxAPI) ByHash(_ *jsonrpctypes.Context, hash []byte, prove bool) (*rpctypes.ResultTx, error) {
 	if prove {
 		return nil, errProvingNotSupported
@@ -310,7 +450,7 @@ func (s *TxAPI) ByHash(_ *jsonrpctypes.Context, hash []byte, prove bool) (*rpcty
 		return nil, fmt.Errorf("tx not found: %x", hash)
 	}
 	return &rpctypes.ResultTx{
-		Hash:     hash,
+		Hash:     bfttypes.Tx(r.Tx).Hash(),
 		Height:   r.Height,
 		Index:    r.Index,
 		TxResult: r.Result,
@@ -478,3 +618,194 @@ func rpcBlock(block *bfttypes.Block) *rpctypes.ResultBlock {
 		Block: block,
 	}
 }
+
+// Tx statuses returned by tx_status and tx_statuses.
+const (
+	// TxStatusIncluded txs are in a block. They may have failed to execute.
+	TxStatusIncluded = "included"
+	// TxStatusPending txs are in the mempool, waiting for the next block that includes mempool txs.
+	TxStatusPending = "pending"
+	// TxStatusRejected txs failed CheckTx when they were submitted, or were dropped with a failed atomic batch.
+	TxStatusRejected = "rejected"
+	// TxStatusUnknown txs never reached this node.
+	TxStatusUnknown = "unknown"
+)
+
+// ResultTxStatus is the result of tx_status.
+type ResultTxStatus struct {
+	Hash   bftbytes.HexBytes `json:"hash"`
+	Status string            `json:"status"`
+	// Tx is set unless the status is TxStatusUnknown.
+	Tx bfttypes.Tx `json:"tx,omitempty"`
+	// Height and Index locate an included tx.
+	Height int64  `json:"height,omitempty"`
+	Index  uint32 `json:"index,omitempty"`
+	// Reason is why a rejected tx was rejected.
+	Reason mempool.Reason `json:"reason,omitempty"`
+	// Code, Codespace, and Log are the execution result of an included tx or the reason a rejected tx was rejected.
+	Code      uint32 `json:"code,omitempty"`
+	Codespace string `json:"codespace,omitempty"`
+	Log       string `json:"log,omitempty"`
+	// RejectedAt is when a rejected tx was rejected.
+	RejectedAt *time.Time `json:"rejected_at,omitempty"`
+}
+
+type TxStatusMempool interface {
+	Get(hash []byte) (bfttypes.Tx, error)
+	Rejection(hash []byte) (*mempool.Rejection, error)
+	Rejections(limit int, before uint64) ([]*mempool.Rejection, error)
+}
+
+type TxStatusAPI struct {
+	txstore TxStore
+	mempool TxStatusMempool
+}
+
+func NewTxStatusAPI(txStore TxStore, mempool TxStatusMempool) *TxStatusAPI {
+	return &TxStatusAPI{
+		txstore: txStore,
+		mempool: mempool,
+	}
+}
+
+// TxStatus reports whether a tx was included, is pending, or was rejected, and why.
+// NOTE: arg `hash` should be a hex string without 0x prefix.
+// Included txs may be looked up by either hash (see monomer.TxHash); others only by the canonical hash.
+func (s *TxStatusAPI) TxStatus(_ *jsonrpctypes.Context, hash []byte) (*ResultTxStatus, error) {
+	return s.txStatus(hash)
+}
+
+// maxTxStatusesHashes is the number of hashes tx_statuses accepts.
+const maxTxStatusesHashes = 1000
+
+// ResultTxStatuses is the result of tx_statuses.
+type ResultTxStatuses struct {
+	// Txs are the statuses of the hashes, in the same order.
+	Txs []*ResultTxStatus `json:"txs"`
+}
+
+// TxStatuses reports the status of up to 1000 txs in one call, like TxStatus. The hashes are hex strings with or
+// without a 0x prefix, so Ethereum tx hashes can be passed as they are.
+func (s *TxStatusAPI) TxStatuses(_ *jsonrpctypes.Context, hashes []string) (*ResultTxStatuses, error) {
+	if len(hashes) == 0 {
+		return nil, errors.New("no hashes")
+	} else if len(hashes) > maxTxStatusesHashes {
+		return nil, fmt.Errorf("%d hashes exceed the limit of %d", len(hashes), maxTxStatusesHashes)
+	}
+	result := &ResultTxStatuses{
+		Txs: make([]*ResultTxStatus, 0, len(hashes)),
+	}
+	for i, hexHash := range hashes {
+		hash, err := hex.DecodeString(strings.TrimPrefix(hexHash, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("decode hash %d: %v", i, err)
+		}
+		status, err := s.txStatus(hash)
+		if err != nil {
+			return nil, fmt.Errorf("get status of hash %d: %v", i, err)
+		}
+		result.Txs = append(result.Txs, status)
+	}
+	return result, nil
+}
+
+func (s *TxStatusAPI) txStatus(hash []byte) (*ResultTxStatus, error) {
+	if r, err := s.txstore.Get(hash); err != nil {
+		return nil, fmt.Errorf("get tx: %v", err)
+	} else if r != nil {
+		return &ResultTxStatus{
+			Hash:      bfttypes.Tx(r.Tx).Hash(),
+			Status:    TxStatusIncluded,
+			Tx:        r.Tx,
+			Height:    r.Height,
+			Index:     r.Index,
+			Code:      r.Result.Code,
+			Codespace: r.Result.Codespace,
+			Log:       r.Result.Log,
+		}, nil
+	}
+
+	// A tx may be resubmitted after it was rejected, so check the mempool first.
+	if tx, err := s.mempool.Get(hash); err != nil {
+		return nil, fmt.Errorf("get mempool tx: %v", err)
+	} else if tx != nil {
+		return &ResultTxStatus{
+			Hash:   hash,
+			Status: TxStatusPending,
+			Tx:     tx,
+		}, nil
+	}
+
+	if rejection, err := s.mempool.Rejection(hash); err != nil {
+		return nil, fmt.Errorf("get rejection: %v", err)
+	} else if rejection != nil {
+		return &ResultTxStatus{
+			Hash:       hash,
+			Status:     TxStatusRejected,
+			Tx:         rejection.Tx,
+			Reason:     rejection.Reason,
+			Code:       rejection.Code,
+			Codespace:  rejection.Codespace,
+			Log:        rejection.Log,
+			RejectedAt: &rejection.Time,
+		}, nil
+	}
+	return &ResultTxStatus{
+		Hash:   hash,
+		Status: TxStatusUnknown,
+	}, nil
+}
+
+const (
+	defaultRejectedTxsLimit = 30
+	maxRejectedTxsLimit     = 100
+)
+
+// ResultRejectedTx is a rejected tx in the result of rejected_txs.
+type ResultRejectedTx struct {
+	// Seq orders rejections by when they were recorded. Pass the last Seq as before to get the next page.
+	Seq       uint64            `json:"seq"`
+	Hash      bftbytes.HexBytes `json:"hash"`
+	Tx        bfttypes.Tx       `json:"tx"`
+	Reason    mempool.Reason    `json:"reason"`
+	Code      uint32            `json:"code"`
+	Codespace string            `json:"codespace"`
+	Log       string            `json:"log"`
+	Time      time.Time         `json:"time"`
+}
+
+// ResultRejectedTxs is the result of rejected_txs.
+type ResultRejectedTxs struct {
+	Txs []*ResultRejectedTx `json:"txs"`
+}
+
+// RejectedTxs lists the most recently rejected txs, newest first. The node keeps a bounded number of rejections.
+// A limit of zero defaults to 30 txs, and limit is capped at 100. If before is nonzero, only txs with a lower Seq are
+// listed.
+func (s *TxStatusAPI) RejectedTxs(_ *jsonrpctypes.Context, limit int, before uint64) (*ResultRejectedTxs, error) {
+	if limit < 0 {
+		return nil, fmt.Errorf("negative limit %d", limit)
+	} else if limit == 0 {
+		limit = defaultRejectedTxsLimit
+	}
+	rejections, err := s.mempool.Rejections(min(limit, maxRejectedTxsLimit), before)
+	if err != nil {
+		return nil, fmt.Errorf("get rejections: %v", err)
+	}
+	result := &ResultRejectedTxs{
+		Txs: make([]*ResultRejectedTx, 0, len(rejections)),
+	}
+	for _, rejection := range rejections {
+		result.Txs = append(result.Txs, &ResultRejectedTx{
+			Seq:       rejection.Seq,
+			Hash:      rejection.Tx.Hash(),
+			Tx:        rejection.Tx,
+			Reason:    rejection.Reason,
+			Code:      rejection.Code,
+			Codespace: rejection.Codespace,
+			Log:       rejection.Log,
+			Time:      rejection.Time,
+		})
+	}
+	return result, nil
+}
@@ -2,18 +2,23 @@ package comet_test
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"testing"
 	"time"
 
+	"cosmossdk.io/store/rootmulti"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	bftbytes "github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/p2p"
 	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	jsonrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	bfttypes "github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/polymerdao/monomer"
 	"github.com/polymerdao/monomer/app/peptide/txstore"
 	"github.com/polymerdao/monomer/comet"
@@ -21,6 +26,7 @@ import (
 	"github.com/polymerdao/monomer/testapp"
 	testmoduletypes "github.com/polymerdao/monomer/testapp/x/testmodule/types"
 	"github.com/polymerdao/monomer/testutils"
+	"github.com/polymerdao/monomer/txforward"
 	"github.com/sourcegraph/conc"
 	"github.com/stretchr/testify/require"
 )
@@ -52,7 +58,7 @@ func TestABCI(t *testing.T) {
 	_, err = app.Commit(context.Background(), &abcitypes.RequestCommit{})
 	require.NoError(t, err)
 
-	abci := comet.NewABCI(app)
+	abci := comet.NewABCI(app, 0, nil)
 
 	// Info.
 	infoResult, err := abci.Info(&jsonrpctypes.Context{})
@@ -71,6 +77,22 @@ func TestABCI(t *testing.T) {
 	require.Equal(t, v, val.GetValue())
 }
 
+// blockingQueryApp blocks queries until their context is done.
+type blockingQueryApp struct {
+	comet.AppABCI
+}
+
+func (blockingQueryApp) Query(ctx context.Context, _ *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestABCIQueryTimeout(t *testing.T) {
+	abci := comet.NewABCI(blockingQueryApp{}, 10*time.Millisecond, nil)
+	_, err := abci.Query(&jsonrpctypes.Context{}, "/path", nil, 0, false)
+	require.ErrorContains(t, err, context.DeadlineExceeded.Error())
+}
+
 func TestStatus(t *testing.T) {
 	blockStore := testutils.NewLocalMemDB(t)
 	headBlock, err := monomer.MakeBlock(&monomer.Header{}, bfttypes.Txs{})
@@ -150,6 +172,87 @@ func TestBroadcastTx(t *testing.T) {
 	require.Equal(t, startLen, endLen)
 }
 
+func TestBroadcastTxBatch(t *testing.T) {
+	chainID := "0"
+	app := testapp.NewTest(t, chainID)
+	mpool := mempool.New(testutils.NewMemDB(t))
+	broadcastTxAPI := comet.NewBroadcastTxAPI(app, mpool)
+
+	// Success case.
+	txs := bfttypes.Txs{testapp.ToTestTx(t, "k1", "v1"), testapp.ToTestTx(t, "k2", "v2")}
+	result, err := broadcastTxAPI.BroadcastTxBatch(&jsonrpctypes.Context{}, txs, true)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), result.Code)
+	require.Equal(t, []bftbytes.HexBytes{txs[0].Hash(), txs[1].Hash()}, result.Hashes)
+	got, err := mpool.DequeueBatch()
+	require.NoError(t, err)
+	require.Equal(t, &mempool.Batch{Txs: txs, Atomic: true}, got)
+
+	// Error case - malformed tx in the middle of the batch.
+	badTxs := bfttypes.Txs{testapp.ToTestTx(t, "k3", "v3"), []byte{1, 2, 3}, testapp.ToTestTx(t, "k4", "v4")}
+	result, err = broadcastTxAPI.BroadcastTxBatch(&jsonrpctypes.Context{}, badTxs, false)
+	require.NoError(t, err)
+	require.NotEqual(t, uint32(0), result.Code)
+	require.Equal(t, 1, result.Index)
+	// assert no insertion to mempool
+	gotLen, err := mpool.Len()
+	require.NoError(t, err)
+	require.Zero(t, gotLen)
+
+	// Error case - empty batch.
+	_, err = broadcastTxAPI.BroadcastTxBatch(&jsonrpctypes.Context{}, nil, false)
+	require.Error(t, err)
+}
+
+// rejectingMempool rejects every tx like a sequencer a txforward.Forwarder forwards to.
+type rejectingMempool struct {
+	*mempool.Pool
+	err *txforward.RejectedError
+}
+
+func (m *rejectingMempool) Enqueue(bfttypes.Tx) error {
+	return m.err
+}
+
+func (m *rejectingMempool) EnqueueBatch(*mempool.Batch) error {
+	return m.err
+}
+
+func TestBroadcastTxSequencerRejected(t *testing.T) {
+	app := testapp.NewTest(t, "0")
+	rejectedErr := &txforward.RejectedError{
+		Index:     1,
+		Code:      5,
+		Codespace: "sdk",
+		Log:       "insufficient fee",
+	}
+	broadcastTxAPI := comet.NewBroadcastTxAPI(app, &rejectingMempool{
+		Pool: mempool.New(testutils.NewMemDB(t)),
+		err:  rejectedErr,
+	})
+
+	tx := bfttypes.Tx(testapp.ToTestTx(t, "k1", "v1"))
+	result, err := broadcastTxAPI.BroadcastTx(&jsonrpctypes.Context{}, tx)
+	require.NoError(t, err)
+	require.Equal(t, &rpctypes.ResultBroadcastTx{
+		Code:      rejectedErr.Code,
+		Codespace: rejectedErr.Codespace,
+		Log:       rejectedErr.Log,
+		Hash:      tx.Hash(),
+	}, result)
+
+	txs := bfttypes.Txs{tx, testapp.ToTestTx(t, "k2", "v2"), testapp.ToTestTx(t, "k3", "v3")}
+	batchResult, err := broadcastTxAPI.BroadcastTxBatch(&jsonrpctypes.Context{}, txs, false)
+	require.NoError(t, err)
+	require.Equal(t, &comet.ResultBroadcastTxBatch{
+		Code:      rejectedErr.Code,
+		Codespace: rejectedErr.Codespace,
+		Log:       rejectedErr.Log,
+		Index:     1,
+		Hashes:    []bftbytes.HexBytes{txs[0].Hash(), txs[1].Hash()},
+	}, batchResult)
+}
+
 type mockWSConnection struct {
 	ctx    context.Context
 	t      *testing.T
@@ -286,6 +389,46 @@ func TestSubscribeUnsubscribe(t *testing.T) {
 	require.Equal(t, &rpctypes.ResultUnsubscribe{}, resultUnsubscribe)
 }
 
+func TestSubscribeLimits(t *testing.T) {
+	bus := bfttypes.NewEventBus()
+	require.NoError(t, bus.Start())
+	defer func() {
+		require.NoError(t, bus.Stop())
+	}()
+	wg := conc.NewWaitGroup()
+	defer wg.Wait()
+	subscribeAPI := comet.NewSubscriberAPI(bus, wg, &comet.SelectiveListener{
+		OnSubscriptionCanceledCb: func(err error) {
+			require.NoError(t, err)
+		},
+	})
+
+	wsConn := newMockWSConnection(t, nil)
+	subscribe := func(i int) error {
+		_, err := subscribeAPI.Subscribe(&jsonrpctypes.Context{
+			JSONReq: &jsonrpctypes.RPCRequest{
+				JSONRPC: "2.0",
+				ID:      jsonrpctypes.JSONRPCIntID(i),
+				Method:  "subscribe",
+			},
+			WSConn: wsConn,
+		}, fmt.Sprintf("tx.height = %d", i))
+		return err
+	}
+	for i := range comet.MaxSubscriptionsPerClient {
+		require.NoError(t, subscribe(i))
+	}
+	require.ErrorContains(t, subscribe(comet.MaxSubscriptionsPerClient), "max number of subscriptions per client")
+
+	// Disconnecting frees the client's subscriptions.
+	subscribeAPI.Disconnect(wsConn.GetRemoteAddr())
+	require.Zero(t, bus.NumClientSubscriptions(wsConn.GetRemoteAddr()))
+	require.NoError(t, subscribe(0))
+	subscribeAPI.Disconnect(wsConn.GetRemoteAddr())
+	// Disconnecting a client without subscriptions is a no-op.
+	subscribeAPI.Disconnect(wsConn.GetRemoteAddr())
+}
+
 func TestTx(t *testing.T) {
 	txStore := txstore.NewTxStore(testutils.NewCometMemDB(t))
 	txAPI := comet.NewTxAPI(txStore)
@@ -315,6 +458,26 @@ func TestTx(t *testing.T) {
 	require.Equal(t, txResult1.Height, resultTx.Height)
 	require.Equal(t, txResult1.Index, resultTx.Index)
 
+	// Txs can also be looked up by the hash of their Ethereum representation, but results contain the canonical hash.
+	ethTxHash := monomer.AdaptNonDepositCosmosTxToEthTx(txResult1.GetTx()).Hash()
+	require.NoError(t, txStore.AddEthTxHashes(map[common.Hash]common.Hash{
+		ethTxHash: monomer.TxHash(txResult1.GetTx()),
+	}))
+	resultTx, err = txAPI.ByHash(&jsonrpctypes.Context{}, ethTxHash.Bytes(), false)
+	require.NoError(t, err)
+	require.Equal(t, txResult1.Tx, []byte(resultTx.Tx))
+	require.Equal(t, bfttypes.Tx(txResult1.GetTx()).Hash(), []byte(resultTx.Hash))
+	for _, hash := range [][]byte{ethTxHash.Bytes(), bfttypes.Tx(txResult1.GetTx()).Hash()} {
+		searchResult, err := txAPI.Search(&jsonrpctypes.Context{
+			WSConn: wsConn,
+		}, fmt.Sprintf("tx.hash = '%X'", hash), false, nil, nil, "")
+		require.NoError(t, err)
+		require.Len(t, searchResult.Txs, 1)
+		require.Equal(t, txResult1.Tx, []byte(searchResult.Txs[0].Tx))
+	}
+	_, err = txAPI.ByHash(&jsonrpctypes.Context{}, common.HexToHash("0x1").Bytes(), false)
+	require.ErrorContains(t, err, "tx not found")
+
 	// Ascending is the default, so the empty string also works.
 	for _, orderAscending := range []string{"asc", ""} {
 		searchResult, err := txAPI.Search(&jsonrpctypes.Context{
@@ -335,6 +498,122 @@ func TestTx(t *testing.T) {
 	require.Equal(t, txResult1.Tx, []byte(searchResult.Txs[1].Tx))
 }
 
+func TestTxStatus(t *testing.T) {
+	app := testapp.NewTest(t, "0")
+	mpool := mempool.New(testutils.NewMemDB(t))
+	broadcastTxAPI := comet.NewBroadcastTxAPI(app, mpool)
+	txStore := txstore.NewTxStore(testutils.NewCometMemDB(t))
+	txStatusAPI := comet.NewTxStatusAPI(txStore, mpool)
+	txStatus := func(tx bfttypes.Tx) *comet.ResultTxStatus {
+		result, err := txStatusAPI.TxStatus(&jsonrpctypes.Context{}, tx.Hash())
+		require.NoError(t, err)
+		require.Equal(t, tx.Hash(), result.Hash.Bytes())
+		return result
+	}
+
+	tx := bfttypes.Tx(testapp.ToTestTx(t, "k1", "v1"))
+	require.Equal(t, &comet.ResultTxStatus{
+		Hash:   tx.Hash(),
+		Status: comet.TxStatusUnknown,
+	}, txStatus(tx))
+
+	// Rejected by CheckTx.
+	badTx := bfttypes.Tx{1, 2, 3}
+	broadcastResult, err := broadcastTxAPI.BroadcastTx(&jsonrpctypes.Context{}, badTx)
+	require.NoError(t, err)
+	result := txStatus(badTx)
+	require.Equal(t, comet.TxStatusRejected, result.Status)
+	require.Equal(t, badTx, result.Tx)
+	require.Equal(t, broadcastResult.Code, result.Code)
+	require.Equal(t, broadcastResult.Codespace, result.Codespace)
+	require.Equal(t, broadcastResult.Log, result.Log)
+	require.Equal(t, mempool.ReasonCheckTxFailed, result.Reason)
+	require.NotNil(t, result.RejectedAt)
+
+	rejectedTxs, err := txStatusAPI.RejectedTxs(&jsonrpctypes.Context{}, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, rejectedTxs.Txs, 1)
+	rejectedTx := rejectedTxs.Txs[0]
+	require.Equal(t, badTx.Hash(), rejectedTx.Hash.Bytes())
+	require.Equal(t, mempool.ReasonCheckTxFailed, rejectedTx.Reason)
+	require.Equal(t, broadcastResult.Code, rejectedTx.Code)
+	require.Equal(t, *result.RejectedAt, rejectedTx.Time)
+	rejectedTxs, err = txStatusAPI.RejectedTxs(&jsonrpctypes.Context{}, 0, rejectedTx.Seq)
+	require.NoError(t, err)
+	require.Empty(t, rejectedTxs.Txs)
+	_, err = txStatusAPI.RejectedTxs(&jsonrpctypes.Context{}, -1, 0)
+	require.Error(t, err)
+
+	// Pending.
+	_, err = broadcastTxAPI.BroadcastTx(&jsonrpctypes.Context{}, tx)
+	require.NoError(t, err)
+	require.Equal(t, &comet.ResultTxStatus{
+		Hash:   tx.Hash(),
+		Status: comet.TxStatusPending,
+		Tx:     tx,
+	}, txStatus(tx))
+
+	// Included.
+	_, err = mpool.Dequeue()
+	require.NoError(t, err)
+	require.NoError(t, txStore.Add([]*abcitypes.TxResult{{
+		Height: 2,
+		Tx:     tx,
+		Result: abcitypes.ExecTxResult{Code: 5, Log: "failed"},
+	}}))
+	require.Equal(t, &comet.ResultTxStatus{
+		Hash:   tx.Hash(),
+		Status: comet.TxStatusIncluded,
+		Tx:     tx,
+		Height: 2,
+		Code:   5,
+		Log:    "failed",
+	}, txStatus(tx))
+}
+
+func TestTxStatuses(t *testing.T) {
+	mpool := mempool.New(testutils.NewMemDB(t))
+	txStore := txstore.NewTxStore(testutils.NewCometMemDB(t))
+	txStatusAPI := comet.NewTxStatusAPI(txStore, mpool)
+
+	includedTx := bfttypes.Tx(testapp.ToTestTx(t, "k1", "v1"))
+	require.NoError(t, txStore.Add([]*abcitypes.TxResult{{
+		Height: 2,
+		Tx:     includedTx,
+	}}))
+	pendingTx := bfttypes.Tx(testapp.ToTestTx(t, "k2", "v2"))
+	require.NoError(t, mpool.Enqueue(pendingTx))
+	unknownTx := bfttypes.Tx(testapp.ToTestTx(t, "k3", "v3"))
+
+	// Hashes are accepted with and without a 0x prefix, and the statuses are in the same order.
+	result, err := txStatusAPI.TxStatuses(&jsonrpctypes.Context{}, []string{
+		hex.EncodeToString(unknownTx.Hash()),
+		"0x" + hex.EncodeToString(includedTx.Hash()),
+		hex.EncodeToString(pendingTx.Hash()),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Txs, 3)
+	for i, want := range []struct {
+		tx     bfttypes.Tx
+		status string
+	}{
+		{unknownTx, comet.TxStatusUnknown},
+		{includedTx, comet.TxStatusIncluded},
+		{pendingTx, comet.TxStatusPending},
+	} {
+		require.Equal(t, want.tx.Hash(), result.Txs[i].Hash.Bytes())
+		require.Equal(t, want.status, result.Txs[i].Status)
+	}
+	require.Equal(t, int64(2), result.Txs[1].Height)
+
+	_, err = txStatusAPI.TxStatuses(&jsonrpctypes.Context{}, nil)
+	require.Error(t, err)
+	_, err = txStatusAPI.TxStatuses(&jsonrpctypes.Context{}, []string{"not hex"})
+	require.Error(t, err)
+	_, err = txStatusAPI.TxStatuses(&jsonrpctypes.Context{}, make([]string, 1001))
+	require.Error(t, err)
+}
+
 func TestBlock(t *testing.T) {
 	blockStore := testutils.NewLocalMemDB(t)
 	block, err := monomer.MakeBlock(&monomer.Header{
@@ -359,3 +638,184 @@ func TestBlock(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, want, resultBlock)
 }
+
+func TestABCIQueryProof(t *testing.T) {
+	chainID := "0"
+	app := testapp.NewTest(t, chainID)
+	_, err := app.InitChain(context.Background(), &abcitypes.RequestInitChain{
+		ChainId: chainID,
+		AppStateBytes: func() []byte {
+			appStateBytes, err := json.Marshal(testapp.MakeGenesisAppState(t, app))
+			require.NoError(t, err)
+			return appStateBytes
+		}(),
+	})
+	require.NoError(t, err)
+
+	k := "k"
+	values := []string{"v1", "v2", "v3"}
+	appHashes := make(map[int64][]byte, len(values))
+	for i, v := range values {
+		height := int64(i + 1)
+		_, err = app.FinalizeBlock(context.Background(), &abcitypes.RequestFinalizeBlock{
+			Txs:    [][]byte{testapp.ToTestTx(t, k, v)},
+			Height: height,
+		})
+		require.NoError(t, err)
+		_, err = app.Commit(context.Background(), &abcitypes.RequestCommit{})
+		require.NoError(t, err)
+		info, err := app.Info(context.Background(), &abcitypes.RequestInfo{})
+		require.NoError(t, err)
+		appHashes[height] = info.GetLastBlockAppHash()
+	}
+
+	abci := comet.NewABCI(app, 0, nil)
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte("testmodule"), merkle.KeyEncodingURL).
+		AppendKey([]byte(k), merkle.KeyEncodingURL).
+		String()
+	// The Cosmos SDK doesn't generate proofs for the first block.
+	for i, v := range values[1:] {
+		height := int64(i + 2)
+		queryResult, err := abci.Query(&jsonrpctypes.Context{}, "/store/testmodule/key", []byte(k), height, true)
+		require.NoError(t, err)
+		resp := queryResult.Response
+		require.True(t, resp.IsOK(), resp.GetLog())
+		require.Equal(t, height, resp.GetHeight())
+		require.Equal(t, v, string(resp.GetValue()))
+		require.NoError(t, rootmulti.DefaultProofRuntime().VerifyValue(resp.GetProofOps(), appHashes[height], keyPath, resp.GetValue()))
+		// The proof must not verify against the app hash at a different height.
+		require.Error(t, rootmulti.DefaultProofRuntime().VerifyValue(resp.GetProofOps(), appHashes[height-1], keyPath, resp.GetValue()))
+	}
+}
+
+// countingQueryApp responds to queries with their data and counts them. It fails queries for the path "fail".
+type countingQueryApp struct {
+	comet.AppABCI
+	queries int
+}
+
+func (a *countingQueryApp) Query(_ context.Context, req *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error) {
+	a.queries++
+	if req.Path == "fail" {
+		return &abcitypes.ResponseQuery{
+			Code: 1,
+		}, nil
+	}
+	return &abcitypes.ResponseQuery{
+		Value:  req.Data,
+		Height: req.Height,
+	}, nil
+}
+
+type queryCacheMetrics struct {
+	hits, misses int
+}
+
+func (m *queryCacheMetrics) RecordQueryCacheHit() {
+	m.hits++
+}
+
+func (m *queryCacheMetrics) RecordQueryCacheMiss() {
+	m.misses++
+}
+
+func TestQueryCache(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	appendBlock := func(height uint64) *monomer.Block {
+		block, err := monomer.MakeBlock(&monomer.Header{
+			Height: height,
+		}, bfttypes.Txs{})
+		require.NoError(t, err)
+		require.NoError(t, blockStore.AppendBlock(block))
+		return block
+	}
+	appendBlock(1)
+
+	app := &countingQueryApp{}
+	metrics := &queryCacheMetrics{}
+	abci := comet.NewABCI(app, 0, comet.NewQueryCache(blockStore, time.Minute, 10, metrics))
+	query := func(path string, data []byte, height int64) *abcitypes.ResponseQuery {
+		result, err := abci.Query(&jsonrpctypes.Context{}, path, data, height, false)
+		require.NoError(t, err)
+		return &result.Response
+	}
+
+	// Queries without a height query the head.
+	resp := query("/path", []byte{1}, 0)
+	require.Equal(t, int64(1), resp.Height)
+	require.Equal(t, resp, query("/path", []byte{1}, 0))
+	require.Equal(t, resp, query("/path", []byte{1}, 1))
+	require.Equal(t, 1, app.queries)
+	require.Equal(t, 2, metrics.hits)
+
+	// Different requests are cached separately.
+	query("/path", []byte{2}, 0)
+	query("/other", []byte{1}, 0)
+	require.Equal(t, 3, app.queries)
+
+	// A new head is a new block to query.
+	appendBlock(2)
+	require.Equal(t, int64(2), query("/path", []byte{1}, 0).Height)
+	require.Equal(t, 4, app.queries)
+	query("/path", []byte{1}, 1)
+	require.Equal(t, 4, app.queries)
+
+	// Failed queries and queries of unknown blocks are not cached.
+	query("fail", nil, 0)
+	query("fail", nil, 0)
+	query("/path", []byte{1}, 100)
+	query("/path", []byte{1}, 100)
+	require.Equal(t, 8, app.queries)
+}
+
+func TestQueryCacheTTL(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	block, err := monomer.MakeBlock(&monomer.Header{
+		Height: 1,
+	}, bfttypes.Txs{})
+	require.NoError(t, err)
+	require.NoError(t, blockStore.AppendBlock(block))
+
+	app := &countingQueryApp{}
+	abci := comet.NewABCI(app, 0, comet.NewQueryCache(blockStore, 0, 10, comet.NewNoopMetrics()))
+	for range 2 {
+		_, err := abci.Query(&jsonrpctypes.Context{}, "/path", nil, 0, false)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, app.queries)
+}
+
+func TestQueryCacheShared(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	block, err := monomer.MakeBlock(&monomer.Header{
+		Height: 1,
+	}, bfttypes.Txs{})
+	require.NoError(t, err)
+	require.NoError(t, blockStore.AppendBlock(block))
+
+	app := &countingQueryApp{}
+	metrics := &queryCacheMetrics{}
+	cache := comet.NewQueryCache(blockStore, time.Minute, 10, metrics)
+	abci := comet.NewABCI(app, 0, cache)
+	result, err := abci.Query(&jsonrpctypes.Context{}, "/path", []byte{1}, 1, false)
+	require.NoError(t, err)
+
+	// Other callers, like eth_call, are served the responses to abci_query requests and record hits.
+	resp, err := cache.Query(context.Background(), &abcitypes.RequestQuery{
+		Path:   "/path",
+		Data:   []byte{1},
+		Height: 1,
+	}, app.Query)
+	require.NoError(t, err)
+	require.Equal(t, &result.Response, resp)
+	require.Equal(t, 1, app.queries)
+	require.Equal(t, 1, metrics.hits)
+
+	// A nil cache runs every query.
+	for range 2 {
+		_, err := (*comet.QueryCache)(nil).Query(context.Background(), &abcitypes.RequestQuery{Path: "/path"}, app.Query)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, app.queries)
+}
@@ -0,0 +1,56 @@
+package comet
+
+import (
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "comet"
+
+// Metrics contains metrics collected from the comet package.
+type Metrics interface {
+	RecordQueryCacheHit()
+	RecordQueryCacheMiss()
+}
+
+type metrics struct {
+	// Number of abci_query and eth_call queries served from the cache.
+	QueryCacheHits stdprometheus.Counter
+	// Number of abci_query and eth_call queries that were not in the cache.
+	QueryCacheMisses stdprometheus.Counter
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		QueryCacheHits: promauto.NewCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "query_cache_hits",
+			Help:      "Number of abci_query and eth_call queries served from the cache",
+		}),
+		QueryCacheMisses: promauto.NewCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "query_cache_misses",
+			Help:      "Number of abci_query and eth_call queries that were not in the cache",
+		}),
+	}
+}
+
+func (m *metrics) RecordQueryCacheHit() {
+	m.QueryCacheHits.Inc()
+}
+
+func (m *metrics) RecordQueryCacheMiss() {
+	m.QueryCacheMisses.Inc()
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) RecordQueryCacheHit() {}
+
+func (*noopMetrics) RecordQueryCacheMiss() {}
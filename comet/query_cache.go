@@ -0,0 +1,110 @@
+package comet
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/polymerdao/monomer"
+)
+
+const (
+	// DefaultQueryCacheTTL is how long query responses are cached by default: about one block.
+	DefaultQueryCacheTTL = 2 * time.Second
+	// DefaultQueryCacheSize is the number of query responses cached by default.
+	DefaultQueryCacheSize = 1024
+)
+
+type QueryCacheBlockStore interface {
+	HeadHeader() (*monomer.Header, error)
+	HeaderByHeight(height uint64) (*monomer.Header, error)
+}
+
+type queryCacheEntry struct {
+	resp    *abcitypes.ResponseQuery
+	expires time.Time
+}
+
+// QueryCache caches abci_query and eth_call responses by the hash of the queried block and the request, because
+// frontends make the same queries many times per block. Only successful responses are cached.
+// Responses are shared, so callers must not modify them.
+type QueryCache struct {
+	blockStore QueryCacheBlockStore
+	ttl        time.Duration
+	metrics    Metrics
+	cache      *lru.Cache[string, *queryCacheEntry]
+}
+
+// NewQueryCache creates a QueryCache that caches up to size responses for ttl.
+func NewQueryCache(blockStore QueryCacheBlockStore, ttl time.Duration, size int, metrics Metrics) *QueryCache {
+	return &QueryCache{
+		blockStore: blockStore,
+		ttl:        ttl,
+		metrics:    metrics,
+		cache:      lru.NewCache[string, *queryCacheEntry](size),
+	}
+}
+
+// Query returns the cached response to req, or runs query and caches its response. A nil QueryCache runs every query.
+func (c *QueryCache) Query(
+	ctx context.Context,
+	req *abcitypes.RequestQuery,
+	query func(context.Context, *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error),
+) (*abcitypes.ResponseQuery, error) {
+	if c == nil {
+		return query(ctx, req)
+	}
+	key, cacheable := c.key(req)
+	if !cacheable {
+		return query(ctx, req)
+	}
+	if resp, ok := c.get(key); ok {
+		return resp, nil
+	}
+	resp, err := query(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	c.add(key, resp)
+	return resp, nil
+}
+
+// key returns the cache key of req. A request without a height queries the head, so key sets its height to the head's,
+// keeping the response consistent with the key when a block is added during the query.
+// It returns false if the queried block is not in the block store.
+func (c *QueryCache) key(req *abcitypes.RequestQuery) (string, bool) {
+	var header *monomer.Header
+	var err error
+	if req.Height == 0 {
+		header, err = c.blockStore.HeadHeader()
+	} else {
+		header, err = c.blockStore.HeaderByHeight(uint64(req.Height))
+	}
+	if err != nil {
+		return "", false
+	}
+	req.Height = int64(header.Height)
+	return fmt.Sprintf("%s/%s/%x/%t", header.Hash, req.Path, sha256.Sum256(req.Data), req.Prove), true
+}
+
+func (c *QueryCache) get(key string) (*abcitypes.ResponseQuery, bool) {
+	if cached, ok := c.cache.Get(key); ok && time.Now().Before(cached.expires) {
+		c.metrics.RecordQueryCacheHit()
+		return cached.resp, true
+	}
+	c.metrics.RecordQueryCacheMiss()
+	return nil, false
+}
+
+func (c *QueryCache) add(key string, resp *abcitypes.ResponseQuery) {
+	if resp.Code != abcitypes.CodeTypeOK {
+		return
+	}
+	c.cache.Add(key, &queryCacheEntry{
+		resp:    resp,
+		expires: time.Now().Add(c.ttl),
+	})
+}
@@ -0,0 +1,146 @@
+package comet
+
+import (
+	"errors"
+	"fmt"
+
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const (
+	defaultUnconfirmedTxsLimit = 30
+	maxUnconfirmedTxsLimit     = 100
+	// maxLocalUnconfirmedTxsLimit is higher, since peers sync all of a node's pending txs at once.
+	maxLocalUnconfirmedTxsLimit = 10_000
+)
+
+// PendingTxs lists pending txs, e.g., a mempool.Pool or a mempoolsync.View.
+type PendingTxs interface {
+	// Txs returns up to limit pending txs. A limit of zero or less returns all of them.
+	Txs(limit int) (bfttypes.Txs, error)
+}
+
+type UnconfirmedTxsAPI struct {
+	local     PendingTxs
+	all       PendingTxs
+	txDecoder sdk.TxDecoder
+}
+
+// NewUnconfirmedTxsAPI serves the txs pending in local, the node's mempool, and all, which also includes the txs pending
+// with the node's peers if mempool sync is enabled. pending_sequence requires txDecoder.
+func NewUnconfirmedTxsAPI(local, all PendingTxs, txDecoder sdk.TxDecoder) *UnconfirmedTxsAPI {
+	return &UnconfirmedTxsAPI{
+		local:     local,
+		all:       all,
+		txDecoder: txDecoder,
+	}
+}
+
+// UnconfirmedTxs lists the pending txs, including the ones pending with the node's peers. A limit of zero defaults to
+// 30 txs, and limit is capped at 100.
+// More: https://docs.cometbft.com/main/rpc/#/Info/unconfirmed_txs
+func (s *UnconfirmedTxsAPI) UnconfirmedTxs(_ *jsonrpctypes.Context, limit *int) (*rpctypes.ResultUnconfirmedTxs, error) {
+	return unconfirmedTxs(s.all, limit, maxUnconfirmedTxsLimit)
+}
+
+// LocalUnconfirmedTxs lists the txs in the node's own mempool, which mempool sync polls its peers for. A limit of zero
+// defaults to 30 txs, and limit is capped at 10000.
+func (s *UnconfirmedTxsAPI) LocalUnconfirmedTxs(_ *jsonrpctypes.Context, limit *int) (*rpctypes.ResultUnconfirmedTxs, error) {
+	return unconfirmedTxs(s.local, limit, maxLocalUnconfirmedTxsLimit)
+}
+
+// NumUnconfirmedTxs counts the pending txs, including the ones pending with the node's peers.
+// More: https://docs.cometbft.com/main/rpc/#/Info/num_unconfirmed_txs
+func (s *UnconfirmedTxsAPI) NumUnconfirmedTxs(_ *jsonrpctypes.Context) (*rpctypes.ResultUnconfirmedTxs, error) {
+	txs, err := s.all.Txs(0)
+	if err != nil {
+		return nil, fmt.Errorf("get pending txs: %v", err)
+	}
+	return &rpctypes.ResultUnconfirmedTxs{
+		Count:      len(txs),
+		Total:      len(txs),
+		TotalBytes: bfttypes.ComputeProtoSizeForTxs(txs),
+	}, nil
+}
+
+func unconfirmedTxs(pending PendingTxs, limitPtr *int, maxLimit int) (*rpctypes.ResultUnconfirmedTxs, error) {
+	limit := defaultUnconfirmedTxsLimit
+	if limitPtr != nil {
+		if *limitPtr < 0 {
+			return nil, fmt.Errorf("negative limit %d", *limitPtr)
+		} else if *limitPtr > 0 {
+			limit = min(*limitPtr, maxLimit)
+		}
+	}
+	txs, err := pending.Txs(0)
+	if err != nil {
+		return nil, fmt.Errorf("get pending txs: %v", err)
+	}
+	result := &rpctypes.ResultUnconfirmedTxs{
+		Total:      len(txs),
+		TotalBytes: bfttypes.ComputeProtoSizeForTxs(txs),
+		Txs:        txs[:min(limit, len(txs))],
+	}
+	result.Count = len(result.Txs)
+	return result, nil
+}
+
+// ResultPendingSequence is the result of pending_sequence.
+type ResultPendingSequence struct {
+	Address string `json:"address"`
+	// Pending is the number of pending txs the address signed.
+	Pending int `json:"pending"`
+	// NextSequence is one more than the highest sequence the address signed a pending tx with, i.e., the sequence to
+	// sign the address's next tx with. It is zero if the address has no pending txs, in which case the account's
+	// sequence on chain is the next one.
+	NextSequence uint64 `json:"next_sequence"`
+}
+
+// PendingSequence returns the sequence (nonce) the address's next tx must be signed with after its pending txs,
+// including the ones pending with the node's peers, are included.
+func (s *UnconfirmedTxsAPI) PendingSequence(_ *jsonrpctypes.Context, address string) (*ResultPendingSequence, error) {
+	if s.txDecoder == nil {
+		return nil, errors.New("pending sequences require an appchain ctx with a tx config")
+	}
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, fmt.Errorf("parse address: %v", err)
+	}
+	txs, err := s.all.Txs(0)
+	if err != nil {
+		return nil, fmt.Errorf("get pending txs: %v", err)
+	}
+	result := &ResultPendingSequence{
+		Address: addr.String(),
+	}
+	for _, txBytes := range txs {
+		// Txs that can't be decoded or verified can't have been signed by the address.
+		tx, err := s.txDecoder(txBytes)
+		if err != nil {
+			continue
+		}
+		sigTx, ok := tx.(authsigning.SigVerifiableTx)
+		if !ok {
+			continue
+		}
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			continue
+		}
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil || len(sigs) != len(signers) {
+			continue
+		}
+		for i, signer := range signers {
+			if addr.Equals(sdk.AccAddress(signer)) {
+				result.Pending++
+				result.NextSequence = max(result.NextSequence, sigs[i].Sequence+1)
+			}
+		}
+	}
+	return result, nil
+}
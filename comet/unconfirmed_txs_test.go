@@ -0,0 +1,98 @@
+package comet_test
+
+import (
+	"testing"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/polymerdao/monomer/comet"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/testapp"
+	testmoduletypes "github.com/polymerdao/monomer/testapp/x/testmodule/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTxDecoder() sdk.TxDecoder {
+	encodingCfg := moduletestutil.MakeTestEncodingConfig()
+	testmoduletypes.RegisterInterfaces(encodingCfg.InterfaceRegistry)
+	return encodingCfg.TxConfig.TxDecoder()
+}
+
+func TestUnconfirmedTxs(t *testing.T) {
+	local := mempool.New(dbm.NewMemDB())
+	all := mempool.New(dbm.NewMemDB())
+	require.NoError(t, local.Enqueue(bfttypes.Tx("local")))
+	for i := range 3 {
+		require.NoError(t, all.Enqueue(bfttypes.Tx{byte(i)}))
+	}
+	api := comet.NewUnconfirmedTxsAPI(local, all, nil)
+
+	result, err := api.UnconfirmedTxs(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, result.Count)
+	require.Equal(t, 3, result.Total)
+	require.Equal(t, []bfttypes.Tx{{0}, {1}, {2}}, result.Txs)
+
+	limit := 2
+	result, err = api.UnconfirmedTxs(nil, &limit)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Count)
+	require.Equal(t, 3, result.Total)
+	require.Equal(t, []bfttypes.Tx{{0}, {1}}, result.Txs)
+
+	limit = -1
+	_, err = api.UnconfirmedTxs(nil, &limit)
+	require.Error(t, err)
+
+	result, err = api.NumUnconfirmedTxs(nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, result.Total)
+	require.Empty(t, result.Txs)
+
+	result, err = api.LocalUnconfirmedTxs(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []bfttypes.Tx{bfttypes.Tx("local")}, result.Txs)
+}
+
+func TestPendingSequence(t *testing.T) {
+	pool := mempool.New(dbm.NewMemDB())
+	signer := testapp.GetAccount(0)
+	other := testapp.GetAccount(1)
+	msg := func(from sdk.AccAddress) *testmoduletypes.MsgSetValue {
+		return &testmoduletypes.MsgSetValue{
+			FromAddress: from.String(),
+			Key:         "k",
+			Value:       "v",
+		}
+	}
+	for _, tx := range [][]byte{
+		signer.SignTx(t, "chain", 0, 4, msg(signer.Address)),
+		other.SignTx(t, "chain", 1, 9, msg(other.Address)),
+		signer.SignTx(t, "chain", 0, 5, msg(signer.Address)),
+		[]byte("not a tx"),
+	} {
+		require.NoError(t, pool.Enqueue(tx))
+	}
+
+	api := comet.NewUnconfirmedTxsAPI(pool, pool, newTxDecoder())
+	result, err := api.PendingSequence(nil, signer.Address.String())
+	require.NoError(t, err)
+	require.Equal(t, &comet.ResultPendingSequence{
+		Address:      signer.Address.String(),
+		Pending:      2,
+		NextSequence: 6,
+	}, result)
+
+	result, err = api.PendingSequence(nil, testapp.GetAccount(2).Address.String())
+	require.NoError(t, err)
+	require.Zero(t, result.Pending)
+	require.Zero(t, result.NextSequence)
+
+	_, err = api.PendingSequence(nil, "not an address")
+	require.Error(t, err)
+
+	_, err = comet.NewUnconfirmedTxsAPI(pool, pool, nil).PendingSequence(nil, signer.Address.String())
+	require.Error(t, err)
+}
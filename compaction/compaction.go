@@ -0,0 +1,190 @@
+// Package compaction compacts the node's databases in the background and reports the block store's disk usage.
+// Compactions compete with block production for disk bandwidth, so they are split into key ranges that each start right
+// after a block is built, when the next block is furthest away.
+package compaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/builder"
+	"github.com/polymerdao/monomer/monomerdb/localdb"
+)
+
+const (
+	// DefaultUsageInterval is how often disk usage is reported by default.
+	DefaultUsageInterval = time.Minute
+	// DefaultIdleTimeout is how long a key range waits for a block to be built by default.
+	DefaultIdleTimeout = 30 * time.Second
+)
+
+// ranges is the number of key ranges each database is compacted in.
+const ranges = 16
+
+// Config configures scheduled compactions.
+type Config struct {
+	// Interval is how often the databases are compacted. Zero disables scheduled compactions; disk usage is still
+	// reported.
+	Interval time.Duration
+	// UsageInterval is how often disk usage is reported. It defaults to DefaultUsageInterval.
+	UsageInterval time.Duration
+	// IdleTimeout is how long a key range waits for a block to be built before it's compacted anyway, e.g., while
+	// op-node is down. It defaults to DefaultIdleTimeout.
+	IdleTimeout time.Duration
+}
+
+// DB is a database the scheduler compacts, e.g., localdb.DB or geth's ethdb.KeyValueStore.
+type DB interface {
+	// Compact compacts the keys from start up to limit. A nil start is the first key and a nil limit is past the last key.
+	Compact(start, limit []byte) error
+}
+
+// BlockStore is the block store, whose disk usage is reported, e.g., localdb.DB.
+type BlockStore interface {
+	DB
+	Usage() (*localdb.Usage, error)
+}
+
+// Scheduler reports the block store's disk usage and compacts the databases.
+//
+// Scheduler is a builder.Interceptor to learn when blocks are built.
+type Scheduler struct {
+	blocks        BlockStore
+	dbs           map[string]DB
+	metrics       Metrics
+	interval      time.Duration
+	usageInterval time.Duration
+	idleTimeout   time.Duration
+	built         chan struct{}
+}
+
+var _ builder.Interceptor = (*Scheduler)(nil)
+
+// NewScheduler returns a scheduler that compacts the block store and the databases in dbs, keyed by name.
+func NewScheduler(blocks BlockStore, dbs map[string]DB, metrics Metrics, cfg *Config) *Scheduler {
+	usageInterval := cfg.UsageInterval
+	if usageInterval == 0 {
+		usageInterval = DefaultUsageInterval
+	}
+	idleTimeout := cfg.IdleTimeout
+	if idleTimeout == 0 {
+		idleTimeout = DefaultIdleTimeout
+	}
+	allDBs := map[string]DB{
+		"blockstore": blocks,
+	}
+	for name, db := range dbs {
+		allDBs[name] = db
+	}
+	return &Scheduler{
+		blocks:        blocks,
+		dbs:           allDBs,
+		metrics:       metrics,
+		interval:      cfg.Interval,
+		usageInterval: usageInterval,
+		idleTimeout:   idleTimeout,
+		built:         make(chan struct{}, 1),
+	}
+}
+
+// Run reports disk usage every usage interval and compacts the databases every interval until ctx is done. Errors are
+// passed to onErr and don't stop it.
+func (s *Scheduler) Run(ctx context.Context, onErr func(error)) {
+	usageTicker := time.NewTicker(s.usageInterval)
+	defer usageTicker.Stop()
+	var compactions <-chan time.Time
+	if s.interval > 0 {
+		compactionTicker := time.NewTicker(s.interval)
+		defer compactionTicker.Stop()
+		compactions = compactionTicker.C
+	}
+	for {
+		if err := s.ReportUsage(); err != nil {
+			onErr(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-usageTicker.C:
+		case <-compactions:
+			if err := s.Compact(ctx); err != nil && ctx.Err() == nil {
+				onErr(err)
+			}
+		}
+	}
+}
+
+// ReportUsage reports the block store's disk usage.
+func (s *Scheduler) ReportUsage() error {
+	usage, err := s.blocks.Usage()
+	if err != nil {
+		return fmt.Errorf("get block store usage: %v", err)
+	}
+	s.metrics.SetUsage(usage)
+	return nil
+}
+
+// Compact compacts the databases one key range at a time, starting each range right after a block is built.
+func (s *Scheduler) Compact(ctx context.Context) error {
+	for name, db := range s.dbs {
+		start := time.Now()
+		for i := range ranges {
+			if err := s.waitForBlock(ctx); err != nil {
+				return err
+			}
+			lower, upper := keyRange(i)
+			if err := db.Compact(lower, upper); err != nil {
+				return fmt.Errorf("compact %s: %v", name, err)
+			}
+		}
+		s.metrics.RecordCompaction(name, time.Since(start))
+	}
+	return nil
+}
+
+// waitForBlock waits until a block is built or the idle timeout passes.
+func (s *Scheduler) waitForBlock(ctx context.Context) error {
+	// Drop a block built before the wait started.
+	select {
+	case <-s.built:
+	default:
+	}
+	timer := time.NewTimer(s.idleTimeout)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.built:
+	case <-timer.C:
+	}
+	return nil
+}
+
+// keyRange returns the i-th of the ranges that split the keys by their first byte.
+func keyRange(i int) (lower, upper []byte) {
+	const width = 256 / ranges
+	if i > 0 {
+		lower = []byte{byte(i * width)}
+	}
+	if i < ranges-1 {
+		upper = []byte{byte((i + 1) * width)}
+	}
+	return lower, upper
+}
+
+// Intercept never adds txs.
+func (s *Scheduler) Intercept(context.Context, uint64) (bfttypes.Txs, error) {
+	return nil, nil
+}
+
+// OnBlock wakes up a compaction waiting for a block to be built.
+func (s *Scheduler) OnBlock(context.Context, *monomer.Block) error {
+	select {
+	case s.built <- struct{}{}:
+	default:
+	}
+	return nil
+}
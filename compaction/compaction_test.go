@@ -0,0 +1,117 @@
+package compaction_test
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/polymerdao/monomer/compaction"
+	"github.com/polymerdao/monomer/monomerdb/localdb"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingDB struct {
+	mu     sync.Mutex
+	ranges [][2][]byte
+}
+
+func (db *recordingDB) Compact(start, limit []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.ranges = append(db.ranges, [2][]byte{start, limit})
+	return nil
+}
+
+func (db *recordingDB) compacted() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return len(db.ranges)
+}
+
+type recordingMetrics struct {
+	mu          sync.Mutex
+	usage       *localdb.Usage
+	compactions map[string]int
+}
+
+func (m *recordingMetrics) SetUsage(usage *localdb.Usage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.usage = usage
+}
+
+func (m *recordingMetrics) RecordCompaction(db string, _ time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.compactions[db]++
+}
+
+func TestCompactBetweenBlocks(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	stateDB := new(recordingDB)
+	metrics := &recordingMetrics{
+		compactions: make(map[string]int),
+	}
+	scheduler := compaction.NewScheduler(blockStore, map[string]compaction.DB{
+		"ethstate": stateDB,
+	}, metrics, &compaction.Config{
+		// Ranges are only compacted after blocks are built.
+		IdleTimeout: time.Hour,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() {
+		done <- scheduler.Compact(ctx)
+	}()
+
+	// Each range waits for its own block.
+	time.Sleep(10 * time.Millisecond)
+	require.Zero(t, stateDB.compacted())
+	for {
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+			require.Equal(t, map[string]int{"blockstore": 1, "ethstate": 1}, metrics.compactions)
+			// The ranges cover every key in order.
+			require.Len(t, stateDB.ranges, 16)
+			require.Nil(t, stateDB.ranges[0][0])
+			require.Nil(t, stateDB.ranges[len(stateDB.ranges)-1][1])
+			for i := 1; i < len(stateDB.ranges); i++ {
+				require.True(t, bytes.Equal(stateDB.ranges[i-1][1], stateDB.ranges[i][0]))
+			}
+			return
+		case <-time.After(time.Millisecond):
+			require.NoError(t, scheduler.OnBlock(ctx, nil))
+		}
+	}
+}
+
+func TestCompactWhenIdle(t *testing.T) {
+	stateDB := new(recordingDB)
+	scheduler := compaction.NewScheduler(testutils.NewLocalMemDB(t), map[string]compaction.DB{
+		"ethstate": stateDB,
+	}, compaction.NewNoopMetrics(), &compaction.Config{
+		IdleTimeout: time.Millisecond,
+	})
+	require.NoError(t, scheduler.Compact(context.Background()))
+	require.Equal(t, 16, stateDB.compacted())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, scheduler.Compact(ctx), context.Canceled)
+}
+
+func TestReportUsage(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	require.NoError(t, blockStore.AppendBlock(testutils.GenerateBlock(t)))
+	metrics := &recordingMetrics{}
+	scheduler := compaction.NewScheduler(blockStore, nil, metrics, &compaction.Config{})
+	require.NoError(t, scheduler.ReportUsage())
+	require.Contains(t, metrics.usage.Buckets, "header_by_height")
+	require.NotZero(t, metrics.usage.DiskBytes)
+}
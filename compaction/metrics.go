@@ -0,0 +1,105 @@
+package compaction
+
+import (
+	"time"
+
+	"github.com/polymerdao/monomer/monomerdb/localdb"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "monomerdb"
+
+// CompactionDurationBucketsSeconds are the buckets of the scheduled compaction duration histogram.
+var CompactionDurationBucketsSeconds = []float64{1, 5, 15, 60, 300, 900, 3600}
+
+// Metrics contains metrics collected from the compaction package.
+type Metrics interface {
+	SetUsage(usage *localdb.Usage)
+	RecordCompaction(db string, duration time.Duration)
+}
+
+type metrics struct {
+	// Estimated size on disk of each of the block store's buckets.
+	BucketBytes *stdprometheus.GaugeVec
+	// Size of the block store's files.
+	DiskBytes stdprometheus.Gauge
+	// Estimated number of bytes the block store needs to compact.
+	CompactionDebt stdprometheus.Gauge
+	// Number of compactions since the block store was opened.
+	Compactions stdprometheus.Gauge
+	// Number of compactions of the block store in progress.
+	CompactionsInProgress stdprometheus.Gauge
+	// Duration of scheduled compactions, by database.
+	CompactionDuration *stdprometheus.HistogramVec
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		BucketBytes: promauto.NewGaugeVec(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "bucket_bytes",
+			Help:      "Estimated size on disk of each of the block store's buckets",
+		}, []string{
+			"bucket",
+		}),
+		DiskBytes: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "disk_bytes",
+			Help:      "Size of the block store's files, including the ones waiting to be deleted",
+		}),
+		CompactionDebt: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compaction_debt_bytes",
+			Help:      "Estimated number of bytes the block store needs to compact",
+		}),
+		Compactions: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compactions",
+			Help:      "Number of compactions since the block store was opened",
+		}),
+		CompactionsInProgress: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compactions_in_progress",
+			Help:      "Number of compactions of the block store in progress",
+		}),
+		CompactionDuration: promauto.NewHistogramVec(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "scheduled_compaction_duration_seconds",
+			Help:      "Duration of scheduled compactions, by database",
+			Buckets:   CompactionDurationBucketsSeconds,
+		}, []string{
+			"db",
+		}),
+	}
+}
+
+func (m *metrics) SetUsage(usage *localdb.Usage) {
+	for bucket, bytes := range usage.Buckets {
+		m.BucketBytes.WithLabelValues(bucket).Set(float64(bytes))
+	}
+	m.DiskBytes.Set(float64(usage.DiskBytes))
+	m.CompactionDebt.Set(float64(usage.CompactionDebt))
+	m.Compactions.Set(float64(usage.Compactions))
+	m.CompactionsInProgress.Set(float64(usage.CompactionsInProgress))
+}
+
+func (m *metrics) RecordCompaction(db string, duration time.Duration) {
+	m.CompactionDuration.WithLabelValues(db).Observe(duration.Seconds())
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) SetUsage(*localdb.Usage) {}
+
+func (*noopMetrics) RecordCompaction(string, time.Duration) {}
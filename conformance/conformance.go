@@ -0,0 +1,160 @@
+// Package conformance runs the third-party clients appchains depend on against a Monomer node and checks their
+// responses, so compatibility breakage is caught by a failing case rather than by users. It covers go-ethereum's
+// ethclient against the eth namespace, and cosmjs (in a node subprocess) and the queries the Hermes relayer makes
+// against the CometBFT-compatible RPC.
+//
+// The engine/conformance package covers the Engine API.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer"
+)
+
+const (
+	ClientEthclient = "ethclient"
+	ClientCosmjs    = "cosmjs"
+	ClientHermes    = "hermes"
+)
+
+// ErrSkipped is wrapped by the errors of cases that could not run, e.g., because a client isn't installed.
+var ErrSkipped = errors.New("skipped")
+
+// Target is the node under test. It must have built at least one block.
+type Target struct {
+	ChainID monomer.ChainID
+	// EthURL serves the eth namespace over HTTP.
+	EthURL string
+	// CometURL serves the CometBFT-compatible RPC over HTTP.
+	CometURL string
+	// StoreName is the name of a store the app mounts. Hermes queries stores with proofs. The proof case is skipped if
+	// it is empty.
+	StoreName string
+	// NewTx returns a new Cosmos tx that the app executes successfully.
+	NewTx func() (bfttypes.Tx, error)
+	// BuildBlock builds a block with the txs in the mempool.
+	BuildBlock func(context.Context) error
+	// CosmjsDir is the directory of the cosmjs client (the cosmjs directory next to this file) with its dependencies
+	// installed by npm ci. The cosmjs cases are skipped if it is empty.
+	CosmjsDir string
+}
+
+// Case is a single conformance check.
+type Case struct {
+	// Client is the client under test.
+	Client string
+	// Name describes the expected behavior.
+	Name string
+	Run  func(context.Context, *Target) error
+}
+
+// ID identifies the case in a Matrix.
+func (c *Case) ID() string {
+	return c.Client + ": " + c.Name
+}
+
+// Result is the outcome of a Case. Err is nil if the case passed.
+type Result struct {
+	Case     *Case
+	Err      error
+	Duration time.Duration
+}
+
+// Skipped reports whether the case could not run.
+func (r *Result) Skipped() bool {
+	return errors.Is(r.Err, ErrSkipped)
+}
+
+// Matrix is the compatibility matrix of a node.
+type Matrix []*Result
+
+// Failed returns the results of the cases that failed. Skipped cases did not fail.
+func (m Matrix) Failed() Matrix {
+	var failed Matrix
+	for _, result := range m {
+		if result.Err != nil && !result.Skipped() {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// WriteTo writes the matrix as an aligned table.
+func (m Matrix) WriteTo(w io.Writer) (int64, error) {
+	counter := &countingWriter{w: w}
+	tw := tabwriter.NewWriter(counter, 0, 0, 2, ' ', 0) //nolint:mnd
+	fmt.Fprintln(tw, "CLIENT\tCASE\tRESULT\tDETAILS")
+	var passed, skipped int
+	for _, result := range m {
+		status, details := "PASS", ""
+		switch {
+		case result.Skipped():
+			status, details = "SKIP", result.Err.Error()
+			skipped++
+		case result.Err != nil:
+			status, details = "FAIL", result.Err.Error()
+		default:
+			passed++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.Case.Client, result.Case.Name, status, details)
+	}
+	fmt.Fprintf(tw, "\n%d/%d cases passed, %d skipped\n", passed, len(m), skipped)
+	if err := tw.Flush(); err != nil {
+		return counter.n, err
+	}
+	return counter.n, nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Cases returns the default cases for all clients.
+func Cases() []*Case {
+	return slices.Concat(ethclientCases(), cosmjsCases(), hermesCases())
+}
+
+// Run runs the cases in order against the target.
+func Run(ctx context.Context, target *Target, cases []*Case) Matrix {
+	matrix := make(Matrix, 0, len(cases))
+	for _, tc := range cases {
+		start := time.Now()
+		err := tc.Run(ctx, target)
+		matrix = append(matrix, &Result{
+			Case:     tc,
+			Err:      err,
+			Duration: time.Since(start),
+		})
+	}
+	return matrix
+}
+
+// submitTx submits a new tx through submit and builds a block including it.
+func (t *Target) submitTx(ctx context.Context, submit func(bfttypes.Tx) error) (bfttypes.Tx, error) {
+	tx, err := t.NewTx()
+	if err != nil {
+		return nil, fmt.Errorf("new tx: %v", err)
+	}
+	if err := submit(tx); err != nil {
+		return nil, fmt.Errorf("submit tx: %v", err)
+	}
+	if err := t.BuildBlock(ctx); err != nil {
+		return nil, fmt.Errorf("build block: %v", err)
+	}
+	return tx, nil
+}
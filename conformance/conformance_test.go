@@ -0,0 +1,79 @@
+package conformance_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/conformance"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testapp/x/testmodule"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// knownDeviations are the cases Monomer is known to fail. Remove a case once it passes.
+var knownDeviations = map[string]struct{}{
+	// eth_getBlockReceipts only accepts block numbers and labels, but ethclient may pass a block hash.
+	"ethclient: BlockReceipts returns a receipt for every tx": {},
+	// Monomer doesn't implement eth_getTransactionCount.
+	"ethclient: NonceAt returns a nonce": {},
+	// Monomer doesn't implement eth_gasPrice.
+	"ethclient: SuggestGasPrice returns a gas price": {},
+	// Monomer doesn't implement eth_call.
+	"ethclient: CallContract calls the L2ToL1MessagePasser": {},
+	// Monomer doesn't implement block_results, commit, validators, consensus_params, or blockchain, so Hermes can't
+	// build light client headers for it.
+	"hermes: block_results returns the results of the latest block": {},
+	"hermes: commit returns the latest signed header":               {},
+	"hermes: validators returns the validator set":                  {},
+	"hermes: consensus_params returns the consensus params":         {},
+	"hermes: blockchain returns block metas":                        {},
+}
+
+func TestConformance(t *testing.T) {
+	chainID := monomer.ChainID(1)
+	app := testapp.NewTest(t, chainID.String())
+	n := testutils.NewInstantNode(t, app, &genesis.Genesis{
+		ChainID:  chainID,
+		AppState: testapp.MakeGenesisAppState(t, app),
+	})
+	n.BuildBlock()
+
+	var txs int
+	matrix := conformance.Run(context.Background(), &conformance.Target{
+		ChainID:   chainID,
+		EthURL:    "http://" + n.EngineAddr(),
+		CometURL:  "http://" + n.CometAddr(),
+		StoreName: testmodule.StoreKey,
+		NewTx: func() (bfttypes.Tx, error) {
+			txs++
+			return testapp.ToTestTx(t, fmt.Sprintf("conformance%d", txs), "v"), nil
+		},
+		BuildBlock: func(context.Context) error {
+			n.BuildBlock()
+			return nil
+		},
+		CosmjsDir: "cosmjs",
+	}, conformance.Cases())
+	var report strings.Builder
+	_, err := matrix.WriteTo(&report)
+	require.NoError(t, err)
+	t.Log("\n" + report.String())
+
+	for _, result := range matrix {
+		if result.Skipped() {
+			continue
+		}
+		_, known := knownDeviations[result.Case.ID()]
+		if known {
+			require.Error(t, result.Err, "%s passes; remove it from the known deviations", result.Case.ID())
+		} else {
+			require.NoError(t, result.Err, result.Case.ID())
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// cosmjsResult is the output of cosmjs/query.mjs.
+type cosmjsResult struct {
+	ChainID     string `json:"chainId"`
+	Height      int64  `json:"height"`
+	BlockHeight int64  `json:"blockHeight"`
+	BlockTxs    int    `json:"blockTxs"`
+	SearchedTxs int    `json:"searchedTxs"`
+}
+
+// runCosmjs runs cosmjs/query.mjs against the target.
+func runCosmjs(ctx context.Context, t *Target) (*cosmjsResult, error) {
+	if t.CosmjsDir == "" {
+		return nil, fmt.Errorf("%w: no cosmjs dir", ErrSkipped)
+	}
+	if _, err := exec.LookPath("node"); err != nil {
+		return nil, fmt.Errorf("%w: node is not installed", ErrSkipped)
+	}
+	if _, err := os.Stat(filepath.Join(t.CosmjsDir, "node_modules")); errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: run npm install in %s", ErrSkipped, t.CosmjsDir)
+	}
+
+	cmd := exec.CommandContext(ctx, "node", "query.mjs", t.CometURL)
+	cmd.Dir = t.CosmjsDir
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("run query.mjs: %v: %s", err, exitErr.Stderr)
+		}
+		return nil, fmt.Errorf("run query.mjs: %v", err)
+	}
+	result := new(cosmjsResult)
+	if err := json.Unmarshal(output, result); err != nil {
+		return nil, fmt.Errorf("unmarshal query.mjs output: %v", err)
+	}
+	return result, nil
+}
+
+func cosmjsCases() []*Case {
+	return []*Case{
+		{
+			Client: ClientCosmjs,
+			Name:   "StargateClient reads the chain ID, latest block, and its txs",
+			Run: func(ctx context.Context, t *Target) error {
+				result, err := runCosmjs(ctx, t)
+				if err != nil {
+					return err
+				}
+				if result.ChainID != t.ChainID.String() {
+					return fmt.Errorf("got chain ID %q, want %q", result.ChainID, t.ChainID)
+				}
+				if result.Height < 1 || result.BlockHeight != result.Height {
+					return fmt.Errorf("got height %d and block height %d", result.Height, result.BlockHeight)
+				}
+				// searchTx only finds txs with results, but getBlock returns all of them.
+				if result.SearchedTxs > result.BlockTxs {
+					return fmt.Errorf("searchTx found %d txs in a block with %d txs", result.SearchedTxs, result.BlockTxs)
+				}
+				return nil
+			},
+		},
+	}
+}
@@ -0,0 +1,18 @@
+// Queries a node with cosmjs the way wallets and frontends do and prints the results as JSON.
+// Usage: node query.mjs <comet-url>
+import { StargateClient } from "@cosmjs/stargate";
+
+const client = await StargateClient.connect(process.argv[2]);
+const chainId = await client.getChainId();
+const height = await client.getHeight();
+const block = await client.getBlock(height);
+const txs = await client.searchTx(`tx.height=${height}`);
+client.disconnect();
+
+console.log(JSON.stringify({
+  chainId,
+  height,
+  blockHeight: block.header.height,
+  blockTxs: block.txs.length,
+  searchedTxs: txs.length,
+}));
@@ -0,0 +1,240 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+)
+
+// withEthclient runs f with an ethclient connected to the target's eth namespace.
+func withEthclient(f func(context.Context, *ethclient.Client, *Target) error) func(context.Context, *Target) error {
+	return func(ctx context.Context, t *Target) error {
+		client, err := ethclient.DialContext(ctx, t.EthURL)
+		if err != nil {
+			return fmt.Errorf("dial: %v", err)
+		}
+		defer client.Close()
+		return f(ctx, client, t)
+	}
+}
+
+// subscriptionTimeout is how long subscription cases wait for a notification.
+const subscriptionTimeout = 10 * time.Second
+
+func ethclientCases() []*Case {
+	return []*Case{
+		{
+			Client: ClientEthclient,
+			Name:   "ChainID returns the chain ID",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, t *Target) error {
+				chainID, err := client.ChainID(ctx)
+				if err != nil {
+					return err
+				}
+				if chainID.Cmp(t.ChainID.Big()) != 0 {
+					return fmt.Errorf("got chain ID %v, want %v", chainID, t.ChainID)
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "BlockNumber returns the height of the latest block",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, _ *Target) error {
+				number, err := client.BlockNumber(ctx)
+				if err != nil {
+					return err
+				}
+				header, err := client.HeaderByNumber(ctx, nil)
+				if err != nil {
+					return fmt.Errorf("header by number: %v", err)
+				}
+				if number != header.Number.Uint64() {
+					return fmt.Errorf("got block number %d, but the latest header is at height %d", number, header.Number)
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "headers hash to their block hash",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, _ *Target) error {
+				header, err := client.HeaderByNumber(ctx, nil)
+				if err != nil {
+					return fmt.Errorf("header by number: %v", err)
+				}
+				// ethclient computes the hash from the header fields, so this fails if any field is missing or wrong.
+				block, err := client.BlockByHash(ctx, header.Hash())
+				if err != nil {
+					return fmt.Errorf("block by hash: %v", err)
+				}
+				if block.NumberU64() != header.Number.Uint64() {
+					return fmt.Errorf("got block %d, want %d", block.NumberU64(), header.Number)
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "BlockByNumber decodes blocks starting with a deposit tx",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, _ *Target) error {
+				block, err := client.BlockByNumber(ctx, nil)
+				if err != nil {
+					return err
+				}
+				if txs := block.Transactions(); len(txs) == 0 || !txs[0].IsDepositTx() {
+					return errors.New("the block does not start with the L1 attributes deposit tx")
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "BalanceAt returns a balance",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, _ *Target) error {
+				_, err := client.BalanceAt(ctx, common.Address{1}, nil)
+				return err
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "CodeAt returns the L2ToL1MessagePasser code",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, _ *Target) error {
+				code, err := client.CodeAt(ctx, predeploys.L2ToL1MessagePasserAddr, nil)
+				if err != nil {
+					return err
+				}
+				if len(code) == 0 {
+					return errors.New("no code")
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "SendTransaction submits a wrapped Cosmos tx and TransactionReceipt returns its receipt",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, t *Target) error {
+				var ethTx *ethtypes.Transaction
+				if _, err := t.submitTx(ctx, func(tx bfttypes.Tx) error {
+					ethTx = monomer.AdaptNonDepositCosmosTxToEthTx(tx)
+					return client.SendTransaction(ctx, ethTx)
+				}); err != nil {
+					return err
+				}
+				receipt, err := client.TransactionReceipt(ctx, ethTx.Hash())
+				if err != nil {
+					return fmt.Errorf("transaction receipt: %v", err)
+				}
+				if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+					return fmt.Errorf("got receipt status %d, want %d", receipt.Status, ethtypes.ReceiptStatusSuccessful)
+				}
+				if _, isPending, err := client.TransactionByHash(ctx, ethTx.Hash()); err != nil {
+					return fmt.Errorf("transaction by hash: %v", err)
+				} else if isPending {
+					return errors.New("included tx is pending")
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "BlockReceipts returns a receipt for every tx",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, _ *Target) error {
+				block, err := client.BlockByNumber(ctx, nil)
+				if err != nil {
+					return fmt.Errorf("block by number: %v", err)
+				}
+				receipts, err := client.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(block.Hash(), false))
+				if err != nil {
+					return err
+				}
+				if len(receipts) != block.Transactions().Len() {
+					return fmt.Errorf("got %d receipts for %d txs", len(receipts), block.Transactions().Len())
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "NonceAt returns a nonce",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, _ *Target) error {
+				_, err := client.NonceAt(ctx, common.Address{1}, nil)
+				return err
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "SuggestGasPrice returns a gas price",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, _ *Target) error {
+				_, err := client.SuggestGasPrice(ctx)
+				return err
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "CallContract calls the L2ToL1MessagePasser",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, _ *Target) error {
+				// MESSAGE_VERSION()
+				result, err := client.CallContract(ctx, ethereum.CallMsg{
+					To:   &predeploys.L2ToL1MessagePasserAddr,
+					Data: common.FromHex("0x3f827a5a"),
+				}, nil)
+				if err != nil {
+					return err
+				}
+				if new(big.Int).SetBytes(result).Sign() == 0 {
+					return errors.New("got message version 0")
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "FilterLogs returns the logs of the latest block",
+			Run: withEthclient(func(ctx context.Context, client *ethclient.Client, _ *Target) error {
+				_, err := client.FilterLogs(ctx, ethereum.FilterQuery{})
+				return err
+			}),
+		},
+		{
+			Client: ClientEthclient,
+			Name:   "SubscribeNewHead notifies of new blocks over websockets",
+			Run: func(ctx context.Context, t *Target) error {
+				client, err := ethclient.DialContext(ctx, "ws"+strings.TrimPrefix(t.EthURL, "http"))
+				if err != nil {
+					return fmt.Errorf("dial: %v", err)
+				}
+				defer client.Close()
+				heads := make(chan *ethtypes.Header, 1)
+				sub, err := client.SubscribeNewHead(ctx, heads)
+				if err != nil {
+					return err
+				}
+				defer sub.Unsubscribe()
+				if err := t.BuildBlock(ctx); err != nil {
+					return fmt.Errorf("build block: %v", err)
+				}
+				select {
+				case <-heads:
+					return nil
+				case err := <-sub.Err():
+					return fmt.Errorf("subscription: %v", err)
+				case <-time.After(subscriptionTimeout):
+					return errors.New("timed out waiting for a new head")
+				}
+			},
+		},
+	}
+}
@@ -0,0 +1,198 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	bfttypes "github.com/cometbft/cometbft/types"
+)
+
+// withCometClient runs f with a CometBFT RPC client connected to the target. Hermes uses the same routes and JSON
+// encoding through tendermint-rs.
+func withCometClient(f func(context.Context, *rpchttp.HTTP, *Target) error) func(context.Context, *Target) error {
+	return func(ctx context.Context, t *Target) error {
+		client, err := rpchttp.New(t.CometURL, "/websocket")
+		if err != nil {
+			return fmt.Errorf("new client: %v", err)
+		}
+		return f(ctx, client, t)
+	}
+}
+
+// latestHeight returns the height of the latest block.
+func latestHeight(ctx context.Context, client *rpchttp.HTTP) (int64, error) {
+	status, err := client.Status(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("status: %v", err)
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// hermesCases are the queries the Hermes relayer makes to relay IBC packets.
+func hermesCases() []*Case {
+	return []*Case{
+		{
+			Client: ClientHermes,
+			Name:   "health succeeds",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, _ *Target) error {
+				_, err := client.Health(ctx)
+				return err
+			}),
+		},
+		{
+			Client: ClientHermes,
+			Name:   "status returns the chain ID and latest height",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, t *Target) error {
+				status, err := client.Status(ctx)
+				if err != nil {
+					return err
+				}
+				if status.NodeInfo.Network != t.ChainID.String() {
+					return fmt.Errorf("got network %q, want %q", status.NodeInfo.Network, t.ChainID)
+				}
+				if status.SyncInfo.LatestBlockHeight < 1 {
+					return fmt.Errorf("got latest height %d", status.SyncInfo.LatestBlockHeight)
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientHermes,
+			Name:   "abci_info returns the latest height",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, _ *Target) error {
+				info, err := client.ABCIInfo(ctx)
+				if err != nil {
+					return err
+				}
+				height, err := latestHeight(ctx, client)
+				if err != nil {
+					return err
+				}
+				if info.Response.LastBlockHeight != height {
+					return fmt.Errorf("got last block height %d, want %d", info.Response.LastBlockHeight, height)
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientHermes,
+			Name:   "abci_query proves store queries at a height",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, t *Target) error {
+				if t.StoreName == "" {
+					return fmt.Errorf("%w: no store name", ErrSkipped)
+				}
+				height, err := latestHeight(ctx, client)
+				if err != nil {
+					return err
+				}
+				result, err := client.ABCIQueryWithOptions(ctx, "store/"+t.StoreName+"/key", []byte("key"), rpcclient.ABCIQueryOptions{
+					Height: height,
+					Prove:  true,
+				})
+				if err != nil {
+					return err
+				}
+				if !result.Response.IsOK() {
+					return fmt.Errorf("query failed with code %d: %s", result.Response.Code, result.Response.Log)
+				}
+				if result.Response.ProofOps == nil || len(result.Response.ProofOps.Ops) == 0 {
+					return errors.New("no proof")
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientHermes,
+			Name:   "block returns the latest block",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, _ *Target) error {
+				height, err := latestHeight(ctx, client)
+				if err != nil {
+					return err
+				}
+				block, err := client.Block(ctx, &height)
+				if err != nil {
+					return err
+				}
+				if block.Block.Height != height {
+					return fmt.Errorf("got height %d, want %d", block.Block.Height, height)
+				}
+				return nil
+			}),
+		},
+		{
+			Client: ClientHermes,
+			Name:   "broadcast_tx_sync submits a tx that tx and tx_search find",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, t *Target) error {
+				tx, err := t.submitTx(ctx, func(tx bfttypes.Tx) error {
+					result, err := client.BroadcastTxSync(ctx, tx)
+					if err != nil {
+						return err
+					} else if result.Code != 0 {
+						return fmt.Errorf("check tx failed with code %d: %s", result.Code, result.Log)
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				result, err := client.Tx(ctx, tx.Hash(), false)
+				if err != nil {
+					return fmt.Errorf("tx: %v", err)
+				}
+				search, err := client.TxSearch(ctx, fmt.Sprintf("tx.height=%d", result.Height), false, nil, nil, "asc")
+				if err != nil {
+					return fmt.Errorf("tx search: %v", err)
+				}
+				for _, found := range search.Txs {
+					if found.Hash.String() == result.Hash.String() {
+						return nil
+					}
+				}
+				return fmt.Errorf("tx_search did not find the tx at height %d", result.Height)
+			}),
+		},
+		{
+			Client: ClientHermes,
+			Name:   "block_results returns the results of the latest block",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, _ *Target) error {
+				_, err := client.BlockResults(ctx, nil)
+				return err
+			}),
+		},
+		{
+			Client: ClientHermes,
+			Name:   "commit returns the latest signed header",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, _ *Target) error {
+				_, err := client.Commit(ctx, nil)
+				return err
+			}),
+		},
+		{
+			Client: ClientHermes,
+			Name:   "validators returns the validator set",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, _ *Target) error {
+				_, err := client.Validators(ctx, nil, nil, nil)
+				return err
+			}),
+		},
+		{
+			Client: ClientHermes,
+			Name:   "consensus_params returns the consensus params",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, _ *Target) error {
+				_, err := client.ConsensusParams(ctx, nil)
+				return err
+			}),
+		},
+		{
+			Client: ClientHermes,
+			Name:   "blockchain returns block metas",
+			Run: withCometClient(func(ctx context.Context, client *rpchttp.HTTP, _ *Target) error {
+				_, err := client.BlockchainInfo(ctx, 1, 1)
+				return err
+			}),
+		},
+	}
+}
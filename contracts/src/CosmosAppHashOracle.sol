@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity 0.8.25;
+
+/// @title CosmosAppHashOracle
+/// @notice The CosmosAppHashOracle stores Cosmos application hashes proposed for L2 blocks. It replaces the
+///         L2OutputOracle for teams that verify withdrawals directly against the Cosmos app hash instead of going
+///         through the MPT state root of the Monomer EVM shim.
+contract CosmosAppHashOracle {
+    /// @notice An app hash proposal.
+    struct Proposal {
+        bytes32 appHash;
+        uint128 timestamp;
+        uint128 l2BlockNumber;
+    }
+
+    /// @notice The address allowed to propose app hashes.
+    address public immutable PROPOSER;
+
+    /// @notice The number of seconds a proposal must wait before withdrawals proven against it can be finalized.
+    uint256 public immutable FINALIZATION_PERIOD_SECONDS;
+
+    /// @notice The proposals indexed by L2 block number.
+    mapping(uint256 => Proposal) internal proposals;
+
+    /// @notice The highest L2 block number with a proposal.
+    uint256 public latestBlockNumber;
+
+    /// @notice Emitted when an app hash is proposed.
+    /// @param appHash       The proposed app hash.
+    /// @param l2BlockNumber The L2 block number the app hash was committed in.
+    /// @param timestamp     The L1 timestamp of the proposal.
+    event AppHashProposed(bytes32 indexed appHash, uint256 indexed l2BlockNumber, uint256 timestamp);
+
+    /// @param _proposer                  The address allowed to propose app hashes.
+    /// @param _finalizationPeriodSeconds The number of seconds before a proposal is considered final.
+    constructor(address _proposer, uint256 _finalizationPeriodSeconds) {
+        PROPOSER = _proposer;
+        FINALIZATION_PERIOD_SECONDS = _finalizationPeriodSeconds;
+    }
+
+    /// @notice Proposes the app hash committed in an L2 block. Block numbers must strictly increase.
+    /// @param _l2BlockNumber The L2 block number the app hash was committed in.
+    /// @param _appHash       The Cosmos app hash.
+    function proposeAppHash(uint256 _l2BlockNumber, bytes32 _appHash) external {
+        require(msg.sender == PROPOSER, "CosmosAppHashOracle: only the proposer can propose app hashes");
+        require(_appHash != bytes32(0), "CosmosAppHashOracle: app hash cannot be zero");
+        require(
+            _l2BlockNumber > latestBlockNumber, "CosmosAppHashOracle: block number must be greater than the latest block"
+        );
+
+        proposals[_l2BlockNumber] = Proposal({
+            appHash: _appHash,
+            timestamp: uint128(block.timestamp),
+            l2BlockNumber: uint128(_l2BlockNumber)
+        });
+        latestBlockNumber = _l2BlockNumber;
+
+        emit AppHashProposed(_appHash, _l2BlockNumber, block.timestamp);
+    }
+
+    /// @notice Returns the proposal for an L2 block number. Reverts if there is none.
+    /// @param _l2BlockNumber The L2 block number.
+    function getProposal(uint256 _l2BlockNumber) external view returns (Proposal memory) {
+        Proposal memory proposal = proposals[_l2BlockNumber];
+        require(proposal.appHash != bytes32(0), "CosmosAppHashOracle: no proposal for block number");
+        return proposal;
+    }
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity 0.8.25;
+
+import { ICS23 } from "./ICS23.sol";
+import { CosmosAppHashOracle } from "./CosmosAppHashOracle.sol";
+
+/// @title CosmosWithdrawalPortal
+/// @notice The CosmosWithdrawalPortal proves and finalizes withdrawals initiated by the x/rollup module using ICS-23
+///         proofs against a Cosmos app hash. The x/rollup module commits every withdrawal hash to its store under
+///         the key "WithdrawalCommitment/" || withdrawalHash, so a withdrawal is proven with two existence proofs:
+///         one from the rollup IAVL store root to the commitment and one from the app hash to the store root.
+contract CosmosWithdrawalPortal {
+    /// @notice A withdrawal transaction. The hash matches the one computed by the L2ToL1MessagePasser.
+    struct WithdrawalTransaction {
+        uint256 nonce;
+        address sender;
+        address target;
+        uint256 value;
+        uint256 gasLimit;
+        bytes data;
+    }
+
+    /// @notice A withdrawal that has been proven against an app hash proposal.
+    struct ProvenWithdrawal {
+        bytes32 appHash;
+        uint128 timestamp;
+        uint128 l2BlockNumber;
+    }
+
+    /// @notice The name of the x/rollup module store in the multistore.
+    bytes public constant ROLLUP_STORE_KEY = "rollup";
+
+    /// @notice The key prefix of withdrawal commitments in the x/rollup module store.
+    bytes public constant WITHDRAWAL_COMMITMENT_PREFIX = "WithdrawalCommitment/";
+
+    /// @notice The value stored for each withdrawal commitment.
+    bytes public constant WITHDRAWAL_COMMITMENT_VALUE = hex"01";
+
+    /// @notice The oracle holding proposed app hashes.
+    CosmosAppHashOracle public immutable ORACLE;
+
+    /// @notice The proven withdrawals indexed by withdrawal hash.
+    mapping(bytes32 => ProvenWithdrawal) public provenWithdrawals;
+
+    /// @notice Whether a withdrawal has been finalized, indexed by withdrawal hash.
+    mapping(bytes32 => bool) public finalizedWithdrawals;
+
+    /// @notice The L2 sender of the withdrawal being finalized. It is only set during the call to the target, so
+    ///         targets like the WithdrawalClaimer can authenticate the L2 sender.
+    address public l2Sender;
+
+    /// @notice Emitted when a withdrawal is proven.
+    /// @param withdrawalHash The withdrawal hash.
+    /// @param from           The L2 sender.
+    /// @param to             The L1 target.
+    event WithdrawalProven(bytes32 indexed withdrawalHash, address indexed from, address indexed to);
+
+    /// @notice Emitted when a withdrawal is finalized.
+    /// @param withdrawalHash The withdrawal hash.
+    /// @param success        Whether the call to the target succeeded.
+    event WithdrawalFinalized(bytes32 indexed withdrawalHash, bool success);
+
+    /// @param _oracle The oracle holding proposed app hashes.
+    constructor(CosmosAppHashOracle _oracle) {
+        ORACLE = _oracle;
+    }
+
+    /// @notice Accepts ETH used to pay out withdrawals.
+    receive() external payable { }
+
+    /// @notice Proves a withdrawal against the app hash proposed for an L2 block.
+    /// @param _tx            The withdrawal transaction.
+    /// @param _l2BlockNumber The L2 block number of the app hash proposal.
+    /// @param _storeProof    The proof of the withdrawal commitment in the x/rollup store.
+    /// @param _appProof      The proof of the x/rollup store root in the multistore.
+    function proveWithdrawalTransaction(
+        WithdrawalTransaction memory _tx,
+        uint256 _l2BlockNumber,
+        ICS23.ExistenceProof memory _storeProof,
+        ICS23.ExistenceProof memory _appProof
+    )
+        external
+    {
+        require(_tx.target != address(this), "CosmosWithdrawalPortal: you cannot send messages to the portal contract");
+
+        CosmosAppHashOracle.Proposal memory proposal = ORACLE.getProposal(_l2BlockNumber);
+        bytes32 withdrawalHash = hashWithdrawal(_tx);
+
+        ProvenWithdrawal memory provenWithdrawal = provenWithdrawals[withdrawalHash];
+        require(
+            provenWithdrawal.timestamp == 0 || provenWithdrawal.appHash != proposal.appHash,
+            "CosmosWithdrawalPortal: withdrawal hash has already been proven"
+        );
+
+        bytes32 storeRoot = ICS23.calculateRoot(_storeProof);
+        ICS23.verifyMembership(
+            ICS23.iavlSpec(),
+            storeRoot,
+            _storeProof,
+            abi.encodePacked(WITHDRAWAL_COMMITMENT_PREFIX, withdrawalHash),
+            WITHDRAWAL_COMMITMENT_VALUE
+        );
+        ICS23.verifyMembership(
+            ICS23.tendermintSpec(), proposal.appHash, _appProof, ROLLUP_STORE_KEY, abi.encodePacked(storeRoot)
+        );
+
+        provenWithdrawals[withdrawalHash] = ProvenWithdrawal({
+            appHash: proposal.appHash,
+            timestamp: uint128(block.timestamp),
+            l2BlockNumber: uint128(_l2BlockNumber)
+        });
+
+        emit WithdrawalProven(withdrawalHash, _tx.sender, _tx.target);
+    }
+
+    /// @notice Finalizes a proven withdrawal once the finalization period has elapsed.
+    /// @param _tx The withdrawal transaction.
+    function finalizeWithdrawalTransaction(WithdrawalTransaction memory _tx) external {
+        bytes32 withdrawalHash = hashWithdrawal(_tx);
+        require(!finalizedWithdrawals[withdrawalHash], "CosmosWithdrawalPortal: withdrawal has already been finalized");
+
+        ProvenWithdrawal memory provenWithdrawal = provenWithdrawals[withdrawalHash];
+        require(provenWithdrawal.timestamp != 0, "CosmosWithdrawalPortal: withdrawal has not been proven yet");
+
+        CosmosAppHashOracle.Proposal memory proposal = ORACLE.getProposal(provenWithdrawal.l2BlockNumber);
+        require(
+            proposal.appHash == provenWithdrawal.appHash,
+            "CosmosWithdrawalPortal: app hash does not match the proven withdrawal"
+        );
+        require(
+            block.timestamp > proposal.timestamp + ORACLE.FINALIZATION_PERIOD_SECONDS()
+                && block.timestamp > provenWithdrawal.timestamp + ORACLE.FINALIZATION_PERIOD_SECONDS(),
+            "CosmosWithdrawalPortal: finalization period has not elapsed"
+        );
+
+        finalizedWithdrawals[withdrawalHash] = true;
+
+        l2Sender = _tx.sender;
+        (bool success,) = _tx.target.call{ gas: _tx.gasLimit, value: _tx.value }(_tx.data);
+        l2Sender = address(0);
+
+        emit WithdrawalFinalized(withdrawalHash, success);
+    }
+
+    /// @notice Computes the hash of a withdrawal the same way as the L2ToL1MessagePasser.
+    /// @param _tx The withdrawal transaction.
+    /// @return The withdrawal hash.
+    function hashWithdrawal(WithdrawalTransaction memory _tx) public pure returns (bytes32) {
+        return keccak256(abi.encode(_tx.nonce, _tx.sender, _tx.target, _tx.value, _tx.gasLimit, _tx.data));
+    }
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity 0.8.25;
+
+/// @title ICS23
+/// @notice Verifies ICS-23 existence proofs produced by a Cosmos SDK multistore. Proofs are passed in an ABI friendly
+///         form rather than as protobuf bytes. Only the two proof specs used by the Cosmos SDK are supported: the IAVL
+///         spec used for module stores and the Tendermint spec used for the multistore commitment.
+///
+///         Both specs hash leaves as sha256(prefix || varint(len(key)) || key || varint(32) || sha256(value)) and
+///         inner nodes as sha256(prefix || child || suffix).
+library ICS23 {
+    /// @notice An inner node of an existence proof.
+    struct InnerOp {
+        bytes prefix;
+        bytes suffix;
+    }
+
+    /// @notice An ICS-23 existence proof for a single key/value pair.
+    struct ExistenceProof {
+        bytes key;
+        bytes value;
+        bytes leafPrefix;
+        InnerOp[] path;
+    }
+
+    /// @notice The parameters of a proof spec that are checked against every proof.
+    struct Spec {
+        bytes1 leafPrefix;
+        uint256 minPrefixLength;
+        uint256 maxPrefixLength;
+        uint256 childSize;
+        uint256 childOrderLength;
+    }
+
+    /// @notice Thrown when the proof key or value does not match the expected key or value.
+    error ICS23_KeyValueMismatch();
+
+    /// @notice Thrown when the proof does not conform to the spec it is verified against.
+    error ICS23_InvalidSpec();
+
+    /// @notice Thrown when the calculated root does not match the expected root.
+    error ICS23_RootMismatch();
+
+    /// @notice Returns the spec used for IAVL module stores.
+    function iavlSpec() internal pure returns (Spec memory) {
+        return Spec({ leafPrefix: 0x00, minPrefixLength: 4, maxPrefixLength: 12, childSize: 33, childOrderLength: 2 });
+    }
+
+    /// @notice Returns the spec used for the Tendermint simple merkle tree committing to the multistore.
+    function tendermintSpec() internal pure returns (Spec memory) {
+        return Spec({ leafPrefix: 0x00, minPrefixLength: 1, maxPrefixLength: 1, childSize: 32, childOrderLength: 2 });
+    }
+
+    /// @notice Reverts unless the proof commits to the given key and value under the given root.
+    /// @param _spec  The spec the proof must conform to.
+    /// @param _root  The expected root.
+    /// @param _proof The existence proof.
+    /// @param _key   The expected key.
+    /// @param _value The expected value.
+    function verifyMembership(
+        Spec memory _spec,
+        bytes32 _root,
+        ExistenceProof memory _proof,
+        bytes memory _key,
+        bytes memory _value
+    )
+        internal
+        pure
+    {
+        if (keccak256(_proof.key) != keccak256(_key) || keccak256(_proof.value) != keccak256(_value)) {
+            revert ICS23_KeyValueMismatch();
+        }
+        checkAgainstSpec(_spec, _proof);
+        if (calculateRoot(_proof) != _root) revert ICS23_RootMismatch();
+    }
+
+    /// @notice Reverts if the proof does not conform to the spec. This prevents a proof from passing off an inner
+    ///         node as a leaf or vice versa.
+    /// @param _spec  The spec the proof must conform to.
+    /// @param _proof The existence proof.
+    function checkAgainstSpec(Spec memory _spec, ExistenceProof memory _proof) internal pure {
+        if (_proof.leafPrefix.length == 0 || _proof.leafPrefix[0] != _spec.leafPrefix) revert ICS23_InvalidSpec();
+        uint256 maxLeftChildBytes = (_spec.childOrderLength - 1) * _spec.childSize;
+        for (uint256 i = 0; i < _proof.path.length; i++) {
+            InnerOp memory op = _proof.path[i];
+            if (op.prefix.length < _spec.minPrefixLength) revert ICS23_InvalidSpec();
+            if (op.prefix.length > _spec.maxPrefixLength + maxLeftChildBytes) revert ICS23_InvalidSpec();
+            if (op.prefix[0] == _spec.leafPrefix) revert ICS23_InvalidSpec();
+            if (op.suffix.length % _spec.childSize != 0) revert ICS23_InvalidSpec();
+        }
+    }
+
+    /// @notice Calculates the root committed to by an existence proof.
+    /// @param _proof The existence proof.
+    /// @return The calculated root.
+    function calculateRoot(ExistenceProof memory _proof) internal pure returns (bytes32) {
+        bytes32 hashedValue = sha256(_proof.value);
+        bytes32 node = sha256(
+            abi.encodePacked(
+                _proof.leafPrefix, encodeVarint(_proof.key.length), _proof.key, encodeVarint(32), hashedValue
+            )
+        );
+        for (uint256 i = 0; i < _proof.path.length; i++) {
+            node = sha256(abi.encodePacked(_proof.path[i].prefix, node, _proof.path[i].suffix));
+        }
+        return node;
+    }
+
+    /// @notice Encodes a length as a protobuf varint.
+    /// @param _value The value to encode.
+    /// @return The varint encoding of the value.
+    function encodeVarint(uint256 _value) internal pure returns (bytes memory) {
+        bytes memory buf = new bytes(10);
+        uint256 n = 0;
+        while (_value >= 0x80) {
+            buf[n++] = bytes1(uint8(_value & 0x7f) | 0x80);
+            _value >>= 7;
+        }
+        buf[n++] = bytes1(uint8(_value));
+        bytes memory out = new bytes(n);
+        for (uint256 i = 0; i < n; i++) {
+            out[i] = buf[i];
+        }
+        return out;
+    }
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity 0.8.25;
+
+/// @notice The part of a withdrawal portal the WithdrawalClaimer relies on. Both the OptimismPortal and the
+///         CosmosWithdrawalPortal expose the L2 sender of the withdrawal being finalized.
+interface IWithdrawalPortal {
+    function l2Sender() external view returns (address);
+}
+
+/// @title WithdrawalClaimer
+/// @notice The WithdrawalClaimer pays out batched withdrawals. The x/rollup module merkleizes pending batched
+///         withdrawals into a single L2 to L1 message that registers the batch root here and carries the ETH of every
+///         withdrawal in it, so only one withdrawal is proven and finalized through the portal per batch. Each user
+///         then claims their withdrawal with a merkle proof against the batch root.
+///
+///         A leaf is keccak256(abi.encode(batchNonce, index, target, value)). Inner nodes hash their children in
+///         sorted order and a node without a sibling is promoted to the next level unchanged.
+contract WithdrawalClaimer {
+    /// @notice A registered batch.
+    struct Batch {
+        bytes32 root;
+        uint256 unclaimed;
+    }
+
+    /// @notice The portal finalizing the batch registration withdrawals.
+    IWithdrawalPortal public immutable PORTAL;
+
+    /// @notice The L2 address of the x/rollup module account, the only sender allowed to register batches.
+    address public immutable L2_SENDER;
+
+    /// @notice The registered batches indexed by batch nonce.
+    mapping(uint256 => Batch) public batches;
+
+    /// @notice Whether a withdrawal has been claimed, indexed by leaf hash.
+    mapping(bytes32 => bool) public claimed;
+
+    /// @notice Emitted when a batch is registered.
+    /// @param batchNonce The batch nonce.
+    /// @param root       The merkle root of the batched withdrawals.
+    /// @param total      The sum of the values of the batched withdrawals.
+    event BatchRegistered(uint256 indexed batchNonce, bytes32 root, uint256 total);
+
+    /// @notice Emitted when a batched withdrawal is claimed.
+    /// @param batchNonce The batch nonce.
+    /// @param index      The index of the withdrawal in the batch.
+    /// @param target     The L1 address the withdrawal was paid to.
+    /// @param value      The withdrawn amount of ETH.
+    event WithdrawalClaimed(uint256 indexed batchNonce, uint256 indexed index, address indexed target, uint256 value);
+
+    /// @param _portal   The portal finalizing the batch registration withdrawals.
+    /// @param _l2Sender The L2 address of the x/rollup module account.
+    constructor(IWithdrawalPortal _portal, address _l2Sender) {
+        PORTAL = _portal;
+        L2_SENDER = _l2Sender;
+    }
+
+    /// @notice Registers a batch. It must be called by the portal finalizing a withdrawal sent by the x/rollup module,
+    ///         with the sum of the values of the batched withdrawals.
+    /// @param _batchNonce The batch nonce.
+    /// @param _root       The merkle root of the batched withdrawals.
+    function registerBatch(uint256 _batchNonce, bytes32 _root) external payable {
+        require(msg.sender == address(PORTAL), "WithdrawalClaimer: only the portal can register batches");
+        require(PORTAL.l2Sender() == L2_SENDER, "WithdrawalClaimer: only the x/rollup module can register batches");
+        require(_root != bytes32(0), "WithdrawalClaimer: root cannot be zero");
+        require(batches[_batchNonce].root == bytes32(0), "WithdrawalClaimer: batch has already been registered");
+
+        batches[_batchNonce] = Batch({ root: _root, unclaimed: msg.value });
+
+        emit BatchRegistered(_batchNonce, _root, msg.value);
+    }
+
+    /// @notice Claims a batched withdrawal, paying it out to its target. Anyone can claim on behalf of the target.
+    /// @param _batchNonce The batch nonce.
+    /// @param _index      The index of the withdrawal in the batch.
+    /// @param _target     The L1 address to pay the withdrawal to.
+    /// @param _value      The withdrawn amount of ETH.
+    /// @param _proof      The sibling hashes from the leaf to the batch root.
+    function claim(
+        uint256 _batchNonce,
+        uint256 _index,
+        address _target,
+        uint256 _value,
+        bytes32[] calldata _proof
+    )
+        external
+    {
+        Batch storage batch = batches[_batchNonce];
+        require(batch.root != bytes32(0), "WithdrawalClaimer: batch has not been registered");
+
+        bytes32 leaf = hashLeaf(_batchNonce, _index, _target, _value);
+        require(!claimed[leaf], "WithdrawalClaimer: withdrawal has already been claimed");
+        require(processProof(leaf, _proof) == batch.root, "WithdrawalClaimer: invalid merkle proof");
+        require(batch.unclaimed >= _value, "WithdrawalClaimer: claim exceeds the unclaimed batch value");
+
+        claimed[leaf] = true;
+        batch.unclaimed -= _value;
+
+        (bool success,) = _target.call{ value: _value }("");
+        require(success, "WithdrawalClaimer: failed to send ETH to the target");
+
+        emit WithdrawalClaimed(_batchNonce, _index, _target, _value);
+    }
+
+    /// @notice Computes the leaf hash of a batched withdrawal the same way as the x/rollup module.
+    /// @param _batchNonce The batch nonce.
+    /// @param _index      The index of the withdrawal in the batch.
+    /// @param _target     The L1 address to pay the withdrawal to.
+    /// @param _value      The withdrawn amount of ETH.
+    /// @return The leaf hash.
+    function hashLeaf(uint256 _batchNonce, uint256 _index, address _target, uint256 _value)
+        public
+        pure
+        returns (bytes32)
+    {
+        return keccak256(abi.encode(_batchNonce, _index, _target, _value));
+    }
+
+    /// @notice Computes the root of the tree a leaf belongs to from its proof.
+    /// @param _leaf  The leaf hash.
+    /// @param _proof The sibling hashes from the leaf to the root.
+    /// @return The root hash.
+    function processProof(bytes32 _leaf, bytes32[] calldata _proof) public pure returns (bytes32) {
+        bytes32 node = _leaf;
+        for (uint256 i = 0; i < _proof.length; i++) {
+            bytes32 sibling = _proof[i];
+            node = node < sibling
+                ? keccak256(abi.encodePacked(node, sibling))
+                : keccak256(abi.encodePacked(sibling, node));
+        }
+        return node;
+    }
+}
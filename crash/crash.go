@@ -0,0 +1,203 @@
+// Package crash isolates panics in the node's subsystems. A Handler recovers a panic, writes a structured crash dump to
+// disk, and fails the node cleanly with an error, instead of a raw panic that loses the node's context.
+package crash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer"
+)
+
+// Subsystems of the node that a Handler isolates.
+const (
+	SubsystemBuilder        = "builder"
+	SubsystemEngineRPC      = "engine-rpc"
+	SubsystemCometRPC       = "comet-rpc"
+	SubsystemBuilderAPI     = "builder-api"
+	SubsystemFirehose       = "firehose"
+	SubsystemPruner         = "pruner"
+	SubsystemLocalSequencer = "local-sequencer"
+	SubsystemCompaction     = "compaction"
+	SubsystemOPNodeMonitor  = "op-node-monitor"
+	SubsystemEngineJWT      = "engine-jwt"
+	SubsystemBuilderJWT     = "builder-jwt"
+	SubsystemSystemConfig   = "system-config"
+	SubsystemMempoolSync    = "mempool-sync"
+)
+
+// maxRecentHeights is the number of recent block heights included in crash dumps.
+const maxRecentHeights = 16
+
+// Dump is the structured crash dump written when a subsystem panics.
+type Dump struct {
+	Subsystem string    `json:"subsystem"`
+	Time      time.Time `json:"time"`
+	Panic     string    `json:"panic"`
+	// Stack is the stack of the panicking goroutine.
+	Stack string `json:"stack"`
+	// Goroutines are the stacks of all goroutines.
+	Goroutines string `json:"goroutines"`
+	// RecentHeights are the heights of the most recently built blocks, oldest first.
+	RecentHeights []uint64 `json:"recentHeights"`
+	// ConfigHash identifies the node's configuration without revealing it.
+	ConfigHash string `json:"configHash"`
+}
+
+// Error is the error a Handler fails the node with.
+type Error struct {
+	Dump *Dump
+	// Path is the file the dump was written to, or empty if it wasn't written.
+	Path string
+}
+
+func (e *Error) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("%s panicked: %s", e.Dump.Subsystem, e.Dump.Panic)
+	}
+	return fmt.Sprintf("%s panicked: %s (crash dump: %s)", e.Dump.Subsystem, e.Dump.Panic, e.Path)
+}
+
+// Handler recovers panics in subsystems. It is a builder.Interceptor that never adds txs, so it can record the heights
+// of the blocks the node builds. A nil Handler doesn't recover panics.
+type Handler struct {
+	dir        string
+	configHash string
+	onCrash    func(error)
+	once       sync.Once
+
+	mu            sync.Mutex
+	recentHeights []uint64
+}
+
+// NewHandler returns a Handler that writes crash dumps to dir and calls onCrash with the first crash. If dir is empty,
+// dumps aren't written, but the node still fails with an Error containing the dump.
+func NewHandler(dir, configHash string, onCrash func(error)) *Handler {
+	return &Handler{
+		dir:        dir,
+		configHash: configHash,
+		onCrash:    onCrash,
+	}
+}
+
+// Recover recovers a panic in subsystem and sets *errp to the resulting Error. It must be deferred directly:
+//
+//	defer h.Recover(crash.SubsystemBuilder, &err)
+func (h *Handler) Recover(subsystem string, errp *error) {
+	if h == nil {
+		return
+	}
+	if r := recover(); r != nil {
+		*errp = h.crash(subsystem, r, debug.Stack())
+	}
+}
+
+// Func returns fn with panics in it recovered as crashes of subsystem. It is meant for goroutines.
+func (h *Handler) Func(subsystem string, fn func()) func() {
+	return func() {
+		var err error
+		defer h.Recover(subsystem, &err)
+		fn()
+	}
+}
+
+// HTTPHandler returns next with panics in it recovered as crashes of subsystem. The request that panicked gets an
+// internal server error.
+func (h *Handler) HTTPHandler(subsystem string, next http.Handler) http.Handler {
+	if h == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				// net/http panics with ErrAbortHandler to abort a request on purpose.
+				if recovered == http.ErrAbortHandler {
+					panic(recovered)
+				}
+				http.Error(w, h.crash(subsystem, recovered, debug.Stack()).Error(), http.StatusInternalServerError)
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (*Handler) Intercept(context.Context, uint64) (bfttypes.Txs, error) {
+	return nil, nil
+}
+
+func (h *Handler) OnBlock(_ context.Context, block *monomer.Block) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.recentHeights = append(h.recentHeights, block.Header.Height)
+	if len(h.recentHeights) > maxRecentHeights {
+		h.recentHeights = h.recentHeights[len(h.recentHeights)-maxRecentHeights:]
+	}
+	return nil
+}
+
+// crash writes a crash dump for the recovered panic, fails the node if it hasn't failed yet, and returns the Error.
+func (h *Handler) crash(subsystem string, recovered any, stack []byte) error {
+	h.mu.Lock()
+	recentHeights := append([]uint64{}, h.recentHeights...)
+	h.mu.Unlock()
+	dump := &Dump{
+		Subsystem:     subsystem,
+		Time:          time.Now().UTC(),
+		Panic:         fmt.Sprint(recovered),
+		Stack:         string(stack),
+		Goroutines:    string(allStacks()),
+		RecentHeights: recentHeights,
+		ConfigHash:    h.configHash,
+	}
+	crashErr := &Error{
+		Dump: dump,
+	}
+	var failErr error = crashErr
+	if h.dir != "" {
+		if path, err := writeDump(h.dir, dump); err != nil {
+			failErr = fmt.Errorf("%w; write crash dump: %v", crashErr, err)
+		} else {
+			crashErr.Path = path
+		}
+	}
+	h.once.Do(func() {
+		h.onCrash(failErr)
+	})
+	return crashErr
+}
+
+func writeDump(dir string, dump *Dump) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("make dir: %v", err)
+	}
+	dumpJSON, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal: %v", err)
+	}
+	path := filepath.Join(dir, fmt.Sprintf("crash-%s-%d.json", dump.Subsystem, dump.Time.UnixNano()))
+	if err := os.WriteFile(path, dumpJSON, 0o600); err != nil {
+		return "", fmt.Errorf("write: %v", err)
+	}
+	return path, nil
+}
+
+// allStacks returns the stacks of all goroutines.
+func allStacks() []byte {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
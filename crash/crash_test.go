@@ -0,0 +1,84 @@
+package crash_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/crash"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecover(t *testing.T) {
+	dir := t.TempDir()
+	var crashes []error
+	h := crash.NewHandler(dir, "hash", func(err error) {
+		crashes = append(crashes, err)
+	})
+	for height := uint64(1); height <= 20; height++ {
+		require.NoError(t, h.OnBlock(context.Background(), &monomer.Block{
+			Header: &monomer.Header{
+				Height: height,
+			},
+		}))
+	}
+
+	err := func() (err error) {
+		defer h.Recover(crash.SubsystemBuilder, &err)
+		panic("boom")
+	}()
+	var crashErr *crash.Error
+	require.ErrorAs(t, err, &crashErr)
+	require.Equal(t, []error{err}, crashes)
+
+	dumpJSON, err := os.ReadFile(crashErr.Path)
+	require.NoError(t, err)
+	require.Equal(t, dir, filepath.Dir(crashErr.Path))
+	var dump crash.Dump
+	require.NoError(t, json.Unmarshal(dumpJSON, &dump))
+	require.Equal(t, crash.SubsystemBuilder, dump.Subsystem)
+	require.Equal(t, "boom", dump.Panic)
+	require.Contains(t, dump.Stack, "TestRecover")
+	require.Contains(t, dump.Goroutines, "goroutine")
+	require.Equal(t, []uint64{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, dump.RecentHeights)
+	require.Equal(t, "hash", dump.ConfigHash)
+
+	// Only the first crash fails the node.
+	h.Func(crash.SubsystemPruner, func() {
+		panic("again")
+	})()
+	require.Len(t, crashes, 1)
+}
+
+func TestHTTPHandler(t *testing.T) {
+	var crashes []error
+	h := crash.NewHandler("", "", func(err error) {
+		crashes = append(crashes, err)
+	})
+	handler := h.HTTPHandler(crash.SubsystemCometRPC, http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	}))
+
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+	require.Equal(t, http.StatusInternalServerError, resp.Code)
+	require.Len(t, crashes, 1)
+	var crashErr *crash.Error
+	require.ErrorAs(t, crashes[0], &crashErr)
+	require.Equal(t, crash.SubsystemCometRPC, crashErr.Dump.Subsystem)
+	require.Empty(t, crashErr.Path)
+}
+
+func TestNilHandler(t *testing.T) {
+	var h *crash.Handler
+	require.PanicsWithValue(t, "boom", func() {
+		var err error
+		defer h.Recover(crash.SubsystemBuilder, &err)
+		panic("boom")
+	})
+}
@@ -0,0 +1,199 @@
+// Package deposit decodes OptimismPortal TransactionDeposited events the same way op-node and the rollup module do, to
+// debug deposits that never arrived.
+package deposit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer/bindings"
+	"github.com/polymerdao/monomer/utils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+)
+
+// Deposit is a decoded TransactionDeposited event.
+type Deposit struct {
+	// L1TxHash, L1BlockHash, and LogIndex locate the event on L1.
+	L1TxHash    common.Hash `json:"l1TxHash"`
+	L1BlockHash common.Hash `json:"l1BlockHash"`
+	LogIndex    uint        `json:"logIndex"`
+	// From is the L1 sender, aliased if it is a contract.
+	From common.Address `json:"from"`
+	// Tx is the deposit tx op-node derives from the event.
+	Tx *ethtypes.Transaction `json:"tx"`
+	// Msg is the message that applies the deposit. On chain, the message also carries the L1 attributes tx and the
+	// other deposits from the same L1 block.
+	Msg *rolluptypes.MsgApplyL1Txs `json:"msg"`
+	// Credits are the balances the rollup module credits when it applies the deposit.
+	Credits []*Credit `json:"credits"`
+	// Problems explain why the deposit won't be applied as expected, if it won't.
+	Problems []string `json:"problems,omitempty"`
+}
+
+// Credit is an amount credited to an account.
+type Credit struct {
+	Address       common.Address `json:"address"`
+	CosmosAddress sdk.AccAddress `json:"cosmosAddress"`
+	Amount        sdk.Coin       `json:"amount"`
+}
+
+// Decode decodes a TransactionDeposited event.
+func Decode(log *ethtypes.Log) (*Deposit, error) {
+	depositTx, err := derive.UnmarshalDepositLogEvent(log)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal deposit log event: %v", err)
+	}
+	tx := ethtypes.NewTx(depositTx)
+	txBytes, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal deposit tx: %v", err)
+	}
+	d := &Deposit{
+		L1TxHash:    log.TxHash,
+		L1BlockHash: log.BlockHash,
+		LogIndex:    log.Index,
+		From:        depositTx.From,
+		Tx:          tx,
+		Msg: &rolluptypes.MsgApplyL1Txs{
+			TxBytes: [][]byte{txBytes},
+		},
+	}
+	if err := d.applyCredits(depositTx); err != nil {
+		d.Problems = append(d.Problems, "the rollup module rejects the deposit: "+err.Error())
+	}
+	return d, nil
+}
+
+// applyCredits mirrors how the rollup module applies user deposits.
+func (d *Deposit) applyCredits(tx *ethtypes.DepositTx) error {
+	if tx.To == nil {
+		return errors.New("contract creation txs are not supported")
+	}
+	mint := tx.Mint
+	if mint == nil {
+		mint = new(big.Int)
+	}
+	if tx.Value.Cmp(mint) > 0 {
+		return fmt.Errorf("transfer amount %v is greater than mint amount %v", tx.Value, mint)
+	}
+	if tx.Value.Sign() > 0 {
+		d.addCredit(*tx.To, sdk.NewCoin(rolluptypes.ETH, sdkmath.NewIntFromBigInt(tx.Value)))
+	}
+	if remaining := new(big.Int).Sub(mint, tx.Value); remaining.Sign() > 0 {
+		d.addCredit(tx.From, sdk.NewCoin(rolluptypes.ETH, sdkmath.NewIntFromBigInt(remaining)))
+	}
+
+	if tx.From != rolluptypes.AliasedL1CrossDomainMessengerAddress || len(tx.Data) == 0 {
+		return nil
+	}
+	relayMessage, finalizeBridgeERC20, err := bindings.UnpackRelayedFinalizeBridgeERC20(tx.Data)
+	if err != nil {
+		return fmt.Errorf("parse cross domain message: %v", err)
+	}
+	// The rollup module also checks the message's sender against the L1StandardBridge in its params, which the decoder
+	// doesn't have.
+	if relayMessage.Target != predeploys.L2StandardBridgeAddr {
+		return fmt.Errorf("cross domain message sent to %s, not the L2 standard bridge %s", relayMessage.Target, predeploys.L2StandardBridgeAddr)
+	}
+	d.addCredit(finalizeBridgeERC20.To, sdk.NewCoin(
+		rolluptypes.ERC20Denom(finalizeBridgeERC20.RemoteToken),
+		sdkmath.NewIntFromBigInt(finalizeBridgeERC20.Amount),
+	))
+	return nil
+}
+
+func (d *Deposit) addCredit(addr common.Address, amount sdk.Coin) { //nolint:gocritic // hugeParam
+	d.Credits = append(d.Credits, &Credit{
+		Address:       addr,
+		CosmosAddress: utils.EvmToCosmosAddress(addr),
+		Amount:        amount,
+	})
+}
+
+// DecodeReceipt decodes the TransactionDeposited events in an L1 receipt. If portal is not the zero address, events
+// emitted by other contracts are reported as problems, since op-node ignores them.
+func DecodeReceipt(receipt *ethtypes.Receipt, portal common.Address) ([]*Deposit, error) {
+	var deposits []*Deposit
+	for _, log := range receipt.Logs {
+		if len(log.Topics) == 0 || log.Topics[0] != derive.DepositEventABIHash {
+			continue
+		}
+		d, err := Decode(log)
+		if err != nil {
+			return nil, fmt.Errorf("decode log %d: %v", log.Index, err)
+		}
+		if portal != (common.Address{}) && log.Address != portal {
+			d.Problems = append(d.Problems, fmt.Sprintf("op-node ignores the event: it was emitted by %s, not the OptimismPortal", log.Address))
+		}
+		if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+			d.Problems = append(d.Problems, "op-node ignores the event: the L1 tx failed")
+		}
+		deposits = append(deposits, d)
+	}
+	return deposits, nil
+}
+
+// Print writes a human-readable description of the deposit.
+func (d *Deposit) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', 0)
+	data := d.Tx.Data()
+	rows := [][2]string{
+		{"L1 tx", d.L1TxHash.Hex()},
+		{"L1 block", d.L1BlockHash.Hex()},
+		{"Log index", fmt.Sprint(d.LogIndex)},
+		{"L2 tx hash", d.Tx.Hash().Hex()},
+		{"Source hash", d.Tx.SourceHash().Hex()},
+		{"From", formatAddress(d.From)},
+		{"To", formatTo(d.Tx.To())},
+		{"Mint", fmt.Sprintf("%v wei", d.Tx.Mint())},
+		{"Value", fmt.Sprintf("%v wei", d.Tx.Value())},
+		{"Gas limit", fmt.Sprint(d.Tx.Gas())},
+		{"Data", fmt.Sprintf("%s (%d bytes)", hexutil.Encode(data), len(data))},
+		{"Cosmos msg", sdk.MsgTypeURL(d.Msg)},
+	}
+	for _, row := range rows {
+		if _, err := fmt.Fprintf(tw, "%s:\t%s\n", row[0], row[1]); err != nil {
+			return err
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if len(d.Credits) > 0 {
+		if _, err := fmt.Fprintln(w, "Credits:"); err != nil {
+			return err
+		}
+		for _, credit := range d.Credits {
+			if _, err := fmt.Fprintf(w, "  %s to %s\n", credit.Amount, formatAddress(credit.Address)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, problem := range d.Problems {
+		if _, err := fmt.Fprintf(w, "Problem: %s\n", problem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatTo(to *common.Address) string {
+	if to == nil {
+		return "none (contract creation)"
+	}
+	return formatAddress(*to)
+}
+
+func formatAddress(addr common.Address) string {
+	return fmt.Sprintf("%s (%s)", addr, utils.EvmToCosmosAddress(addr))
+}
@@ -0,0 +1,173 @@
+package deposit_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer/bindings"
+	"github.com/polymerdao/monomer/deposit"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/polymerdao/monomer/utils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	portal = common.HexToAddress("0x1234")
+	from   = common.HexToAddress("0x01")
+	to     = common.HexToAddress("0x02")
+)
+
+func depositLog(t *testing.T, emitter common.Address, depositTx *ethtypes.DepositTx) *ethtypes.Log {
+	log, err := derive.MarshalDepositLogEvent(emitter, depositTx)
+	require.NoError(t, err)
+	log.BlockHash = common.HexToHash("0xb10c")
+	log.TxHash = common.HexToHash("0x7a")
+	log.Index = 3
+	return log
+}
+
+func TestDecode(t *testing.T) {
+	log := depositLog(t, portal, &ethtypes.DepositTx{
+		From:  from,
+		To:    &to,
+		Mint:  big.NewInt(100),
+		Value: big.NewInt(40),
+		Gas:   100_000,
+	})
+	d, err := deposit.Decode(log)
+	require.NoError(t, err)
+
+	require.Equal(t, log.TxHash, d.L1TxHash)
+	require.Equal(t, log.BlockHash, d.L1BlockHash)
+	require.Equal(t, log.Index, d.LogIndex)
+	require.Equal(t, from, d.From)
+	require.Equal(t, to, *d.Tx.To())
+	require.Equal(t, (&derive.UserDepositSource{L1BlockHash: log.BlockHash, LogIndex: 3}).SourceHash(), d.Tx.SourceHash())
+	require.Len(t, d.Msg.TxBytes, 1)
+	require.Equal(t, testutils.TxToBytes(t, d.Tx), d.Msg.TxBytes[0])
+	require.Equal(t, []*deposit.Credit{
+		{
+			Address:       to,
+			CosmosAddress: utils.EvmToCosmosAddress(to),
+			Amount:        sdk.NewCoin(rolluptypes.ETH, sdkmath.NewInt(40)),
+		},
+		{
+			Address:       from,
+			CosmosAddress: utils.EvmToCosmosAddress(from),
+			Amount:        sdk.NewCoin(rolluptypes.ETH, sdkmath.NewInt(60)),
+		},
+	}, d.Credits)
+	require.Empty(t, d.Problems)
+
+	var out bytes.Buffer
+	require.NoError(t, d.Print(&out))
+	require.Contains(t, out.String(), "Credits:\n  40ETH to "+to.String())
+	require.NotContains(t, out.String(), "Problem:")
+}
+
+func TestDecodeERC20(t *testing.T) {
+	userAddr := common.HexToAddress("0x03")
+	tokenAddr := common.HexToAddress("0x04")
+	depositTx := testutils.GenerateERC20DepositTx(t, tokenAddr, userAddr, big.NewInt(7))
+	d, err := deposit.Decode(depositLog(t, portal, &ethtypes.DepositTx{
+		From:  rolluptypes.AliasedL1CrossDomainMessengerAddress,
+		To:    depositTx.To(),
+		Value: new(big.Int),
+		Data:  depositTx.Data(),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []*deposit.Credit{{
+		Address:       userAddr,
+		CosmosAddress: utils.EvmToCosmosAddress(userAddr),
+		Amount:        sdk.NewCoin("erc20/"+tokenAddr.String()[2:], sdkmath.NewInt(7)),
+	}}, d.Credits)
+	require.Empty(t, d.Problems)
+}
+
+func TestDecodeRejected(t *testing.T) {
+	for name, test := range map[string]struct {
+		depositTx *ethtypes.DepositTx
+		problem   string
+	}{
+		"contract creation": {
+			depositTx: &ethtypes.DepositTx{From: from, Value: new(big.Int)},
+			problem:   "contract creation txs are not supported",
+		},
+		"value exceeds mint": {
+			depositTx: &ethtypes.DepositTx{From: from, To: &to, Mint: big.NewInt(1), Value: big.NewInt(2)},
+			problem:   "transfer amount 2 is greater than mint amount 1",
+		},
+		"unrecognized cross domain message": {
+			depositTx: &ethtypes.DepositTx{
+				From:  rolluptypes.AliasedL1CrossDomainMessengerAddress,
+				To:    &to,
+				Value: new(big.Int),
+				Data:  []byte{1, 2, 3, 4},
+			},
+			problem: "parse cross domain message",
+		},
+		"cross domain message to another target than the L2StandardBridge": {
+			depositTx: &ethtypes.DepositTx{
+				From:  rolluptypes.AliasedL1CrossDomainMessengerAddress,
+				To:    &to,
+				Value: new(big.Int),
+				Data:  relayedFinalizeBridgeERC20(t, common.HexToAddress("0x07")),
+			},
+			problem: "not the L2 standard bridge",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d, err := deposit.Decode(depositLog(t, portal, test.depositTx))
+			require.NoError(t, err)
+			require.Len(t, d.Problems, 1)
+			require.Contains(t, d.Problems[0], test.problem)
+		})
+	}
+}
+
+func TestDecodeReceipt(t *testing.T) {
+	depositTx := &ethtypes.DepositTx{From: from, To: &to, Value: new(big.Int)}
+	receipt := &ethtypes.Receipt{
+		Status: ethtypes.ReceiptStatusSuccessful,
+		Logs: []*ethtypes.Log{
+			{Address: portal, Topics: []common.Hash{{1}}}, // Not a deposit.
+			depositLog(t, portal, depositTx),
+			depositLog(t, common.HexToAddress("0xbad"), depositTx),
+		},
+	}
+
+	deposits, err := deposit.DecodeReceipt(receipt, portal)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+	require.Empty(t, deposits[0].Problems)
+	require.Len(t, deposits[1].Problems, 1)
+	require.Contains(t, deposits[1].Problems[0], "not the OptimismPortal")
+
+	// Without a portal, any emitter is accepted.
+	deposits, err = deposit.DecodeReceipt(receipt, common.Address{})
+	require.NoError(t, err)
+	require.Empty(t, deposits[1].Problems)
+
+	receipt.Status = ethtypes.ReceiptStatusFailed
+	deposits, err = deposit.DecodeReceipt(receipt, portal)
+	require.NoError(t, err)
+	require.Contains(t, deposits[0].Problems, "op-node ignores the event: the L1 tx failed")
+}
+
+// relayedFinalizeBridgeERC20 returns the data of a deposit that relays a finalizeBridgeERC20 message to target.
+func relayedFinalizeBridgeERC20(t *testing.T, target common.Address) []byte {
+	depositTx := testutils.GenerateERC20DepositTx(t, common.HexToAddress("0x04"), common.HexToAddress("0x03"), big.NewInt(7))
+	relayMessage, _, err := bindings.UnpackRelayedFinalizeBridgeERC20(depositTx.Data())
+	require.NoError(t, err)
+	relayMessage.Target = target
+	data, err := relayMessage.Pack()
+	require.NoError(t, err)
+	return data
+}
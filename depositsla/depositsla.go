@@ -0,0 +1,144 @@
+// Package depositsla tracks how long L1 deposits take to be included on L2, the SLO bridge operators commit to. A
+// deposit is derived from the L1 block that emitted its TransactionDeposited event, so its inclusion delay is the time
+// between that block and the L2 block that includes it. The delays are measured with block timestamps, so they are the
+// same on every node and while syncing old blocks.
+package depositsla
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/builder"
+	"github.com/polymerdao/monomer/utils"
+)
+
+// DefaultWindow is how long included deposits count towards the maximum inclusion delay by default.
+const DefaultWindow = time.Hour
+
+// Config configures the tracker.
+type Config struct {
+	// MaxInclusionDelay is the longest a deposit may take to be included before the SLO is breached.
+	MaxInclusionDelay time.Duration
+	// Window is how long, in L2 block time, an included deposit counts towards the maximum inclusion delay. It defaults to
+	// DefaultWindow.
+	Window time.Duration
+}
+
+// inclusion is the inclusion of the deposits of an L2 block.
+type inclusion struct {
+	// time is the timestamp of the L2 block.
+	time  uint64
+	delay time.Duration
+}
+
+// Tracker is a builder.Interceptor that measures the inclusion delay of the deposits in every block the builder builds.
+// It doesn't add txs to blocks.
+type Tracker struct {
+	maxInclusionDelay time.Duration
+	window            time.Duration
+	metrics           Metrics
+	onErr             func(error)
+
+	mu         sync.Mutex
+	inclusions []inclusion
+	breached   bool
+}
+
+var _ builder.Interceptor = (*Tracker)(nil)
+
+// New creates a Tracker. onErr is called once with an error describing the breach when the maximum inclusion delay in the
+// window first exceeds cfg.MaxInclusionDelay, and again after the delays recover and exceed it anew.
+func New(cfg *Config, metrics Metrics, onErr func(error)) *Tracker {
+	window := cfg.Window
+	if window == 0 {
+		window = DefaultWindow
+	}
+	return &Tracker{
+		maxInclusionDelay: cfg.MaxInclusionDelay,
+		window:            window,
+		metrics:           metrics,
+		onErr:             onErr,
+	}
+}
+
+// Intercept implements builder.Interceptor. It doesn't add txs.
+func (*Tracker) Intercept(_ context.Context, _ uint64) (bfttypes.Txs, error) {
+	return nil, nil
+}
+
+// OnBlock records the inclusion delay of the block's deposits and updates the maximum delay in the window.
+func (t *Tracker) OnBlock(_ context.Context, block *monomer.Block) error {
+	depositTxs, err := monomer.GetDepositTxs(block.Txs.ToSliceOfBytes())
+	if err != nil {
+		return fmt.Errorf("get deposit txs: %v", err)
+	}
+	l1Time, err := l1OriginTime(block.Header.Time, depositTxs[0].Data())
+	if err != nil {
+		return err
+	}
+	var delay time.Duration
+	if block.Header.Time > l1Time {
+		delay = time.Duration(block.Header.Time-l1Time) * time.Second
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// The first deposit tx is the L1 attributes tx, which isn't a user deposit.
+	userDeposits := len(depositTxs) - 1
+	for range userDeposits {
+		t.metrics.RecordInclusion(delay)
+	}
+	if userDeposits > 0 {
+		t.inclusions = append(t.inclusions, inclusion{
+			time:  block.Header.Time,
+			delay: delay,
+		})
+	}
+
+	windowSeconds := uint64(t.window / time.Second)
+	t.inclusions = slices.DeleteFunc(t.inclusions, func(i inclusion) bool {
+		return i.time+windowSeconds < block.Header.Time
+	})
+	var maxDelay time.Duration
+	for _, i := range t.inclusions {
+		maxDelay = max(maxDelay, i.delay)
+	}
+	breached := maxDelay > t.maxInclusionDelay
+	t.metrics.SetMaxInclusionDelay(maxDelay)
+	t.metrics.SetBreached(breached)
+	if breached && !t.breached {
+		t.onErr(fmt.Errorf("deposit inclusion SLO breached at block %d: a deposit took %s to be included, more than %s",
+			block.Header.Height, maxDelay, t.maxInclusionDelay))
+	}
+	t.breached = breached
+	return nil
+}
+
+// Breached reports whether a deposit included in the window took longer than the maximum inclusion delay.
+func (t *Tracker) Breached() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.breached
+}
+
+// l1OriginTime returns the timestamp of the L1 block the L1 attributes tx data describes.
+func l1OriginTime(l2Time uint64, data []byte) (uint64, error) {
+	// The tracker doesn't know when the rollup activates Ecotone, so the format is picked by the function selector.
+	rollupCfg := new(rollup.Config)
+	if bytes.HasPrefix(data, derive.L1InfoFuncEcotoneBytes4) {
+		rollupCfg.EcotoneTime = utils.Ptr(uint64(0))
+	}
+	info, err := derive.L1BlockInfoFromBytes(rollupCfg, l2Time, data)
+	if err != nil {
+		return 0, fmt.Errorf("decode l1 attributes tx: %v", err)
+	}
+	return info.Time, nil
+}
@@ -0,0 +1,90 @@
+package depositsla_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer/depositsla"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+type testMetrics struct {
+	delays   []time.Duration
+	maxDelay time.Duration
+	breached bool
+}
+
+func (m *testMetrics) RecordInclusion(delay time.Duration) {
+	m.delays = append(m.delays, delay)
+}
+
+func (m *testMetrics) SetMaxInclusionDelay(delay time.Duration) {
+	m.maxDelay = delay
+}
+
+func (m *testMetrics) SetBreached(breached bool) {
+	m.breached = breached
+}
+
+// onBlock notifies the tracker of a block at l2Time with the deposits, derived from an L1 block at time 0.
+func onBlock(t *testing.T, tracker *depositsla.Tracker, l1InfoTx *gethtypes.Transaction, l2Time uint64, depositTxs ...*gethtypes.Transaction) {
+	block := testutils.GenerateBlockFromEthTxs(t, l1InfoTx, depositTxs, nil)
+	block.Header.Time = l2Time
+	require.NoError(t, tracker.OnBlock(context.Background(), block))
+}
+
+func TestTracker(t *testing.T) {
+	l1InfoTx, depositTx, _ := testutils.GenerateEthTxs(t)
+	metrics := new(testMetrics)
+	var errs []error
+	tracker := depositsla.New(&depositsla.Config{
+		MaxInclusionDelay: 30 * time.Second,
+		Window:            time.Minute,
+	}, metrics, func(err error) {
+		errs = append(errs, err)
+	})
+
+	txs, err := tracker.Intercept(context.Background(), 1)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	onBlock(t, tracker, l1InfoTx, 10, depositTx, depositTx)
+	require.Equal(t, []time.Duration{10 * time.Second, 10 * time.Second}, metrics.delays)
+	require.Equal(t, 10*time.Second, metrics.maxDelay)
+	require.False(t, metrics.breached)
+	require.Empty(t, errs)
+
+	// A late deposit breaches the SLO once.
+	onBlock(t, tracker, l1InfoTx, 100, depositTx)
+	require.Equal(t, 100*time.Second, metrics.maxDelay)
+	require.True(t, metrics.breached)
+	require.True(t, tracker.Breached())
+	require.Len(t, errs, 1)
+	require.ErrorContains(t, errs[0], "a deposit took 1m40s to be included")
+
+	// Blocks without deposits don't add delays, and the late deposit stays in the window.
+	onBlock(t, tracker, l1InfoTx, 120)
+	require.Len(t, metrics.delays, 3)
+	require.True(t, metrics.breached)
+	require.Len(t, errs, 1)
+
+	// The SLO recovers once the late deposit leaves the window.
+	onBlock(t, tracker, l1InfoTx, 200)
+	require.Zero(t, metrics.maxDelay)
+	require.False(t, metrics.breached)
+	require.False(t, tracker.Breached())
+}
+
+func TestTrackerEcotone(t *testing.T) {
+	_, depositTx, _ := testutils.GenerateEthTxs(t)
+	metrics := new(testMetrics)
+	tracker := depositsla.New(&depositsla.Config{MaxInclusionDelay: time.Minute}, metrics, func(err error) {
+		require.NoError(t, err)
+	})
+
+	onBlock(t, tracker, testutils.GenerateEcotoneL1InfoTx(t), 12, depositTx)
+	require.Equal(t, []time.Duration{12 * time.Second}, metrics.delays)
+}
@@ -0,0 +1,82 @@
+package depositsla
+
+import (
+	"time"
+
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "deposit_sla"
+
+// InclusionDelayBucketsSeconds are the buckets of the deposit inclusion delay histogram. op-node includes deposits
+// within the sequencer drift, which is 10 minutes before Fjord and 30 minutes from Fjord on.
+var InclusionDelayBucketsSeconds = []float64{2, 6, 12, 24, 60, 120, 300, 600, 1800}
+
+// Metrics contains metrics collected from the depositsla package.
+type Metrics interface {
+	RecordInclusion(delay time.Duration)
+	SetMaxInclusionDelay(delay time.Duration)
+	SetBreached(breached bool)
+}
+
+type metrics struct {
+	// Time between the L1 block that emitted a deposit and the L2 block that included it.
+	InclusionDelay stdprometheus.Histogram
+	// Longest inclusion delay of the deposits included in the window.
+	MaxInclusionDelay stdprometheus.Gauge
+	// Whether the longest inclusion delay in the window exceeds the SLO.
+	Breached stdprometheus.Gauge
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		InclusionDelay: promauto.NewHistogram(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "inclusion_delay_seconds",
+			Help:      "Time between the L1 block that emitted a deposit and the L2 block that included it",
+			Buckets:   InclusionDelayBucketsSeconds,
+		}),
+		MaxInclusionDelay: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "max_inclusion_delay_seconds",
+			Help:      "Longest inclusion delay of the deposits included in the window",
+		}),
+		Breached: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "breached",
+			Help:      "1 if a deposit included in the window took longer than the maximum inclusion delay, 0 otherwise",
+		}),
+	}
+}
+
+func (m *metrics) RecordInclusion(delay time.Duration) {
+	m.InclusionDelay.Observe(delay.Seconds())
+}
+
+func (m *metrics) SetMaxInclusionDelay(delay time.Duration) {
+	m.MaxInclusionDelay.Set(delay.Seconds())
+}
+
+func (m *metrics) SetBreached(breached bool) {
+	if breached {
+		m.Breached.Set(1)
+	} else {
+		m.Breached.Set(0)
+	}
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) RecordInclusion(time.Duration) {}
+
+func (*noopMetrics) SetMaxInclusionDelay(time.Duration) {}
+
+func (*noopMetrics) SetBreached(bool) {}
@@ -0,0 +1,150 @@
+// Package dispute checks L2OutputOracle output proposals against the output roots Monomer computes, to respond to
+// challenges quickly. An output root only commits to its components through a hash, so the components that diverge are
+// found by comparing the correct components with the ones the proposer's rollup node reported.
+package dispute
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	opeth "github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/eth"
+)
+
+// Components of an output root.
+const (
+	ComponentStateRoot      = "state root"
+	ComponentWithdrawalRoot = "withdrawal root"
+	ComponentBlockHash      = "block hash"
+)
+
+// Report is the result of checking an output proposal.
+type Report struct {
+	// Index is the proposal's index in the L2OutputOracle.
+	Index uint64 `json:"index"`
+	// ProposedRoot, L2BlockNumber, and Timestamp are the proposal's.
+	ProposedRoot  common.Hash `json:"proposedRoot"`
+	L2BlockNumber uint64      `json:"l2BlockNumber"`
+	Timestamp     uint64      `json:"timestamp"`
+	// Correct is the output Monomer computes at the proposal's block.
+	Correct *eth.Output `json:"correct"`
+	// Proposed is the output the proposer's rollup node reported at the proposal's block, if it is known.
+	Proposed *eth.Output `json:"proposed,omitempty"`
+	// Diverging are the components of the proposed root that differ from the correct ones. It is empty if the proposed
+	// root is correct or its components are unknown.
+	Diverging []string `json:"diverging,omitempty"`
+}
+
+// NewReport checks the output proposal at index against the correct output at its block. proposed is the output the
+// proposer's rollup node reports at the block, or nil if it is unknown. It must commit to the proposed root.
+func NewReport(index uint64, proposal *opbindings.TypesOutputProposal, correct, proposed *eth.Output) (*Report, error) {
+	if !proposal.L2BlockNumber.IsUint64() || proposal.L2BlockNumber.Uint64() != uint64(correct.BlockNumber) {
+		return nil, fmt.Errorf("the correct output is at block %d, but the proposal is at block %v", correct.BlockNumber, proposal.L2BlockNumber)
+	}
+	r := &Report{
+		Index:         index,
+		ProposedRoot:  proposal.OutputRoot,
+		L2BlockNumber: uint64(correct.BlockNumber),
+		Timestamp:     proposal.Timestamp.Uint64(),
+		Correct:       correct,
+		Proposed:      proposed,
+	}
+	if proposed == nil {
+		return r, nil
+	}
+	if proposed.BlockNumber != correct.BlockNumber {
+		return nil, fmt.Errorf("the proposed output is at block %d, but the proposal is at block %d", proposed.BlockNumber, correct.BlockNumber)
+	}
+	if root := OutputRoot(proposed); root != r.ProposedRoot {
+		return nil, fmt.Errorf("the proposer's output commits to %s, not the proposed root %s", root, r.ProposedRoot)
+	}
+	if r.ProposedRoot == correct.OutputRoot {
+		return r, nil
+	}
+	correctComponents, proposedComponents := components(correct), components(proposed)
+	for i, name := range componentNames {
+		if proposedComponents[i] != correctComponents[i] {
+			r.Diverging = append(r.Diverging, name)
+		}
+	}
+	return r, nil
+}
+
+// Valid reports whether the proposed root is the correct one.
+func (r *Report) Valid() bool {
+	return r.ProposedRoot == r.Correct.OutputRoot
+}
+
+// OutputRoot returns the version 0 output root the output's components commit to.
+func OutputRoot(output *eth.Output) common.Hash {
+	return common.Hash(opeth.OutputRoot(&opeth.OutputV0{
+		StateRoot:                opeth.Bytes32(output.StateRoot),
+		MessagePasserStorageRoot: opeth.Bytes32(output.WithdrawalStorageRoot),
+		BlockHash:                output.BlockHash,
+	}))
+}
+
+// Print writes the report in a human-readable form.
+func (r *Report) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', 0)
+	rows := [][2]string{
+		{"Output index", fmt.Sprint(r.Index)},
+		{"L2 block", fmt.Sprint(r.L2BlockNumber)},
+		{"Timestamp", fmt.Sprint(r.Timestamp)},
+		{"Proposed root", r.ProposedRoot.Hex()},
+		{"Correct root", r.Correct.OutputRoot.Hex()},
+	}
+	for _, row := range rows {
+		if _, err := fmt.Fprintf(tw, "%s:\t%s\n", row[0], row[1]); err != nil {
+			return err
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if r.Valid() {
+		_, err := fmt.Fprintln(w, "The proposed root is correct.")
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "The proposed root is INVALID."); err != nil {
+		return err
+	}
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "Component\tCorrect\tProposed"); err != nil {
+		return err
+	}
+	correct := components(r.Correct)
+	for i, name := range componentNames {
+		proposed := "unknown"
+		if r.Proposed != nil {
+			proposedComponent := components(r.Proposed)[i]
+			proposed = proposedComponent.Hex()
+			if proposedComponent != correct[i] {
+				proposed += " (diverges)"
+			}
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\n", name, correct[i].Hex(), proposed); err != nil {
+			return err
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if r.Proposed == nil {
+		_, err := fmt.Fprintln(w, "The proposed components are unknown; pass the proposer's rollup node to find the diverging ones.")
+		return err
+	}
+	return nil
+}
+
+var componentNames = [...]string{ComponentStateRoot, ComponentWithdrawalRoot, ComponentBlockHash}
+
+// components returns the output's components in the order of componentNames.
+func components(output *eth.Output) [len(componentNames)]common.Hash {
+	return [...]common.Hash{output.StateRoot, output.WithdrawalStorageRoot, output.BlockHash}
+}
@@ -0,0 +1,106 @@
+package dispute_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/dispute"
+	"github.com/polymerdao/monomer/eth"
+	"github.com/stretchr/testify/require"
+)
+
+func newOutput(stateRoot, withdrawalRoot, blockHash common.Hash) *eth.Output {
+	output := &eth.Output{
+		BlockNumber:           10,
+		BlockHash:             blockHash,
+		StateRoot:             stateRoot,
+		WithdrawalStorageRoot: withdrawalRoot,
+	}
+	output.OutputRoot = dispute.OutputRoot(output)
+	return output
+}
+
+func newProposal(output *eth.Output) *opbindings.TypesOutputProposal {
+	return &opbindings.TypesOutputProposal{
+		OutputRoot:    output.OutputRoot,
+		Timestamp:     big.NewInt(20),
+		L2BlockNumber: new(big.Int).SetUint64(uint64(output.BlockNumber)),
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	correct := newOutput(common.Hash{1}, common.Hash{2}, common.Hash{3})
+
+	tests := map[string]struct {
+		proposed  *eth.Output
+		valid     bool
+		diverging []string
+	}{
+		"valid": {
+			proposed: correct,
+			valid:    true,
+		},
+		"diverging state root": {
+			proposed:  newOutput(common.Hash{4}, common.Hash{2}, common.Hash{3}),
+			diverging: []string{dispute.ComponentStateRoot},
+		},
+		"diverging withdrawal root and block hash": {
+			proposed:  newOutput(common.Hash{1}, common.Hash{5}, common.Hash{6}),
+			diverging: []string{dispute.ComponentWithdrawalRoot, dispute.ComponentBlockHash},
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			report, err := dispute.NewReport(1, newProposal(test.proposed), correct, test.proposed)
+			require.NoError(t, err)
+			require.Equal(t, test.valid, report.Valid())
+			require.Equal(t, test.diverging, report.Diverging)
+			require.Equal(t, uint64(10), report.L2BlockNumber)
+			require.Equal(t, uint64(20), report.Timestamp)
+
+			var buf bytes.Buffer
+			require.NoError(t, report.Print(&buf))
+			if test.valid {
+				require.Contains(t, buf.String(), "The proposed root is correct.")
+			} else {
+				require.Contains(t, buf.String(), "The proposed root is INVALID.")
+				require.Contains(t, buf.String(), "(diverges)")
+			}
+		})
+	}
+}
+
+func TestNewReportUnknownComponents(t *testing.T) {
+	correct := newOutput(common.Hash{1}, common.Hash{2}, common.Hash{3})
+	proposal := newProposal(newOutput(common.Hash{4}, common.Hash{2}, common.Hash{3}))
+
+	report, err := dispute.NewReport(1, proposal, correct, nil)
+	require.NoError(t, err)
+	require.False(t, report.Valid())
+	require.Empty(t, report.Diverging)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.Print(&buf))
+	require.Contains(t, buf.String(), "unknown")
+}
+
+func TestNewReportErrors(t *testing.T) {
+	correct := newOutput(common.Hash{1}, common.Hash{2}, common.Hash{3})
+
+	t.Run("block mismatch", func(t *testing.T) {
+		proposal := newProposal(correct)
+		proposal.L2BlockNumber = big.NewInt(11)
+		_, err := dispute.NewReport(1, proposal, correct, nil)
+		require.ErrorContains(t, err, "the proposal is at block 11")
+	})
+
+	t.Run("proposed output doesn't commit to the proposed root", func(t *testing.T) {
+		proposed := newOutput(common.Hash{4}, common.Hash{2}, common.Hash{3})
+		_, err := dispute.NewReport(1, newProposal(correct), correct, proposed)
+		require.ErrorContains(t, err, "not the proposed root")
+	})
+}
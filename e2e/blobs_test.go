@@ -0,0 +1,86 @@
+package e2e_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/ethereum-optimism/optimism/op-batcher/flags"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/polymerdao/monomer/e2e"
+	"github.com/polymerdao/monomer/environment"
+	"github.com/polymerdao/monomer/node"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+// TestBlobBatches runs the stack with the batcher submitting EIP-4844 blobs instead of calldata, which runs the rollup
+// on Ecotone from genesis.
+func TestBlobBatches(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e tests in short mode")
+	}
+
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+
+	if err := os.Mkdir(artifactsDirectoryName, 0o755); !errors.Is(err, os.ErrExist) {
+		require.NoError(t, err)
+	}
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandler(openLogFile(t, env, "blobs-root-logger"), false)))
+
+	opLogger := log.NewTerminalHandler(openLogFile(t, env, "blobs-op"), false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stack, err := e2e.Setup(ctx, env, &config.InstrumentationConfig{}, &e2e.Options{
+		Verifiers:            1,
+		DataAvailabilityType: flags.BlobsType,
+	}, &e2e.SelectiveListener{
+		OPLogCb: func(r slog.Record) {
+			require.NoError(t, opLogger.Handle(context.Background(), r))
+		},
+		NodeSelectiveListener: &node.SelectiveListener{
+			OnEngineHTTPServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnEngineWebsocketServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnCometServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, stack.RollupConfig.IsEcotone(stack.RollupConfig.Genesis.L2Time))
+
+	// The verifier can only converge with the sequencer by deriving the chain from the blobs.
+	verifierConvergence(t, stack)
+
+	status, err := stack.Verifiers[0].SyncStatus()
+	require.NoError(t, err)
+	var batchTxs int
+	for number := uint64(0); number <= status.CurrentL1.Number; number++ {
+		block, err := stack.L1Client.BlockByNumber(stack.Ctx, new(big.Int).SetUint64(number))
+		require.NoError(t, err)
+		for _, tx := range block.Transactions() {
+			if to := tx.To(); to == nil || *to != stack.RollupConfig.BatchInboxAddress {
+				continue
+			}
+			require.Equal(t, uint8(types.BlobTxType), tx.Type(), "batch tx %s isn't a blob tx", tx.Hash())
+			require.NotEmpty(t, tx.BlobHashes())
+			batchTxs++
+		}
+	}
+	require.NotZero(t, batchTxs, "the batcher didn't submit any batches")
+	t.Logf("The verifier derived the chain from %d blob txs", batchTxs)
+}
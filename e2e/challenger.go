@@ -0,0 +1,141 @@
+package e2e
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/polymerdao/monomer/environment"
+)
+
+// ChallengerConfig configures the op-challenger the stack runs.
+type ChallengerConfig struct {
+	// DisputeGameFactory is the factory whose games the challenger plays.
+	DisputeGameFactory common.Address
+	// PrivKey signs the challenger's moves and pays their bonds.
+	PrivKey *ecdsa.PrivateKey
+}
+
+// runChallenger runs the op-challenger binary at OPStackConfig.ChallengerBin against the DisputeGameFactory. It plays the
+// alphabet games in the factory, checking their root claims against the output roots of the op-node the stack runs, and
+// disputes the invalid ones. op-challenger runs in its own process because its fault proof dependencies need a newer
+// op-geth than Monomer is built with.
+func (op *OPStack) runChallenger(env *environment.Env) error {
+	datadir, err := os.MkdirTemp("", "monomer-e2e-challenger")
+	if err != nil {
+		return fmt.Errorf("make challenger datadir: %v", err)
+	}
+	env.DeferErr("remove challenger datadir", func() error {
+		return os.RemoveAll(datadir)
+	})
+
+	//nolint:gosec // The binary and its arguments come from the stack's config.
+	cmd := exec.Command(op.cfg.ChallengerBin,
+		"--l1-eth-rpc", op.l1URL.String(),
+		"--l1-beacon", op.l1BeaconURL,
+		"--rollup-rpc", op.nodeURL.String(),
+		"--l2-rpc", op.engineURL.String(),
+		"--datadir", datadir,
+		"--trace-type", "alphabet",
+		"--game-factory-address", op.challengerConfig.DisputeGameFactory.Hex(),
+		"--http-poll-interval", op.cfg.ChallengerPollInterval.String(),
+		"--private-key", e2eutils.EncodePrivKeyToString(op.challengerConfig.PrivKey),
+		"--num-confirmations", strconv.FormatUint(op.cfg.NumConfirmations, 10),
+		"--resubmission-timeout", op.cfg.ResubmissionTimeout.String(),
+		"--txmgr.receipt-query-interval", "50ms",
+	)
+	output, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("pipe challenger output: %v", err)
+	}
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start challenger: %v", err)
+	}
+
+	logger := op.newLogger("challenger")
+	logged := make(chan struct{})
+	env.Go(func() {
+		defer close(logged)
+		logLines(logger, output)
+	})
+	// op-challenger stops gracefully when it is interrupted.
+	env.DeferErr("stop challenger", func() error {
+		if err := cmd.Process.Signal(os.Interrupt); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			return fmt.Errorf("interrupt challenger: %v", err)
+		}
+		// The pipe must be drained before Wait closes it.
+		<-logged
+		if err := cmd.Wait(); err != nil && !interrupted(err) {
+			return fmt.Errorf("wait for challenger: %v", err)
+		}
+		return nil
+	})
+	return nil
+}
+
+// logLines logs each line of the output of an external process until it is closed.
+func logLines(logger log.Logger, output io.Reader) {
+	scanner := bufio.NewScanner(output)
+	for scanner.Scan() {
+		logger.Info(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		logger.Error("Failed to read output", "err", err)
+	}
+}
+
+// interrupted reports whether err is the exit error of a process stopped by an interrupt.
+func interrupted(err error) bool {
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return false
+	}
+	status, ok := exitErr.Sys().(syscall.WaitStatus)
+	return ok && status.Signaled() && status.Signal() == os.Interrupt
+}
+
+// outputRootRollupProvider returns rollup clients whose output roots are the ones the event listener picks, so tests can
+// make the proposer propose invalid output roots.
+type outputRootRollupProvider struct {
+	dial.RollupProvider
+	eventListener OPEventListener
+}
+
+func (p *outputRootRollupProvider) RollupClient(ctx context.Context) (dial.RollupClientInterface, error) {
+	client, err := p.RollupProvider.RollupClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &outputRootRollupClient{
+		RollupClientInterface: client,
+		eventListener:         p.eventListener,
+	}, nil
+}
+
+type outputRootRollupClient struct {
+	dial.RollupClientInterface
+	eventListener OPEventListener
+}
+
+func (c *outputRootRollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	output, err := c.RollupClientInterface.OutputAtBlock(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	proposed := *output
+	proposed.OutputRoot = c.eventListener.ProposedOutputRoot(blockNum, output.OutputRoot)
+	return &proposed, nil
+}
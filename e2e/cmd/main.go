@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/polymerdao/monomer/e2e/snapshot"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "e2e",
+		Short: "e2e manages the setup of Monomer's e2e tests.",
+	}
+
+	snapshotCmd = &cobra.Command{
+		Use:   "snapshot",
+		Short: "Capture the e2e setup into a reusable snapshot directory.",
+		Long: "Capture the e2e setup into a reusable snapshot directory. " +
+			"It copies the L1 and L2 allocs, the L1 contract addresses, and the deploy config generated by `make setup-e2e` " +
+			"from the optimism monorepo, so CI and other checkouts can run the e2e tests without deploying the contracts again.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			commit, err := snapshot.OptimismCommit(optimismDir)
+			if err != nil {
+				return err
+			} else if commit == "" {
+				return fmt.Errorf("optimism monorepo is not checked out at %s", optimismDir)
+			}
+			manifest, err := snapshot.Create(optimismDir, snapshotDir, commit)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "wrote snapshot of optimism %s to %s\n", manifest.OptimismCommit, snapshotDir)
+			return nil
+		},
+	}
+
+	verifyCmd = &cobra.Command{
+		Use:   "verify",
+		Short: "Check a snapshot's files against its manifest.",
+		Long: "Check a snapshot's files against its manifest. " +
+			"If the optimism monorepo is checked out, the snapshot must also have been generated from its commit.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			commit, err := snapshot.OptimismCommit(optimismDir)
+			if err != nil {
+				return err
+			}
+			manifest, err := snapshot.Verify(snapshotDir, commit)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "snapshot of optimism %s in %s is valid\n", manifest.OptimismCommit, snapshotDir)
+			return nil
+		},
+	}
+
+	optimismDir string
+	snapshotDir string
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	rootCmd.PersistentFlags().StringVar(&optimismDir, "optimism-dir", "e2e/optimism", "optimism monorepo the e2e setup ran in")
+	rootCmd.PersistentFlags().StringVar(&snapshotDir, "snapshot-dir", "e2e/artifacts/snapshot", "snapshot directory")
+	rootCmd.AddCommand(snapshotCmd, verifyCmd)
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		cancel()   // cancel is not called on os.Exit, we have to call it manually
+		os.Exit(1) //nolint:gocritic // Doesn't recognize that cancel() is called.
+	}
+}
@@ -0,0 +1,171 @@
+package e2e
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-node/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// pollInterval is how often the helpers that wait for L1 state check it.
+const pollInterval = 250 * time.Millisecond
+
+// WaitL1Time waits until the L1 head's timestamp reaches timestamp.
+//
+// It fast forwards the L1 clock, so L1 builds the blocks up to timestamp right away instead of in real time. L2 follows
+// the real time, though, and op-node can only adopt L1 blocks that are older than its L2 head. The clock is therefore
+// only advanced as far as op-node's L1 origin can lag behind the L1 head without leaving the sequencing window or
+// exceeding the sequencer drift; the rest is waited out in real time.
+func (s *StackConfig) WaitL1Time(timestamp uint64) error {
+	for {
+		head, err := s.L1Client.BlockByNumber(s.Ctx, nil)
+		if err != nil {
+			return fmt.Errorf("get the latest L1 block: %v", err)
+		}
+		if head.Time() >= timestamp {
+			return nil
+		}
+		if ahead := time.Unix(int64(timestamp), 0).Sub(s.l1Clock.Now()); ahead > 0 {
+			headroom, err := s.l1TimeHeadroom()
+			if err != nil {
+				return err
+			}
+			if step := min(ahead, headroom); step > 0 {
+				s.l1Clock.AdvanceTime(step)
+			}
+		}
+		select {
+		case <-s.Ctx.Done():
+			return s.Ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// l1TimeHeadroom returns how far the L1 clock can be advanced without leaving op-node unable to build on the L1 head.
+func (s *StackConfig) l1TimeHeadroom() (time.Duration, error) {
+	l2Head, err := s.MonomerClient.BlockByNumber(s.Ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("get the latest L2 block: %v", err)
+	}
+	// Stay within half of each bound, since op-node's L1 origin is a few blocks behind the L2 head as well.
+	maxLead := min(
+		time.Duration(s.RollupConfig.SeqWindowSize*s.l1BlockTime)*time.Second/2, //nolint:mnd
+		time.Duration(s.RollupConfig.MaxSequencerDrift)*time.Second/2,           //nolint:mnd
+	)
+	lead := s.l1Clock.Now().Sub(time.Unix(int64(l2Head.Time()), 0))
+	return maxLead - lead, nil
+}
+
+// WaitFinalizationPeriod waits out the L2OutputOracle's finalization period, fast forwarding L1 as WaitL1Time does.
+// Afterwards, the outputs proposed and the withdrawals proven before the call can be finalized.
+func (s *StackConfig) WaitFinalizationPeriod() error {
+	finalizationPeriod, err := s.L2OutputOracleCaller.FinalizationPeriodSeconds(&bind.CallOpts{Context: s.Ctx})
+	if err != nil {
+		return fmt.Errorf("get the finalization period: %v", err)
+	}
+	head, err := s.L1Client.BlockByNumber(s.Ctx, nil)
+	if err != nil {
+		return fmt.Errorf("get the latest L1 block: %v", err)
+	}
+	// The contracts require strictly more than the finalization period to have passed.
+	return s.WaitL1Time(head.Time() + finalizationPeriod.Uint64() + 1)
+}
+
+// WaitForOutput waits for the proposer to propose the first output that includes l2BlockNumber and returns the
+// output's index and proposal.
+func (s *StackConfig) WaitForOutput(l2BlockNumber *big.Int) (*big.Int, *bindings.TypesOutputProposal, error) {
+	opts := &bind.CallOpts{Context: s.Ctx}
+	for {
+		latest, err := s.L2OutputOracleCaller.LatestBlockNumber(opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("get the latest output block number: %v", err)
+		}
+		if latest.Cmp(l2BlockNumber) >= 0 {
+			break
+		}
+		select {
+		case <-s.Ctx.Done():
+			return nil, nil, s.Ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+	index, err := s.L2OutputOracleCaller.GetL2OutputIndexAfter(opts, l2BlockNumber)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get the index of the output after block %d: %v", l2BlockNumber, err)
+	}
+	output, err := s.L2OutputOracleCaller.GetL2Output(opts, index)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get output %d: %v", index, err)
+	}
+	return index, &output, nil
+}
+
+// OutputRootAt returns the output root of Monomer's block at l2BlockNumber.
+func (s *StackConfig) OutputRootAt(l2BlockNumber *big.Int) (common.Hash, error) {
+	block, err := s.MonomerClient.BlockByNumber(s.Ctx, l2BlockNumber)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("get L2 block %d: %v", l2BlockNumber, err)
+	}
+	proof, err := s.MonomerClient.GetProof(s.Ctx, predeploys.L2ToL1MessagePasserAddr, nil, block.Number())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.Hash(eth.OutputRoot(&eth.OutputV0{
+		StateRoot:                eth.Bytes32(block.Root()),
+		MessagePasserStorageRoot: eth.Bytes32(proof.StorageHash),
+		BlockHash:                block.Hash(),
+	})), nil
+}
+
+// CheckOutputs checks every output in the L2OutputOracle: outputs are proposed every submission interval blocks, in
+// order, and their roots are the output roots of Monomer's blocks.
+func (s *StackConfig) CheckOutputs() error {
+	opts := &bind.CallOpts{Context: s.Ctx}
+	next, err := s.L2OutputOracleCaller.NextOutputIndex(opts)
+	if err != nil {
+		return fmt.Errorf("get the next output index: %v", err)
+	}
+	startingBlockNumber, err := s.L2OutputOracleCaller.StartingBlockNumber(opts)
+	if err != nil {
+		return fmt.Errorf("get the starting block number: %v", err)
+	}
+	submissionInterval, err := s.L2OutputOracleCaller.SubmissionInterval(opts)
+	if err != nil {
+		return fmt.Errorf("get the submission interval: %v", err)
+	}
+	var errs []error
+	var lastTimestamp uint64
+	for i := int64(0); i < next.Int64(); i++ {
+		output, err := s.L2OutputOracleCaller.GetL2Output(opts, big.NewInt(i))
+		if err != nil {
+			return fmt.Errorf("get output %d: %v", i, err)
+		}
+		//nolint:gocritic
+		// wantBlockNumber = startingBlockNumber + (i+1) * submissionInterval
+		wantBlockNumber := new(big.Int).Add(startingBlockNumber, new(big.Int).Mul(big.NewInt(i+1), submissionInterval))
+		if output.L2BlockNumber.Cmp(wantBlockNumber) != 0 {
+			errs = append(errs, fmt.Errorf("output %d is for block %d, want %d", i, output.L2BlockNumber, wantBlockNumber))
+			continue
+		}
+		if output.Timestamp.Uint64() < lastTimestamp {
+			errs = append(errs, fmt.Errorf("output %d was proposed at %d, before the previous output", i, output.Timestamp))
+		}
+		lastTimestamp = output.Timestamp.Uint64()
+		outputRoot, err := s.OutputRootAt(output.L2BlockNumber)
+		if err != nil {
+			return err
+		}
+		if outputRoot != output.OutputRoot {
+			errs = append(errs, fmt.Errorf("output %d has root %s, but block %d's is %s", i,
+				common.Hash(output.OutputRoot), output.L2BlockNumber, outputRoot))
+		}
+	}
+	return errors.Join(errs...)
+}
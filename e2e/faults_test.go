@@ -0,0 +1,113 @@
+package e2e_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/polymerdao/monomer/e2e"
+	"github.com/polymerdao/monomer/environment"
+	"github.com/polymerdao/monomer/faults"
+	"github.com/polymerdao/monomer/node"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+// TestABCIFaults injects execution hiccups into the sequencer's app and checks that op-node retries and the chain
+// recovers: a FinalizeBlock slower than the L2 block time, a Commit that fails once, and a FinalizeBlock that fails
+// until it is cleared.
+func TestABCIFaults(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e tests in short mode")
+	}
+
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+
+	if err := os.Mkdir(artifactsDirectoryName, 0o755); !errors.Is(err, os.ErrExist) {
+		require.NoError(t, err)
+	}
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandler(openLogFile(t, env, "faults-root-logger"), false)))
+
+	opLogger := log.NewTerminalHandler(openLogFile(t, env, "faults-op"), false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	injector := faults.NewInjector()
+	stack, err := e2e.Setup(ctx, env, &config.InstrumentationConfig{}, &e2e.Options{
+		Faults: injector,
+	}, &e2e.SelectiveListener{
+		OPLogCb: func(r slog.Record) {
+			require.NoError(t, opLogger.Handle(context.Background(), r))
+		},
+		NodeSelectiveListener: &node.SelectiveListener{
+			OnEngineHTTPServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnEngineWebsocketServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnCometServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, stack.WaitL2(1))
+
+	for _, fault := range []struct {
+		name   string
+		method string
+		fault  faults.Fault
+		// duration is how long the fault is injected before it is cleared. Zero leaves it to Fault.Times.
+		duration time.Duration
+	}{
+		{
+			name:   "slow FinalizeBlock",
+			method: faults.MethodFinalizeBlock,
+			fault: faults.Fault{
+				Delay: 5 * time.Second,
+				Times: 1,
+			},
+		},
+		{
+			name:   "Commit error once",
+			method: faults.MethodCommit,
+			fault: faults.Fault{
+				Err:   errors.New("injected commit error"),
+				Times: 1,
+			},
+		},
+		{
+			name:   "FinalizeBlock errors",
+			method: faults.MethodFinalizeBlock,
+			fault: faults.Fault{
+				Err: errors.New("injected finalize block error"),
+			},
+			duration: 10 * time.Second,
+		},
+	} {
+		status, err := stack.SyncStatus()
+		require.NoError(t, err)
+		injector.Inject(fault.method, fault.fault)
+		if fault.duration > 0 {
+			time.Sleep(fault.duration)
+			injector.Clear(fault.method)
+		}
+
+		// op-node keeps building on Monomer's chain, and the blocks built around the fault become safe.
+		require.NoError(t, stack.WaitL2(3))
+		_, err = stack.WaitSafeHead(new(big.Int).SetUint64(status.UnsafeL2.Number + 1))
+		require.NoError(t, err, fault.name)
+		t.Logf("The chain recovered from a %s", fault.name)
+	}
+}
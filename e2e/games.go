@@ -0,0 +1,128 @@
+package e2e
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	monomerbindings "github.com/polymerdao/monomer/bindings/generated"
+)
+
+// Game is a dispute game the proposer created for an output.
+type Game struct {
+	Index         *big.Int
+	Address       common.Address
+	Timestamp     uint64
+	RootClaim     common.Hash
+	L2BlockNumber *big.Int
+}
+
+// WaitForGame waits for the proposer to create a dispute game for an output at or after l2BlockNumber and returns the
+// first such game. The stack must run with ProposePermissionedGames or ProposeFaultDisputeGames.
+func (s *StackConfig) WaitForGame(l2BlockNumber *big.Int) (*Game, error) {
+	for {
+		games, err := s.games()
+		if err != nil {
+			return nil, err
+		}
+		for _, game := range games {
+			if game.L2BlockNumber.Cmp(l2BlockNumber) >= 0 {
+				return game, nil
+			}
+		}
+		select {
+		case <-s.Ctx.Done():
+			return nil, s.Ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// CheckGames checks every dispute game the proposer created in the DisputeGameFactory: games are created for increasing
+// blocks, and their root claims are the output roots of Monomer's blocks.
+func (s *StackConfig) CheckGames() error {
+	games, err := s.games()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for i, game := range games {
+		if i > 0 && game.L2BlockNumber.Cmp(games[i-1].L2BlockNumber) <= 0 {
+			errs = append(errs, fmt.Errorf("game %d is for block %d, not after the previous game's block %d",
+				game.Index, game.L2BlockNumber, games[i-1].L2BlockNumber))
+		}
+		outputRoot, err := s.OutputRootAt(game.L2BlockNumber)
+		if err != nil {
+			return err
+		}
+		if outputRoot != game.RootClaim {
+			errs = append(errs, fmt.Errorf("game %d claims root %s, but block %d's is %s", game.Index, game.RootClaim,
+				game.L2BlockNumber, outputRoot))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WaitForChallenge waits for the challenger to counter the root claim of the fault dispute game. The stack must run with
+// ProposeFaultDisputeGames.
+func (s *StackConfig) WaitForChallenge(game *Game) error {
+	faultDisputeGame, err := opbindings.NewFaultDisputeGameCaller(game.Address, s.L1Client)
+	if err != nil {
+		return fmt.Errorf("new fault dispute game caller: %v", err)
+	}
+	for {
+		// The first claim is the root claim, so any other claim is a move against it.
+		claims, err := faultDisputeGame.ClaimDataLen(&bind.CallOpts{Context: s.Ctx})
+		if err != nil {
+			return fmt.Errorf("get the claim count of game %d: %v", game.Index, err)
+		}
+		if claims.Cmp(common.Big1) > 0 {
+			return nil
+		}
+		select {
+		case <-s.Ctx.Done():
+			return s.Ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// games returns the dispute games of the proposer's game type in the DisputeGameFactory, oldest first.
+func (s *StackConfig) games() ([]*Game, error) {
+	opts := &bind.CallOpts{Context: s.Ctx}
+	count, err := s.DisputeGameFactory.GameCount(opts)
+	if err != nil {
+		return nil, fmt.Errorf("get the game count: %v", err)
+	}
+	if count.Sign() == 0 {
+		return nil, nil
+	}
+	// FindLatestGames searches backwards from the start index.
+	results, err := s.DisputeGameFactory.FindLatestGames(opts, s.ProposerMode.gameType(), new(big.Int).Sub(count, common.Big1), count)
+	if err != nil {
+		return nil, fmt.Errorf("find the games: %v", err)
+	}
+	games := make([]*Game, 0, len(results))
+	for _, result := range results {
+		games = append(games, newGame(&result))
+	}
+	slices.Reverse(games)
+	return games, nil
+}
+
+func newGame(result *monomerbindings.IDisputeGameFactoryGameSearchResult) *Game {
+	return &Game{
+		Index: result.Index,
+		// The game's ID packs its type, its creation timestamp, and its address, in its low 20 bytes.
+		Address:   common.BytesToAddress(result.Metadata[:]),
+		Timestamp: result.Timestamp,
+		RootClaim: result.RootClaim,
+		// The extra data of output games is the output's L2 block number.
+		L2BlockNumber: new(big.Int).SetBytes(result.ExtraData[:min(len(result.ExtraData), common.HashLength)]),
+	}
+}
@@ -0,0 +1,150 @@
+package e2e_test
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"math/big"
+	"os"
+	"os/exec"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cometbft/cometbft/config"
+	opeth "github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/polymerdao/monomer/e2e"
+	"github.com/polymerdao/monomer/environment"
+	"github.com/polymerdao/monomer/node"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+var challengerBin = flag.String("op-challenger", "op-challenger", "op-challenger binary TestChallenger runs; it is skipped if the binary doesn't exist")
+
+// TestPermissionedGames runs the proposer as it runs on chains with fault proofs, creating permissioned dispute games in
+// the DisputeGameFactory instead of proposing to the L2OutputOracle.
+func TestPermissionedGames(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e tests in short mode")
+	}
+
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+
+	if err := os.Mkdir(artifactsDirectoryName, 0o755); !errors.Is(err, os.ErrExist) {
+		require.NoError(t, err)
+	}
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandler(openLogFile(t, env, "games-root-logger"), false)))
+
+	opLogger := log.NewTerminalHandler(openLogFile(t, env, "games-op"), false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stack, err := e2e.Setup(ctx, env, &config.InstrumentationConfig{}, &e2e.Options{ProposerMode: e2e.ProposePermissionedGames}, &e2e.SelectiveListener{
+		OPLogCb: func(r slog.Record) {
+			require.NoError(t, opLogger.Handle(context.Background(), r))
+		},
+		NodeSelectiveListener: &node.SelectiveListener{
+			OnEngineHTTPServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnEngineWebsocketServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnCometServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	head, err := stack.MonomerClient.BlockByNumber(stack.Ctx, nil)
+	require.NoError(t, err)
+	game, err := stack.WaitForGame(head.Number())
+	require.NoError(t, err)
+	t.Logf("The proposer created game %d for block %d", game.Index, game.L2BlockNumber)
+
+	// The proposer creates the next game for a later safe head.
+	_, err = stack.WaitForGame(new(big.Int).Add(game.L2BlockNumber, common.Big1))
+	require.NoError(t, err)
+	require.NoError(t, stack.CheckGames())
+
+	// The L2OutputOracle isn't used.
+	next, err := stack.L2OutputOracleCaller.NextOutputIndex(&bind.CallOpts{Context: stack.Ctx})
+	require.NoError(t, err)
+	require.Zero(t, next.Sign())
+}
+
+// TestChallenger makes the proposer propose an invalid output root in a fault dispute game and checks that the
+// challenger disputes it.
+func TestChallenger(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e tests in short mode")
+	}
+	if _, err := exec.LookPath(*challengerBin); err != nil {
+		t.Skipf("skipping challenger test: %v; build op-challenger with make op-challenger", err)
+	}
+
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+
+	if err := os.Mkdir(artifactsDirectoryName, 0o755); !errors.Is(err, os.ErrExist) {
+		require.NoError(t, err)
+	}
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandler(openLogFile(t, env, "challenger-root-logger"), false)))
+
+	opLogger := log.NewTerminalHandler(openLogFile(t, env, "challenger-op"), false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Only the first output the proposer fetches is invalid.
+	var injected atomic.Bool
+	stack, err := e2e.Setup(ctx, env, &config.InstrumentationConfig{}, &e2e.Options{
+		ProposerMode: e2e.ProposeFaultDisputeGames,
+		OPStackOptions: []e2e.OPStackOption{func(cfg *e2e.OPStackConfig) {
+			cfg.ChallengerBin = *challengerBin
+		}},
+	}, &e2e.SelectiveListener{
+		OPLogCb: func(r slog.Record) {
+			require.NoError(t, opLogger.Handle(context.Background(), r))
+		},
+		ProposedOutputRootCb: func(_ uint64, outputRoot opeth.Bytes32) opeth.Bytes32 {
+			if injected.CompareAndSwap(false, true) {
+				return opeth.Bytes32{0x01}
+			}
+			return outputRoot
+		},
+		NodeSelectiveListener: &node.SelectiveListener{
+			OnEngineHTTPServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnEngineWebsocketServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnCometServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	game, err := stack.WaitForGame(common.Big0)
+	require.NoError(t, err)
+	outputRoot, err := stack.OutputRootAt(game.L2BlockNumber)
+	require.NoError(t, err)
+	require.NotEqual(t, outputRoot, game.RootClaim)
+	t.Logf("The proposer created game %d for block %d with an invalid root claim", game.Index, game.L2BlockNumber)
+
+	require.NoError(t, stack.WaitForChallenge(game))
+}
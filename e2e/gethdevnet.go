@@ -9,14 +9,35 @@ import (
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/geth"
 	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/polymerdao/monomer/environment"
 )
 
-func gethdevnet(env *environment.Env, blockTime uint64, genesis *core.Genesis) (*rpc.Client, string, error) {
-	blobsDirectory := os.TempDir()
+// gethdevnet runs a geth L1 that builds a block every blockTime seconds of the returned clock's time. The clock follows
+// the system clock and can be advanced to build blocks ahead of it.
+//
+// It also serves the blobs of the L1 blocks on a stub of the beacon API, whose address it returns. op-node fetches
+// blobs from it once Ecotone is active, which requires Cancun to be active on L1.
+func gethdevnet(
+	env *environment.Env,
+	blockTime uint64,
+	genesis *core.Genesis,
+) (*rpc.Client, string, *clock.AdvancingClock, string, error) {
+	blobsDirectory, err := os.MkdirTemp("", "monomer-e2e-blobs")
+	if err != nil {
+		return nil, "", nil, "", fmt.Errorf("make blobs directory: %v", err)
+	}
+	env.DeferErr("remove blobs directory", func() error {
+		return os.RemoveAll(blobsDirectory)
+	})
+
+	beacon := fakebeacon.NewBeacon(log.Root().With("monomer-e2e-component", "l1-beacon"), blobsDirectory, genesis.Timestamp, blockTime)
+	if err := beacon.Start("127.0.0.1:0"); err != nil {
+		return nil, "", nil, "", fmt.Errorf("start beacon API: %v", err)
+	}
+	env.DeferErr("close beacon API", beacon.Close)
 
-	beacon := fakebeacon.NewBeacon(nil, blobsDirectory, genesis.Timestamp, blockTime)
 	myClock := clock.NewAdvancingClock(time.Second) // Arbitrary working duration. Eventually consumed by geth lifecycle instances.
 	node, _, err := geth.InitL1(
 		genesis.Config.ChainID.Uint64(),
@@ -27,15 +48,15 @@ func gethdevnet(env *environment.Env, blockTime uint64, genesis *core.Genesis) (
 		beacon,
 	)
 	if err != nil {
-		return nil, "", fmt.Errorf("init geth L1: %w", err)
+		return nil, "", nil, "", fmt.Errorf("init geth L1: %w", err)
 	}
 
 	err = node.Start()
 	if err != nil {
-		return nil, "", fmt.Errorf("start geth L1: %w", err)
+		return nil, "", nil, "", fmt.Errorf("start geth L1: %w", err)
 	}
 
 	env.DeferErr("close geth node", node.Close)
 
-	return node.Attach(), node.WSEndpoint(), nil
+	return node.Attach(), node.WSEndpoint(), myClock, beacon.BeaconAddr(), nil
 }
@@ -0,0 +1,427 @@
+package e2e
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/ethereum-optimism/optimism/op-batcher/flags"
+	"github.com/ethereum-optimism/optimism/op-conductor/conductor"
+	conductorrpc "github.com/ethereum-optimism/optimism/op-conductor/rpc"
+	ope2econfig "github.com/ethereum-optimism/optimism/op-e2e/config"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	"github.com/ethereum-optimism/optimism/op-node/p2p/store"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	opclient "github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/p2p/host/peerstore/pstoremem"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	ma "github.com/multiformats/go-multiaddr"
+	e2eurl "github.com/polymerdao/monomer/e2e/url"
+	"github.com/polymerdao/monomer/environment"
+	"github.com/polymerdao/monomer/jwtauth"
+)
+
+const (
+	haSequencers = 3
+	// haBasePort is the first of the hard-coded ports the HA sequencers listen on. Each sequencer uses haPortsPerSequencer
+	// consecutive ports.
+	haBasePort          = 8900
+	haPortsPerSequencer = 10
+)
+
+// HASequencer is a member of an HA sequencer set: a Monomer node, the op-node driving it, and the op-conductor that
+// decides whether the op-node sequences.
+type HASequencer struct {
+	// ID is the raft server ID of the sequencer's conductor.
+	ID              string
+	MonomerClient   *MonomerClient
+	RollupClient    *sources.RollupClient
+	ConductorClient *conductorrpc.APIClient
+	consensusAddr   string
+	stop            func() error
+	stopped         bool
+}
+
+// ConsensusAddr returns the address of the conductor's raft transport.
+func (s *HASequencer) ConsensusAddr() string {
+	return s.consensusAddr
+}
+
+// Stop fail-stops the Monomer node and the op-node, as in a crash. The conductor keeps running, so it notices the
+// sequencer is down and hands leadership over if it has it.
+func (s *HASequencer) Stop() error {
+	if s.stopped {
+		return nil
+	}
+	s.stopped = true
+	return s.stop()
+}
+
+// Stopped reports whether the sequencer was stopped.
+func (s *HASequencer) Stopped() bool {
+	return s.stopped
+}
+
+type HAStackConfig struct {
+	Ctx          context.Context
+	L1Client     *L1Client
+	RollupConfig *rollup.Config
+	Sequencers   []*HASequencer
+}
+
+// SetupHA creates and runs an L1 and a set of Monomer sequencers under an op-conductor raft quorum for end-to-end
+// testing of HA sequencing. The conductors decide which sequencer's op-node is active. The other op-nodes receive
+// the active sequencer's unsafe blocks over p2p and insert them into their Monomer nodes. A single batcher and proposer
+// follow the active sequencer.
+//
+// Like Setup, it assumes availability of hard-coded local ports, starting at 8900.
+//
+// It returns once the first sequencer is sequencing and every conductor reports a healthy sequencer.
+func SetupHA(ctx context.Context, env *environment.Env, eventListener EventListener) (*HAStackConfig, error) {
+	l1, err := runL1(ctx, env, flags.CalldataType)
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := e2eutils.DefaultMnemonicConfig.Secrets()
+	if err != nil {
+		return nil, fmt.Errorf("get secrets for default mnemonics: %v", err)
+	}
+
+	// Every Monomer node authenticates its op-node with the same secret.
+	engineJWTSecret, err := jwtauth.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("generate engine jwt secret: %v", err)
+	}
+
+	// Every op-node is connected to every other op-node through an in-memory network.
+	network := mocknet.New()
+	env.DeferErr("close mock network", network.Close)
+
+	type member struct {
+		*HASequencer
+		ctx          context.Context
+		env          *environment.Env
+		engineURL    *e2eurl.URL
+		nodeURL      *e2eurl.URL
+		conductorURL *e2eurl.URL
+		host         host.Host
+	}
+	members := make([]*member, haSequencers)
+	var rollupConfig *rollup.Config
+	for i := range members {
+		port := haBasePort + i*haPortsPerSequencer
+		m := &member{
+			HASequencer: &HASequencer{
+				ID:            fmt.Sprintf("sequencer-%d", i),
+				consensusAddr: fmt.Sprintf("127.0.0.1:%d", port+4), //nolint:mnd
+			},
+			env: environment.New(),
+		}
+		members[i] = m
+		memberCtx, cancel := context.WithCancel(ctx)
+		m.ctx = memberCtx
+		m.stop = func() error {
+			cancel()
+			return m.env.Close()
+		}
+		env.DeferErr("stop "+m.ID, m.Stop)
+
+		if m.engineURL, err = e2eurl.ParseString(fmt.Sprintf("ws://127.0.0.1:%d", port)); err != nil {
+			return nil, fmt.Errorf("new monomer url: %v", err)
+		}
+		monomerCometURL, err := e2eurl.ParseString(fmt.Sprintf("http://127.0.0.1:%d", port+1))
+		if err != nil {
+			return nil, fmt.Errorf("new cometBFT url: %v", err)
+		}
+		if m.nodeURL, err = e2eurl.ParseString(fmt.Sprintf("http://127.0.0.1:%d", port+2)); err != nil { //nolint:mnd
+			return nil, fmt.Errorf("new op-node url: %v", err)
+		}
+		if m.conductorURL, err = e2eurl.ParseString(fmt.Sprintf("http://127.0.0.1:%d", port+3)); err != nil { //nolint:mnd
+			return nil, fmt.Errorf("new op-conductor url: %v", err)
+		}
+
+		s := &stack{
+			monomerEngineURL: m.engineURL,
+			monomerCometURL:  monomerCometURL,
+			engineJWTSecret:  [32]byte(engineJWTSecret),
+			eventListener:    eventListener,
+			prometheusCfg: &config.InstrumentationConfig{
+				Prometheus: false,
+			},
+		}
+		if err := s.runMonomer(m.ctx, m.env, l1.latestBlock.Time(), l1.deployConfig.L2ChainID); err != nil {
+			return nil, err
+		}
+		if !m.engineURL.IsReachable(ctx) {
+			return nil, fmt.Errorf("reaching monomer url: %s", m.engineURL.String())
+		}
+		monomerRPCClient, err := rpc.DialContext(ctx, m.engineURL.String())
+		if err != nil {
+			return nil, fmt.Errorf("dial monomer: %v", err)
+		}
+		env.Defer(monomerRPCClient.Close)
+		m.MonomerClient = NewMonomerClient(monomerRPCClient)
+
+		// Every sequencer must start from the same genesis block.
+		l2GenesisBlockHash, err := m.MonomerClient.GenesisHash(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get Monomer genesis block hash: %v", err)
+		}
+		if rollupConfig == nil {
+			rollupConfig, err = l1.deployConfig.RollupConfig(l1.latestBlock, l2GenesisBlockHash, 1)
+			if err != nil {
+				return nil, fmt.Errorf("new rollup config: %v", err)
+			}
+		} else if l2GenesisBlockHash != rollupConfig.Genesis.L2.Hash {
+			return nil, fmt.Errorf("%s has genesis block %s, expected %s", m.ID, l2GenesisBlockHash, rollupConfig.Genesis.L2.Hash)
+		}
+
+		if m.host, err = newMockNetworkHost(network); err != nil {
+			return nil, fmt.Errorf("new p2p host: %v", err)
+		}
+	}
+	if err := network.LinkAll(); err != nil {
+		return nil, fmt.Errorf("link p2p hosts: %v", err)
+	}
+
+	opStack := NewOPStack(
+		l1.url,
+		l1.beaconURL,
+		nil,
+		nil,
+		NodeRPCConfig{},
+		[32]byte(engineJWTSecret),
+		&ProposerConfig{
+			L2OutputOracle: ope2econfig.L1Deployments.L2OutputOracleProxy,
+		},
+		nil,
+		secrets.Batcher,
+		secrets.Proposer,
+		flags.CalldataType,
+		rollupConfig,
+		eventListener,
+	)
+	for _, m := range members {
+		cfg := opStack.nodeConfig(m.engineURL, m.nodeURL)
+		// The conductors start the leader's sequencer.
+		cfg.Driver.SequencerStopped = true
+		cfg.RPC.EnableAdmin = true
+		cfg.P2P = &p2p.Prepared{
+			HostP2P: m.host,
+		}
+		cfg.P2PSigner = &p2p.PreparedSigner{
+			Signer: p2p.NewLocalSigner(secrets.SequencerP2P),
+		}
+		cfg.ConductorEnabled = true
+		cfg.ConductorRpc = m.conductorURL.String()
+		cfg.ConductorRpcTimeout = time.Second
+		if err := opStack.runNode(ctx, m.env, "node-"+m.ID, cfg); err != nil {
+			return nil, err
+		}
+
+		rollupRPCClient, err := rpc.DialContext(ctx, m.nodeURL.String())
+		if err != nil {
+			return nil, fmt.Errorf("dial op-node: %v", err)
+		}
+		env.Defer(rollupRPCClient.Close)
+		m.RollupClient = sources.NewRollupClient(opclient.NewBaseRPCClient(rollupRPCClient))
+	}
+	// Connect the hosts after the op-nodes started so they negotiate the gossip protocols.
+	if err := network.ConnectAllButSelf(); err != nil {
+		return nil, fmt.Errorf("connect p2p hosts: %v", err)
+	}
+
+	for i, m := range members {
+		if err := runConductor(ctx, env, opStack.newLogger("conductor-"+m.ID), m.HASequencer, &conductor.Config{
+			ConsensusAddr: m.conductorURL.Hostname(),
+			ConsensusPort: int(m.conductorURL.PortU16()) + 1,
+			RaftServerID:  m.ID,
+			RaftBootstrap: i == 0, // The first conductor forms the cluster, and the others join it below.
+			NodeRPC:       m.nodeURL.String(),
+			ExecutionRPC:  m.engineURL.String(),
+			Paused:        true,
+			HealthCheck: conductor.HealthCheckConfig{
+				Interval: 1,
+				// The health monitor also checks that the unsafe head progresses every block, so this can be generous.
+				UnsafeInterval: 30, //nolint:mnd
+				// The safe head is not checked, but the interval must be set.
+				SafeInterval: 30, //nolint:mnd
+				// Every op-node is connected to the others, so it has a peer while any other op-node runs.
+				MinPeerCount: 1,
+			},
+			RollupCfg: *rollupConfig,
+			RPC: oprpc.CLIConfig{
+				ListenAddr: m.conductorURL.Hostname(),
+				ListenPort: int(m.conductorURL.PortU16()),
+			},
+		}); err != nil {
+			return nil, fmt.Errorf("run %s conductor: %v", m.ID, err)
+		}
+	}
+
+	// Form the quorum and start sequencing on the leader.
+	leader := members[0]
+	if err := waitFor(ctx, func() (bool, error) {
+		return leader.ConductorClient.Leader(ctx)
+	}); err != nil {
+		return nil, fmt.Errorf("wait for %s to lead: %v", leader.ID, err)
+	}
+	for _, m := range members[1:] {
+		if err := leader.ConductorClient.AddServerAsVoter(ctx, m.ID, m.ConsensusAddr()); err != nil {
+			return nil, fmt.Errorf("add %s as voter: %v", m.ID, err)
+		}
+	}
+	if err := leader.RollupClient.StartSequencer(ctx, rollupConfig.Genesis.L2.Hash); err != nil {
+		return nil, fmt.Errorf("start sequencer: %v", err)
+	}
+
+	ethURLs := make([]string, 0, len(members))
+	rollupURLs := make([]string, 0, len(members))
+	for _, m := range members {
+		ethURLs = append(ethURLs, m.engineURL.String())
+		rollupURLs = append(rollupURLs, m.nodeURL.String())
+	}
+	const networkTimeout = 2 * time.Second
+	rollupProvider, err := dial.NewActiveL2RollupProvider(ctx, rollupURLs, 0, networkTimeout, opStack.newLogger("proposer-dialer"))
+	if err != nil {
+		return nil, fmt.Errorf("new active l2 rollup provider: %v", err)
+	}
+	env.Defer(rollupProvider.Close)
+	endpointProvider, err := dial.NewActiveL2EndpointProvider(ctx, ethURLs, rollupURLs, 0, networkTimeout, opStack.newLogger("batcher-dialer"))
+	if err != nil {
+		return nil, fmt.Errorf("new active l2 endpoint provider: %v", err)
+	}
+	env.Defer(endpointProvider.Close)
+	if err := opStack.runSubmitters(ctx, env, rollupProvider, endpointProvider); err != nil {
+		return nil, err
+	}
+
+	// The followers are healthy once they import the leader's blocks.
+	sequencers := make([]*HASequencer, 0, len(members))
+	for _, m := range members {
+		if err := waitFor(ctx, func() (bool, error) {
+			return m.ConductorClient.SequencerHealthy(ctx)
+		}); err != nil {
+			return nil, fmt.Errorf("wait for %s to be healthy: %v", m.ID, err)
+		}
+		sequencers = append(sequencers, m.HASequencer)
+	}
+	for _, m := range members {
+		if err := m.ConductorClient.Resume(ctx); err != nil {
+			return nil, fmt.Errorf("resume %s conductor: %v", m.ID, err)
+		}
+	}
+
+	return &HAStackConfig{
+		Ctx:          ctx,
+		L1Client:     l1.client,
+		RollupConfig: rollupConfig,
+		Sequencers:   sequencers,
+	}, nil
+}
+
+// runConductor runs the sequencer's conductor until env is closed. It keeps running when the sequencer is stopped.
+func runConductor(ctx context.Context, env *environment.Env, logger log.Logger, s *HASequencer, cfg *conductor.Config) error {
+	raftDir, err := os.MkdirTemp("", "monomer-e2e-"+s.ID)
+	if err != nil {
+		return fmt.Errorf("make raft storage dir: %v", err)
+	}
+	env.DeferErr("remove raft storage dir", func() error {
+		return os.RemoveAll(raftDir)
+	})
+	cfg.RaftStorageDir = raftDir
+
+	opConductor, err := conductor.New(ctx, cfg, logger, "v0.1")
+	if err != nil {
+		return fmt.Errorf("new conductor: %v", err)
+	}
+	if err := opConductor.Start(ctx); err != nil {
+		return fmt.Errorf("start conductor: %v", err)
+	}
+	env.DeferErr("stop conductor", func() error {
+		return opConductor.Stop(context.Background())
+	})
+
+	rpcClient, err := rpc.DialContext(ctx, opConductor.HTTPEndpoint())
+	if err != nil {
+		return fmt.Errorf("dial conductor: %v", err)
+	}
+	s.ConductorClient = conductorrpc.NewAPIClient(rpcClient)
+	env.Defer(s.ConductorClient.Close)
+	return nil
+}
+
+// newMockNetworkHost adds a p2p host to the in-memory network. op-node requires hosts with an extended peerstore.
+func newMockNetworkHost(network mocknet.Mocknet) (host.Host, error) {
+	privKey, _, err := ic.GenerateECDSAKeyPair(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate key pair: %v", err)
+	}
+	id, err := peer.IDFromPrivateKey(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("peer id from private key: %v", err)
+	}
+	// The address is never dialed, but it must be unique.
+	addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", len(network.Peers())+1))
+	if err != nil {
+		return nil, fmt.Errorf("new multiaddr: %v", err)
+	}
+
+	ps, err := pstoremem.NewPeerstore()
+	if err != nil {
+		return nil, fmt.Errorf("new peerstore: %v", err)
+	}
+	ps.AddAddr(id, addr, peerstore.PermanentAddrTTL)
+	if err := ps.AddPrivKey(id, privKey); err != nil {
+		return nil, fmt.Errorf("add private key: %v", err)
+	}
+	if err := ps.AddPubKey(id, privKey.GetPublic()); err != nil {
+		return nil, fmt.Errorf("add public key: %v", err)
+	}
+	eps, err := store.NewExtendedPeerstore(
+		context.Background(),
+		log.NewLogger(log.DiscardHandler()),
+		clock.SystemClock,
+		ps,
+		dssync.MutexWrap(ds.NewMapDatastore()),
+		24*time.Hour, //nolint:mnd
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new extended peerstore: %v", err)
+	}
+	return network.AddPeerWithPeerstore(id, eps)
+}
+
+// waitFor polls condition until it returns true or an error, or until a minute passes.
+func waitFor(ctx context.Context, condition func() (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	for {
+		if ok, err := condition(); err != nil {
+			return err
+		} else if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(250 * time.Millisecond): //nolint:mnd
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package e2e_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/polymerdao/monomer/e2e"
+	"github.com/polymerdao/monomer/environment"
+	"github.com/polymerdao/monomer/node"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestHA(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e tests in short mode")
+	}
+
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+
+	if err := os.Mkdir(artifactsDirectoryName, 0o755); !errors.Is(err, os.ErrExist) {
+		require.NoError(t, err)
+	}
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandler(openLogFile(t, env, "ha-root-logger"), false)))
+
+	opLogger := log.NewTerminalHandler(openLogFile(t, env, "ha-op"), false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stack, err := e2e.SetupHA(ctx, env, &e2e.SelectiveListener{
+		OPLogCb: func(r slog.Record) {
+			require.NoError(t, opLogger.Handle(context.Background(), r))
+		},
+		NodeSelectiveListener: &node.SelectiveListener{
+			OnEngineHTTPServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnEngineWebsocketServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnCometServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// The subtests run in order: each starts from the leader the previous one left behind.
+	t.Run("leadership transfer", func(t *testing.T) {
+		before := activeSequencer(t, stack, nil)
+		waitForBlocks(t, stack, before, 3)
+
+		require.NoError(t, before.ConductorClient.TransferLeader(stack.Ctx))
+		after := activeSequencer(t, stack, before)
+		waitForBlocks(t, stack, after, 3)
+
+		requireConsistentChains(t, stack)
+	})
+
+	t.Run("failstop", func(t *testing.T) {
+		before := activeSequencer(t, stack, nil)
+		waitForBlocks(t, stack, before, 3)
+
+		require.NoError(t, before.Stop())
+		after := activeSequencer(t, stack, before)
+		waitForBlocks(t, stack, after, 3)
+
+		requireConsistentChains(t, stack)
+	})
+}
+
+// activeSequencer waits for a running sequencer other than previous to lead the quorum and sequence.
+func activeSequencer(t *testing.T, stack *e2e.HAStackConfig, previous *e2e.HASequencer) *e2e.HASequencer {
+	var active *e2e.HASequencer
+	require.Eventually(t, func() bool {
+		for _, s := range stack.Sequencers {
+			if s == previous || s.Stopped() {
+				continue
+			}
+			leader, err := s.ConductorClient.Leader(stack.Ctx)
+			if err != nil || !leader {
+				continue
+			}
+			sequencing, err := s.RollupClient.SequencerActive(stack.Ctx)
+			if err != nil || !sequencing {
+				continue
+			}
+			active = s
+			return true
+		}
+		return false
+	}, time.Minute, 250*time.Millisecond)
+	return active
+}
+
+// waitForBlocks waits for the sequencer to build n more blocks.
+func waitForBlocks(t *testing.T, stack *e2e.HAStackConfig, s *e2e.HASequencer, n uint64) {
+	start := headHeight(t, stack, s)
+	require.Eventually(t, func() bool {
+		return headHeight(t, stack, s) >= start+n
+	}, time.Minute, 250*time.Millisecond)
+}
+
+func headHeight(t *testing.T, stack *e2e.HAStackConfig, s *e2e.HASequencer) uint64 {
+	head, err := s.MonomerClient.BlockByNumber(stack.Ctx, nil)
+	require.NoError(t, err)
+	return head.NumberU64()
+}
+
+// requireConsistentChains checks that the running sequencers agree on every block up to the lowest of their heads, and
+// that the chain has exactly one block per slot: no block was built twice, and no slot was skipped when the leader changed.
+func requireConsistentChains(t *testing.T, stack *e2e.HAStackConfig) {
+	var running []*e2e.HASequencer
+	height := uint64(0)
+	for _, s := range stack.Sequencers {
+		if s.Stopped() {
+			continue
+		}
+		sHeight := headHeight(t, stack, s)
+		if len(running) == 0 || sHeight < height {
+			height = sHeight
+		}
+		running = append(running, s)
+	}
+	require.Greater(t, len(running), 1)
+
+	genesis := stack.RollupConfig.Genesis.L2
+	var parentHash common.Hash
+	var parentTime uint64
+	for number := genesis.Number; number <= height; number++ {
+		var hash common.Hash
+		for i, s := range running {
+			block, err := s.MonomerClient.BlockByNumber(stack.Ctx, new(big.Int).SetUint64(number))
+			require.NoError(t, err)
+			if i == 0 {
+				hash = block.Hash()
+				if number == genesis.Number {
+					require.Equal(t, genesis.Hash, hash)
+				} else {
+					require.Equal(t, parentHash, block.ParentHash(), "block %d does not build on block %d", number, number-1)
+					require.Equal(t, parentTime+stack.RollupConfig.BlockTime, block.Time(), "block %d is not in the slot after block %d", number, number-1)
+				}
+				parentTime = block.Time()
+			} else {
+				require.Equal(t, hash, block.Hash(), "%s and %s disagree on block %d", running[0].ID, s.ID, number)
+			}
+		}
+		parentHash = hash
+	}
+}
@@ -0,0 +1,16 @@
+package e2e
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ReorgL1 rewinds L1 to the block at number. L1 keeps building blocks on it, with later timestamps than the blocks
+// they replace, so the blocks after number are reorged out along with the batches and deposits in them.
+func (s *StackConfig) ReorgL1(number uint64) error {
+	if err := s.L1Client.client.CallContext(s.Ctx, nil, "debug_setHead", hexutil.Uint64(number)); err != nil {
+		return fmt.Errorf("debug_setHead: %v", err)
+	}
+	return nil
+}
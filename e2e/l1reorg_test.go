@@ -0,0 +1,98 @@
+package e2e_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/polymerdao/monomer/e2e"
+	"github.com/polymerdao/monomer/environment"
+	"github.com/polymerdao/monomer/node"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+// TestL1Reorg reorgs out the L1 origin of a block with a user tx. op-node resets its unsafe head below the block, and
+// Monomer must roll the block back and include the tx again in a block built on the new L1 chain.
+func TestL1Reorg(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e tests in short mode")
+	}
+
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+
+	if err := os.Mkdir(artifactsDirectoryName, 0o755); !errors.Is(err, os.ErrExist) {
+		require.NoError(t, err)
+	}
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandler(openLogFile(t, env, "l1-reorg-root-logger"), false)))
+
+	opLogger := log.NewTerminalHandler(openLogFile(t, env, "l1-reorg-op"), false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stack, err := e2e.Setup(ctx, env, &config.InstrumentationConfig{}, &e2e.Options{}, &e2e.SelectiveListener{
+		OPLogCb: func(r slog.Record) {
+			require.NoError(t, opLogger.Handle(context.Background(), r))
+		},
+		NodeSelectiveListener: &node.SelectiveListener{
+			OnEngineHTTPServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnEngineWebsocketServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnCometServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, stack.WaitL2(1))
+
+	txBytes := testapp.ToTestTx(t, "l1ReorgKey", "l1ReorgValue")
+	bftTx := bfttypes.Tx(txBytes)
+	putTx, err := stack.L2Client.BroadcastTxSync(stack.Ctx, txBytes)
+	require.NoError(t, err)
+	require.Equal(t, abcitypes.CodeTypeOK, putTx.Code, "put.Code is not OK")
+	var height int64
+	require.Eventually(t, func() bool {
+		result, err := stack.L2Client.Tx(stack.Ctx, bftTx.Hash(), false)
+		if err != nil {
+			return false
+		}
+		height = result.Height
+		return true
+	}, time.Minute, 250*time.Millisecond, "the tx wasn't included")
+	orphan, err := stack.MonomerClient.BlockByNumber(stack.Ctx, big.NewInt(height))
+	require.NoError(t, err)
+	l1Origin, err := derive.L1BlockInfoFromBytes(stack.RollupConfig, orphan.Time(), orphan.Transactions()[0].Data())
+	require.NoError(t, err)
+
+	require.NoError(t, stack.ReorgL1(l1Origin.Number-1))
+	t.Logf("Reorged out L1 block %d, the L1 origin of L2 block %d", l1Origin.Number, height)
+
+	// The tx is included again in a block that replaces the orphaned one.
+	require.Eventually(t, func() bool {
+		result, err := stack.L2Client.Tx(stack.Ctx, bftTx.Hash(), false)
+		if err != nil {
+			return false
+		}
+		block, err := stack.MonomerClient.BlockByNumber(stack.Ctx, big.NewInt(result.Height))
+		return err == nil && block.Hash() != orphan.Hash()
+	}, 2*time.Minute, time.Second, "the orphaned block wasn't rolled back, or its tx wasn't included again")
+	t.Log("Monomer rolled back the orphaned block and included its tx again")
+}
@@ -0,0 +1,254 @@
+package e2e_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	cometcore "github.com/cometbft/cometbft/rpc/core/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer/e2e"
+	"github.com/polymerdao/monomer/environment"
+	"github.com/polymerdao/monomer/node"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+var (
+	loadDuration = flag.Duration("load-duration", 0, "how long TestLoad drives load through the stack; TestLoad is skipped if 0")
+	loadRate     = flag.Int("load-rate", 50, "number of txs TestLoad sends per second")
+)
+
+const (
+	// Each load tx writes loadNumWrites values of loadValueSize bytes to the workload module, about 330k gas.
+	loadNumWrites = 10
+	loadValueSize = 1024
+
+	// loadWarmupBlocks are the first blocks built under load, which aren't counted towards the gas utilization.
+	loadWarmupBlocks = 5
+	// loadMinGasUtilization is the minimum ratio of the gas the load txs use to the blocks' gas limit.
+	loadMinGasUtilization = 0.5
+	// loadMaxSafeHeadStall is the longest the safe head can go without advancing.
+	loadMaxSafeHeadStall = 2 * time.Minute
+	// loadMaxBacklog bounds the txs that were sent but aren't in a block yet, in seconds of load.
+	loadMaxBacklog = 10
+	// loadDrainTimeout is how long the txs sent before the load stops have to be included.
+	loadDrainTimeout = 30 * time.Second
+)
+
+// TestLoad drives sustained tx load through the full stack and checks that the node keeps up: blocks are filled, the
+// txs that wait for a block stay bounded, and the safe head keeps advancing. It catches throughput regressions that
+// unit benchmarks of a single component miss.
+func TestLoad(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping e2e tests in short mode")
+	}
+	if *loadDuration == 0 {
+		t.Skip("skipping the load test; set -load-duration to run it")
+	}
+
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+
+	if err := os.Mkdir(artifactsDirectoryName, 0o755); !errors.Is(err, os.ErrExist) {
+		require.NoError(t, err)
+	}
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandler(openLogFile(t, env, "load-root-logger"), false)))
+
+	opLogger := log.NewTerminalHandler(openLogFile(t, env, "load-op"), false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stack, err := e2e.Setup(ctx, env, &config.InstrumentationConfig{}, &e2e.Options{}, &e2e.SelectiveListener{
+		OPLogCb: func(r slog.Record) {
+			require.NoError(t, opLogger.Handle(context.Background(), r))
+		},
+		NodeSelectiveListener: &node.SelectiveListener{
+			OnEngineHTTPServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnEngineWebsocketServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnCometServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Build the txs up front, so sending them keeps up with the rate. Each has its own sender so their hashes differ.
+	txs := make([]bfttypes.Tx, int(loadDuration.Seconds()*float64(*loadRate)))
+	for i := range txs {
+		sender := sdk.AccAddress(binary.BigEndian.AppendUint64(make([]byte, 12), uint64(i)))
+		txs[i] = testapp.ToWriteTx(t, sender, loadNumWrites, loadValueSize)
+	}
+
+	head, err := stack.MonomerClient.BlockByNumber(stack.Ctx, nil)
+	require.NoError(t, err)
+	load := &loadObserver{
+		stack:      stack,
+		pending:    make(map[string]struct{}, len(txs)),
+		nextHeight: head.NumberU64() + 1,
+	}
+	sendErr := make(chan error, 1)
+	go func() {
+		sendErr <- load.send(txs, time.Second/time.Duration(*loadRate))
+	}()
+
+	var gasUsed, gasLimit uint64
+	var blocks int
+	maxBacklog := loadMaxBacklog * *loadRate
+	safeHead, safeHeadAdvanced := uint64(0), time.Now()
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for sending := true; sending; {
+		select {
+		case err := <-sendErr:
+			require.NoError(t, err)
+			sending = false
+		case <-ticker.C:
+		}
+
+		observed, err := load.observeBlocks()
+		require.NoError(t, err)
+		for _, block := range observed {
+			blocks++
+			if blocks > loadWarmupBlocks {
+				gasUsed += block.gasUsed
+				gasLimit += block.gasLimit
+			}
+		}
+
+		safe, err := stack.MonomerClient.BlockByNumber(stack.Ctx, big.NewInt(int64(rpc.SafeBlockNumber)))
+		require.NoError(t, err)
+		if safe.NumberU64() > safeHead {
+			safeHead, safeHeadAdvanced = safe.NumberU64(), time.Now()
+		}
+		require.Less(t, time.Since(safeHeadAdvanced), loadMaxSafeHeadStall, "safe head stalled at %d", safeHead)
+
+		backlog := load.backlog()
+		require.LessOrEqual(t, backlog, maxBacklog, "txs that aren't in a block yet keep growing")
+	}
+	require.Greater(t, blocks, loadWarmupBlocks, "too few blocks were built under load")
+	utilization := float64(gasUsed) / float64(gasLimit)
+	t.Logf("Sent %d txs in %d blocks with a gas utilization of %.2f", len(txs), blocks, utilization)
+	require.GreaterOrEqual(t, utilization, loadMinGasUtilization, "blocks aren't full under load")
+
+	require.Eventually(t, func() bool {
+		_, err := load.observeBlocks()
+		require.NoError(t, err)
+		return load.backlog() == 0
+	}, loadDrainTimeout, 500*time.Millisecond, "not all txs were included")
+}
+
+// loadObserver sends the load txs and tracks which of them have been included.
+type loadObserver struct {
+	stack *e2e.StackConfig
+
+	mu sync.Mutex
+	// pending are the hashes of the txs that were sent but aren't in a block yet.
+	pending map[string]struct{}
+
+	nextHeight uint64
+}
+
+type loadBlock struct {
+	gasUsed  uint64
+	gasLimit uint64
+}
+
+// send broadcasts the txs, one every interval.
+func (l *loadObserver) send(txs []bfttypes.Tx, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for _, tx := range txs {
+		l.mu.Lock()
+		l.pending[string(tx.Hash())] = struct{}{}
+		l.mu.Unlock()
+		result, err := l.stack.L2Client.BroadcastTxAsync(l.stack.Ctx, tx)
+		if err != nil {
+			return err
+		}
+		if result.Code != abcitypes.CodeTypeOK {
+			return errors.New(result.Log)
+		}
+		select {
+		case <-l.stack.Ctx.Done():
+			return l.stack.Ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// observeBlocks returns the gas the load txs used in each block built since the last call, and marks them as included.
+func (l *loadObserver) observeBlocks() ([]*loadBlock, error) {
+	head, err := l.stack.MonomerClient.BlockByNumber(l.stack.Ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var blocks []*loadBlock
+	for ; l.nextHeight <= head.NumberU64(); l.nextHeight++ {
+		ethBlock, err := l.stack.MonomerClient.BlockByNumber(l.stack.Ctx, new(big.Int).SetUint64(l.nextHeight))
+		if err != nil {
+			return nil, err
+		}
+		results, err := blockTxResults(l.stack, l.nextHeight)
+		if err != nil {
+			return nil, err
+		}
+		block := &loadBlock{
+			gasLimit: ethBlock.GasLimit(),
+		}
+		l.mu.Lock()
+		for _, result := range results {
+			if _, ok := l.pending[string(result.Hash)]; ok {
+				delete(l.pending, string(result.Hash))
+				block.gasUsed += uint64(result.TxResult.GasUsed)
+			}
+		}
+		l.mu.Unlock()
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+func (l *loadObserver) backlog() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.pending)
+}
+
+// blockTxResults returns the results of all txs in the block at height.
+func blockTxResults(stack *e2e.StackConfig, height uint64) ([]*cometcore.ResultTx, error) {
+	perPage := 100
+	var results []*cometcore.ResultTx
+	for page := 1; ; page++ {
+		result, err := stack.L2Client.TxSearch(stack.Ctx, fmt.Sprintf("tx.height = %d", height), false, &page, &perPage, "asc")
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result.Txs...)
+		if len(results) >= result.TotalCount || len(result.Txs) == 0 {
+			return results, nil
+		}
+	}
+}
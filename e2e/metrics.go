@@ -0,0 +1,39 @@
+package e2e
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/polymerdao/monomer/utils"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// ScrapeMetrics returns the sequencer's Prometheus metrics, keyed by name. Setup must be called with Prometheus
+// enabled.
+func (s *StackConfig) ScrapeMetrics() (_ map[string]*dto.MetricFamily, err error) {
+	if s.prometheusAddr == "" {
+		return nil, errors.New("prometheus is disabled")
+	}
+	req, err := http.NewRequestWithContext(s.Ctx, http.MethodGet, "http://"+s.prometheusAddr+"/metrics", http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("new request: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get metrics: %v", err)
+	}
+	defer func() {
+		err = utils.WrapCloseErr(err, resp.Body)
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get metrics: status %s", resp.Status)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("parse metrics: %v", err)
+	}
+	return families, nil
+}
@@ -9,9 +9,11 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-batcher/batcher"
 	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
+	"github.com/ethereum-optimism/optimism/op-batcher/flags"
 	opbatchermetrics "github.com/ethereum-optimism/optimism/op-batcher/metrics"
 	opnodemetrics "github.com/ethereum-optimism/optimism/op-node/metrics"
 	opnode "github.com/ethereum-optimism/optimism/op-node/node"
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
@@ -19,6 +21,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-proposer/proposer"
 	opcrypto "github.com/ethereum-optimism/optimism/op-service/crypto"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
@@ -26,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
 	"github.com/polymerdao/monomer/e2e/url"
 	"github.com/polymerdao/monomer/environment"
 	"github.com/polymerdao/monomer/utils"
@@ -35,94 +39,337 @@ import (
 type OPEventListener interface {
 	// Log may be called many times.
 	Log(r slog.Record)
+	// ProposedOutputRoot is called with the output root at an L2 block when the proposer fetches it, and returns the
+	// output root the proposer proposes instead. Tests return invalid output roots to make the challenger dispute them.
+	ProposedOutputRoot(l2BlockNumber uint64, outputRoot eth.Bytes32) eth.Bytes32
 }
 
-type OPStack struct {
-	l1URL               *url.URL
-	engineURL           *url.URL
-	nodeURL             *url.URL
-	batcherPrivKey      *ecdsa.PrivateKey
-	proposerPrivKey     *ecdsa.PrivateKey
-	rollupConfig        *rollup.Config
-	l2OutputOracleProxy common.Address
-	eventListener       OPEventListener
+// PermissionedGameType is the type of the permissioned dispute games the proposer creates when it proposes to the
+// DisputeGameFactory. Only the proposer can create them and only the challenger can challenge them.
+const PermissionedGameType uint32 = 1
+
+// AlphabetGameType is the type of the permissionless dispute games the proposer creates with fault proofs. Alphabet
+// games bisect output roots like Cannon games, but their execution traces are a toy VM's, so op-challenger plays them
+// without a fault proof program.
+const AlphabetGameType uint32 = 255
+
+// ProposerConfig selects the contract the proposer proposes outputs to.
+type ProposerConfig struct {
+	// L2OutputOracle is the legacy L2OutputOracle, which the proposer proposes every output to unless
+	// DisputeGameFactory is set.
+	L2OutputOracle common.Address
+	// DisputeGameFactory makes the proposer create a dispute game of GameType for the safe head's output every
+	// ProposalInterval instead, as it does on chains with fault proofs.
+	DisputeGameFactory *common.Address
+	GameType           uint32
+	ProposalInterval   time.Duration
 }
 
-// TODO setup verifiers
+// NodeRPCConfig enables the optional RPC namespaces of the op-node the stack runs.
+type NodeRPCConfig struct {
+	// Admin serves the admin namespace, e.g., admin_sequencerActive and admin_stopSequencer.
+	Admin bool
+	// P2P runs op-node with a p2p host on an in-memory network, which serves the opp2p namespace. No other host is on
+	// the network, so op-node doesn't gossip blocks.
+	P2P bool
+}
+
+// OPStackConfig holds the tuning values of the op-node, proposer, and batcher the stack runs. The defaults favor fast
+// tests over realism: everything polls every 50ms, channels are closed as soon as possible, and L1 txs need a single
+// confirmation.
+type OPStackConfig struct {
+	// L1BatchSize is the number of L1 requests op-node batches into a single RPC call.
+	L1BatchSize int
+	// L1MaxConcurrency is the maximum number of concurrent L1 requests op-node makes.
+	L1MaxConcurrency int
+	// SyncMode is how op-node syncs the engine.
+	SyncMode sync.Mode
+
+	// ProposerPollInterval is how often the proposer checks for a new output to propose.
+	ProposerPollInterval time.Duration
+	// ChallengerPollInterval is how often the challenger checks for new L1 blocks, and so for games to play.
+	ChallengerPollInterval time.Duration
+	// ChallengerBin is the op-challenger binary the stack runs, looked up in PATH if it has no path separators.
+	ChallengerBin string
+
+	// BatcherPollInterval is how often the batcher checks for new L2 blocks to submit.
+	BatcherPollInterval time.Duration
+	// MaxPendingTransactions is the number of batcher txs that may wait for confirmation at once. Zero is unlimited.
+	MaxPendingTransactions uint64
+	// MaxChannelDuration is the number of L1 blocks after which the batcher closes a channel. Zero only closes channels
+	// when they are full or about to time out.
+	MaxChannelDuration uint64
+	// SubSafetyMargin is the number of L1 blocks before the sequencing window or channel timeout ends at which the batcher
+	// closes a channel. Zero uses half the sequencing window.
+	SubSafetyMargin uint64
+	// MaxFrameSize is the maximum size of the frames the batcher submits as calldata. Blob frames always fill a blob.
+	MaxFrameSize uint64
+	// CompressorConfig configures how the batcher compresses channels.
+	CompressorConfig compressor.Config
 
+	// NumConfirmations is the number of L1 confirmations the proposer and batcher wait for before considering a tx
+	// included.
+	NumConfirmations uint64
+	// ResubmissionTimeout is how long the proposer and batcher wait before resubmitting a tx with a higher fee.
+	ResubmissionTimeout time.Duration
+}
+
+// DefaultOPStackConfig returns the config the stack runs with unless options override it.
+func DefaultOPStackConfig() *OPStackConfig {
+	return &OPStackConfig{
+		L1BatchSize:            10,
+		L1MaxConcurrency:       10,
+		SyncMode:               sync.CLSync,
+		ProposerPollInterval:   50 * time.Millisecond,
+		ChallengerPollInterval: 50 * time.Millisecond,
+		ChallengerBin:          "op-challenger",
+		BatcherPollInterval:    50 * time.Millisecond,
+		MaxPendingTransactions: 1,
+		// MaxFrameSize field value is copied from:
+		//nolint:lll
+		// https://github.com/ethereum-optimism/optimism/blob/5b13bad9883fa5737af67ba3ee700aaa8737f686/op-batcher/batcher/channel_config_test.go#L19
+		MaxFrameSize: 120_000,
+		CompressorConfig: compressor.Config{
+			TargetOutputSize: 100_000,
+			ApproxComprRatio: 0.4,
+		},
+		// https://github.com/ethereum-optimism/optimism/blob/5b13bad9883fa5737af67ba3ee700aaa8737f686/op-e2e/setup.go#L83-L93
+		NumConfirmations:    1,
+		ResubmissionTimeout: 3 * time.Second,
+	}
+}
+
+// OPStackOption overrides part of the default OPStackConfig.
+type OPStackOption func(*OPStackConfig)
+
+// WithL1RPC sets how op-node batches and parallelizes its L1 requests.
+func WithL1RPC(batchSize, maxConcurrency int) OPStackOption {
+	return func(cfg *OPStackConfig) {
+		cfg.L1BatchSize = batchSize
+		cfg.L1MaxConcurrency = maxConcurrency
+	}
+}
+
+// WithSyncMode sets how op-node syncs the engine.
+func WithSyncMode(mode sync.Mode) OPStackOption {
+	return func(cfg *OPStackConfig) {
+		cfg.SyncMode = mode
+	}
+}
+
+// WithPollIntervals sets how often the proposer and batcher poll op-node.
+func WithPollIntervals(proposer, batcher time.Duration) OPStackOption {
+	return func(cfg *OPStackConfig) {
+		cfg.ProposerPollInterval = proposer
+		cfg.BatcherPollInterval = batcher
+	}
+}
+
+// WithChannelTimings sets when the batcher closes channels, e.g., to hold them open for many L1 blocks like mainnet
+// batchers do.
+func WithChannelTimings(maxChannelDuration, subSafetyMargin uint64) OPStackOption {
+	return func(cfg *OPStackConfig) {
+		cfg.MaxChannelDuration = maxChannelDuration
+		cfg.SubSafetyMargin = subSafetyMargin
+	}
+}
+
+// WithCompression sets the size of the calldata frames the batcher submits and how it compresses channels.
+func WithCompression(maxFrameSize uint64, compressorConfig compressor.Config) OPStackOption {
+	return func(cfg *OPStackConfig) {
+		cfg.MaxFrameSize = maxFrameSize
+		cfg.CompressorConfig = compressorConfig
+	}
+}
+
+// WithMaxPendingTransactions sets the number of batcher txs that may wait for confirmation at once.
+func WithMaxPendingTransactions(n uint64) OPStackOption {
+	return func(cfg *OPStackConfig) {
+		cfg.MaxPendingTransactions = n
+	}
+}
+
+// WithL1Confirmations sets how many L1 confirmations the proposer and batcher wait for, and how long they wait before
+// resubmitting a tx, e.g., to simulate slow L1 inclusion.
+func WithL1Confirmations(numConfirmations uint64, resubmissionTimeout time.Duration) OPStackOption {
+	return func(cfg *OPStackConfig) {
+		cfg.NumConfirmations = numConfirmations
+		cfg.ResubmissionTimeout = resubmissionTimeout
+	}
+}
+
+type OPStack struct {
+	l1URL           *url.URL
+	l1BeaconURL     string
+	engineURL       *url.URL
+	nodeURL         *url.URL
+	nodeRPC         NodeRPCConfig
+	engineJWTSecret [32]byte
+	batcherPrivKey  *ecdsa.PrivateKey
+	proposerPrivKey *ecdsa.PrivateKey
+	daType          flags.DataAvailabilityType
+	rollupConfig    *rollup.Config
+	proposerConfig  *ProposerConfig
+	// challengerConfig is nil if the stack doesn't run a challenger.
+	challengerConfig *ChallengerConfig
+	eventListener    OPEventListener
+	cfg              *OPStackConfig
+}
+
+// NewOPStack returns an OP Stack whose batcher submits batches to L1 as daType. op-node fetches blobs from the beacon
+// API at l1BeaconURL, which only has to serve blobs if Ecotone is active. The options override the
+// DefaultOPStackConfig. The stack runs op-challenger unless challengerConfig is nil.
 func NewOPStack(
-	l1URL,
+	l1URL *url.URL,
+	l1BeaconURL string,
 	engineURL,
 	nodeURL *url.URL,
-	l2OutputOracleProxy common.Address,
+	nodeRPC NodeRPCConfig,
+	engineJWTSecret [32]byte,
+	proposerConfig *ProposerConfig,
+	challengerConfig *ChallengerConfig,
 	batcherPrivKey *ecdsa.PrivateKey,
 	proposerPrivKey *ecdsa.PrivateKey,
+	daType flags.DataAvailabilityType,
 	rollupConfig *rollup.Config,
 	eventListener OPEventListener,
+	opts ...OPStackOption,
 ) *OPStack {
+	cfg := DefaultOPStackConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
 	return &OPStack{
-		l1URL:               l1URL,
-		engineURL:           engineURL,
-		nodeURL:             nodeURL,
-		batcherPrivKey:      batcherPrivKey,
-		proposerPrivKey:     proposerPrivKey,
-		rollupConfig:        rollupConfig,
-		l2OutputOracleProxy: l2OutputOracleProxy,
-		eventListener:       eventListener,
+		l1URL:            l1URL,
+		l1BeaconURL:      l1BeaconURL,
+		engineURL:        engineURL,
+		nodeURL:          nodeURL,
+		nodeRPC:          nodeRPC,
+		engineJWTSecret:  engineJWTSecret,
+		batcherPrivKey:   batcherPrivKey,
+		proposerPrivKey:  proposerPrivKey,
+		daType:           daType,
+		rollupConfig:     rollupConfig,
+		proposerConfig:   proposerConfig,
+		challengerConfig: challengerConfig,
+		eventListener:    eventListener,
+		cfg:              cfg,
 	}
 }
 
 func (op *OPStack) Run(ctx context.Context, env *environment.Env) error {
-	l1RPCClient, err := rpc.DialContext(ctx, op.l1URL.String())
+	cfg := op.nodeConfig(op.engineURL, op.nodeURL)
+	cfg.RPC.EnableAdmin = op.nodeRPC.Admin
+	if op.nodeRPC.P2P {
+		network := mocknet.New()
+		env.DeferErr("close mock network", network.Close)
+		host, err := newMockNetworkHost(network)
+		if err != nil {
+			return fmt.Errorf("new p2p host: %v", err)
+		}
+		cfg.P2P = &p2p.Prepared{
+			HostP2P: host,
+		}
+	}
+	if err := op.runNode(ctx, env, "node", cfg); err != nil {
+		return err
+	}
+
+	rollupProvider, err := dial.NewStaticL2RollupProvider(ctx, op.newLogger("proposer-dialer"), op.nodeURL.String())
 	if err != nil {
-		return fmt.Errorf("dial L1: %v", err)
+		return fmt.Errorf("new static l2 rollup provider: %v", err)
 	}
-	l1 := NewL1Client(l1RPCClient)
+	env.Defer(rollupProvider.Close)
+
+	endpointProvider, err := dial.NewStaticL2EndpointProvider(
+		ctx,
+		op.newLogger("batcher-dialer"),
+		op.engineURL.String(),
+		op.nodeURL.String(),
+	)
+	if err != nil {
+		return fmt.Errorf("new static l2 endpoint provider: %v", err)
+	}
+	env.Defer(endpointProvider.Close)
 
-	if err := op.runNode(ctx, env); err != nil {
+	if err := op.runSubmitters(ctx, env, rollupProvider, endpointProvider); err != nil {
 		return err
 	}
+	if op.challengerConfig != nil {
+		return op.runChallenger(env)
+	}
+	return nil
+}
+
+// runSubmitters runs the proposer and batcher against the L2 endpoints the providers return.
+func (op *OPStack) runSubmitters(
+	ctx context.Context,
+	env *environment.Env,
+	rollupProvider dial.RollupProvider,
+	endpointProvider dial.L2EndpointProvider,
+) error {
+	l1RPCClient, err := rpc.DialContext(ctx, op.l1URL.String())
+	if err != nil {
+		return fmt.Errorf("dial L1: %v", err)
+	}
+	l1 := NewL1Client(l1RPCClient)
 
 	l1ChainID, err := l1.ChainID(ctx)
 	if err != nil {
 		return fmt.Errorf("get l1 chain id: %v", err)
 	}
 
-	if err := op.runProposer(ctx, env, l1, op.newTxManagerConfig(l1, l1ChainID, op.proposerPrivKey)); err != nil {
+	if err := op.runProposer(env, l1, op.newTxManagerConfig(l1, l1ChainID, op.proposerPrivKey), rollupProvider); err != nil {
 		return err
 	}
 
-	if err := op.runBatcher(ctx, env, l1, op.newTxManagerConfig(l1, l1ChainID, op.batcherPrivKey)); err != nil {
+	if err := op.runBatcher(ctx, env, l1, op.newTxManagerConfig(l1, l1ChainID, op.batcherPrivKey), endpointProvider); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (op *OPStack) runNode(ctx context.Context, env *environment.Env) error {
-	opNode, err := opnode.New(ctx, &opnode.Config{
+// nodeConfig returns the config of a sequencing op-node that drives the engine at engineURL and serves its RPC at nodeURL.
+func (op *OPStack) nodeConfig(engineURL, nodeURL *url.URL) *opnode.Config {
+	return &opnode.Config{
 		L1: &opnode.L1EndpointConfig{
 			L1NodeAddr:     op.l1URL.String(),
-			BatchSize:      10,
-			MaxConcurrency: 10,
+			BatchSize:      op.cfg.L1BatchSize,
+			MaxConcurrency: op.cfg.L1MaxConcurrency,
 			L1RPCKind:      sources.RPCKindBasic,
 		},
+		Beacon: &opnode.L1BeaconEndpointConfig{
+			BeaconAddr: op.l1BeaconURL,
+		},
 		L2: &opnode.L2EndpointConfig{
-			L2EngineAddr:      op.engineURL.String(),
-			L2EngineJWTSecret: [32]byte{},
+			L2EngineAddr:      engineURL.String(),
+			L2EngineJWTSecret: op.engineJWTSecret,
 		},
 		Driver: driver.Config{
 			SequencerEnabled: true,
 		},
 		Rollup: *op.rollupConfig,
 		RPC: opnode.RPCConfig{
-			ListenAddr: op.nodeURL.Hostname(),
-			ListenPort: int(op.nodeURL.PortU16()),
+			ListenAddr: nodeURL.Hostname(),
+			ListenPort: int(nodeURL.PortU16()),
 		},
 		ConfigPersistence: opnode.DisabledConfigPersistence{},
 		Sync: sync.Config{
-			SyncMode: sync.CLSync,
+			SyncMode: op.cfg.SyncMode,
 		},
-	}, op.newLogger("node"), op.newLogger("node-snapshotter"), "v0.1", opnodemetrics.NewMetrics(""))
+	}
+}
+
+// RunVerifier runs a verifier op-node that drives the engine at engineURL and serves its RPC at nodeURL. It doesn't
+// sequence or gossip: it derives the chain from the batches the batcher submits to L1.
+func (op *OPStack) RunVerifier(ctx context.Context, env *environment.Env, name string, engineURL, nodeURL *url.URL) error {
+	cfg := op.nodeConfig(engineURL, nodeURL)
+	cfg.Driver.SequencerEnabled = false
+	return op.runNode(ctx, env, name, cfg)
+}
+
+func (op *OPStack) runNode(ctx context.Context, env *environment.Env, name string, cfg *opnode.Config) error {
+	opNode, err := opnode.New(ctx, cfg, op.newLogger(name), op.newLogger(name+"-snapshotter"), "v0.1", opnodemetrics.NewMetrics(""))
 	if err != nil {
 		return fmt.Errorf("new node: %v", err)
 	}
@@ -135,7 +382,12 @@ func (op *OPStack) runNode(ctx context.Context, env *environment.Env) error {
 	return nil
 }
 
-func (op *OPStack) runProposer(ctx context.Context, env *environment.Env, l1Client proposer.L1Client, txManagerConfig *txmgr.Config) error {
+func (op *OPStack) runProposer(
+	env *environment.Env,
+	l1Client proposer.L1Client,
+	txManagerConfig *txmgr.Config,
+	rollupProvider dial.RollupProvider,
+) error {
 	metrics := opproposermetrics.NoopMetrics
 
 	txManager, err := txmgr.NewSimpleTxManagerFromConfig("proposer", op.newLogger("proposer-tx-manager"), metrics, *txManagerConfig)
@@ -144,25 +396,29 @@ func (op *OPStack) runProposer(ctx context.Context, env *environment.Env, l1Clie
 	}
 	env.Defer(txManager.Close)
 
-	rollupProvider, err := dial.NewStaticL2RollupProvider(ctx, op.newLogger("proposer-dialer"), op.nodeURL.String())
-	if err != nil {
-		return fmt.Errorf("new static l2 rollup provider: %v", err)
+	cfg := proposer.ProposerConfig{
+		PollInterval:   op.cfg.ProposerPollInterval,
+		NetworkTimeout: 2 * time.Second,
+		// Enable the proposal of safe, but non-finalized L2 blocks for testing purposes.
+		AllowNonFinalized: true,
+	}
+	if op.proposerConfig.DisputeGameFactory != nil {
+		cfg.DisputeGameFactoryAddr = op.proposerConfig.DisputeGameFactory
+		cfg.ProposalInterval = op.proposerConfig.ProposalInterval
+		cfg.DisputeGameType = op.proposerConfig.GameType
+	} else {
+		cfg.L2OutputOracleAddr = utils.Ptr(op.proposerConfig.L2OutputOracle)
 	}
-	env.Defer(rollupProvider.Close)
-
 	outputSubmitter, err := proposer.NewL2OutputSubmitter(proposer.DriverSetup{
-		Log:  op.newLogger("proposer"),
-		Metr: metrics,
-		Cfg: proposer.ProposerConfig{
-			PollInterval:       50 * time.Millisecond,
-			NetworkTimeout:     2 * time.Second,
-			L2OutputOracleAddr: utils.Ptr(op.l2OutputOracleProxy),
-			// Enable the proposal of safe, but non-finalized L2 blocks for testing purposes.
-			AllowNonFinalized: true,
+		Log:      op.newLogger("proposer"),
+		Metr:     metrics,
+		Cfg:      cfg,
+		Txmgr:    txManager,
+		L1Client: l1Client,
+		RollupProvider: &outputRootRollupProvider{
+			RollupProvider: rollupProvider,
+			eventListener:  op.eventListener,
 		},
-		Txmgr:          txManager,
-		L1Client:       l1Client,
-		RollupProvider: rollupProvider,
 	})
 	if err != nil {
 		return fmt.Errorf("new l2 output submitter: %v", err)
@@ -174,7 +430,13 @@ func (op *OPStack) runProposer(ctx context.Context, env *environment.Env, l1Clie
 	return nil
 }
 
-func (op *OPStack) runBatcher(ctx context.Context, env *environment.Env, l1Client batcher.L1Client, txManagerConfig *txmgr.Config) error {
+func (op *OPStack) runBatcher(
+	ctx context.Context,
+	env *environment.Env,
+	l1Client batcher.L1Client,
+	txManagerConfig *txmgr.Config,
+	endpointProvider dial.L2EndpointProvider,
+) error {
 	metrics := opbatchermetrics.NoopMetrics
 
 	txManager, err := txmgr.NewSimpleTxManagerFromConfig("batcher", op.newLogger("batcher-tx-manager"), metrics, *txManagerConfig)
@@ -183,42 +445,41 @@ func (op *OPStack) runBatcher(ctx context.Context, env *environment.Env, l1Clien
 	}
 	env.Defer(txManager.Close)
 
-	endpointProvider, err := dial.NewStaticL2EndpointProvider(
-		ctx,
-		op.newLogger("batcher-dialer"),
-		op.engineURL.String(),
-		op.nodeURL.String(),
-	)
-	if err != nil {
-		return fmt.Errorf("new static l2 endpoint provider: %v", err)
+	batcherConfig := batcher.BatcherConfig{
+		NetworkTimeout:         2 * time.Second,
+		PollInterval:           op.cfg.BatcherPollInterval,
+		MaxPendingTransactions: op.cfg.MaxPendingTransactions,
+	}
+	channelConfig := batcher.ChannelConfig{
+		SeqWindowSize:      op.rollupConfig.SeqWindowSize,
+		ChannelTimeout:     op.rollupConfig.ChannelTimeout,
+		MaxChannelDuration: op.cfg.MaxChannelDuration,
+		SubSafetyMargin:    op.cfg.SubSafetyMargin,
+		MaxFrameSize:       op.cfg.MaxFrameSize,
+		CompressorConfig:   op.cfg.CompressorConfig,
+	}
+	if channelConfig.SubSafetyMargin == 0 {
+		channelConfig.SubSafetyMargin = op.rollupConfig.SeqWindowSize / 2
+	}
+	switch op.daType {
+	case flags.CalldataType:
+	case flags.BlobsType:
+		// Each frame fills a blob, as op-batcher does when it submits blobs.
+		batcherConfig.UseBlobs = true
+		channelConfig.MaxFrameSize = eth.MaxBlobDataSize - 1
+	default:
+		return fmt.Errorf("unknown data availability type: %s", op.daType)
 	}
-	env.Defer(endpointProvider.Close)
 
 	batchSubmitter := batcher.NewBatchSubmitter(batcher.DriverSetup{
-		Log:          op.newLogger("batcher"),
-		Metr:         metrics,
-		RollupConfig: op.rollupConfig,
-		Config: batcher.BatcherConfig{
-			NetworkTimeout:         2 * time.Second,
-			PollInterval:           50 * time.Millisecond,
-			MaxPendingTransactions: 1,
-		},
+		Log:              op.newLogger("batcher"),
+		Metr:             metrics,
+		RollupConfig:     op.rollupConfig,
+		Config:           batcherConfig,
 		Txmgr:            txManager,
 		L1Client:         l1Client,
 		EndpointProvider: endpointProvider,
-		ChannelConfig: batcher.ChannelConfig{
-			SeqWindowSize:   op.rollupConfig.SeqWindowSize,
-			ChannelTimeout:  op.rollupConfig.ChannelTimeout,
-			SubSafetyMargin: op.rollupConfig.SeqWindowSize / 2,
-			// MaxFrameSize field value is copied from:
-			//nolint:lll
-			// https://github.com/ethereum-optimism/optimism/blob/5b13bad9883fa5737af67ba3ee700aaa8737f686/op-batcher/batcher/channel_config_test.go#L19
-			MaxFrameSize: 120_000,
-			CompressorConfig: compressor.Config{
-				TargetOutputSize: 100_000,
-				ApproxComprRatio: 0.4,
-			},
-		},
+		ChannelConfig:    channelConfig,
 	})
 	if err := batchSubmitter.StartBatchSubmitting(); err != nil {
 		return fmt.Errorf("start batch submitting: %v", err)
@@ -237,10 +498,10 @@ func (op *OPStack) newTxManagerConfig(l1 txmgr.ETHBackend, l1ChainID *big.Int, k
 		Backend: l1,
 		ChainID: l1ChainID,
 		// https://github.com/ethereum-optimism/optimism/blob/5b13bad9883fa5737af67ba3ee700aaa8737f686/op-e2e/setup.go#L83-L93
-		NumConfirmations:          1,
+		NumConfirmations:          op.cfg.NumConfirmations,
 		SafeAbortNonceTooLowCount: 3,
 		FeeLimitMultiplier:        5,
-		ResubmissionTimeout:       3 * time.Second,
+		ResubmissionTimeout:       op.cfg.ResubmissionTimeout,
 		ReceiptQueryInterval:      50 * time.Millisecond,
 		NetworkTimeout:            2 * time.Second,
 		TxNotInMempoolTimeout:     2 * time.Minute,
@@ -0,0 +1,66 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+)
+
+// SequencerActive reports whether op-node is sequencing. The stack must run with Options.OPNodeRPC.Admin.
+func (s *StackConfig) SequencerActive() (bool, error) {
+	active, err := s.RollupClient.SequencerActive(s.Ctx)
+	if err != nil {
+		return false, fmt.Errorf("admin_sequencerActive: %v", err)
+	}
+	return active, nil
+}
+
+// SyncStatus returns op-node's view of the L1 and L2 chains.
+func (s *StackConfig) SyncStatus() (*eth.SyncStatus, error) {
+	return syncStatus(s.Ctx, s.RollupClient)
+}
+
+// WaitSafeHead waits until op-node's safe head reaches l2BlockNumber and returns the sync status at that point.
+func (s *StackConfig) WaitSafeHead(l2BlockNumber *big.Int) (*eth.SyncStatus, error) {
+	return waitSafeHead(s.Ctx, s.RollupClient, l2BlockNumber)
+}
+
+// SyncStatus returns the verifier op-node's view of the L1 and L2 chains. Its SafeL2 and FinalizedL2 are the heads the
+// verifier derived from L1.
+func (v *Verifier) SyncStatus() (*eth.SyncStatus, error) {
+	return syncStatus(v.ctx, v.RollupClient)
+}
+
+// WaitSafeHead waits until the verifier's safe head reaches l2BlockNumber and returns the sync status at that point.
+func (v *Verifier) WaitSafeHead(l2BlockNumber *big.Int) (*eth.SyncStatus, error) {
+	return waitSafeHead(v.ctx, v.RollupClient, l2BlockNumber)
+}
+
+func syncStatus(ctx context.Context, client *sources.RollupClient) (*eth.SyncStatus, error) {
+	status, err := client.SyncStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("optimism_syncStatus: %v", err)
+	}
+	return status, nil
+}
+
+func waitSafeHead(ctx context.Context, client *sources.RollupClient, l2BlockNumber *big.Int) (*eth.SyncStatus, error) {
+	for {
+		status, err := syncStatus(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		if new(big.Int).SetUint64(status.SafeL2.Number).Cmp(l2BlockNumber) >= 0 {
+			return status, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
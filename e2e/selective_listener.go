@@ -1,6 +1,7 @@
 package e2e
 
 import (
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/polymerdao/monomer/node"
 	"golang.org/x/exp/slog"
 )
@@ -11,7 +12,8 @@ type NodeSelectiveListener = node.SelectiveListener
 type SelectiveListener struct {
 	*NodeSelectiveListener
 
-	OPLogCb func(slog.Record)
+	OPLogCb              func(slog.Record)
+	ProposedOutputRootCb func(l2BlockNumber uint64, outputRoot eth.Bytes32) eth.Bytes32
 }
 
 func (s *SelectiveListener) Log(r slog.Record) { //nolint:gocritic // hugeParam
@@ -19,3 +21,11 @@ func (s *SelectiveListener) Log(r slog.Record) { //nolint:gocritic // hugeParam
 		s.OPLogCb(r)
 	}
 }
+
+// ProposedOutputRoot returns the output root unchanged unless ProposedOutputRootCb is set.
+func (s *SelectiveListener) ProposedOutputRoot(l2BlockNumber uint64, outputRoot eth.Bytes32) eth.Bytes32 {
+	if s.ProposedOutputRootCb != nil {
+		return s.ProposedOutputRootCb(l2BlockNumber, outputRoot)
+	}
+	return outputRoot
+}
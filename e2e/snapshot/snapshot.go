@@ -0,0 +1,153 @@
+// Package snapshot captures the e2e setup into a directory that can be reused instead of running `make setup-e2e`.
+//
+// The setup deploys the L1 contracts with forge in the optimism monorepo, which takes minutes, and writes the L1 allocs
+// with the deployed contracts, their addresses, the L2 allocs, and the deploy config. The e2e stack only reads those
+// files: it builds the L1 genesis and Monomer's genesis from them in-process when it starts. A snapshot is a flat
+// directory of the files and a manifest of their hashes and the optimism commit they were generated from, so it can be
+// shipped as a CI cache or a docker image and used with the e2e flags directly.
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ManifestName is the name of the manifest in a snapshot directory.
+const ManifestName = "snapshot.json"
+
+// Files maps the names of the files in a snapshot to their paths in the optimism monorepo.
+var Files = map[string]string{
+	"allocs-l1.json":         filepath.Join(".devnet", "allocs-l1.json"),
+	"addresses.json":         filepath.Join(".devnet", "addresses.json"),
+	"allocs-l2-delta.json":   filepath.Join(".devnet", "allocs-l2-delta.json"),
+	"allocs-l2-ecotone.json": filepath.Join(".devnet", "allocs-l2-ecotone.json"),
+	"devnetL1.json":          filepath.Join("packages", "contracts-bedrock", "deploy-config", "devnetL1.json"),
+}
+
+// Manifest describes a snapshot.
+type Manifest struct {
+	// OptimismCommit is the commit of the optimism monorepo the files were generated from.
+	OptimismCommit string `json:"optimismCommit"`
+	// Files maps the names of the files to their hex-encoded SHA-256 hashes.
+	Files map[string]string `json:"files"`
+}
+
+// OptimismCommit returns the commit the optimism monorepo at optimismDir is checked out at. It returns "" if the
+// monorepo isn't checked out, e.g., if the submodule wasn't initialized.
+func OptimismCommit(optimismDir string) (string, error) {
+	// Without its own .git, git would resolve the commit of the repo that contains optimismDir.
+	if _, err := os.Stat(filepath.Join(optimismDir, ".git")); errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	out, err := exec.Command("git", "-C", optimismDir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return "", fmt.Errorf("get optimism commit: %v", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Create copies the setup files from the optimism monorepo at optimismDir, generated at commit, to snapshotDir and
+// writes the manifest.
+func Create(optimismDir, snapshotDir, commit string) (*Manifest, error) {
+	if err := os.MkdirAll(snapshotDir, 0o755); err != nil { //nolint:mnd
+		return nil, fmt.Errorf("make snapshot dir: %v", err)
+	}
+	manifest := &Manifest{
+		OptimismCommit: commit,
+		Files:          make(map[string]string, len(Files)),
+	}
+	for name, path := range Files {
+		hash, err := copyFile(filepath.Join(optimismDir, path), filepath.Join(snapshotDir, name))
+		if err != nil {
+			return nil, fmt.Errorf("copy %s (run `make setup-e2e` first): %v", path, err)
+		}
+		manifest.Files[name] = hash
+	}
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal manifest: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(snapshotDir, ManifestName), manifestJSON, 0o644); err != nil { //nolint:mnd
+		return nil, fmt.Errorf("write manifest: %v", err)
+	}
+	return manifest, nil
+}
+
+// Verify checks that the files in snapshotDir match its manifest. If commit isn't empty, it also checks that the
+// snapshot was generated from that optimism commit, since the contracts of a different commit may not match the
+// op-node and op-proposer the e2e stack runs.
+func Verify(snapshotDir, commit string) (*Manifest, error) {
+	manifestJSON, err := os.ReadFile(filepath.Join(snapshotDir, ManifestName))
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %v", err)
+	}
+	manifest := new(Manifest)
+	if err := json.Unmarshal(manifestJSON, manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %v", err)
+	}
+	if commit != "" && manifest.OptimismCommit != commit {
+		return nil, fmt.Errorf("snapshot was generated from optimism commit %s, but optimism is at %s", manifest.OptimismCommit, commit)
+	}
+	names := make([]string, 0, len(Files))
+	for name := range Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var errs []error
+	for _, name := range names {
+		want, ok := manifest.Files[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s is missing from the manifest", name))
+			continue
+		}
+		got, err := hashFile(filepath.Join(snapshotDir, name))
+		if err != nil {
+			errs = append(errs, err)
+		} else if got != want {
+			errs = append(errs, fmt.Errorf("%s has hash %s, but the manifest has %s", name, got, want))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// copyFile copies src to dst and returns the hex-encoded SHA-256 hash of its contents.
+func copyFile(src, dst string) (string, error) {
+	contents, err := os.ReadFile(src)
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(dst, contents, 0o644); err != nil { //nolint:mnd
+		return "", err
+	}
+	return hash(bytes.NewReader(contents))
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return hash(f)
+}
+
+func hash(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
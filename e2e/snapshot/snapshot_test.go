@@ -0,0 +1,62 @@
+package snapshot_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/polymerdao/monomer/e2e/snapshot"
+	"github.com/stretchr/testify/require"
+)
+
+const commit = "0123456789abcdef0123456789abcdef01234567"
+
+// newOptimismDir writes the setup files to a fake optimism monorepo.
+func newOptimismDir(t *testing.T) string {
+	dir := t.TempDir()
+	for name, path := range snapshot.Files {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte(name), 0o644))
+	}
+	return dir
+}
+
+func TestCreateVerify(t *testing.T) {
+	snapshotDir := t.TempDir()
+	created, err := snapshot.Create(newOptimismDir(t), snapshotDir, commit)
+	require.NoError(t, err)
+	require.Equal(t, commit, created.OptimismCommit)
+	require.Len(t, created.Files, len(snapshot.Files))
+	for name := range snapshot.Files {
+		contents, err := os.ReadFile(filepath.Join(snapshotDir, name))
+		require.NoError(t, err)
+		require.Equal(t, name, string(contents))
+	}
+
+	verified, err := snapshot.Verify(snapshotDir, commit)
+	require.NoError(t, err)
+	require.Equal(t, created, verified)
+	// The commit isn't checked without an optimism checkout.
+	_, err = snapshot.Verify(snapshotDir, "")
+	require.NoError(t, err)
+
+	_, err = snapshot.Verify(snapshotDir, "fedcba9876543210fedcba9876543210fedcba98")
+	require.ErrorContains(t, err, "optimism commit")
+
+	require.NoError(t, os.WriteFile(filepath.Join(snapshotDir, "addresses.json"), []byte("{}"), 0o644))
+	_, err = snapshot.Verify(snapshotDir, commit)
+	require.ErrorContains(t, err, "addresses.json has hash")
+}
+
+func TestCreateMissingSetup(t *testing.T) {
+	optimismDir := newOptimismDir(t)
+	require.NoError(t, os.Remove(filepath.Join(optimismDir, snapshot.Files["allocs-l1.json"])))
+	_, err := snapshot.Create(optimismDir, t.TempDir(), commit)
+	require.ErrorContains(t, err, "make setup-e2e")
+}
+
+func TestOptimismCommitNotCheckedOut(t *testing.T) {
+	commit, err := snapshot.OptimismCommit(t.TempDir())
+	require.NoError(t, err)
+	require.Empty(t, commit)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"maps"
 	"math/big"
 	"net"
 	"time"
@@ -16,26 +17,82 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
-	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-batcher/flags"
 	opgenesis "github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
 	ope2econfig "github.com/ethereum-optimism/optimism/op-e2e/config"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
 	"github.com/ethereum-optimism/optimism/op-node/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/p2p"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	opclient "github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/triedb"
 	"github.com/polymerdao/monomer"
+	monomerbindings "github.com/polymerdao/monomer/bindings/generated"
 	e2eurl "github.com/polymerdao/monomer/e2e/url"
 	"github.com/polymerdao/monomer/environment"
+	"github.com/polymerdao/monomer/faults"
 	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/jwtauth"
 	"github.com/polymerdao/monomer/monomerdb/localdb"
 	"github.com/polymerdao/monomer/node"
 	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/txforward"
+	"github.com/polymerdao/monomer/utils"
 )
 
+// ProposerMode selects where the stack's proposer proposes outputs.
+type ProposerMode int
+
+const (
+	// ProposeToL2OutputOracle proposes every output to the legacy L2OutputOracle, which the OptimismPortal proves
+	// withdrawals against.
+	ProposeToL2OutputOracle ProposerMode = iota
+	// ProposePermissionedGames creates a permissioned dispute game in the DisputeGameFactory for the safe head's output
+	// every gameProposalInterval, as OP Mainnet's proposer does with fault proofs.
+	ProposePermissionedGames
+	// ProposeFaultDisputeGames creates a permissionless alphabet dispute game in the DisputeGameFactory for the safe head's
+	// output every gameProposalInterval and runs op-challenger, which disputes the games whose root claims are invalid.
+	ProposeFaultDisputeGames
+)
+
+// gameType returns the type of the dispute games the proposer creates in the mode.
+func (m ProposerMode) gameType() uint32 {
+	if m == ProposeFaultDisputeGames {
+		return AlphabetGameType
+	}
+	return PermissionedGameType
+}
+
+// Options configure the optional parts of the stack. The zero value runs the default stack.
+type Options struct {
+	// ProposerMode selects where the proposer proposes outputs.
+	ProposerMode ProposerMode
+	// OPNodeRPC enables op-node's optional RPC namespaces.
+	OPNodeRPC NodeRPCConfig
+	// Verifiers is the number of verifiers to run alongside the sequencer, see StackConfig.Verifiers.
+	Verifiers int
+	// DataAvailabilityType selects how the batcher submits batches to L1. The zero value submits them as calldata.
+	// flags.BlobsType submits them as EIP-4844 blobs, which activates Cancun on L1 and Ecotone on L2 at genesis.
+	DataAvailabilityType flags.DataAvailabilityType
+	// OPStackOptions override the tuning values of the op-node, proposer, and batcher, see DefaultOPStackConfig.
+	OPStackOptions []OPStackOption
+	// Faults injects faults into the ABCI calls of the sequencer's app, e.g., to test how op-node and Monomer recover
+	// from slow or failing block execution. No faults are injected if it is nil.
+	Faults *faults.Injector
+}
+
+// gameProposalInterval is how often the proposer creates a dispute game with ProposePermissionedGames.
+const gameProposalInterval = 10 * time.Second
+
 type EventListener interface {
 	OPEventListener
 	node.EventListener
@@ -47,32 +104,51 @@ type StackConfig struct {
 	L1Client             *L1Client
 	L1Deployments        *opgenesis.L1Deployments
 	OptimismPortal       *bindings.OptimismPortal
-	L1StandardBridge     *opbindings.L1StandardBridge
+	L1StandardBridge     *monomerbindings.L1StandardBridge
 	L2OutputOracleCaller *bindings.L2OutputOracleCaller
-	L2Client             *bftclient.HTTP
-	MonomerClient        *MonomerClient
-	RollupConfig         *rollup.Config
-	WaitL1               func(numBlocks int) error
-	WaitL2               func(numBlocks int) error
+	DisputeGameFactory   *monomerbindings.DisputeGameFactoryCaller
+	ProposerMode         ProposerMode
+	// RollupClient calls op-node's optimism and admin namespaces. The admin namespace requires Options.OPNodeRPC.Admin.
+	RollupClient *sources.RollupClient
+	// P2PClient calls op-node's opp2p namespace. It is nil unless Options.OPNodeRPC.P2P is set.
+	P2PClient *p2p.Client
+	// Verifiers are the stack's verifiers, one for each of Options.Verifiers.
+	Verifiers     []*Verifier
+	L2Client      *bftclient.HTTP
+	MonomerClient *MonomerClient
+	RollupConfig  *rollup.Config
+	WaitL1        func(numBlocks int) error
+	WaitL2        func(numBlocks int) error
+
+	l1Clock     *clock.AdvancingClock
+	l1BlockTime uint64
+	// prometheusAddr is the address of the sequencer's Prometheus metrics server. It is empty if Prometheus is disabled.
+	prometheusAddr string
 }
 
 type stack struct {
 	monomerEngineURL *e2eurl.URL
 	monomerCometURL  *e2eurl.URL
 	opNodeURL        *e2eurl.URL
+	engineJWTSecret  [32]byte
 	eventListener    EventListener
 	prometheusCfg    *config.InstrumentationConfig
+	opts             *Options
+	// sequencerCometURL runs Monomer as a replica that forwards the txs submitted to it to the sequencer at this url.
+	sequencerCometURL *e2eurl.URL
 }
 
 // Setup creates and runs a new stack for end-to-end testing.
 //
-// It assumes availability of hard-coded local URLs for the Monomer engine, Comet, and OP node.
+// It assumes availability of hard-coded local URLs for the Monomer engine, Comet, and OP node, and of the ports the
+// verifiers listen on, starting at 8950.
 //
 // It returns a StackConfig with L1 and L2 clients, the rollup config, and operator and user accounts.
 func Setup(
 	ctx context.Context,
 	env *environment.Env,
 	prometheusCfg *config.InstrumentationConfig,
+	opts *Options,
 	eventListener EventListener,
 ) (*StackConfig, error) {
 	monomerEngineURL, err := e2eurl.ParseString("ws://127.0.0.1:8889")
@@ -87,33 +163,57 @@ func Setup(
 	if err != nil {
 		return nil, fmt.Errorf("new op-node url: %v", err)
 	}
+	engineJWTSecret, err := jwtauth.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("generate engine jwt secret: %v", err)
+	}
 
 	stack := stack{
 		monomerEngineURL: monomerEngineURL,
 		monomerCometURL:  monomerCometURL,
 		opNodeURL:        opNodeURL,
+		engineJWTSecret:  [32]byte(engineJWTSecret),
 		eventListener:    eventListener,
 		prometheusCfg:    prometheusCfg,
+		opts:             opts,
 	}
 
 	return stack.run(ctx, env)
 }
 
-func (s *stack) run(ctx context.Context, env *environment.Env) (*StackConfig, error) {
-	// configure & run L1
+// l1Devnet is a running L1 with the OP Stack contracts deployed.
+type l1Devnet struct {
+	deployConfig *opgenesis.DeployConfig
+	url          *e2eurl.URL
+	client       *L1Client
+	latestBlock  *ethtypes.Block
+	clock        *clock.AdvancingClock
+	// beaconURL is the address of the beacon API that serves the blobs of the L1 blocks.
+	beaconURL string
+}
 
+func runL1(ctx context.Context, env *environment.Env, daType flags.DataAvailabilityType) (*l1Devnet, error) {
 	deployConfig := ope2econfig.DeployConfig.Copy()
 	// Set a shorter Sequencer Window Size to force unsafe block consolidation to happen more often.
 	// A verifier (and the sequencer when it's determining the safe head) will have to read the entire sequencer window
 	// before advancing in the worst case. For the sake of tests running quickly, we minimize that worst case to 4 blocks.
 	deployConfig.SequencerWindowSize = 4
+	if daType == flags.BlobsType {
+		// Blob transactions require Cancun on L1, and op-node only derives batches from blobs from Ecotone on.
+		genesisOffset := utils.Ptr(hexutil.Uint64(0))
+		deployConfig.L1CancunTimeOffset = genesisOffset
+		deployConfig.L2GenesisRegolithTimeOffset = genesisOffset
+		deployConfig.L2GenesisCanyonTimeOffset = genesisOffset
+		deployConfig.L2GenesisDeltaTimeOffset = genesisOffset
+		deployConfig.L2GenesisEcotoneTimeOffset = genesisOffset
+	}
 
 	l1genesis, err := opgenesis.BuildL1DeveloperGenesis(deployConfig, ope2econfig.L1Allocs, ope2econfig.L1Deployments)
 	if err != nil {
 		return nil, fmt.Errorf("build l1 developer genesis: %v", err)
 	}
 
-	l1RPCclient, l1HTTPendpoint, err := gethdevnet(env, deployConfig.L1BlockTime, l1genesis)
+	l1RPCclient, l1HTTPendpoint, l1Clock, beaconURL, err := gethdevnet(env, deployConfig.L1BlockTime, l1genesis)
 	if err != nil {
 		return nil, fmt.Errorf("ethdevnet: %v", err)
 	}
@@ -134,6 +234,26 @@ func (s *stack) run(ctx context.Context, env *environment.Env) (*StackConfig, er
 	if err != nil {
 		return nil, fmt.Errorf("get the latest l1 block: %v", err)
 	}
+	return &l1Devnet{
+		deployConfig: deployConfig,
+		url:          l1url,
+		client:       l1Client,
+		latestBlock:  latestL1Block,
+		clock:        l1Clock,
+		beaconURL:    beaconURL,
+	}, nil
+}
+
+func (s *stack) run(ctx context.Context, env *environment.Env) (*StackConfig, error) {
+	daType := s.opts.DataAvailabilityType
+	if daType == "" {
+		daType = flags.CalldataType
+	}
+	l1, err := runL1(ctx, env, daType)
+	if err != nil {
+		return nil, err
+	}
+	deployConfig, l1url, l1Client, latestL1Block := l1.deployConfig, l1.url, l1.client, l1.latestBlock
 
 	// Run Monomer.
 	if err := s.runMonomer(ctx, env, latestL1Block.Time(), deployConfig.L2ChainID); err != nil {
@@ -162,7 +282,7 @@ func (s *stack) run(ctx context.Context, env *environment.Env) (*StackConfig, er
 		return nil, fmt.Errorf("new optimism portal: %v", err)
 	}
 
-	l1StandardBridge, err := opbindings.NewL1StandardBridge(ope2econfig.L1Deployments.L1StandardBridgeProxy, l1Client)
+	l1StandardBridge, err := monomerbindings.NewL1StandardBridge(ope2econfig.L1Deployments.L1StandardBridgeProxy, l1Client)
 	if err != nil {
 		return nil, fmt.Errorf("new l1 standard bridge: %v", err)
 	}
@@ -172,24 +292,73 @@ func (s *stack) run(ctx context.Context, env *environment.Env) (*StackConfig, er
 		return nil, fmt.Errorf("new l2 output oracle caller: %v", err)
 	}
 
+	disputeGameFactory, err := monomerbindings.NewDisputeGameFactoryCaller(ope2econfig.L1Deployments.DisputeGameFactoryProxy, l1Client)
+	if err != nil {
+		return nil, fmt.Errorf("new dispute game factory caller: %v", err)
+	}
+
 	secrets, err := e2eutils.DefaultMnemonicConfig.Secrets()
 	if err != nil {
 		return nil, fmt.Errorf("get secrets for default mnemonics: %v", err)
 	}
 
+	proposerConfig := &ProposerConfig{
+		L2OutputOracle: ope2econfig.L1Deployments.L2OutputOracleProxy,
+	}
+	var challengerConfig *ChallengerConfig
+	if s.opts.ProposerMode != ProposeToL2OutputOracle {
+		gameType := s.opts.ProposerMode.gameType()
+		// The L1 allocs deploy the dispute game implementations and register them in the DisputeGameFactory.
+		impl, err := disputeGameFactory.GameImpls(&bind.CallOpts{Context: ctx}, gameType)
+		if err != nil {
+			return nil, fmt.Errorf("get the implementation of dispute game type %d: %v", gameType, err)
+		} else if impl == (common.Address{}) {
+			return nil, fmt.Errorf("the L1 allocs don't deploy an implementation of dispute game type %d", gameType)
+		}
+		proposerConfig.DisputeGameFactory = &ope2econfig.L1Deployments.DisputeGameFactoryProxy
+		proposerConfig.GameType = gameType
+		proposerConfig.ProposalInterval = gameProposalInterval
+	}
+	if s.opts.ProposerMode == ProposeFaultDisputeGames {
+		challengerConfig = &ChallengerConfig{
+			DisputeGameFactory: ope2econfig.L1Deployments.DisputeGameFactoryProxy,
+			// Mallory isn't one of the stack's users, so the challenger's L1 txs don't race the tests'.
+			PrivKey: secrets.Mallory,
+		}
+	}
 	opStack := NewOPStack(
 		l1url,
+		l1.beaconURL,
 		s.monomerEngineURL,
 		s.opNodeURL,
-		ope2econfig.L1Deployments.L2OutputOracleProxy,
+		s.opts.OPNodeRPC,
+		s.engineJWTSecret,
+		proposerConfig,
+		challengerConfig,
 		secrets.Batcher,
 		secrets.Proposer,
+		daType,
 		rollupConfig,
 		s.eventListener,
+		s.opts.OPStackOptions...,
 	)
 	if err := opStack.Run(ctx, env); err != nil {
 		return nil, fmt.Errorf("run the op stack: %v", err)
 	}
+	verifiers, err := s.runVerifiers(ctx, env, l1, opStack, rollupConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	opNodeRPCClient, err := rpc.DialContext(ctx, s.opNodeURL.String())
+	if err != nil {
+		return nil, fmt.Errorf("dial op-node: %v", err)
+	}
+	env.Defer(opNodeRPCClient.Close)
+	var p2pClient *p2p.Client
+	if s.opts.OPNodeRPC.P2P {
+		p2pClient = p2p.NewClient(opNodeRPCClient)
+	}
 
 	// construct L2 client
 	l2Client, err := bftclient.New(s.monomerCometURL.String(), "/websocket")
@@ -237,6 +406,11 @@ func (s *stack) run(ctx context.Context, env *environment.Env) (*StackConfig, er
 		OptimismPortal:       opPortal,
 		L1StandardBridge:     l1StandardBridge,
 		L2OutputOracleCaller: l2OutputOracleCaller,
+		DisputeGameFactory:   disputeGameFactory,
+		ProposerMode:         s.opts.ProposerMode,
+		RollupClient:         sources.NewRollupClient(opclient.NewBaseRPCClient(opNodeRPCClient)),
+		P2PClient:            p2pClient,
+		Verifiers:            verifiers,
 		L2Client:             l2Client,
 		MonomerClient:        monomerClient,
 		Users:                []*ecdsa.PrivateKey{secrets.Alice, secrets.Bob},
@@ -247,9 +421,20 @@ func (s *stack) run(ctx context.Context, env *environment.Env) (*StackConfig, er
 		WaitL2: func(numBlocks int) error {
 			return wait(numBlocks, 2)
 		},
+		l1Clock:        l1.clock,
+		l1BlockTime:    deployConfig.L1BlockTime,
+		prometheusAddr: s.prometheusAddr(),
 	}, nil
 }
 
+// prometheusAddr returns the address of the Prometheus metrics server, or an empty string if Prometheus is disabled.
+func (s *stack) prometheusAddr() string {
+	if !s.prometheusCfg.IsPrometheusEnabled() {
+		return ""
+	}
+	return s.prometheusCfg.PrometheusListenAddr
+}
+
 func (s *stack) runMonomer(ctx context.Context, env *environment.Env, genesisTime, chainIDU64 uint64) error {
 	engineWS, err := net.Listen("tcp", s.monomerEngineURL.Host())
 	if err != nil {
@@ -264,6 +449,11 @@ func (s *stack) runMonomer(ctx context.Context, env *environment.Env, genesisTim
 	if err != nil {
 		return fmt.Errorf("new test app: %v", err)
 	}
+	// ERC-20 deposits are only accepted from the devnet's L1StandardBridge.
+	appState := maps.Clone(app.DefaultGenesis())
+	if err := genesis.SetL1StandardBridge(app.AppCodec(), appState, ope2econfig.L1Deployments.L1StandardBridgeProxy); err != nil {
+		return fmt.Errorf("set l1 standard bridge: %v", err)
+	}
 
 	sdkclient, err := client.NewClientFromNode(s.monomerCometURL.String())
 	if err != nil {
@@ -292,24 +482,45 @@ func (s *stack) runMonomer(ctx context.Context, env *environment.Env, genesisTim
 	trieDB := triedb.NewDatabase(rawDB, nil)
 	env.DeferErr("close trieDB", trieDB.Close)
 	ethstatedb := state.NewDatabaseWithNodeDB(rawDB, trieDB)
+	engineJWT, err := jwtauth.NewSecrets(&jwtauth.Config{
+		Secret: s.engineJWTSecret[:],
+	})
+	if err != nil {
+		return fmt.Errorf("new engine jwt secrets: %v", err)
+	}
+	var txForwarding *txforward.Config
+	if s.sequencerCometURL != nil {
+		txForwarding = &txforward.Config{
+			SequencerURL: s.sequencerCometURL.String(),
+		}
+	}
+	var nodeApp monomer.Application = app
+	if s.opts != nil && s.opts.Faults != nil {
+		nodeApp = s.opts.Faults.Wrap(app)
+	}
 	n := node.New(
-		app,
-		&appchainCtx,
+		nodeApp,
 		&genesis.Genesis{
-			AppState: app.DefaultGenesis(),
+			AppState: appState,
 			ChainID:  chainID,
 			Time:     genesisTime,
 		},
-		engineWS,
-		cometListener,
-		localdb.New(blockPebbleDB),
-		mempooldb,
-		txdb,
-		ethstatedb,
-		s.prometheusCfg,
-		s.eventListener,
+		&node.Config{
+			AppchainCtx:     &appchainCtx,
+			EngineListener:  engineWS,
+			CometListener:   cometListener,
+			BlockDB:         localdb.New(blockPebbleDB),
+			MempoolDB:       mempooldb,
+			TxDB:            txdb,
+			EthStateDB:      ethstatedb,
+			EngineJWT:       engineJWT,
+			Instrumentation: s.prometheusCfg,
+			EventListener:   s.eventListener,
+			Replica:         txForwarding != nil,
+			TxForwarding:    txForwarding,
+		},
 	)
-	if err := n.Run(ctx, env); err != nil {
+	if err := n.Start(ctx, env); err != nil {
 		return fmt.Errorf("run monomer: %v", err)
 	}
 	return nil
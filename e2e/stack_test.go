@@ -20,9 +20,9 @@ import (
 	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/receipts"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
-	"github.com/ethereum-optimism/optimism/op-node/bindings"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -31,12 +31,15 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/builder"
 	"github.com/polymerdao/monomer/e2e"
+	"github.com/polymerdao/monomer/engine"
 	"github.com/polymerdao/monomer/environment"
 	"github.com/polymerdao/monomer/node"
 	"github.com/polymerdao/monomer/testapp"
 	"github.com/polymerdao/monomer/utils"
 	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slog"
 )
@@ -77,6 +80,22 @@ var e2eTests = []struct {
 		name: "No Rollbacks",
 		run:  checkForRollbacks,
 	},
+	{
+		name: "op-node Sequencing State",
+		run:  opNodeSequencingState,
+	},
+	{
+		name: "Replica Tx Forwarding",
+		run:  replicaTxForwarding,
+	},
+	{
+		name: "Verifier Convergence",
+		run:  verifierConvergence,
+	},
+	{
+		name: "Prometheus Metrics",
+		run:  prometheusMetrics,
+	},
 }
 
 func TestE2E(t *testing.T) {
@@ -100,13 +119,18 @@ func TestE2E(t *testing.T) {
 	opLogger := log.NewTerminalHandler(openLogFile(t, env, "op"), false)
 
 	prometheusCfg := &config.InstrumentationConfig{
-		Prometheus: false,
+		Prometheus:           true,
+		PrometheusListenAddr: "127.0.0.1:8892",
+		Namespace:            "monomer",
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	stack, err := e2e.Setup(ctx, env, prometheusCfg, &e2e.SelectiveListener{
+	stack, err := e2e.Setup(ctx, env, prometheusCfg, &e2e.Options{
+		OPNodeRPC: e2e.NodeRPCConfig{Admin: true, P2P: true},
+		Verifiers: 1,
+	}, &e2e.SelectiveListener{
 		OPLogCb: func(r slog.Record) {
 			require.NoError(t, opLogger.Handle(context.Background(), r))
 		},
@@ -183,6 +207,110 @@ func checkForRollbacks(t *testing.T, stack *e2e.StackConfig) {
 	require.Fail(t, "event chan closed prematurely")
 }
 
+func opNodeSequencingState(t *testing.T, stack *e2e.StackConfig) {
+	active, err := stack.SequencerActive()
+	require.NoError(t, err)
+	require.True(t, active, "op-node isn't sequencing")
+
+	status, err := stack.SyncStatus()
+	require.NoError(t, err)
+	unsafeHead, err := stack.MonomerClient.BlockByNumber(stack.Ctx, new(big.Int).SetUint64(status.UnsafeL2.Number))
+	require.NoError(t, err)
+	require.Equal(t, unsafeHead.Hash(), status.UnsafeL2.Hash, "op-node's unsafe head isn't Monomer's block")
+
+	// The batcher submits the unsafe head, and op-node derives it as safe.
+	status, err = stack.WaitSafeHead(new(big.Int).SetUint64(status.UnsafeL2.Number))
+	require.NoError(t, err)
+	safeHead, err := stack.MonomerClient.BlockByNumber(stack.Ctx, new(big.Int).SetUint64(status.SafeL2.Number))
+	require.NoError(t, err)
+	require.Equal(t, safeHead.Hash(), status.SafeL2.Hash, "op-node's safe head isn't Monomer's block")
+
+	peer, err := stack.P2PClient.Self(stack.Ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, peer.PeerID)
+	t.Log("op-node is sequencing on Monomer's chain")
+}
+
+func replicaTxForwarding(t *testing.T, stack *e2e.StackConfig) {
+	require.Len(t, stack.Verifiers, 1)
+	replica := stack.Verifiers[0]
+
+	txBytes := testapp.ToTestTx(t, "replicaTxKey", "replicaTxValue")
+	bftTx := bfttypes.Tx(txBytes)
+	putTx, err := replica.L2Client.BroadcastTxSync(stack.Ctx, txBytes)
+	require.NoError(t, err)
+	require.Equal(t, abcitypes.CodeTypeOK, putTx.Code, "put.Code is not OK")
+	t.Log("Replica accepted the tx")
+
+	// The replica doesn't sequence, so the tx is only included if it was forwarded to the sequencer.
+	require.Eventually(t, func() bool {
+		_, err := stack.L2Client.Tx(stack.Ctx, bftTx.Hash(), false)
+		return err == nil
+	}, time.Minute, time.Second, "the sequencer didn't include the tx submitted to the replica")
+	t.Log("Replica forwarded the tx to the sequencer")
+}
+
+func verifierConvergence(t *testing.T, stack *e2e.StackConfig) {
+	require.Len(t, stack.Verifiers, 1)
+	verifier := stack.Verifiers[0]
+
+	status, err := stack.SyncStatus()
+	require.NoError(t, err)
+	// The verifier derives the sequencer's unsafe head as safe once the batcher submits it.
+	verifierStatus, err := verifier.WaitSafeHead(new(big.Int).SetUint64(status.UnsafeL2.Number))
+	require.NoError(t, err)
+
+	for _, head := range []struct {
+		name  string
+		block eth.L2BlockRef
+	}{
+		{"safe", verifierStatus.SafeL2},
+		{"finalized", verifierStatus.FinalizedL2},
+	} {
+		number := new(big.Int).SetUint64(head.block.Number)
+		sequencerBlock, err := stack.MonomerClient.BlockByNumber(stack.Ctx, number)
+		require.NoError(t, err)
+		require.Equal(t, sequencerBlock.Hash(), head.block.Hash, "verifier's %s head isn't the sequencer's block", head.name)
+		verifierBlock, err := verifier.MonomerClient.BlockByNumber(stack.Ctx, number)
+		require.NoError(t, err)
+		require.Equal(t, head.block.Hash, verifierBlock.Hash(), "verifier's %s head isn't its Monomer's block", head.name)
+	}
+	t.Log("Verifier converged with the sequencer")
+}
+
+func prometheusMetrics(t *testing.T, stack *e2e.StackConfig) {
+	require.NoError(t, stack.WaitL2(2))
+	families, err := stack.ScrapeMetrics()
+	require.NoError(t, err)
+
+	histogram := func(name string, labels ...string) *dto.Histogram {
+		family, ok := families[name]
+		require.True(t, ok, "%s is missing", name)
+		for _, metric := range family.GetMetric() {
+			if len(labels) == 0 {
+				return metric.GetHistogram()
+			}
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == labels[0] && label.GetValue() == labels[1] {
+					return metric.GetHistogram()
+				}
+			}
+		}
+		require.Fail(t, "metric not found", "%s%v", name, labels)
+		return nil
+	}
+	require.NotZero(t, histogram("monomer_builder_build_duration_seconds").GetSampleCount(), "no block builds recorded")
+	require.NotZero(t, histogram("monomer_builder_block_bytes").GetSampleSum(), "no block sizes recorded")
+	for _, store := range []string{builder.CommitStoreApp, builder.CommitStoreBlock, builder.CommitStoreTx} {
+		require.NotZero(t, histogram("monomer_builder_commit_duration_seconds", "store", store).GetSampleCount(),
+			"no %s commits recorded", store)
+	}
+	require.NotZero(t, histogram("monomer_engine_method_call", "method", engine.ForkchoiceUpdatedV3MethodName).GetSampleCount(),
+		"no engine API calls recorded")
+	require.Contains(t, families, "monomer_builder_mempool_depth")
+	t.Log("Monomer's Prometheus metrics were scraped")
+}
+
 func containsAttributesTx(t *testing.T, stack *e2e.StackConfig) {
 	targetHeight := uint64(5)
 
@@ -350,10 +478,14 @@ func ethRollupFlow(t *testing.T, stack *e2e.StackConfig) {
 	requireEthIsBurned(t, stack, userCosmosAddr, depositValueHex)
 
 	// wait for the L2 output containing the withdrawal tx to be proposed on L1
-	l2OutputBlockNumber := waitForL2OutputProposal(t, stack.L2OutputOracleCaller)
+	withdrawalBlock, err := stack.MonomerClient.BlockByNumber(stack.Ctx, nil)
+	require.NoError(t, err)
+	_, output, err := stack.WaitForOutput(withdrawalBlock.Number())
+	require.NoError(t, err)
+	require.NoError(t, stack.CheckOutputs())
 
 	// generate the proofs necessary to prove the withdrawal on L1
-	provenWithdrawalParams, err := e2e.ProveWithdrawalParameters(stack, *withdrawalTx, l2OutputBlockNumber)
+	provenWithdrawalParams, err := e2e.ProveWithdrawalParameters(stack, *withdrawalTx, output.L2BlockNumber)
 	require.NoError(t, err)
 
 	// send a withdrawal proving tx to prove the withdrawal on L1
@@ -392,9 +524,7 @@ func ethRollupFlow(t *testing.T, stack *e2e.StackConfig) {
 	require.NoError(t, proveWithdrawalLogs.Close())
 
 	// wait for the withdrawal finalization period before sending the withdrawal finalizing tx
-	finalizationPeriod, err := stack.L2OutputOracleCaller.FinalizationPeriodSeconds(&bind.CallOpts{})
-	require.NoError(t, err)
-	time.Sleep(time.Duration(finalizationPeriod.Uint64()) * time.Second)
+	require.NoError(t, stack.WaitFinalizationPeriod())
 
 	// get the user's balance before the withdrawal has been finalized
 	balanceBeforeFinalization, err := stack.L1Client.BalanceAt(stack.Ctx, userAddress, nil)
@@ -625,23 +755,3 @@ func getSuggestedL1GasPrice(t *testing.T, stack *e2e.StackConfig) *big.Int {
 	require.NoError(t, err)
 	return gasPrice
 }
-
-// waitForL2OutputProposal waits for the L2 output containing the withdrawal tx to be proposed on L1 and returns
-// the block number with the L2 output proposal.
-func waitForL2OutputProposal(t *testing.T, l2OutputOracleCaller *bindings.L2OutputOracleCaller) *big.Int {
-	// get the L2 block number where the withdrawal tx will be included in an output proposal
-	nextOutputBlockNumber, err := l2OutputOracleCaller.NextBlockNumber(&bind.CallOpts{})
-	require.NoError(t, err)
-
-	// wait for the L2 output containing the withdrawal tx to be proposed on L1
-	l2OutputBlockNumber, err := l2OutputOracleCaller.LatestBlockNumber(&bind.CallOpts{})
-	require.NoError(t, err)
-	for l2OutputBlockNumber.Cmp(nextOutputBlockNumber) < 0 {
-		l2OutputBlockNumber, err = l2OutputOracleCaller.LatestBlockNumber(&bind.CallOpts{})
-		require.NoError(t, err)
-
-		time.Sleep(250 * time.Millisecond)
-	}
-
-	return l2OutputBlockNumber
-}
@@ -0,0 +1,114 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cometbft/cometbft/config"
+	bftclient "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	opclient "github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/rpc"
+	e2eurl "github.com/polymerdao/monomer/e2e/url"
+	"github.com/polymerdao/monomer/environment"
+)
+
+const (
+	// verifierBasePort is the first of the hard-coded ports the verifiers listen on. Each verifier uses
+	// verifierPortsPerNode consecutive ports.
+	verifierBasePort     = 8950
+	verifierPortsPerNode = 3
+)
+
+// Verifier is a Monomer replica driven by a non-sequencing op-node. The op-node doesn't receive unsafe blocks from the
+// sequencer: it derives the chain from the batches on L1, so the verifier's safe head only converges with the
+// sequencer's if the batches reproduce the sequencer's blocks. The txs submitted to the replica are forwarded to the
+// sequencer.
+type Verifier struct {
+	MonomerClient *MonomerClient
+	RollupClient  *sources.RollupClient
+	L2Client      *bftclient.HTTP
+	ctx           context.Context
+}
+
+// runVerifiers runs Options.Verifiers verifiers that derive the chain of the rollup from L1.
+func (s *stack) runVerifiers(
+	ctx context.Context,
+	env *environment.Env,
+	l1 *l1Devnet,
+	opStack *OPStack,
+	rollupConfig *rollup.Config,
+) ([]*Verifier, error) {
+	verifiers := make([]*Verifier, 0, s.opts.Verifiers)
+	for i := range s.opts.Verifiers {
+		name := fmt.Sprintf("verifier-%d", i)
+		port := verifierBasePort + i*verifierPortsPerNode
+		engineURL, err := e2eurl.ParseString(fmt.Sprintf("ws://127.0.0.1:%d", port))
+		if err != nil {
+			return nil, fmt.Errorf("new %s monomer url: %v", name, err)
+		}
+		cometURL, err := e2eurl.ParseString(fmt.Sprintf("http://127.0.0.1:%d", port+1))
+		if err != nil {
+			return nil, fmt.Errorf("new %s cometBFT url: %v", name, err)
+		}
+		nodeURL, err := e2eurl.ParseString(fmt.Sprintf("http://127.0.0.1:%d", port+2)) //nolint:mnd
+		if err != nil {
+			return nil, fmt.Errorf("new %s op-node url: %v", name, err)
+		}
+
+		verifierStack := &stack{
+			monomerEngineURL: engineURL,
+			monomerCometURL:  cometURL,
+			engineJWTSecret:  s.engineJWTSecret,
+			eventListener:    s.eventListener,
+			prometheusCfg: &config.InstrumentationConfig{
+				Prometheus: false,
+			},
+			sequencerCometURL: s.monomerCometURL,
+		}
+		if err := verifierStack.runMonomer(ctx, env, l1.latestBlock.Time(), l1.deployConfig.L2ChainID); err != nil {
+			return nil, fmt.Errorf("run %s monomer: %v", name, err)
+		}
+		if !engineURL.IsReachable(ctx) {
+			return nil, fmt.Errorf("reaching %s monomer url: %s", name, engineURL.String())
+		}
+		monomerRPCClient, err := rpc.DialContext(ctx, engineURL.String())
+		if err != nil {
+			return nil, fmt.Errorf("dial %s monomer: %v", name, err)
+		}
+		env.Defer(monomerRPCClient.Close)
+		monomerClient := NewMonomerClient(monomerRPCClient)
+
+		// The verifier must start from the sequencer's genesis block.
+		l2GenesisBlockHash, err := monomerClient.GenesisHash(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get %s Monomer genesis block hash: %v", name, err)
+		}
+		if l2GenesisBlockHash != rollupConfig.Genesis.L2.Hash {
+			return nil, fmt.Errorf("%s has genesis block %s, expected %s", name, l2GenesisBlockHash, rollupConfig.Genesis.L2.Hash)
+		}
+
+		if err := opStack.RunVerifier(ctx, env, "node-"+name, engineURL, nodeURL); err != nil {
+			return nil, fmt.Errorf("run %s op-node: %v", name, err)
+		}
+		rollupRPCClient, err := rpc.DialContext(ctx, nodeURL.String())
+		if err != nil {
+			return nil, fmt.Errorf("dial %s op-node: %v", name, err)
+		}
+		env.Defer(rollupRPCClient.Close)
+
+		l2Client, err := bftclient.New(cometURL.String(), "/websocket")
+		if err != nil {
+			return nil, fmt.Errorf("new %s Comet client: %v", name, err)
+		}
+
+		verifiers = append(verifiers, &Verifier{
+			MonomerClient: monomerClient,
+			RollupClient:  sources.NewRollupClient(opclient.NewBaseRPCClient(rollupRPCClient)),
+			L2Client:      l2Client,
+			ctx:           ctx,
+		})
+	}
+	return verifiers, nil
+}
@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/polymerdao/monomer/monomerdb"
+)
+
+// Capabilities are the Engine API methods Monomer serves, as returned by engine_exchangeCapabilities.
+var Capabilities = []string{
+	"engine_exchangeTransitionConfigurationV1",
+	"engine_forkchoiceUpdatedV1",
+	"engine_forkchoiceUpdatedV2",
+	"engine_forkchoiceUpdatedV3",
+	"engine_getPayloadV1",
+	"engine_getPayloadV2",
+	"engine_getPayloadV3",
+	"engine_newPayloadV1",
+	"engine_newPayloadV2",
+	"engine_newPayloadV3",
+}
+
+// currentMethods are the method versions op-node must call for Monomer's blocks.
+var currentMethods = []string{
+	"engine_forkchoiceUpdatedV3",
+	"engine_getPayloadV3",
+	"engine_newPayloadV3",
+}
+
+// deprecatedMethods maps the deprecated method versions Monomer still serves to the version that replaces them.
+// Monomer chains start at Ecotone, so the older versions are handled as their V3 replacement.
+var deprecatedMethods = map[string]string{
+	"engine_forkchoiceUpdatedV1": "engine_forkchoiceUpdatedV3",
+	"engine_forkchoiceUpdatedV2": "engine_forkchoiceUpdatedV3",
+	"engine_getPayloadV1":        "engine_getPayloadV3",
+	"engine_getPayloadV2":        "engine_getPayloadV3",
+	"engine_newPayloadV1":        "engine_newPayloadV3",
+	"engine_newPayloadV2":        "engine_newPayloadV3",
+}
+
+// DeprecationWarning reports that op-node called a deprecated method version.
+type DeprecationWarning struct {
+	Method      string
+	Replacement string
+}
+
+func (w *DeprecationWarning) Error() string {
+	return fmt.Sprintf("%s is deprecated and handled as %s; upgrade op-node to a release that calls %s",
+		w.Method, w.Replacement, w.Replacement)
+}
+
+// CapabilitiesWarning reports that op-node's Engine API methods don't match Monomer's. Unsupported methods are the ones
+// op-node supports but Monomer doesn't, so calls to them fail with "method not found", e.g., after a hard fork Monomer
+// doesn't support yet. Missing methods are the current versions op-node doesn't support, so it calls deprecated ones.
+type CapabilitiesWarning struct {
+	Unsupported []string
+	Missing     []string
+}
+
+func (w *CapabilitiesWarning) Error() string {
+	var reasons []string
+	if len(w.Unsupported) > 0 {
+		reasons = append(reasons, fmt.Sprintf("op-node supports methods Monomer doesn't serve: %s; upgrade Monomer if op-node requires them",
+			strings.Join(w.Unsupported, ", ")))
+	}
+	if len(w.Missing) > 0 {
+		reasons = append(reasons, fmt.Sprintf("op-node doesn't support current methods: %s; upgrade op-node",
+			strings.Join(w.Missing, ", ")))
+	}
+	return "engine capabilities mismatch: " + strings.Join(reasons, "; ")
+}
+
+// ExchangeCapabilities returns the methods Monomer serves. Mismatches with the methods op-node supports are reported as
+// a *CapabilitiesWarning, so upgrading either side without the other fails informatively.
+// More: https://github.com/ethereum/execution-apis/blob/main/src/engine/common.md#capabilities
+func (e *EngineAPI) ExchangeCapabilities(capabilities []string) []string {
+	warning := new(CapabilitiesWarning)
+	for _, capability := range capabilities {
+		if strings.HasPrefix(capability, "engine_") && !slices.Contains(Capabilities, capability) {
+			warning.Unsupported = append(warning.Unsupported, capability)
+		}
+	}
+	for _, method := range currentMethods {
+		if !slices.Contains(capabilities, method) {
+			warning.Missing = append(warning.Missing, method)
+		}
+	}
+	if len(warning.Unsupported) > 0 || len(warning.Missing) > 0 {
+		e.onWarn(warning)
+	}
+	return slices.Clone(Capabilities)
+}
+
+// deprecated reports the first call to a deprecated method version with a *DeprecationWarning. op-node calls the same
+// methods for every block, so later calls aren't reported again.
+func (e *EngineAPI) deprecated(method string) {
+	e.warnLock.Lock()
+	defer e.warnLock.Unlock()
+	if _, ok := e.warned[method]; ok {
+		return
+	}
+	e.warned[method] = struct{}{}
+	e.onWarn(&DeprecationWarning{
+		Method:      method,
+		Replacement: deprecatedMethods[method],
+	})
+}
+
+// ExchangeTransitionConfigurationV1 checks that op-node agrees with Monomer on the merge transition, which some op-node
+// versions call at startup. Monomer chains are post-merge from their genesis block, so the terminal total difficulty
+// is zero. A terminal block op-node sets must be Monomer's block at that height, e.g., the genesis block of op-node's
+// rollup config, so a node started with another chain's genesis fails up front instead of on the first forkchoice
+// update.
+// More: https://github.com/ethereum/execution-apis/blob/main/src/engine/paris.md#engine_exchangetransitionconfigurationv1
+func (e *EngineAPI) ExchangeTransitionConfigurationV1(
+	config engine.TransitionConfigurationV1,
+) (*engine.TransitionConfigurationV1, error) {
+	if config.TerminalTotalDifficulty == nil {
+		return nil, engine.InvalidParams.With(errors.New("terminal total difficulty not provided"))
+	}
+	if ttd := config.TerminalTotalDifficulty.ToInt(); ttd.Sign() != 0 {
+		return nil, engine.InvalidParams.With(fmt.Errorf("terminal total difficulty is %v, but Monomer chains are post-merge "+
+			"from genesis with a terminal total difficulty of 0: check that op-node is configured for this chain", ttd))
+	}
+	result := &engine.TransitionConfigurationV1{
+		TerminalTotalDifficulty: new(hexutil.Big),
+	}
+	if config.TerminalBlockHash == (common.Hash{}) {
+		return result, nil
+	}
+
+	header, err := e.blockStore.HeaderByHeight(uint64(config.TerminalBlockNumber))
+	if errors.Is(err, monomerdb.ErrNotFound) {
+		return nil, engine.InvalidParams.With(fmt.Errorf("terminal block %d not found: op-node's rollup config is for "+
+			"another chain, or Monomer's block store is behind it", config.TerminalBlockNumber))
+	} else if err != nil {
+		return nil, engine.GenericServerError.With(fmt.Errorf("header by height: %v", err))
+	}
+	if header.Hash != config.TerminalBlockHash {
+		return nil, engine.InvalidParams.With(fmt.Errorf("terminal block %d is %s, but op-node expects %s: op-node's rollup "+
+			"config is for another genesis; check its genesis.l2.hash with `monomer validate-config --monomer.rollup-config`",
+			config.TerminalBlockNumber, header.Hash, config.TerminalBlockHash))
+	}
+	result.TerminalBlockHash = config.TerminalBlockHash
+	result.TerminalBlockNumber = config.TerminalBlockNumber
+	return result, nil
+}
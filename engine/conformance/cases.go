@@ -0,0 +1,455 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	forkchoiceUpdatedMethod  = "engine_forkchoiceUpdatedV3"
+	getPayloadMethod         = "engine_getPayloadV3"
+	newPayloadMethod         = "engine_newPayloadV3"
+	exchangeCapabilitiesName = "engine_exchangeCapabilities"
+	exchangeTransitionName   = "engine_exchangeTransitionConfigurationV1"
+
+	// unknownPayloadCode is the Engine API's "Unknown payload" error code. go-ethereum doesn't define it.
+	unknownPayloadCode = -38001
+)
+
+// unknownHash is a block hash no endpoint is expected to know about.
+var unknownHash = common.HexToHash("0xdeadbeef")
+
+// Cases returns the default cases. They follow the Engine API spec
+// (https://github.com/ethereum/execution-apis/tree/main/src/engine) and the OP Stack's additions to it
+// (https://specs.optimism.io/protocol/exec-engine.html).
+func Cases() []*Case {
+	return []*Case{
+		{
+			Method: forkchoiceUpdatedMethod,
+			Name:   "no attributes returns VALID with a null payloadId",
+			Run: func(ctx context.Context, c *Client) error {
+				head, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				result, err := c.ForkchoiceUpdated(ctx, forkchoiceState(head.Hash), nil)
+				if err != nil {
+					return err
+				}
+				if err := expectStatus(&result.PayloadStatus, eth.ExecutionValid); err != nil {
+					return err
+				}
+				if result.PayloadStatus.LatestValidHash == nil || *result.PayloadStatus.LatestValidHash != head.Hash {
+					return fmt.Errorf("expected latestValidHash %s, got %v", head.Hash, result.PayloadStatus.LatestValidHash)
+				}
+				if result.PayloadID != nil {
+					return fmt.Errorf("expected a null payloadId, got %s", result.PayloadID)
+				}
+				return nil
+			},
+		},
+		{
+			Method: forkchoiceUpdatedMethod,
+			Name:   "unknown head block returns -38002",
+			Run: func(ctx context.Context, c *Client) error {
+				head, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				_, err = c.ForkchoiceUpdated(ctx, &eth.ForkchoiceState{
+					HeadBlockHash:      unknownHash,
+					SafeBlockHash:      head.Hash,
+					FinalizedBlockHash: head.Hash,
+				}, nil)
+				return expectCode(err, engine.InvalidForkChoiceState.ErrorCode())
+			},
+		},
+		{
+			Method: forkchoiceUpdatedMethod,
+			Name:   "unknown safe block returns -38002",
+			Run: func(ctx context.Context, c *Client) error {
+				head, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				_, err = c.ForkchoiceUpdated(ctx, &eth.ForkchoiceState{
+					HeadBlockHash:      head.Hash,
+					SafeBlockHash:      unknownHash,
+					FinalizedBlockHash: head.Hash,
+				}, nil)
+				return expectCode(err, engine.InvalidForkChoiceState.ErrorCode())
+			},
+		},
+		{
+			Method: forkchoiceUpdatedMethod,
+			Name:   "unknown finalized block returns -38002",
+			Run: func(ctx context.Context, c *Client) error {
+				head, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				_, err = c.ForkchoiceUpdated(ctx, &eth.ForkchoiceState{
+					HeadBlockHash:      head.Hash,
+					SafeBlockHash:      head.Hash,
+					FinalizedBlockHash: unknownHash,
+				}, nil)
+				return expectCode(err, engine.InvalidForkChoiceState.ErrorCode())
+			},
+		},
+		{
+			Method: forkchoiceUpdatedMethod,
+			Name:   "safe block after the head block returns -38002",
+			Run: func(ctx context.Context, c *Client) error {
+				parent, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				child, err := c.BuildBlock(ctx)
+				if err != nil {
+					return err
+				}
+				_, err = c.ForkchoiceUpdated(ctx, &eth.ForkchoiceState{
+					HeadBlockHash:      parent.Hash,
+					SafeBlockHash:      child.Hash,
+					FinalizedBlockHash: parent.Hash,
+				}, nil)
+				return expectCode(err, engine.InvalidForkChoiceState.ErrorCode())
+			},
+		},
+		{
+			Method: forkchoiceUpdatedMethod,
+			Name:   "attributes without a gasLimit return -38003",
+			Run: func(ctx context.Context, c *Client) error {
+				return expectInvalidAttributes(ctx, c, func(attrs *eth.PayloadAttributes) {
+					attrs.GasLimit = nil
+				})
+			},
+		},
+		{
+			Method: forkchoiceUpdatedMethod,
+			Name:   "attributes with a timestamp not after the parent's return -38003",
+			Run: func(ctx context.Context, c *Client) error {
+				return expectInvalidAttributes(ctx, c, func(attrs *eth.PayloadAttributes) {
+					attrs.Timestamp--
+				})
+			},
+		},
+		{
+			Method: forkchoiceUpdatedMethod,
+			Name:   "attributes without the L1 attributes tx return -38003",
+			Run: func(ctx context.Context, c *Client) error {
+				return expectInvalidAttributes(ctx, c, func(attrs *eth.PayloadAttributes) {
+					attrs.Transactions = nil
+				})
+			},
+		},
+		{
+			Method: forkchoiceUpdatedMethod,
+			Name:   "valid attributes return VALID with a payloadId",
+			Run: func(ctx context.Context, c *Client) error {
+				head, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				attrs, err := c.Attributes(head)
+				if err != nil {
+					return err
+				}
+				result, err := c.ForkchoiceUpdated(ctx, forkchoiceState(head.Hash), attrs)
+				if err != nil {
+					return err
+				}
+				if err := expectStatus(&result.PayloadStatus, eth.ExecutionValid); err != nil {
+					return err
+				}
+				if result.PayloadID == nil {
+					return errors.New("expected a payloadId")
+				}
+				return nil
+			},
+		},
+		{
+			Method: getPayloadMethod,
+			Name:   "unknown payloadId returns -38001",
+			Run: func(ctx context.Context, c *Client) error {
+				_, err := c.GetPayload(ctx, eth.PayloadID{0xff})
+				return expectCode(err, unknownPayloadCode)
+			},
+		},
+		{
+			Method: getPayloadMethod,
+			Name:   "payload is built from the attributes",
+			Run: func(ctx context.Context, c *Client) error {
+				head, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				attrs, err := c.Attributes(head)
+				if err != nil {
+					return err
+				}
+				payload, err := c.BuildPayload(ctx, head, attrs)
+				if err != nil {
+					return err
+				}
+				switch {
+				case payload.ParentHash != head.Hash:
+					return fmt.Errorf("expected parentHash %s, got %s", head.Hash, payload.ParentHash)
+				case uint64(payload.BlockNumber) != uint64(head.Number)+1:
+					return fmt.Errorf("expected blockNumber %d, got %d", head.Number+1, payload.BlockNumber)
+				case payload.Timestamp != eth.Uint64Quantity(attrs.Timestamp):
+					return fmt.Errorf("expected timestamp %d, got %d", attrs.Timestamp, payload.Timestamp)
+				case payload.GasLimit != *attrs.GasLimit:
+					return fmt.Errorf("expected gasLimit %d, got %d", *attrs.GasLimit, payload.GasLimit)
+				}
+				return expectTxPrefix(payload.Transactions, attrs.Transactions)
+			},
+		},
+		{
+			Method: getPayloadMethod,
+			Name:   "payload hashes to its blockHash",
+			Run: func(ctx context.Context, c *Client) error {
+				head, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				attrs, err := c.Attributes(head)
+				if err != nil {
+					return err
+				}
+				payload, err := c.BuildPayload(ctx, head, attrs)
+				if err != nil {
+					return err
+				}
+				// op-node drops gossiped payloads that fail this check.
+				envelope := &eth.ExecutionPayloadEnvelope{
+					ParentBeaconBlockRoot: attrs.ParentBeaconBlockRoot,
+					ExecutionPayload:      payload,
+				}
+				if actual, ok := envelope.CheckBlockHash(); !ok {
+					return fmt.Errorf("expected blockHash %s, got %s", actual, payload.BlockHash)
+				}
+				return nil
+			},
+		},
+		{
+			Method: getPayloadMethod,
+			Name:   "deposit txs are included in order after the L1 attributes tx",
+			Run: func(ctx context.Context, c *Client) error {
+				head, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				var depositTxs []*ethtypes.Transaction
+				for i := int64(1); i <= 2; i++ {
+					to := common.BigToAddress(big.NewInt(i))
+					depositTxs = append(depositTxs, ethtypes.NewTx(&ethtypes.DepositTx{
+						SourceHash: common.BigToHash(big.NewInt(i)),
+						From:       to,
+						To:         &to,
+						Mint:       big.NewInt(i),
+						Value:      big.NewInt(0),
+						Gas:        100_000, //nolint:mnd
+					}))
+				}
+				attrs, err := c.Attributes(head, depositTxs...)
+				if err != nil {
+					return err
+				}
+				payload, err := c.BuildPayload(ctx, head, attrs)
+				if err != nil {
+					return err
+				}
+				return expectTxPrefix(payload.Transactions, attrs.Transactions)
+			},
+		},
+		{
+			Method: newPayloadMethod,
+			Name:   "known payload returns VALID with a latestValidHash",
+			Run: func(ctx context.Context, c *Client) error {
+				head, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				attrs, err := c.Attributes(head)
+				if err != nil {
+					return err
+				}
+				payload, err := c.BuildPayload(ctx, head, attrs)
+				if err != nil {
+					return err
+				}
+				status, err := c.NewPayload(ctx, payload, attrs.ParentBeaconBlockRoot)
+				if err != nil {
+					return err
+				}
+				if err := expectStatus(status, eth.ExecutionValid); err != nil {
+					return err
+				}
+				if status.LatestValidHash == nil {
+					return errors.New("expected a latestValidHash")
+				}
+				return nil
+			},
+		},
+		{
+			Method: newPayloadMethod,
+			Name:   "unknown payload returns a status rather than an error",
+			Run: func(ctx context.Context, c *Client) error {
+				status, err := c.NewPayload(ctx, &eth.ExecutionPayload{BlockHash: unknownHash}, &common.Hash{})
+				if err != nil {
+					return err
+				}
+				return expectStatus(status, eth.ExecutionSyncing, eth.ExecutionAccepted, eth.ExecutionInvalid, eth.ExecutionInvalidBlockHash)
+			},
+		},
+		{
+			Method: newPayloadMethod,
+			Name:   "payload with more than 16 withdrawals returns INVALID",
+			Run: func(ctx context.Context, c *Client) error {
+				head, err := c.Head(ctx)
+				if err != nil {
+					return err
+				}
+				withdrawals := make(ethtypes.Withdrawals, 17) //nolint:mnd
+				status, err := c.NewPayload(ctx, &eth.ExecutionPayload{
+					BlockHash:   head.Hash,
+					Withdrawals: &withdrawals,
+				}, &common.Hash{})
+				if err != nil {
+					return err
+				}
+				return expectStatus(status, eth.ExecutionInvalid)
+			},
+		},
+		{
+			Method: forkchoiceUpdatedMethod,
+			Name:   "ancestor head block reorgs the chain",
+			Run: func(ctx context.Context, c *Client) error {
+				ancestor, err := c.BuildBlock(ctx)
+				if err != nil {
+					return err
+				}
+				orphan, err := c.BuildBlock(ctx)
+				if err != nil {
+					return err
+				}
+				result, err := c.ForkchoiceUpdated(ctx, forkchoiceState(ancestor.Hash), nil)
+				if err != nil {
+					return err
+				}
+				if err := expectStatus(&result.PayloadStatus, eth.ExecutionValid); err != nil {
+					return err
+				}
+				if head, err := c.Head(ctx); err != nil {
+					return err
+				} else if head.Hash != ancestor.Hash {
+					return fmt.Errorf("expected head %s, got %s", ancestor.Hash, head.Hash)
+				}
+				// The chain must keep growing from the new head.
+				if replacement, err := c.BuildBlock(ctx); err != nil {
+					return err
+				} else if replacement.Number != orphan.Number {
+					return fmt.Errorf("expected replacement block number %d, got %d", orphan.Number, replacement.Number)
+				}
+				return nil
+			},
+		},
+		{
+			Method: exchangeCapabilitiesName,
+			Name:   "returns the supported methods",
+			Run: func(ctx context.Context, c *Client) error {
+				methods := []string{forkchoiceUpdatedMethod, getPayloadMethod, newPayloadMethod}
+				var supported []string
+				if err := c.Call(ctx, &supported, exchangeCapabilitiesName, methods); err != nil {
+					return err
+				}
+				for _, method := range methods {
+					if !slices.Contains(supported, method) {
+						return fmt.Errorf("%s is not supported", method)
+					}
+				}
+				return nil
+			},
+		},
+		{
+			Method: exchangeTransitionName,
+			Name:   "returns a zero terminal total difficulty for post-merge chains",
+			Run: func(ctx context.Context, c *Client) error {
+				var config engine.TransitionConfigurationV1
+				if err := c.Call(ctx, &config, exchangeTransitionName, &engine.TransitionConfigurationV1{
+					TerminalTotalDifficulty: new(hexutil.Big),
+				}); err != nil {
+					return err
+				}
+				if config.TerminalTotalDifficulty == nil || config.TerminalTotalDifficulty.ToInt().Sign() != 0 {
+					return fmt.Errorf("expected a zero terminal total difficulty, got %v", config.TerminalTotalDifficulty)
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// expectInvalidAttributes builds valid attributes on top of the head, invalidates them with invalidate, and expects
+// the endpoint to reject them with -38003.
+func expectInvalidAttributes(ctx context.Context, c *Client, invalidate func(*eth.PayloadAttributes)) error {
+	head, err := c.Head(ctx)
+	if err != nil {
+		return err
+	}
+	attrs, err := c.Attributes(head)
+	if err != nil {
+		return err
+	}
+	invalidate(attrs)
+	_, err = c.ForkchoiceUpdated(ctx, forkchoiceState(head.Hash), attrs)
+	return expectCode(err, engine.InvalidPayloadAttributes.ErrorCode())
+}
+
+func expectCode(err error, code int) error {
+	if err == nil {
+		return fmt.Errorf("expected error code %d, got no error", code)
+	}
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return fmt.Errorf("expected error code %d, got %v", code, err)
+	}
+	if rpcErr.ErrorCode() != code {
+		return fmt.Errorf("expected error code %d, got %d (%v)", code, rpcErr.ErrorCode(), err)
+	}
+	return nil
+}
+
+func expectStatus(status *eth.PayloadStatusV1, expected ...eth.ExecutePayloadStatus) error {
+	for _, s := range expected {
+		if status.Status == s {
+			return nil
+		}
+	}
+	return fmt.Errorf("expected status %v, got %s", expected, status.Status)
+}
+
+// expectTxPrefix ensures txs starts with prefix.
+func expectTxPrefix(txs, prefix []eth.Data) error {
+	if len(txs) < len(prefix) {
+		return fmt.Errorf("expected at least %d txs, got %d", len(prefix), len(txs))
+	}
+	for i, tx := range prefix {
+		if !bytes.Equal(txs[i], tx) {
+			return fmt.Errorf("tx %d does not match the attributes", i)
+		}
+	}
+	return nil
+}
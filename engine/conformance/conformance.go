@@ -0,0 +1,266 @@
+// Package conformance checks an Engine API endpoint against the Engine API and OP Stack specs, hive-style: every case
+// drives the endpoint over JSON-RPC, exactly like op-node, and the results are reported as a compliance matrix.
+//
+// The cases assume the endpoint also serves eth_getBlockByNumber, as Monomer does, and that they are the only source
+// of forkchoice updates while they run.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// gasLimit is the gas limit of the payloads the cases build.
+const gasLimit = 30_000_000
+
+// Case is a single conformance check.
+type Case struct {
+	// Method is the Engine API method under test.
+	Method string
+	// Name describes the expected behavior.
+	Name string
+	Run  func(context.Context, *Client) error
+}
+
+// ID identifies the case in a Matrix.
+func (c *Case) ID() string {
+	return c.Method + ": " + c.Name
+}
+
+// Result is the outcome of a Case. Err is nil if the case passed.
+type Result struct {
+	Case     *Case
+	Err      error
+	Duration time.Duration
+}
+
+// Matrix is the compliance matrix of an endpoint.
+type Matrix []*Result
+
+// Failed returns the results of the cases that failed.
+func (m Matrix) Failed() Matrix {
+	var failed Matrix
+	for _, result := range m {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// WriteTo writes the matrix as an aligned table.
+func (m Matrix) WriteTo(w io.Writer) (int64, error) {
+	counter := &countingWriter{w: w}
+	tw := tabwriter.NewWriter(counter, 0, 0, 2, ' ', 0) //nolint:mnd
+	fmt.Fprintln(tw, "METHOD\tCASE\tRESULT\tDETAILS")
+	var passed int
+	for _, result := range m {
+		status, details := "PASS", ""
+		if result.Err != nil {
+			status, details = "FAIL", result.Err.Error()
+		} else {
+			passed++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.Case.Method, result.Case.Name, status, details)
+	}
+	fmt.Fprintf(tw, "\n%d/%d cases passed\n", passed, len(m))
+	if err := tw.Flush(); err != nil {
+		return counter.n, err
+	}
+	return counter.n, nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Run runs the cases in order against the endpoint client is connected to.
+func Run(ctx context.Context, client *rpc.Client, cases []*Case) Matrix {
+	c := NewClient(client)
+	matrix := make(Matrix, 0, len(cases))
+	for _, tc := range cases {
+		start := time.Now()
+		err := tc.Run(ctx, c)
+		matrix = append(matrix, &Result{
+			Case:     tc,
+			Err:      err,
+			Duration: time.Since(start),
+		})
+	}
+	return matrix
+}
+
+// Client is an Engine API client that builds blocks the way op-node does.
+type Client struct {
+	rpc         *rpc.Client
+	l1Block     *ethtypes.Block
+	sequenceNum uint64
+}
+
+func NewClient(client *rpc.Client) *Client {
+	return &Client{
+		rpc: client,
+		l1Block: ethtypes.NewBlock(&ethtypes.Header{
+			BaseFee:    big.NewInt(10), //nolint:mnd
+			Difficulty: common.Big0,
+			Number:     big.NewInt(0),
+		}, nil, nil, nil, trie.NewStackTrie(nil)),
+	}
+}
+
+// Header is the subset of a block header the cases need.
+type Header struct {
+	Hash   common.Hash    `json:"hash"`
+	Number hexutil.Uint64 `json:"number"`
+	Time   hexutil.Uint64 `json:"timestamp"`
+}
+
+// Head returns the unsafe head.
+func (c *Client) Head(ctx context.Context) (*Header, error) {
+	var header *Header
+	if err := c.rpc.CallContext(ctx, &header, "eth_getBlockByNumber", "latest", false); err != nil {
+		return nil, fmt.Errorf("get head: %v", err)
+	}
+	if header == nil {
+		return nil, errors.New("head not found")
+	}
+	return header, nil
+}
+
+func (c *Client) ForkchoiceUpdated(
+	ctx context.Context,
+	fcs *eth.ForkchoiceState,
+	attrs *eth.PayloadAttributes,
+) (*eth.ForkchoiceUpdatedResult, error) {
+	var result *eth.ForkchoiceUpdatedResult
+	if err := c.rpc.CallContext(ctx, &result, "engine_forkchoiceUpdatedV3", fcs, attrs); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) GetPayload(ctx context.Context, id eth.PayloadID) (*eth.ExecutionPayloadEnvelope, error) {
+	var envelope *eth.ExecutionPayloadEnvelope
+	if err := c.rpc.CallContext(ctx, &envelope, "engine_getPayloadV3", id); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// NewPayload inserts payload. Payloads built from Attributes commit to parentBeaconBlockRoot.
+func (c *Client) NewPayload(
+	ctx context.Context,
+	payload *eth.ExecutionPayload,
+	parentBeaconBlockRoot *common.Hash,
+) (*eth.PayloadStatusV1, error) {
+	var status *eth.PayloadStatusV1
+	if err := c.rpc.CallContext(ctx, &status, "engine_newPayloadV3", payload, []common.Hash{}, parentBeaconBlockRoot); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Call calls any method.
+func (c *Client) Call(ctx context.Context, result any, method string, args ...any) error {
+	return c.rpc.CallContext(ctx, result, method, args...)
+}
+
+// Attributes returns payload attributes for a block on top of parent, containing the L1 attributes tx followed by
+// depositTxs.
+func (c *Client) Attributes(parent *Header, depositTxs ...*ethtypes.Transaction) (*eth.PayloadAttributes, error) {
+	timestamp := uint64(parent.Time) + 1
+	l1InfoTx, err := derive.L1InfoDeposit(&rollup.Config{}, eth.SystemConfig{}, c.sequenceNum, eth.BlockToInfo(c.l1Block), timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("new l1 info deposit: %v", err)
+	}
+	c.sequenceNum++
+	txs := make([]eth.Data, 0, 1+len(depositTxs))
+	for _, tx := range append([]*ethtypes.Transaction{ethtypes.NewTx(l1InfoTx)}, depositTxs...) {
+		txBytes, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("marshal tx: %v", err)
+		}
+		txs = append(txs, txBytes)
+	}
+	gas := eth.Uint64Quantity(gasLimit)
+	return &eth.PayloadAttributes{
+		Timestamp:             hexutil.Uint64(timestamp),
+		Transactions:          txs,
+		GasLimit:              &gas,
+		ParentBeaconBlockRoot: &common.Hash{},
+	}, nil
+}
+
+// BuildPayload starts building a payload with attrs on top of parent and returns it without inserting it.
+func (c *Client) BuildPayload(ctx context.Context, parent *Header, attrs *eth.PayloadAttributes) (*eth.ExecutionPayload, error) {
+	result, err := c.ForkchoiceUpdated(ctx, forkchoiceState(parent.Hash), attrs)
+	if err != nil {
+		return nil, fmt.Errorf("forkchoice updated: %v", err)
+	}
+	if result.PayloadID == nil {
+		return nil, fmt.Errorf("no payload id, status %s", result.PayloadStatus.Status)
+	}
+	envelope, err := c.GetPayload(ctx, *result.PayloadID)
+	if err != nil {
+		return nil, fmt.Errorf("get payload: %v", err)
+	}
+	return envelope.ExecutionPayload, nil
+}
+
+// BuildBlock builds a block on top of the head and makes it the unsafe, safe, and finalized head.
+func (c *Client) BuildBlock(ctx context.Context) (*Header, error) {
+	head, err := c.Head(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := c.Attributes(head)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := c.BuildPayload(ctx, head, attrs)
+	if err != nil {
+		return nil, err
+	}
+	if status, err := c.NewPayload(ctx, payload, attrs.ParentBeaconBlockRoot); err != nil {
+		return nil, fmt.Errorf("new payload: %v", err)
+	} else if status.Status != eth.ExecutionValid {
+		return nil, fmt.Errorf("new payload: status %s", status.Status)
+	}
+	if _, err := c.ForkchoiceUpdated(ctx, forkchoiceState(payload.BlockHash), nil); err != nil {
+		return nil, fmt.Errorf("forkchoice updated: %v", err)
+	}
+	return &Header{
+		Hash:   payload.BlockHash,
+		Number: hexutil.Uint64(payload.BlockNumber),
+		Time:   hexutil.Uint64(payload.Timestamp),
+	}, nil
+}
+
+func forkchoiceState(head common.Hash) *eth.ForkchoiceState {
+	return &eth.ForkchoiceState{
+		HeadBlockHash:      head,
+		SafeBlockHash:      head,
+		FinalizedBlockHash: head,
+	}
+}
@@ -0,0 +1,51 @@
+package conformance_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/engine/conformance"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// knownDeviations are the cases Monomer is known to fail. Remove a case once it passes.
+var knownDeviations = map[string]struct{}{
+	// Monomer returns -38003.
+	"engine_forkchoiceUpdatedV3: unknown finalized block returns -38002": {},
+	// Monomer returns -32602.
+	"engine_getPayloadV3: unknown payloadId returns -38001": {},
+}
+
+func TestConformance(t *testing.T) {
+	chainID := monomer.ChainID(1)
+	app := testapp.NewTest(t, chainID.String())
+	n := testutils.NewInstantNode(t, app, &genesis.Genesis{
+		ChainID:  chainID,
+		AppState: testapp.MakeGenesisAppState(t, app),
+	})
+	n.BuildBlock()
+	client, err := rpc.DialContext(context.Background(), "ws://"+n.EngineAddr())
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	matrix := conformance.Run(context.Background(), client, conformance.Cases())
+	var report strings.Builder
+	_, err = matrix.WriteTo(&report)
+	require.NoError(t, err)
+	t.Log("\n" + report.String())
+
+	for _, result := range matrix {
+		_, known := knownDeviations[result.Case.ID()]
+		if known {
+			require.Error(t, result.Err, "%s passes; remove it from the known deviations", result.Case.ID())
+		} else {
+			require.NoError(t, result.Err, result.Case.ID())
+		}
+	}
+}
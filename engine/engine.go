@@ -12,10 +12,10 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/beacon/engine"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/audit"
 	"github.com/polymerdao/monomer/builder"
-	"github.com/polymerdao/monomer/engine/signer"
 	"github.com/polymerdao/monomer/monomerdb"
 )
 
@@ -32,38 +32,62 @@ type EngineAPI struct {
 	builder                  *builder.Builder
 	txValidator              TxValidator
 	blockStore               DB
-	signer                   *signer.Signer
 	currentPayloadAttributes *monomer.PayloadAttributes
 	metrics                  Metrics
-	lock                     sync.RWMutex
+	auditLog                 *audit.Log
+	// lastForkchoiceState is the last forkchoice state recorded in the audit log.
+	lastForkchoiceState eth.ForkchoiceState
+	lock                sync.RWMutex
+	// replica rejects payload attributes that would include the mempool's txs, since only the sequencer includes them.
+	replica bool
+	// onWarn is called with a *DeprecationWarning or a *CapabilitiesWarning.
+	onWarn func(error)
+	// warned are the deprecated methods already reported to onWarn.
+	warned   map[string]struct{}
+	warnLock sync.Mutex
 }
 
 type TxValidator interface {
 	CheckTx(context.Context, *abci.RequestCheckTx) (*abci.ResponseCheckTx, error)
 }
 
+// NewEngineAPI returns the Engine API for the builder's chain. The appchain ctx is ignored and kept for compatibility,
+// since the engine no longer signs the txs it derives from payloads; pass nil.
 func NewEngineAPI(
 	b *builder.Builder,
 	txValidator TxValidator,
 	blockStore DB,
-	appchainCtx *appchainClient.Context,
+	_ *appchainClient.Context,
 	metrics Metrics,
+	auditLog *audit.Log,
+	onWarn func(error),
 ) *EngineAPI {
 	return &EngineAPI{
 		txValidator: txValidator,
-		signer:      signer.New(appchainCtx, monomer.PrivKey),
 		blockStore:  blockStore,
 		builder:     b,
 		metrics:     metrics,
+		auditLog:    auditLog,
+		onWarn:      onWarn,
+		warned:      make(map[string]struct{}),
 	}
 }
 
+// SetReplica makes the engine only build blocks from derived payload attributes, which set noTxPool, for nodes that
+// replicate the sequencer's chain. An op-node that sequences against a replica is rejected, so it can't fork the chain
+// with the replica's mempool.
+func (e *EngineAPI) SetReplica(replica bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.replica = replica
+}
+
 func (e *EngineAPI) ForkchoiceUpdatedV1(
 	ctx context.Context,
 	fcs eth.ForkchoiceState, //nolint:gocritic
 	pa *eth.PayloadAttributes,
 ) (*eth.ForkchoiceUpdatedResult, error) {
-	// TODO should this be called after Ecotone?
+	e.deprecated("engine_forkchoiceUpdatedV1")
 	return e.ForkchoiceUpdatedV3(ctx, fcs, pa)
 }
 
@@ -72,7 +96,7 @@ func (e *EngineAPI) ForkchoiceUpdatedV2(
 	fcs eth.ForkchoiceState, //nolint:gocritic
 	pa *eth.PayloadAttributes,
 ) (*eth.ForkchoiceUpdatedResult, error) {
-	// TODO should this be called after Ecotone?
+	e.deprecated("engine_forkchoiceUpdatedV2")
 	return e.ForkchoiceUpdatedV3(ctx, fcs, pa)
 }
 
@@ -122,19 +146,46 @@ func (e *EngineAPI) ForkchoiceUpdatedV3(
 			headHeader.Height))
 	}
 
-	// It is possible for reorgs to occur on unsafe block consolidation when the batcher's txs don't land on L1 in time.
+	// It is possible for reorgs to occur on unsafe block consolidation when the batcher's txs don't land on L1 in time, or
+	// when an L1 reorg removes the batches or L1 origins of unsafe blocks.
 	if height, err := e.blockStore.Height(); err != nil {
 		return nil, engine.GenericServerError.With(fmt.Errorf("get height: %v", err))
 	} else if headHeader.Height < height {
-		if err := e.builder.Rollback(ctx, fcs.HeadBlockHash, fcs.SafeBlockHash, fcs.FinalizedBlockHash); err != nil {
+		// Replicas don't build blocks from the mempool, so the txs of the rolled back blocks are left to op-node to
+		// derive again.
+		rollback := e.builder.Reorg
+		if e.replica {
+			rollback = e.builder.Rollback
+		}
+		if err := rollback(ctx, fcs.HeadBlockHash, fcs.SafeBlockHash, fcs.FinalizedBlockHash); err != nil {
 			return nil, engine.GenericServerError.With(fmt.Errorf("rollback: %v", err))
 		}
+		if err := e.builder.RecordReorg(); err != nil {
+			return nil, engine.GenericServerError.With(fmt.Errorf("record reorg: %v", err))
+		}
+		if err := e.auditLog.Record(ctx, audit.ActionRollback, map[string]string{
+			"from_height": fmt.Sprint(height),
+			"to_height":   fmt.Sprint(headHeader.Height),
+			"head":        fcs.HeadBlockHash.String(),
+		}); err != nil {
+			return nil, engine.GenericServerError.With(fmt.Errorf("record rollback: %v", err))
+		}
 	}
 
 	// Update block labels.
 	if err := e.blockStore.UpdateLabels(fcs.HeadBlockHash, fcs.SafeBlockHash, fcs.FinalizedBlockHash); err != nil {
 		return nil, engine.GenericServerError.With(fmt.Errorf("update labels: %v", err))
 	}
+	if fcs != e.lastForkchoiceState {
+		if err := e.auditLog.Record(ctx, audit.ActionForkchoiceUpdate, map[string]string{
+			"unsafe":    fcs.HeadBlockHash.String(),
+			"safe":      fcs.SafeBlockHash.String(),
+			"finalized": fcs.FinalizedBlockHash.String(),
+		}); err != nil {
+			return nil, engine.GenericServerError.With(fmt.Errorf("record forkchoice update: %v", err))
+		}
+		e.lastForkchoiceState = fcs
+	}
 
 	if pa == nil {
 		// Engine API spec:
@@ -177,12 +228,14 @@ func (e *EngineAPI) ForkchoiceUpdatedV3(
 	if pa.GasLimit == nil {
 		return nil, engine.InvalidPayloadAttributes.With(errors.New("gas limit not provided"))
 	}
+	if e.replica && !pa.NoTxPool {
+		return nil, engine.InvalidPayloadAttributes.With(errors.New("replica doesn't sequence: payload attributes must set noTxPool"))
+	}
 
-	cosmosTxs, err := monomer.AdaptPayloadTxsToCosmosTxs(
-		pa.Transactions,
-		e.signer.Sign,
-		e.signer.AccountAddress().String(),
-	)
+	if err := checkTxs(pa.Transactions); err != nil {
+		return nil, engine.InvalidPayloadAttributes.With(err)
+	}
+	cosmosTxs, err := monomer.AdaptPayloadTxsToCosmosTxs(pa.Transactions, nil, "")
 	if err != nil {
 		return nil, engine.InvalidPayloadAttributes.With(fmt.Errorf("convert payload attributes txs to cosmos txs: %v", err))
 	}
@@ -236,12 +289,12 @@ func (e *EngineAPI) ForkchoiceUpdatedV3(
 }
 
 func (e *EngineAPI) GetPayloadV1(ctx context.Context, payloadID engine.PayloadID) (*eth.ExecutionPayloadEnvelope, error) {
-	// TODO should this be called after Ecotone?
+	e.deprecated("engine_getPayloadV1")
 	return e.GetPayloadV3(ctx, payloadID)
 }
 
 func (e *EngineAPI) GetPayloadV2(ctx context.Context, payloadID engine.PayloadID) (*eth.ExecutionPayloadEnvelope, error) {
-	// TODO should this be called after Ecotone?
+	e.deprecated("engine_getPayloadV2")
 	return e.GetPayloadV3(ctx, payloadID)
 }
 
@@ -262,42 +315,32 @@ func (e *EngineAPI) GetPayloadV3(ctx context.Context, payloadID engine.PayloadID
 
 	// TODO: handle time slot based block production
 	// for now assume block is sealed by this call
-	block, err := e.builder.Build(ctx, &builder.Payload{
+	block, err := e.build(ctx, &builder.Payload{
 		InjectedTransactions: e.currentPayloadAttributes.CosmosTxs,
 		GasLimit:             e.currentPayloadAttributes.GasLimit,
 		Timestamp:            e.currentPayloadAttributes.Timestamp,
 		NoTxPool:             e.currentPayloadAttributes.NoTxPool,
+		ParentBeaconRoot:     e.currentPayloadAttributes.ParentBeaconBlockRoot,
 	})
 	if err != nil {
-		panic(fmt.Errorf("build block: %v", err))
+		return nil, engine.GenericServerError.With(fmt.Errorf("build block: %v", err))
 	}
 
-	txs, err := monomer.AdaptCosmosTxsToEthTxs(block.Txs)
+	// The payload carries the block's own header fields so that it hashes to the block hash. op-node checks this before
+	// gossiping the payload to, or accepting it from, other nodes.
+	ethBlock, err := block.ToEth()
 	if err != nil {
-		return nil, engine.GenericServerError.With(fmt.Errorf("convert cosmos txs to eth txs: %v", err))
+		return nil, engine.GenericServerError.With(fmt.Errorf("convert block to eth block: %v", err))
 	}
-
-	txBytes := make([]hexutil.Bytes, len(txs))
-	for i, tx := range txs {
-		txBytes[i], err = tx.MarshalBinary()
-		if err != nil {
-			return nil, engine.GenericServerError.With(fmt.Errorf("marshal tx binary: %v", err))
-		}
+	payload, err := eth.BlockAsPayload(ethBlock, nil)
+	if err != nil {
+		return nil, engine.GenericServerError.With(fmt.Errorf("block as payload: %v", err))
 	}
-
+	// Monomer blocks always commit to empty withdrawals.
+	payload.Withdrawals = &ethtypes.Withdrawals{}
 	payloadEnvelope := &eth.ExecutionPayloadEnvelope{
-		ExecutionPayload: &eth.ExecutionPayload{
-			ParentHash:   e.currentPayloadAttributes.ParentHash,
-			BlockNumber:  hexutil.Uint64(e.currentPayloadAttributes.Height),
-			BlockHash:    block.Header.Hash,
-			FeeRecipient: e.currentPayloadAttributes.SuggestedFeeRecipient,
-			Timestamp:    hexutil.Uint64(e.currentPayloadAttributes.Timestamp),
-			PrevRandao:   e.currentPayloadAttributes.PrevRandao,
-			Withdrawals:  e.currentPayloadAttributes.Withdrawals,
-			Transactions: txBytes,
-			GasLimit:     hexutil.Uint64(e.currentPayloadAttributes.GasLimit),
-			StateRoot:    eth.Bytes32(block.Header.StateRoot),
-		},
+		ParentBeaconBlockRoot: block.Header.ParentBeaconRoot,
+		ExecutionPayload:      payload,
 	}
 	// remove payload
 	e.currentPayloadAttributes = nil
@@ -306,25 +349,45 @@ func (e *EngineAPI) GetPayloadV3(ctx context.Context, payloadID engine.PayloadID
 }
 
 func (e *EngineAPI) NewPayloadV1(payload eth.ExecutionPayload) (*eth.PayloadStatusV1, error) { //nolint:gocritic
-	// TODO should this be called after Ecotone?
-	return e.NewPayloadV3(payload)
+	e.deprecated("engine_newPayloadV1")
+	return e.NewPayloadV3(payload, nil, nil)
 }
 
 func (e *EngineAPI) NewPayloadV2(payload eth.ExecutionPayload) (*eth.PayloadStatusV1, error) { //nolint:gocritic
-	// TODO should this be called after Ecotone?
-	return e.NewPayloadV3(payload)
+	e.deprecated("engine_newPayloadV2")
+	return e.NewPayloadV3(payload, nil, nil)
 }
 
-// NewPayloadV3 ensures the payload's block hash is present in the block store.
-func (e *EngineAPI) NewPayloadV3(payload eth.ExecutionPayload) (*eth.PayloadStatusV1, error) { //nolint:gocritic
+// NewPayloadV3 ensures the payload is within the limits and its block hash is present in the block store.
+// Payloads built elsewhere, e.g., by another sequencer in an HA setup, are imported if they build on the head.
+// op-node passes the parent beacon block root from Ecotone on. Monomer blocks never carry blobs, so versionedHashes
+// must be empty.
+func (e *EngineAPI) NewPayloadV3( //nolint:gocritic
+	payload eth.ExecutionPayload,
+	versionedHashes []common.Hash,
+	parentBeaconBlockRoot *common.Hash,
+) (*eth.PayloadStatusV1, error) {
 	e.lock.Lock()
 	defer e.lock.Unlock()
 	defer e.metrics.RecordRPCMethodCall(NewPayloadV3MethodName, time.Now())
 
-	if _, err := e.blockStore.HeaderByHash(payload.BlockHash); errors.Is(err, monomerdb.ErrNotFound) {
+	if len(versionedHashes) > 0 {
+		validationErr := fmt.Sprintf("expected no blob versioned hashes, got %d", len(versionedHashes))
 		return &eth.PayloadStatusV1{
-			Status: eth.ExecutionInvalidBlockHash,
-		}, engine.InvalidParams.With(errors.New("block not found"))
+			Status:          eth.ExecutionInvalid,
+			ValidationError: &validationErr,
+		}, nil
+	}
+	if err := checkPayload(&payload); err != nil {
+		validationErr := err.Error()
+		return &eth.PayloadStatusV1{
+			Status:          eth.ExecutionInvalid,
+			ValidationError: &validationErr,
+		}, nil
+	}
+
+	if _, err := e.blockStore.HeaderByHash(payload.BlockHash); errors.Is(err, monomerdb.ErrNotFound) {
+		return e.importPayload(context.Background(), &payload, parentBeaconBlockRoot)
 	} else if err != nil {
 		return nil, engine.GenericServerError.With(fmt.Errorf("header by hash: %v", err))
 	}
@@ -337,3 +400,79 @@ func (e *EngineAPI) NewPayloadV3(payload eth.ExecutionPayload) (*eth.PayloadStat
 		LatestValidHash: &headHeader.Hash, // TODO should we be using unsafe head instead?
 	}, nil
 }
+
+// build builds a block from the payload. If building fails, e.g., because the app's Commit failed, whatever was stored of
+// the block is rolled back and the block is built again once from the builder's WAL, so a transient failure doesn't leave
+// the app ahead of the block store. The rebuilt block becomes the unsafe head.
+func (e *EngineAPI) build(ctx context.Context, payload *builder.Payload) (*monomer.Block, error) {
+	block, err := e.builder.Build(ctx, payload)
+	if err == nil {
+		return block, nil
+	}
+	block, replayErr := e.builder.Replay(ctx)
+	if replayErr != nil {
+		return nil, errors.Join(err, fmt.Errorf("replay: %v", replayErr))
+	} else if block == nil {
+		// The builder failed before writing the payload to the WAL, so nothing was built.
+		return nil, err
+	}
+	return block, nil
+}
+
+// importPayload executes the payload's transactions in a new block on top of the head and keeps the block if its hash
+// matches the payload's. Monomer can only build on its head, so payloads with another parent are reported as SYNCING
+// until op-node inserts the missing blocks or reorgs the head.
+func (e *EngineAPI) importPayload(
+	ctx context.Context,
+	payload *eth.ExecutionPayload,
+	parentBeaconBlockRoot *common.Hash,
+) (*eth.PayloadStatusV1, error) {
+	headHeader, err := e.blockStore.HeadHeader()
+	if err != nil {
+		return nil, engine.GenericServerError.With(fmt.Errorf("head header: %v", err))
+	}
+	if payload.ParentHash != headHeader.Hash || uint64(payload.BlockNumber) != headHeader.Height+1 {
+		return &eth.PayloadStatusV1{
+			Status: eth.ExecutionSyncing,
+		}, nil
+	}
+
+	invalid := func(err error) *eth.PayloadStatusV1 {
+		validationErr := err.Error()
+		return &eth.PayloadStatusV1{
+			Status:          eth.ExecutionInvalid,
+			LatestValidHash: &headHeader.Hash,
+			ValidationError: &validationErr,
+		}
+	}
+	cosmosTxs, err := monomer.AdaptPayloadTxsToCosmosTxs(payload.Transactions, nil, "")
+	if err != nil {
+		return invalid(fmt.Errorf("convert payload txs to cosmos txs: %v", err)), nil
+	}
+	// The payload contains every tx in the block, so the mempool is not used.
+	block, err := e.build(ctx, &builder.Payload{
+		InjectedTransactions: cosmosTxs,
+		GasLimit:             uint64(payload.GasLimit),
+		Timestamp:            uint64(payload.Timestamp),
+		NoTxPool:             true,
+		ParentBeaconRoot:     parentBeaconBlockRoot,
+	})
+	if err != nil {
+		return nil, engine.GenericServerError.With(fmt.Errorf("build block: %v", err))
+	}
+	if block.Header.Hash != payload.BlockHash {
+		safe, finalized := e.lastForkchoiceState.SafeBlockHash, e.lastForkchoiceState.FinalizedBlockHash
+		if safe == (common.Hash{}) || finalized == (common.Hash{}) {
+			safe, finalized = headHeader.Hash, headHeader.Hash
+		}
+		if err := e.builder.Rollback(ctx, headHeader.Hash, safe, finalized); err != nil {
+			return nil, engine.GenericServerError.With(fmt.Errorf("rollback: %v", err))
+		}
+		return invalid(fmt.Errorf("block hash mismatch: payload has %s, executing it produced %s", payload.BlockHash,
+			block.Header.Hash)), nil
+	}
+	return &eth.PayloadStatusV1{
+		Status:          eth.ExecutionValid,
+		LatestValidHash: &block.Header.Hash,
+	}, nil
+}
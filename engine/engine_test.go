@@ -0,0 +1,299 @@
+package engine_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	gethengine "github.com/ethereum/go-ethereum/beacon/engine"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/engine"
+	"github.com/polymerdao/monomer/faults"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/monomerdb"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPayloadLimits(t *testing.T) {
+	chainID := monomer.ChainID(1)
+	app := testapp.NewTest(t, chainID.String())
+	n := testutils.NewInstantNode(t, app, &genesis.Genesis{
+		ChainID:  chainID,
+		AppState: testapp.MakeGenesisAppState(t, app),
+	})
+	block := n.BuildBlock()
+	client, err := rpc.DialContext(context.Background(), "ws://"+n.EngineAddr())
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	newPayload := func(payload *eth.ExecutionPayload) *eth.PayloadStatusV1 {
+		var status eth.PayloadStatusV1
+		require.NoError(t, client.CallContext(context.Background(), &status, "engine_newPayloadV3", payload, []common.Hash{},
+			block.Header.ParentBeaconRoot))
+		return &status
+	}
+	validTx := hexutil.Bytes(testutils.TxToBytes(t, ethtypes.NewTx(&ethtypes.DynamicFeeTx{})))
+	repeat := func(tx hexutil.Bytes, n int) []eth.Data {
+		txs := make([]eth.Data, n)
+		for i := range txs {
+			txs[i] = tx
+		}
+		return txs
+	}
+
+	status := newPayload(&eth.ExecutionPayload{
+		BlockHash:    block.Header.Hash,
+		Transactions: []eth.Data{validTx},
+	})
+	require.Equal(t, eth.ExecutionValid, status.Status)
+
+	tooManyWithdrawals := make(ethtypes.Withdrawals, engine.MaxWithdrawals+1)
+	for name, payload := range map[string]*eth.ExecutionPayload{
+		"too many txs":         {Transactions: repeat(validTx, engine.MaxPayloadTxs+1)},
+		"tx too large":         {Transactions: []eth.Data{make(hexutil.Bytes, engine.MaxTxSize+1)}},
+		"too many withdrawals": {Withdrawals: &tooManyWithdrawals},
+		"malformed tx":         {Transactions: []eth.Data{{0x02, 0xff}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			payload.BlockHash = block.Header.Hash
+			status := newPayload(payload)
+			require.Equal(t, eth.ExecutionInvalid, status.Status)
+			require.NotNil(t, status.ValidationError)
+		})
+	}
+
+	// Monomer blocks never carry blobs.
+	var blobStatus eth.PayloadStatusV1
+	require.NoError(t, client.CallContext(context.Background(), &blobStatus, "engine_newPayloadV3", &eth.ExecutionPayload{
+		BlockHash: block.Header.Hash,
+	}, []common.Hash{{1}}, block.Header.ParentBeaconRoot))
+	require.Equal(t, eth.ExecutionInvalid, blobStatus.Status)
+
+	// The node still works.
+	require.Equal(t, block.Header.Height+1, n.BuildBlock().Header.Height)
+}
+
+func TestNewPayloadImport(t *testing.T) {
+	chainID := monomer.ChainID(1)
+	newNode := func() *testutils.InstantNode {
+		app := testapp.NewTest(t, chainID.String())
+		return testutils.NewInstantNode(t, app, &genesis.Genesis{
+			ChainID:  chainID,
+			AppState: testapp.MakeGenesisAppState(t, app),
+		})
+	}
+	sequencer := newNode()
+	follower := newNode()
+	require.Equal(t, sequencer.Head().Hash, follower.Head().Hash)
+
+	client, err := rpc.DialContext(context.Background(), "ws://"+follower.EngineAddr())
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	newPayload := func(block *monomer.Block, payload *eth.ExecutionPayload) *eth.PayloadStatusV1 {
+		var status eth.PayloadStatusV1
+		require.NoError(t, client.CallContext(context.Background(), &status, "engine_newPayloadV3", payload, []common.Hash{},
+			block.Header.ParentBeaconRoot))
+		return &status
+	}
+	payloadOf := func(block *monomer.Block) *eth.ExecutionPayload {
+		ethBlock, err := block.ToEth()
+		require.NoError(t, err)
+		txs := make([]eth.Data, 0, ethBlock.Transactions().Len())
+		for _, tx := range ethBlock.Transactions() {
+			txs = append(txs, testutils.TxToBytes(t, tx))
+		}
+		return &eth.ExecutionPayload{
+			ParentHash:   block.Header.ParentHash,
+			BlockNumber:  hexutil.Uint64(block.Header.Height),
+			BlockHash:    block.Header.Hash,
+			Timestamp:    hexutil.Uint64(block.Header.Time),
+			GasLimit:     hexutil.Uint64(block.Header.GasLimit),
+			Transactions: txs,
+		}
+	}
+
+	first := sequencer.SubmitTx(testapp.ToTestTx(t, "k", "v"))
+	second := sequencer.BuildBlock()
+
+	// A payload that doesn't build on the head can't be imported yet.
+	status := newPayload(second, payloadOf(second))
+	require.Equal(t, eth.ExecutionSyncing, status.Status)
+
+	// A payload with the wrong hash is rejected and leaves the chain as it was.
+	head := follower.Head()
+	tampered := payloadOf(first)
+	tampered.BlockHash = common.Hash{1}
+	status = newPayload(first, tampered)
+	require.Equal(t, eth.ExecutionInvalid, status.Status)
+	require.Equal(t, head.Hash, *status.LatestValidHash)
+	require.Equal(t, head.Hash, follower.Head().Hash)
+
+	for _, block := range []*monomer.Block{first, second} {
+		status := newPayload(block, payloadOf(block))
+		require.Equal(t, eth.ExecutionValid, status.Status, status.ValidationError)
+		require.Equal(t, block.Header.Hash, follower.Head().Hash)
+	}
+
+	// Importing a known payload is a no-op.
+	require.Equal(t, eth.ExecutionValid, newPayload(second, payloadOf(second)).Status)
+	require.Equal(t, second.Header.Hash, follower.Head().Hash)
+}
+
+func TestBuildRecovery(t *testing.T) {
+	chainID := monomer.ChainID(1)
+	app := testapp.NewTest(t, chainID.String())
+	injector := faults.NewInjector()
+	node := testutils.NewInstantNode(t, injector.Wrap(app), &genesis.Genesis{
+		ChainID:  chainID,
+		AppState: testapp.MakeGenesisAppState(t, app),
+	})
+
+	// A Commit that fails once is recovered from by rebuilding the block, including the mempool's txs.
+	injector.Inject(faults.MethodCommit, faults.Fault{
+		Err:   errors.New("commit failed"),
+		Times: 1,
+	})
+	kvs := map[string]string{"k1": "v1"}
+	block := node.SubmitTx(testapp.ToTestTx(t, "k1", "v1"))
+	app.StateContains(t, block.Header.Height, kvs)
+
+	// A slow FinalizeBlock only delays the block.
+	injector.Inject(faults.MethodFinalizeBlock, faults.Fault{
+		Delay: 100 * time.Millisecond,
+		Times: 1,
+	})
+	next := node.BuildBlock()
+	require.Equal(t, block.Header.Height+1, next.Header.Height)
+	require.Equal(t, next.Header.Hash, node.Head().Hash)
+}
+
+// notFoundDB is a block store without any blocks.
+type notFoundDB struct {
+	engine.DB
+}
+
+func (notFoundDB) HeaderByHash(common.Hash) (*monomer.Header, error) {
+	return nil, monomerdb.ErrNotFound
+}
+
+func TestCompatibilityWarnings(t *testing.T) {
+	var warnings []error
+	api := engine.NewEngineAPI(nil, nil, notFoundDB{}, nil, engine.NewNoopMetrics(), nil, func(err error) {
+		warnings = append(warnings, err)
+	})
+
+	// op-node supports a method Monomer doesn't and lacks the current version of another.
+	capabilities := api.ExchangeCapabilities([]string{
+		"engine_forkchoiceUpdatedV3",
+		"engine_getPayloadV3",
+		"engine_newPayloadV2",
+		"engine_newPayloadV4",
+	})
+	require.Equal(t, engine.Capabilities, capabilities)
+	require.Len(t, warnings, 1)
+	var capabilitiesWarning *engine.CapabilitiesWarning
+	require.ErrorAs(t, warnings[0], &capabilitiesWarning)
+	require.Equal(t, []string{"engine_newPayloadV4"}, capabilitiesWarning.Unsupported)
+	require.Equal(t, []string{"engine_newPayloadV3"}, capabilitiesWarning.Missing)
+
+	// Matching capabilities aren't reported.
+	api.ExchangeCapabilities([]string{"engine_forkchoiceUpdatedV3", "engine_getPayloadV3", "engine_newPayloadV3"})
+	require.Len(t, warnings, 1)
+
+	// Deprecated versions are reported on their first call only.
+	for range 2 {
+		_, err := api.ForkchoiceUpdatedV2(context.Background(), eth.ForkchoiceState{}, nil)
+		require.Error(t, err)
+	}
+	require.Len(t, warnings, 2)
+	var deprecationWarning *engine.DeprecationWarning
+	require.ErrorAs(t, warnings[1], &deprecationWarning)
+	require.Equal(t, &engine.DeprecationWarning{
+		Method:      "engine_forkchoiceUpdatedV2",
+		Replacement: "engine_forkchoiceUpdatedV3",
+	}, deprecationWarning)
+}
+
+// genesisDB is a block store with only a genesis block.
+type genesisDB struct {
+	engine.DB
+	genesis *monomer.Header
+}
+
+func (db genesisDB) HeaderByHeight(height uint64) (*monomer.Header, error) {
+	if height != db.genesis.Height {
+		return nil, monomerdb.ErrNotFound
+	}
+	return db.genesis, nil
+}
+
+func TestExchangeTransitionConfiguration(t *testing.T) {
+	genesisHeader := &monomer.Header{
+		Height: 1,
+		Hash:   common.Hash{1},
+	}
+	api := engine.NewEngineAPI(nil, nil, genesisDB{genesis: genesisHeader}, nil, engine.NewNoopMetrics(), nil, func(error) {})
+	zero := new(hexutil.Big)
+
+	config, err := api.ExchangeTransitionConfigurationV1(gethengine.TransitionConfigurationV1{
+		TerminalTotalDifficulty: zero,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &gethengine.TransitionConfigurationV1{TerminalTotalDifficulty: zero}, config)
+
+	config, err = api.ExchangeTransitionConfigurationV1(gethengine.TransitionConfigurationV1{
+		TerminalTotalDifficulty: zero,
+		TerminalBlockHash:       genesisHeader.Hash,
+		TerminalBlockNumber:     1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, genesisHeader.Hash, config.TerminalBlockHash)
+
+	for name, test := range map[string]struct {
+		config gethengine.TransitionConfigurationV1
+		errMsg string
+	}{
+		"no ttd": {
+			errMsg: "not provided",
+		},
+		"nonzero ttd": {
+			config: gethengine.TransitionConfigurationV1{TerminalTotalDifficulty: (*hexutil.Big)(big.NewInt(1))},
+			errMsg: "post-merge from genesis",
+		},
+		"other genesis": {
+			config: gethengine.TransitionConfigurationV1{
+				TerminalTotalDifficulty: zero,
+				TerminalBlockHash:       common.Hash{2},
+				TerminalBlockNumber:     1,
+			},
+			errMsg: "another genesis",
+		},
+		"unknown terminal block": {
+			config: gethengine.TransitionConfigurationV1{
+				TerminalTotalDifficulty: zero,
+				TerminalBlockHash:       genesisHeader.Hash,
+				TerminalBlockNumber:     2,
+			},
+			errMsg: "not found",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := api.ExchangeTransitionConfigurationV1(test.config)
+			// The reason is in the error's data, which op-node logs with the Invalid parameters message.
+			var apiErr *gethengine.EngineAPIError
+			require.ErrorAs(t, err, &apiErr)
+			require.Equal(t, gethengine.InvalidParams.ErrorCode(), apiErr.ErrorCode())
+			require.Contains(t, fmt.Sprint(apiErr.ErrorData()), test.errMsg)
+		})
+	}
+}
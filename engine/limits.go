@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Payloads and payload attributes are bounded so a buggy or compromised op-node can't wedge the node with pathological
+// payloads. The bounds are checked before any tx is decoded or executed.
+const (
+	// MaxPayloadTxs is the most txs a payload may contain.
+	MaxPayloadTxs = 10_000
+	// MaxTxSize is the largest encoded tx a payload may contain. It matches CometBFT's default mempool.max_tx_bytes.
+	MaxTxSize = 1 << 20
+	// MaxPayloadTxsSize is the largest total size of a payload's encoded txs. It matches CometBFT's default
+	// block.max_bytes.
+	MaxPayloadTxsSize = 22020096
+	// MaxWithdrawals is the most withdrawals a payload may contain. It matches MAX_WITHDRAWALS_PER_PAYLOAD.
+	MaxWithdrawals = 16
+)
+
+// checkTxs bounds the number and size of txs.
+func checkTxs(txs []eth.Data) error {
+	if len(txs) > MaxPayloadTxs {
+		return fmt.Errorf("%d txs exceeds the limit of %d", len(txs), MaxPayloadTxs)
+	}
+	var totalSize int
+	for i, tx := range txs {
+		if len(tx) > MaxTxSize {
+			return fmt.Errorf("tx %d is %d bytes, exceeding the limit of %d", i, len(tx), MaxTxSize)
+		}
+		totalSize += len(tx)
+	}
+	if totalSize > MaxPayloadTxsSize {
+		return fmt.Errorf("txs total %d bytes, exceeding the limit of %d", totalSize, MaxPayloadTxsSize)
+	}
+	return nil
+}
+
+// checkPayload bounds the payload and ensures its txs are well-formed.
+func checkPayload(payload *eth.ExecutionPayload) error {
+	if payload.Withdrawals != nil && len(*payload.Withdrawals) > MaxWithdrawals {
+		return fmt.Errorf("%d withdrawals exceeds the limit of %d", len(*payload.Withdrawals), MaxWithdrawals)
+	}
+	if err := checkTxs(payload.Transactions); err != nil {
+		return err
+	}
+	for i, txBytes := range payload.Transactions {
+		var tx ethtypes.Transaction
+		if err := tx.UnmarshalBinary(txBytes); err != nil {
+			return fmt.Errorf("decode tx %d: %v", i, err)
+		}
+	}
+	return nil
+}
@@ -1,3 +1,7 @@
+// Package signer signs cosmos txs with a node's own key.
+//
+// Deprecated: the engine no longer signs the txs it derives from payloads, since a node-local key made the derived
+// blocks differ between nodes. Nothing in monomer uses this package.
 package signer
 
 import (
@@ -0,0 +1,119 @@
+package eth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/eth"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlockscoutCompatibility runs the JSON-RPC probes Blockscout's indexer makes against a geth-like node over HTTP
+// and checks the responses have the fields it requires.
+func TestBlockscoutCompatibility(t *testing.T) {
+	chainID := monomer.ChainID(901)
+	app := testapp.NewTest(t, chainID.String())
+	n := testutils.NewInstantNode(t, app, &genesis.Genesis{
+		ChainID:  chainID,
+		AppState: testapp.MakeGenesisAppState(t, app),
+	})
+	block := n.SubmitTx(testapp.ToTestTx(t, "k", "v"))
+	ethBlock, err := block.ToEth()
+	require.NoError(t, err)
+	require.Len(t, ethBlock.Transactions(), 2) // The L1 attributes deposit and the Cosmos tx.
+
+	client, err := rpc.DialHTTP("http://" + n.EngineAddr())
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+	call := func(result any, method string, args ...any) {
+		require.NoError(t, client.CallContext(context.Background(), result, method, args...), method)
+	}
+
+	var gotChainID hexutil.Big
+	call(&gotChainID, "eth_chainId")
+	require.Equal(t, chainID.HexBig(), &gotChainID)
+
+	var blockNumber hexutil.Uint64
+	call(&blockNumber, "eth_blockNumber")
+	require.Equal(t, hexutil.Uint64(block.Header.Height), blockNumber)
+
+	var rpcBlock map[string]any
+	call(&rpcBlock, "eth_getBlockByNumber", "latest", true)
+	requireFields(t, rpcBlock, "hash", "number", "parentHash", "timestamp", "miner", "gasLimit", "gasUsed", "difficulty",
+		"nonce", "size", "stateRoot", "extraData", "logsBloom", "transactions", "uncles")
+	require.Equal(t, block.Header.Hash.Hex(), rpcBlock["hash"])
+	var blockByHash map[string]any
+	call(&blockByHash, "eth_getBlockByHash", block.Header.Hash, true)
+	require.Equal(t, rpcBlock, blockByHash)
+
+	rpcTxs, ok := rpcBlock["transactions"].([]any)
+	require.True(t, ok)
+	require.Len(t, rpcTxs, len(ethBlock.Transactions()))
+	var receipts []map[string]any
+	call(&receipts, "eth_getBlockReceipts", hexutil.Uint64(block.Header.Height))
+	require.Len(t, receipts, len(rpcTxs))
+	var blockTraces []map[string]any
+	call(&blockTraces, "debug_traceBlockByNumber", hexutil.Uint64(block.Header.Height), map[string]any{"tracer": eth.CallTracer})
+	require.Len(t, blockTraces, len(rpcTxs))
+
+	for i, ethTx := range ethBlock.Transactions() {
+		rpcTx, ok := rpcTxs[i].(map[string]any)
+		require.True(t, ok)
+		requireFields(t, rpcTx, "hash", "blockHash", "blockNumber", "from", "gas", "gasPrice", "input", "nonce", "to",
+			"transactionIndex", "value", "type", "v", "r", "s")
+		require.Equal(t, ethTx.Hash().Hex(), rpcTx["hash"])
+		if ethTx.Type() != ethtypes.DepositTxType {
+			require.Equal(t, gotChainID.String(), rpcTx["chainId"])
+		}
+
+		var txByHash map[string]any
+		call(&txByHash, "eth_getTransactionByHash", ethTx.Hash())
+		require.Equal(t, rpcTx, txByHash)
+
+		var receipt map[string]any
+		call(&receipt, "eth_getTransactionReceipt", ethTx.Hash())
+		requireFields(t, receipt, "blockHash", "blockNumber", "transactionHash", "transactionIndex", "from", "to", "gasUsed",
+			"cumulativeGasUsed", "effectiveGasPrice", "contractAddress", "logs", "logsBloom", "status", "type")
+		require.Equal(t, ethTx.Hash().Hex(), receipt["transactionHash"])
+		require.Equal(t, rpcBlock["hash"], receipt["blockHash"])
+		require.Equal(t, rpcTx["transactionIndex"], receipt["transactionIndex"])
+		require.Nil(t, receipt["contractAddress"])
+		require.Equal(t, "0x1", receipt["status"])
+		require.Equal(t, receipts[i], receipt)
+
+		var trace map[string]any
+		call(&trace, "debug_traceTransaction", ethTx.Hash(), map[string]any{"tracer": eth.CallTracer})
+		requireFields(t, trace, "type", "from", "value", "gas", "gasUsed", "input", "output")
+		require.Equal(t, receipt["gasUsed"], trace["gasUsed"])
+		require.Equal(t, map[string]any{"txHash": ethTx.Hash().Hex(), "result": trace}, blockTraces[i])
+	}
+	require.NotEqual(t, "0x0", receipts[len(receipts)-1]["cumulativeGasUsed"])
+
+	var missingTx, missingReceipt map[string]any
+	call(&missingTx, "eth_getTransactionByHash", common.Hash{})
+	require.Nil(t, missingTx)
+	call(&missingReceipt, "eth_getTransactionReceipt", common.Hash{})
+	require.Nil(t, missingReceipt)
+	require.Error(t, client.CallContext(context.Background(), new(any), "debug_traceTransaction",
+		ethBlock.Transactions()[0].Hash(), map[string]any{"tracer": "{ result: function() {} }"}))
+
+	var balance hexutil.Big
+	call(&balance, "eth_getBalance", common.Address{}, "latest")
+	var code hexutil.Bytes
+	call(&code, "eth_getCode", common.Address{}, "latest")
+	require.Empty(t, code)
+}
+
+func requireFields(t *testing.T, object map[string]any, fields ...string) {
+	for _, field := range fields {
+		require.Contains(t, object, field)
+	}
+}
@@ -0,0 +1,111 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer/query"
+	"github.com/polymerdao/monomer/querycall"
+)
+
+// CallArgs are the fields of an eth_call's call object that CallAPI reads. The rest, like gas and value, are ignored.
+type CallArgs struct {
+	From  *common.Address `json:"from"`
+	To    *common.Address `json:"to"`
+	Data  *hexutil.Bytes  `json:"data"`
+	Input *hexutil.Bytes  `json:"input"`
+}
+
+// QueryCache serves query responses cached by block, like *comet.QueryCache.
+type QueryCache interface {
+	Query(
+		ctx context.Context,
+		req *abcitypes.RequestQuery,
+		query func(context.Context, *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error),
+	) (*abcitypes.ResponseQuery, error)
+}
+
+// CallAPI serves eth_call to the reserved addresses of the querycall package by running the app's gRPC queries against
+// its state at the block the call names. Monomer doesn't run EVM contracts, so calls to other addresses fail.
+// The queries share queryCache with abci_query.
+type CallAPI struct {
+	app        query.App
+	router     *querycall.Router
+	backend    *ethAPIBackend
+	queryCache QueryCache
+	metrics    Metrics
+}
+
+func NewCallAPI(app query.App, router *querycall.Router, blockStore DB, queryCache QueryCache, metrics Metrics) *CallAPI {
+	return &CallAPI{
+		app:        app,
+		router:     router,
+		backend:    newEthAPIBackend(nil, blockStore),
+		queryCache: queryCache,
+		metrics:    metrics,
+	}
+}
+
+// Call returns the ABI-encoded return values of the call. blockNrOrHash defaults to the latest block.
+func (c *CallAPI) Call(ctx context.Context, args CallArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	defer c.metrics.RecordRPCMethodCall(CallMethodName, time.Now())
+
+	if args.To == nil {
+		return nil, errors.New("contract creation is not supported")
+	}
+	if !c.router.Routes(*args.To) {
+		return nil, fmt.Errorf("%s is not a query address; Monomer doesn't run EVM contracts", *args.To)
+	}
+	// Like geth, input takes precedence over data.
+	var data []byte
+	if args.Input != nil {
+		data = *args.Input
+	} else if args.Data != nil {
+		data = *args.Data
+	}
+
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+	header, err := c.backend.HeaderByNumberOrHash(ctx, *blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return c.router.Call(ctx, &appQuerier{
+		app:    c.app,
+		cache:  c.queryCache,
+		height: header.Number.Int64(),
+	}, *args.To, data)
+}
+
+// appQuerier runs queries against the app's state at a height.
+type appQuerier struct {
+	app    query.App
+	cache  QueryCache
+	height int64
+}
+
+func (q *appQuerier) Query(ctx context.Context, path string, data []byte) ([]byte, error) {
+	req := &abcitypes.RequestQuery{
+		Path:   path,
+		Data:   data,
+		Height: q.height,
+	}
+	resp, err := q.cache.Query(ctx, req, func(ctx context.Context, req *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error) {
+		return query.Serve(ctx, q.app, req)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query %s: %v", path, err)
+	}
+	if !resp.IsOK() {
+		return nil, fmt.Errorf("query %s failed with code %d (codespace %q): %s", path, resp.GetCode(), resp.GetCodespace(), resp.GetLog())
+	}
+	return resp.GetValue(), nil
+}
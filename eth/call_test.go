@@ -0,0 +1,87 @@
+package eth_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/querycall"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testutils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+const queryCallABI = `[{
+	"type": "function",
+	"name": "balanceOf",
+	"stateMutability": "view",
+	"inputs": [{"name": "account", "type": "address"}, {"name": "denom", "type": "string"}],
+	"outputs": [{"name": "", "type": "uint256"}]
+}, {
+	"type": "function",
+	"name": "query",
+	"stateMutability": "view",
+	"inputs": [{"name": "path", "type": "string"}, {"name": "request", "type": "bytes"}],
+	"outputs": [{"name": "response", "type": "bytes"}]
+}]`
+
+func TestCall(t *testing.T) {
+	chainID := monomer.ChainID(901)
+	app := testapp.NewTest(t, chainID.String())
+	n := testutils.NewInstantNode(t, app, &genesis.Genesis{
+		ChainID:  chainID,
+		AppState: testapp.MakeGenesisAppState(t, app),
+	})
+	n.BuildBlock()
+	client, err := rpc.DialHTTP("http://" + n.EngineAddr())
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	abi, err := gethabi.JSON(strings.NewReader(queryCallABI))
+	require.NoError(t, err)
+	call := func(to common.Address, method string, args ...any) ([]any, error) {
+		data, err := abi.Pack(method, args...)
+		require.NoError(t, err)
+		var result hexutil.Bytes
+		if err := client.CallContext(context.Background(), &result, "eth_call", map[string]any{
+			"to":   to,
+			"data": hexutil.Bytes(data),
+		}, "latest"); err != nil {
+			return nil, err
+		}
+		return abi.Unpack(method, result)
+	}
+
+	account := common.BytesToAddress(testapp.GetAccount(0).Address)
+	values, err := call(querycall.BankAddress, "balanceOf", account, rolluptypes.ETH)
+	require.NoError(t, err)
+	require.Equal(t, testapp.AccountBalance.BigInt(), values[0])
+	values, err = call(querycall.BankAddress, "balanceOf", common.Address{1}, rolluptypes.ETH)
+	require.NoError(t, err)
+	require.Zero(t, values[0].(*big.Int).Sign())
+
+	request, err := (&banktypes.QueryBalanceRequest{
+		Address: testapp.GetAccount(0).Address.String(),
+		Denom:   rolluptypes.ETH,
+	}).Marshal()
+	require.NoError(t, err)
+	values, err = call(querycall.RawAddress, "query", "/cosmos.bank.v1beta1.Query/Balance", request)
+	require.NoError(t, err)
+	resp := new(banktypes.QueryBalanceResponse)
+	require.NoError(t, resp.Unmarshal(values[0].([]byte)))
+	require.Equal(t, testapp.AccountBalance, resp.Balance.Amount)
+
+	_, err = call(querycall.RawAddress, "query", "app/simulate", []byte{})
+	require.ErrorContains(t, err, "not a gRPC query")
+	_, err = call(common.Address{1}, "balanceOf", account, rolluptypes.ETH)
+	require.ErrorContains(t, err, "not a query address")
+}
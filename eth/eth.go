@@ -45,13 +45,15 @@ type DB interface {
 
 type BlockAPI struct {
 	blockStore DB
+	txStore    TxStore
 	chainID    *big.Int
 	metrics    Metrics
 }
 
-func NewBlockAPI(blockStore DB, chainID *big.Int, metrics Metrics) *BlockAPI {
+func NewBlockAPI(blockStore DB, txStore TxStore, chainID *big.Int, metrics Metrics) *BlockAPI {
 	return &BlockAPI{
 		blockStore: blockStore,
+		txStore:    txStore,
 		chainID:    chainID,
 		metrics:    metrics,
 	}
@@ -81,6 +83,17 @@ func (e *BlockAPI) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]any
 	return e.toRPCBlock(block, fullTx)
 }
 
+// BlockNumber returns the height of the unsafe head.
+func (e *BlockAPI) BlockNumber() (hexutil.Uint64, error) {
+	defer e.metrics.RecordRPCMethodCall(BlockNumberMethodName, time.Now())
+
+	block, err := e.blockStore.HeadBlock()
+	if err != nil {
+		return 0, fmt.Errorf("get head block: %v", err)
+	}
+	return hexutil.Uint64(block.Header.Height), nil
+}
+
 func (e *BlockAPI) toRPCBlock(block *monomer.Block, fullTx bool) (map[string]any, error) {
 	ethBlock, err := block.ToEth()
 	if err != nil {
@@ -90,6 +103,21 @@ func (e *BlockAPI) toRPCBlock(block *monomer.Block, fullTx bool) (map[string]any
 	if err != nil {
 		return nil, fmt.Errorf("rpc marshal block: %v", err)
 	}
+	if fullTx && block.Txs.Len() > 0 {
+		// Deposit txs have the same nonces as in eth_getTransactionByHash.
+		result, err := e.txStore.Get(block.Txs[0].Hash())
+		if err != nil {
+			return nil, fmt.Errorf("get tx result: %v", err)
+		}
+		if result != nil {
+			for _, tx := range rpcBlock["transactions"].([]any) {
+				rpcTx := tx.(*ethapi.RPCTransaction)
+				if nonce, ok := depositNonce(&result.Result, rpcTx.Hash); ok {
+					setDepositNonce(rpcTx, nonce)
+				}
+			}
+		}
+	}
 	return rpcBlock, nil
 }
 
@@ -113,3 +141,49 @@ func (p *ProofAPI) GetProof(
 ) (*ethapi.AccountResult, error) {
 	return p.blockchainAPI.GetProof(ctx, address, storageKeys, blockNrOrHash)
 }
+
+// GetProofs returns the proofs of many accounts and their storage at the same block, in the order they were requested.
+// It is equivalent to calling GetProof for each request, but reads the state once.
+func (p *ProofAPI) GetProofs(
+	ctx context.Context,
+	requests []ethapi.ProofRequest,
+	blockNrOrHash rpc.BlockNumberOrHash,
+) ([]*ethapi.AccountResult, error) {
+	return p.blockchainAPI.GetProofs(ctx, requests, blockNrOrHash)
+}
+
+// StateAPI serves reads of the Ethereum state Monomer maintains alongside the Cosmos state.
+type StateAPI struct {
+	backend *ethAPIBackend
+	metrics Metrics
+}
+
+func NewStateAPI(db state.Database, blockStore DB, metrics Metrics) *StateAPI {
+	return &StateAPI{
+		backend: newEthAPIBackend(db, blockStore),
+		metrics: metrics,
+	}
+}
+
+// GetBalance returns the wei balance of the address in the Ethereum state.
+// Balances of Cosmos accounts are not part of the Ethereum state.
+func (s *StateAPI) GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	defer s.metrics.RecordRPCMethodCall(GetBalanceMethodName, time.Now())
+
+	stateDB, _, err := s.backend.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(stateDB.GetBalance(address).ToBig()), nil
+}
+
+// GetCode returns the code stored at the address in the Ethereum state.
+func (s *StateAPI) GetCode(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	defer s.metrics.RecordRPCMethodCall(GetCodeMethodName, time.Now())
+
+	stateDB, _, err := s.backend.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return stateDB.GetCode(address), nil
+}
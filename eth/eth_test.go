@@ -2,6 +2,7 @@ package eth_test
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -83,7 +84,7 @@ func TestGetBlockByNumber(t *testing.T) {
 			} {
 				t.Run(description, func(t *testing.T) {
 					chainID := new(big.Int)
-					blockAPI := eth.NewBlockAPI(blockStore, chainID, eth.NewNoopMetrics())
+					blockAPI := eth.NewBlockAPI(blockStore, txStore{}, chainID, eth.NewNoopMetrics())
 					got, err := blockAPI.GetBlockByNumber(test.id, fullTxs)
 					if test.want == nil {
 						require.ErrorIs(t, err, ethereum.NotFound)
@@ -112,7 +113,7 @@ func TestGetBlockByHash(t *testing.T) {
 		t.Run(description, func(t *testing.T) {
 			t.Run("block hash 1 exists", func(t *testing.T) {
 				chainID := new(big.Int)
-				blockAPI := eth.NewBlockAPI(blockStore, chainID, eth.NewNoopMetrics())
+				blockAPI := eth.NewBlockAPI(blockStore, txStore{}, chainID, eth.NewNoopMetrics())
 				got, err := blockAPI.GetBlockByHash(block.Header.Hash, fullTx)
 				require.NoError(t, err)
 				ethBlock, err := block.ToEth()
@@ -129,7 +130,7 @@ func TestGetBlockByHash(t *testing.T) {
 			} {
 				t.Run(description, func(t *testing.T) {
 					chainID := new(big.Int)
-					e := eth.NewBlockAPI(blockStore, chainID, eth.NewNoopMetrics())
+					e := eth.NewBlockAPI(blockStore, txStore{}, chainID, eth.NewNoopMetrics())
 					got, err := e.GetBlockByHash(common.Hash{}, inclTx)
 					require.Nil(t, got)
 					require.ErrorIs(t, err, ethereum.NotFound)
@@ -205,6 +206,75 @@ func TestGetProof(t *testing.T) {
 	}
 }
 
+func TestGetProofs(t *testing.T) {
+	blockNumber := rpc.LatestBlockNumber
+	blockNrOrHash := rpc.BlockNumberOrHash{BlockNumber: &blockNumber}
+
+	blockStore := testutils.NewLocalMemDB(t)
+	ethStateDB := testutils.NewEthStateDB(t)
+
+	ethState, err := state.New(ethtypes.EmptyRootHash, ethStateDB, nil)
+	require.NoError(t, err)
+	var requests []ethapi.ProofRequest
+	for i := int64(1); i <= 20; i++ {
+		address := common.BigToAddress(big.NewInt(i))
+		ethState.SetNonce(address, uint64(i))
+		ethState.SetBalance(address, uint256.NewInt(uint64(i*1000)))
+		request := ethapi.ProofRequest{Address: address}
+		for j := int64(1); j <= i; j++ {
+			key := common.BigToHash(big.NewInt(j))
+			// withdrawals.VerifyProof only accepts storage values below 0x80, whose RLP encoding is the value itself.
+			ethState.SetState(address, key, common.BigToHash(big.NewInt(i+j)))
+			request.StorageKeys = append(request.StorageKeys, key.String())
+		}
+		requests = append(requests, request)
+	}
+	stateRoot, err := ethState.Commit(uint64(blockNumber.Int64()), true)
+	require.NoError(t, err)
+	require.NoError(t, ethState.Database().TrieDB().Commit(stateRoot, false))
+	setupBlockStore(t, blockStore, stateRoot)
+
+	missingAddress := common.HexToAddress("0xdead")
+	requests = append(requests,
+		ethapi.ProofRequest{Address: missingAddress, StorageKeys: []string{"0x1"}},
+		// A repeated account, with a storage key that is not set.
+		ethapi.ProofRequest{Address: requests[1].Address, StorageKeys: []string{requests[1].StorageKeys[1], common.BigToHash(big.NewInt(100)).String()}},
+	)
+
+	proofAPI := eth.NewProofAPI(ethStateDB, blockStore)
+	proofs, err := proofAPI.GetProofs(context.Background(), requests, blockNrOrHash)
+	require.NoError(t, err)
+	require.Len(t, proofs, len(requests))
+	for i, request := range requests {
+		want, err := proofAPI.GetProof(context.Background(), request.Address, request.StorageKeys, blockNrOrHash)
+		require.NoError(t, err)
+		wantJSON, err := json.Marshal(want)
+		require.NoError(t, err)
+		gotJSON, err := json.Marshal(proofs[i])
+		require.NoError(t, err)
+		require.JSONEq(t, string(wantJSON), string(gotJSON))
+		if request.Address != missingAddress {
+			require.NoError(t, withdrawals.VerifyProof(stateRoot, adaptProof(proofs[i])))
+		}
+	}
+
+	t.Run("invalid storage key", func(t *testing.T) {
+		_, err := proofAPI.GetProofs(context.Background(), []ethapi.ProofRequest{{
+			Address:     requests[0].Address,
+			StorageKeys: []string{"not a key"},
+		}}, blockNrOrHash)
+		require.Error(t, err)
+	})
+
+	t.Run("too many proofs", func(t *testing.T) {
+		_, err := proofAPI.GetProofs(context.Background(), []ethapi.ProofRequest{{
+			Address:     requests[0].Address,
+			StorageKeys: make([]string, ethapi.MaxBatchedProofs),
+		}}, blockNrOrHash)
+		require.ErrorContains(t, err, "too many proofs")
+	})
+}
+
 func setupEthState(t *testing.T, ethStateDB state.Database, accountAddress common.Address, blockNumber rpc.BlockNumber, storageKey common.Hash, ethStateIsEmpty bool) common.Hash {
 	if ethStateIsEmpty {
 		return ethtypes.EmptyRootHash
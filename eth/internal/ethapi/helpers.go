@@ -2,13 +2,17 @@ package ethapi
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/polymerdao/monomer"
 )
 
@@ -35,5 +39,144 @@ func (noopBackend) StateAndHeaderByNumberOrHash(_ context.Context, _ rpc.BlockNu
 }
 
 func SimpleRPCMarshalBlock(block *types.Block, fullTx bool, chainID *big.Int) (map[string]interface{}, error) {
-	return RPCMarshalBlock(context.Background(), block, true, fullTx, monomer.NewChainConfig(chainID), noopBackend{})
+	fields, err := RPCMarshalBlock(context.Background(), block, true, fullTx, monomer.NewChainConfig(chainID), noopBackend{})
+	if err != nil {
+		return nil, err
+	}
+	if fullTx {
+		for _, tx := range fields["transactions"].([]interface{}) {
+			setChainID(tx.(*RPCTransaction), chainID)
+		}
+	}
+	return fields, nil
+}
+
+// SimpleRPCTransaction returns the RPC representation of the transaction at index in block, or nil if the index is out of range.
+func SimpleRPCTransaction(block *types.Block, index uint64, chainID *big.Int) *RPCTransaction {
+	tx := newRPCTransactionFromBlockIndex(context.Background(), block, index, monomer.NewChainConfig(chainID), noopBackend{})
+	if tx != nil {
+		setChainID(tx, chainID)
+	}
+	return tx
+}
+
+// SimpleRPCPendingTransaction returns the RPC representation of a transaction that isn't in a block yet.
+func SimpleRPCPendingTransaction(tx *types.Transaction, chainID *big.Int) *RPCTransaction {
+	rpcTx := newRPCTransaction(tx, common.Hash{}, 0, 0, 0, nil, monomer.NewChainConfig(chainID), nil)
+	setChainID(rpcTx, chainID)
+	return rpcTx
+}
+
+// setChainID sets the chain ID of the Ethereum representation of Cosmos txs, which don't carry one,
+// so that it is consistent with eth_chainId.
+func setChainID(tx *RPCTransaction, chainID *big.Int) {
+	if uint8(tx.Type) != types.DepositTxType && (tx.ChainID == nil || tx.ChainID.ToInt().Sign() == 0) {
+		tx.ChainID = (*hexutil.Big)(new(big.Int).Set(chainID))
+	}
+}
+
+// MaxBatchedProofs is the maximum number of account and storage proofs GetProofs generates in one call.
+const MaxBatchedProofs = 10_000
+
+// ProofRequest is an account and the storage keys to prove for it, as in eth_getProof.
+type ProofRequest struct {
+	Address     common.Address `json:"address"`
+	StorageKeys []string       `json:"storageKeys"`
+}
+
+// GetProofs returns the same results as calling GetProof for each request, all at the same block.
+// The state and each trie are opened once, and every key is looked up before it is proven: the lookup resolves the
+// path into the in-memory trie, so nodes shared between proofs are read from the database once.
+func (s *BlockChainAPI) GetProofs(ctx context.Context, requests []ProofRequest, blockNrOrHash rpc.BlockNumberOrHash) ([]*AccountResult, error) {
+	numProofs := 0
+	keys := make([][]common.Hash, len(requests))
+	keyLengths := make([][]int, len(requests))
+	// Deserialize all keys. This prevents state access on invalid input.
+	for i, request := range requests {
+		numProofs += 1 + len(request.StorageKeys)
+		keys[i] = make([]common.Hash, len(request.StorageKeys))
+		keyLengths[i] = make([]int, len(request.StorageKeys))
+		for j, hexKey := range request.StorageKeys {
+			var err error
+			keys[i][j], keyLengths[i][j], err = decodeHash(hexKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if numProofs > MaxBatchedProofs {
+		return nil, fmt.Errorf("too many proofs requested: %d, the maximum is %d", numProofs, MaxBatchedProofs)
+	}
+
+	statedb, header, err := s.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if statedb == nil || err != nil {
+		return nil, err
+	}
+	trieDB := statedb.Database().TrieDB()
+	accountTrie, err := trie.NewStateTrie(trie.StateTrieID(header.Root), trieDB)
+	if err != nil {
+		return nil, err
+	}
+	storageTries := make(map[common.Address]*trie.StateTrie)
+
+	results := make([]*AccountResult, len(requests))
+	for i, request := range requests {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		address := request.Address
+		account, err := accountTrie.GetAccount(address)
+		if err != nil {
+			return nil, err
+		}
+		var accountProof proofList
+		if err := accountTrie.Prove(crypto.Keccak256(address.Bytes()), &accountProof); err != nil {
+			return nil, err
+		}
+		result := &AccountResult{
+			Address:      address,
+			AccountProof: accountProof,
+			Balance:      new(hexutil.Big),
+			StorageProof: make([]StorageResult, len(keys[i])),
+		}
+		if account != nil {
+			result.Balance = (*hexutil.Big)(account.Balance.ToBig())
+			result.CodeHash = common.BytesToHash(account.CodeHash)
+			result.Nonce = hexutil.Uint64(account.Nonce)
+			result.StorageHash = account.Root
+		}
+
+		storageTrie := storageTries[address]
+		if storageTrie == nil && len(keys[i]) > 0 && result.StorageHash != types.EmptyRootHash && result.StorageHash != (common.Hash{}) {
+			id := trie.StorageTrieID(header.Root, crypto.Keccak256Hash(address.Bytes()), result.StorageHash)
+			if storageTrie, err = trie.NewStateTrie(id, trieDB); err != nil {
+				return nil, err
+			}
+			storageTries[address] = storageTrie
+		}
+		for j, key := range keys[i] {
+			// See GetProof for the output key encoding.
+			var outputKey string
+			if keyLengths[i][j] != 32 {
+				outputKey = hexutil.EncodeBig(key.Big())
+			} else {
+				outputKey = hexutil.Encode(key[:])
+			}
+			if storageTrie == nil {
+				result.StorageProof[j] = StorageResult{outputKey, &hexutil.Big{}, []string{}}
+				continue
+			}
+			value, err := storageTrie.GetStorage(address, key.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			var proof proofList
+			if err := storageTrie.Prove(crypto.Keccak256(key.Bytes()), &proof); err != nil {
+				return nil, err
+			}
+			result.StorageProof[j] = StorageResult{outputKey, (*hexutil.Big)(new(big.Int).SetBytes(value)), proof}
+		}
+		results[i] = result
+	}
+	return results, nil
 }
@@ -0,0 +1,333 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/monomerdb"
+)
+
+const (
+	// MaxLogsBlockRange is the maximum number of blocks a log query scans.
+	MaxLogsBlockRange = 10_000
+	// FilterTimeout is how long a filter is kept after it was last polled, as in geth.
+	FilterTimeout = 5 * time.Minute
+)
+
+// LogStore looks up the results of Cosmos txs, which logs are derived from, and the logs blooms of blocks.
+type LogStore interface {
+	TxStore
+	LogsBloom(height uint64) (ethtypes.Bloom, bool, error)
+}
+
+// FilterAPI serves the logs of the events Cosmos txs emit, see package ethlog. Logs are queried directly with
+// eth_getLogs, or with filters that are polled for the logs of new blocks. Filters are kept in memory, so they don't
+// survive restarts, and they don't report the logs of blocks removed by reorgs.
+type FilterAPI struct {
+	blockStore DB
+	logStore   LogStore
+	chainID    *big.Int
+	metrics    Metrics
+
+	mu      sync.Mutex
+	filters map[rpc.ID]*logFilter
+}
+
+// logFilter is a filter installed with eth_newFilter.
+type logFilter struct {
+	criteria *filters.FilterCriteria
+	// next is the height of the first block whose logs eth_getFilterChanges hasn't returned.
+	next     uint64
+	deadline time.Time
+}
+
+func NewFilterAPI(blockStore DB, logStore LogStore, chainID *big.Int, metrics Metrics) *FilterAPI {
+	return &FilterAPI{
+		blockStore: blockStore,
+		logStore:   logStore,
+		chainID:    chainID,
+		metrics:    metrics,
+		filters:    make(map[rpc.ID]*logFilter),
+	}
+}
+
+// GetLogs returns the logs matching the criteria.
+func (e *FilterAPI) GetLogs(criteria filters.FilterCriteria) ([]*ethtypes.Log, error) { //nolint:gocritic // hugeParam
+	defer e.metrics.RecordRPCMethodCall(GetLogsMethodName, time.Now())
+
+	return e.logs(&criteria)
+}
+
+// NewFilter installs a filter for the logs matching the criteria in the blocks built after it, and returns its id.
+func (e *FilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID, error) { //nolint:gocritic // hugeParam
+	defer e.metrics.RecordRPCMethodCall(NewFilterMethodName, time.Now())
+
+	if criteria.BlockHash != nil {
+		return "", errors.New("filters can't be restricted to a block hash")
+	}
+	head, err := e.blockStore.HeadBlock()
+	if err != nil {
+		return "", fmt.Errorf("get head block: %v", err)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.removeExpired()
+	id := rpc.NewID()
+	e.filters[id] = &logFilter{
+		criteria: &criteria,
+		next:     head.Header.Height + 1,
+		deadline: time.Now().Add(FilterTimeout),
+	}
+	return id, nil
+}
+
+// GetFilterChanges returns the logs matching the filter in the blocks built since it was last polled. At most
+// MaxLogsBlockRange blocks are scanned; the logs of later blocks are returned by the next call.
+func (e *FilterAPI) GetFilterChanges(id rpc.ID) ([]*ethtypes.Log, error) {
+	defer e.metrics.RecordRPCMethodCall(GetFilterChangesMethodName, time.Now())
+
+	head, err := e.blockStore.HeadBlock()
+	if err != nil {
+		return nil, fmt.Errorf("get head block: %v", err)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.removeExpired()
+	f, ok := e.filters[id]
+	if !ok {
+		return nil, errFilterNotFound
+	}
+	f.deadline = time.Now().Add(FilterTimeout)
+
+	// After the head is rolled back, the rebuilt blocks are reported as they are built.
+	f.next = min(f.next, head.Header.Height+1)
+	from, to := f.next, min(head.Header.Height, f.next+MaxLogsBlockRange-1)
+	f.next = to + 1
+	// The criteria's block numbers further restrict the blocks.
+	if f.criteria.FromBlock != nil && f.criteria.FromBlock.Sign() > 0 {
+		from = max(from, f.criteria.FromBlock.Uint64())
+	}
+	if f.criteria.ToBlock != nil && f.criteria.ToBlock.Sign() >= 0 {
+		to = min(to, f.criteria.ToBlock.Uint64())
+	}
+	return e.logsInRange(f.criteria, from, to)
+}
+
+// GetFilterLogs returns the logs matching the filter's criteria, like GetLogs.
+func (e *FilterAPI) GetFilterLogs(id rpc.ID) ([]*ethtypes.Log, error) {
+	defer e.metrics.RecordRPCMethodCall(GetFilterLogsMethodName, time.Now())
+
+	e.mu.Lock()
+	e.removeExpired()
+	f, ok := e.filters[id]
+	if ok {
+		f.deadline = time.Now().Add(FilterTimeout)
+	}
+	e.mu.Unlock()
+	if !ok {
+		return nil, errFilterNotFound
+	}
+	return e.logs(f.criteria)
+}
+
+// UninstallFilter removes the filter and reports whether it existed.
+func (e *FilterAPI) UninstallFilter(id rpc.ID) bool {
+	defer e.metrics.RecordRPCMethodCall(UninstallFilterMethodName, time.Now())
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.removeExpired()
+	_, ok := e.filters[id]
+	delete(e.filters, id)
+	return ok
+}
+
+var errFilterNotFound = errors.New("filter not found")
+
+// removeExpired removes the filters that weren't polled within FilterTimeout. The caller must hold e.mu.
+func (e *FilterAPI) removeExpired() {
+	now := time.Now()
+	for id, f := range e.filters {
+		if now.After(f.deadline) {
+			delete(e.filters, id)
+		}
+	}
+}
+
+// logs returns the logs matching the criteria, in the block with the criteria's hash or in its range of blocks.
+func (e *FilterAPI) logs(criteria *filters.FilterCriteria) ([]*ethtypes.Log, error) {
+	if criteria.BlockHash != nil {
+		block, err := e.blockStore.BlockByHash(*criteria.BlockHash)
+		if errors.Is(err, monomerdb.ErrNotFound) {
+			return nil, ethereum.NotFound
+		} else if err != nil {
+			return nil, fmt.Errorf("get block by hash (%s): %v", criteria.BlockHash, err)
+		}
+		logs, err := e.blockLogs(criteria, block)
+		if err != nil {
+			return nil, err
+		}
+		return nonNilLogs(logs), nil
+	}
+
+	head, err := e.blockStore.HeadBlock()
+	if err != nil {
+		return nil, fmt.Errorf("get head block: %v", err)
+	}
+	from, err := e.height(criteria.FromBlock, head.Header.Height)
+	if err != nil {
+		return nil, fmt.Errorf("from block: %v", err)
+	}
+	to, err := e.height(criteria.ToBlock, head.Header.Height)
+	if err != nil {
+		return nil, fmt.Errorf("to block: %v", err)
+	}
+	if from > to {
+		// As in geth, only an explicit range is invalid; a range starting after the head has no logs yet.
+		if criteria.ToBlock != nil && criteria.ToBlock.Sign() >= 0 {
+			return nil, fmt.Errorf("from block %d is after to block %d", from, to)
+		}
+		return []*ethtypes.Log{}, nil
+	} else if to-from >= MaxLogsBlockRange {
+		return nil, fmt.Errorf("block range %d-%d is longer than %d blocks", from, to, MaxLogsBlockRange)
+	}
+	return e.logsInRange(criteria, from, min(to, head.Header.Height))
+}
+
+// height returns the height of the block a filter criteria block number refers to. A nil number refers to the head.
+func (e *FilterAPI) height(number *big.Int, head uint64) (uint64, error) {
+	if number == nil {
+		return head, nil
+	}
+	var label eth.BlockLabel
+	switch blockNumber := rpc.BlockNumber(number.Int64()); blockNumber {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+		return head, nil
+	case rpc.SafeBlockNumber:
+		label = eth.Safe
+	case rpc.FinalizedBlockNumber:
+		label = eth.Finalized
+	default:
+		if blockNumber < 0 {
+			return 0, fmt.Errorf("invalid block number %d", blockNumber)
+		}
+		return uint64(blockNumber), nil
+	}
+	block, err := e.blockStore.BlockByLabel(label)
+	if err != nil {
+		return 0, fmt.Errorf("get %s block: %v", label, err)
+	}
+	return block.Header.Height, nil
+}
+
+// logsInRange returns the logs matching the criteria in the blocks from through to. Blocks whose logs bloom can't match
+// are skipped.
+func (e *FilterAPI) logsInRange(criteria *filters.FilterCriteria, from, to uint64) ([]*ethtypes.Log, error) {
+	logs := []*ethtypes.Log{}
+	for height := from; height <= to; height++ {
+		bloom, ok, err := e.logStore.LogsBloom(height)
+		if err != nil {
+			return nil, fmt.Errorf("get logs bloom (%d): %v", height, err)
+		}
+		// Blocks built before logs blooms were indexed don't have one, so they are always scanned.
+		if ok && !bloomMatches(bloom, criteria) {
+			continue
+		}
+		block, err := e.blockStore.BlockByHeight(height)
+		if errors.Is(err, monomerdb.ErrNotFound) {
+			// Heights before the first block, like 0, which clients use as the default start of a range, have no logs.
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("get block by height (%d): %v", height, err)
+		}
+		blockLogs, err := e.blockLogs(criteria, block)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, blockLogs...)
+	}
+	return logs, nil
+}
+
+// blockLogs returns the logs matching the criteria in the block.
+func (e *FilterAPI) blockLogs(criteria *filters.FilterCriteria, block *monomer.Block) ([]*ethtypes.Log, error) {
+	txs, err := executedTxs(block, e.logStore, e.chainID)
+	if err != nil {
+		return nil, err
+	}
+	var logs []*ethtypes.Log
+	for _, tx := range txs {
+		for _, log := range tx.logs {
+			if logMatches(log, criteria) {
+				logs = append(logs, log)
+			}
+		}
+	}
+	return logs, nil
+}
+
+// bloomMatches reports whether a block with the logs bloom may contain logs matching the criteria.
+func bloomMatches(bloom ethtypes.Bloom, criteria *filters.FilterCriteria) bool {
+	if len(criteria.Addresses) > 0 {
+		var found bool
+		for _, address := range criteria.Addresses {
+			if bloom.Test(address.Bytes()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, topics := range criteria.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		var found bool
+		for _, topic := range topics {
+			if bloom.Test(topic.Bytes()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// logMatches reports whether the log matches the criteria's addresses and topics. An empty list of topics at a
+// position matches any topic.
+func logMatches(log *ethtypes.Log, criteria *filters.FilterCriteria) bool {
+	if len(criteria.Addresses) > 0 && !slices.Contains(criteria.Addresses, log.Address) {
+		return false
+	}
+	if len(criteria.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, topics := range criteria.Topics {
+		if len(topics) > 0 && !slices.Contains(topics, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func nonNilLogs(logs []*ethtypes.Log) []*ethtypes.Log {
+	if logs == nil {
+		return []*ethtypes.Log{}
+	}
+	return logs
+}
@@ -0,0 +1,151 @@
+package eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/eth"
+	"github.com/polymerdao/monomer/ethlog"
+	"github.com/polymerdao/monomer/monomerdb/localdb"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+type logStore struct {
+	txStore
+	blooms map[uint64]ethtypes.Bloom
+}
+
+func (s *logStore) LogsBloom(height uint64) (ethtypes.Bloom, bool, error) {
+	bloom, ok := s.blooms[height]
+	return bloom, ok, nil
+}
+
+// appendBlock appends a block with a Cosmos tx that emits the events, and indexes its logs bloom.
+func appendBlock(t *testing.T, blockStore *localdb.DB, store *logStore, parent *monomer.Header, events ...abcitypes.Event) *monomer.Block {
+	height := uint64(0)
+	if parent != nil {
+		height = parent.Height + 1
+	}
+	block := testutils.GenerateBlockWithParentAndTxs(t, parent, bfttypes.Tx(big.NewInt(int64(height)).String()))
+	require.NoError(t, blockStore.AppendBlock(block))
+	results := make([]*abcitypes.TxResult, 0, block.Txs.Len())
+	for _, tx := range block.Txs {
+		result := &abcitypes.TxResult{Height: int64(block.Header.Height)}
+		store.txStore[string(tx.Hash())] = result
+		results = append(results, result)
+	}
+	results[1].Result.Events = events
+	bloom, err := ethlog.Bloom(results)
+	require.NoError(t, err)
+	store.blooms[block.Header.Height] = bloom
+	return block
+}
+
+func TestGetLogs(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	store := &logStore{
+		txStore: txStore{},
+		blooms:  make(map[uint64]ethtypes.Bloom),
+	}
+	transfer := abcitypes.Event{
+		Type:       "transfer",
+		Attributes: []abcitypes.EventAttribute{{Key: "recipient", Value: "cosmos1recipient"}, {Key: "amount", Value: "1stake"}},
+	}
+	block0 := appendBlock(t, blockStore, store, nil, transfer)
+	block1 := appendBlock(t, blockStore, store, block0.Header, abcitypes.Event{Type: "message"}, transfer)
+	filterAPI := eth.NewFilterAPI(blockStore, store, big.NewInt(1), eth.NewNoopMetrics())
+	transferTopic := crypto.Keccak256Hash([]byte("transfer"))
+
+	logs, err := filterAPI.GetLogs(filters.FilterCriteria{
+		FromBlock: big.NewInt(0),
+		Topics:    [][]common.Hash{{transferTopic}},
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	for i, block := range []*monomer.Block{block0, block1} {
+		log := logs[i]
+		require.Equal(t, ethlog.CosmosEventsAddress, log.Address)
+		require.Equal(t, []common.Hash{
+			transferTopic,
+			crypto.Keccak256Hash([]byte("cosmos1recipient")),
+			crypto.Keccak256Hash([]byte("1stake")),
+		}, log.Topics)
+		require.Equal(t, block.Header.Hash, log.BlockHash)
+		require.Equal(t, block.Header.Height, log.BlockNumber)
+		require.Equal(t, uint(1), log.TxIndex) // The L1 attributes tx comes first.
+	}
+	require.Equal(t, uint(0), logs[0].Index)
+	require.Equal(t, uint(1), logs[1].Index) // The message event comes first.
+
+	// The recipient is the first indexed attribute.
+	logs, err = filterAPI.GetLogs(filters.FilterCriteria{
+		FromBlock: big.NewInt(0),
+		Topics:    [][]common.Hash{{}, {crypto.Keccak256Hash([]byte("cosmos1sender"))}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, logs)
+
+	logs, err = filterAPI.GetLogs(filters.FilterCriteria{BlockHash: &block0.Header.Hash})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+
+	// The range defaults to the head.
+	logs, err = filterAPI.GetLogs(filters.FilterCriteria{Addresses: []common.Address{ethlog.CosmosEventsAddress}})
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	require.Equal(t, block1.Header.Height, logs[0].BlockNumber)
+
+	_, err = filterAPI.GetLogs(filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(0)})
+	require.Error(t, err)
+	_, err = filterAPI.GetLogs(filters.FilterCriteria{FromBlock: big.NewInt(0), ToBlock: big.NewInt(eth.MaxLogsBlockRange)})
+	require.Error(t, err)
+}
+
+func TestFilters(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	store := &logStore{
+		txStore: txStore{},
+		blooms:  make(map[uint64]ethtypes.Bloom),
+	}
+	event := abcitypes.Event{Type: "transfer"}
+	block := appendBlock(t, blockStore, store, nil, event)
+	filterAPI := eth.NewFilterAPI(blockStore, store, big.NewInt(1), eth.NewNoopMetrics())
+
+	id, err := filterAPI.NewFilter(filters.FilterCriteria{FromBlock: big.NewInt(0)})
+	require.NoError(t, err)
+
+	// Only the logs of blocks built after the filter was installed are changes.
+	logs, err := filterAPI.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+
+	block = appendBlock(t, blockStore, store, block.Header, event)
+	logs, err = filterAPI.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, block.Header.Height, logs[0].BlockNumber)
+	logs, err = filterAPI.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+
+	// The filter's logs are all the logs matching its criteria.
+	logs, err = filterAPI.GetFilterLogs(id)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+
+	require.True(t, filterAPI.UninstallFilter(id))
+	require.False(t, filterAPI.UninstallFilter(id))
+	_, err = filterAPI.GetFilterChanges(id)
+	require.Error(t, err)
+	_, err = filterAPI.GetFilterLogs(rpc.ID("unknown"))
+	require.Error(t, err)
+}
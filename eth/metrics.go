@@ -10,6 +10,35 @@ const (
 	ChainIDMethodName          = "chainId"
 	GetBlockByNumberMethodName = "getBlockByNumber"
 	GetBlockByHashMethodName   = "getBlockByHash"
+	BlockNumberMethodName      = "blockNumber"
+	GetBalanceMethodName       = "getBalance"
+	GetCodeMethodName          = "getCode"
+
+	GetTransactionByHashMethodName  = "getTransactionByHash"
+	GetTransactionReceiptMethodName = "getTransactionReceipt"
+	GetBlockReceiptsMethodName      = "getBlockReceipts"
+	SendRawTransactionMethodName    = "sendRawTransaction"
+	CallMethodName                  = "call"
+
+	TraceTransactionMethodName   = "traceTransaction"
+	TraceBlockByNumberMethodName = "traceBlockByNumber"
+	TraceBlockByHashMethodName   = "traceBlockByHash"
+
+	GetLogsMethodName          = "getLogs"
+	NewFilterMethodName        = "newFilter"
+	GetFilterChangesMethodName = "getFilterChanges"
+	GetFilterLogsMethodName    = "getFilterLogs"
+	UninstallFilterMethodName  = "uninstallFilter"
+	SubscribeMethodName        = "subscribe"
+
+	OutputsAtBlocksMethodName = "outputsAtBlocks"
+
+	NetVersionMethodName   = "version"
+	NetListeningMethodName = "listening"
+	NetPeerCountMethodName = "peerCount"
+
+	TxPoolStatusMethodName  = "status"
+	TxPoolContentMethodName = "content"
 )
 
 var RPCMethodDurationBucketsMicroseconds = []float64{1, 10, 50, 100, 500, 1000}
@@ -0,0 +1,54 @@
+package eth
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// PeerCounter reports how many peers a node is connected to, e.g., mempoolsync.Syncer.
+type PeerCounter interface {
+	PeerCount() int
+}
+
+// NetAPI serves the net namespace, which SDKs such as web3.js and ethers.js call when they connect.
+type NetAPI struct {
+	networkID string
+	peers     []PeerCounter
+	metrics   Metrics
+}
+
+func NewNetAPI(chainID *big.Int, metrics Metrics, peers ...PeerCounter) *NetAPI {
+	return &NetAPI{
+		networkID: chainID.String(),
+		peers:     peers,
+		metrics:   metrics,
+	}
+}
+
+// Version returns the network ID. Like op-geth, it is the chain ID as a decimal string.
+func (n *NetAPI) Version() string {
+	defer n.metrics.RecordRPCMethodCall(NetVersionMethodName, time.Now())
+
+	return n.networkID
+}
+
+// Listening returns true, since the node accepts connections while it is running.
+func (n *NetAPI) Listening() bool {
+	defer n.metrics.RecordRPCMethodCall(NetListeningMethodName, time.Now())
+
+	return true
+}
+
+// PeerCount returns the number of peers the node is connected to. Monomer doesn't gossip blocks itself, so only the
+// peers it exchanges data with directly are counted, e.g., the mempool sync peers that are reachable.
+func (n *NetAPI) PeerCount() hexutil.Uint {
+	defer n.metrics.RecordRPCMethodCall(NetPeerCountMethodName, time.Now())
+
+	var count int
+	for _, peers := range n.peers {
+		count += peers.PeerCount()
+	}
+	return hexutil.Uint(count)
+}
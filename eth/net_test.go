@@ -0,0 +1,37 @@
+package eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer/eth"
+	"github.com/stretchr/testify/require"
+)
+
+type peerCounter int
+
+func (c peerCounter) PeerCount() int {
+	return int(c)
+}
+
+func TestNet(t *testing.T) {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("net", eth.NewNetAPI(big.NewInt(901), eth.NewNoopMetrics(), peerCounter(2), peerCounter(1))))
+	t.Cleanup(server.Stop)
+	client := rpc.DialInProc(server)
+	t.Cleanup(client.Close)
+
+	var version string
+	require.NoError(t, client.Call(&version, "net_version"))
+	require.Equal(t, "901", version)
+
+	var listening bool
+	require.NoError(t, client.Call(&listening, "net_listening"))
+	require.True(t, listening)
+
+	var peerCount hexutil.Uint
+	require.NoError(t, client.Call(&peerCount, "net_peerCount"))
+	require.Equal(t, hexutil.Uint(3), peerCount)
+}
@@ -0,0 +1,100 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	opeth "github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/polymerdao/monomer"
+)
+
+// MaxOutputsPerCall is the maximum number of outputs OutputsAtBlocks returns in one call.
+const MaxOutputsPerCall = 1000
+
+// OutputDB is a block store that keeps the headers of pruned blocks, e.g., localdb.DB.
+type OutputDB interface {
+	// ArchivedHeaderByHeight returns the header at height, even if its block was pruned.
+	ArchivedHeaderByHeight(uint64) (*monomer.Header, error)
+}
+
+// Output is the L2 output at a block and the values its output root commits to.
+type Output struct {
+	BlockNumber           hexutil.Uint64 `json:"blockNumber"`
+	BlockHash             common.Hash    `json:"blockHash"`
+	StateRoot             common.Hash    `json:"stateRoot"`
+	WithdrawalStorageRoot common.Hash    `json:"withdrawalStorageRoot"`
+	OutputRoot            common.Hash    `json:"outputRoot"`
+}
+
+// OutputAPI serves the output roots output bisection games claim, so op-challenger can check them against Monomer.
+type OutputAPI struct {
+	blockStore OutputDB
+	db         state.Database
+	metrics    Metrics
+}
+
+func NewOutputAPI(blockStore OutputDB, db state.Database, metrics Metrics) *OutputAPI {
+	return &OutputAPI{
+		blockStore: blockStore,
+		db:         db,
+		metrics:    metrics,
+	}
+}
+
+// OutputsAtBlocks returns the outputs at the block numbers, in the order they were requested.
+// Outputs of pruned blocks are still available, since the block store keeps their headers and the EVM state isn't pruned.
+func (o *OutputAPI) OutputsAtBlocks(ctx context.Context, numbers []hexutil.Uint64) ([]*Output, error) {
+	defer o.metrics.RecordRPCMethodCall(OutputsAtBlocksMethodName, time.Now())
+
+	if len(numbers) > MaxOutputsPerCall {
+		return nil, fmt.Errorf("too many outputs requested: %d, the maximum is %d", len(numbers), MaxOutputsPerCall)
+	}
+	outputs := make([]*Output, len(numbers))
+	for i, number := range numbers {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		output, err := o.outputAtBlock(uint64(number))
+		if err != nil {
+			return nil, fmt.Errorf("output at block %d: %w", number, err)
+		}
+		outputs[i] = output
+	}
+	return outputs, nil
+}
+
+func (o *OutputAPI) outputAtBlock(number uint64) (*Output, error) {
+	header, err := o.blockStore.ArchivedHeaderByHeight(number)
+	if err != nil {
+		return nil, fmt.Errorf("get header: %w", err)
+	}
+	tr, err := o.db.OpenTrie(header.StateRoot)
+	if err != nil {
+		return nil, fmt.Errorf("open state trie: %v", err)
+	}
+	messagePasser, err := tr.GetAccount(predeploys.L2ToL1MessagePasserAddr)
+	if err != nil {
+		return nil, fmt.Errorf("get L2ToL1MessagePasser account: %v", err)
+	}
+	// Like eth_getProof, which op-node reads the storage root from, a missing account has a zero storage root.
+	var withdrawalStorageRoot common.Hash
+	if messagePasser != nil {
+		withdrawalStorageRoot = messagePasser.Root
+	}
+	return &Output{
+		BlockNumber:           hexutil.Uint64(header.Height),
+		BlockHash:             header.Hash,
+		StateRoot:             header.StateRoot,
+		WithdrawalStorageRoot: withdrawalStorageRoot,
+		OutputRoot: common.Hash(opeth.OutputRoot(&opeth.OutputV0{
+			StateRoot:                opeth.Bytes32(header.StateRoot),
+			MessagePasserStorageRoot: opeth.Bytes32(withdrawalStorageRoot),
+			BlockHash:                header.Hash,
+		})),
+	}, nil
+}
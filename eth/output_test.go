@@ -0,0 +1,86 @@
+package eth_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	opeth "github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/eth"
+	"github.com/polymerdao/monomer/monomerdb"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputsAtBlocks(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	ethStateDB := testutils.NewEthStateDB(t)
+
+	// Each block records one more withdrawal in the L2ToL1MessagePasser's storage.
+	ethState, err := state.New(ethtypes.EmptyRootHash, ethStateDB, nil)
+	require.NoError(t, err)
+	var blocks []*monomer.Block
+	parent := &monomer.Header{}
+	for height := uint64(1); height <= 5; height++ {
+		ethState.SetState(predeploys.L2ToL1MessagePasserAddr, common.BigToHash(new(big.Int).SetUint64(height)), common.BigToHash(common.Big1))
+		stateRoot, err := ethState.Commit(height, true)
+		require.NoError(t, err)
+		require.NoError(t, ethStateDB.TrieDB().Commit(stateRoot, false))
+		ethState, err = state.New(stateRoot, ethStateDB, nil)
+		require.NoError(t, err)
+
+		block, err := monomer.MakeBlock(&monomer.Header{
+			Height:     height,
+			ParentHash: parent.Hash,
+			StateRoot:  stateRoot,
+		}, bfttypes.Txs{})
+		require.NoError(t, err)
+		require.NoError(t, blockStore.AppendBlock(block))
+		blocks = append(blocks, block)
+		parent = block.Header
+	}
+	head := blocks[len(blocks)-1]
+	require.NoError(t, blockStore.UpdateLabels(head.Header.Hash, head.Header.Hash, head.Header.Hash))
+	require.NoError(t, blockStore.PruneBelow(head.Header.Height))
+
+	outputAPI := eth.NewOutputAPI(blockStore, ethStateDB, eth.NewNoopMetrics())
+	// The numbers don't have to be in order, and include blocks pruned from the block store.
+	numbers := []hexutil.Uint64{5, 1, 3, 2, 4, 3}
+	outputs, err := outputAPI.OutputsAtBlocks(context.Background(), numbers)
+	require.NoError(t, err)
+	require.Len(t, outputs, len(numbers))
+	for i, number := range numbers {
+		header := blocks[number-1].Header
+		stateDB, err := state.New(header.StateRoot, ethStateDB, nil)
+		require.NoError(t, err)
+		withdrawalStorageRoot := stateDB.GetStorageRoot(predeploys.L2ToL1MessagePasserAddr)
+		require.Equal(t, &eth.Output{
+			BlockNumber:           number,
+			BlockHash:             header.Hash,
+			StateRoot:             header.StateRoot,
+			WithdrawalStorageRoot: withdrawalStorageRoot,
+			OutputRoot: common.Hash(opeth.OutputRoot(&opeth.OutputV0{
+				StateRoot:                opeth.Bytes32(header.StateRoot),
+				MessagePasserStorageRoot: opeth.Bytes32(withdrawalStorageRoot),
+				BlockHash:                header.Hash,
+			})),
+		}, outputs[i])
+	}
+
+	t.Run("unknown block", func(t *testing.T) {
+		_, err := outputAPI.OutputsAtBlocks(context.Background(), []hexutil.Uint64{1, 6})
+		require.ErrorIs(t, err, monomerdb.ErrNotFound)
+	})
+
+	t.Run("too many outputs", func(t *testing.T) {
+		_, err := outputAPI.OutputsAtBlocks(context.Background(), make([]hexutil.Uint64, eth.MaxOutputsPerCall+1))
+		require.ErrorContains(t, err, "too many outputs")
+	})
+}
@@ -0,0 +1,101 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/txforward"
+)
+
+type AppMempool interface {
+	CheckTx(context.Context, *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error)
+}
+
+type Mempool interface {
+	Enqueue(userTxn bfttypes.Tx) error
+	Reject(rejection *mempool.Rejection) error
+}
+
+// SendTxAPI lets Ethereum tooling submit Cosmos txs.
+//
+// A Cosmos tx is submitted wrapped in an Ethereum tx whose data is the encoded Cosmos tx, which is how the eth namespace
+// represents Cosmos txs. Only the Cosmos tx is executed and its signatures are the only ones verified, so the rest of the
+// wrapper, including its signature, is ignored. This lets frontends submit any Cosmos tx, e.g., a session key's
+// authz MsgExec (see the sessionkey package), through the same RPC endpoint as everything else.
+type SendTxAPI struct {
+	app     AppMempool
+	mempool Mempool
+	metrics Metrics
+}
+
+func NewSendTxAPI(app AppMempool, mempool Mempool, metrics Metrics) *SendTxAPI {
+	return &SendTxAPI{
+		app:     app,
+		mempool: mempool,
+		metrics: metrics,
+	}
+}
+
+// SendRawTransaction checks the Cosmos tx wrapped in the encoded Ethereum tx and adds it to the mempool.
+// It returns the hash the eth namespace uses for the tx, which can be passed to eth_getTransactionReceipt.
+func (e *SendTxAPI) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error) {
+	defer e.metrics.RecordRPCMethodCall(SendRawTransactionMethodName, time.Now())
+
+	var ethTx ethtypes.Transaction
+	if err := ethTx.UnmarshalBinary(data); err != nil {
+		return common.Hash{}, fmt.Errorf("unmarshal binary: %v", err)
+	}
+	if ethTx.IsDepositTx() {
+		return common.Hash{}, errors.New("deposit txs can only be submitted on L1")
+	}
+	// The signature is ignored, but a wrapper that is signed must have a sender, so tooling that recovers it from the
+	// wrapper can't disagree with the node about who sent the tx.
+	if v, r, s := ethTx.RawSignatureValues(); v.Sign() != 0 || r.Sign() != 0 || s.Sign() != 0 {
+		if _, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(ethTx.ChainId()), &ethTx); err != nil {
+			return common.Hash{}, fmt.Errorf("recover wrapper sender: %v", err)
+		}
+	}
+	cosmosTx := bfttypes.Tx(ethTx.Data())
+	if len(cosmosTx) == 0 {
+		return common.Hash{}, errors.New("tx does not wrap a cosmos tx")
+	}
+
+	checkTxResp, err := e.app.CheckTx(ctx, &abcitypes.RequestCheckTx{
+		Tx:   cosmosTx,
+		Type: abcitypes.CheckTxType_New,
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("check tx: %v", err)
+	}
+	if !checkTxResp.IsOK() {
+		if err := e.mempool.Reject(&mempool.Rejection{
+			Tx:        cosmosTx,
+			Reason:    mempool.ReasonCheckTxFailed,
+			Code:      checkTxResp.GetCode(),
+			Codespace: checkTxResp.GetCodespace(),
+			Log:       checkTxResp.GetLog(),
+		}); err != nil {
+			return common.Hash{}, fmt.Errorf("record rejection: %v", err)
+		}
+		return common.Hash{}, fmt.Errorf("check tx failed with code %d (codespace %q): %s",
+			checkTxResp.GetCode(), checkTxResp.GetCodespace(), checkTxResp.GetLog())
+	}
+	if err := e.mempool.Enqueue(cosmosTx); err != nil {
+		// A node that forwards txs returns the sequencer's CheckTx failure like its own.
+		var rejectedErr *txforward.RejectedError
+		if errors.As(err, &rejectedErr) {
+			return common.Hash{}, rejectedErr
+		}
+		return common.Hash{}, fmt.Errorf("enqueue in mempool: %v", err)
+	}
+	return monomer.AdaptNonDepositCosmosTxToEthTx(cosmosTx).Hash(), nil
+}
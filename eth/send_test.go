@@ -0,0 +1,74 @@
+package eth_test
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendRawTransaction(t *testing.T) {
+	chainID := monomer.ChainID(901)
+	app := testapp.NewTest(t, chainID.String())
+	n := testutils.NewInstantNode(t, app, &genesis.Genesis{
+		ChainID:  chainID,
+		AppState: testapp.MakeGenesisAppState(t, app),
+	})
+	client, err := rpc.DialHTTP("http://" + n.EngineAddr())
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	send := func(tx *ethtypes.Transaction) (common.Hash, error) {
+		var hash common.Hash
+		return hash, client.CallContext(context.Background(), &hash, "eth_sendRawTransaction", hexutil.Bytes(testutils.TxToBytes(t, tx)))
+	}
+
+	// The wrapper's other fields and signature are ignored.
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	cosmosTx := testapp.ToTestTx(t, "k", "v")
+	wrapper, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID.Big()), &ethtypes.DynamicFeeTx{
+		ChainID: chainID.Big(),
+		Nonce:   1,
+		Gas:     21000,
+		Value:   big.NewInt(1),
+		Data:    cosmosTx,
+	})
+	require.NoError(t, err)
+	hash, err := send(wrapper)
+	require.NoError(t, err)
+	require.Equal(t, monomer.AdaptNonDepositCosmosTxToEthTx(cosmosTx).Hash(), hash)
+
+	block := n.BuildBlock()
+	require.Equal(t, cosmosTx, []byte(block.Txs[1]))
+	var receipt map[string]any
+	require.NoError(t, client.CallContext(context.Background(), &receipt, "eth_getTransactionReceipt", hash))
+	require.Equal(t, "0x1", receipt["status"])
+
+	_, err = send(ethtypes.NewTx(&ethtypes.DynamicFeeTx{}))
+	require.ErrorContains(t, err, "does not wrap a cosmos tx")
+	_, err = send(ethtypes.NewTx(&ethtypes.DynamicFeeTx{Data: []byte("not a cosmos tx")}))
+	require.ErrorContains(t, err, "check tx")
+	_, err = send(ethtypes.NewTx(&ethtypes.DepositTx{Data: cosmosTx}))
+	require.ErrorContains(t, err, "deposit")
+	// A signed wrapper must have a sender.
+	invalidSig := append(bytes.Repeat([]byte{0xff}, 64), 0)
+	unrecoverable, err := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID: chainID.Big(),
+		Data:    cosmosTx,
+	}).WithSignature(ethtypes.LatestSignerForChainID(chainID.Big()), invalidSig)
+	require.NoError(t, err)
+	_, err = send(unrecoverable)
+	require.ErrorContains(t, err, "recover wrapper sender")
+}
@@ -0,0 +1,189 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/eth/internal/ethapi"
+	"github.com/polymerdao/monomer/heads"
+)
+
+// PendingTxFeed notifies subscribers of the txs added to the mempool, e.g., mempool.Pool.
+type PendingTxFeed interface {
+	Subscribe() (<-chan bfttypes.Tx, func())
+}
+
+// SubscriptionAPI serves eth_subscribe and eth_unsubscribe over websockets, with the newHeads, logs, and
+// newPendingTransactions subscriptions. Subscribers that fall far behind stop receiving notifications, so they don't
+// hold up the builder or the mempool.
+type SubscriptionAPI struct {
+	headFeed   *heads.Feed
+	pendingTxs PendingTxFeed
+	blockStore DB
+	logs       *FilterAPI
+	chainID    *big.Int
+	metrics    Metrics
+}
+
+func NewSubscriptionAPI(
+	headFeed *heads.Feed,
+	pendingTxs PendingTxFeed,
+	blockStore DB,
+	logStore LogStore,
+	chainID *big.Int,
+	metrics Metrics,
+) *SubscriptionAPI {
+	return &SubscriptionAPI{
+		headFeed:   headFeed,
+		pendingTxs: pendingTxs,
+		blockStore: blockStore,
+		logs:       NewFilterAPI(blockStore, logStore, chainID, metrics),
+		chainID:    chainID,
+		metrics:    metrics,
+	}
+}
+
+// NewHeads notifies the subscriber of the header of every new unsafe head, including an older block after a rollback.
+func (e *SubscriptionAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	defer e.metrics.RecordRPCMethodCall(SubscribeMethodName, time.Now())
+
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	events, unsubscribe, err := e.headFeed.SubscribeNext(heads.EventNewHead)
+	if err != nil {
+		return nil, fmt.Errorf("subscribe to new heads: %v", err)
+	}
+	sub := notifier.CreateSubscription()
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if err := notifier.Notify(sub.ID, event.Header); err != nil {
+					return
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+// Logs notifies the subscriber of the logs matching the criteria in the blocks built after it subscribed, one
+// notification per log. The criteria's block range is ignored. As with filters, the logs of blocks removed by a
+// rollback aren't notified again as removed, but the blocks that replace them are notified when they are built.
+func (e *SubscriptionAPI) Logs(ctx context.Context, criteria filters.FilterCriteria) (*rpc.Subscription, error) { //nolint:gocritic // hugeParam
+	defer e.metrics.RecordRPCMethodCall(SubscribeMethodName, time.Now())
+
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	if criteria.BlockHash != nil {
+		return nil, errors.New("log subscriptions can't be restricted to a block hash")
+	}
+	// Subscribe before reading the head, so no block is missed.
+	events, unsubscribe, err := e.headFeed.SubscribeNext(heads.EventNewHead)
+	if err != nil {
+		return nil, fmt.Errorf("subscribe to new heads: %v", err)
+	}
+	head, err := e.blockStore.HeadBlock()
+	if err != nil {
+		unsubscribe()
+		return nil, fmt.Errorf("get head block: %v", err)
+	}
+	sub := notifier.CreateSubscription()
+	go func() {
+		defer unsubscribe()
+		// next is the height of the first block whose logs haven't been notified.
+		next := head.Header.Height + 1
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				height := event.Header.Number.Uint64()
+				if height < next {
+					// After a rollback, the rebuilt blocks are notified as they are built.
+					next = height + 1
+					continue
+				}
+				// At most MaxLogsBlockRange blocks are scanned, even if the head jumped further.
+				from := next
+				if height-from >= MaxLogsBlockRange {
+					from = height - MaxLogsBlockRange + 1
+				}
+				next = height + 1
+				logs, err := e.logs.logsInRange(&criteria, from, height)
+				if err != nil {
+					return
+				}
+				if err := notifyLogs(notifier, sub, logs); err != nil {
+					return
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
+
+func notifyLogs(notifier *rpc.Notifier, sub *rpc.Subscription, logs []*ethtypes.Log) error {
+	for _, log := range logs {
+		if err := notifier.Notify(sub.ID, log); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewPendingTransactions notifies the subscriber of the txs added to the node's mempool: their hashes, or the txs
+// themselves if fullTx is true. Txs a node forwards to the sequencer aren't added to its mempool.
+func (e *SubscriptionAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (*rpc.Subscription, error) {
+	defer e.metrics.RecordRPCMethodCall(SubscribeMethodName, time.Now())
+
+	notifier, ok := rpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	txs, unsubscribe := e.pendingTxs.Subscribe()
+	sub := notifier.CreateSubscription()
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case tx, ok := <-txs:
+				if !ok {
+					return
+				}
+				ethTx := monomer.AdaptNonDepositCosmosTxToEthTx(tx)
+				var notification any = ethTx.Hash()
+				if fullTx != nil && *fullTx {
+					notification = ethapi.SimpleRPCPendingTransaction(ethTx, e.chainID)
+				}
+				if err := notifier.Notify(sub.ID, notification); err != nil {
+					return
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	return sub, nil
+}
@@ -0,0 +1,83 @@
+package eth_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/eth"
+	"github.com/polymerdao/monomer/heads"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a notification")
+	}
+	panic("unreachable")
+}
+
+func TestSubscriptions(t *testing.T) {
+	db := testutils.NewLocalMemDB(t)
+	store := &logStore{
+		txStore: txStore{},
+		blooms:  make(map[uint64]ethtypes.Bloom),
+	}
+	block0 := appendBlock(t, db, store, nil)
+	require.NoError(t, db.UpdateLabels(block0.Header.Hash, block0.Header.Hash, block0.Header.Hash))
+	feed := heads.NewFeed()
+	t.Cleanup(feed.Close)
+	blockStore, err := heads.NewBlockStore(db, feed)
+	require.NoError(t, err)
+	pool := mempool.New(testutils.NewMemDB(t))
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", eth.NewSubscriptionAPI(feed, pool, blockStore, store, big.NewInt(1), eth.NewNoopMetrics())))
+	t.Cleanup(server.Stop)
+	client := rpc.DialInProc(server)
+	t.Cleanup(client.Close)
+	ctx := context.Background()
+
+	newHeads := make(chan *ethtypes.Header, 1)
+	headsSub, err := client.EthSubscribe(ctx, newHeads, "newHeads")
+	require.NoError(t, err)
+	defer headsSub.Unsubscribe()
+	transferTopic := crypto.Keccak256Hash([]byte("transfer"))
+	logs := make(chan ethtypes.Log, 1)
+	logsSub, err := client.EthSubscribe(ctx, logs, "logs", map[string]any{
+		"topics": [][]common.Hash{{transferTopic}},
+	})
+	require.NoError(t, err)
+	defer logsSub.Unsubscribe()
+	pendingTxs := make(chan common.Hash, 1)
+	pendingSub, err := client.EthSubscribe(ctx, pendingTxs, "newPendingTransactions")
+	require.NoError(t, err)
+	defer pendingSub.Unsubscribe()
+
+	// The current head isn't notified, only the blocks built after subscribing.
+	block1 := appendBlock(t, db, store, block0.Header, abcitypes.Event{Type: "message"}, abcitypes.Event{Type: "transfer"})
+	require.NoError(t, blockStore.UpdateLabels(block1.Header.Hash, block0.Header.Hash, block0.Header.Hash))
+	require.Equal(t, block1.Header.Hash, receive(t, newHeads).Hash())
+	log := receive(t, logs)
+	require.Equal(t, transferTopic, log.Topics[0])
+	require.Equal(t, block1.Header.Hash, log.BlockHash)
+	require.Equal(t, block1.Header.Height, log.BlockNumber)
+	require.Empty(t, logs)
+
+	tx := bfttypes.Tx("pending")
+	require.NoError(t, pool.Enqueue(tx))
+	require.Equal(t, monomer.AdaptNonDepositCosmosTxToEthTx(tx).Hash(), receive(t, pendingTxs))
+}
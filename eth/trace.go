@@ -0,0 +1,162 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/monomerdb"
+)
+
+// CallTracer is the name of the only tracer supported by the TraceAPI, besides the default struct logger.
+const CallTracer = "callTracer"
+
+// TraceConfig is the subset of go-ethereum's tracer config the TraceAPI understands. Other fields are ignored.
+type TraceConfig struct {
+	Tracer *string `json:"tracer"`
+}
+
+// CallFrame is the output of go-ethereum's callTracer.
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+}
+
+// StructLogResult is the output of go-ethereum's default struct logger.
+type StructLogResult struct {
+	Gas         hexutil.Uint64 `json:"gas"`
+	Failed      bool           `json:"failed"`
+	ReturnValue string         `json:"returnValue"`
+	StructLogs  []any          `json:"structLogs"`
+}
+
+// TxTraceResult is the trace of a single tx in a block trace.
+type TxTraceResult struct {
+	TxHash common.Hash `json:"txHash"`
+	Result any         `json:"result"`
+}
+
+// TraceAPI serves the debug_trace* methods block explorers use to index internal transactions.
+//
+// Monomer doesn't execute Ethereum txs, so there is no EVM execution to trace: every trace consists of the top-level call alone.
+type TraceAPI struct {
+	blockStore DB
+	txStore    TxStore
+	chainID    *big.Int
+	metrics    Metrics
+}
+
+func NewTraceAPI(blockStore DB, txStore TxStore, chainID *big.Int, metrics Metrics) *TraceAPI {
+	return &TraceAPI{
+		blockStore: blockStore,
+		txStore:    txStore,
+		chainID:    chainID,
+		metrics:    metrics,
+	}
+}
+
+func (e *TraceAPI) TraceTransaction(hash common.Hash, config *TraceConfig) (any, error) {
+	defer e.metrics.RecordRPCMethodCall(TraceTransactionMethodName, time.Now())
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	txs, index, err := lookupTx(e.blockStore, e.txStore, e.chainID, hash)
+	if err != nil {
+		return nil, err
+	} else if txs == nil {
+		return nil, fmt.Errorf("transaction %s %w", hash, ethereum.NotFound)
+	}
+	return txs[index].trace(config), nil
+}
+
+func (e *TraceAPI) TraceBlockByNumber(id BlockID, config *TraceConfig) ([]*TxTraceResult, error) {
+	defer e.metrics.RecordRPCMethodCall(TraceBlockByNumberMethodName, time.Now())
+
+	block, err := id.Get(e.blockStore)
+	if errors.Is(err, monomerdb.ErrNotFound) {
+		return nil, ethereum.NotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return e.traceBlock(block, config)
+}
+
+func (e *TraceAPI) TraceBlockByHash(hash common.Hash, config *TraceConfig) ([]*TxTraceResult, error) {
+	defer e.metrics.RecordRPCMethodCall(TraceBlockByHashMethodName, time.Now())
+
+	block, err := e.blockStore.BlockByHash(hash)
+	if errors.Is(err, monomerdb.ErrNotFound) {
+		return nil, ethereum.NotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return e.traceBlock(block, config)
+}
+
+func (e *TraceAPI) traceBlock(block *monomer.Block, config *TraceConfig) ([]*TxTraceResult, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	txs, err := executedTxs(block, e.txStore, e.chainID)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*TxTraceResult, 0, len(txs))
+	for _, tx := range txs {
+		results = append(results, &TxTraceResult{
+			TxHash: tx.tx.Hash(),
+			Result: tx.trace(config),
+		})
+	}
+	return results, nil
+}
+
+func (c *TraceConfig) isCallTracer() bool {
+	return c != nil && c.Tracer != nil && *c.Tracer == CallTracer
+}
+
+func (c *TraceConfig) validate() error {
+	if c == nil || c.Tracer == nil || *c.Tracer == "" || *c.Tracer == CallTracer {
+		return nil
+	}
+	return fmt.Errorf("tracer %q is not supported; use %q or the default struct logger", *c.Tracer, CallTracer)
+}
+
+func (tx *executedTx) trace(config *TraceConfig) any {
+	if !config.isCallTracer() {
+		return &StructLogResult{
+			Gas:        hexutil.Uint64(tx.gasUsed),
+			Failed:     tx.status() == ethtypes.ReceiptStatusFailed,
+			StructLogs: []any{},
+		}
+	}
+	frame := &CallFrame{
+		Type:    "CALL",
+		From:    tx.rpcTx.From,
+		To:      tx.rpcTx.To,
+		Value:   tx.rpcTx.Value,
+		Gas:     tx.rpcTx.Gas,
+		GasUsed: hexutil.Uint64(tx.gasUsed),
+		Input:   tx.rpcTx.Input,
+		Output:  hexutil.Bytes{},
+	}
+	if !tx.result.IsOK() {
+		frame.Error = tx.result.Log
+	}
+	return frame
+}
@@ -0,0 +1,357 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/eth/internal/ethapi"
+	"github.com/polymerdao/monomer/ethlog"
+	"github.com/polymerdao/monomer/monomerdb"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+)
+
+// TxStore looks up the results of Cosmos txs by their canonical hash or the hash of an Ethereum tx that represents them.
+type TxStore interface {
+	Get(hash []byte) (*abcitypes.TxResult, error)
+}
+
+type TxAPI struct {
+	blockStore DB
+	txStore    TxStore
+	chainID    *big.Int
+	metrics    Metrics
+}
+
+func NewTxAPI(blockStore DB, txStore TxStore, chainID *big.Int, metrics Metrics) *TxAPI {
+	return &TxAPI{
+		blockStore: blockStore,
+		txStore:    txStore,
+		chainID:    chainID,
+		metrics:    metrics,
+	}
+}
+
+// GetTransactionByHash returns the transaction with the given Ethereum tx hash, or nil if it doesn't exist.
+func (e *TxAPI) GetTransactionByHash(hash common.Hash) (*ethapi.RPCTransaction, error) {
+	defer e.metrics.RecordRPCMethodCall(GetTransactionByHashMethodName, time.Now())
+
+	txs, index, err := lookupTx(e.blockStore, e.txStore, e.chainID, hash)
+	if err != nil || txs == nil {
+		return nil, err
+	}
+	return txs[index].rpcTx, nil
+}
+
+// GetTransactionReceipt returns the receipt of the transaction with the given Ethereum tx hash, or nil if it doesn't exist.
+func (e *TxAPI) GetTransactionReceipt(hash common.Hash) (map[string]any, error) {
+	defer e.metrics.RecordRPCMethodCall(GetTransactionReceiptMethodName, time.Now())
+
+	txs, index, err := lookupTx(e.blockStore, e.txStore, e.chainID, hash)
+	if err != nil || txs == nil {
+		return nil, err
+	}
+	return txs[index].receipt(), nil
+}
+
+// GetBlockReceipts returns the receipts of all transactions in the block.
+func (e *TxAPI) GetBlockReceipts(id BlockID) ([]map[string]any, error) {
+	defer e.metrics.RecordRPCMethodCall(GetBlockReceiptsMethodName, time.Now())
+
+	block, err := id.Get(e.blockStore)
+	if errors.Is(err, monomerdb.ErrNotFound) {
+		return nil, ethereum.NotFound
+	} else if err != nil {
+		return nil, err
+	}
+	txs, err := executedTxs(block, e.txStore, e.chainID)
+	if err != nil {
+		return nil, err
+	}
+	receipts := make([]map[string]any, 0, len(txs))
+	for _, tx := range txs {
+		receipts = append(receipts, tx.receipt())
+	}
+	return receipts, nil
+}
+
+// executedTx is an Ethereum tx in a block along with the result of the Cosmos tx that contains it.
+type executedTx struct {
+	tx    *ethtypes.Transaction
+	rpcTx *ethapi.RPCTransaction
+	// result is shared by all deposit txs, which are applied by the same Cosmos tx.
+	result            *abcitypes.ExecTxResult
+	gasUsed           uint64
+	cumulativeGasUsed uint64
+	// depositNonce is the nonce x/rollup assigned to a deposit tx, or nil if the tx isn't a deposit or the deposits failed.
+	depositNonce *uint64
+	logs         []*ethtypes.Log
+}
+
+// lookupTx returns the executed txs of the block containing the Ethereum tx with the given hash and the tx's index in the block.
+// It returns nil txs if the tx doesn't exist.
+func lookupTx(blockStore DB, txStore TxStore, chainID *big.Int, hash common.Hash) ([]*executedTx, int, error) {
+	result, err := txStore.Get(hash.Bytes())
+	if err != nil {
+		return nil, 0, fmt.Errorf("get tx result: %v", err)
+	} else if result == nil {
+		return nil, 0, nil
+	}
+	block, err := blockStore.BlockByHeight(uint64(result.Height))
+	if err != nil {
+		return nil, 0, fmt.Errorf("get block by height (%d): %v", result.Height, err)
+	}
+	txs, err := executedTxs(block, txStore, chainID)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i, tx := range txs {
+		if tx.tx.Hash() == hash {
+			return txs, i, nil
+		}
+	}
+	// The hash is the canonical hash of a Cosmos tx, which the eth namespace doesn't know about.
+	return nil, 0, nil
+}
+
+// executedTxs returns the txs in the block's Ethereum representation.
+//
+// Monomer doesn't execute Ethereum txs, so gas is accounted for in Cosmos gas: each non-deposit tx uses the gas of its Cosmos tx
+// and deposit txs use no gas.
+func executedTxs(block *monomer.Block, txStore TxStore, chainID *big.Int) ([]*executedTx, error) {
+	ethBlock, err := block.ToEth()
+	if err != nil {
+		return nil, fmt.Errorf("convert to eth block: %v", err)
+	}
+	ethTxs := ethBlock.Transactions()
+	numDeposits := len(ethTxs) - max(block.Txs.Len()-1, 0)
+	txs := make([]*executedTx, 0, len(ethTxs))
+	var cumulativeGasUsed uint64
+	for i, ethTx := range ethTxs {
+		cosmosTx := block.Txs[0]
+		if i >= numDeposits {
+			cosmosTx = block.Txs[i-numDeposits+1]
+		}
+		result, err := txStore.Get(cosmosTx.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("get tx result: %v", err)
+		} else if result == nil {
+			return nil, fmt.Errorf("tx result %w", ethereum.NotFound)
+		}
+		var gasUsed uint64
+		if ethTx.Type() != ethtypes.DepositTxType {
+			gasUsed = uint64(result.Result.GasUsed)
+		}
+		cumulativeGasUsed += gasUsed
+		tx := &executedTx{
+			tx:                ethTx,
+			rpcTx:             ethapi.SimpleRPCTransaction(ethBlock, uint64(i), chainID),
+			result:            &result.Result,
+			gasUsed:           gasUsed,
+			cumulativeGasUsed: cumulativeGasUsed,
+		}
+		if ethTx.Type() == ethtypes.DepositTxType {
+			tx.setDepositNonce()
+		}
+		txs = append(txs, tx)
+	}
+	if err := setLogs(txs, block.Header.Height, block.Header.Hash); err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+// setLogs sets the logs of the txs in a block, numbered in the order of the txs. The events of the Cosmos tx that
+// applies the deposits are logs of the deposit tx whose hash they carry, or of the L1 attributes tx if they carry none.
+func setLogs(txs []*executedTx, height uint64, blockHash common.Hash) error {
+	depositIndexes := make(map[common.Hash]int)
+	for i, tx := range txs {
+		if tx.tx.IsDepositTx() {
+			depositIndexes[tx.tx.Hash()] = i
+		}
+	}
+	for i, tx := range txs {
+		if !tx.tx.IsDepositTx() {
+			logs, err := ethlog.FromTxResult(tx.result)
+			if err != nil {
+				return fmt.Errorf("logs of tx %s: %v", tx.tx.Hash(), err)
+			}
+			tx.logs = append(tx.logs, logs...)
+			continue
+		}
+		// The deposit txs share the result of the first one.
+		if i > 0 || !tx.result.IsOK() {
+			continue
+		}
+		for j := range tx.result.Events {
+			event := &tx.result.Events[j]
+			log, err := ethlog.FromEvent(event)
+			if err != nil {
+				return fmt.Errorf("logs of deposit txs: %v", err)
+			}
+			owner := tx
+			if depositIndex, ok := depositIndexes[eventTxHash(event)]; ok {
+				owner = txs[depositIndex]
+			}
+			owner.logs = append(owner.logs, log)
+		}
+	}
+
+	var logIndex uint
+	for i, tx := range txs {
+		for _, log := range tx.logs {
+			log.BlockNumber = height
+			log.BlockHash = blockHash
+			log.TxHash = tx.tx.Hash()
+			log.TxIndex = uint(i)
+			log.Index = logIndex
+			logIndex++
+		}
+	}
+	return nil
+}
+
+// eventTxHash returns the value of the event's tx hash attribute, or the zero hash if it has none.
+func eventTxHash(event *abcitypes.Event) common.Hash {
+	for _, attr := range event.Attributes {
+		if attr.Key == rolluptypes.AttributeKeyTxHash {
+			return common.HexToHash(attr.Value)
+		}
+	}
+	return common.Hash{}
+}
+
+// setDepositNonce sets the deposit tx's nonce from the deposit event x/rollup emitted for it.
+func (tx *executedTx) setDepositNonce() {
+	if nonce, ok := depositNonce(tx.result, tx.tx.Hash()); ok {
+		tx.depositNonce = &nonce
+		setDepositNonce(tx.rpcTx, nonce)
+	}
+}
+
+// depositNonce returns the nonce in the deposit event x/rollup emitted for the deposit tx with the given hash.
+func depositNonce(result *abcitypes.ExecTxResult, hash common.Hash) (uint64, bool) {
+	for _, event := range result.Events {
+		if event.Type != rolluptypes.EventTypeDeposit {
+			continue
+		}
+		var txHash common.Hash
+		var nonce *uint64
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case rolluptypes.AttributeKeyTxHash:
+				txHash = common.HexToHash(attr.Value)
+			case rolluptypes.AttributeKeyNonce:
+				if n, err := hexutil.DecodeUint64(attr.Value); err == nil {
+					nonce = &n
+				}
+			}
+		}
+		if txHash == hash && nonce != nil {
+			return *nonce, true
+		}
+	}
+	return 0, false
+}
+
+// setDepositNonce sets the fields op-geth sets on the RPC representation of deposit txs after Canyon.
+func setDepositNonce(rpcTx *ethapi.RPCTransaction, nonce uint64) {
+	rpcTx.Nonce = hexutil.Uint64(nonce)
+	version := hexutil.Uint64(ethtypes.CanyonDepositReceiptVersion)
+	rpcTx.DepositReceiptVersion = &version
+}
+
+// status returns the status of the tx's receipt. A deposit tx whose execution failed has a failed status even though its
+// Cosmos tx succeeded, as in op-geth.
+func (tx *executedTx) status() uint64 {
+	if !tx.result.IsOK() || (tx.tx.IsDepositTx() && depositFailed(tx.result, tx.tx.Hash())) {
+		return ethtypes.ReceiptStatusFailed
+	}
+	return ethtypes.ReceiptStatusSuccessful
+}
+
+// depositFailed reports whether x/rollup emitted a deposit failed event for the deposit tx with the given hash.
+func depositFailed(result *abcitypes.ExecTxResult, hash common.Hash) bool {
+	for _, event := range result.Events {
+		if event.Type != rolluptypes.EventTypeDepositFailed {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == rolluptypes.AttributeKeyTxHash && common.HexToHash(attr.Value) == hash {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// receipt returns the RPC representation of the tx's receipt.
+// Cosmos txs don't create contracts, so contractAddress is always null. Their events are logs, see package ethlog.
+// Receipts of deposit txs have the depositNonce and depositReceiptVersion fields, as in op-geth.
+// Receipts of sponsored txs have an extra sponsor field with the address of the account that paid the fee.
+func (tx *executedTx) receipt() map[string]any {
+	gasPrice := tx.rpcTx.GasPrice
+	if gasPrice == nil {
+		gasPrice = new(hexutil.Big)
+	}
+	receipt := map[string]any{
+		"blockHash":         tx.rpcTx.BlockHash,
+		"blockNumber":       tx.rpcTx.BlockNumber,
+		"transactionHash":   tx.rpcTx.Hash,
+		"transactionIndex":  tx.rpcTx.TransactionIndex,
+		"from":              tx.rpcTx.From,
+		"to":                tx.rpcTx.To,
+		"gasUsed":           hexutil.Uint64(tx.gasUsed),
+		"cumulativeGasUsed": hexutil.Uint64(tx.cumulativeGasUsed),
+		"effectiveGasPrice": gasPrice,
+		"contractAddress":   nil,
+		"logs":              tx.rpcLogs(),
+		"logsBloom":         tx.logsBloom(),
+		"type":              tx.rpcTx.Type,
+		"status":            hexutil.Uint64(tx.status()),
+	}
+	if tx.depositNonce != nil {
+		receipt["depositNonce"] = hexutil.Uint64(*tx.depositNonce)
+		receipt["depositReceiptVersion"] = hexutil.Uint64(ethtypes.CanyonDepositReceiptVersion)
+	}
+	if sponsor, ok := tx.sponsor(); ok {
+		receipt["sponsor"] = sponsor
+	}
+	return receipt
+}
+
+// sponsor returns the x/rollup sponsor that paid the tx's fee on behalf of its sender, if any.
+func (tx *executedTx) sponsor() (string, bool) {
+	for _, event := range tx.result.Events {
+		if event.Type != rolluptypes.EventTypeSponsoredFee {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == rolluptypes.AttributeKeySponsor {
+				return attr.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// rpcLogs returns the tx's logs, or an empty list if it has none, as in op-geth.
+func (tx *executedTx) rpcLogs() []*ethtypes.Log {
+	if tx.logs == nil {
+		return []*ethtypes.Log{}
+	}
+	return tx.logs
+}
+
+func (tx *executedTx) logsBloom() ethtypes.Bloom {
+	var bloom ethtypes.Bloom
+	ethlog.AddToBloom(&bloom, tx.logs)
+	return bloom
+}
@@ -0,0 +1,107 @@
+package eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer/eth"
+	"github.com/polymerdao/monomer/testutils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+type txStore map[string]*abcitypes.TxResult
+
+func (s txStore) Get(hash []byte) (*abcitypes.TxResult, error) {
+	return s[string(hash)], nil
+}
+
+func TestReceiptSponsor(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	block := testutils.GenerateBlockWithParentAndTxs(t, nil, bfttypes.Tx("unsponsored"), bfttypes.Tx("sponsored"))
+	require.NoError(t, blockStore.AppendBlock(block))
+
+	sponsor := "cosmos1sponsor"
+	results := txStore{}
+	for _, tx := range block.Txs {
+		results[string(tx.Hash())] = &abcitypes.TxResult{Height: int64(block.Header.Height)}
+	}
+	results[string(block.Txs[2].Hash())].Result.Events = []abcitypes.Event{{
+		Type:       rolluptypes.EventTypeSponsoredFee,
+		Attributes: []abcitypes.EventAttribute{{Key: rolluptypes.AttributeKeySponsor, Value: sponsor}},
+	}}
+
+	receipts, err := eth.NewTxAPI(blockStore, results, big.NewInt(1), eth.NewNoopMetrics()).GetBlockReceipts(eth.BlockID{})
+	require.NoError(t, err)
+	require.Len(t, receipts, 3) // The L1 attributes deposit and the two Cosmos txs.
+	require.NotContains(t, receipts[0], "sponsor")
+	require.NotContains(t, receipts[1], "sponsor")
+	require.Equal(t, sponsor, receipts[2]["sponsor"])
+}
+
+func TestReceiptDepositNonce(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	block := testutils.GenerateBlockWithParentAndTxs(t, nil, bfttypes.Tx("cosmos tx"))
+	require.NoError(t, blockStore.AppendBlock(block))
+	ethBlock, err := block.ToEth()
+	require.NoError(t, err)
+	depositHash := ethBlock.Transactions()[0].Hash()
+
+	results := txStore{}
+	for _, tx := range block.Txs {
+		results[string(tx.Hash())] = &abcitypes.TxResult{Height: int64(block.Header.Height)}
+	}
+	results[string(block.Txs[0].Hash())].Result.Events = []abcitypes.Event{{
+		Type: rolluptypes.EventTypeDeposit,
+		Attributes: []abcitypes.EventAttribute{
+			{Key: rolluptypes.AttributeKeyTxHash, Value: depositHash.Hex()},
+			{Key: rolluptypes.AttributeKeyNonce, Value: "0x5"},
+		},
+	}}
+	results[string(depositHash.Bytes())] = results[string(block.Txs[0].Hash())]
+	txAPI := eth.NewTxAPI(blockStore, results, big.NewInt(1), eth.NewNoopMetrics())
+
+	receipts, err := txAPI.GetBlockReceipts(eth.BlockID{})
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	require.Equal(t, hexutil.Uint64(5), receipts[0]["depositNonce"])
+	require.Equal(t, hexutil.Uint64(ethtypes.CanyonDepositReceiptVersion), receipts[0]["depositReceiptVersion"])
+	require.NotContains(t, receipts[1], "depositNonce")
+	require.NotContains(t, receipts[1], "depositReceiptVersion")
+
+	rpcTx, err := txAPI.GetTransactionByHash(depositHash)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(5), rpcTx.Nonce)
+	require.Equal(t, hexutil.Uint64(ethtypes.CanyonDepositReceiptVersion), *rpcTx.DepositReceiptVersion)
+}
+
+func TestReceiptFailedDeposit(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	block := testutils.GenerateBlockWithParentAndTxs(t, nil, bfttypes.Tx("cosmos tx"))
+	require.NoError(t, blockStore.AppendBlock(block))
+	ethBlock, err := block.ToEth()
+	require.NoError(t, err)
+	depositHash := ethBlock.Transactions()[0].Hash()
+
+	results := txStore{}
+	for _, tx := range block.Txs {
+		results[string(tx.Hash())] = &abcitypes.TxResult{Height: int64(block.Header.Height)}
+	}
+	results[string(block.Txs[0].Hash())].Result.Events = []abcitypes.Event{{
+		Type: rolluptypes.EventTypeDepositFailed,
+		Attributes: []abcitypes.EventAttribute{
+			{Key: rolluptypes.AttributeKeyTxHash, Value: depositHash.Hex()},
+			{Key: rolluptypes.AttributeKeyReason, Value: "contract creation deposits are not supported"},
+		},
+	}}
+
+	receipts, err := eth.NewTxAPI(blockStore, results, big.NewInt(1), eth.NewNoopMetrics()).GetBlockReceipts(eth.BlockID{})
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	require.Equal(t, hexutil.Uint64(ethtypes.ReceiptStatusFailed), receipts[0]["status"])
+	require.Equal(t, hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), receipts[1]["status"])
+}
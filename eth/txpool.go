@@ -0,0 +1,81 @@
+package eth
+
+import (
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/eth/internal/ethapi"
+	"github.com/polymerdao/monomer/mempool"
+)
+
+// TxPool lists the txs in the mempool in the order they are dequeued, e.g., mempool.Pool.
+type TxPool interface {
+	Pending(limit int) ([]*mempool.PendingTx, error)
+}
+
+// TxPoolAPI serves the txpool namespace, which tooling calls to inspect the mempool.
+//
+// Like geth, txs are grouped by sender and nonce, which are the address and sequence of a Cosmos tx's first signer.
+// The mempool doesn't know the accounts' sequences, so every tx is pending and none is queued.
+type TxPoolAPI struct {
+	pool    TxPool
+	chainID *big.Int
+	metrics Metrics
+}
+
+func NewTxPoolAPI(pool TxPool, chainID *big.Int, metrics Metrics) *TxPoolAPI {
+	return &TxPoolAPI{
+		pool:    pool,
+		chainID: chainID,
+		metrics: metrics,
+	}
+}
+
+// Status returns the number of pending and queued txs.
+func (e *TxPoolAPI) Status() (map[string]hexutil.Uint, error) {
+	defer e.metrics.RecordRPCMethodCall(TxPoolStatusMethodName, time.Now())
+
+	pending, err := e.pool.Pending(0)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]hexutil.Uint{
+		"pending": hexutil.Uint(len(pending)),
+		"queued":  0,
+	}, nil
+}
+
+// Content returns the pending and queued txs, by sender and nonce. Txs without a signer have no sender, so they are
+// counted by txpool_status but not listed.
+func (e *TxPoolAPI) Content() (map[string]map[string]map[string]*ethapi.RPCTransaction, error) {
+	defer e.metrics.RecordRPCMethodCall(TxPoolContentMethodName, time.Now())
+
+	pending, err := e.pool.Pending(0)
+	if err != nil {
+		return nil, err
+	}
+	content := map[string]map[string]map[string]*ethapi.RPCTransaction{
+		"pending": make(map[string]map[string]*ethapi.RPCTransaction),
+		"queued":  make(map[string]map[string]*ethapi.RPCTransaction),
+	}
+	for _, pendingTx := range pending {
+		if pendingTx.Info.Sender == nil {
+			continue
+		}
+		rpcTx := ethapi.SimpleRPCPendingTransaction(monomer.AdaptNonDepositCosmosTxToEthTx(pendingTx.Tx), e.chainID)
+		rpcTx.From = common.BytesToAddress(pendingTx.Info.Sender)
+		rpcTx.Nonce = hexutil.Uint64(pendingTx.Info.Sequence)
+		rpcTx.Gas = hexutil.Uint64(pendingTx.Info.Gas)
+
+		sender := rpcTx.From.Hex()
+		if content["pending"][sender] == nil {
+			content["pending"][sender] = make(map[string]*ethapi.RPCTransaction)
+		}
+		content["pending"][sender][strconv.FormatUint(pendingTx.Info.Sequence, 10)] = rpcTx
+	}
+	return content, nil
+}
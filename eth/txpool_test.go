@@ -0,0 +1,62 @@
+package eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/eth"
+	"github.com/polymerdao/monomer/eth/internal/ethapi"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxPool(t *testing.T) {
+	sender := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	pool := mempool.New(testutils.NewMemDB(t))
+	pool.SetTxInfoFunc(func(tx bfttypes.Tx) (*mempool.TxInfo, error) {
+		if string(tx) == "unsigned" {
+			return &mempool.TxInfo{}, nil
+		}
+		return &mempool.TxInfo{
+			Sender:   sender.Bytes(),
+			Sequence: uint64(tx[len(tx)-1]),
+			Fee:      big.NewInt(1),
+			Gas:      100,
+		}, nil
+	})
+	signedTxs := bfttypes.Txs{bfttypes.Tx("signed\x04"), bfttypes.Tx("signed\x05")}
+	for _, tx := range append(bfttypes.Txs{bfttypes.Tx("unsigned")}, signedTxs...) {
+		require.NoError(t, pool.Enqueue(tx))
+	}
+
+	chainID := big.NewInt(901)
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("txpool", eth.NewTxPoolAPI(pool, chainID, eth.NewNoopMetrics())))
+	t.Cleanup(server.Stop)
+	client := rpc.DialInProc(server)
+	t.Cleanup(client.Close)
+
+	var status map[string]hexutil.Uint
+	require.NoError(t, client.Call(&status, "txpool_status"))
+	require.Equal(t, map[string]hexutil.Uint{"pending": 3, "queued": 0}, status)
+
+	var content map[string]map[string]map[string]*ethapi.RPCTransaction
+	require.NoError(t, client.Call(&content, "txpool_content"))
+	require.Empty(t, content["queued"])
+	// The unsigned tx has no sender, so it isn't listed.
+	require.Len(t, content["pending"], 1)
+	txs := content["pending"][sender.Hex()]
+	require.Len(t, txs, 2)
+	for nonce, tx := range map[string]bfttypes.Tx{"4": signedTxs[0], "5": signedTxs[1]} {
+		require.Equal(t, monomer.AdaptNonDepositCosmosTxToEthTx(tx).Hash(), txs[nonce].Hash)
+		require.Equal(t, sender, txs[nonce].From)
+		require.Equal(t, hexutil.Uint64(100), txs[nonce].Gas)
+	}
+	require.Equal(t, hexutil.Uint64(4), txs["4"].Nonce)
+}
@@ -0,0 +1,188 @@
+// Package ethlog represents the events Cosmos txs emit as Ethereum logs, so Ethereum tooling can query them through the
+// eth namespace. Logs aren't stored: they are derived from the tx results whenever they are needed, so every node
+// derives the same logs. Only the logs bloom of each block is indexed, to skip blocks that can't match a query.
+//
+// Withdrawals the builder registered are represented by the MessagePassed logs the L2ToL1MessagePasser emits on OP
+// Stack chains. Other events, including withdrawal events the builder didn't register, are logs of CosmosEventsAddress:
+// the first topic is the Keccak-256 hash of the event type, the next topics are the hashes of the values of the first
+// three attributes, as Solidity indexes string parameters, and the data is the ABI-encoded
+// (string[] keys, string[] values) of all attributes.
+package ethlog
+
+import (
+	"fmt"
+	"math/big"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	bindings "github.com/polymerdao/monomer/bindings/generated"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+)
+
+// CosmosEventsAddress is the address of the logs that represent Cosmos events, next to the precompiles.
+var CosmosEventsAddress = common.HexToAddress("0x0000000000000000000000000000000000000c00")
+
+// maxIndexedAttributes is the number of attribute values in the topics of a Cosmos event log.
+const maxIndexedAttributes = 3
+
+const messagePassedEventName = "MessagePassed"
+
+var (
+	stringArrayType, _ = abi.NewType("string[]", "", nil)
+	// cosmosEventData are the fields of the data of a Cosmos event log.
+	cosmosEventData = abi.Arguments{{Type: stringArrayType}, {Type: stringArrayType}}
+)
+
+// FromTxResult returns the logs of the events of a tx, in the order they were emitted. Failed txs have no logs, as in
+// Ethereum, even though Cosmos keeps the events of their ante handlers. The logs' block and tx fields aren't set.
+func FromTxResult(result *abcitypes.ExecTxResult) ([]*ethtypes.Log, error) {
+	if !result.IsOK() {
+		return nil, nil
+	}
+	logs := make([]*ethtypes.Log, 0, len(result.Events))
+	for i := range result.Events {
+		log, err := FromEvent(&result.Events[i])
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+// FromEvent returns the log that represents the event. The log's block and tx fields aren't set.
+func FromEvent(event *abcitypes.Event) (*ethtypes.Log, error) {
+	// Only the withdrawals the builder registered have a nonce. Other withdrawal events, e.g., forged by another module,
+	// must not look like withdrawals to Ethereum tooling.
+	if event.Type == rolluptypes.EventTypeWithdrawalInitiated && hasAttribute(event, rolluptypes.AttributeKeyNonce) {
+		log, err := messagePassedLog(event)
+		if err != nil {
+			return nil, fmt.Errorf("%s event: %v", event.Type, err)
+		}
+		return log, nil
+	}
+
+	topics := []common.Hash{crypto.Keccak256Hash([]byte(event.Type))}
+	keys := make([]string, 0, len(event.Attributes))
+	values := make([]string, 0, len(event.Attributes))
+	for i, attr := range event.Attributes {
+		if i < maxIndexedAttributes {
+			topics = append(topics, crypto.Keccak256Hash([]byte(attr.Value)))
+		}
+		keys = append(keys, attr.Key)
+		values = append(values, attr.Value)
+	}
+	data, err := cosmosEventData.Pack(keys, values)
+	if err != nil {
+		return nil, fmt.Errorf("pack %s event attributes: %v", event.Type, err)
+	}
+	return &ethtypes.Log{
+		Address: CosmosEventsAddress,
+		Topics:  topics,
+		Data:    data,
+	}, nil
+}
+
+// messagePassedLog returns the MessagePassed log the L2ToL1MessagePasser emits for the withdrawal in a
+// withdrawal_initiated event. The builder adds the message nonce to the event when it registers the withdrawal.
+func messagePassedLog(event *abcitypes.Event) (*ethtypes.Log, error) {
+	var nonce, value, gasLimit *big.Int
+	var sender, target common.Address
+	var data []byte
+	var withdrawalHash common.Hash
+	for _, attr := range event.Attributes {
+		var err error
+		switch attr.Key {
+		case rolluptypes.AttributeKeyNonce:
+			nonce, err = decodeBig(attr.Value)
+		case rolluptypes.AttributeKeySender:
+			var senderAddress sdk.AccAddress
+			if senderAddress, err = sdk.AccAddressFromBech32(attr.Value); err == nil {
+				sender = common.BytesToAddress(senderAddress)
+			}
+		case rolluptypes.AttributeKeyL1Target:
+			target = common.HexToAddress(attr.Value)
+		case rolluptypes.AttributeKeyValue:
+			value, err = decodeBig(attr.Value)
+		case rolluptypes.AttributeKeyGasLimit:
+			gasLimit, err = decodeBig(attr.Value)
+		case rolluptypes.AttributeKeyData:
+			data, err = hexutil.Decode(attr.Value)
+		case rolluptypes.AttributeKeyWithdrawalHash:
+			withdrawalHash = common.HexToHash(attr.Value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decode %s attribute: %v", attr.Key, err)
+		}
+	}
+	if value == nil || gasLimit == nil {
+		return nil, fmt.Errorf("missing %s or %s attribute", rolluptypes.AttributeKeyValue, rolluptypes.AttributeKeyGasLimit)
+	}
+
+	messagePasserABI, err := bindings.L2ToL1MessagePasserMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("get L2ToL1MessagePasser abi: %v", err)
+	}
+	messagePassed := messagePasserABI.Events[messagePassedEventName]
+	logData, err := messagePassed.Inputs.NonIndexed().Pack(value, gasLimit, data, withdrawalHash)
+	if err != nil {
+		return nil, fmt.Errorf("pack %s data: %v", messagePassedEventName, err)
+	}
+	return &ethtypes.Log{
+		Address: predeploys.L2ToL1MessagePasserAddr,
+		Topics: []common.Hash{
+			messagePassed.ID,
+			common.BigToHash(nonce),
+			common.BytesToHash(sender.Bytes()),
+			common.BytesToHash(target.Bytes()),
+		},
+		Data: logData,
+	}, nil
+}
+
+func hasAttribute(event *abcitypes.Event, key string) bool {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeBig decodes the big-endian hex bytes x/rollup encodes numbers with.
+func decodeBig(value string) (*big.Int, error) {
+	b, err := hexutil.Decode(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// Bloom returns the logs bloom of a block with the tx results.
+func Bloom(results []*abcitypes.TxResult) (ethtypes.Bloom, error) {
+	var bloom ethtypes.Bloom
+	for _, result := range results {
+		logs, err := FromTxResult(&result.Result)
+		if err != nil {
+			return ethtypes.Bloom{}, err
+		}
+		AddToBloom(&bloom, logs)
+	}
+	return bloom, nil
+}
+
+// AddToBloom adds the addresses and topics of the logs to the bloom.
+func AddToBloom(bloom *ethtypes.Bloom, logs []*ethtypes.Log) {
+	for _, log := range logs {
+		bloom.Add(log.Address.Bytes())
+		for _, topic := range log.Topics {
+			bloom.Add(topic.Bytes())
+		}
+	}
+}
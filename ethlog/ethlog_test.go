@@ -0,0 +1,116 @@
+package ethlog_test
+
+import (
+	"math/big"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	bindings "github.com/polymerdao/monomer/bindings/generated"
+	"github.com/polymerdao/monomer/ethlog"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromEvent(t *testing.T) {
+	event := &abcitypes.Event{
+		Type: "transfer",
+		Attributes: []abcitypes.EventAttribute{
+			{Key: "recipient", Value: "cosmos1recipient"},
+			{Key: "sender", Value: "cosmos1sender"},
+			{Key: "amount", Value: "1stake"},
+			{Key: "memo", Value: "not indexed"},
+		},
+	}
+	log, err := ethlog.FromEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, ethlog.CosmosEventsAddress, log.Address)
+	require.Equal(t, []common.Hash{
+		crypto.Keccak256Hash([]byte("transfer")),
+		crypto.Keccak256Hash([]byte("cosmos1recipient")),
+		crypto.Keccak256Hash([]byte("cosmos1sender")),
+		crypto.Keccak256Hash([]byte("1stake")),
+	}, log.Topics)
+
+	// The data has all attributes.
+	stringArrayType, err := abi.NewType("string[]", "", nil)
+	require.NoError(t, err)
+	keysAndValues, err := abi.Arguments{{Type: stringArrayType}, {Type: stringArrayType}}.Unpack(log.Data)
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		[]string{"recipient", "sender", "amount", "memo"},
+		[]string{"cosmos1recipient", "cosmos1sender", "1stake", "not indexed"},
+	}, keysAndValues)
+}
+
+func TestFromEventWithdrawal(t *testing.T) {
+	sender := common.Address{1}
+	target := common.Address{2}
+	nonce := big.NewInt(3)
+	withdrawalHash := common.Hash{4}
+	event := &abcitypes.Event{
+		Type: rolluptypes.EventTypeWithdrawalInitiated,
+		Attributes: []abcitypes.EventAttribute{
+			{Key: rolluptypes.AttributeKeySender, Value: sdk.AccAddress(sender.Bytes()).String()},
+			{Key: rolluptypes.AttributeKeyL1Target, Value: target.Hex()},
+			{Key: rolluptypes.AttributeKeyValue, Value: hexutil.Encode(big.NewInt(100).Bytes())},
+			{Key: rolluptypes.AttributeKeyGasLimit, Value: hexutil.Encode(big.NewInt(100_000).Bytes())},
+			{Key: rolluptypes.AttributeKeyData, Value: "0x1234"},
+			{Key: rolluptypes.AttributeKeyWithdrawalHash, Value: withdrawalHash.Hex()},
+			{Key: rolluptypes.AttributeKeyNonce, Value: hexutil.Encode(nonce.Bytes())},
+		},
+	}
+	log, err := ethlog.FromEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, predeploys.L2ToL1MessagePasserAddr, log.Address)
+
+	messagePasserABI, err := bindings.L2ToL1MessagePasserMetaData.GetAbi()
+	require.NoError(t, err)
+	messagePassed := messagePasserABI.Events["MessagePassed"]
+	require.Equal(t, []common.Hash{
+		messagePassed.ID,
+		common.BigToHash(nonce),
+		common.BytesToHash(sender.Bytes()),
+		common.BytesToHash(target.Bytes()),
+	}, log.Topics)
+	data, err := messagePassed.Inputs.NonIndexed().Unpack(log.Data)
+	require.NoError(t, err)
+	require.Equal(t, []any{big.NewInt(100), big.NewInt(100_000), []byte{0x12, 0x34}, [32]byte(withdrawalHash)}, data)
+
+	// The builder adds the nonce when it registers the withdrawal. An unregistered withdrawal event is a Cosmos event log.
+	event.Attributes = event.Attributes[:len(event.Attributes)-1]
+	log, err = ethlog.FromEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, ethlog.CosmosEventsAddress, log.Address)
+	require.Equal(t, crypto.Keccak256Hash([]byte(rolluptypes.EventTypeWithdrawalInitiated)), log.Topics[0])
+}
+
+func TestFromTxResult(t *testing.T) {
+	result := &abcitypes.ExecTxResult{
+		Events: []abcitypes.Event{{Type: "message"}, {Type: "transfer"}},
+	}
+	logs, err := ethlog.FromTxResult(result)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+
+	// Failed txs have no logs.
+	result.Code = 1
+	logs, err = ethlog.FromTxResult(result)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+}
+
+func TestBloom(t *testing.T) {
+	bloom, err := ethlog.Bloom([]*abcitypes.TxResult{{
+		Result: abcitypes.ExecTxResult{Events: []abcitypes.Event{{Type: "transfer"}}},
+	}})
+	require.NoError(t, err)
+	require.True(t, bloom.Test(ethlog.CosmosEventsAddress.Bytes()))
+	require.True(t, bloom.Test(crypto.Keccak256([]byte("transfer"))))
+	require.False(t, bloom.Test(crypto.Keccak256([]byte("message"))))
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/polymerdao/monomer/faucet"
+	"github.com/polymerdao/monomer/keystore"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sourcegraph/conc"
+	"github.com/spf13/cobra"
+)
+
+const (
+	captchaSecretEnvVar = "FAUCET_CAPTCHA_SECRET"
+	readHeaderTimeout   = 30 * time.Second
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "faucet",
+		Short: "faucet serves testnet funds from a Monomer chain.",
+		Long: "faucet serves testnet funds from a Monomer chain. " +
+			"It sends a fixed amount from the account of a key in its keystore " +
+			"to the address in each POST /drip request, rate limited per address and per IP address. " +
+			"Add the key with `faucet keys add`.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return run(cmd.Context())
+		},
+	}
+
+	listenAddr        string
+	metricsAddr       string
+	cometRPCAddr      string
+	chainID           string
+	keyringBackend    string
+	keyringDir        string
+	keyName           string
+	bech32Prefix      string
+	amount            string
+	fees              string
+	gasLimit          uint64
+	cooldown          time.Duration
+	captchaVerifyURL  string
+	trustProxyHeaders bool
+)
+
+func run(ctx context.Context) error {
+	dripAmount, err := sdk.ParseCoinsNormalized(amount)
+	if err != nil {
+		return fmt.Errorf("parse amount: %v", err)
+	}
+	feeAmount, err := sdk.ParseCoinsNormalized(fees)
+	if err != nil {
+		return fmt.Errorf("parse fees: %v", err)
+	}
+	// Messages are encoded with the global address prefix.
+	sdk.GetConfig().SetBech32PrefixForAccount(bech32Prefix, bech32Prefix+sdk.PrefixPublic)
+
+	interfaceRegistry, cdc := newCodec()
+	kr, err := keystore.Open(keyringBackend, keyringDir, cdc, os.Stdin)
+	if err != nil {
+		return fmt.Errorf("open keystore: %v", err)
+	}
+	cometClient, err := client.NewClientFromNode(cometRPCAddr)
+	if err != nil {
+		return fmt.Errorf("new comet client: %v", err)
+	}
+	clientCtx := client.Context{}.
+		WithClient(cometClient).
+		WithChainID(chainID).
+		WithCodec(cdc).
+		WithInterfaceRegistry(interfaceRegistry).
+		WithTxConfig(authtx.NewTxConfig(cdc, authtx.DefaultSignModes)).
+		WithAccountRetriever(authtypes.AccountRetriever{})
+	sender, err := faucet.NewCometSender(clientCtx, kr, keyName, feeAmount, gasLimit)
+	if err != nil {
+		return fmt.Errorf("new comet sender: %v", err)
+	}
+
+	cfg := &faucet.Config{
+		Amount:            dripAmount,
+		Bech32Prefix:      bech32Prefix,
+		Cooldown:          cooldown,
+		TrustProxyHeaders: trustProxyHeaders,
+	}
+	if captchaVerifyURL != "" {
+		secret := os.Getenv(captchaSecretEnvVar)
+		if secret == "" {
+			return fmt.Errorf("%s must be set when a captcha verify URL is provided", captchaSecretEnvVar)
+		}
+		cfg.Captcha = faucet.NewSiteVerifyCaptcha(&http.Client{Timeout: 10 * time.Second}, captchaVerifyURL, secret) //nolint:mnd
+	}
+	metrics := faucet.NewNoopMetrics()
+	if metricsAddr != "" {
+		metrics = faucet.NewMetrics("monomer")
+	}
+	f, err := faucet.New(sender, cfg, metrics)
+	if err != nil {
+		return fmt.Errorf("new faucet: %v", err)
+	}
+
+	fmt.Printf("serving %s per drip from %s on %s\n", dripAmount, sender.Address(), listenAddr)
+	servers := []*http.Server{{Addr: listenAddr, Handler: f, ReadHeaderTimeout: readHeaderTimeout}}
+	if metricsAddr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", promhttp.Handler())
+		servers = append(servers, &http.Server{Addr: metricsAddr, Handler: metricsMux, ReadHeaderTimeout: readHeaderTimeout})
+	}
+	return serve(ctx, servers)
+}
+
+func newCodec() (codectypes.InterfaceRegistry, *codec.ProtoCodec) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	authtypes.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	return interfaceRegistry, codec.NewProtoCodec(interfaceRegistry)
+}
+
+// serve runs the servers until ctx is done or one of them fails.
+func serve(ctx context.Context, servers []*http.Server) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errCh := make(chan error, 2*len(servers)) // Each server may fail to serve and to shut down.
+	var wg conc.WaitGroup
+	for _, srv := range servers {
+		wg.Go(func() {
+			if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				errCh <- fmt.Errorf("serve %s: %v", srv.Addr, err)
+				cancel()
+			}
+		})
+	}
+	<-ctx.Done()
+	for _, srv := range servers {
+		if err := srv.Shutdown(context.Background()); err != nil {
+			errCh <- fmt.Errorf("shutdown %s: %v", srv.Addr, err)
+		}
+	}
+	wg.Wait()
+	close(errCh)
+	return <-errCh
+}
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	rootCmd.Flags().StringVar(&listenAddr, "listen-addr", "127.0.0.1:8080", "address to serve the faucet on")
+	rootCmd.Flags().StringVar(&metricsAddr, "metrics-addr", "", "address to serve Prometheus metrics on; disabled if empty")
+	rootCmd.Flags().StringVar(&cometRPCAddr, "comet-rpc", "http://127.0.0.1:26657", "Monomer CometBFT-compatible RPC address")
+	rootCmd.Flags().StringVar(&chainID, "chain-id", "1", "chain ID")
+	homeDir, _ := os.UserHomeDir() // The keystore is relative to the working directory if there is no home dir.
+	defaultKeyringDir := filepath.Join(homeDir, ".faucet")
+	rootCmd.Flags().StringVar(&keyringBackend, "keyring-backend", keystore.DefaultBackend, "keystore backend (os|file|kwallet|pass)")
+	rootCmd.Flags().StringVar(&keyringDir, "keyring-dir", defaultKeyringDir, "keystore directory")
+	rootCmd.Flags().StringVar(&keyName, "from", "faucet", "name of the faucet account's key in the keystore")
+	rootCmd.Flags().StringVar(&bech32Prefix, "address-prefix", sdk.Bech32MainPrefix, "address prefix")
+	rootCmd.Flags().StringVar(&amount, "amount", "1000000000000000000"+rolluptypes.ETH, "amount sent on every drip")
+	rootCmd.Flags().StringVar(&fees, "fees", "", "fees paid by every drip transaction")
+	rootCmd.Flags().Uint64Var(&gasLimit, "gas-limit", 200_000, "gas limit of every drip transaction") //nolint:mnd
+	rootCmd.Flags().DurationVar(&cooldown, "cooldown", faucet.DefaultCooldown, "minimum time between drips to the same address or IP address")
+	rootCmd.Flags().StringVar(&captchaVerifyURL, "captcha-verify-url", "",
+		"siteverify URL of the captcha provider, e.g., "+faucet.HCaptchaVerifyURL+"; the secret is read from "+captchaSecretEnvVar)
+	rootCmd.Flags().BoolVar(&trustProxyHeaders, "trust-proxy-headers", false, "rate limit by the X-Forwarded-For header; only enable behind a reverse proxy")
+
+	_, cdc := newCodec()
+	rootCmd.AddCommand(keystore.Command(defaultKeyringDir, cdc))
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		cancel()   // cancel is not called on os.Exit, we have to call it manually
+		os.Exit(1) //nolint:gocritic // Doesn't recognize that cancel() is called.
+	}
+}
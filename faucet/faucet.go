@@ -0,0 +1,257 @@
+package faucet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	bftbytes "github.com/cometbft/cometbft/libs/bytes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const (
+	// DefaultCooldown is the default minimum time between drips to the same address or IP address.
+	DefaultCooldown = 24 * time.Hour
+	// maxRequestBodyBytes bounds the size of drip requests.
+	maxRequestBodyBytes = 1 << 12
+)
+
+// Sender sends coins from the faucet account.
+type Sender interface {
+	// Send returns the hash of the transaction that sends the coins.
+	Send(ctx context.Context, to sdk.AccAddress, coins sdk.Coins) ([]byte, error)
+}
+
+// CaptchaVerifier verifies captcha tokens submitted with drip requests.
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// Config configures the faucet. Amount must be set; other fields are optional.
+type Config struct {
+	// Amount is sent on every drip.
+	Amount sdk.Coins
+	// Bech32Prefix is the prefix of recipient addresses. Defaults to "cosmos".
+	Bech32Prefix string
+	// Cooldown is the minimum time between drips to the same address or IP address. Defaults to DefaultCooldown.
+	Cooldown time.Duration
+	// Captcha verifies the captcha token of every request. Captchas are not required if it is nil.
+	Captcha CaptchaVerifier
+	// TrustProxyHeaders makes the faucet rate limit by the client IP address in the X-Forwarded-For header.
+	// It must only be enabled behind a reverse proxy that sets the header.
+	TrustProxyHeaders bool
+}
+
+// DripRequest is the body of a drip request.
+type DripRequest struct {
+	Address string `json:"address"`
+	Captcha string `json:"captcha,omitempty"`
+}
+
+// DripResponse is the body of a successful drip response.
+type DripResponse struct {
+	TxHash bftbytes.HexBytes `json:"txHash"`
+	Amount string            `json:"amount"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Faucet is an HTTP service that sends a fixed amount of coins to the addresses it is given.
+// It serves:
+//   - POST /drip: sends Config.Amount to the address in the DripRequest body.
+//   - GET /info: returns the amount sent on every drip.
+type Faucet struct {
+	sender  Sender
+	cfg     Config
+	limiter *limiter
+	metrics Metrics
+	mux     *http.ServeMux
+}
+
+var _ http.Handler = (*Faucet)(nil)
+
+func New(sender Sender, cfg *Config, metrics Metrics) (*Faucet, error) {
+	if cfg == nil || !cfg.Amount.IsValid() {
+		return nil, errors.New("a valid non-zero amount is required")
+	}
+	f := &Faucet{
+		sender:  sender,
+		cfg:     *cfg,
+		metrics: metrics,
+		mux:     http.NewServeMux(),
+	}
+	if f.cfg.Bech32Prefix == "" {
+		f.cfg.Bech32Prefix = sdk.Bech32MainPrefix
+	}
+	if f.cfg.Cooldown == 0 {
+		f.cfg.Cooldown = DefaultCooldown
+	}
+	f.limiter = newLimiter(f.cfg.Cooldown)
+	f.mux.HandleFunc("/drip", f.handleDrip)
+	f.mux.HandleFunc("/info", f.handleInfo)
+	return f, nil
+}
+
+func (f *Faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mux.ServeHTTP(w, r)
+}
+
+func (f *Faucet) handleInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"amount":          f.cfg.Amount.String(),
+		"cooldownSeconds": int64(f.cfg.Cooldown.Seconds()),
+		"captchaRequired": f.cfg.Captcha != nil,
+	})
+}
+
+func (f *Faucet) handleDrip(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+		return
+	}
+
+	var req DripRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)).Decode(&req); err != nil {
+		f.metrics.RecordDrip(OutcomeInvalidRequest)
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("decode request: %v", err)})
+		return
+	}
+	recipient, err := f.parseAddress(req.Address)
+	if err != nil {
+		f.metrics.RecordDrip(OutcomeInvalidRequest)
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("parse address: %v", err)})
+		return
+	}
+
+	clientIP := f.clientIP(r)
+	if f.cfg.Captcha != nil {
+		if err := f.cfg.Captcha.Verify(r.Context(), req.Captcha, clientIP); err != nil {
+			f.metrics.RecordDrip(OutcomeCaptchaFailed)
+			writeJSON(w, http.StatusForbidden, errorResponse{Error: fmt.Sprintf("verify captcha: %v", err)})
+			return
+		}
+	}
+
+	// Reserve both keys before sending so concurrent requests can't drip twice.
+	keys := []string{"address:" + recipient.String(), "ip:" + clientIP}
+	if retryAfter, ok := f.limiter.reserve(keys, time.Now()); !ok {
+		f.metrics.RecordDrip(OutcomeRateLimited)
+		w.Header().Set("Retry-After", strconv.FormatInt(int64(retryAfter.Round(time.Second).Seconds()), 10))
+		writeJSON(w, http.StatusTooManyRequests, errorResponse{Error: fmt.Sprintf("rate limited, retry in %s", retryAfter.Round(time.Second))})
+		return
+	}
+
+	txHash, err := f.sender.Send(r.Context(), recipient, f.cfg.Amount)
+	if err != nil {
+		f.limiter.release(keys)
+		f.metrics.RecordDrip(OutcomeSendFailed)
+		writeJSON(w, http.StatusBadGateway, errorResponse{Error: fmt.Sprintf("send: %v", err)})
+		return
+	}
+	f.metrics.RecordDrip(OutcomeSuccess)
+	writeJSON(w, http.StatusOK, DripResponse{
+		TxHash: txHash,
+		Amount: f.cfg.Amount.String(),
+	})
+}
+
+func (f *Faucet) parseAddress(address string) (sdk.AccAddress, error) {
+	prefix, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+	if prefix != f.cfg.Bech32Prefix {
+		return nil, fmt.Errorf("expected prefix %q, got %q", f.cfg.Bech32Prefix, prefix)
+	}
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+func (f *Faucet) clientIP(r *http.Request) string {
+	if f.cfg.TrustProxyHeaders {
+		if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+			clientIP, _, _ := strings.Cut(forwardedFor, ",")
+			return strings.TrimSpace(clientIP)
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// limiter allows one reservation per key per cooldown.
+type limiter struct {
+	cooldown  time.Duration
+	mu        sync.Mutex
+	last      map[string]time.Time
+	lastPrune time.Time
+}
+
+func newLimiter(cooldown time.Duration) *limiter {
+	return &limiter{
+		cooldown: cooldown,
+		last:     make(map[string]time.Time),
+	}
+}
+
+// reserve reserves all keys at now. If any key was reserved less than a cooldown ago, nothing is reserved and the time
+// until all keys are available is returned.
+func (l *limiter) reserve(keys []string, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastPrune) > l.cooldown {
+		for key, last := range l.last {
+			if now.Sub(last) >= l.cooldown {
+				delete(l.last, key)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	var retryAfter time.Duration
+	for _, key := range keys {
+		if last, ok := l.last[key]; ok {
+			retryAfter = max(retryAfter, l.cooldown-now.Sub(last))
+		}
+	}
+	if retryAfter > 0 {
+		return retryAfter, false
+	}
+	for _, key := range keys {
+		l.last[key] = now
+	}
+	return 0, true
+}
+
+func (l *limiter) release(keys []string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		delete(l.last, key)
+	}
+}
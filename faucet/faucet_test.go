@@ -0,0 +1,242 @@
+package faucet_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/faucet"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testutils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSender struct {
+	sent []sdk.AccAddress
+	err  error
+}
+
+func (s *mockSender) Send(_ context.Context, to sdk.AccAddress, _ sdk.Coins) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.sent = append(s.sent, to)
+	return []byte{1, 2, 3}, nil
+}
+
+type mockCaptcha struct{}
+
+func (mockCaptcha) Verify(_ context.Context, token, _ string) error {
+	if token != "valid" {
+		return errors.New("invalid token")
+	}
+	return nil
+}
+
+var amount = sdk.NewCoins(sdk.NewCoin(rolluptypes.ETH, math.NewInt(100)))
+
+func drip(t *testing.T, f http.Handler, remoteAddr string, req *faucet.DripRequest) *httptest.ResponseRecorder {
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	httpReq := httptest.NewRequest(http.MethodPost, "/drip", bytes.NewReader(body))
+	httpReq.RemoteAddr = remoteAddr
+	recorder := httptest.NewRecorder()
+	f.ServeHTTP(recorder, httpReq)
+	return recorder
+}
+
+func TestDrip(t *testing.T) {
+	sender := new(mockSender)
+	f, err := faucet.New(sender, &faucet.Config{
+		Amount: amount,
+	}, faucet.NewNoopMetrics())
+	require.NoError(t, err)
+	addr1 := testapp.GetAccount(1).Address
+	addr2 := testapp.GetAccount(2).Address
+
+	resp := drip(t, f, "1.1.1.1:1234", &faucet.DripRequest{Address: addr1.String()})
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	var dripResp faucet.DripResponse
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &dripResp))
+	require.Equal(t, []byte{1, 2, 3}, []byte(dripResp.TxHash))
+	require.Equal(t, amount.String(), dripResp.Amount)
+	require.Equal(t, []sdk.AccAddress{addr1}, sender.sent)
+
+	// Same address, different IP.
+	resp = drip(t, f, "2.2.2.2:1234", &faucet.DripRequest{Address: addr1.String()})
+	require.Equal(t, http.StatusTooManyRequests, resp.Code)
+	require.NotEmpty(t, resp.Header().Get("Retry-After"))
+	// Same IP, different address.
+	resp = drip(t, f, "1.1.1.1:5678", &faucet.DripRequest{Address: addr2.String()})
+	require.Equal(t, http.StatusTooManyRequests, resp.Code)
+	// Different IP and address.
+	resp = drip(t, f, "2.2.2.2:1234", &faucet.DripRequest{Address: addr2.String()})
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	require.Equal(t, []sdk.AccAddress{addr1, addr2}, sender.sent)
+}
+
+func TestDripInvalidRequests(t *testing.T) {
+	sender := new(mockSender)
+	f, err := faucet.New(sender, &faucet.Config{
+		Amount:  amount,
+		Captcha: mockCaptcha{},
+	}, faucet.NewNoopMetrics())
+	require.NoError(t, err)
+	addr := testapp.GetAccount(1).Address
+
+	for description, test := range map[string]struct {
+		req        *faucet.DripRequest
+		wantStatus int
+	}{
+		"invalid address": {
+			req:        &faucet.DripRequest{Address: "cosmos1invalid", Captcha: "valid"},
+			wantStatus: http.StatusBadRequest,
+		},
+		"wrong address prefix": {
+			req:        &faucet.DripRequest{Address: sdk.MustBech32ifyAddressBytes("osmo", addr), Captcha: "valid"},
+			wantStatus: http.StatusBadRequest,
+		},
+		"missing captcha": {
+			req:        &faucet.DripRequest{Address: addr.String()},
+			wantStatus: http.StatusForbidden,
+		},
+		"invalid captcha": {
+			req:        &faucet.DripRequest{Address: addr.String(), Captcha: "invalid"},
+			wantStatus: http.StatusForbidden,
+		},
+	} {
+		t.Run(description, func(t *testing.T) {
+			resp := drip(t, f, "1.1.1.1:1234", test.req)
+			require.Equal(t, test.wantStatus, resp.Code, resp.Body.String())
+		})
+	}
+	require.Empty(t, sender.sent)
+
+	// Rejected requests don't count towards the rate limit.
+	resp := drip(t, f, "1.1.1.1:1234", &faucet.DripRequest{Address: addr.String(), Captcha: "valid"})
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+	resp = httptest.NewRecorder()
+	f.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/drip", http.NoBody))
+	require.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+}
+
+func TestDripSendFailureReleasesRateLimit(t *testing.T) {
+	sender := &mockSender{err: errors.New("node unavailable")}
+	f, err := faucet.New(sender, &faucet.Config{
+		Amount: amount,
+	}, faucet.NewNoopMetrics())
+	require.NoError(t, err)
+	req := &faucet.DripRequest{Address: testapp.GetAccount(1).Address.String()}
+
+	resp := drip(t, f, "1.1.1.1:1234", req)
+	require.Equal(t, http.StatusBadGateway, resp.Code)
+	sender.err = nil
+	resp = drip(t, f, "1.1.1.1:1234", req)
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+}
+
+func TestDripTrustProxyHeaders(t *testing.T) {
+	f, err := faucet.New(new(mockSender), &faucet.Config{
+		Amount:            amount,
+		TrustProxyHeaders: true,
+	}, faucet.NewNoopMetrics())
+	require.NoError(t, err)
+
+	for i, forwardedFor := range []string{"1.1.1.1", "2.2.2.2, 10.0.0.1"} {
+		body, err := json.Marshal(&faucet.DripRequest{Address: testapp.GetAccount(i).Address.String()})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/drip", bytes.NewReader(body))
+		req.RemoteAddr = "10.0.0.1:1234" // The proxy.
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		resp := httptest.NewRecorder()
+		f.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	}
+}
+
+func TestSiteVerifyCaptcha(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "secret", r.PostForm.Get("secret"))
+		require.Equal(t, "1.1.1.1", r.PostForm.Get("remoteip"))
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"success":     r.PostForm.Get("response") == "valid",
+			"error-codes": []string{"invalid-input-response"},
+		}))
+	}))
+	t.Cleanup(server.Close)
+
+	captcha := faucet.NewSiteVerifyCaptcha(server.Client(), server.URL, "secret")
+	require.NoError(t, captcha.Verify(context.Background(), "valid", "1.1.1.1"))
+	require.ErrorContains(t, captcha.Verify(context.Background(), "invalid", "1.1.1.1"), "invalid-input-response")
+	require.ErrorContains(t, captcha.Verify(context.Background(), "", "1.1.1.1"), "missing captcha token")
+}
+
+func TestCometSender(t *testing.T) {
+	chainID := monomer.ChainID(1)
+	app := testapp.NewTest(t, chainID.String())
+	n := testutils.NewInstantNode(t, app, &genesis.Genesis{
+		ChainID:  chainID,
+		AppState: testapp.MakeGenesisAppState(t, app),
+	})
+
+	cometClient, err := client.NewClientFromNode("http://" + n.CometAddr())
+	require.NoError(t, err)
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	authtypes.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	clientCtx := client.Context{}.
+		WithClient(cometClient).
+		WithChainID(chainID.String()).
+		WithCodec(cdc).
+		WithInterfaceRegistry(interfaceRegistry).
+		WithTxConfig(authtx.NewTxConfig(cdc, authtx.DefaultSignModes)).
+		WithAccountRetriever(authtypes.AccountRetriever{})
+	faucetAccount := testapp.GetAccount(0)
+	kr := keyring.NewInMemory(cdc)
+	require.NoError(t, kr.ImportPrivKeyHex("faucet", hex.EncodeToString(faucetAccount.PrivKey.Key), string(hd.Secp256k1Type)))
+	sender, err := faucet.NewCometSender(clientCtx, kr, "faucet", sdk.NewCoins(), 200_000)
+	require.NoError(t, err)
+	require.Equal(t, faucetAccount.Address, sender.Address())
+
+	// Several drips can be included in the same block.
+	recipients := []sdk.AccAddress{testapp.GetAccount(1).Address, testapp.GetAccount(2).Address}
+	for _, recipient := range recipients {
+		txHash, err := sender.Send(context.Background(), recipient, amount)
+		require.NoError(t, err)
+		require.Len(t, txHash, 32)
+	}
+	block := n.BuildBlock()
+	require.Len(t, block.Txs, 1+len(recipients))
+
+	for _, recipient := range recipients {
+		resp, err := banktypes.NewQueryClient(clientCtx).Balance(context.Background(), &banktypes.QueryBalanceRequest{
+			Address: recipient.String(),
+			Denom:   rolluptypes.ETH,
+		})
+		require.NoError(t, err)
+		require.Equal(t, testapp.AccountBalance.Add(amount.AmountOf(rolluptypes.ETH)), resp.GetBalance().Amount)
+	}
+}
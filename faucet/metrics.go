@@ -0,0 +1,51 @@
+package faucet
+
+import (
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	MetricsSubsystem = "faucet"
+
+	OutcomeSuccess        = "success"
+	OutcomeInvalidRequest = "invalid_request"
+	OutcomeCaptchaFailed  = "captcha_failed"
+	OutcomeRateLimited    = "rate_limited"
+	OutcomeSendFailed     = "send_failed"
+)
+
+// Metrics contains metrics collected from the faucet package.
+type Metrics interface {
+	RecordDrip(outcome string)
+}
+
+type metrics struct {
+	// Count of drip requests by outcome.
+	Drips *stdprometheus.CounterVec
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		Drips: promauto.NewCounterVec(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "drips",
+			Help:      "Number of drip requests by outcome",
+		}, []string{
+			"outcome",
+		}),
+	}
+}
+
+func (m *metrics) RecordDrip(outcome string) {
+	m.Drips.WithLabelValues(outcome).Inc()
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) RecordDrip(_ string) {}
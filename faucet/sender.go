@@ -0,0 +1,130 @@
+package faucet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	cosmostx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CometSender sends bank transfers from a single account through a Monomer node's CometBFT-compatible RPC.
+// Sends are serialized and the account sequence is tracked locally, so several drips can be included in the same block.
+type CometSender struct {
+	clientCtx client.Context
+	keyring   keyring.Keyring
+	keyName   string
+	signMode  signing.SignMode
+	address   sdk.AccAddress
+	fees      sdk.Coins
+	gasLimit  uint64
+
+	mu            sync.Mutex
+	accountLoaded bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+var _ Sender = (*CometSender)(nil)
+
+// NewCometSender creates a sender for the account of the key named keyName in kr.
+// clientCtx must have its Client, ChainID, TxConfig, AccountRetriever, InterfaceRegistry, and Codec set.
+func NewCometSender( //nolint:gocritic // hugeParam
+	clientCtx client.Context,
+	kr keyring.Keyring,
+	keyName string,
+	fees sdk.Coins,
+	gasLimit uint64,
+) (*CometSender, error) {
+	record, err := kr.Key(keyName)
+	if err != nil {
+		return nil, fmt.Errorf("get key %s: %v", keyName, err)
+	}
+	address, err := record.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("get address: %v", err)
+	}
+	signMode := signing.SignMode_SIGN_MODE_DIRECT
+	if record.GetType() == keyring.TypeLedger {
+		// The Ledger Cosmos app only signs amino JSON.
+		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	}
+	return &CometSender{
+		clientCtx: clientCtx,
+		keyring:   kr,
+		keyName:   keyName,
+		signMode:  signMode,
+		address:   address,
+		fees:      fees,
+		gasLimit:  gasLimit,
+	}, nil
+}
+
+// Address returns the address of the faucet account.
+func (s *CometSender) Address() sdk.AccAddress {
+	return s.address
+}
+
+func (s *CometSender) Send(ctx context.Context, to sdk.AccAddress, coins sdk.Coins) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.accountLoaded {
+		accountNumber, sequence, err := s.clientCtx.AccountRetriever.GetAccountNumberSequence(s.clientCtx.WithCmdContext(ctx), s.address)
+		if err != nil {
+			return nil, fmt.Errorf("get account number and sequence: %v", err)
+		}
+		s.accountNumber = accountNumber
+		s.sequence = sequence
+		s.accountLoaded = true
+	}
+
+	txBytes, err := s.sign(ctx, banktypes.NewMsgSend(s.address, to, coins))
+	if err != nil {
+		return nil, fmt.Errorf("sign: %v", err)
+	}
+	resp, err := s.clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		// The tx may or may not have been accepted, so reload the sequence next time.
+		s.accountLoaded = false
+		return nil, fmt.Errorf("broadcast tx: %v", err)
+	}
+	if resp.Code != 0 {
+		s.accountLoaded = false
+		return nil, fmt.Errorf("tx rejected with code %d: %s", resp.Code, resp.RawLog)
+	}
+	s.sequence++
+	return bfttypes.Tx(txBytes).Hash(), nil
+}
+
+func (s *CometSender) sign(ctx context.Context, msg sdk.Msg) ([]byte, error) {
+	txConfig := s.clientCtx.TxConfig
+	txBuilder := txConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msg); err != nil {
+		return nil, fmt.Errorf("set msgs: %v", err)
+	}
+	txBuilder.SetFeeAmount(s.fees)
+	txBuilder.SetGasLimit(s.gasLimit)
+
+	txf := cosmostx.Factory{}.
+		WithKeybase(s.keyring).
+		WithTxConfig(txConfig).
+		WithChainID(s.clientCtx.ChainID).
+		WithAccountNumber(s.accountNumber).
+		WithSequence(s.sequence).
+		WithSignMode(s.signMode)
+	if err := cosmostx.Sign(ctx, txf, s.keyName, txBuilder, true); err != nil {
+		return nil, fmt.Errorf("sign with key %s: %v", s.keyName, err)
+	}
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("encode tx: %v", err)
+	}
+	return txBytes, nil
+}
@@ -0,0 +1,130 @@
+// Package faults injects delays and errors into an app's ABCI calls, so tests can check how op-node and Monomer
+// recover from slow or failing block execution, e.g., a FinalizeBlock that takes longer than op-node waits for, or a
+// Commit that fails once.
+package faults
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/polymerdao/monomer"
+)
+
+// ABCI methods faults can be injected into.
+const (
+	MethodCheckTx       = "CheckTx"
+	MethodFinalizeBlock = "FinalizeBlock"
+	MethodCommit        = "Commit"
+)
+
+// Fault is a delay and an error injected into calls to a method. The delay comes first and ends early if the call's
+// context is done. The app isn't called if Err is set.
+type Fault struct {
+	Delay time.Duration
+	Err   error
+	// Times is the number of calls the fault is injected into. Zero injects it into every call until it is cleared.
+	Times int
+}
+
+// Injector injects faults into the apps it wraps. It is safe for concurrent use, so faults can be injected while the
+// node runs.
+type Injector struct {
+	mu     sync.Mutex
+	faults map[string]*Fault
+}
+
+func NewInjector() *Injector {
+	return &Injector{
+		faults: make(map[string]*Fault),
+	}
+}
+
+// Inject injects fault into the calls to method, replacing the method's previous fault.
+func (i *Injector) Inject(method string, fault Fault) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.faults[method] = &fault
+}
+
+// Clear stops injecting faults into the calls to method.
+func (i *Injector) Clear(method string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.faults, method)
+}
+
+// Wrap returns app with the injector's faults injected into its calls. The returned app only implements
+// monomer.Application, so the node features that need optional interfaces, such as state surgery and pruning, are
+// unavailable with it.
+func (i *Injector) Wrap(app monomer.Application) monomer.Application {
+	return &application{
+		Application: app,
+		injector:    i,
+	}
+}
+
+// next returns the fault to inject into the next call to method, or nil if there is none.
+func (i *Injector) next(method string) *Fault {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	fault, ok := i.faults[method]
+	if !ok {
+		return nil
+	}
+	if fault.Times > 0 {
+		fault.Times--
+		if fault.Times == 0 {
+			delete(i.faults, method)
+		}
+	}
+	return fault
+}
+
+// inject waits out the delay of the fault injected into the call to method and returns its error.
+func (i *Injector) inject(ctx context.Context, method string) error {
+	fault := i.next(method)
+	if fault == nil {
+		return nil
+	}
+	if fault.Delay > 0 {
+		timer := time.NewTimer(fault.Delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return fault.Err
+}
+
+type application struct {
+	monomer.Application
+	injector *Injector
+}
+
+func (a *application) CheckTx(ctx context.Context, req *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error) {
+	if err := a.injector.inject(ctx, MethodCheckTx); err != nil {
+		return nil, err
+	}
+	return a.Application.CheckTx(ctx, req)
+}
+
+func (a *application) FinalizeBlock(
+	ctx context.Context,
+	req *abcitypes.RequestFinalizeBlock,
+) (*abcitypes.ResponseFinalizeBlock, error) {
+	if err := a.injector.inject(ctx, MethodFinalizeBlock); err != nil {
+		return nil, err
+	}
+	return a.Application.FinalizeBlock(ctx, req)
+}
+
+func (a *application) Commit(ctx context.Context, req *abcitypes.RequestCommit) (*abcitypes.ResponseCommit, error) {
+	if err := a.injector.inject(ctx, MethodCommit); err != nil {
+		return nil, err
+	}
+	return a.Application.Commit(ctx, req)
+}
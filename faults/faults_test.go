@@ -0,0 +1,54 @@
+package faults_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/polymerdao/monomer/faults"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjector(t *testing.T) {
+	injector := faults.NewInjector()
+	app := injector.Wrap(testapp.NewTest(t, "1"))
+	ctx := context.Background()
+	checkTx := func(ctx context.Context) error {
+		_, err := app.CheckTx(ctx, &abcitypes.RequestCheckTx{
+			Tx: testapp.ToTestTx(t, "k", "v"),
+		})
+		return err
+	}
+	require.NoError(t, checkTx(ctx))
+
+	// The fault is injected into the given number of calls.
+	errInjected := errors.New("injected")
+	injector.Inject(faults.MethodCheckTx, faults.Fault{
+		Err:   errInjected,
+		Times: 2,
+	})
+	require.ErrorIs(t, checkTx(ctx), errInjected)
+	require.ErrorIs(t, checkTx(ctx), errInjected)
+	require.NoError(t, checkTx(ctx))
+
+	// Without a number of calls, the fault is injected until it is cleared.
+	injector.Inject(faults.MethodCheckTx, faults.Fault{
+		Err: errInjected,
+	})
+	for range 3 {
+		require.ErrorIs(t, checkTx(ctx), errInjected)
+	}
+	injector.Clear(faults.MethodCheckTx)
+	require.NoError(t, checkTx(ctx))
+
+	// A delay ends early if the call's context is done.
+	injector.Inject(faults.MethodCheckTx, faults.Fault{
+		Delay: time.Hour,
+	})
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, checkTx(timeoutCtx), context.DeadlineExceeded)
+}
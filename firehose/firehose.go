@@ -0,0 +1,164 @@
+// Package firehose extracts the blocks a Monomer node builds in the format read by Firehose, which feeds graph-node and
+// Substreams.
+//
+// Blocks are written as lines of the Firehose console reader protocol:
+//
+//	FIRE INIT <protocol version> <block type>
+//	FIRE BLOCK <number> <hash> <parent number> <parent hash> <last irreversible number> <timestamp nanos> <base64 payload>
+//
+// The payload is a types.Block.
+package firehose
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+	opeth "github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/firehose/types"
+)
+
+const (
+	// ProtocolVersion is the version of the Firehose console reader protocol.
+	ProtocolVersion = "3.0"
+	// subscriberName identifies the extractor's event bus subscription.
+	subscriberName = "firehose"
+	// subscriptionCapacity is the number of blocks the extractor can fall behind the builder before it fails.
+	subscriptionCapacity = 100
+)
+
+// BlockType is the fully-qualified protobuf name of the block payloads.
+var BlockType = proto.MessageName(&types.Block{})
+
+type DB interface {
+	BlockByHash(common.Hash) (*monomer.Block, error)
+	BlockByLabel(opeth.BlockLabel) (*monomer.Block, error)
+}
+
+// Extractor writes blocks to an io.Writer, usually stdout, where a Firehose reader picks them up.
+type Extractor struct {
+	w          io.Writer
+	blockStore DB
+}
+
+func NewExtractor(w io.Writer, blockStore DB) *Extractor {
+	return &Extractor{
+		w:          w,
+		blockStore: blockStore,
+	}
+}
+
+// Subscribe writes the FIRE INIT line and subscribes to new blocks. It must be called before blocks are built.
+// The returned subscription is passed to Run.
+func (e *Extractor) Subscribe(ctx context.Context, eventBus *bfttypes.EventBus) (bfttypes.Subscription, error) {
+	sub, err := eventBus.Subscribe(ctx, subscriberName, bfttypes.EventQueryNewBlock, subscriptionCapacity)
+	if err != nil {
+		return nil, fmt.Errorf("subscribe to new blocks: %v", err)
+	}
+	if _, err := fmt.Fprintf(e.w, "FIRE INIT %s %s\n", ProtocolVersion, BlockType); err != nil {
+		return nil, fmt.Errorf("write init: %v", err)
+	}
+	return sub, nil
+}
+
+// Run writes every block published on sub until ctx is done.
+// Blocks are written in the order they are built. If the writer falls too far behind, Run returns an error rather than
+// skip blocks.
+func (e *Extractor) Run(ctx context.Context, sub bfttypes.Subscription) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.Canceled():
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("subscription canceled: %v", sub.Err())
+		case msg := <-sub.Out():
+			data, ok := msg.Data().(bfttypes.EventDataNewBlock)
+			if !ok {
+				return fmt.Errorf("unexpected event data type %T", msg.Data())
+			}
+			block, err := e.blockStore.BlockByHash(common.BytesToHash(data.BlockID.Hash))
+			if err != nil {
+				return fmt.Errorf("get block by hash: %v", err)
+			}
+			if err := e.WriteBlock(block, &data.ResultFinalizeBlock); err != nil {
+				return fmt.Errorf("write block %d: %v", block.Header.Height, err)
+			}
+		}
+	}
+}
+
+// WriteBlock writes the FIRE BLOCK line for block, which was finalized with resp.
+func (e *Extractor) WriteBlock(block *monomer.Block, resp *abcitypes.ResponseFinalizeBlock) error {
+	if len(resp.TxResults) != block.Txs.Len() {
+		return fmt.Errorf("got %d tx results for %d txs", len(resp.TxResults), block.Txs.Len())
+	}
+	header := block.Header
+	payload := &types.Block{
+		Height:       header.Height,
+		Hash:         header.Hash.Bytes(),
+		ParentHash:   header.ParentHash.Bytes(),
+		Time:         header.Time,
+		StateRoot:    header.StateRoot.Bytes(),
+		AppHash:      header.AppHash,
+		GasLimit:     header.GasLimit,
+		Transactions: make([]*types.Transaction, 0, block.Txs.Len()),
+		Events:       resp.Events,
+	}
+	for i, tx := range block.Txs {
+		payload.Transactions = append(payload.Transactions, &types.Transaction{
+			Hash:   tx.Hash(),
+			Tx:     tx,
+			Result: *resp.TxResults[i],
+		})
+	}
+	payloadBytes, err := payload.Marshal()
+	if err != nil {
+		return fmt.Errorf("marshal payload: %v", err)
+	}
+
+	libNum, err := e.lastIrreversibleHeight(header.Height)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(
+		e.w,
+		"FIRE BLOCK %d %s %d %s %d %d %s\n",
+		header.Height,
+		hex.EncodeToString(header.Hash.Bytes()),
+		parentHeight(header.Height),
+		hex.EncodeToString(header.ParentHash.Bytes()),
+		libNum,
+		time.Unix(int64(header.Time), 0).UnixNano(),
+		base64.StdEncoding.EncodeToString(payloadBytes),
+	); err != nil {
+		return fmt.Errorf("write block: %v", err)
+	}
+	return nil
+}
+
+// lastIrreversibleHeight returns the height of the finalized block, which can't be reorged, capped at height.
+func (e *Extractor) lastIrreversibleHeight(height uint64) (uint64, error) {
+	finalized, err := e.blockStore.BlockByLabel(opeth.Finalized)
+	if err != nil {
+		return 0, fmt.Errorf("get finalized block: %v", err)
+	}
+	return min(finalized.Header.Height, height), nil
+}
+
+func parentHeight(height uint64) uint64 {
+	if height == 0 {
+		return 0
+	}
+	return height - 1
+}
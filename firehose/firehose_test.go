@@ -0,0 +1,101 @@
+package firehose_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer/firehose"
+	"github.com/polymerdao/monomer/firehose/types"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractor(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	parent := testutils.GenerateBlockWithParentAndTxs(t, nil)
+	block := testutils.GenerateBlockWithParentAndTxs(t, parent.Header, bfttypes.Tx("tx"))
+	block.Header.Time = 10
+	require.NoError(t, blockStore.AppendBlock(parent))
+	require.NoError(t, blockStore.AppendBlock(block))
+	require.NoError(t, blockStore.UpdateLabels(block.Header.Hash, block.Header.Hash, parent.Header.Hash))
+
+	eventBus := bfttypes.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		require.NoError(t, eventBus.Stop())
+	})
+
+	r, w := io.Pipe()
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, 1<<20)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+	extractor := firehose.NewExtractor(w, blockStore)
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := extractor.Subscribe(ctx, eventBus)
+	require.NoError(t, err)
+	errCh := make(chan error)
+	go func() {
+		errCh <- extractor.Run(ctx, sub)
+	}()
+
+	require.Equal(t, "FIRE INIT "+firehose.ProtocolVersion+" firehose.v1.Block", <-lines)
+
+	resp := abcitypes.ResponseFinalizeBlock{
+		TxResults: []*abcitypes.ExecTxResult{{Code: 0, GasUsed: 1}, {Code: 1, Log: "failed"}},
+		Events:    []abcitypes.Event{{Type: "end_block"}},
+	}
+	require.NoError(t, eventBus.PublishEventNewBlock(bfttypes.EventDataNewBlock{
+		Block:               block.ToCometLikeBlock(),
+		BlockID:             bfttypes.BlockID{Hash: block.Header.Hash.Bytes()},
+		ResultFinalizeBlock: resp,
+	}))
+	fields := strings.Fields(<-lines)
+	require.Equal(t, []string{
+		"FIRE",
+		"BLOCK",
+		fmt.Sprint(block.Header.Height),
+		hex.EncodeToString(block.Header.Hash.Bytes()),
+		fmt.Sprint(parent.Header.Height),
+		hex.EncodeToString(parent.Header.Hash.Bytes()),
+		fmt.Sprint(parent.Header.Height), // The finalized block.
+		fmt.Sprint(time.Unix(10, 0).UnixNano()),
+	}, fields[:len(fields)-1])
+
+	payloadBytes, err := base64.StdEncoding.DecodeString(fields[len(fields)-1])
+	require.NoError(t, err)
+	var payload types.Block
+	require.NoError(t, payload.Unmarshal(payloadBytes))
+	require.Equal(t, block.Header.Hash.Bytes(), payload.Hash)
+	require.Equal(t, block.Header.ParentHash.Bytes(), payload.ParentHash)
+	require.Equal(t, resp.Events, payload.Events)
+	require.Len(t, payload.Transactions, block.Txs.Len())
+	for i, tx := range payload.Transactions {
+		require.Equal(t, []byte(block.Txs[i]), tx.Tx)
+		require.Equal(t, block.Txs[i].Hash(), tx.Hash)
+		require.Equal(t, *resp.TxResults[i], tx.Result)
+	}
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestWriteBlockRequiresAllTxResults(t *testing.T) {
+	blockStore := testutils.NewLocalMemDB(t)
+	block := testutils.GenerateBlockWithParentAndTxs(t, nil)
+	extractor := firehose.NewExtractor(io.Discard, blockStore)
+	require.Error(t, extractor.WriteBlock(block, &abcitypes.ResponseFinalizeBlock{}))
+}
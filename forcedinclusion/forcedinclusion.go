@@ -0,0 +1,138 @@
+// Package forcedinclusion enforces a list of txs that must be included within a number of blocks, e.g.,
+// governance-approved txs. It complements L1 forced deposits: deposits are forced by L1, while forced inclusion
+// obligations are supplied to the sequencer by its operator or by a contract.
+package forcedinclusion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/builder"
+)
+
+// Obligation is a tx that must be included.
+type Obligation struct {
+	Tx bfttypes.Tx
+	// Height is the height of the first block the tx could be included in.
+	Height uint64
+	// Deadline is the height of the last block the tx must be included by.
+	Deadline uint64
+}
+
+// Source supplies txs that must be included, e.g., from a file maintained by the operator or from a governance
+// contract's state. It is polled before every block built with the tx pool.
+type Source interface {
+	// NewTxs returns the txs added since the last call.
+	NewTxs(ctx context.Context) (bfttypes.Txs, error)
+}
+
+// List is a builder.Interceptor that includes the txs on it in the next block built with the tx pool.
+// Since blocks built without the tx pool (i.e., derived from L1) can't include them, txs may miss their deadlines; missed
+// obligations stay on the list until they are included and are reported by the overdue metric.
+type List struct {
+	window  uint64
+	sources []Source
+	metrics Metrics
+
+	mu          sync.Mutex
+	obligations []*Obligation
+}
+
+var _ builder.Interceptor = (*List)(nil)
+
+// New creates a List whose txs must be included within window blocks of being added.
+func New(window uint64, metrics Metrics, sources ...Source) *List {
+	return &List{
+		window:  window,
+		sources: sources,
+		metrics: metrics,
+	}
+}
+
+// Add adds a tx that must be included in a block between height and height+window. A tx already on the list is
+// ignored.
+func (l *List) Add(tx bfttypes.Tx, height uint64) error {
+	// Deposit txs can only be submitted on L1.
+	if _, err := monomer.GetDepositTxs([][]byte{tx}); err == nil {
+		return errors.New("deposit txs can't be forced")
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.indexOf(tx) != -1 {
+		return nil
+	}
+	l.obligations = append(l.obligations, &Obligation{
+		Tx:       tx,
+		Height:   height,
+		Deadline: height + l.window,
+	})
+	l.metrics.SetOutstanding(len(l.obligations))
+	return nil
+}
+
+// Obligations returns the outstanding obligations in the order they were added.
+func (l *List) Obligations() []*Obligation {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Clone(l.obligations)
+}
+
+// Intercept polls the sources and returns the txs of all outstanding obligations.
+func (l *List) Intercept(ctx context.Context, height uint64) (bfttypes.Txs, error) {
+	for _, source := range l.sources {
+		txs, err := source.NewTxs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get new txs: %v", err)
+		}
+		for _, tx := range txs {
+			if err := l.Add(tx, height); err != nil {
+				return nil, fmt.Errorf("add tx %X: %v", tx.Hash(), err)
+			}
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	txs := make(bfttypes.Txs, 0, len(l.obligations))
+	for _, obligation := range l.obligations {
+		txs = append(txs, obligation.Tx)
+	}
+	return txs, nil
+}
+
+// OnBlock discharges the obligations whose txs are in the block, no matter how they got there, and updates the
+// metrics.
+func (l *List) OnBlock(_ context.Context, block *monomer.Block) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	height := block.Header.Height
+	for _, tx := range block.Txs {
+		if i := l.indexOf(tx); i != -1 {
+			l.metrics.RecordIncluded(height - l.obligations[i].Height)
+			l.obligations = slices.Delete(l.obligations, i, i+1)
+		}
+	}
+
+	var overdue int
+	for _, obligation := range l.obligations {
+		if obligation.Deadline <= height {
+			overdue++
+		}
+	}
+	l.metrics.SetOutstanding(len(l.obligations))
+	l.metrics.SetOverdue(overdue)
+	return nil
+}
+
+func (l *List) indexOf(tx bfttypes.Tx) int {
+	hash := tx.Hash()
+	return slices.IndexFunc(l.obligations, func(obligation *Obligation) bool {
+		return string(obligation.Tx.Hash()) == string(hash)
+	})
+}
@@ -0,0 +1,95 @@
+package forcedinclusion_test
+
+import (
+	"context"
+	"testing"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/forcedinclusion"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+type testMetrics struct {
+	outstanding int
+	overdue     int
+	delays      []uint64
+}
+
+func (m *testMetrics) SetOutstanding(n int) {
+	m.outstanding = n
+}
+
+func (m *testMetrics) SetOverdue(n int) {
+	m.overdue = n
+}
+
+func (m *testMetrics) RecordIncluded(delayBlocks uint64) {
+	m.delays = append(m.delays, delayBlocks)
+}
+
+type testSource struct {
+	txs bfttypes.Txs
+}
+
+func (s *testSource) NewTxs(_ context.Context) (bfttypes.Txs, error) {
+	txs := s.txs
+	s.txs = nil
+	return txs, nil
+}
+
+func onBlock(t *testing.T, list *forcedinclusion.List, height uint64, txs ...bfttypes.Tx) {
+	require.NoError(t, list.OnBlock(context.Background(), &monomer.Block{
+		Header: &monomer.Header{Height: height},
+		Txs:    txs,
+	}))
+}
+
+func TestList(t *testing.T) {
+	metrics := new(testMetrics)
+	source := new(testSource)
+	list := forcedinclusion.New(2, metrics, source)
+	tx1 := bfttypes.Tx{1}
+	tx2 := bfttypes.Tx{2}
+
+	require.NoError(t, list.Add(tx1, 1))
+	require.NoError(t, list.Add(tx1, 1)) // Duplicates are ignored.
+	source.txs = bfttypes.Txs{tx2}
+	txs, err := list.Intercept(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, bfttypes.Txs{tx1, tx2}, txs)
+	require.Equal(t, []*forcedinclusion.Obligation{
+		{Tx: tx1, Height: 1, Deadline: 3},
+		{Tx: tx2, Height: 1, Deadline: 3},
+	}, list.Obligations())
+	require.Equal(t, 2, metrics.outstanding)
+
+	// A block without the tx pool doesn't include them.
+	onBlock(t, list, 1)
+	require.Len(t, list.Obligations(), 2)
+	require.Zero(t, metrics.overdue)
+
+	// tx2 is included by another route.
+	onBlock(t, list, 2, tx2)
+	require.Equal(t, []uint64{1}, metrics.delays)
+	require.Equal(t, 1, metrics.outstanding)
+
+	onBlock(t, list, 3)
+	require.Equal(t, 1, metrics.overdue)
+	txs, err = list.Intercept(context.Background(), 4)
+	require.NoError(t, err)
+	require.Equal(t, bfttypes.Txs{tx1}, txs)
+
+	onBlock(t, list, 4, tx1)
+	require.Empty(t, list.Obligations())
+	require.Equal(t, []uint64{1, 3}, metrics.delays)
+	require.Zero(t, metrics.outstanding)
+	require.Zero(t, metrics.overdue)
+}
+
+func TestAddDeposit(t *testing.T) {
+	list := forcedinclusion.New(1, forcedinclusion.NewNoopMetrics())
+	require.Error(t, list.Add(testutils.GenerateBlock(t).Txs[0], 1))
+	require.Empty(t, list.Obligations())
+}
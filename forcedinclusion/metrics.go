@@ -0,0 +1,74 @@
+package forcedinclusion
+
+import (
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "forced_inclusion"
+
+var InclusionDelayBucketsBlocks = []float64{0, 1, 2, 5, 10, 50, 100}
+
+// Metrics contains metrics collected from the forcedinclusion package.
+type Metrics interface {
+	SetOutstanding(n int)
+	SetOverdue(n int)
+	RecordIncluded(delayBlocks uint64)
+}
+
+type metrics struct {
+	// Number of obligations that have not been included yet.
+	Outstanding stdprometheus.Gauge
+	// Number of outstanding obligations past their deadline.
+	Overdue stdprometheus.Gauge
+	// Number of blocks between adding an obligation and including its tx.
+	InclusionDelay stdprometheus.Histogram
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		Outstanding: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "outstanding",
+			Help:      "Number of forced txs that have not been included yet",
+		}),
+		Overdue: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "overdue",
+			Help:      "Number of forced txs that have not been included by their deadline",
+		}),
+		InclusionDelay: promauto.NewHistogram(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "inclusion_delay_blocks",
+			Help:      "Number of blocks between forcing a tx and including it",
+			Buckets:   InclusionDelayBucketsBlocks,
+		}),
+	}
+}
+
+func (m *metrics) SetOutstanding(n int) {
+	m.Outstanding.Set(float64(n))
+}
+
+func (m *metrics) SetOverdue(n int) {
+	m.Overdue.Set(float64(n))
+}
+
+func (m *metrics) RecordIncluded(delayBlocks uint64) {
+	m.InclusionDelay.Observe(float64(delayBlocks))
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) SetOutstanding(_ int) {}
+
+func (*noopMetrics) SetOverdue(_ int) {}
+
+func (*noopMetrics) RecordIncluded(_ uint64) {}
@@ -25,6 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Module is the config object for the x/rollup module.
 type Module struct {
+	// The address that can update the module parameters. Defaults to the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
 func (m *Module) Reset()         { *m = Module{} }
@@ -60,6 +62,13 @@ func (m *Module) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Module proto.InternalMessageInfo
 
+func (m *Module) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Module)(nil), "rollup.module.v1.Module")
 }
@@ -67,19 +76,20 @@ func init() {
 func init() { proto.RegisterFile("rollup/module/v1/module.proto", fileDescriptor_5510ebceb64c57ed) }
 
 var fileDescriptor_5510ebceb64c57ed = []byte{
-	// 180 bytes of a gzipped FileDescriptorProto
+	// 199 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0xca, 0xcf, 0xc9,
 	0x29, 0x2d, 0xd0, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0xd5, 0x2f, 0x33, 0x84, 0xb2, 0xf4, 0x0a,
 	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x20, 0xd2, 0x7a, 0x50, 0xc1, 0x32, 0x43, 0x29, 0x85, 0xe4,
 	0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xc4, 0x82, 0x02, 0xfd, 0x32, 0xc3, 0xc4, 0x9c, 0x82, 0x8c,
-	0x44, 0x54, 0x3d, 0x4a, 0x16, 0x5c, 0x6c, 0xbe, 0x60, 0xbe, 0x95, 0xde, 0xae, 0x03, 0xd3, 0x6e,
-	0x31, 0x6a, 0x70, 0xa9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17,
-	0xe4, 0xe7, 0x54, 0xe6, 0xa6, 0x16, 0xa5, 0x24, 0xe6, 0xeb, 0xe7, 0xe6, 0xe7, 0xe5, 0xe7, 0xa6,
-	0x16, 0xe9, 0x57, 0xe8, 0x43, 0x6c, 0x71, 0x0a, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0x6b, 0xfc, 0x26, 0xa4, 0xa7, 0xe6, 0xe9, 0xa3, 0x7b, 0xc4, 0x1a, 0xc2, 0x2a,
-	0x33, 0x4c, 0x62, 0x03, 0xbb, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x67, 0xf2, 0xe5, 0x98,
-	0xec, 0x00, 0x00, 0x00,
+	0x44, 0x54, 0x3d, 0x4a, 0x61, 0x5c, 0x6c, 0xbe, 0x60, 0xbe, 0x90, 0x0c, 0x17, 0x67, 0x62, 0x69,
+	0x49, 0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x42, 0xc0,
+	0x4a, 0x6f, 0xd7, 0x81, 0x69, 0xb7, 0x18, 0x35, 0xb8, 0xd4, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93,
+	0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x0b, 0xf2, 0x73, 0x2a, 0x73, 0x53, 0x8b, 0x52, 0x12, 0xf3, 0xf5,
+	0x73, 0xf3, 0xf3, 0xf2, 0x73, 0x53, 0x8b, 0xf4, 0x2b, 0xf4, 0x21, 0x6e, 0x70, 0x0a, 0x3d, 0xf1,
+	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
+	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x6b, 0xfc, 0x26, 0xa4, 0xa7, 0xe6, 0xe9,
+	0xa3, 0x7b, 0xd3, 0x1a, 0xc2, 0x2a, 0x33, 0x4c, 0x62, 0x03, 0xbb, 0xda, 0x18, 0x30, 0x00, 0x83,
+	0x1b, 0xcb, 0x99, 0x0a, 0x01, 0x00, 0x00,
 }
 
 func (m *Module) Marshal() (dAtA []byte, err error) {
@@ -102,6 +112,13 @@ func (m *Module) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintModule(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -122,6 +139,10 @@ func (m *Module) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovModule(uint64(l))
+	}
 	return n
 }
 
@@ -160,6 +181,38 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Module: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModule(dAtA[iNdEx:])
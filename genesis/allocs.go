@@ -0,0 +1,170 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/polymerdao/monomer/utils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+)
+
+// Allocs are the wei balances of EVM addresses at genesis.
+type Allocs map[common.Address]*big.Int
+
+// ParseAllocs parses an OP-style allocs file, which maps addresses to accounts with a balance, e.g., a state dump of an
+// existing EVM chain, or a prefund file, which maps addresses to amounts. Amounts are numbers or decimal or 0x-prefixed
+// hex strings. Code and storage in allocs files are ignored.
+func ParseAllocs(data []byte) (Allocs, error) {
+	var entries map[common.Address]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("unmarshal allocs: %v", err)
+	}
+	allocs := make(Allocs, len(entries))
+	for addr, entry := range entries {
+		amount, err := parseAlloc(entry)
+		if err != nil {
+			return nil, fmt.Errorf("parse alloc of %s: %v", addr, err)
+		}
+		allocs[addr] = amount
+	}
+	return allocs, nil
+}
+
+func parseAlloc(entry json.RawMessage) (*big.Int, error) {
+	switch {
+	case bytes.HasPrefix(entry, []byte("{")):
+		var account struct {
+			Balance *math.HexOrDecimal256 `json:"balance"`
+		}
+		if err := json.Unmarshal(entry, &account); err != nil {
+			return nil, err
+		}
+		if account.Balance == nil {
+			return nil, errors.New("no balance")
+		}
+		return (*big.Int)(account.Balance), nil
+	case bytes.HasPrefix(entry, []byte(`"`)):
+		var amount math.HexOrDecimal256
+		if err := json.Unmarshal(entry, &amount); err != nil {
+			return nil, err
+		}
+		return (*big.Int)(&amount), nil
+	default:
+		amount := new(big.Int)
+		if err := amount.UnmarshalJSON(entry); err != nil {
+			return nil, err
+		}
+		return amount, nil
+	}
+}
+
+// FundFromAllocs credits the allocs to the Cosmos accounts of their addresses in the auth and bank genesis states of
+// appState, in the same ETH denom deposits are minted in (see ETHDenom). Accounts that don't exist are created, and
+// balances are added to existing ones. Zero balances are skipped.
+func FundFromAllocs(cdc codec.JSONCodec, appState map[string]json.RawMessage, allocs Allocs) error {
+	denom, err := ETHDenom(cdc, appState)
+	if err != nil {
+		return err
+	}
+	var authGenesis authtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[authtypes.ModuleName], &authGenesis); err != nil {
+		return fmt.Errorf("unmarshal auth genesis: %v", err)
+	}
+	var bankGenesis banktypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis); err != nil {
+		return fmt.Errorf("unmarshal bank genesis: %v", err)
+	}
+	accounts, err := authtypes.UnpackAccounts(authGenesis.Accounts)
+	if err != nil {
+		return fmt.Errorf("unpack accounts: %v", err)
+	}
+
+	existing := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		existing[account.GetAddress().String()] = struct{}{}
+	}
+	balances := make(map[string]int, len(bankGenesis.Balances))
+	for i, balance := range bankGenesis.Balances {
+		balances[balance.Address] = i
+	}
+
+	// Iterate in a deterministic order so the same allocs always produce the same genesis.
+	addrs := make([]common.Address, 0, len(allocs))
+	for addr := range allocs {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Cmp(addrs[j]) < 0
+	})
+	for _, addr := range addrs {
+		amount := allocs[addr]
+		if amount.Sign() == 0 {
+			continue
+		}
+		if amount.Sign() < 0 {
+			return fmt.Errorf("alloc of %s has a negative balance", addr)
+		}
+		cosmosAddr := utils.EvmToCosmosAddress(addr)
+		if _, ok := existing[cosmosAddr.String()]; !ok {
+			accounts = append(accounts, authtypes.NewBaseAccount(cosmosAddr, nil, 0, 0))
+			existing[cosmosAddr.String()] = struct{}{}
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))
+		if i, ok := balances[cosmosAddr.String()]; ok {
+			bankGenesis.Balances[i].Coins = bankGenesis.Balances[i].Coins.Add(coins...)
+		} else {
+			balances[cosmosAddr.String()] = len(bankGenesis.Balances)
+			bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+				Address: cosmosAddr.String(),
+				Coins:   coins,
+			})
+		}
+		// An empty supply is computed from the balances at genesis, so it only needs to be kept in sync if it is set.
+		if !bankGenesis.Supply.IsZero() {
+			bankGenesis.Supply = bankGenesis.Supply.Add(coins...)
+		}
+	}
+	bankGenesis.Balances = banktypes.SanitizeGenesisBalances(bankGenesis.Balances)
+
+	if authGenesis.Accounts, err = authtypes.PackAccounts(authtypes.SanitizeGenesisAccounts(accounts)); err != nil {
+		return fmt.Errorf("pack accounts: %v", err)
+	}
+	if err := bankGenesis.Validate(); err != nil {
+		return fmt.Errorf("validate bank genesis: %v", err)
+	}
+
+	authGenesisBytes, err := cdc.MarshalJSON(&authGenesis)
+	if err != nil {
+		return fmt.Errorf("marshal auth genesis: %v", err)
+	}
+	bankGenesisBytes, err := cdc.MarshalJSON(&bankGenesis)
+	if err != nil {
+		return fmt.Errorf("marshal bank genesis: %v", err)
+	}
+	appState[authtypes.ModuleName] = authGenesisBytes
+	appState[banktypes.ModuleName] = bankGenesisBytes
+	return nil
+}
+
+// ETHDenom returns the denom deposits are minted in at appState's genesis, i.e., the base denom of the rollup genesis
+// state's ETH denom metadata, or the default ETH denom if appState has no rollup genesis state.
+func ETHDenom(cdc codec.JSONCodec, appState map[string]json.RawMessage) (string, error) {
+	var rollupGenesis rolluptypes.GenesisState
+	if rollupState, ok := appState[rolluptypes.ModuleName]; ok {
+		if err := cdc.UnmarshalJSON(rollupState, &rollupGenesis); err != nil {
+			return "", fmt.Errorf("unmarshal rollup genesis: %v", err)
+		}
+	}
+	return rollupGenesis.ETHDenomMetadata().Base, nil
+}
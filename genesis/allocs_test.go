@@ -0,0 +1,153 @@
+package genesis_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/utils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	allocAddr1 = common.HexToAddress("0x1")
+	allocAddr2 = common.HexToAddress("0x2")
+	allocAddr3 = common.HexToAddress("0x3")
+)
+
+func TestParseAllocs(t *testing.T) {
+	tests := map[string]struct {
+		allocs string
+		want   genesis.Allocs
+		err    bool
+	}{
+		"op allocs": {
+			allocs: `{
+				"0x0000000000000000000000000000000000000001": {"balance": "0x64", "nonce": "0x1", "code": "0x00"},
+				"0x0000000000000000000000000000000000000002": {"balance": "200", "storage": {}}
+			}`,
+			want: genesis.Allocs{
+				allocAddr1: big.NewInt(100),
+				allocAddr2: big.NewInt(200),
+			},
+		},
+		"prefund": {
+			allocs: `{
+				"0x0000000000000000000000000000000000000001": "0x64",
+				"0x0000000000000000000000000000000000000002": "200",
+				"0x0000000000000000000000000000000000000003": 300
+			}`,
+			want: genesis.Allocs{
+				allocAddr1: big.NewInt(100),
+				allocAddr2: big.NewInt(200),
+				allocAddr3: big.NewInt(300),
+			},
+		},
+		"no balance": {
+			allocs: `{"0x0000000000000000000000000000000000000001": {"nonce": "0x1"}}`,
+			err:    true,
+		},
+		"invalid amount": {
+			allocs: `{"0x0000000000000000000000000000000000000001": "lots"}`,
+			err:    true,
+		},
+		"invalid address": {
+			allocs: `{"0x1": "100"}`,
+			err:    true,
+		},
+	}
+
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			allocs, err := genesis.ParseAllocs([]byte(test.allocs))
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.want, allocs)
+		})
+	}
+}
+
+func TestFundFromAllocs(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}).Codec
+	existingAddr := utils.EvmToCosmosAddress(allocAddr1)
+	accounts, err := authtypes.PackAccounts(authtypes.GenesisAccounts{authtypes.NewBaseAccount(existingAddr, nil, 0, 0)})
+	require.NoError(t, err)
+	existingCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin(rolluptypes.ETH, 10))
+	authGenesis := authtypes.DefaultGenesisState()
+	authGenesis.Accounts = accounts
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{{
+		Address: existingAddr.String(),
+		Coins:   existingCoins,
+	}}
+	bankGenesis.Supply = existingCoins
+	appState := map[string]json.RawMessage{
+		authtypes.ModuleName: cdc.MustMarshalJSON(authGenesis),
+		banktypes.ModuleName: cdc.MustMarshalJSON(bankGenesis),
+	}
+
+	require.NoError(t, genesis.FundFromAllocs(cdc, appState, genesis.Allocs{
+		allocAddr1: big.NewInt(100),
+		allocAddr2: big.NewInt(200),
+		allocAddr3: big.NewInt(0),
+	}))
+
+	var gotAuthGenesis authtypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[authtypes.ModuleName], &gotAuthGenesis)
+	gotAccounts, err := authtypes.UnpackAccounts(gotAuthGenesis.Accounts)
+	require.NoError(t, err)
+	gotAddrs := make([]sdk.AccAddress, 0, len(gotAccounts))
+	for _, account := range gotAccounts {
+		gotAddrs = append(gotAddrs, account.GetAddress())
+	}
+	require.ElementsMatch(t, []sdk.AccAddress{existingAddr, utils.EvmToCosmosAddress(allocAddr2)}, gotAddrs)
+
+	var gotBankGenesis banktypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[banktypes.ModuleName], &gotBankGenesis)
+	require.NoError(t, gotBankGenesis.Validate())
+	eth := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(rolluptypes.ETH, sdkmath.NewInt(amount)))
+	}
+	require.ElementsMatch(t, []banktypes.Balance{
+		{
+			Address: existingAddr.String(),
+			Coins:   existingCoins.Add(eth(100)...),
+		},
+		{
+			Address: utils.EvmToCosmosAddress(allocAddr2).String(),
+			Coins:   eth(200),
+		},
+	}, gotBankGenesis.Balances)
+	require.Equal(t, existingCoins.Add(eth(300)...), gotBankGenesis.Supply)
+
+	require.Error(t, genesis.FundFromAllocs(cdc, appState, genesis.Allocs{
+		allocAddr1: big.NewInt(-1),
+	}))
+
+	// Allocs are credited in the ETH denom of the rollup genesis state.
+	rollupGenesis := rolluptypes.DefaultGenesisState()
+	rollupGenesis.EthDenomMetadata.Base = "aweth"
+	rollupGenesis.EthDenomMetadata.DenomUnits[0].Denom = "aweth"
+	appState[rolluptypes.ModuleName] = cdc.MustMarshalJSON(rollupGenesis)
+	denom, err := genesis.ETHDenom(cdc, appState)
+	require.NoError(t, err)
+	require.Equal(t, "aweth", denom)
+	require.NoError(t, genesis.FundFromAllocs(cdc, appState, genesis.Allocs{
+		allocAddr2: big.NewInt(50),
+	}))
+	cdc.MustUnmarshalJSON(appState[banktypes.ModuleName], &gotBankGenesis)
+	require.Equal(t, existingCoins.Add(eth(300)...).Add(sdk.NewInt64Coin("aweth", 50)), gotBankGenesis.Supply)
+}
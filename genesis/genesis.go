@@ -19,6 +19,10 @@ type Genesis struct {
 	Time     uint64                     `json:"time"`
 	ChainID  monomer.ChainID            `json:"chain_id"`
 	AppState map[string]json.RawMessage `json:"app_state"`
+	// MinClientVersion is the oldest Monomer version that may run the chain, so chains can make upgrades mandatory. Nodes
+	// running an older version, or a version that can't be compared, refuse to start. Every version may run the chain
+	// if it is empty.
+	MinClientVersion string `json:"min_client_version,omitempty"`
 }
 
 type DB interface {
@@ -47,18 +51,18 @@ func (g *Genesis) Commit(ctx context.Context, app monomer.Application, blockStor
 		return fmt.Errorf("init chain: %v", err)
 	}
 
+	info, err := app.Info(ctx, &abci.RequestInfo{})
+	if err != nil {
+		return fmt.Errorf("info: %v", err)
+	}
 	header := &monomer.Header{
 		Height:   initialHeight,
 		ChainID:  g.ChainID,
 		Time:     g.Time,
+		AppHash:  info.GetLastBlockAppHash(),
 		GasLimit: defaultGasLimit,
 	}
 	cometHeader := header.ToComet()
-	info, err := app.Info(ctx, &abci.RequestInfo{})
-	if err != nil {
-		return fmt.Errorf("info: %v", err)
-	}
-	cometHeader.AppHash = info.GetLastBlockAppHash()
 
 	if _, err := app.FinalizeBlock(ctx, &abci.RequestFinalizeBlock{
 		Hash:               cometHeader.Hash(),
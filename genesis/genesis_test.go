@@ -51,6 +51,8 @@ func TestCommit(t *testing.T) {
 			blockStore := testutils.NewLocalMemDB(t)
 			ethstatedb := testutils.NewEthStateDB(t)
 
+			preGenesisInfo, err := app.Info(context.Background(), &abci.RequestInfo{})
+			require.NoError(t, err)
 			require.NoError(t, test.genesis.Commit(context.Background(), app, blockStore, ethstatedb))
 
 			info, err := app.Info(context.Background(), &abci.RequestInfo{})
@@ -73,6 +75,7 @@ func TestCommit(t *testing.T) {
 				Time:      test.genesis.Time,
 				GasLimit:  30_000_000, // We cheat a little and copy the default gas limit here.
 				StateRoot: evm.MonomerGenesisRootHash,
+				AppHash:   preGenesisInfo.GetLastBlockAppHash(),
 			}, bfttypes.Txs{})
 			require.NoError(t, err)
 			gotBlock, err := blockStore.BlockByHeight(uint64(info.GetLastBlockHeight()))
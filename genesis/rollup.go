@@ -0,0 +1,28 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/common"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+)
+
+// SetL1StandardBridge sets the L1StandardBridge in the rollup module params of appState, which enables ERC-20 deposits
+// through it. The rollup genesis state defaults to rolluptypes.DefaultGenesisState if appState has none.
+func SetL1StandardBridge(cdc codec.JSONCodec, appState map[string]json.RawMessage, l1StandardBridge common.Address) error {
+	rollupGenesis := rolluptypes.DefaultGenesisState()
+	if rollupState, ok := appState[rolluptypes.ModuleName]; ok {
+		if err := cdc.UnmarshalJSON(rollupState, rollupGenesis); err != nil {
+			return fmt.Errorf("unmarshal rollup genesis: %v", err)
+		}
+	}
+	rollupGenesis.Params.L1StandardBridge = l1StandardBridge.Hex()
+	rollupGenesisBytes, err := cdc.MarshalJSON(rollupGenesis)
+	if err != nil {
+		return fmt.Errorf("marshal rollup genesis: %v", err)
+	}
+	appState[rolluptypes.ModuleName] = rollupGenesisBytes
+	return nil
+}
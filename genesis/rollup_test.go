@@ -0,0 +1,37 @@
+package genesis_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/genesis"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetL1StandardBridge(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	l1StandardBridge := common.HexToAddress("0x0b1d6e")
+
+	// Without a rollup genesis state, the default one is used.
+	appState := make(map[string]json.RawMessage)
+	require.NoError(t, genesis.SetL1StandardBridge(cdc, appState, l1StandardBridge))
+	var rollupGenesis rolluptypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[rolluptypes.ModuleName], &rollupGenesis)
+	want := rolluptypes.DefaultParams()
+	want.L1StandardBridge = l1StandardBridge.Hex()
+	require.Equal(t, want, rollupGenesis.Params)
+	require.Equal(t, rolluptypes.DefaultETHDenomMetadata().Base, rollupGenesis.ETHDenomMetadata().Base)
+
+	// The rest of an existing rollup genesis state is kept.
+	rollupGenesis.Params.WithdrawalClaimer = common.HexToAddress("0x01").Hex()
+	rollupGenesis.Params.MaxWithdrawalBatchSize = 10
+	appState[rolluptypes.ModuleName] = cdc.MustMarshalJSON(&rollupGenesis)
+	require.NoError(t, genesis.SetL1StandardBridge(cdc, appState, common.HexToAddress("0x02")))
+	var got rolluptypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[rolluptypes.ModuleName], &got)
+	rollupGenesis.Params.L1StandardBridge = common.HexToAddress("0x02").Hex()
+	require.Equal(t, rollupGenesis.Params, got.Params)
+}
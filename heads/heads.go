@@ -0,0 +1,243 @@
+// Package heads notifies clients of new and finalized chain heads with server-sent events, for clients that can't hold
+// websockets open, e.g., behind proxies that only pass plain HTTP.
+package heads
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/monomerdb"
+)
+
+// Event types.
+const (
+	// EventNewHead is sent when the unsafe head changes, including to an older block after a rollback.
+	EventNewHead = "newHead"
+	// EventFinalizedHead is sent when the finalized head changes.
+	EventFinalizedHead = "finalizedHead"
+)
+
+// subscriptionBuffer is the number of events a subscriber can fall behind before it's dropped.
+const subscriptionBuffer = 64
+
+// Event is a change of a chain head.
+type Event struct {
+	Type   string
+	Header *ethtypes.Header
+}
+
+// Feed sends head events to its subscribers. Subscribers that fall behind are dropped, rather than holding up the writes
+// to the block store.
+type Feed struct {
+	mu     sync.Mutex
+	subs   map[chan *Event]string
+	latest map[string]*Event
+	closed bool
+}
+
+func NewFeed() *Feed {
+	return &Feed{
+		subs:   make(map[chan *Event]string),
+		latest: make(map[string]*Event),
+	}
+}
+
+// Subscribe returns a channel that receives the latest event of eventType, if any, and the events after it. The channel
+// is closed when the subscriber falls behind or the feed is closed. The returned function unsubscribes.
+func (f *Feed) Subscribe(eventType string) (<-chan *Event, func(), error) {
+	return f.subscribe(eventType, true)
+}
+
+// SubscribeNext is like Subscribe, but the channel only receives the events after it is called, e.g., for eth_subscribe,
+// which doesn't notify subscribers of the current head.
+func (f *Feed) SubscribeNext(eventType string) (<-chan *Event, func(), error) {
+	return f.subscribe(eventType, false)
+}
+
+func (f *Feed) subscribe(eventType string, sendLatest bool) (<-chan *Event, func(), error) {
+	if eventType != EventNewHead && eventType != EventFinalizedHead {
+		return nil, nil, fmt.Errorf("unknown event type %q", eventType)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return nil, nil, errors.New("feed closed")
+	}
+	ch := make(chan *Event, subscriptionBuffer)
+	if event, ok := f.latest[eventType]; ok && sendLatest {
+		ch <- event
+	}
+	f.subs[ch] = eventType
+	return ch, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if _, ok := f.subs[ch]; ok {
+			delete(f.subs, ch)
+			close(ch)
+		}
+	}, nil
+}
+
+func (f *Feed) publish(event *Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return
+	}
+	f.latest[event.Type] = event
+	for ch, eventType := range f.subs {
+		if eventType != event.Type {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			delete(f.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// Close closes the subscribers' channels, which ends their streams. Servers can't shut down gracefully while streams are
+// open.
+func (f *Feed) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return
+	}
+	f.closed = true
+	for ch := range f.subs {
+		close(ch)
+	}
+	clear(f.subs)
+}
+
+type DB interface {
+	UpdateLabels(unsafe, safe, finalized common.Hash) error
+	Height() (uint64, error)
+	HeaderByHash(hash common.Hash) (*monomer.Header, error)
+	Rollback(unsafe, safe, finalized common.Hash) error
+	HeaderByHeight(height uint64) (*monomer.Header, error)
+	AppendBlock(*monomer.Block) error
+	HeadHeader() (*monomer.Header, error)
+	BlockByLabel(eth.BlockLabel) (*monomer.Block, error)
+	BlockByHeight(uint64) (*monomer.Block, error)
+	BlockByHash(hash common.Hash) (*monomer.Block, error)
+	HeadBlock() (*monomer.Block, error)
+	ArchivedHeaderByHeight(uint64) (*monomer.Header, error)
+}
+
+// BlockStore publishes head events when the labels of a DB change. All label updates must go through it.
+type BlockStore struct {
+	DB
+	feed *Feed
+	// mu orders the events of concurrent label updates.
+	mu        sync.Mutex
+	unsafe    common.Hash
+	finalized common.Hash
+}
+
+// NewBlockStore returns a BlockStore that publishes to feed, starting with the current heads, if any.
+func NewBlockStore(db DB, feed *Feed) (*BlockStore, error) {
+	s := &BlockStore{
+		DB:   db,
+		feed: feed,
+	}
+	for _, head := range []struct {
+		label     eth.BlockLabel
+		eventType string
+		last      *common.Hash
+	}{
+		{eth.Unsafe, EventNewHead, &s.unsafe},
+		{eth.Finalized, EventFinalizedHead, &s.finalized},
+	} {
+		block, err := db.BlockByLabel(head.label)
+		if errors.Is(err, monomerdb.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("get %s block: %v", head.label, err)
+		}
+		event, err := newEvent(head.eventType, block)
+		if err != nil {
+			return nil, err
+		}
+		*head.last = block.Header.Hash
+		feed.publish(event)
+	}
+	return s, nil
+}
+
+func (s *BlockStore) UpdateLabels(unsafe, safe, finalized common.Hash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.DB.UpdateLabels(unsafe, safe, finalized); err != nil {
+		return err
+	}
+	return s.publish(unsafe, finalized)
+}
+
+func (s *BlockStore) Rollback(unsafe, safe, finalized common.Hash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.DB.Rollback(unsafe, safe, finalized); err != nil {
+		return err
+	}
+	return s.publish(unsafe, finalized)
+}
+
+// PruneBelow deletes the blocks below height if the DB supports pruning.
+func (s *BlockStore) PruneBelow(height uint64) error {
+	pruner, ok := s.DB.(interface {
+		PruneBelow(height uint64) error
+	})
+	if !ok {
+		return errors.New("block db does not support pruning")
+	}
+	return pruner.PruneBelow(height)
+}
+
+// publish publishes the heads that changed. If it fails, the labels are updated but their events aren't published until
+// the next update, so the error is returned for the caller to retry.
+func (s *BlockStore) publish(unsafe, finalized common.Hash) error {
+	for _, head := range []struct {
+		eventType string
+		hash      common.Hash
+		last      *common.Hash
+	}{
+		{EventNewHead, unsafe, &s.unsafe},
+		{EventFinalizedHead, finalized, &s.finalized},
+	} {
+		if head.hash == *head.last || head.hash == (common.Hash{}) {
+			continue
+		}
+		block, err := s.DB.BlockByHash(head.hash)
+		if err != nil {
+			return fmt.Errorf("get %s block: %v", head.eventType, err)
+		}
+		event, err := newEvent(head.eventType, block)
+		if err != nil {
+			return err
+		}
+		*head.last = head.hash
+		s.feed.publish(event)
+	}
+	return nil
+}
+
+// newEvent returns an event with the block's Ethereum header, whose hash is the block's hash.
+func newEvent(eventType string, block *monomer.Block) (*Event, error) {
+	ethBlock, err := block.ToEth()
+	if err != nil {
+		return nil, fmt.Errorf("convert block to eth: %v", err)
+	}
+	return &Event{
+		Type:   eventType,
+		Header: ethBlock.Header(),
+	}, nil
+}
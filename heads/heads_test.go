@@ -0,0 +1,126 @@
+package heads_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/heads"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockStore(t *testing.T) {
+	db := testutils.NewLocalMemDB(t)
+	block1 := testutils.GenerateBlockWithParentAndTxs(t, &monomer.Header{}, testapp.ToTestTx(t, "k1", "v1"))
+	require.NoError(t, db.AppendBlock(block1))
+	require.NoError(t, db.UpdateLabels(block1.Header.Hash, block1.Header.Hash, block1.Header.Hash))
+
+	feed := heads.NewFeed()
+	blockStore, err := heads.NewBlockStore(db, feed)
+	require.NoError(t, err)
+	newHeads, unsubscribe, err := feed.Subscribe(heads.EventNewHead)
+	require.NoError(t, err)
+	defer unsubscribe()
+	finalizedHeads, unsubscribe, err := feed.Subscribe(heads.EventFinalizedHead)
+	require.NoError(t, err)
+	defer unsubscribe()
+	requireHead := func(events <-chan *heads.Event, eventType string, block *monomer.Block) {
+		event := <-events
+		require.Equal(t, eventType, event.Type)
+		require.Equal(t, block.Header.Hash, event.Header.Hash())
+	}
+
+	// Subscribers start with the current heads, unless they only subscribe to the next ones.
+	requireHead(newHeads, heads.EventNewHead, block1)
+	requireHead(finalizedHeads, heads.EventFinalizedHead, block1)
+	nextHeads, unsubscribe, err := feed.SubscribeNext(heads.EventNewHead)
+	require.NoError(t, err)
+	defer unsubscribe()
+	require.Empty(t, nextHeads)
+
+	// Only the heads that changed are published.
+	block2 := testutils.GenerateBlockWithParentAndTxs(t, block1.Header, testapp.ToTestTx(t, "k2", "v2"))
+	require.NoError(t, blockStore.AppendBlock(block2))
+	require.NoError(t, blockStore.UpdateLabels(block2.Header.Hash, block2.Header.Hash, block1.Header.Hash))
+	requireHead(newHeads, heads.EventNewHead, block2)
+	requireHead(nextHeads, heads.EventNewHead, block2)
+	require.Empty(t, finalizedHeads)
+
+	require.NoError(t, blockStore.Rollback(block1.Header.Hash, block1.Header.Hash, block1.Header.Hash))
+	requireHead(newHeads, heads.EventNewHead, block1)
+	require.Empty(t, finalizedHeads)
+
+	// Subscribers that fall behind are dropped.
+	require.NoError(t, blockStore.AppendBlock(block2))
+	for i := range 100 {
+		head := block1
+		if i%2 == 0 {
+			head = block2
+		}
+		require.NoError(t, blockStore.UpdateLabels(head.Header.Hash, head.Header.Hash, block1.Header.Hash))
+	}
+	var received int
+	for range newHeads {
+		received++
+	}
+	require.Less(t, received, 100)
+	require.Empty(t, finalizedHeads)
+
+	_, _, err = feed.Subscribe("unknown")
+	require.Error(t, err)
+	feed.Close()
+	_, ok := <-finalizedHeads
+	require.False(t, ok)
+	_, _, err = feed.Subscribe(heads.EventNewHead)
+	require.Error(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	db := testutils.NewLocalMemDB(t)
+	block := testutils.GenerateBlock(t)
+	require.NoError(t, db.AppendBlock(block))
+	require.NoError(t, db.UpdateLabels(block.Header.Hash, block.Header.Hash, block.Header.Hash))
+	feed := heads.NewFeed()
+	_, err := heads.NewBlockStore(db, feed)
+	require.NoError(t, err)
+	server := httptest.NewServer(heads.NewHandler(feed, heads.EventFinalizedHead))
+	defer server.Close()
+
+	resp, err := http.Post(server.URL, "text/plain", nil) //nolint:noctx
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	resp, err = http.Get(server.URL) //nolint:noctx
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	scanner := bufio.NewScanner(resp.Body)
+	var lines []string
+	for len(lines) < 3 && scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.Len(t, lines, 3)
+	require.Equal(t, "event: "+heads.EventFinalizedHead, lines[0])
+	require.Equal(t, fmt.Sprintf("id: %d", block.Header.Height), lines[1])
+	header := new(ethtypes.Header)
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(lines[2], "data: ")), header))
+	require.Equal(t, block.Header.Hash, header.Hash())
+
+	// Closing the feed ends the stream.
+	feed.Close()
+	for scanner.Scan() {
+		require.Empty(t, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+}
@@ -0,0 +1,74 @@
+package heads
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// keepAliveInterval is how often a comment is sent on idle streams, so proxies don't close them.
+const keepAliveInterval = 15 * time.Second
+
+// NewHandler streams the events of eventType as server-sent events. Each event's data is the head's header as JSON, in
+// the same format as eth_subscribe's newHeads, and its id is the head's number. The stream starts with the current head.
+// Clients that fall behind are disconnected and should reconnect.
+func NewHandler(feed *Feed, eventType string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming not supported", http.StatusInternalServerError)
+			return
+		}
+		events, unsubscribe, err := feed.Subscribe(eventType)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		defer unsubscribe()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		// Keep proxies like nginx from buffering the stream.
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		keepAlive := time.NewTicker(keepAliveInterval)
+		defer keepAlive.Stop()
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-keepAlive.C:
+				if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+					return
+				}
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if err := writeEvent(w, event); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+		}
+	})
+}
+
+func writeEvent(w http.ResponseWriter, event *Event) error {
+	data, err := json.Marshal(event.Header)
+	if err != nil {
+		return fmt.Errorf("marshal header: %v", err)
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\nid: %d\ndata: %s\n\n", event.Type, event.Header.Number, data); err != nil {
+		return fmt.Errorf("write event: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package integrations
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/polymerdao/monomer/bundles"
+	"github.com/polymerdao/monomer/monomerdb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// envKeyReplacer maps flag names to the suffixes of their environment variables, like the SDK does for app.toml keys.
+var envKeyReplacer = strings.NewReplacer(".", "_", "-", "_")
+
+// AddMonomerCommand adds the monomer command to an appchain binary's root command. cmds are added under it as well,
+// e.g., InitCommand, GenesisCommand, and KeysCommand, so every command an operator needs is under one tree.
+func AddMonomerCommand(rootCmd *cobra.Command, appCreator servertypes.AppCreator, defaultNodeHome string, cmds ...*cobra.Command) {
+	rootCmd.AddCommand(NewMonomerCommand(appCreator, defaultNodeHome, cmds...))
+}
+
+// NewMonomerCommand returns the monomer command with cmds added under it, for binaries that mount it themselves.
+//
+// Every flag of its subcommands can be set with an environment variable named after the binary and the flag, e.g.,
+// APPD_MONOMER_ENGINE_URL for --monomer.engine-url of appd. Flags set on the command line take precedence.
+func NewMonomerCommand(appCreator servertypes.AppCreator, defaultNodeHome string, cmds ...*cobra.Command) *cobra.Command {
+	monomerCmd := &cobra.Command{
+		Use:   "monomer",
+		Short: "Monomer subcommands",
+	}
+	monomerCmd.AddCommand(StartCommand(appCreator, defaultNodeHome))
+	monomerCmd.AddCommand(auditCommand())
+	monomerCmd.AddCommand(decodeDepositCommand())
+	monomerCmd.AddCommand(whyNotIncludedCommand())
+	monomerCmd.AddCommand(disputeOutputCommand())
+	monomerCmd.AddCommand(addGenesisAllocsCommand())
+	monomerCmd.AddCommand(migrateCommand())
+	monomerCmd.AddCommand(exitCommand())
+	monomerCmd.AddCommand(dbCommand())
+	monomerCmd.AddCommand(surgeryCommand(appCreator))
+	monomerCmd.AddCommand(presetsCommand())
+	monomerCmd.AddCommand(jwtCommand())
+	monomerCmd.AddCommand(validateConfigCommand(appCreator))
+	monomerCmd.AddCommand(cmds...)
+	bindEnv(monomerCmd)
+	return monomerCmd
+}
+
+// StartCommand returns the command that starts a Monomer node running the app.
+func StartCommand(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	return server.StartCmdWithOptions(appCreator, defaultNodeHome, server.StartCmdOptions{
+		StartCommandHandler: startCommandHandler,
+		AddFlags:            addStartFlags,
+	})
+}
+
+// InitCommand returns the command that initializes the node's config and genesis files.
+func InitCommand(basicManager module.BasicManager, defaultNodeHome string) *cobra.Command {
+	return genutilcli.InitCmd(basicManager, defaultNodeHome)
+}
+
+// GenesisCommand returns the command that edits and validates the genesis file.
+func GenesisCommand(txConfig client.TxConfig, basicManager module.BasicManager, defaultNodeHome string) *cobra.Command {
+	return genutilcli.Commands(txConfig, basicManager, defaultNodeHome)
+}
+
+// KeysCommand returns the command that manages the keys in the node's keyring.
+func KeysCommand() *cobra.Command {
+	return keys.Commands()
+}
+
+// bindEnv sets the flags of cmd's runnable subcommands from the environment before they run. See NewMonomerCommand.
+func bindEnv(cmd *cobra.Command) {
+	for _, subCmd := range cmd.Commands() {
+		bindEnv(subCmd)
+	}
+	if !cmd.Runnable() {
+		return
+	}
+	// Cobra only runs PreRun if PreRunE isn't set.
+	preRun, preRunE := cmd.PreRun, cmd.PreRunE
+	cmd.PreRun = nil
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := setFlagsFromEnv(cmd, envPrefix(cmd)); err != nil {
+			return err
+		}
+		if preRunE != nil {
+			return preRunE(cmd, args)
+		} else if preRun != nil {
+			preRun(cmd, args)
+		}
+		return nil
+	}
+}
+
+// envPrefix returns the prefix of the environment variables of cmd's flags. It is the name of the executable, which
+// the SDK uses for the variables of app.toml keys.
+func envPrefix(cmd *cobra.Command) string {
+	executable, err := os.Executable()
+	if err != nil {
+		return cmd.Root().Name()
+	}
+	return filepath.Base(executable)
+}
+
+// envVar returns the environment variable of a flag.
+func envVar(prefix, flag string) string {
+	return strings.ToUpper(envKeyReplacer.Replace(prefix + "_" + flag))
+}
+
+// setFlagsFromEnv sets the flags of cmd that aren't set on the command line from their environment variables.
+func setFlagsFromEnv(cmd *cobra.Command, prefix string) error {
+	var errs []error
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if f.Changed {
+			return
+		}
+		name := envVar(prefix, f.Name)
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return
+		}
+		if err := cmd.Flags().Set(f.Name, value); err != nil {
+			errs = append(errs, fmt.Errorf("set --%s from %s: %v", f.Name, name, err))
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// addFlagCompletions completes the values of the start flags that take one of a few values or a path.
+func addFlagCompletions(cmd *cobra.Command) {
+	for flag, values := range map[string][]string{
+		flagConsensus:    {consensusRollup, consensusLocal},
+		flagCompression:  {string(monomerdb.CompressionNone), string(monomerdb.CompressionSnappy), string(monomerdb.CompressionZstd)},
+		flagBundlePolicy: {bundles.PolicyFirstSubmitted, bundles.PolicyHighestFee},
+		flagPreset:       {presetDevnet, presetTestnet},
+	} {
+		_ = cmd.RegisterFlagCompletionFunc(flag, cobra.FixedCompletions(values, cobra.ShellCompDirectiveNoFileComp))
+	}
+	for flag, extensions := range map[string][]string{
+		flagAdmissionPolicy:   {"wasm"},
+		flagBuilderSecrets:    {"json"},
+		flagRollupConfig:      {"json"},
+		flagEngineJWT:         nil,
+		flagL1AllocsPath:      {"json"},
+		flagL1DeploymentsPath: {"json"},
+		flagDeployConfigPath:  {"json"},
+		flagMneumonicsPath:    nil,
+	} {
+		_ = cmd.MarkFlagFilename(flag, extensions...)
+	}
+	_ = cmd.MarkFlagDirname(flagColdBlockStore)
+}
@@ -0,0 +1,96 @@
+package integrations
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvVar(t *testing.T) {
+	require.Equal(t, "APPD_MONOMER_ENGINE_URL", envVar("appd", flagEngineURL))
+	require.Equal(t, "APPD_MONOMER_TX_FORWARD_SEQUENCER_URL", envVar("appd", flagTxForwardURL))
+}
+
+func TestBindEnv(t *testing.T) {
+	var preRan bool
+	cmd := &cobra.Command{
+		Use: "test",
+		PreRun: func(*cobra.Command, []string) {
+			preRan = true
+		},
+		RunE: func(*cobra.Command, []string) error {
+			return nil
+		},
+	}
+	cmd.Flags().String(flagEngineURL, "", "")
+	cmd.Flags().StringSlice(flagHTTPAPI, nil, "")
+	cmd.Flags().Int(flagQueryCacheSize, 0, "")
+	bindEnv(cmd)
+	prefix := envPrefix(cmd)
+
+	t.Setenv(envVar(prefix, flagEngineURL), "ws://env:9000")
+	t.Setenv(envVar(prefix, flagHTTPAPI), "eth,monomer")
+	cmd.SetArgs([]string{"--" + flagQueryCacheSize, "1"})
+	require.NoError(t, cmd.Execute())
+	require.True(t, preRan)
+	engineURL, err := cmd.Flags().GetString(flagEngineURL)
+	require.NoError(t, err)
+	require.Equal(t, "ws://env:9000", engineURL)
+	httpAPI, err := cmd.Flags().GetStringSlice(flagHTTPAPI)
+	require.NoError(t, err)
+	require.Equal(t, []string{"eth", "monomer"}, httpAPI)
+
+	// Flags set on the command line take precedence.
+	t.Setenv(envVar(prefix, flagQueryCacheSize), "2")
+	cmd.SetArgs([]string{"--" + flagQueryCacheSize, "1"})
+	require.NoError(t, cmd.Execute())
+	queryCacheSize, err := cmd.Flags().GetInt(flagQueryCacheSize)
+	require.NoError(t, err)
+	require.Equal(t, 1, queryCacheSize)
+}
+
+func TestBindEnvInvalidValue(t *testing.T) {
+	cmd := &cobra.Command{
+		Use: "test",
+		RunE: func(*cobra.Command, []string) error {
+			return nil
+		},
+	}
+	cmd.Flags().Int(flagQueryCacheSize, 0, "")
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	bindEnv(cmd)
+	name := envVar(envPrefix(cmd), flagQueryCacheSize)
+	t.Setenv(name, "many")
+	cmd.SetArgs(nil)
+	require.ErrorContains(t, cmd.Execute(), name)
+}
+
+func TestNewMonomerCommand(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "appd"}
+	AddMonomerCommand(rootCmd, nil, t.TempDir(), KeysCommand())
+	for _, path := range [][]string{
+		{"monomer", "start"},
+		{"monomer", "keys", "add"},
+		{"monomer", "validate-config"},
+	} {
+		cmd, _, err := rootCmd.Find(path)
+		require.NoError(t, err)
+		require.Equal(t, path[len(path)-1], cmd.Name())
+	}
+
+	complete := func(args ...string) []string {
+		var out bytes.Buffer
+		rootCmd.SetOut(&out)
+		rootCmd.SetArgs(append([]string{cobra.ShellCompRequestCmd}, args...))
+		require.NoError(t, rootCmd.Execute())
+		// The last line is the completion directive.
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		return lines[:len(lines)-1]
+	}
+	require.Equal(t, []string{consensusRollup, consensusLocal}, complete("monomer", "start", "--"+flagConsensus, ""))
+	require.Equal(t, []string{presetDevnet, presetTestnet}, complete("monomer", "validate-config", "--"+flagPreset, ""))
+}
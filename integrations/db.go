@@ -0,0 +1,188 @@
+package integrations
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	cometdb "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/polymerdao/monomer/app/peptide/txstore"
+	"github.com/polymerdao/monomer/monomerdb"
+	"github.com/polymerdao/monomer/monomerdb/localdb"
+	"github.com/polymerdao/monomer/utils"
+	"github.com/spf13/cobra"
+)
+
+func dbCommand() *cobra.Command {
+	dbCmd := &cobra.Command{
+		Use:   "db",
+		Short: "Database subcommands",
+		Long:  "Database subcommands. The node must be stopped, since they open its databases.",
+	}
+	dbCmd.AddCommand(&cobra.Command{
+		Use:   "usage",
+		Short: "Print the block store's disk usage by bucket and its compaction stats",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			blockdb, closeBlockDB, err := openBlockStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				err = errors.Join(err, closeBlockDB())
+			}()
+			usage, err := blockdb.Usage()
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) //nolint:mnd
+			names := make([]string, 0, len(usage.Buckets))
+			for name := range usage.Buckets {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			for _, name := range names {
+				fmt.Fprintf(w, "%s\t%d\n", name, usage.Buckets[name])
+			}
+			fmt.Fprintf(w, "disk bytes\t%d\n", usage.DiskBytes)
+			fmt.Fprintf(w, "compaction debt bytes\t%d\n", usage.CompactionDebt)
+			return w.Flush()
+		},
+	})
+	dbCmd.AddCommand(&cobra.Command{
+		Use:   "compact",
+		Short: "Compact the block store and the eth state db",
+		Long: "Compact the block store and the eth state db, which reclaims the space of deleted and overwritten " +
+			"keys, e.g., after pruning. Large databases can take a long time to compact.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			out := cmd.OutOrStdout()
+			blockdb, closeBlockDB, err := openBlockStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				err = errors.Join(err, closeBlockDB())
+			}()
+			start := time.Now()
+			if err := blockdb.Compact(nil, nil); err != nil {
+				return fmt.Errorf("compact block store: %v", err)
+			}
+			fmt.Fprintf(out, "Compacted the block store in %s\n", time.Since(start).Round(time.Millisecond))
+
+			rawDB, err := rawdb.NewPebbleDBDatabase(
+				filepath.Join(server.GetServerContextFromCmd(cmd).Config.RootDir, "ethstate"),
+				defaultCacheSize,
+				defaultHandlesSize,
+				"",
+				false,
+				false,
+			)
+			if err != nil {
+				return fmt.Errorf("open eth state db: %v", err)
+			}
+			defer func() {
+				err = utils.WrapCloseErr(err, rawDB)
+			}()
+			start = time.Now()
+			if err := rawDB.Compact(nil, nil); err != nil {
+				return fmt.Errorf("compact eth state db: %v", err)
+			}
+			fmt.Fprintf(out, "Compacted the eth state db in %s\n", time.Since(start).Round(time.Millisecond))
+			return nil
+		},
+	})
+	dbCmd.AddCommand(&cobra.Command{
+		Use:   "recompress <none|snappy|zstd>",
+		Short: "Rewrite the stored txs and tx results with a compression",
+		Long: "Rewrite the txs in the block store and the tx results in the tx db with a compression, e.g., to compress the " +
+			"data stored before --" + flagCompression + " was set. Start the node with the same --" + flagCompression +
+			" afterwards. An interrupted recompression can be run again.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			compression, err := monomerdb.ParseCompression(args[0])
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			blockdb, closeBlockDB, err := openBlockStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				err = errors.Join(err, closeBlockDB())
+			}()
+			start := time.Now()
+			if err := blockdb.Recompress(compression); err != nil {
+				return fmt.Errorf("recompress block store: %v", err)
+			}
+			fmt.Fprintf(out, "Recompressed the block store in %s\n", time.Since(start).Round(time.Millisecond))
+
+			config := server.GetServerContextFromCmd(cmd).Config
+			txdb, err := cometdb.NewDB("tx", cometdb.BackendType(config.DBBackend), config.RootDir)
+			if err != nil {
+				return fmt.Errorf("open tx db: %v", err)
+			}
+			defer func() {
+				err = utils.WrapCloseErr(err, txdb)
+			}()
+			start = time.Now()
+			if err := txstore.Recompress(txdb, compression); err != nil {
+				return fmt.Errorf("recompress tx db: %v", err)
+			}
+			fmt.Fprintf(out, "Recompressed the tx db in %s\n", time.Since(start).Round(time.Millisecond))
+			return nil
+		},
+	})
+	dbCmd.PersistentFlags().String(flagColdBlockStore, "", "path of the block store's cold keyspace; relative to the home directory")
+	return dbCmd
+}
+
+func openBlockStoreFromCmd(cmd *cobra.Command) (*localdb.DB, func() error, error) {
+	coldPath, err := cmd.Flags().GetString(flagColdBlockStore)
+	if err != nil {
+		return nil, nil, err
+	}
+	return openBlockStore(server.GetServerContextFromCmd(cmd).Config.RootDir, coldPath)
+}
+
+// defaultColdBlockStorePath is where the block store's cold keyspace is kept by default, relative to the home directory.
+const defaultColdBlockStorePath = "blockstore-cold"
+
+// openBlockStore opens the block store in the home directory, with its cold keyspace at coldPath. A relative coldPath is
+// relative to the home directory. The returned function closes the block store. It fails if the node is running.
+func openBlockStore(rootDir, coldPath string) (*localdb.DB, func() error, error) {
+	if coldPath == "" {
+		coldPath = defaultColdBlockStorePath
+	}
+	if !filepath.IsAbs(coldPath) {
+		coldPath = filepath.Join(rootDir, coldPath)
+	}
+	hot, err := pebble.Open(filepath.Join(rootDir, "blockstore"), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open block store: %v", err)
+	}
+	cache := pebble.NewCache(defaultCacheSize << 20) //nolint:mnd
+	defer cache.Unref()
+	coldOptions := localdb.ColdOptions()
+	coldOptions.Cache = cache
+	cold, err := pebble.Open(coldPath, coldOptions)
+	if err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("open cold block store: %v", err), hot.Close())
+	}
+	closeFn := func() error {
+		return errors.Join(cold.Close(), hot.Close())
+	}
+	blockdb, err := localdb.NewSplit(hot, cold)
+	if err != nil {
+		return nil, nil, errors.Join(err, closeFn())
+	}
+	return blockdb, closeFn, nil
+}
@@ -0,0 +1,147 @@
+package integrations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	opclient "github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer/dispute"
+	"github.com/polymerdao/monomer/eth"
+	"github.com/spf13/cobra"
+)
+
+func disputeOutputCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute-output <output-index>",
+		Short: "Check an L2OutputOracle output proposal against the output root Monomer computes",
+		Long: "Check the L2OutputOracle output proposal at an index against the output root a Monomer node computes at " +
+			"its block. If the proposed root is wrong, print which of its components (state root, withdrawal root, " +
+			"block hash) diverge. An output root only commits to its components through a hash, so the diverging " +
+			"components are found with the proposer's rollup node, given with --proposer-node-url.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			index, ok := new(big.Int).SetString(args[0], 10)
+			if !ok || !index.IsUint64() {
+				return fmt.Errorf("invalid output index %q", args[0])
+			}
+			l1URL, err := cmd.Flags().GetString("l1-url")
+			if err != nil {
+				return err
+			}
+			oracleHex, err := cmd.Flags().GetString("l2-output-oracle")
+			if err != nil {
+				return err
+			}
+			if oracleHex == "" {
+				return fmt.Errorf("--l2-output-oracle is required")
+			} else if !common.IsHexAddress(oracleHex) {
+				return fmt.Errorf("invalid --l2-output-oracle address %q", oracleHex)
+			}
+			monomerURL, err := cmd.Flags().GetString("monomer-url")
+			if err != nil {
+				return err
+			}
+			proposerNodeURL, err := cmd.Flags().GetString("proposer-node-url")
+			if err != nil {
+				return err
+			}
+			asJSON, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return err
+			}
+
+			l1Client, err := ethclient.DialContext(cmd.Context(), l1URL)
+			if err != nil {
+				return fmt.Errorf("dial L1: %v", err)
+			}
+			defer l1Client.Close()
+			oracle, err := opbindings.NewL2OutputOracleCaller(common.HexToAddress(oracleHex), l1Client)
+			if err != nil {
+				return fmt.Errorf("new L2OutputOracle caller: %v", err)
+			}
+			proposal, err := oracle.GetL2Output(&bind.CallOpts{Context: cmd.Context()}, index)
+			if err != nil {
+				return fmt.Errorf("get output proposal %d: %v", index, err)
+			}
+			if !proposal.L2BlockNumber.IsUint64() {
+				return fmt.Errorf("output proposal %d is at invalid block %v", index, proposal.L2BlockNumber)
+			}
+			blockNumber := proposal.L2BlockNumber.Uint64()
+
+			correct, err := monomerOutputAtBlock(cmd.Context(), monomerURL, blockNumber)
+			if err != nil {
+				return err
+			}
+			var proposed *eth.Output
+			if proposerNodeURL != "" {
+				if proposed, err = opNodeOutputAtBlock(cmd.Context(), proposerNodeURL, blockNumber); err != nil {
+					return err
+				}
+			}
+			report, err := dispute.NewReport(index.Uint64(), &proposal, correct, proposed)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if asJSON {
+				encoder := json.NewEncoder(out)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(report)
+			}
+			return report.Print(out)
+		},
+	}
+	cmd.Flags().String("l1-url", "http://127.0.0.1:8545", "url of an L1 JSON-RPC endpoint")
+	cmd.Flags().String("l2-output-oracle", "", "L2OutputOracle address")
+	cmd.Flags().String("monomer-url", "http://127.0.0.1:8551", "url of the Monomer node's engine JSON-RPC endpoint")
+	cmd.Flags().String("proposer-node-url", "", "url of the rollup node the proposer read the output from, to find the diverging components")
+	cmd.Flags().Bool("json", false, "print the report as JSON")
+	return cmd
+}
+
+// monomerOutputAtBlock returns the output the Monomer node computes at the block.
+func monomerOutputAtBlock(ctx context.Context, url string, number uint64) (*eth.Output, error) {
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("dial monomer: %v", err)
+	}
+	defer client.Close()
+	var outputs []*eth.Output
+	if err := client.CallContext(ctx, &outputs, "monomer_"+eth.OutputsAtBlocksMethodName, []hexutil.Uint64{hexutil.Uint64(number)}); err != nil {
+		return nil, fmt.Errorf("get output at block %d: %v", number, err)
+	}
+	if len(outputs) != 1 || outputs[0] == nil {
+		return nil, fmt.Errorf("monomer has no output at block %d", number)
+	}
+	return outputs[0], nil
+}
+
+// opNodeOutputAtBlock returns the output the op-node computes at the block.
+func opNodeOutputAtBlock(ctx context.Context, url string, number uint64) (*eth.Output, error) {
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("dial proposer node: %v", err)
+	}
+	defer client.Close()
+	output, err := sources.NewRollupClient(opclient.NewBaseRPCClient(client)).OutputAtBlock(ctx, number)
+	if err != nil {
+		return nil, fmt.Errorf("get proposer node output at block %d: %v", number, err)
+	}
+	return &eth.Output{
+		BlockNumber:           hexutil.Uint64(output.BlockRef.Number),
+		BlockHash:             output.BlockRef.Hash,
+		StateRoot:             output.StateRoot,
+		WithdrawalStorageRoot: output.WithdrawalStorageRoot,
+		OutputRoot:            common.Hash(output.OutputRoot),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package integrations
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/spf13/cobra"
+)
+
+func addGenesisAllocsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-genesis-allocs <allocs-file>",
+		Short: "Fund genesis accounts from an OP-style allocs or prefund file",
+		Long: "Fund genesis accounts from an OP-style allocs file, e.g., a state dump of an existing EVM chain or a " +
+			"devnet's allocs, or a prefund file mapping addresses to amounts in wei. Each address is credited in " +
+			"its Cosmos account, the same account L1 deposits from it are minted to. Code and storage are ignored.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			allocsJSON, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("read allocs: %v", err)
+			}
+			allocs, err := genesis.ParseAllocs(allocsJSON)
+			if err != nil {
+				return err
+			}
+
+			genesisPath := server.GetServerContextFromCmd(cmd).Config.GenesisFile()
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genesisPath)
+			if err != nil {
+				return fmt.Errorf("load application genesis file: %v", err)
+			}
+			appState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+			if err != nil {
+				return fmt.Errorf("unmarshal app state: %v", err)
+			}
+			cdc := client.GetClientContextFromCmd(cmd).Codec
+			denom, err := genesis.ETHDenom(cdc, appState)
+			if err != nil {
+				return err
+			}
+			if err := genesis.FundFromAllocs(cdc, appState, allocs); err != nil {
+				return fmt.Errorf("fund accounts: %v", err)
+			}
+			if appGenesis.AppState, err = json.Marshal(appState); err != nil {
+				return fmt.Errorf("marshal app state: %v", err)
+			}
+			if err := genutil.ExportGenesisFile(appGenesis, genesisPath); err != nil {
+				return fmt.Errorf("export genesis file: %v", err)
+			}
+
+			var funded int
+			total := new(big.Int)
+			for _, amount := range allocs {
+				if amount.Sign() > 0 {
+					funded++
+					total.Add(total, amount)
+				}
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Funded %d accounts with %s%s\n", funded, total, denom)
+			return nil
+		},
+	}
+}
@@ -11,10 +11,14 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
+	pruningtypes "cosmossdk.io/store/pruning/types"
 	"github.com/cockroachdb/pebble"
 	"github.com/cockroachdb/pebble/vfs"
 	cometdb "github.com/cometbft/cometbft-db"
@@ -28,21 +32,51 @@ import (
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	opgenesis "github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	opclient "github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/ethclient"
 	ethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/hashicorp/go-plugin"
 	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/admission"
+	"github.com/polymerdao/monomer/audit"
+	bindings "github.com/polymerdao/monomer/bindings/generated"
+	"github.com/polymerdao/monomer/builder"
+	"github.com/polymerdao/monomer/buildinfo"
+	"github.com/polymerdao/monomer/bundles"
+	"github.com/polymerdao/monomer/comet"
+	"github.com/polymerdao/monomer/compaction"
+	"github.com/polymerdao/monomer/crash"
+	"github.com/polymerdao/monomer/deposit"
+	"github.com/polymerdao/monomer/depositsla"
 	"github.com/polymerdao/monomer/e2e/url"
 	"github.com/polymerdao/monomer/environment"
 	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/jwtauth"
+	"github.com/polymerdao/monomer/l1"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/mempoolsync"
+	"github.com/polymerdao/monomer/monomerdb"
 	"github.com/polymerdao/monomer/monomerdb/localdb"
 	"github.com/polymerdao/monomer/node"
 	"github.com/polymerdao/monomer/opdevnet"
+	"github.com/polymerdao/monomer/opnode"
+	"github.com/polymerdao/monomer/pruning"
+	"github.com/polymerdao/monomer/surgery"
+	"github.com/polymerdao/monomer/systemconfig"
+	"github.com/polymerdao/monomer/telemetry"
+	"github.com/polymerdao/monomer/txforward"
 	"github.com/polymerdao/monomer/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -60,32 +94,270 @@ const (
 	flagMneumonicsPath    = "monomer.dev.mneumonics"
 	flagL1URL             = "monomer.dev.l1-url"
 	flagOPNodeURL         = "monomer.dev.op-node-url"
+	flagFirehose          = "monomer.firehose"
+	flagAdmissionPolicy   = "monomer.admission-policy"
+	flagL1RPCURL          = "monomer.l1-rpc-url"
+	flagPruningPortal     = "monomer.pruning.optimism-portal"
+	flagPruningOracle     = "monomer.pruning.l2-output-oracle"
+	flagPruningInterval   = "monomer.pruning.interval"
+	flagCompactInterval   = "monomer.compaction.interval"
+	flagColdBlockStore    = "monomer.blockstore.cold-path"
+	flagCompression       = "monomer.compression"
+	flagHTTPAPI           = "monomer.http.api"
+	flagWSAPI             = "monomer.ws.api"
+	flagLocalAPI          = "monomer.local-api"
+	flagIPCPath           = "monomer.ipc.path"
+	flagIPCAPI            = "monomer.ipc.api"
+	flagQueryTimeout      = "monomer.query-timeout"
+	flagQueryCacheSize    = "monomer.query-cache.size"
+	flagQueryCacheTTL     = "monomer.query-cache.ttl"
+	flagBlockCacheSize    = "monomer.block-cache.size"
+	flagBuilderAPIAddr    = "monomer.builder-api.addr"
+	flagBuilderSecrets    = "monomer.builder-api.secrets"
+	flagBuilderJWTWindow  = "monomer.builder-api.jwt-rotation-window"
+	flagBundlePolicy      = "monomer.builder-api.policy"
+	flagBundleFeeDenom    = "monomer.builder-api.fee-denom"
+	flagTelemetryEndpoint = "monomer.telemetry.endpoint"
+	flagTelemetryInterval = "monomer.telemetry.interval"
+	flagConsensus         = "monomer.consensus"
+	flagPreset            = "monomer.preset"
+	flagRollupConfig      = "monomer.rollup-config"
+	flagMonitorURL        = "monomer.op-node.url"
+	flagMonitorStall      = "monomer.op-node.stall-timeout"
+	flagMonitorMaxLag     = "monomer.op-node.max-lag"
+	flagMonitorMaxL1Lag   = "monomer.op-node.max-l1-lag"
+	flagEngineJWT         = "monomer.engine.jwt-secret"
+	flagEngineJWTWindow   = "monomer.engine.jwt-rotation-window"
+	flagEngineJWTHex      = "monomer.engine.jwt-secret-hex"
+	flagWitness           = "monomer.witness"
+	flagSysCfgCheck       = "monomer.system-config.check"
+	flagSysCfgInterval    = "monomer.system-config.interval"
+	flagSysCfgSigner      = "monomer.system-config.unsafe-block-signer"
+	flagSysCfgBatcher     = "monomer.system-config.batcher"
+	flagSysCfgGasLimit    = "monomer.system-config.gas-limit"
+	flagTxForwardURL      = "monomer.tx-forward.sequencer-url"
+	flagTxForwardAttempts = "monomer.tx-forward.max-attempts"
+	flagTxForwardBackoff  = "monomer.tx-forward.retry-backoff"
+	flagTxForwardTimeout  = "monomer.tx-forward.timeout"
+	flagTxForwardDedupe   = "monomer.tx-forward.dedupe-ttl"
+	flagReplica           = "monomer.replica"
+	flagMempoolPeers      = "monomer.mempool-sync.peers"
+	flagMempoolInterval   = "monomer.mempool-sync.interval"
+	flagMempoolMaxTxs     = "monomer.mempool-sync.max-txs"
+	flagMempoolSize       = "monomer.mempool.size"
+	flagMempoolFeeDenom   = "monomer.mempool.fee-denom"
+	flagDepositSLADelay   = "monomer.deposit-sla.max-delay"
+	flagDepositSLAWindow  = "monomer.deposit-sla.window"
+	flagFreezeHeight      = "monomer.surgery.freeze-height"
+	flagStatePatch        = "monomer.surgery.patch"
+	flagMinApprovals      = "monomer.surgery.min-approvals"
+	flagLocalBlockTime    = "monomer.local.block-time"
+	flagLocalTimeStep     = "monomer.local.time-step"
+
+	// consensusRollup follows op-node's forkchoice updates, and consensusLocal builds blocks on a timer instead.
+	consensusRollup = "rollup"
+	consensusLocal  = "local"
+
+	auditLogFileName = "audit.log"
 
 	defaultCacheSize   = 16 // 16 MB
 	defaultHandlesSize = 16
+
+	defaultBlockCacheSize = 64 // 64 MB
 )
 
 var sigCh = make(chan os.Signal, 1)
 
-func AddMonomerCommand(rootCmd *cobra.Command, appCreator servertypes.AppCreator, defaultNodeHome string) {
-	monomerCmd := &cobra.Command{
-		Use:   "monomer",
-		Short: "Monomer subcommands",
-	}
-	monomerCmd.AddCommand(server.StartCmdWithOptions(appCreator, defaultNodeHome, server.StartCmdOptions{
-		StartCommandHandler: startCommandHandler,
-		AddFlags: func(cmd *cobra.Command) {
-			cmd.Flags().String(flagEngineURL, "ws://127.0.0.1:9000", "url of Monomer's Engine API endpoint")
-			cmd.Flags().Bool(flagDev, false, "run the OP Stack devnet in-process for testing")
-			cmd.Flags().String(flagL1URL, "ws://127.0.0.1:9001", "")
-			cmd.Flags().String(flagOPNodeURL, "http://127.0.0.1:9002", "")
-			cmd.Flags().String(flagL1DeploymentsPath, "", "")
-			cmd.Flags().String(flagDeployConfigPath, "", "")
-			cmd.Flags().String(flagL1AllocsPath, "", "")
-			cmd.Flags().String(flagMneumonicsPath, "", "")
+// addStartFlags adds the flags of the start command, which validate-config checks as well.
+func addStartFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagEngineURL, "ws://127.0.0.1:9000", "url of Monomer's Engine API endpoint")
+	cmd.Flags().Bool(flagDev, false, "run the OP Stack devnet in-process for testing")
+	cmd.Flags().Bool(flagFirehose, false, "write every block to stdout in the Firehose console reader protocol")
+	cmd.Flags().String(flagAdmissionPolicy, "", "path to a WASM policy program evaluated on every tx submitted to the mempool")
+	cmd.Flags().String(flagL1RPCURL, "http://127.0.0.1:8545", "url of the L1 JSON-RPC endpoint features that read L1 share")
+	cmd.Flags().String(flagPruningPortal, "", "OptimismPortal2 address; keep the blocks its dispute games may need when pruning")
+	cmd.Flags().String(flagPruningOracle, "", "L2OutputOracle address; keep the blocks its outputs may need when pruning")
+	cmd.Flags().Duration(flagPruningInterval, pruning.DefaultInterval, "how often the challenge window is read from L1")
+	cmd.Flags().String(flagColdBlockStore, "", "path of the block store's cold keyspace, the historical blocks and their indexes; relative to the home directory, "+defaultColdBlockStorePath+" if empty")
+	cmd.Flags().String(flagCompression, string(monomerdb.CompressionNone), "how the txs in blocks and the tx results are compressed when they are stored: none, snappy, or zstd; see the db recompress command to rewrite stored data")
+	cmd.Flags().Duration(flagCompactInterval, 0, "how often the block store and eth state db are compacted between blocks; 0 disables scheduled compactions")
+	cmd.Flags().StringSlice(flagHTTPAPI, []string{"engine", "eth", "net", "txpool", "debug", "monomer"}, "namespaces served over HTTP on the Engine API endpoint")
+	cmd.Flags().StringSlice(flagWSAPI, []string{"engine", "eth", "net", "txpool", "debug", "monomer"}, "namespaces served over websockets on the Engine API endpoint")
+	cmd.Flags().StringSlice(flagLocalAPI, []string{"debug"}, "namespaces only served to clients connecting from localhost")
+	cmd.Flags().String(flagIPCPath, "", "path of a unix socket serving the Engine API endpoint's namespaces; relative to the home directory")
+	cmd.Flags().Duration(flagQueryTimeout, 10*time.Second, "deadline of abci_query requests; 0 for none")
+	cmd.Flags().Int(flagQueryCacheSize, comet.DefaultQueryCacheSize, "number of abci_query and eth_call query responses cached; 0 disables the cache")
+	cmd.Flags().Duration(flagQueryCacheTTL, comet.DefaultQueryCacheTTL, "how long query responses are cached")
+	cmd.Flags().Int(flagBlockCacheSize, defaultBlockCacheSize, "memory budget in MB of the cache of recent blocks and tx results the RPC servers share; 0 disables the cache")
+	cmd.Flags().StringSlice(flagIPCAPI, []string{"engine", "eth", "net", "txpool", "debug", "monomer"}, "namespaces served over the unix socket")
+	cmd.Flags().String(flagBuilderAPIAddr, "", "address of the builder API, where external block builders submit bundles; disabled if empty")
+	cmd.Flags().String(flagBuilderSecrets, "", "path to a JSON file mapping builder names to their hex-encoded JWT secret files, which are reloaded while the node runs")
+	cmd.Flags().Duration(flagBuilderJWTWindow, jwtauth.DefaultRotationWindow, "how long a builder's previous JWT secret is accepted after its secret file changes")
+	cmd.Flags().String(flagBundlePolicy, bundles.PolicyFirstSubmitted, "how to choose among the bundles for a block: first-submitted or highest-fee")
+	cmd.Flags().String(flagBundleFeeDenom, "", "fee denom the highest-fee bundle policy compares")
+	cmd.Flags().String(flagTelemetryEndpoint, "", "URL to send anonymous usage and crash reports to; disabled if empty")
+	cmd.Flags().Duration(flagTelemetryInterval, time.Hour, "how often to send usage reports to the telemetry endpoint")
+	cmd.Flags().String(flagPreset, "", "devnet or testnet to default the flags that aren't set to the preset's; see the presets command")
+	cmd.Flags().String(flagRollupConfig, "", "rollup.json path or op-node URL; the node refuses to start if the genesis doesn't match the rollup config's")
+	cmd.Flags().String(flagMonitorURL, "", "url of the op-node driving the node, whose sync status is monitored; disabled if empty")
+	cmd.Flags().Duration(flagMonitorStall, opnode.DefaultStallTimeout, "how long the safe head can stay behind the unsafe head without advancing before derivation is considered stalled")
+	cmd.Flags().Uint64(flagMonitorMaxLag, 0, "number of unsafe blocks the safe head can lag behind before the health route fails; 0 disables the check")
+	cmd.Flags().Uint64(flagMonitorMaxL1Lag, 0, "number of L1 blocks derivation can lag behind the L1 head before the health route fails; 0 disables the check")
+	cmd.Flags().String(flagEngineJWT, "", "path to the hex-encoded JWT secret the engine namespace's requests must be signed with; reloaded while the node runs; unauthenticated if empty; see the jwt command")
+	cmd.Flags().String(flagEngineJWTHex, "", "hex-encoded JWT secret the engine namespace's requests must be signed with, instead of a secret file; never rotated")
+	cmd.Flags().Duration(flagEngineJWTWindow, jwtauth.DefaultRotationWindow, "how long the previous JWT secret is accepted after the secret file changes")
+	cmd.Flags().Bool(flagWitness, false, "record the execution witness of every block and serve it with monomer_getWitness")
+	cmd.Flags().Bool(flagSysCfgCheck, false, "periodically check the L1 SystemConfig against the rollup config and report the parameters that diverge")
+	cmd.Flags().Duration(flagSysCfgInterval, systemconfig.DefaultInterval, "how often the L1 SystemConfig is checked")
+	cmd.Flags().String(flagSysCfgSigner, "", "address op-node accepts gossiped blocks from, checked against the SystemConfig's unsafe block signer; unchecked if empty")
+	cmd.Flags().String(flagSysCfgBatcher, "", "batcher address the SystemConfig should have, if it was rotated since genesis; defaults to the rollup config's")
+	cmd.Flags().Uint64(flagSysCfgGasLimit, 0, "gas limit the SystemConfig should have, if it was changed since genesis; defaults to the rollup config's")
+	cmd.Flags().String(flagTxForwardURL, "", "CometBFT RPC url of the sequencer the txs submitted to the node are forwarded to, for nodes that don't sequence; disabled if empty")
+	cmd.Flags().Int(flagTxForwardAttempts, txforward.DefaultMaxAttempts, "how many times a tx is sent to the sequencer before forwarding fails")
+	cmd.Flags().Duration(flagTxForwardBackoff, txforward.DefaultRetryBackoff, "how long the first retry of a forwarded tx waits; each retry waits twice as long")
+	cmd.Flags().Duration(flagTxForwardTimeout, txforward.DefaultTimeout, "deadline of each attempt to forward a tx")
+	cmd.Flags().Duration(flagTxForwardDedupe, txforward.DefaultDedupeTTL, "how long a forwarded tx isn't forwarded again when it's resubmitted")
+	cmd.Flags().Bool(flagReplica, false, "run as a read replica driven by a verifier op-node: payload attributes that would sequence the mempool are rejected; requires --"+flagTxForwardURL)
+	cmd.Flags().StringSlice(flagMempoolPeers, nil, "CometBFT RPC urls of the trusted Monomer nodes whose pending txs are synced, so the node reports them as pending too; disabled if empty")
+	cmd.Flags().Duration(flagMempoolInterval, mempoolsync.DefaultInterval, "how often the peers' pending txs are synced")
+	cmd.Flags().Int(flagMempoolMaxTxs, mempoolsync.DefaultMaxTxs, "number of pending txs synced from each peer")
+	cmd.Flags().Uint64(flagMempoolSize, mempool.DefaultMaxSize, "number of txs and batches the mempool holds before it evicts the txs that pay the lowest gas price")
+	cmd.Flags().String(flagMempoolFeeDenom, "", "fee denom the mempool orders txs by, highest gas price first; txs are ordered by when they were submitted if empty")
+	cmd.Flags().Duration(flagDepositSLADelay, 0, "longest a deposit may take from its L1 block to its inclusion on L2 before the deposit SLO is breached; 0 disables the deposit inclusion metrics")
+	cmd.Flags().Duration(flagDepositSLAWindow, depositsla.DefaultWindow, "how long included deposits count towards the maximum inclusion delay")
+	cmd.Flags().Uint64(flagFreezeHeight, 0, "height the chain halts at: blocks above it aren't built, but the node keeps serving the chain; 0 doesn't freeze the chain")
+	cmd.Flags().String(flagStatePatch, "", "path of a state patch created with `monomer surgery create`, applied to the block after its height; disabled if empty")
+	cmd.Flags().Int(flagMinApprovals, defaultMinApprovals, "number of reviewers other than its author who must have approved the state patch")
+	cmd.Flags().String(flagConsensus, consensusRollup, "rollup to follow op-node, or local to build blocks on a timer without an OP stack")
+	cmd.Flags().Duration(flagLocalBlockTime, time.Second, "how often blocks are built with local consensus")
+	cmd.Flags().Duration(flagLocalTimeStep, 0, "time between the timestamps of consecutive blocks with local consensus, in whole seconds; 0 uses the wall clock")
+	cmd.Flags().String(flagL1URL, "ws://127.0.0.1:9001", "")
+	cmd.Flags().String(flagOPNodeURL, "http://127.0.0.1:9002", "")
+	cmd.Flags().String(flagL1DeploymentsPath, "", "")
+	cmd.Flags().String(flagDeployConfigPath, "", "")
+	cmd.Flags().String(flagL1AllocsPath, "", "")
+	cmd.Flags().String(flagMneumonicsPath, "", "")
+	addFlagCompletions(cmd)
+}
+
+func auditCommand() *cobra.Command {
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit log subcommands",
+	}
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Verify the audit log and write its entries to stdout",
+		Long: "Verify the audit log and write its entries to stdout. " +
+			"The log records forkchoice updates, rollbacks, and node starts and stops.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+			since, err := parseTimeFlag(cmd, "since")
+			if err != nil {
+				return err
+			}
+			until, err := parseTimeFlag(cmd, "until")
+			if err != nil {
+				return err
+			}
+			f, err := os.Open(filepath.Join(server.GetServerContextFromCmd(cmd).Config.RootDir, auditLogFileName))
+			if err != nil {
+				return fmt.Errorf("open audit log: %v", err)
+			}
+			defer f.Close()
+			return audit.Export(f, cmd.OutOrStdout(), audit.Format(format), since, until)
+		},
+	}
+	exportCmd.Flags().String("format", string(audit.FormatJSON), "output format (json|csv)")
+	exportCmd.Flags().String("since", "", "only export entries recorded at or after this RFC 3339 time")
+	exportCmd.Flags().String("until", "", "only export entries recorded before this RFC 3339 time")
+	auditCmd.AddCommand(exportCmd)
+	return auditCmd
+}
+
+func decodeDepositCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-deposit <l1-tx-hash>",
+		Short: "Decode the deposits made by an L1 tx",
+		Long: "Decode the OptimismPortal TransactionDeposited events emitted by an L1 tx into the deposit txs op-node " +
+			"derives from them and the balances the rollup module credits, and explain why a deposit won't arrive.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txHash := common.HexToHash(args[0])
+			l1URL, err := cmd.Flags().GetString("l1-url")
+			if err != nil {
+				return err
+			}
+			portalHex, err := cmd.Flags().GetString("portal")
+			if err != nil {
+				return err
+			}
+			var portal common.Address
+			if portalHex != "" {
+				if !common.IsHexAddress(portalHex) {
+					return fmt.Errorf("invalid --portal address %q", portalHex)
+				}
+				portal = common.HexToAddress(portalHex)
+			}
+			asJSON, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return err
+			}
+
+			l1Client, err := ethclient.DialContext(cmd.Context(), l1URL)
+			if err != nil {
+				return fmt.Errorf("dial L1: %v", err)
+			}
+			defer l1Client.Close()
+			receipt, err := l1Client.TransactionReceipt(cmd.Context(), txHash)
+			if err != nil {
+				return fmt.Errorf("get receipt: %v", err)
+			}
+			deposits, err := deposit.DecodeReceipt(receipt, portal)
+			if err != nil {
+				return err
+			}
+			if len(deposits) == 0 {
+				return fmt.Errorf("tx %s did not emit any TransactionDeposited events", txHash)
+			}
+
+			out := cmd.OutOrStdout()
+			if asJSON {
+				encoder := json.NewEncoder(out)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(deposits)
+			}
+			for i, d := range deposits {
+				if i > 0 {
+					fmt.Fprintln(out)
+				}
+				if err := d.Print(out); err != nil {
+					return fmt.Errorf("print deposit: %v", err)
+				}
+			}
+			return nil
 		},
-	}))
-	rootCmd.AddCommand(monomerCmd)
+	}
+	cmd.Flags().String("l1-url", "http://127.0.0.1:8545", "url of an L1 JSON-RPC endpoint")
+	cmd.Flags().String("portal", "", "OptimismPortal address; events emitted by other contracts are flagged")
+	cmd.Flags().Bool("json", false, "print the deposits as JSON")
+	return cmd
+}
+
+func parseTimeFlag(cmd *cobra.Command, name string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse --%s: %v", name, err)
+	}
+	return t, nil
 }
 
 // startCommandHandler is a custom callback that overrides the default `start` function in the Cosmos
@@ -97,6 +369,18 @@ func startCommandHandler(
 	inProcessConsensus bool,
 	opts server.StartCmdOptions,
 ) error {
+	var reporter *telemetry.Reporter
+	defer func() {
+		// Report panics before crashing, including the ones env.Close re-raises from the node's goroutines.
+		if r := recover(); r != nil {
+			if reporter != nil {
+				if err := reporter.ReportPanic(r, debug.Stack()); err != nil {
+					svrCtx.Logger.Error("Failed to report panic", "err", err)
+				}
+			}
+			panic(r)
+		}
+	}()
 	env := environment.New()
 	defer func() {
 		if err := env.Close(); err != nil {
@@ -107,6 +391,13 @@ func startCommandHandler(
 	if !inProcessConsensus {
 		return errors.New("in-process consensus must be enabled")
 	}
+	if err := applyPreset(svrCtx.Viper); err != nil {
+		return err
+	}
+	localBlockTime, err := newLocalBlockTime(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
 
 	svrCfg, err := serverconfig.GetConfig(svrCtx.Viper)
 	if err != nil {
@@ -137,10 +428,26 @@ func startCommandHandler(
 	if err != nil {
 		return fmt.Errorf("parse chain ID: %v", err)
 	}
+	if svrCtx.Viper.GetBool(flagDev) {
+		if appGenesis.AppState, err = withDevL1StandardBridge(clientCtx.Codec, svrCtx.Viper, appGenesis.AppState); err != nil {
+			return err
+		}
+	}
 
 	g, monomerCtx := getCtx(svrCtx)
 	env.DeferErr("unexpected error in errgroup", g.Wait)
 
+	if endpoint := svrCtx.Viper.GetString(flagTelemetryEndpoint); endpoint != "" {
+		reporter = telemetry.New(endpoint, monomer.ChainID(l2ChainID), telemetryFeatures(svrCtx))
+		interval := svrCtx.Viper.GetDuration(flagTelemetryInterval)
+		env.Go(func() {
+			reporter.Run(monomerCtx, interval, func(err error) {
+				svrCtx.Logger.Debug("[Telemetry]", "error", err)
+			})
+		})
+		svrCtx.Logger.Info("Sending telemetry", "endpoint", endpoint)
+	}
+
 	// Would usually start a Comet node in-process here, but we replace the
 	// Comet node with a Monomer node.
 	if err := startInProcess(
@@ -156,6 +463,8 @@ func startCommandHandler(
 		l2ChainID,
 		appGenesis.AppState,
 		uint64(appGenesis.GenesisTime.Unix()),
+		reporter,
+		localBlockTime,
 	); err != nil {
 		return fmt.Errorf("start Monomer node in-process: %v", err)
 	}
@@ -194,6 +503,27 @@ func readFromFileOrGetDefault[T any](path string, getDefault func() (*T, error))
 	return &structuredData, nil
 }
 
+// withDevL1StandardBridge returns appState with the L1StandardBridge of the devnet's L1 deployments in the rollup module
+// params, so the chain accepts the ERC-20 deposits sent through the devnet.
+func withDevL1StandardBridge(cdc codec.JSONCodec, v *viper.Viper, appState json.RawMessage) (json.RawMessage, error) {
+	l1Deployments, err := readFromFileOrGetDefault(v.GetString(flagL1DeploymentsPath), opdevnet.DefaultL1Deployments)
+	if err != nil {
+		return nil, fmt.Errorf("get l1 deployments: %v", err)
+	}
+	var appStateMap map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &appStateMap); err != nil {
+		return nil, fmt.Errorf("unmarshal app state: %v", err)
+	}
+	if err := genesis.SetL1StandardBridge(cdc, appStateMap, l1Deployments.L1StandardBridgeProxy); err != nil {
+		return nil, fmt.Errorf("set l1 standard bridge: %v", err)
+	}
+	appState, err = json.Marshal(appStateMap)
+	if err != nil {
+		return nil, fmt.Errorf("marshal app state: %v", err)
+	}
+	return appState, nil
+}
+
 func startOPDevnet(
 	ctx context.Context,
 	env *environment.Env,
@@ -313,6 +643,15 @@ func startApp(
 
 	// TODO: Check if is testnet and implement `testnetify` function
 
+	if name := streamingPlugin(svrCtx.Viper); name != "" {
+		if err := validateStreaming(svrCtx.Viper); err != nil {
+			return nil, err
+		}
+		svrCtx.Logger.Info("Streaming ABCI data to plugin", "plugin", name, "keys", svrCtx.Viper.GetStringSlice(streamingKeysKey))
+		// The plugin process is managed by the app, which doesn't stop it on Close. It's stopped after the app is
+		// closed, so the last blocks are streamed.
+		env.Defer(plugin.CleanupClients)
+	}
 	app := appCreator(svrCtx.Logger, db, &fakeTraceWriter{}, svrCtx.Viper)
 	env.DeferErr("close app", app.Close)
 
@@ -334,11 +673,34 @@ func startInProcess(
 	l2ChainID uint64,
 	appState json.RawMessage,
 	genesisTime uint64,
+	reporter *telemetry.Reporter,
+	localBlockTime time.Duration,
 ) error {
 	svrCtx.Logger.Info("Starting Monomer node in-process")
-	if err := startMonomerNode(&WrappedApplication{
-		app: app,
-	}, env, monomerCtx, svrCtx, clientCtx, engineWS, l2ChainID, appState, genesisTime); err != nil {
+	l1Reader, err := newL1Reader(monomerCtx, env, svrCtx)
+	if err != nil {
+		return fmt.Errorf("new l1 reader: %v", err)
+	}
+	pruningCfg, err := newPruningConfig(monomerCtx, svrCtx.Viper, app, l1Reader)
+	if err != nil {
+		return fmt.Errorf("new pruning config: %v", err)
+	}
+	if err := startMonomerNode(
+		NewWrappedApplication(app),
+		env,
+		monomerCtx,
+		svrCtx,
+		clientCtx,
+		engineWS,
+		l2ChainID,
+		appState,
+		genesisTime,
+		pruningCfg,
+		l1Reader,
+		reporter,
+		g,
+		localBlockTime,
+	); err != nil {
 		return fmt.Errorf("start Monomer node: %v", err)
 	}
 
@@ -383,6 +745,11 @@ func startMonomerNode(
 	l2ChainID uint64,
 	appStateJSON json.RawMessage,
 	genesisTime uint64,
+	pruningCfg *pruning.Config,
+	l1Reader *l1.Reader,
+	reporter *telemetry.Reporter,
+	g *errgroup.Group,
+	localBlockTime time.Duration,
 ) error {
 	cmtListenAddr := svrCtx.Config.RPC.ListenAddress
 	cmtListenAddr = strings.TrimPrefix(cmtListenAddr, "tcp://")
@@ -399,21 +766,29 @@ func startMonomerNode(
 	*clientCtx = clientCtx.WithClient(rpcclient)
 	clientCtx.ChainID = fmt.Sprintf("%d", l2ChainID)
 
-	var blockPebbleDB *pebble.DB
+	var blockdb *localdb.DB
 	if backendType := dbm.BackendType(svrCtx.Config.DBBackend); backendType == dbm.MemDBBackend {
-		blockPebbleDB, err = pebble.Open("", &pebble.Options{
+		blockPebbleDB, err := pebble.Open("", &pebble.Options{
 			FS: vfs.NewMem(),
 		})
+		if err != nil {
+			return fmt.Errorf("open blockstore: %v", err)
+		}
+		env.DeferErr("close block db", blockPebbleDB.Close)
+		blockdb = localdb.New(blockPebbleDB)
 	} else {
 		if backendType != dbm.PebbleDBBackend {
 			svrCtx.Logger.Info("Overriding provided db backend for the blockstore", "provided", backendType, "using", dbm.PebbleDBBackend)
 		}
-		blockPebbleDB, err = pebble.Open(filepath.Join(svrCtx.Config.RootDir, "blockstore"), nil)
-	}
-	if err != nil {
-		return fmt.Errorf("open blockstore: %v", err)
+		// Block stores written before the cold keyspace was split out are moved to it here, which can take a while.
+		svrCtx.Logger.Info("Opening blockstore")
+		var closeBlockDB func() error
+		blockdb, closeBlockDB, err = openBlockStore(svrCtx.Config.RootDir, svrCtx.Viper.GetString(flagColdBlockStore))
+		if err != nil {
+			return err
+		}
+		env.DeferErr("close block db", closeBlockDB)
 	}
-	env.DeferErr("close block db", blockPebbleDB.Close)
 
 	txdb, err := cometdb.NewDB("tx", cometdb.BackendType(svrCtx.Config.DBBackend), svrCtx.Config.RootDir)
 	if err != nil {
@@ -427,6 +802,21 @@ func startMonomerNode(
 	}
 	env.DeferErr("close mempool db", mempooldb.Close)
 
+	waldb, err := dbm.NewDB("wal", dbm.BackendType(svrCtx.Config.DBBackend), svrCtx.Config.RootDir)
+	if err != nil {
+		return fmt.Errorf("create wal db: %v", err)
+	}
+	env.DeferErr("close wal db", waldb.Close)
+
+	var witnessdb dbm.DB
+	if svrCtx.Viper.GetBool(flagWitness) {
+		witnessdb, err = dbm.NewDB("witness", dbm.BackendType(svrCtx.Config.DBBackend), svrCtx.Config.RootDir)
+		if err != nil {
+			return fmt.Errorf("create witness db: %v", err)
+		}
+		env.DeferErr("close witness db", witnessdb.Close)
+	}
+
 	rawDB, err := rawdb.NewPebbleDBDatabase(
 		svrCtx.Config.RootDir+"/ethstate",
 		defaultCacheSize,
@@ -447,52 +837,646 @@ func startMonomerNode(
 	if err := json.Unmarshal(appStateJSON, &appState); err != nil {
 		return fmt.Errorf("unmarshal app state: %v", err)
 	}
+	minClientVersion, err := readMinClientVersion(svrCtx.Config.GenesisFile())
+	if err != nil {
+		return err
+	}
 
 	engineWS, err := net.Listen("tcp", engineURL.Host())
 	if err != nil {
 		return fmt.Errorf("create engine listener: %v", err)
 	}
+	var ipcListener net.Listener
+	if ipcPath := svrCtx.Viper.GetString(flagIPCPath); ipcPath != "" {
+		if !filepath.IsAbs(ipcPath) {
+			ipcPath = filepath.Join(svrCtx.Config.RootDir, ipcPath)
+		}
+		ipcListener, err = listenIPC(ipcPath)
+		if err != nil {
+			return fmt.Errorf("create ipc listener: %v", err)
+		}
+		svrCtx.Logger.Info("Serving IPC", "path", ipcPath)
+	}
+	market, builderAPIListener, builderSecrets, err := newBuilderAPI(svrCtx, clientCtx)
+	if err != nil {
+		return fmt.Errorf("set up builder api: %v", err)
+	}
+	var firehoseWriter io.Writer
+	if svrCtx.Viper.GetBool(flagFirehose) {
+		firehoseWriter = os.Stdout
+	}
+	var admissionPolicy *admission.Policy
+	if policyPath := svrCtx.Viper.GetString(flagAdmissionPolicy); policyPath != "" {
+		wasm, err := os.ReadFile(policyPath)
+		if err != nil {
+			return fmt.Errorf("read admission policy: %v", err)
+		}
+		admissionPolicy, err = admission.NewPolicy(monomerCtx, wasm)
+		if err != nil {
+			return fmt.Errorf("new admission policy: %v", err)
+		}
+		env.DeferErr("close admission policy", func() error {
+			return admissionPolicy.Close(context.Background())
+		})
+		svrCtx.Logger.Info("Loaded admission policy", "path", policyPath)
+	}
+	auditLog, err := audit.Open(filepath.Join(svrCtx.Config.RootDir, auditLogFileName))
+	if err != nil {
+		return err
+	}
+	env.DeferErr("close audit log", auditLog.Close)
+	var interceptors []builder.Interceptor
+	if reporter != nil {
+		interceptors = append(interceptors, reporter)
+	}
+	compression := monomerdb.CompressionNone
+	if name := svrCtx.Viper.GetString(flagCompression); name != "" {
+		compression, err = monomerdb.ParseCompression(name)
+		if err != nil {
+			return err
+		}
+	}
+	var genesisHash common.Hash
+	var rollupCfg *rollup.Config
+	if source := svrCtx.Viper.GetString(flagRollupConfig); source != "" {
+		rollupCfg, err = loadRollupConfig(monomerCtx, source)
+		if err != nil {
+			return err
+		}
+		if err := checkRollupConfig(rollupCfg, monomer.ChainID(l2ChainID), genesisTime); err != nil {
+			return fmt.Errorf("genesis does not match the rollup config: %v", err)
+		}
+		genesisHash = rollupCfg.Genesis.L2.Hash
+		svrCtx.Logger.Info("Checking the genesis block against the rollup config", "source", source, "hash", genesisHash)
+	}
+	opNodeMonitorCfg, err := newOPNodeMonitorConfig(monomerCtx, env, svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+	systemConfigCfg, err := newSystemConfigCheck(svrCtx.Viper, rollupCfg, l1Reader)
+	if err != nil {
+		return err
+	}
+	if systemConfigCfg != nil {
+		svrCtx.Logger.Info("Checking the L1 SystemConfig against the rollup config", "address", systemConfigCfg.Address)
+	}
+	txForwardingCfg, err := newTxForwardingConfig(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+	if txForwardingCfg != nil {
+		svrCtx.Logger.Info("Forwarding submitted txs to the sequencer", "url", txForwardingCfg.SequencerURL)
+	}
+	if svrCtx.Viper.GetBool(flagReplica) {
+		svrCtx.Logger.Info("Running as a replica of the sequencer")
+	}
+	mempoolSyncCfg, err := newMempoolSyncConfig(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+	if mempoolSyncCfg != nil {
+		svrCtx.Logger.Info("Syncing pending txs with peers", "peers", mempoolSyncCfg.Peers)
+	}
+	depositSLACfg, err := newDepositSLAConfig(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+	statePatch, err := newStatePatch(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+	if statePatch != nil {
+		svrCtx.Logger.Info("Applying state patch", "height", statePatch.Height, "hash", statePatch.Hash, "reason", statePatch.Reason)
+	}
+	if freezeHeight := svrCtx.Viper.GetUint64(flagFreezeHeight); freezeHeight != 0 {
+		svrCtx.Logger.Info("Freezing the chain", "height", freezeHeight)
+	}
+	engineJWT, err := newEngineJWT(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+	if engineJWT != nil {
+		secret := svrCtx.Viper.GetString(flagEngineJWT)
+		if secret == "" {
+			secret = "--" + flagEngineJWTHex
+		}
+		svrCtx.Logger.Info("Authenticating Engine API requests", "secret", secret)
+	}
+	var compactionCfg *compaction.Config
+	if interval := svrCtx.Viper.GetDuration(flagCompactInterval); interval > 0 {
+		compactionCfg = &compaction.Config{
+			Interval: interval,
+		}
+	}
 	n := node.New(
 		wrappedApp,
-		clientCtx,
 		&genesis.Genesis{
-			ChainID:  monomer.ChainID(l2ChainID),
-			AppState: appState,
-			Time:     genesisTime,
+			ChainID:          monomer.ChainID(l2ChainID),
+			AppState:         appState,
+			Time:             genesisTime,
+			MinClientVersion: minClientVersion,
 		},
-		engineWS,
-		cometListener,
-		localdb.New(blockPebbleDB),
-		mempooldb,
-		txdb,
-		ethstatedb,
-		svrCtx.Config.Instrumentation,
-		&node.SelectiveListener{
-			OnEngineHTTPServeErrCb: func(err error) {
-				svrCtx.Logger.Error("[Engine HTTP Server]", "error", err)
-			},
-			OnEngineWebsocketServeErrCb: func(err error) {
-				svrCtx.Logger.Error("[Engine Websocket]", "error", err)
-			},
-			OnCometServeErrCb: func(err error) {
-				svrCtx.Logger.Error("[CometBFT]", "error", err)
-			},
-			OnPrometheusServeErrCb: func(err error) {
-				svrCtx.Logger.Error("[Prometheus]", "error", err)
+		&node.Config{
+			AppchainCtx:     clientCtx,
+			EngineListener:  engineWS,
+			CometListener:   cometListener,
+			BlockDB:         blockdb,
+			MempoolDB:       mempooldb,
+			WALDB:           waldb,
+			TxDB:            txdb,
+			EthStateDB:      ethstatedb,
+			Instrumentation: svrCtx.Config.Instrumentation,
+			EventListener: &node.SelectiveListener{
+				OnEngineHTTPServeErrCb: func(err error) {
+					svrCtx.Logger.Error("[Engine HTTP Server]", "error", err)
+				},
+				OnEngineWebsocketServeErrCb: func(err error) {
+					svrCtx.Logger.Error("[Engine Websocket]", "error", err)
+				},
+				OnCometServeErrCb: func(err error) {
+					svrCtx.Logger.Error("[CometBFT]", "error", err)
+				},
+				OnIPCServeErrCb: func(err error) {
+					svrCtx.Logger.Error("[IPC]", "error", err)
+				},
+				OnPrometheusServeErrCb: func(err error) {
+					svrCtx.Logger.Error("[Prometheus]", "error", err)
+				},
+				OnFirehoseErrCb: func(err error) {
+					svrCtx.Logger.Error("[Firehose]", "error", err)
+				},
+				OnPruningErrCb: func(err error) {
+					svrCtx.Logger.Error("[Pruning]", "error", err)
+				},
+				OnBuilderAPIServeErrCb: func(err error) {
+					svrCtx.Logger.Error("[Builder API]", "error", err)
+				},
+				OnCrashCb: func(err error) {
+					svrCtx.Logger.Error("[Crash]", "error", err)
+					var crashErr *crash.Error
+					if reporter != nil && errors.As(err, &crashErr) {
+						if err := reporter.ReportPanic(crashErr.Dump.Panic, []byte(crashErr.Dump.Stack)); err != nil {
+							svrCtx.Logger.Error("Failed to report panic", "err", err)
+						}
+					}
+					// Fail the errgroup to stop the node and the other servers.
+					g.Go(func() error {
+						return err
+					})
+				},
+				OnLocalSequencerErrCb: func(err error) {
+					svrCtx.Logger.Error("[Local Sequencer]", "error", err)
+				},
+				OnCompactionErrCb: func(err error) {
+					svrCtx.Logger.Error("[Compaction]", "error", err)
+				},
+				OnOPNodeMonitorErrCb: func(err error) {
+					svrCtx.Logger.Error("[op-node Monitor]", "error", err)
+				},
+				OnEngineJWTErrCb: func(err error) {
+					svrCtx.Logger.Error("[Engine JWT]", "error", err)
+				},
+				OnBuilderJWTErrCb: func(err error) {
+					svrCtx.Logger.Error("[Builder JWT]", "error", err)
+				},
+				OnSystemConfigErrCb: func(err error) {
+					svrCtx.Logger.Error("[System Config]", "error", err)
+				},
+				OnMempoolSyncErrCb: func(err error) {
+					svrCtx.Logger.Error("[Mempool Sync]", "error", err)
+				},
+				OnDepositSLAErrCb: func(err error) {
+					svrCtx.Logger.Error("[Deposit SLA]", "error", err)
+				},
+				OnEngineCompatibilityWarnCb: func(err error) {
+					svrCtx.Logger.Warn("[Engine API]", "warning", err)
+				},
 			},
+			Firehose:            firehoseWriter,
+			AdmissionPolicy:     admissionPolicy,
+			AuditLog:            auditLog,
+			Pruning:             pruningCfg,
+			Compaction:          compactionCfg,
+			HTTPAPIs:            svrCtx.Viper.GetStringSlice(flagHTTPAPI),
+			WSAPIs:              svrCtx.Viper.GetStringSlice(flagWSAPI),
+			LocalAPIs:           svrCtx.Viper.GetStringSlice(flagLocalAPI),
+			IPCListener:         ipcListener,
+			IPCAPIs:             svrCtx.Viper.GetStringSlice(flagIPCAPI),
+			QueryTimeout:        svrCtx.Viper.GetDuration(flagQueryTimeout),
+			QueryCacheSize:      svrCtx.Viper.GetInt(flagQueryCacheSize),
+			QueryCacheTTL:       svrCtx.Viper.GetDuration(flagQueryCacheTTL),
+			BlockCacheSize:      svrCtx.Viper.GetInt(flagBlockCacheSize) * 1024 * 1024,
+			Compression:         compression,
+			Bundles:             market,
+			BuilderAPIListener:  builderAPIListener,
+			BuilderSecrets:      builderSecrets,
+			BuilderInterceptors: interceptors,
+			CrashDir:            filepath.Join(svrCtx.Config.RootDir, "crash"),
+			LocalBlockTime:      localBlockTime,
+			LocalTimeStep:       svrCtx.Viper.GetDuration(flagLocalTimeStep),
+			GenesisHash:         genesisHash,
+			OPNodeMonitor:       opNodeMonitorCfg,
+			EngineJWT:           engineJWT,
+			WitnessDB:           witnessdb,
+			SystemConfig:        systemConfigCfg,
+			TxForwarding:        txForwardingCfg,
+			Replica:             svrCtx.Viper.GetBool(flagReplica),
+			MempoolSync:         mempoolSyncCfg,
+			MempoolSize:         svrCtx.Viper.GetUint64(flagMempoolSize),
+			MempoolFeeDenom:     svrCtx.Viper.GetString(flagMempoolFeeDenom),
+			DepositSLA:          depositSLACfg,
+			FreezeHeight:        svrCtx.Viper.GetUint64(flagFreezeHeight),
+			StatePatch:          statePatch,
 		},
 	)
-	svrCtx.Logger.Info("Spinning up Monomer node")
+	info := buildinfo.Read()
+	svrCtx.Logger.Info("Spinning up Monomer node", "version", info.Version, "revision", info.Revision, "build_hash", info.Hash)
 
-	if err := n.Run(monomerCtx, env); err != nil {
-		return fmt.Errorf("run Monomer node: %v", err)
+	if err := n.Start(monomerCtx, env); err != nil {
+		return fmt.Errorf("start Monomer node: %v", err)
 	}
 
 	svrCtx.Logger.Info("Monomer started w/ CometBFT listener on", "address", cometListener.Addr())
+	if localBlockTime > 0 {
+		svrCtx.Logger.Info("Building blocks with local consensus", "block_time", localBlockTime)
+	}
 
 	return nil
 }
 
+// listenIPC listens on a unix socket at path that only the current user can connect to. A socket left behind by a
+// node that didn't stop cleanly is replaced.
+func listenIPC(path string) (net.Listener, error) {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("remove stale socket: %v", err)
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("listen: %v", err)
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		return nil, errors.Join(fmt.Errorf("restrict socket permissions: %v", err), listener.Close())
+	}
+	return listener, nil
+}
+
+// newBuilderAPI returns the bundle market and the builder API's listener and builder secrets, or nils if the builder
+// API is disabled.
+func newBuilderAPI(
+	svrCtx *server.Context,
+	clientCtx *client.Context,
+) (*bundles.Market, net.Listener, map[string]*jwtauth.Secrets, error) {
+	addr := svrCtx.Viper.GetString(flagBuilderAPIAddr)
+	if addr == "" {
+		return nil, nil, nil, nil
+	}
+	policy, err := bundles.NewPolicy(svrCtx.Viper.GetString(flagBundlePolicy), svrCtx.Viper.GetString(flagBundleFeeDenom))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	secrets, err := readBuilderSecrets(svrCtx.Viper.GetString(flagBuilderSecrets), svrCtx.Viper.GetDuration(flagBuilderJWTWindow))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("read builder secrets: %v", err)
+	}
+	metrics := bundles.NewNoopMetrics()
+	if svrCtx.Config.Instrumentation.IsPrometheusEnabled() {
+		metrics = bundles.NewMetrics(svrCtx.Config.Instrumentation.Namespace)
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("listen: %v", err)
+	}
+	svrCtx.Logger.Info("Serving builder API", "address", listener.Addr(), "builders", len(secrets))
+	return bundles.New(clientCtx.TxConfig.TxDecoder(), policy, metrics), listener, secrets, nil
+}
+
+// readBuilderSecrets reads a JSON object mapping builder names to the paths of their hex-encoded JWT secret files, in
+// the format of the engine's secret file. Relative paths are relative to the JSON file's directory.
+func readBuilderSecrets(path string, rotationWindow time.Duration) (map[string]*jwtauth.Secrets, error) {
+	if path == "" {
+		return nil, errors.New("no secrets file")
+	}
+	pathsJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var secretPaths map[string]string
+	if err := json.Unmarshal(pathsJSON, &secretPaths); err != nil {
+		return nil, fmt.Errorf("unmarshal: %v", err)
+	}
+	secrets := make(map[string]*jwtauth.Secrets, len(secretPaths))
+	for builder, secretPath := range secretPaths {
+		if !filepath.IsAbs(secretPath) {
+			secretPath = filepath.Join(filepath.Dir(path), secretPath)
+		}
+		builderSecrets, err := jwtauth.NewSecrets(&jwtauth.Config{
+			Path:           secretPath,
+			RotationWindow: rotationWindow,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("load secret of %q: %v", builder, err)
+		}
+		secrets[builder] = builderSecrets
+	}
+	return secrets, nil
+}
+
+// telemetryFeatures returns the optional features the node was started with, which telemetry reports include.
+func telemetryFeatures(svrCtx *server.Context) []string {
+	features := []string{}
+	for feature, enabled := range map[string]bool{
+		"dev":              svrCtx.Viper.GetBool(flagDev),
+		"firehose":         svrCtx.Viper.GetBool(flagFirehose),
+		"admission-policy": svrCtx.Viper.GetString(flagAdmissionPolicy) != "",
+		"l1-rpc":           svrCtx.Viper.GetString(flagL1RPCURL) != "",
+		"pruning":          svrCtx.Viper.GetString(flagPruningPortal) != "" || svrCtx.Viper.GetString(flagPruningOracle) != "",
+		"ipc":              svrCtx.Viper.GetString(flagIPCPath) != "",
+		"builder-api":      svrCtx.Viper.GetString(flagBuilderAPIAddr) != "",
+		"prometheus":       svrCtx.Config.Instrumentation.IsPrometheusEnabled(),
+		"local-consensus":  svrCtx.Viper.GetString(flagConsensus) == consensusLocal,
+		"witness":          svrCtx.Viper.GetBool(flagWitness),
+		"system-config":    svrCtx.Viper.GetBool(flagSysCfgCheck),
+		"tx-forwarding":    svrCtx.Viper.GetString(flagTxForwardURL) != "",
+		"replica":          svrCtx.Viper.GetBool(flagReplica),
+		"mempool-sync":     len(svrCtx.Viper.GetStringSlice(flagMempoolPeers)) > 0,
+		"abci-streaming":   streamingPlugin(svrCtx.Viper) != "",
+	} {
+		if enabled {
+			features = append(features, feature)
+		}
+	}
+	sort.Strings(features)
+	return features
+}
+
+// newLocalBlockTime returns the block time of local consensus, or zero with rollup consensus. It also validates the
+// other local consensus flags.
+func newLocalBlockTime(v *viper.Viper) (time.Duration, error) {
+	timeStep := v.GetDuration(flagLocalTimeStep)
+	switch consensus := v.GetString(flagConsensus); consensus {
+	case consensusRollup, "":
+		if timeStep != 0 {
+			return 0, fmt.Errorf("--%s requires local consensus", flagLocalTimeStep)
+		}
+		return 0, nil
+	case consensusLocal:
+		if v.GetBool(flagDev) {
+			return 0, errors.New("the OP Stack devnet requires rollup consensus")
+		}
+		blockTime := v.GetDuration(flagLocalBlockTime)
+		if blockTime <= 0 {
+			return 0, fmt.Errorf("local block time must be positive, got %s", blockTime)
+		}
+		// Block timestamps are in seconds.
+		if timeStep < 0 || timeStep%time.Second != 0 {
+			return 0, fmt.Errorf("local time step must be a non-negative number of whole seconds, got %s", timeStep)
+		}
+		return blockTime, nil
+	default:
+		return 0, fmt.Errorf("unknown consensus %q: must be %s or %s", consensus, consensusRollup, consensusLocal)
+	}
+}
+
+// newL1Reader returns the reader the features that read L1 share, so they don't each dial and poll the L1 RPC.
+// It returns nil if no L1 URL is set.
+func newL1Reader(ctx context.Context, env *environment.Env, svrCtx *server.Context) (*l1.Reader, error) {
+	l1URL := svrCtx.Viper.GetString(flagL1RPCURL)
+	if l1URL == "" {
+		return nil, nil
+	}
+	l1Client, err := ethclient.DialContext(ctx, l1URL)
+	if err != nil {
+		return nil, fmt.Errorf("dial L1: %v", err)
+	}
+	env.Defer(l1Client.Close)
+	metrics := l1.NewNoopMetrics()
+	if svrCtx.Config.Instrumentation.IsPrometheusEnabled() {
+		metrics = l1.NewMetrics(svrCtx.Config.Instrumentation.Namespace)
+	}
+	return l1.NewReader(l1Client, l1.DefaultTTL, l1.DefaultCacheSize, metrics), nil
+}
+
+// newEngineJWT returns the secrets in the secret file or the hex-encoded secret in the flags, or nil if neither is set.
+func newEngineJWT(v *viper.Viper) (*jwtauth.Secrets, error) {
+	path, hexSecret := v.GetString(flagEngineJWT), v.GetString(flagEngineJWTHex)
+	if path == "" && hexSecret == "" {
+		return nil, nil
+	}
+	if path != "" && hexSecret != "" {
+		return nil, fmt.Errorf("--%s and --%s are mutually exclusive", flagEngineJWT, flagEngineJWTHex)
+	}
+	// The in-process OP stack's batcher and proposer can't authenticate.
+	if v.GetBool(flagDev) {
+		return nil, fmt.Errorf("engine jwt authentication is not supported with --%s", flagDev)
+	}
+	cfg := &jwtauth.Config{
+		Path:           path,
+		RotationWindow: v.GetDuration(flagEngineJWTWindow),
+	}
+	if hexSecret != "" {
+		secret, err := jwtauth.DecodeSecret(hexSecret)
+		if err != nil {
+			return nil, fmt.Errorf("--%s: %v", flagEngineJWTHex, err)
+		}
+		cfg.Secret = secret
+	}
+	secrets, err := jwtauth.NewSecrets(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("load engine jwt secret: %v", err)
+	}
+	return secrets, nil
+}
+
+// newTxForwardingConfig returns the config of the forwarding of txs to the sequencer in the flags, or nil if no
+// sequencer is set.
+func newTxForwardingConfig(v *viper.Viper) (*txforward.Config, error) {
+	sequencerURL := v.GetString(flagTxForwardURL)
+	if sequencerURL == "" {
+		// A replica's mempool txs are never included, so they must reach the sequencer.
+		if v.GetBool(flagReplica) {
+			return nil, fmt.Errorf("--%s requires --%s", flagReplica, flagTxForwardURL)
+		}
+		return nil, nil
+	}
+	// The node sequences itself with local consensus and the in-process OP stack.
+	if v.GetString(flagConsensus) == consensusLocal {
+		return nil, fmt.Errorf("--%s requires rollup consensus", flagTxForwardURL)
+	} else if v.GetBool(flagDev) {
+		return nil, fmt.Errorf("--%s is not supported with --%s", flagTxForwardURL, flagDev)
+	}
+	if u, err := url.ParseString(sequencerURL); err != nil {
+		return nil, fmt.Errorf("parse sequencer url: %v", err)
+	} else if scheme := u.Scheme(); scheme != "http" && scheme != "https" {
+		return nil, fmt.Errorf("sequencer url needs to have scheme `http` or `https`, got %s", scheme)
+	}
+	cfg := &txforward.Config{
+		SequencerURL: sequencerURL,
+		MaxAttempts:  v.GetInt(flagTxForwardAttempts),
+		RetryBackoff: v.GetDuration(flagTxForwardBackoff),
+		Timeout:      v.GetDuration(flagTxForwardTimeout),
+		DedupeTTL:    v.GetDuration(flagTxForwardDedupe),
+	}
+	if cfg.MaxAttempts < 1 {
+		return nil, fmt.Errorf("--%s must be at least 1", flagTxForwardAttempts)
+	}
+	for flag, d := range map[string]time.Duration{
+		flagTxForwardBackoff: cfg.RetryBackoff,
+		flagTxForwardTimeout: cfg.Timeout,
+		flagTxForwardDedupe:  cfg.DedupeTTL,
+	} {
+		if d <= 0 {
+			return nil, fmt.Errorf("--%s must be positive", flag)
+		}
+	}
+	return cfg, nil
+}
+
+// newMempoolSyncConfig returns the config of the sync of pending txs with the peers in the flags, or nil if no peers
+// are set.
+func newMempoolSyncConfig(v *viper.Viper) (*mempoolsync.Config, error) {
+	peers := v.GetStringSlice(flagMempoolPeers)
+	if len(peers) == 0 {
+		return nil, nil
+	}
+	for _, peer := range peers {
+		if u, err := url.ParseString(peer); err != nil {
+			return nil, fmt.Errorf("parse mempool sync peer url: %v", err)
+		} else if scheme := u.Scheme(); scheme != "http" && scheme != "https" {
+			return nil, fmt.Errorf("mempool sync peer url needs to have scheme `http` or `https`, got %s", scheme)
+		}
+	}
+	cfg := &mempoolsync.Config{
+		Peers:    peers,
+		Interval: v.GetDuration(flagMempoolInterval),
+		MaxTxs:   v.GetInt(flagMempoolMaxTxs),
+	}
+	if cfg.Interval <= 0 {
+		return nil, fmt.Errorf("--%s must be positive", flagMempoolInterval)
+	} else if cfg.MaxTxs < 1 {
+		return nil, fmt.Errorf("--%s must be at least 1", flagMempoolMaxTxs)
+	}
+	return cfg, nil
+}
+
+// newDepositSLAConfig returns the config of the deposit inclusion SLO in the flags, or nil if no maximum inclusion delay
+// is set.
+func newDepositSLAConfig(v *viper.Viper) (*depositsla.Config, error) {
+	maxDelay := v.GetDuration(flagDepositSLADelay)
+	if maxDelay == 0 {
+		return nil, nil
+	} else if maxDelay < 0 {
+		return nil, fmt.Errorf("--%s must be positive", flagDepositSLADelay)
+	}
+	cfg := &depositsla.Config{
+		MaxInclusionDelay: maxDelay,
+		Window:            v.GetDuration(flagDepositSLAWindow),
+	}
+	if cfg.Window < time.Second {
+		return nil, fmt.Errorf("--%s must be at least a second", flagDepositSLAWindow)
+	}
+	return cfg, nil
+}
+
+// newStatePatch reads the state patch in the flags and verifies its approvals, or returns nil if none is set.
+func newStatePatch(v *viper.Viper) (*surgery.Patch, error) {
+	path := v.GetString(flagStatePatch)
+	if path == "" {
+		return nil, nil
+	}
+	patch, err := surgery.Read(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := patch.Verify(v.GetInt(flagMinApprovals)); err != nil {
+		return nil, fmt.Errorf("verify state patch: %v", err)
+	}
+	return patch, nil
+}
+
+// newOPNodeMonitorConfig returns the config of the monitor of the op-node in the flags, or nil if none is set.
+func newOPNodeMonitorConfig(ctx context.Context, env *environment.Env, v *viper.Viper) (*opnode.Config, error) {
+	opNodeURL := v.GetString(flagMonitorURL)
+	if opNodeURL == "" {
+		return nil, nil
+	}
+	// Dialing over HTTP doesn't connect, so the node can start before op-node.
+	rpcClient, err := rpc.DialContext(ctx, opNodeURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial op-node: %v", err)
+	}
+	env.Defer(rpcClient.Close)
+	return &opnode.Config{
+		Client:           sources.NewRollupClient(opclient.NewBaseRPCClient(rpcClient)),
+		StallTimeout:     v.GetDuration(flagMonitorStall),
+		MaxDerivationLag: v.GetUint64(flagMonitorMaxLag),
+		MaxL1HeadLag:     v.GetUint64(flagMonitorMaxL1Lag),
+	}, nil
+}
+
+// newPruningConfig returns the pruning config for the L1 contract in the flags, or nil if none is set. Monomer then
+// prunes the app state in place of the Cosmos SDK, keeping the versions still inside the challenge window as well as the
+// ones the app's pruning options keep.
+func newPruningConfig(
+	ctx context.Context,
+	v *viper.Viper,
+	app servertypes.Application,
+	l1Reader *l1.Reader,
+) (*pruning.Config, error) {
+	portalHex := v.GetString(flagPruningPortal)
+	oracleHex := v.GetString(flagPruningOracle)
+	if portalHex == "" && oracleHex == "" {
+		return nil, nil
+	} else if portalHex != "" && oracleHex != "" {
+		return nil, fmt.Errorf("only one of --%s and --%s can be set", flagPruningPortal, flagPruningOracle)
+	}
+	pruningOpts, err := server.GetPruningOptionsFromFlags(v)
+	if err != nil {
+		return nil, fmt.Errorf("get pruning options: %v", err)
+	}
+	if pruningOpts.Strategy == pruningtypes.PruningNothing {
+		return nil, nil
+	}
+	if l1Reader == nil {
+		return nil, fmt.Errorf("--%s is required to read the challenge window", flagL1RPCURL)
+	}
+
+	var boundary pruning.Boundary
+	if portalHex != "" {
+		if !common.IsHexAddress(portalHex) {
+			return nil, fmt.Errorf("invalid --%s address %q", flagPruningPortal, portalHex)
+		}
+		portal, err := bindings.NewOptimismPortal2Caller(common.HexToAddress(portalHex), l1Reader)
+		if err != nil {
+			return nil, fmt.Errorf("new optimism portal 2 caller: %v", err)
+		}
+		factoryAddress, err := portal.DisputeGameFactory(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return nil, fmt.Errorf("get dispute game factory: %v", err)
+		}
+		factory, err := bindings.NewDisputeGameFactoryCaller(factoryAddress, l1Reader)
+		if err != nil {
+			return nil, fmt.Errorf("new dispute game factory caller: %v", err)
+		}
+		boundary = pruning.NewDisputeGameBoundary(l1Reader, portal, pruning.NewL1Games(factory, l1Reader))
+	} else {
+		if !common.IsHexAddress(oracleHex) {
+			return nil, fmt.Errorf("invalid --%s address %q", flagPruningOracle, oracleHex)
+		}
+		oracle, err := opbindings.NewL2OutputOracleCaller(common.HexToAddress(oracleHex), l1Reader)
+		if err != nil {
+			return nil, fmt.Errorf("new l2 output oracle caller: %v", err)
+		}
+		boundary = pruning.NewOutputOracleBoundary(l1Reader, oracle)
+	}
+
+	app.CommitMultiStore().SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	return &pruning.Config{
+		KeepRecent: pruningOpts.KeepRecent,
+		Interval:   v.GetDuration(flagPruningInterval),
+		Boundary:   boundary,
+	}, nil
+}
+
 // Starts the gRPC server if enabled in the server configuration.
 func startGrpcServer(
 	monomerCtx context.Context,
@@ -579,9 +1563,12 @@ func getCtx(svrCtx *server.Context) (*errgroup.Group, context.Context) {
 	// return.
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 	g.Go(func() error {
-		sig := <-sigCh
-		svrCtx.Logger.Info("Shutting down Monomer...", "signal", sig.String())
-		cancelFn()
+		select {
+		case sig := <-sigCh:
+			svrCtx.Logger.Info("Shutting down Monomer...", "signal", sig.String())
+			cancelFn()
+		case <-ctx.Done(): // Another goroutine in the errgroup failed.
+		}
 		return nil
 	})
 
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"syscall"
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/snapshots"
@@ -22,12 +23,165 @@ import (
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/gogoproto/grpc"
+	"github.com/polymerdao/monomer/depositsla"
 	"github.com/polymerdao/monomer/e2e/url"
+	"github.com/polymerdao/monomer/mempoolsync"
+	"github.com/polymerdao/monomer/surgery"
 	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/txforward"
 	"github.com/sourcegraph/conc"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
+func TestNewTxForwardingConfig(t *testing.T) {
+	v := viper.New()
+	v.Set(flagTxForwardAttempts, txforward.DefaultMaxAttempts)
+	v.Set(flagTxForwardBackoff, txforward.DefaultRetryBackoff)
+	v.Set(flagTxForwardTimeout, txforward.DefaultTimeout)
+	v.Set(flagTxForwardDedupe, txforward.DefaultDedupeTTL)
+	cfg, err := newTxForwardingConfig(v)
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+
+	v.Set(flagReplica, true)
+	_, err = newTxForwardingConfig(v)
+	require.ErrorContains(t, err, flagTxForwardURL)
+
+	v.Set(flagTxForwardURL, "http://sequencer:26657")
+	cfg, err = newTxForwardingConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, &txforward.Config{
+		SequencerURL: "http://sequencer:26657",
+		MaxAttempts:  txforward.DefaultMaxAttempts,
+		RetryBackoff: txforward.DefaultRetryBackoff,
+		Timeout:      txforward.DefaultTimeout,
+		DedupeTTL:    txforward.DefaultDedupeTTL,
+	}, cfg)
+
+	v.Set(flagConsensus, consensusLocal)
+	_, err = newTxForwardingConfig(v)
+	require.ErrorContains(t, err, "requires rollup consensus")
+	v.Set(flagConsensus, consensusRollup)
+
+	v.Set(flagTxForwardAttempts, 0)
+	_, err = newTxForwardingConfig(v)
+	require.ErrorContains(t, err, flagTxForwardAttempts)
+	v.Set(flagTxForwardAttempts, txforward.DefaultMaxAttempts)
+
+	v.Set(flagTxForwardURL, "ws://sequencer:26657")
+	_, err = newTxForwardingConfig(v)
+	require.ErrorContains(t, err, "scheme")
+}
+
+func TestNewMempoolSyncConfig(t *testing.T) {
+	v := viper.New()
+	v.Set(flagMempoolInterval, mempoolsync.DefaultInterval)
+	v.Set(flagMempoolMaxTxs, mempoolsync.DefaultMaxTxs)
+	cfg, err := newMempoolSyncConfig(v)
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+
+	v.Set(flagMempoolPeers, []string{"http://sequencer:26657", "https://rpc-1:26657"})
+	cfg, err = newMempoolSyncConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, &mempoolsync.Config{
+		Peers:    []string{"http://sequencer:26657", "https://rpc-1:26657"},
+		Interval: mempoolsync.DefaultInterval,
+		MaxTxs:   mempoolsync.DefaultMaxTxs,
+	}, cfg)
+
+	v.Set(flagMempoolInterval, 0)
+	_, err = newMempoolSyncConfig(v)
+	require.ErrorContains(t, err, flagMempoolInterval)
+	v.Set(flagMempoolInterval, mempoolsync.DefaultInterval)
+
+	v.Set(flagMempoolMaxTxs, 0)
+	_, err = newMempoolSyncConfig(v)
+	require.ErrorContains(t, err, flagMempoolMaxTxs)
+	v.Set(flagMempoolMaxTxs, mempoolsync.DefaultMaxTxs)
+
+	v.Set(flagMempoolPeers, []string{"http://sequencer:26657", "ws://rpc-1:26657"})
+	_, err = newMempoolSyncConfig(v)
+	require.ErrorContains(t, err, "scheme")
+}
+
+func TestNewDepositSLAConfig(t *testing.T) {
+	v := viper.New()
+	v.Set(flagDepositSLAWindow, depositsla.DefaultWindow)
+	cfg, err := newDepositSLAConfig(v)
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+
+	v.Set(flagDepositSLADelay, 5*time.Minute)
+	cfg, err = newDepositSLAConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, &depositsla.Config{
+		MaxInclusionDelay: 5 * time.Minute,
+		Window:            depositsla.DefaultWindow,
+	}, cfg)
+
+	v.Set(flagDepositSLAWindow, 0)
+	_, err = newDepositSLAConfig(v)
+	require.ErrorContains(t, err, flagDepositSLAWindow)
+}
+
+func TestNewStatePatch(t *testing.T) {
+	v := viper.New()
+	v.Set(flagMinApprovals, defaultMinApprovals)
+	patch, err := newStatePatch(v)
+	require.NoError(t, err)
+	require.Nil(t, patch)
+
+	want := &surgery.Patch{
+		ChainID: 1,
+		Height:  10,
+		AppHash: []byte{1},
+		Reason:  "incident",
+		Author:  "alice",
+		Ops:     []surgery.Op{{Store: "bank", Key: []byte{1}, Value: []byte{2}}},
+	}
+	require.NoError(t, want.Seal())
+	path := filepath.Join(t.TempDir(), "patch.json")
+	require.NoError(t, writePatchFile(path, want))
+	v.Set(flagStatePatch, path)
+	_, err = newStatePatch(v)
+	require.ErrorContains(t, err, "approvals")
+
+	require.NoError(t, want.Approve("bob"))
+	require.NoError(t, writePatchFile(path, want))
+	patch, err = newStatePatch(v)
+	require.NoError(t, err)
+	require.Equal(t, want, patch)
+}
+
+func TestValidateStreaming(t *testing.T) {
+	v := viper.New()
+	require.NoError(t, validateStreaming(v))
+
+	v.Set(streamingPluginKey, "file")
+	require.ErrorContains(t, validateStreaming(v), "unsupported streaming plugin")
+
+	v.Set(streamingPluginKey, "abci")
+	t.Setenv("COSMOS_SDK_ABCI", "")
+	require.ErrorContains(t, validateStreaming(v), "COSMOS_SDK_ABCI")
+
+	t.Setenv("COSMOS_SDK_ABCI", "/usr/local/bin/indexer")
+	require.NoError(t, validateStreaming(v))
+}
+
+func TestWithoutStreaming(t *testing.T) {
+	v := viper.New()
+	v.Set(streamingPluginKey, "abci")
+	v.Set(streamingKeysKey, []string{"*"})
+	v.Set(flagConsensus, consensusLocal)
+	opts := withoutStreaming{v}
+	require.Nil(t, opts.Get("streaming"))
+	require.Nil(t, opts.Get(streamingPluginKey))
+	require.Nil(t, opts.Get(streamingKeysKey))
+	require.Equal(t, consensusLocal, opts.Get(flagConsensus))
+}
+
 // Application Constructor `appCreator` for testing
 func mockAppCreator(
 	_ log.Logger,
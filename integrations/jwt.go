@@ -0,0 +1,74 @@
+package integrations
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/polymerdao/monomer/jwtauth"
+	"github.com/spf13/cobra"
+)
+
+func jwtCommand() *cobra.Command {
+	jwtCmd := &cobra.Command{
+		Use:   "jwt",
+		Short: "Engine API JWT secret subcommands",
+		Long: "Engine API JWT secret subcommands. The secret file is shared by the node, which reads it with --" +
+			flagEngineJWT + ", and op-node, which reads it with --l2.jwt-secret.",
+	}
+
+	generateCmd := &cobra.Command{
+		Use:   "generate <path>",
+		Short: "Write a new random JWT secret to a file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+			force, err := cmd.Flags().GetBool("force")
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stat(path); err == nil && !force {
+				return fmt.Errorf("%s already exists; use the rotate command to replace the secret of a running node", path)
+			} else if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+			if err := writeNewSecret(path); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Wrote a new JWT secret to %s\n", path)
+			return nil
+		},
+	}
+	generateCmd.Flags().Bool("force", false, "overwrite the file if it exists")
+	jwtCmd.AddCommand(generateCmd)
+
+	jwtCmd.AddCommand(&cobra.Command{
+		Use:   "rotate <path>",
+		Short: "Replace the JWT secret in a file",
+		Long: "Replace the JWT secret in a file. A running node reloads the file within seconds and keeps accepting the " +
+			"previous secret for --" + flagEngineJWTWindow + ". Restart op-node within that time so it signs its " +
+			"requests with the new secret.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+			if _, err := jwtauth.ReadSecretFile(path); err != nil {
+				return fmt.Errorf("%v; use the generate command to create a secret file", err)
+			}
+			if err := writeNewSecret(path); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Wrote a new JWT secret to %s; restart op-node to use it\n", path)
+			return nil
+		},
+	})
+	return jwtCmd
+}
+
+func writeNewSecret(path string) error {
+	secret, err := jwtauth.Generate()
+	if err != nil {
+		return err
+	}
+	return jwtauth.WriteSecretFile(path, secret)
+}
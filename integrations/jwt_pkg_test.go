@@ -0,0 +1,68 @@
+package integrations
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/polymerdao/monomer/jwtauth"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTCommand(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	run := func(args ...string) error {
+		cmd := jwtCommand()
+		cmd.SetArgs(args)
+		return cmd.Execute()
+	}
+
+	require.Error(t, run("rotate", path))
+
+	require.NoError(t, run("generate", path))
+	secret, err := jwtauth.ReadSecretFile(path)
+	require.NoError(t, err)
+	require.Error(t, run("generate", path))
+	require.NoError(t, run("generate", "--force", path))
+	forcedSecret, err := jwtauth.ReadSecretFile(path)
+	require.NoError(t, err)
+	require.NotEqual(t, secret, forcedSecret)
+
+	require.NoError(t, run("rotate", path))
+	rotatedSecret, err := jwtauth.ReadSecretFile(path)
+	require.NoError(t, err)
+	require.NotEqual(t, forcedSecret, rotatedSecret)
+}
+
+func TestNewEngineJWT(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	secret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	require.NoError(t, jwtauth.WriteSecretFile(path, secret))
+
+	newEngineJWTWith := func(kvs map[string]any) (*jwtauth.Secrets, error) {
+		v := viper.New()
+		for k, value := range kvs {
+			v.Set(k, value)
+		}
+		return newEngineJWT(v)
+	}
+	secrets, err := newEngineJWTWith(nil)
+	require.NoError(t, err)
+	require.Nil(t, secrets)
+
+	secrets, err = newEngineJWTWith(map[string]any{flagEngineJWT: path})
+	require.NoError(t, err)
+	require.NotNil(t, secrets)
+	secrets, err = newEngineJWTWith(map[string]any{flagEngineJWTHex: hexutil.Encode(secret)})
+	require.NoError(t, err)
+	require.NotNil(t, secrets)
+
+	_, err = newEngineJWTWith(map[string]any{flagEngineJWT: path, flagEngineJWTHex: hexutil.Encode(secret)})
+	require.ErrorContains(t, err, "mutually exclusive")
+	_, err = newEngineJWTWith(map[string]any{flagEngineJWTHex: "0xabcd"})
+	require.ErrorContains(t, err, "not 32")
+	_, err = newEngineJWTWith(map[string]any{flagEngineJWTHex: hexutil.Encode(secret), flagDev: true})
+	require.ErrorContains(t, err, "not supported")
+}
@@ -0,0 +1,158 @@
+package integrations
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/polymerdao/monomer/migration"
+	"github.com/spf13/cobra"
+)
+
+const migrationRecordFileName = "migration.json"
+
+func migrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate <exported-genesis>",
+		Short: "Convert the genesis exported from a CometBFT chain into a Monomer genesis",
+		Long: "Convert the genesis exported from a Cosmos chain running on CometBFT into a Monomer genesis. " +
+			"The exported app state replaces the app state of the genesis `init` wrote in the home directory, " +
+			"which provides the numeric chain ID, the genesis time, and the state of modules the chain didn't have. " +
+			"The height offset between the chains and the validator chosen to run the sequencer are recorded in " +
+			"config/" + migrationRecordFileName + ".",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sequencer, err := cmd.Flags().GetString("sequencer")
+			if err != nil {
+				return err
+			}
+			exported, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("load exported genesis: %v", err)
+			}
+
+			config := server.GetServerContextFromCmd(cmd).Config
+			recordPath := filepath.Join(config.RootDir, "config", migrationRecordFileName)
+			if _, err := os.Stat(recordPath); err == nil {
+				return fmt.Errorf("already migrated: %s exists", recordPath)
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("stat migration record: %v", err)
+			}
+			genesisPath := config.GenesisFile()
+			base, err := genutiltypes.AppGenesisFromFile(genesisPath)
+			if err != nil {
+				return fmt.Errorf("load application genesis file: %v", err)
+			}
+
+			migrated, record, err := migration.Migrate(exported, base, sequencer)
+			if err != nil {
+				return err
+			}
+			if err := genutil.ExportGenesisFile(migrated, genesisPath); err != nil {
+				return fmt.Errorf("export genesis file: %v", err)
+			}
+			recordJSON, err := json.MarshalIndent(record, "", "  ")
+			if err != nil {
+				return fmt.Errorf("marshal migration record: %v", err)
+			}
+			if err := os.WriteFile(recordPath, recordJSON, 0o600); err != nil {
+				return fmt.Errorf("write migration record: %v", err)
+			}
+
+			fmt.Fprintf(
+				cmd.OutOrStdout(),
+				"Migrated %s at height %d to chain %s; rollup height h is height h+%d of %s\n",
+				record.SourceChainID, record.ExportHeight, record.ChainID, record.HeightOffset, record.SourceChainID,
+			)
+			return nil
+		},
+	}
+	cmd.Flags().String("sequencer", "", "consensus address or name of the validator that runs the sequencer; defaults to the one with the most power")
+	return cmd
+}
+
+func exitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-cometbft <exported-genesis>",
+		Short: "Convert the rollup's exported state into a genesis a CometBFT chain can launch from",
+		Long: "Convert the state exported with `export`, usually at the rollup's halt height, into a genesis a Cosmos " +
+			"chain running on CometBFT can launch from, and write it to stdout. The chain continues at the next height. " +
+			"If the app has no staking module, pass the validators' priv_validator_key.json files; only their public " +
+			"keys are read.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := &migration.ExitConfig{}
+			var err error
+			if cfg.ChainID, err = cmd.Flags().GetString("chain-id"); err != nil {
+				return err
+			}
+			if cfg.GenesisTime, err = parseTimeFlag(cmd, "genesis-time"); err != nil {
+				return err
+			}
+			if cfg.DropModules, err = cmd.Flags().GetStringSlice("drop-module"); err != nil {
+				return err
+			}
+			keyPaths, err := cmd.Flags().GetStringSlice("validator-key")
+			if err != nil {
+				return err
+			}
+			power, err := cmd.Flags().GetInt64("validator-power")
+			if err != nil {
+				return err
+			}
+			for _, keyPath := range keyPaths {
+				validator, err := readGenesisValidator(keyPath, power)
+				if err != nil {
+					return err
+				}
+				cfg.Validators = append(cfg.Validators, validator)
+			}
+
+			exported, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("load exported genesis: %v", err)
+			}
+			exit, err := migration.ToCometBFT(exported, cfg)
+			if err != nil {
+				return err
+			}
+			exitJSON, err := json.MarshalIndent(exit, "", "  ")
+			if err != nil {
+				return fmt.Errorf("marshal genesis: %v", err)
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(exitJSON))
+			return err
+		},
+	}
+	cmd.Flags().String("chain-id", "", "chain ID of the CometBFT chain; defaults to the rollup's")
+	cmd.Flags().String("genesis-time", "", "RFC 3339 genesis time of the CometBFT chain; defaults to the export's")
+	cmd.Flags().StringSlice("drop-module", nil, "modules to remove from the app state, e.g., rollup")
+	cmd.Flags().StringSlice("validator-key", nil, "priv_validator_key.json files of the validators, if the app doesn't export any")
+	cmd.Flags().Int64("validator-power", 1, "voting power of each validator given with --validator-key")
+	return cmd
+}
+
+// readGenesisValidator reads the public key of a validator from its CometBFT priv_validator_key.json file.
+func readGenesisValidator(keyPath string, power int64) (cmttypes.GenesisValidator, error) {
+	keyJSON, err := os.ReadFile(keyPath)
+	if err != nil {
+		return cmttypes.GenesisValidator{}, fmt.Errorf("read validator key: %v", err)
+	}
+	var key privval.FilePVKey
+	if err := cmtjson.Unmarshal(keyJSON, &key); err != nil {
+		return cmttypes.GenesisValidator{}, fmt.Errorf("unmarshal validator key %s: %v", keyPath, err)
+	}
+	return cmttypes.GenesisValidator{
+		Address: key.PubKey.Address(),
+		PubKey:  key.PubKey,
+		Power:   power,
+	}, nil
+}
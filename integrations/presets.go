@@ -0,0 +1,102 @@
+package integrations
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/polymerdao/monomer/monomerdb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	presetDevnet  = "devnet"
+	presetTestnet = "testnet"
+)
+
+// preset is a named set of start flag values for a kind of network.
+type preset struct {
+	description string
+	flags       map[string]any
+}
+
+var presets = map[string]*preset{
+	presetDevnet: {
+		description: "a throwaway chain with the OP Stack devnet running in-process",
+		flags: map[string]any{
+			flagDev:       true,
+			flagConsensus: consensusRollup,
+			// Block explorers and scripts on the same machine often run in containers, which don't connect from a
+			// loopback address.
+			flagLocalAPI:        []string{},
+			flagCompression:     string(monomerdb.CompressionNone),
+			flagCompactInterval: time.Duration(0),
+		},
+	},
+	presetTestnet: {
+		description: "a long-lived chain following an external op-node",
+		flags: map[string]any{
+			flagDev:             false,
+			flagConsensus:       consensusRollup,
+			flagLocalAPI:        []string{"debug"},
+			flagCompression:     string(monomerdb.CompressionZstd),
+			flagCompactInterval: 24 * time.Hour,
+			flagQueryTimeout:    10 * time.Second,
+		},
+	},
+}
+
+// applyPreset sets the flags of the preset named by --monomer.preset, unless they are set on the command line or in
+// app.toml.
+func applyPreset(v *viper.Viper) error {
+	name := v.GetString(flagPreset)
+	if name == "" {
+		return nil
+	}
+	p, ok := presets[name]
+	if !ok {
+		return fmt.Errorf("unknown preset %q: must be %s or %s", name, presetDevnet, presetTestnet)
+	}
+	for key, value := range p.flags {
+		if !v.IsSet(key) {
+			v.Set(key, value)
+		}
+	}
+	return nil
+}
+
+func presetsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "presets",
+		Short: "List the presets --" + flagPreset + " accepts and the flags they set",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) //nolint:mnd
+			names := make([]string, 0, len(presets))
+			for name := range presets {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			for _, name := range names {
+				p := presets[name]
+				fmt.Fprintf(w, "%s\t%s\n", name, p.description)
+				keys := make([]string, 0, len(p.flags))
+				for key := range p.flags {
+					keys = append(keys, key)
+				}
+				slices.Sort(keys)
+				for _, key := range keys {
+					value := fmt.Sprint(p.flags[key])
+					if values, ok := p.flags[key].([]string); ok {
+						value = `"` + strings.Join(values, ",") + `"`
+					}
+					fmt.Fprintf(w, "  --%s\t%s\n", key, value)
+				}
+			}
+			return w.Flush()
+		},
+	}
+}
@@ -0,0 +1,140 @@
+package integrations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	opclient "github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/l1"
+	"github.com/polymerdao/monomer/systemconfig"
+	"github.com/spf13/viper"
+)
+
+// rollupConfigTimeout is how long loadRollupConfig waits for op-node.
+const rollupConfigTimeout = 10 * time.Second
+
+// loadRollupConfig reads the rollup config from a rollup.json file or, if source is an http or ws URL, from the op-node
+// serving it.
+func loadRollupConfig(ctx context.Context, source string) (*rollup.Config, error) {
+	for _, scheme := range []string{"http://", "https://", "ws://", "wss://"} {
+		if strings.HasPrefix(source, scheme) {
+			return fetchRollupConfig(ctx, source)
+		}
+	}
+	cfgBytes, err := os.ReadFile(source)
+	if err != nil {
+		return nil, fmt.Errorf("read rollup config: %v", err)
+	}
+	cfg := new(rollup.Config)
+	if err := json.Unmarshal(cfgBytes, cfg); err != nil {
+		return nil, fmt.Errorf("unmarshal rollup config: %v", err)
+	}
+	return cfg, nil
+}
+
+func fetchRollupConfig(ctx context.Context, opNodeURL string) (*rollup.Config, error) {
+	ctx, cancel := context.WithTimeout(ctx, rollupConfigTimeout)
+	defer cancel()
+	rpcClient, err := rpc.DialContext(ctx, opNodeURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial op-node: %v", err)
+	}
+	defer rpcClient.Close()
+	cfg, err := sources.NewRollupClient(opclient.NewBaseRPCClient(rpcClient)).RollupConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get rollup config from op-node: %v", err)
+	}
+	return cfg, nil
+}
+
+// checkRollupConfig returns an error if the rollup config is invalid or its L2 chain isn't the one with chainID and
+// genesisTime. The genesis block hash is checked by the node once the genesis is committed.
+func checkRollupConfig(cfg *rollup.Config, chainID monomer.ChainID, genesisTime uint64) error {
+	if err := cfg.Check(); err != nil {
+		return err
+	}
+	var errs []error
+	if cfg.L2ChainID == nil || !cfg.L2ChainID.IsUint64() || cfg.L2ChainID.Uint64() != uint64(chainID) {
+		errs = append(errs, fmt.Errorf("l2_chain_id is %v, but the genesis chain id is %s", cfg.L2ChainID, chainID))
+	}
+	if cfg.Genesis.L2Time != genesisTime {
+		errs = append(errs, fmt.Errorf("genesis.l2_time is %d, but the genesis time is %d", cfg.Genesis.L2Time, genesisTime))
+	}
+	if cfg.Genesis.L2.Number != 1 {
+		errs = append(errs, fmt.Errorf("genesis.l2.number is %d, but the genesis block is 1", cfg.Genesis.L2.Number))
+	}
+	return errors.Join(errs...)
+}
+
+// newSystemConfigCheck returns the config of the check of the L1 SystemConfig against the rollup config, or nil if the
+// check isn't enabled. The batcher and gas limit in the flags override the rollup config's, which are the values at
+// genesis.
+func newSystemConfigCheck(v *viper.Viper, rollupCfg *rollup.Config, l1Reader *l1.Reader) (*systemconfig.Config, error) {
+	overrides, err := systemConfigOverrides(v)
+	if err != nil || !v.GetBool(flagSysCfgCheck) {
+		return nil, err
+	}
+	if rollupCfg == nil {
+		return nil, fmt.Errorf("--%s requires --%s", flagSysCfgCheck, flagRollupConfig)
+	}
+	if l1Reader == nil {
+		return nil, fmt.Errorf("--%s requires --%s", flagSysCfgCheck, flagL1RPCURL)
+	}
+	expected := systemconfig.Values{
+		Batcher:           rollupCfg.Genesis.SystemConfig.BatcherAddr,
+		GasLimit:          rollupCfg.Genesis.SystemConfig.GasLimit,
+		UnsafeBlockSigner: overrides.UnsafeBlockSigner,
+		BatchInbox:        rollupCfg.BatchInboxAddress,
+		OptimismPortal:    rollupCfg.DepositContractAddress,
+	}
+	if overrides.Batcher != (common.Address{}) {
+		expected.Batcher = overrides.Batcher
+	}
+	if overrides.GasLimit != 0 {
+		expected.GasLimit = overrides.GasLimit
+	}
+	return &systemconfig.Config{
+		Caller:   l1Reader,
+		Address:  rollupCfg.L1SystemConfigAddress,
+		Expected: expected,
+		Interval: v.GetDuration(flagSysCfgInterval),
+	}, nil
+}
+
+// systemConfigOverrides returns the SystemConfig values in the flags.
+func systemConfigOverrides(v *viper.Viper) (*systemconfig.Values, error) {
+	if !v.GetBool(flagSysCfgCheck) {
+		for _, flag := range []string{flagSysCfgSigner, flagSysCfgBatcher, flagSysCfgGasLimit} {
+			if v.IsSet(flag) {
+				return nil, fmt.Errorf("--%s requires --%s", flag, flagSysCfgCheck)
+			}
+		}
+	} else if interval := v.GetDuration(flagSysCfgInterval); interval <= 0 {
+		return nil, fmt.Errorf("system config check interval must be positive, got %s", interval)
+	}
+	values := &systemconfig.Values{
+		GasLimit: v.GetUint64(flagSysCfgGasLimit),
+	}
+	for flag, address := range map[string]*common.Address{
+		flagSysCfgSigner:  &values.UnsafeBlockSigner,
+		flagSysCfgBatcher: &values.Batcher,
+	} {
+		if hex := v.GetString(flag); hex != "" {
+			if !common.IsHexAddress(hex) {
+				return nil, fmt.Errorf("--%s: invalid address %q", flag, hex)
+			}
+			*address = common.HexToAddress(hex)
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,104 @@
+package integrations
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer/l1"
+	"github.com/polymerdao/monomer/systemconfig"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+type rollupConfigAPI struct {
+	cfg *rollup.Config
+}
+
+func (api *rollupConfigAPI) RollupConfig() *rollup.Config {
+	return api.cfg
+}
+
+func TestLoadRollupConfig(t *testing.T) {
+	cfg := &rollup.Config{
+		BlockTime: 2,
+		L2ChainID: big.NewInt(1),
+	}
+
+	cfgBytes, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "rollup.json")
+	require.NoError(t, os.WriteFile(path, cfgBytes, 0o600))
+	got, err := loadRollupConfig(context.Background(), path)
+	require.NoError(t, err)
+	require.Equal(t, cfg, got)
+
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("optimism", &rollupConfigAPI{cfg: cfg}))
+	server := httptest.NewServer(rpcServer)
+	defer server.Close()
+	got, err = loadRollupConfig(context.Background(), server.URL)
+	require.NoError(t, err)
+	require.Equal(t, cfg, got)
+
+	_, err = loadRollupConfig(context.Background(), filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestNewSystemConfigCheck(t *testing.T) {
+	rollupCfg := &rollup.Config{
+		BatchInboxAddress:      common.Address{1},
+		DepositContractAddress: common.Address{2},
+		L1SystemConfigAddress:  common.Address{3},
+	}
+	rollupCfg.Genesis.SystemConfig.BatcherAddr = common.Address{4}
+	rollupCfg.Genesis.SystemConfig.GasLimit = 30_000_000
+	l1Reader := l1.NewReader(new(ethclient.Client), l1.DefaultTTL, l1.DefaultCacheSize, l1.NewNoopMetrics())
+
+	v := viper.New()
+	v.Set(flagSysCfgInterval, systemconfig.DefaultInterval)
+	cfg, err := newSystemConfigCheck(v, rollupCfg, l1Reader)
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+
+	v.Set(flagSysCfgSigner, common.Address{5}.Hex())
+	_, err = newSystemConfigCheck(v, rollupCfg, l1Reader)
+	require.ErrorContains(t, err, "requires --"+flagSysCfgCheck)
+
+	v.Set(flagSysCfgCheck, true)
+	_, err = newSystemConfigCheck(v, nil, l1Reader)
+	require.ErrorContains(t, err, "requires --"+flagRollupConfig)
+	_, err = newSystemConfigCheck(v, rollupCfg, nil)
+	require.ErrorContains(t, err, "requires --"+flagL1RPCURL)
+
+	cfg, err = newSystemConfigCheck(v, rollupCfg, l1Reader)
+	require.NoError(t, err)
+	require.Equal(t, rollupCfg.L1SystemConfigAddress, cfg.Address)
+	require.Equal(t, systemconfig.Values{
+		Batcher:           common.Address{4},
+		GasLimit:          30_000_000,
+		UnsafeBlockSigner: common.Address{5},
+		BatchInbox:        common.Address{1},
+		OptimismPortal:    common.Address{2},
+	}, cfg.Expected)
+
+	// The flags override the rollup config's genesis values.
+	v.Set(flagSysCfgBatcher, common.Address{6}.Hex())
+	v.Set(flagSysCfgGasLimit, 60_000_000)
+	cfg, err = newSystemConfigCheck(v, rollupCfg, l1Reader)
+	require.NoError(t, err)
+	require.Equal(t, common.Address{6}, cfg.Expected.Batcher)
+	require.Equal(t, uint64(60_000_000), cfg.Expected.GasLimit)
+
+	v.Set(flagSysCfgBatcher, "0x1234")
+	_, err = newSystemConfigCheck(v, rollupCfg, l1Reader)
+	require.ErrorContains(t, err, "invalid address")
+}
@@ -0,0 +1,62 @@
+package integrations
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"cosmossdk.io/store/streaming"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/viper"
+)
+
+var (
+	streamingPluginKey = strings.Join([]string{
+		baseapp.StreamingTomlKey,
+		baseapp.StreamingABCITomlKey,
+		baseapp.StreamingABCIPluginTomlKey,
+	}, ".")
+	streamingKeysKey = strings.Join([]string{
+		baseapp.StreamingTomlKey,
+		baseapp.StreamingABCITomlKey,
+		baseapp.StreamingABCIKeysTomlKey,
+	}, ".")
+)
+
+// streamingPlugin returns the name of the ABCI streaming plugin set in app.toml's [streaming.abci] section, or "" if
+// streaming is disabled. The app launches the plugin when it calls RegisterStreamingServices in its constructor, and
+// the plugin receives every block the builder executes, since the builder calls FinalizeBlock and Commit like
+// CometBFT does.
+func streamingPlugin(v *viper.Viper) string {
+	return strings.TrimSpace(v.GetString(streamingPluginKey))
+}
+
+// validateStreaming checks the [streaming.abci] section of app.toml, so a misconfigured plugin fails with a clear error
+// before the app tries to launch it.
+func validateStreaming(v *viper.Viper) error {
+	name := streamingPlugin(v)
+	if name == "" {
+		return nil
+	}
+	if _, ok := streaming.HandshakeMap[name]; !ok {
+		return fmt.Errorf("unsupported streaming plugin %q in %s: only abci is supported", name, streamingPluginKey)
+	}
+	if envKey := streaming.GetPluginEnvKey(name); os.Getenv(envKey) == "" {
+		return fmt.Errorf("streaming plugin %s requires %s to be set to the command that runs the plugin", name, envKey)
+	}
+	return nil
+}
+
+// withoutStreaming hides app.toml's streaming config from an app, so apps that are only created to check the config
+// don't launch the streaming plugin or stream the blocks they execute to it.
+type withoutStreaming struct {
+	servertypes.AppOptions
+}
+
+func (o withoutStreaming) Get(key string) any {
+	if key == baseapp.StreamingTomlKey || strings.HasPrefix(key, baseapp.StreamingTomlKey+".") {
+		return nil
+	}
+	return o.AppOptions.Get(key)
+}
@@ -0,0 +1,186 @@
+package integrations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"cosmossdk.io/log"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/polymerdao/monomer/surgery"
+	"github.com/spf13/cobra"
+)
+
+func surgeryCommand(appCreator servertypes.AppCreator) *cobra.Command {
+	surgeryCmd := &cobra.Command{
+		Use:   "surgery",
+		Short: "State patch subcommands for break-glass incident recovery",
+		Long: "State patch subcommands for break-glass incident recovery. Freeze the chain with --" + flagFreezeHeight +
+			", stop the node, create a patch, have it approved, and restart the node with --" + flagStatePatch +
+			" and without --" + flagFreezeHeight + ".",
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create <ops-file> <patch-file>",
+		Short: "Create a state patch at the node's height from a JSON file of ops",
+		Long: "Create a state patch at the node's height from a JSON file of ops, e.g., " +
+			`[{"store": "bank", "key": "0x01", "value": "0x02"}, {"store": "bank", "key": "0x03", "delete": true}]. ` +
+			"The current value of every key and the app hash are recorded in the patch, so it is only applied to the " +
+			"state it was created against. The node must be stopped, since the command opens its app database.",
+		Args: cobra.ExactArgs(2), //nolint:mnd
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reason, err := cmd.Flags().GetString("reason")
+			if err != nil {
+				return err
+			}
+			author, err := cmd.Flags().GetString("author")
+			if err != nil {
+				return err
+			}
+			if reason == "" || author == "" {
+				return errors.New("--reason and --author are required")
+			}
+			opsBytes, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("read ops: %v", err)
+			}
+			patch := &surgery.Patch{
+				Reason: reason,
+				Author: author,
+			}
+			if err := json.Unmarshal(opsBytes, &patch.Ops); err != nil {
+				return fmt.Errorf("unmarshal ops: %v", err)
+			}
+			if err := createStatePatch(cmd.Context(), server.GetServerContextFromCmd(cmd), appCreator, patch); err != nil {
+				return err
+			}
+			if err := writePatchFile(args[1], patch); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Wrote patch %s at height %d to %s\n", patch.Hash, patch.Height, args[1])
+			return nil
+		},
+	}
+	createCmd.Flags().String("reason", "", "why the patch is needed, e.g., a link to the incident")
+	createCmd.Flags().String("author", "", "who wrote the patch")
+	surgeryCmd.AddCommand(createCmd)
+
+	approveCmd := &cobra.Command{
+		Use:   "approve <patch-file>",
+		Short: "Add a reviewer's approval to a state patch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reviewer, err := cmd.Flags().GetString("reviewer")
+			if err != nil {
+				return err
+			}
+			patch, err := surgery.Read(args[0])
+			if err != nil {
+				return err
+			}
+			if err := patch.Approve(reviewer); err != nil {
+				return err
+			}
+			if err := writePatchFile(args[0], patch); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s approved patch %s\n", reviewer, patch.Hash)
+			return nil
+		},
+	}
+	approveCmd.Flags().String("reviewer", "", "who reviewed the patch")
+	surgeryCmd.AddCommand(approveCmd)
+
+	verifyCmd := &cobra.Command{
+		Use:   "verify <patch-file>",
+		Short: "Check a state patch's hash and approvals and print it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			minApprovals, err := cmd.Flags().GetInt("min-approvals")
+			if err != nil {
+				return err
+			}
+			patch, err := surgery.Read(args[0])
+			if err != nil {
+				return err
+			}
+			if err := patch.Verify(minApprovals); err != nil {
+				return err
+			}
+			printStatePatch(cmd.OutOrStdout(), patch)
+			return nil
+		},
+	}
+	verifyCmd.Flags().Int("min-approvals", defaultMinApprovals, "number of reviewers other than the author who must have approved the patch")
+	surgeryCmd.AddCommand(verifyCmd)
+	return surgeryCmd
+}
+
+// defaultMinApprovals is the number of approvals a state patch needs by default.
+const defaultMinApprovals = 1
+
+// createStatePatch sets the patch's chain ID, height, app hash, and the current values of its keys from the node's
+// app, and seals it.
+func createStatePatch(ctx context.Context, svrCtx *server.Context, appCreator servertypes.AppCreator, patch *surgery.Patch) (err error) {
+	g, err := loadGenesis(svrCtx.Config.GenesisFile())
+	if err != nil {
+		return err
+	}
+	// The SDK's default app db, as opened by the start command.
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(svrCtx.Viper), filepath.Join(svrCtx.Config.RootDir, "data"))
+	if err != nil {
+		return fmt.Errorf("open app db: %v", err)
+	}
+	app := appCreator(log.NewNopLogger(), db, &fakeTraceWriter{}, withoutStreaming{svrCtx.Viper})
+	defer func() {
+		err = errors.Join(err, app.Close())
+	}()
+	wrappedApp := NewWrappedApplication(app)
+	info, err := wrappedApp.Info(ctx, &abcitypes.RequestInfo{})
+	if err != nil {
+		return fmt.Errorf("info: %v", err)
+	}
+	patch.ChainID = uint64(g.ChainID)
+	patch.Height = uint64(info.GetLastBlockHeight())
+	patch.AppHash = info.GetLastBlockAppHash()
+	if err := patch.FillPrev(wrappedApp); err != nil {
+		return err
+	}
+	if err := patch.Seal(); err != nil {
+		return err
+	}
+	return patch.Verify(0)
+}
+
+func writePatchFile(path string, patch *surgery.Patch) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("create patch file: %v", err)
+	}
+	return errors.Join(patch.Write(f), f.Close())
+}
+
+func printStatePatch(w io.Writer, patch *surgery.Patch) {
+	fmt.Fprintf(w, "hash:      %s\n", patch.Hash)
+	fmt.Fprintf(w, "chain id:  %d\n", patch.ChainID)
+	fmt.Fprintf(w, "height:    %d (applied to block %d)\n", patch.Height, patch.Height+1)
+	fmt.Fprintf(w, "app hash:  %s\n", patch.AppHash)
+	fmt.Fprintf(w, "reason:    %s\n", patch.Reason)
+	fmt.Fprintf(w, "author:    %s\n", patch.Author)
+	fmt.Fprintf(w, "approvals: %s\n", strings.Join(patch.Approvals, ", "))
+	for _, op := range patch.Ops {
+		if op.Delete {
+			fmt.Fprintf(w, "delete %s %s (was %s)\n", op.Store, op.Key, op.Prev)
+		} else {
+			fmt.Fprintf(w, "set    %s %s = %s (was %s)\n", op.Store, op.Key, op.Value, op.Prev)
+		}
+	}
+}
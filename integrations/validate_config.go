@@ -0,0 +1,259 @@
+package integrations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"cosmossdk.io/log"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/buildinfo"
+	"github.com/polymerdao/monomer/e2e/url"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/monomerdb"
+	"github.com/polymerdao/monomer/monomerdb/localdb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func validateConfigCommand(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-config",
+		Short: "Check that the node config, the genesis, and the rollup config describe the same chain",
+		Long: "Check that the node config, the genesis, and the rollup config describe the same chain, before starting " +
+			"a node that would silently build a different chain than the op-node expects. It takes the start command's " +
+			"flags, computes the genesis block in memory, and compares it to the rollup config's genesis and to the " +
+			"block store's, if the node was started before. The node must be stopped if its block store exists.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			svrCtx := server.GetServerContextFromCmd(cmd)
+			if err := validateConfig(cmd.Context(), cmd.OutOrStdout(), svrCtx, appCreator); err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "Config is valid")
+			return nil
+		},
+	}
+	addStartFlags(cmd)
+	return cmd
+}
+
+// validateConfig writes the result of each check to out and returns an error if any failed.
+func validateConfig(ctx context.Context, out io.Writer, svrCtx *server.Context, appCreator servertypes.AppCreator) error {
+	var failed bool
+	check := func(name string, err error) bool {
+		if err != nil {
+			failed = true
+			fmt.Fprintf(out, "FAIL %s: %v\n", name, err)
+			return false
+		}
+		fmt.Fprintf(out, "ok   %s\n", name)
+		return true
+	}
+
+	check("node config", validateNodeConfig(svrCtx.Viper))
+
+	g, err := loadGenesis(svrCtx.Config.GenesisFile())
+	if !check("genesis", err) {
+		return errors.New("invalid config")
+	}
+	check("client version", buildinfo.CheckMinVersion(buildinfo.Version(), g.MinClientVersion))
+	genesisHash, err := genesisBlockHash(ctx, appCreator, svrCtx.Viper, g)
+	if !check("genesis block", err) {
+		return errors.New("invalid config")
+	}
+	fmt.Fprintf(out, "     genesis block hash %s\n", genesisHash)
+
+	if source := svrCtx.Viper.GetString(flagRollupConfig); source != "" {
+		check("rollup config", checkRollupConfigSource(ctx, source, g, genesisHash))
+	}
+
+	if _, err := os.Stat(filepath.Join(svrCtx.Config.RootDir, "blockstore")); err == nil {
+		check("block store", checkStoredGenesis(svrCtx, genesisHash))
+	}
+
+	if failed {
+		return errors.New("invalid config")
+	}
+	return nil
+}
+
+// validateNodeConfig runs the start command's checks of the flags and app.toml.
+func validateNodeConfig(v *viper.Viper) error {
+	if err := applyPreset(v); err != nil {
+		return err
+	}
+	if _, err := newLocalBlockTime(v); err != nil {
+		return err
+	}
+	engineURL, err := url.ParseString(v.GetString(flagEngineURL))
+	if err != nil {
+		return fmt.Errorf("parse engine url: %v", err)
+	} else if scheme := engineURL.Scheme(); scheme != "ws" && scheme != "wss" {
+		return fmt.Errorf("engine url needs to have scheme `ws` or `wss`, got %s", scheme)
+	}
+	if _, err := newEngineJWT(v); err != nil {
+		return err
+	}
+	if _, err := newTxForwardingConfig(v); err != nil {
+		return err
+	}
+	if _, err := newMempoolSyncConfig(v); err != nil {
+		return err
+	}
+	if _, err := newStatePatch(v); err != nil {
+		return err
+	}
+	if err := validateStreaming(v); err != nil {
+		return err
+	}
+	if _, err := systemConfigOverrides(v); err != nil {
+		return err
+	}
+	if v.GetBool(flagSysCfgCheck) && v.GetString(flagRollupConfig) == "" {
+		return fmt.Errorf("--%s requires --%s", flagSysCfgCheck, flagRollupConfig)
+	}
+	if name := v.GetString(flagCompression); name != "" {
+		if _, err := monomerdb.ParseCompression(name); err != nil {
+			return err
+		}
+	}
+	svrCfg, err := serverconfig.GetConfig(v)
+	if err != nil {
+		return fmt.Errorf("get server config: %v", err)
+	}
+	if err := svrCfg.ValidateBasic(); err != nil {
+		return fmt.Errorf("validate server config: %v", err)
+	}
+	return nil
+}
+
+// loadGenesis reads the Monomer genesis from the application genesis file.
+func loadGenesis(path string) (*genesis.Genesis, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load application genesis file: %v", err)
+	}
+	l2ChainID, err := strconv.ParseUint(appGenesis.ChainID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse chain ID: %v", err)
+	}
+	if appGenesis.GenesisTime.Unix() <= 0 {
+		return nil, errors.New("genesis time must be after the unix epoch")
+	}
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return nil, fmt.Errorf("unmarshal app state: %v", err)
+	}
+	minClientVersion, err := readMinClientVersion(path)
+	if err != nil {
+		return nil, err
+	}
+	return &genesis.Genesis{
+		ChainID:          monomer.ChainID(l2ChainID),
+		AppState:         appState,
+		Time:             uint64(appGenesis.GenesisTime.Unix()),
+		MinClientVersion: minClientVersion,
+	}, nil
+}
+
+// readMinClientVersion reads the min_client_version field of the application genesis file, which the Cosmos SDK's
+// genesis type doesn't have.
+func readMinClientVersion(path string) (string, error) {
+	genesisJSON, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read genesis file: %v", err)
+	}
+	var fields struct {
+		MinClientVersion string `json:"min_client_version"`
+	}
+	if err := json.Unmarshal(genesisJSON, &fields); err != nil {
+		return "", fmt.Errorf("unmarshal genesis file: %v", err)
+	}
+	return fields.MinClientVersion, nil
+}
+
+// genesisBlockHash commits the genesis to an application and block store in memory and returns the genesis block's
+// hash, which is the hash a node started with the same genesis commits.
+func genesisBlockHash(
+	ctx context.Context,
+	appCreator servertypes.AppCreator,
+	v *viper.Viper,
+	g *genesis.Genesis,
+) (_ common.Hash, err error) {
+	app := appCreator(log.NewNopLogger(), dbm.NewMemDB(), &fakeTraceWriter{}, withoutStreaming{v})
+	defer func() {
+		err = errors.Join(err, app.Close())
+	}()
+	blockPebbleDB, err := pebble.Open("", &pebble.Options{
+		FS: vfs.NewMem(),
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("open blockstore: %v", err)
+	}
+	defer func() {
+		err = errors.Join(err, blockPebbleDB.Close())
+	}()
+	blockdb := localdb.New(blockPebbleDB)
+	if err := g.Commit(ctx, NewWrappedApplication(app), blockdb, state.NewDatabase(rawdb.NewMemoryDatabase())); err != nil {
+		return common.Hash{}, fmt.Errorf("commit genesis: %v", err)
+	}
+	header, err := blockdb.HeaderByHeight(1)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("get genesis block: %v", err)
+	}
+	return header.Hash, nil
+}
+
+// checkRollupConfigSource returns an error if the rollup config at source doesn't describe the chain that starts with
+// the genesis block.
+func checkRollupConfigSource(ctx context.Context, source string, g *genesis.Genesis, genesisHash common.Hash) error {
+	cfg, err := loadRollupConfig(ctx, source)
+	if err != nil {
+		return err
+	}
+	if err := checkRollupConfig(cfg, g.ChainID, g.Time); err != nil {
+		return err
+	}
+	if cfg.Genesis.L2.Hash != genesisHash {
+		return fmt.Errorf("genesis.l2.hash is %s, but the genesis block hash is %s", cfg.Genesis.L2.Hash, genesisHash)
+	}
+	return nil
+}
+
+// checkStoredGenesis returns an error if the block store's genesis block isn't the one the genesis commits, e.g., after
+// the genesis file was edited.
+func checkStoredGenesis(svrCtx *server.Context, genesisHash common.Hash) (err error) {
+	blockdb, closeBlockDB, err := openBlockStore(svrCtx.Config.RootDir, svrCtx.Viper.GetString(flagColdBlockStore))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, closeBlockDB())
+	}()
+	header, err := blockdb.HeaderByHeight(1)
+	if errors.Is(err, monomerdb.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("get genesis block: %v", err)
+	}
+	if header.Hash != genesisHash {
+		return fmt.Errorf("the stored genesis block hash is %s, but the genesis block hash is %s", header.Hash, genesisHash)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package integrations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/monomerdb"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPreset(t *testing.T) {
+	v := viper.New()
+	require.NoError(t, applyPreset(v))
+	require.False(t, v.IsSet(flagCompression))
+
+	v.Set(flagPreset, presetTestnet)
+	v.Set(flagCompression, string(monomerdb.CompressionSnappy))
+	require.NoError(t, applyPreset(v))
+	// Flags that are set aren't overridden.
+	require.Equal(t, string(monomerdb.CompressionSnappy), v.GetString(flagCompression))
+	require.Equal(t, 24*time.Hour, v.GetDuration(flagCompactInterval))
+	require.Equal(t, []string{"debug"}, v.GetStringSlice(flagLocalAPI))
+
+	v.Set(flagPreset, "mainnet")
+	require.Error(t, applyPreset(v))
+}
+
+func TestValidateConfig(t *testing.T) {
+	svrCtx := server.NewDefaultContext()
+	svrCtx.Config.RootDir = t.TempDir()
+	genesisPath, err := filepath.Abs("testdata/genesis.json")
+	require.NoError(t, err)
+	svrCtx.Config.Genesis = genesisPath
+	svrCtx.Viper.Set("minimum-gas-prices", "0.025stake")
+	svrCtx.Viper.Set(flagEngineURL, "ws://127.0.0.1:9000")
+
+	g, err := loadGenesis(genesisPath)
+	require.NoError(t, err)
+	genesisHash, err := genesisBlockHash(context.Background(), mockAppCreator, svrCtx.Viper, g)
+	require.NoError(t, err)
+
+	validate := func(cfg *rollup.Config) (string, error) {
+		cfgBytes, err := json.Marshal(cfg)
+		require.NoError(t, err)
+		path := filepath.Join(t.TempDir(), "rollup.json")
+		require.NoError(t, os.WriteFile(path, cfgBytes, 0o600))
+		svrCtx.Viper.Set(flagRollupConfig, path)
+		var out bytes.Buffer
+		err = validateConfig(context.Background(), &out, svrCtx, mockAppCreator)
+		return out.String(), err
+	}
+
+	cfg := &rollup.Config{
+		Genesis: rollup.Genesis{
+			L1: eth.BlockID{
+				Hash: common.Hash{1},
+			},
+			L2: eth.BlockID{
+				Hash:   genesisHash,
+				Number: 1,
+			},
+			L2Time: g.Time,
+			SystemConfig: eth.SystemConfig{
+				BatcherAddr: common.Address{1},
+				Overhead:    eth.Bytes32{1},
+				Scalar:      eth.Bytes32{1},
+				GasLimit:    30_000_000,
+			},
+		},
+		BlockTime:              2,
+		SeqWindowSize:          3600,
+		ChannelTimeout:         300,
+		L1ChainID:              big.NewInt(900),
+		L2ChainID:              new(big.Int).SetUint64(uint64(g.ChainID)),
+		BatchInboxAddress:      common.Address{1},
+		DepositContractAddress: common.Address{1},
+	}
+	out, err := validate(cfg)
+	require.NoError(t, err, out)
+	require.Contains(t, out, "ok   rollup config")
+	require.Contains(t, out, "ok   client version")
+
+	// The rollup config of another chain.
+	cfg.L2ChainID = big.NewInt(2)
+	out, err = validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, out, "FAIL rollup config: l2_chain_id")
+	cfg.L2ChainID = new(big.Int).SetUint64(uint64(g.ChainID))
+
+	// The rollup config of a chain with another genesis.
+	cfg.Genesis.L2.Hash = common.Hash{2}
+	out, err = validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, out, "FAIL rollup config: genesis.l2.hash")
+	cfg.Genesis.L2.Hash = genesisHash
+
+	// An invalid node config is reported along with the other checks.
+	svrCtx.Viper.Set(flagEngineURL, "http://127.0.0.1:9000")
+	out, err = validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, out, "FAIL node config")
+	require.Contains(t, out, "ok   rollup config")
+	svrCtx.Viper.Set(flagEngineURL, "ws://127.0.0.1:9000")
+
+	// A genesis that requires a newer client. Test binaries have an unknown version, which can't satisfy a minimum.
+	genesisJSON, err := os.ReadFile(genesisPath)
+	require.NoError(t, err)
+	var genesisFields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genesisJSON, &genesisFields))
+	genesisFields["min_client_version"] = json.RawMessage(`"v0.2.0"`)
+	genesisJSON, err = json.Marshal(genesisFields)
+	require.NoError(t, err)
+	svrCtx.Config.Genesis = filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, os.WriteFile(svrCtx.Config.Genesis, genesisJSON, 0o600))
+	out, err = validate(cfg)
+	require.Error(t, err)
+	require.Contains(t, out, "FAIL client version: version unknown can't be compared to the minimum version v0.2.0")
+	require.Contains(t, out, "ok   rollup config")
+}
@@ -0,0 +1,202 @@
+package integrations
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/polymerdao/monomer/admission"
+	"github.com/polymerdao/monomer/comet"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/spf13/cobra"
+)
+
+func whyNotIncludedCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "why-not-included <tx-hash>",
+		Short: "Explain why a tx was not included in a block",
+		Long: "Explain why a tx was not included in a block. The node reports whether the tx is pending in its mempool, " +
+			"was rejected and why, or was never seen. For txs the node has, the signers' sequences, the fee, and the " +
+			"timeout height are checked against the chain.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			hash, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return fmt.Errorf("decode tx hash: %v", err)
+			}
+
+			rpcClient, err := jsonrpcclient.New(clientCtx.NodeURI)
+			if err != nil {
+				return fmt.Errorf("new rpc client: %v", err)
+			}
+			status := new(comet.ResultTxStatus)
+			if _, err := rpcClient.Call(cmd.Context(), "tx_status", map[string]any{"hash": hash}, status); err != nil {
+				return fmt.Errorf("get tx status: %v", err)
+			}
+
+			var findings []string
+			if status.Tx != nil && status.Status != comet.TxStatusIncluded {
+				findings, err = checkTx(cmd.Context(), clientCtx, status.Tx)
+				if err != nil {
+					return err
+				}
+			}
+			return explainTxStatus(cmd.OutOrStdout(), status, findings)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// checkTx checks the tx against the latest state of the chain, returning any findings that explain why it can't be
+// included.
+func checkTx(ctx context.Context, clientCtx client.Context, txBytes bfttypes.Tx) ([]string, error) { //nolint:gocritic // hugeParam
+	tx, err := clientCtx.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return []string{fmt.Sprintf("The tx can't be decoded: %v.", err)}, nil
+	}
+	var findings []string
+
+	status, err := clientCtx.Client.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get status: %v", err)
+	}
+	if timeoutTx, ok := tx.(sdk.TxWithTimeoutHeight); ok {
+		findings = append(findings, checkTimeoutHeight(timeoutTx.GetTimeoutHeight(), status.SyncInfo.LatestBlockHeight)...)
+	}
+
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil {
+			return nil, fmt.Errorf("get signatures: %v", err)
+		}
+		for _, sig := range sigs {
+			if sig.PubKey == nil {
+				continue
+			}
+			addr := sdk.AccAddress(sig.PubKey.Address())
+			_, accountSequence, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+			if err != nil {
+				findings = append(findings, fmt.Sprintf("The signer %s has no account on chain: %v.", addr, err))
+				continue
+			}
+			findings = append(findings, checkSequence(addr, accountSequence, sig.Sequence)...)
+		}
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		findings = append(findings, fmt.Sprintf("The tx pays %s for a gas limit of %d. "+
+			"Nodes reject txs that pay less than their minimum gas prices times the gas limit.", feeTx.GetFee(), feeTx.GetGas()))
+	}
+	return findings, nil
+}
+
+func checkTimeoutHeight(timeoutHeight uint64, latestHeight int64) []string {
+	if timeoutHeight == 0 || latestHeight < int64(timeoutHeight) {
+		return nil
+	}
+	return []string{fmt.Sprintf("The tx expired: its timeout height is %d, but the chain is at height %d. "+
+		"Sign it again with a later timeout height.", timeoutHeight, latestHeight)}
+}
+
+func checkSequence(signer sdk.AccAddress, accountSequence, txSequence uint64) []string {
+	switch {
+	case txSequence > accountSequence:
+		return []string{fmt.Sprintf("Nonce gap: the next sequence of %s is %d, but the tx uses %d. "+
+			"The txs with sequences %d through %d must be included first.",
+			signer, accountSequence, txSequence, accountSequence, txSequence-1)}
+	case txSequence < accountSequence:
+		return []string{fmt.Sprintf("Nonce already used: the next sequence of %s is %d, but the tx uses %d. "+
+			"Another tx with the same sequence was included; sign the tx again with sequence %d.",
+			signer, accountSequence, txSequence, accountSequence)}
+	default:
+		return nil
+	}
+}
+
+func explainTxStatus(w io.Writer, status *comet.ResultTxStatus, findings []string) error {
+	var lines []string
+	switch status.Status {
+	case comet.TxStatusIncluded:
+		if status.Code == 0 {
+			lines = append(lines, fmt.Sprintf("The tx was included in block %d at index %d and succeeded.", status.Height, status.Index))
+		} else {
+			lines = append(lines,
+				fmt.Sprintf("The tx was included in block %d at index %d, but it failed:", status.Height, status.Index),
+				explainCode(status.Code, status.Codespace, status.Log))
+		}
+	case comet.TxStatusPending:
+		lines = append(lines, "The tx is pending in the mempool. "+
+			"It will be included in the next block op-node asks the node to build from the mempool. "+
+			"If it stays pending, check that op-node is running and sequencing.")
+	case comet.TxStatusRejected:
+		switch status.Reason {
+		case mempool.ReasonEvicted:
+			lines = append(lines, fmt.Sprintf("The tx was removed from the mempool at %s:", status.RejectedAt.Format(time.RFC3339)))
+		case mempool.ReasonReplaced:
+			lines = append(lines, fmt.Sprintf("The tx was replaced in the mempool by a tx that pays a higher fee at %s:",
+				status.RejectedAt.Format(time.RFC3339)))
+		case mempool.ReasonSequencerRejected:
+			lines = append(lines, fmt.Sprintf("The tx passed this node's checks, but the sequencer rejected it at %s:",
+				status.RejectedAt.Format(time.RFC3339)))
+		default:
+			lines = append(lines, fmt.Sprintf("The tx was rejected at %s:", status.RejectedAt.Format(time.RFC3339)))
+		}
+		lines = append(lines, explainCode(status.Code, status.Codespace, status.Log))
+	case comet.TxStatusUnknown:
+		lines = append(lines, "The node has never seen the tx. It was never submitted to this node, "+
+			"or the hash is not the tx's canonical (SHA-256) hash. "+
+			"Hashes from the eth namespace only identify txs that were included.")
+	default:
+		return fmt.Errorf("unknown tx status %q", status.Status)
+	}
+	lines = append(lines, findings...)
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// explainCode explains the most common reasons txs are rejected or fail.
+func explainCode(code uint32, codespace, log string) string {
+	var reason string
+	switch {
+	case codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrWrongSequence.ABCICode():
+		reason = "The tx's sequence (nonce) does not match the signer's account."
+	case codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrInsufficientFee.ABCICode():
+		reason = "The tx's fee is below the node's minimum gas prices."
+	case codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrTxTimeoutHeight.ABCICode():
+		reason = "The tx expired before it was included."
+	case codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrOutOfGas.ABCICode():
+		reason = "The tx ran out of gas. Sign it again with a higher gas limit."
+	case codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrInsufficientFunds.ABCICode():
+		reason = "The signer can't afford the tx."
+	case codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrUnauthorized.ABCICode():
+		reason = "The tx's signature is invalid. Check the chain ID and account number it was signed with."
+	case codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrTxDecode.ABCICode():
+		reason = "The tx can't be decoded."
+	case codespace == admission.Codespace:
+		reason = "The node's admission policy rejected the tx."
+	case codespace == mempool.Codespace && code == mempool.CodeReplaced:
+		reason = "Only one tx per signer and sequence can be included, so the replacement is included instead."
+	case codespace == mempool.Codespace && code == mempool.CodeFull:
+		reason = "The mempool was full. Resubmit it with a higher fee to evict txs that pay less."
+	case codespace == mempool.Codespace && code == mempool.CodeReorged:
+		reason = "The tx was included in a block that was reorged out and couldn't be added back to the mempool. Resubmit it."
+	default:
+		reason = fmt.Sprintf("The tx failed with code %d (codespace %q).", code, codespace)
+	}
+	return fmt.Sprintf("%s Log: %s", reason, log)
+}
@@ -0,0 +1,113 @@
+package integrations
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/polymerdao/monomer/admission"
+	"github.com/polymerdao/monomer/comet"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainTxStatus(t *testing.T) {
+	rejectedAt := time.Unix(1, 0).UTC()
+	for name, test := range map[string]struct {
+		status *comet.ResultTxStatus
+		want   string
+	}{
+		"included": {
+			status: &comet.ResultTxStatus{Status: comet.TxStatusIncluded, Height: 3, Index: 1},
+			want:   "included in block 3 at index 1 and succeeded",
+		},
+		"included but failed": {
+			status: &comet.ResultTxStatus{
+				Status:    comet.TxStatusIncluded,
+				Code:      sdkerrors.ErrOutOfGas.ABCICode(),
+				Codespace: sdkerrors.RootCodespace,
+			},
+			want: "ran out of gas",
+		},
+		"pending": {
+			status: &comet.ResultTxStatus{Status: comet.TxStatusPending},
+			want:   "pending in the mempool",
+		},
+		"wrong sequence": {
+			status: &comet.ResultTxStatus{
+				Status:     comet.TxStatusRejected,
+				Code:       sdkerrors.ErrWrongSequence.ABCICode(),
+				Codespace:  sdkerrors.RootCodespace,
+				RejectedAt: &rejectedAt,
+			},
+			want: "sequence (nonce) does not match",
+		},
+		"insufficient fee": {
+			status: &comet.ResultTxStatus{
+				Status:     comet.TxStatusRejected,
+				Code:       sdkerrors.ErrInsufficientFee.ABCICode(),
+				Codespace:  sdkerrors.RootCodespace,
+				RejectedAt: &rejectedAt,
+			},
+			want: "below the node's minimum gas prices",
+		},
+		"admission": {
+			status: &comet.ResultTxStatus{
+				Status:     comet.TxStatusRejected,
+				Code:       1,
+				Codespace:  admission.Codespace,
+				Log:        "sender is denied",
+				RejectedAt: &rejectedAt,
+			},
+			want: "admission policy rejected the tx. Log: sender is denied",
+		},
+		"evicted": {
+			status: &comet.ResultTxStatus{
+				Status:     comet.TxStatusRejected,
+				Reason:     mempool.ReasonEvicted,
+				Code:       sdkerrors.ErrInsufficientFunds.ABCICode(),
+				Codespace:  sdkerrors.RootCodespace,
+				RejectedAt: &rejectedAt,
+			},
+			want: "removed from the mempool at 1970-01-01T00:00:01Z:\nThe signer can't afford the tx.",
+		},
+		"replaced": {
+			status: &comet.ResultTxStatus{
+				Status:     comet.TxStatusRejected,
+				Reason:     mempool.ReasonReplaced,
+				Code:       mempool.CodeReplaced,
+				Codespace:  mempool.Codespace,
+				RejectedAt: &rejectedAt,
+			},
+			want: "replaced in the mempool by a tx that pays a higher fee at 1970-01-01T00:00:01Z:\nOnly one tx per signer",
+		},
+		"unknown": {
+			status: &comet.ResultTxStatus{Status: comet.TxStatusUnknown},
+			want:   "never seen the tx",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			require.NoError(t, explainTxStatus(&out, test.status, []string{"finding"}))
+			require.Contains(t, out.String(), test.want)
+			require.True(t, strings.HasSuffix(out.String(), "\nfinding\n"))
+		})
+	}
+
+	require.Error(t, explainTxStatus(new(strings.Builder), &comet.ResultTxStatus{Status: "bogus"}, nil))
+}
+
+func TestCheckSequence(t *testing.T) {
+	signer := sdk.AccAddress("signer")
+	require.Empty(t, checkSequence(signer, 5, 5))
+	require.Contains(t, checkSequence(signer, 5, 7)[0], "sequences 5 through 6 must be included first")
+	require.Contains(t, checkSequence(signer, 5, 3)[0], "Nonce already used")
+}
+
+func TestCheckTimeoutHeight(t *testing.T) {
+	require.Empty(t, checkTimeoutHeight(0, 10))
+	require.Empty(t, checkTimeoutHeight(11, 10))
+	require.Contains(t, checkTimeoutHeight(10, 10)[0], "expired")
+}
@@ -2,9 +2,15 @@ package integrations
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	storetypes "cosmossdk.io/store/types"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/query"
+	"github.com/polymerdao/monomer/surgery"
 )
 
 // A wrapper around `servertypes.Application` that reconciles discrepancies
@@ -14,10 +20,72 @@ type WrappedApplication struct {
 	app servertypes.Application
 }
 
+var (
+	_ monomer.Application = (*WrappedApplication)(nil)
+	_ surgery.State       = (*WrappedApplication)(nil)
+)
+
+// NewWrappedApplication adapts a Cosmos SDK application to the monomer.Application interface.
+func NewWrappedApplication(app servertypes.Application) *WrappedApplication {
+	return &WrappedApplication{
+		app: app,
+	}
+}
+
 func (wa *WrappedApplication) RollbackToHeight(_ context.Context, targetHeight uint64) error {
 	return wa.app.CommitMultiStore().RollbackToVersion(int64(targetHeight))
 }
 
+// PruneStatesBelow deletes the app state versions below height. It is used by the pruning package, which takes over
+// pruning from the Cosmos SDK so that versions inside the challenge window are kept.
+func (wa *WrappedApplication) PruneStatesBelow(_ context.Context, height uint64) error {
+	store, ok := wa.app.CommitMultiStore().(interface{ PruneStores(int64) error })
+	if !ok {
+		return errors.New("commit multi store does not support pruning")
+	}
+	return store.PruneStores(int64(height) - 1)
+}
+
+// StateValue returns the value of the key in the named store of the latest app state. It is used by the surgery
+// package, which patches the state of halted chains.
+func (wa *WrappedApplication) StateValue(store string, key []byte) ([]byte, error) {
+	kvStore, err := wa.kvStore(store)
+	if err != nil {
+		return nil, err
+	}
+	return kvStore.Get(key), nil
+}
+
+// SetStateValue sets the value of the key in the named store, or deletes it if value is nil. The write is committed
+// with the next block.
+func (wa *WrappedApplication) SetStateValue(store string, key, value []byte) error {
+	kvStore, err := wa.kvStore(store)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		kvStore.Delete(key)
+	} else {
+		kvStore.Set(key, value)
+	}
+	return nil
+}
+
+func (wa *WrappedApplication) kvStore(name string) (storetypes.KVStore, error) {
+	cms := wa.app.CommitMultiStore()
+	keys, ok := cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil, errors.New("commit multi store does not list its stores")
+	}
+	key, ok := keys.StoreKeysByName()[name]
+	if !ok {
+		return nil, fmt.Errorf("store %q not found", name)
+	}
+	return cms.GetKVStore(key), nil
+}
+
 func (wa *WrappedApplication) Info(_ context.Context, req *abcitypes.RequestInfo) (*abcitypes.ResponseInfo, error) {
 	return wa.app.Info(req)
 }
@@ -41,6 +109,49 @@ func (wa *WrappedApplication) InitChain(_ context.Context, req *abcitypes.Reques
 	return wa.app.InitChain(req)
 }
 
+func (wa *WrappedApplication) PrepareProposal(
+	_ context.Context,
+	req *abcitypes.RequestPrepareProposal,
+) (*abcitypes.ResponsePrepareProposal, error) {
+	return wa.app.PrepareProposal(req)
+}
+
+func (wa *WrappedApplication) ProcessProposal(
+	_ context.Context,
+	req *abcitypes.RequestProcessProposal,
+) (*abcitypes.ResponseProcessProposal, error) {
+	return wa.app.ProcessProposal(req)
+}
+
+func (wa *WrappedApplication) ListSnapshots(
+	_ context.Context,
+	req *abcitypes.RequestListSnapshots,
+) (*abcitypes.ResponseListSnapshots, error) {
+	return wa.app.ListSnapshots(req)
+}
+
+func (wa *WrappedApplication) LoadSnapshotChunk(
+	_ context.Context,
+	req *abcitypes.RequestLoadSnapshotChunk,
+) (*abcitypes.ResponseLoadSnapshotChunk, error) {
+	return wa.app.LoadSnapshotChunk(req)
+}
+
+func (wa *WrappedApplication) OfferSnapshot(
+	_ context.Context,
+	req *abcitypes.RequestOfferSnapshot,
+) (*abcitypes.ResponseOfferSnapshot, error) {
+	return wa.app.OfferSnapshot(req)
+}
+
+func (wa *WrappedApplication) ApplySnapshotChunk(
+	_ context.Context,
+	req *abcitypes.RequestApplySnapshotChunk,
+) (*abcitypes.ResponseApplySnapshotChunk, error) {
+	return wa.app.ApplySnapshotChunk(req)
+}
+
+// Query stops gRPC queries when ctx is done. See query.Serve.
 func (wa *WrappedApplication) Query(ctx context.Context, req *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error) {
-	return wa.app.Query(ctx, req)
+	return query.Serve(ctx, wa.app, req)
 }
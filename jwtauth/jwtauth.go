@@ -0,0 +1,246 @@
+// Package jwtauth authenticates Engine API clients, e.g., op-node, and builder API clients with a JWT secret shared
+// through a file or set directly. The file is reloaded while the node runs, so the secret can be rotated without a
+// restart: after the file changes, the previous secret is accepted for a rotation window, which leaves time to restart
+// the client with the new one.
+package jwtauth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// SecretSize is the size of the secrets op-node accepts.
+	SecretSize = 32
+	// DefaultReloadInterval is how often the secret file is checked for changes by default.
+	DefaultReloadInterval = 5 * time.Second
+	// DefaultRotationWindow is how long the previous secret is accepted after a rotation by default.
+	DefaultRotationWindow = 10 * time.Minute
+)
+
+// maxTokenAge is how far a token's iat claim can be from the current time, as in the Engine API spec.
+const maxTokenAge = time.Minute
+
+// Generate returns a random secret.
+func Generate() ([]byte, error) {
+	secret := make([]byte, SecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("generate secret: %v", err)
+	}
+	return secret, nil
+}
+
+// WriteSecretFile writes the secret to path hex-encoded, the format op-node reads. The file is replaced atomically, so a
+// node reloading it never reads a partial secret.
+func WriteSecretFile(path string, secret []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %v", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // The file was renamed if writing succeeded.
+	if _, err := tmp.WriteString(hexutil.Encode(secret) + "\n"); err != nil {
+		return errors.Join(fmt.Errorf("write secret: %v", err), tmp.Close())
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temp file: %v", err)
+	}
+	// CreateTemp makes files only the owner can read, which is what a secret needs.
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("rename temp file: %v", err)
+	}
+	return nil
+}
+
+// ReadSecretFile reads a hex-encoded secret, with or without a 0x prefix.
+func ReadSecretFile(path string) ([]byte, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read secret file: %v", err)
+	}
+	return DecodeSecret(string(contents))
+}
+
+// DecodeSecret decodes a hex-encoded secret, with or without a 0x prefix.
+func DecodeSecret(hexSecret string) ([]byte, error) {
+	hexSecret = strings.TrimSpace(hexSecret)
+	if !strings.HasPrefix(hexSecret, "0x") {
+		hexSecret = "0x" + hexSecret
+	}
+	secret, err := hexutil.Decode(hexSecret)
+	if err != nil {
+		return nil, fmt.Errorf("decode secret: %v", err)
+	}
+	if len(secret) != SecretSize {
+		return nil, fmt.Errorf("secret is %d bytes, not %d", len(secret), SecretSize)
+	}
+	return secret, nil
+}
+
+// Config configures Secrets.
+type Config struct {
+	// Path is the secret file.
+	Path string
+	// Secret is used instead of the secret file if Path is empty. It is never rotated.
+	Secret []byte
+	// ReloadInterval is how often the file is checked for changes. It defaults to DefaultReloadInterval.
+	ReloadInterval time.Duration
+	// RotationWindow is how long the previous secret is accepted after the file changes. It defaults to
+	// DefaultRotationWindow.
+	RotationWindow time.Duration
+}
+
+// Secrets holds the current secret and, during a rotation window after the secret file changed, the previous one.
+type Secrets struct {
+	path           string
+	reloadInterval time.Duration
+	rotationWindow time.Duration
+
+	mu             sync.RWMutex
+	current        []byte
+	previous       []byte
+	previousExpiry time.Time
+}
+
+// NewSecrets reads the secret file, or checks the secret if there is no file.
+func NewSecrets(cfg *Config) (*Secrets, error) {
+	secret := cfg.Secret
+	if cfg.Path != "" {
+		var err error
+		if secret, err = ReadSecretFile(cfg.Path); err != nil {
+			return nil, err
+		}
+	} else if len(secret) != SecretSize {
+		return nil, fmt.Errorf("secret is %d bytes, not %d", len(secret), SecretSize)
+	}
+	reloadInterval := cfg.ReloadInterval
+	if reloadInterval == 0 {
+		reloadInterval = DefaultReloadInterval
+	}
+	rotationWindow := cfg.RotationWindow
+	if rotationWindow == 0 {
+		rotationWindow = DefaultRotationWindow
+	}
+	return &Secrets{
+		path:           cfg.Path,
+		reloadInterval: reloadInterval,
+		rotationWindow: rotationWindow,
+		current:        secret,
+	}, nil
+}
+
+// Run reloads the secret file every reload interval until ctx is done. Errors are passed to onErr and don't stop it;
+// the secrets from the last successful reload stay in use. It returns immediately if there is no secret file.
+func (s *Secrets) Run(ctx context.Context, onErr func(error)) {
+	if s.path == "" {
+		return
+	}
+	ticker := time.NewTicker(s.reloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Reload(); err != nil {
+				onErr(err)
+			}
+		}
+	}
+}
+
+// Reload reads the secret file and reports whether the secret changed. If it did, the previous secret is accepted for
+// the rotation window. Without a secret file, the secret never changes.
+func (s *Secrets) Reload() (bool, error) {
+	if s.path == "" {
+		return false, nil
+	}
+	secret, err := ReadSecretFile(s.path)
+	if err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if bytes.Equal(secret, s.current) {
+		return false, nil
+	}
+	s.previous = s.current
+	s.previousExpiry = time.Now().Add(s.rotationWindow)
+	s.current = secret
+	return true, nil
+}
+
+// Handler serves the requests that carry a valid token with authenticated and the requests without a token with
+// unauthenticated, e.g., a handler that leaves out the methods that require authentication. Requests with an invalid
+// token are rejected, as are the requests without a token if unauthenticated is nil.
+func (s *Secrets) Handler(authenticated, unauthenticated http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		if authorization == "" && unauthenticated != nil {
+			unauthenticated.ServeHTTP(w, r)
+			return
+		}
+		if err := s.authenticate(authorization, time.Now()); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		authenticated.ServeHTTP(w, r)
+	})
+}
+
+// Authenticate returns an error unless the Authorization header's token is valid, for handlers that need more than
+// Handler, e.g., to choose the secrets by the token's claims.
+func (s *Secrets) Authenticate(authorization string) error {
+	return s.authenticate(authorization, time.Now())
+}
+
+// authenticate returns an error unless the Authorization header's token is signed with the current secret or, during
+// the rotation window, the previous one, and its iat claim is within maxTokenAge of now.
+func (s *Secrets) authenticate(authorization string, now time.Time) error {
+	tokenString, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return errors.New("missing token")
+	}
+	s.mu.RLock()
+	secrets := [][]byte{s.current}
+	if s.previous != nil && now.Before(s.previousExpiry) {
+		secrets = append(secrets, s.previous)
+	}
+	s.mu.RUnlock()
+
+	var token *jwt.Token
+	var err error
+	for _, secret := range secrets {
+		token, err = jwt.NewParser(
+			jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+			jwt.WithoutClaimsValidation(),
+		).Parse(tokenString, func(*jwt.Token) (any, error) {
+			return secret, nil
+		})
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid token: %v", err)
+	}
+	iat, ok := token.Claims.(jwt.MapClaims)["iat"].(float64)
+	if !ok {
+		return errors.New("missing iat claim")
+	}
+	if issuedAt := time.Unix(int64(iat), 0); issuedAt.Before(now.Add(-maxTokenAge)) || issuedAt.After(now.Add(maxTokenAge)) {
+		return errors.New("stale token")
+	}
+	return nil
+}
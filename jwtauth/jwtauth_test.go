@@ -0,0 +1,135 @@
+package jwtauth_test
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/polymerdao/monomer/jwtauth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	secret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	require.Len(t, secret, jwtauth.SecretSize)
+	require.NoError(t, jwtauth.WriteSecretFile(path, secret))
+	got, err := jwtauth.ReadSecretFile(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, got)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// op-node also reads secrets without the 0x prefix.
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(secret)), 0o600))
+	got, err = jwtauth.ReadSecretFile(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, got)
+
+	require.NoError(t, os.WriteFile(path, []byte("0xabcd"), 0o600))
+	_, err = jwtauth.ReadSecretFile(path)
+	require.ErrorContains(t, err, "not 32")
+}
+
+func TestHandler(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	oldSecret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	require.NoError(t, jwtauth.WriteSecretFile(path, oldSecret))
+	secrets, err := jwtauth.NewSecrets(&jwtauth.Config{
+		Path:           path,
+		RotationWindow: 200 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	server := httptest.NewServer(secrets.Handler(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}), nil))
+	defer server.Close()
+
+	statusCode := func(secret []byte, iat time.Time) int {
+		return statusCode(t, server.URL, secret, iat)
+	}
+	otherSecret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode(oldSecret, time.Now()))
+	require.Equal(t, http.StatusUnauthorized, statusCode(nil, time.Now()))
+	require.Equal(t, http.StatusUnauthorized, statusCode(otherSecret, time.Now()))
+	require.Equal(t, http.StatusUnauthorized, statusCode(oldSecret, time.Now().Add(-2*time.Minute)))
+
+	changed, err := secrets.Reload()
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	// Both secrets are accepted during the rotation window.
+	newSecret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	require.NoError(t, jwtauth.WriteSecretFile(path, newSecret))
+	changed, err = secrets.Reload()
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, http.StatusOK, statusCode(newSecret, time.Now()))
+	require.Equal(t, http.StatusOK, statusCode(oldSecret, time.Now()))
+
+	time.Sleep(250 * time.Millisecond)
+	require.Equal(t, http.StatusOK, statusCode(newSecret, time.Now()))
+	require.Equal(t, http.StatusUnauthorized, statusCode(oldSecret, time.Now()))
+
+	// An invalid file keeps the current secret.
+	require.NoError(t, os.WriteFile(path, []byte("not hex"), 0o600))
+	_, err = secrets.Reload()
+	require.Error(t, err)
+	require.Equal(t, http.StatusOK, statusCode(newSecret, time.Now()))
+}
+
+func TestHandlerWithoutToken(t *testing.T) {
+	secret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	_, err = jwtauth.NewSecrets(&jwtauth.Config{
+		Secret: secret[:16],
+	})
+	require.ErrorContains(t, err, "not 32")
+	secrets, err := jwtauth.NewSecrets(&jwtauth.Config{
+		Secret: secret,
+	})
+	require.NoError(t, err)
+	changed, err := secrets.Reload()
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	// Requests without a token are served by the unauthenticated handler, which responds with 202 here.
+	server := httptest.NewServer(secrets.Handler(
+		http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}),
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+		}),
+	))
+	defer server.Close()
+	otherSecret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, statusCode(t, server.URL, secret, time.Now()))
+	require.Equal(t, http.StatusAccepted, statusCode(t, server.URL, nil, time.Now()))
+	require.Equal(t, http.StatusUnauthorized, statusCode(t, server.URL, otherSecret, time.Now()))
+}
+
+// statusCode returns the status code of a request to url with a token signed with secret and issued at iat. The request
+// has no token if secret is nil.
+func statusCode(t *testing.T, url string, secret []byte, iat time.Time) int {
+	req, err := http.NewRequest(http.MethodPost, url, http.NoBody) //nolint:noctx
+	require.NoError(t, err)
+	if secret != nil {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"iat": iat.Unix(),
+		}).SignedString(secret)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp.StatusCode
+}
@@ -0,0 +1,68 @@
+// Package keystore holds the operator keys of Monomer's auxiliary services, e.g., the faucet, encrypted at rest.
+//
+// It is a Cosmos SDK keyring restricted to the backends that encrypt keys. Keys may also live on a Ledger, in which
+// case the keystore only holds the public key and signing happens on the device.
+package keystore
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// DefaultBackend stores keys in files encrypted with a passphrase.
+const DefaultBackend = keyring.BackendFile
+
+// Open opens the keystore in dir. The passphrase, if the backend needs one, is read from input, usually os.Stdin.
+func Open(backend, dir string, cdc codec.Codec, input io.Reader) (keyring.Keyring, error) {
+	if err := checkBackend(backend); err != nil {
+		return nil, err
+	}
+	kr, err := keyring.New(sdk.KeyringServiceName(), backend, dir, input, cdc)
+	if err != nil {
+		return nil, fmt.Errorf("new keyring: %v", err)
+	}
+	return kr, nil
+}
+
+func checkBackend(backend string) error {
+	if backend == keyring.BackendTest || backend == keyring.BackendMemory {
+		return fmt.Errorf("the %s keyring backend does not encrypt keys", backend)
+	}
+	return nil
+}
+
+// Command returns the keys command, which manages the keystore in defaultDir.
+// Existing keys are imported with `keys add <name> --recover`, and Ledger keys are added with `keys add <name> --ledger`.
+func Command(defaultDir string, cdc codec.Codec) *cobra.Command {
+	cmd := keys.Commands()
+	backendFlag := cmd.PersistentFlags().Lookup(flags.FlagKeyringBackend)
+	backendFlag.DefValue = DefaultBackend
+	backendFlag.Usage = "keyring backend (os|file|kwallet|pass)"
+	if err := backendFlag.Value.Set(DefaultBackend); err != nil {
+		panic(err) // Setting a string flag never fails.
+	}
+	cmd.PersistentFlags().Lookup(flags.FlagKeyringDir).DefValue = defaultDir
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		backend, err := cmd.Flags().GetString(flags.FlagKeyringBackend)
+		if err != nil {
+			return err
+		}
+		if err := checkBackend(backend); err != nil {
+			return err
+		}
+		return client.SetCmdClientContextHandler(client.Context{}.
+			WithCodec(cdc).
+			WithInput(cmd.InOrStdin()).
+			WithOutput(cmd.OutOrStdout()).
+			WithHomeDir(defaultDir), cmd)
+	}
+	return cmd
+}
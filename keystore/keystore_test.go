@@ -0,0 +1,68 @@
+package keystore_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/polymerdao/monomer/keystore"
+	"github.com/stretchr/testify/require"
+)
+
+func newCodec() codec.Codec {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	return codec.NewProtoCodec(interfaceRegistry)
+}
+
+func TestOpen(t *testing.T) {
+	cdc := newCodec()
+	dir := t.TempDir()
+	privKey := secp256k1.GenPrivKey()
+
+	// The file backend asks for the passphrase twice when it creates the keystore.
+	kr, err := keystore.Open(keystore.DefaultBackend, dir, cdc, strings.NewReader("passphrase\npassphrase\n"))
+	require.NoError(t, err)
+	require.NoError(t, kr.ImportPrivKeyHex("operator", hex.EncodeToString(privKey.Key), string(hd.Secp256k1Type)))
+
+	// The key is encrypted at rest.
+	require.NoError(t, filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		contents, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.False(t, bytes.Contains(contents, privKey.Key), path)
+		require.NotContains(t, string(contents), hex.EncodeToString(privKey.Key), path)
+		return nil
+	}))
+
+	kr, err = keystore.Open(keystore.DefaultBackend, dir, cdc, strings.NewReader("passphrase\n"))
+	require.NoError(t, err)
+	record, err := kr.Key("operator")
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, privKey.PubKey(), pubKey)
+
+	kr, err = keystore.Open(keystore.DefaultBackend, dir, cdc, strings.NewReader("wrong\n"))
+	require.NoError(t, err)
+	_, err = kr.Key("operator")
+	require.Error(t, err)
+}
+
+func TestOpenRejectsUnencryptedBackends(t *testing.T) {
+	for _, backend := range []string{keyring.BackendTest, keyring.BackendMemory} {
+		_, err := keystore.Open(backend, t.TempDir(), newCodec(), strings.NewReader(""))
+		require.ErrorContains(t, err, "does not encrypt", backend)
+	}
+}
@@ -0,0 +1,88 @@
+package l1
+
+import (
+	"time"
+
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "l1"
+
+// Metrics contains metrics collected from the l1 package.
+type Metrics interface {
+	RecordRequest(method string, start time.Time)
+	RecordError(method string)
+	RecordCacheHit(method string)
+	RecordCoalesced(method string)
+}
+
+type metrics struct {
+	// Duration of the requests made to L1.
+	RequestDuration *stdprometheus.HistogramVec
+	// Number of requests to L1 that failed.
+	Errors *stdprometheus.CounterVec
+	// Number of reads served from the cache.
+	CacheHits *stdprometheus.CounterVec
+	// Number of reads that shared a concurrent read's request.
+	Coalesced *stdprometheus.CounterVec
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		RequestDuration: promauto.NewHistogramVec(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of the requests made to L1",
+		}, []string{"method"}),
+		Errors: promauto.NewCounterVec(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "errors",
+			Help:      "Number of requests to L1 that failed",
+		}, []string{"method"}),
+		CacheHits: promauto.NewCounterVec(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "cache_hits",
+			Help:      "Number of L1 reads served from the cache",
+		}, []string{"method"}),
+		Coalesced: promauto.NewCounterVec(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "coalesced",
+			Help:      "Number of L1 reads that shared a concurrent read's request",
+		}, []string{"method"}),
+	}
+}
+
+func (m *metrics) RecordRequest(method string, start time.Time) {
+	m.RequestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func (m *metrics) RecordError(method string) {
+	m.Errors.WithLabelValues(method).Inc()
+}
+
+func (m *metrics) RecordCacheHit(method string) {
+	m.CacheHits.WithLabelValues(method).Inc()
+}
+
+func (m *metrics) RecordCoalesced(method string) {
+	m.Coalesced.WithLabelValues(method).Inc()
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) RecordRequest(_ string, _ time.Time) {}
+
+func (*noopMetrics) RecordError(_ string) {}
+
+func (*noopMetrics) RecordCacheHit(_ string) {}
+
+func (*noopMetrics) RecordCoalesced(_ string) {}
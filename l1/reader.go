@@ -0,0 +1,132 @@
+// Package l1 reads L1 for the features that need it, e.g., pruning coordination and deposit reporting, so they share one
+// client, its cache, and its metrics instead of each dialing and polling the L1 RPC independently.
+package l1
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultTTL is how long responses are cached by default: about one L1 block.
+	DefaultTTL = 12 * time.Second
+	// DefaultCacheSize is the number of responses cached by default.
+	DefaultCacheSize = 1024
+
+	MethodHeaderByNumber     = "header_by_number"
+	MethodTransactionReceipt = "transaction_receipt"
+	MethodCodeAt             = "code_at"
+	MethodCallContract       = "call_contract"
+)
+
+// Client is the part of an L1 client the Reader uses. It is implemented by ethclient.Client.
+type Client interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error)
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+type entry struct {
+	value   any
+	expires time.Time
+}
+
+// Reader reads headers, receipts, and contract state (e.g., the SystemConfig or the L2OutputOracle through their
+// bindings) from L1. Identical requests made at the same time share one L1 request, and responses are cached for the
+// TTL, so a response may be up to one TTL stale. Errors are not cached.
+// Responses are shared, so callers must not modify them.
+type Reader struct {
+	client  Client
+	ttl     time.Duration
+	metrics Metrics
+	group   singleflight.Group
+	cache   *lru.Cache[string, *entry]
+}
+
+var _ bind.ContractCaller = (*Reader)(nil)
+
+// NewReader creates a Reader that caches up to cacheSize responses for ttl.
+func NewReader(client Client, ttl time.Duration, cacheSize int, metrics Metrics) *Reader {
+	return &Reader{
+		client:  client,
+		ttl:     ttl,
+		metrics: metrics,
+		cache:   lru.NewCache[string, *entry](cacheSize),
+	}
+}
+
+func (r *Reader) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return read(r, MethodHeaderByNumber, fmt.Sprint(number), func() (*types.Header, error) {
+		return r.client.HeaderByNumber(ctx, number)
+	})
+}
+
+func (r *Reader) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return read(r, MethodTransactionReceipt, txHash.String(), func() (*types.Receipt, error) {
+		return r.client.TransactionReceipt(ctx, txHash)
+	})
+}
+
+func (r *Reader) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return read(r, MethodCodeAt, fmt.Sprintf("%s/%v", contract, blockNumber), func() ([]byte, error) {
+		return r.client.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+func (r *Reader) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	// Calls from contract bindings only set To and Data.
+	if call.From != (common.Address{}) || call.Gas != 0 || call.GasPrice != nil || call.Value != nil {
+		defer r.metrics.RecordRequest(MethodCallContract, time.Now())
+		result, err := r.client.CallContract(ctx, call, blockNumber)
+		if err != nil {
+			r.metrics.RecordError(MethodCallContract)
+		}
+		return result, err
+	}
+	return read(r, MethodCallContract, fmt.Sprintf("%v/%x/%v", call.To, call.Data, blockNumber), func() ([]byte, error) {
+		return r.client.CallContract(ctx, call, blockNumber)
+	})
+}
+
+// read returns the cached response for the method and key, or calls fetch, sharing the call with concurrent reads of
+// the same method and key.
+func read[T any](r *Reader, method, key string, fetch func() (T, error)) (T, error) {
+	key = method + "/" + key
+	if cached, ok := r.cache.Get(key); ok && time.Now().Before(cached.expires) {
+		r.metrics.RecordCacheHit(method)
+		return cached.value.(T), nil
+	}
+	var fetched bool
+	value, err, _ := r.group.Do(key, func() (any, error) {
+		fetched = true
+		defer r.metrics.RecordRequest(method, time.Now())
+		value, err := fetch()
+		if err != nil {
+			r.metrics.RecordError(method)
+			return nil, err
+		}
+		r.cache.Add(key, &entry{
+			value:   value,
+			expires: time.Now().Add(r.ttl),
+		})
+		return value, nil
+	})
+	if !fetched {
+		r.metrics.RecordCoalesced(method)
+	}
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return value.(T), nil
+}
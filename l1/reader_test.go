@@ -0,0 +1,99 @@
+package l1_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer/l1"
+	"github.com/stretchr/testify/require"
+)
+
+type client struct {
+	calls int
+	err   error
+}
+
+func (c *client) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	c.calls++
+	return &types.Header{Number: number, Time: uint64(c.calls)}, c.err
+}
+
+func (c *client) TransactionReceipt(context.Context, common.Hash) (*types.Receipt, error) {
+	c.calls++
+	return &types.Receipt{}, c.err
+}
+
+func (c *client) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	c.calls++
+	return []byte{1}, c.err
+}
+
+func (c *client) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	c.calls++
+	return call.Data, c.err
+}
+
+func TestReaderCaches(t *testing.T) {
+	ctx := context.Background()
+	c := &client{}
+	reader := l1.NewReader(c, l1.DefaultTTL, l1.DefaultCacheSize, l1.NewNoopMetrics())
+
+	header, err := reader.HeaderByNumber(ctx, big.NewInt(1))
+	require.NoError(t, err)
+	cachedHeader, err := reader.HeaderByNumber(ctx, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, header, cachedHeader)
+	require.Equal(t, 1, c.calls)
+
+	// Requests with different arguments are cached separately.
+	_, err = reader.HeaderByNumber(ctx, big.NewInt(2))
+	require.NoError(t, err)
+	require.Equal(t, 2, c.calls)
+
+	to := common.Address{1}
+	for range 2 {
+		result, err := reader.CallContract(ctx, ethereum.CallMsg{To: &to, Data: []byte{2}}, nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte{2}, result)
+	}
+	require.Equal(t, 3, c.calls)
+
+	// Calls that aren't plain reads aren't cached.
+	for range 2 {
+		_, err := reader.CallContract(ctx, ethereum.CallMsg{From: common.Address{2}, To: &to, Data: []byte{2}}, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 5, c.calls)
+}
+
+func TestReaderExpires(t *testing.T) {
+	ctx := context.Background()
+	c := &client{}
+	reader := l1.NewReader(c, 0, l1.DefaultCacheSize, l1.NewNoopMetrics())
+
+	for range 2 {
+		_, err := reader.TransactionReceipt(ctx, common.Hash{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, c.calls)
+}
+
+func TestReaderDoesNotCacheErrors(t *testing.T) {
+	ctx := context.Background()
+	c := &client{err: errors.New("unavailable")}
+	reader := l1.NewReader(c, l1.DefaultTTL, l1.DefaultCacheSize, l1.NewNoopMetrics())
+
+	_, err := reader.CodeAt(ctx, common.Address{}, nil)
+	require.Error(t, err)
+
+	c.err = nil
+	code, err := reader.CodeAt(ctx, common.Address{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, code)
+	require.Equal(t, 2, c.calls)
+}
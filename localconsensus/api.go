@@ -0,0 +1,229 @@
+package localconsensus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/utils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+)
+
+// Quantity is a JSON-RPC quantity. Besides hex strings, it accepts JSON numbers, which tools written for anvil and
+// Hardhat often send to the evm namespace.
+type Quantity uint64
+
+func (q *Quantity) UnmarshalJSON(data []byte) error {
+	var number uint64
+	if err := json.Unmarshal(data, &number); err == nil {
+		*q = Quantity(number)
+		return nil
+	}
+	var hex hexutil.Uint64
+	if err := hex.UnmarshalJSON(data); err != nil {
+		return fmt.Errorf("unmarshal quantity: %v", err)
+	}
+	*q = Quantity(hex)
+	return nil
+}
+
+// EVMAPI is the evm namespace, which controls the chain's clock and blocks like anvil's and Hardhat's namespaces of the
+// same name, so app logic can be tested against a local chain.
+type EVMAPI struct {
+	sequencer *Sequencer
+}
+
+func NewEVMAPI(sequencer *Sequencer) *EVMAPI {
+	return &EVMAPI{
+		sequencer: sequencer,
+	}
+}
+
+// SetNextBlockTimestamp sets the timestamp of the next block, in seconds.
+func (e *EVMAPI) SetNextBlockTimestamp(timestamp Quantity) error {
+	return e.sequencer.SetNextBlockTimestamp(uint64(timestamp))
+}
+
+// IncreaseTime moves the chain's clock forward and returns the total number of seconds it was moved forward.
+func (e *EVMAPI) IncreaseTime(seconds Quantity) (hexutil.Uint64, error) {
+	offset, err := e.sequencer.IncreaseTime(uint64(seconds))
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(offset), nil
+}
+
+// Mine builds a block, timestamped with timestamp if it is set. It returns 0 like Hardhat.
+func (e *EVMAPI) Mine(ctx context.Context, timestamp *Quantity) (string, error) {
+	if timestamp != nil {
+		if err := e.sequencer.SetNextBlockTimestamp(uint64(*timestamp)); err != nil {
+			return "", err
+		}
+	}
+	if _, err := e.sequencer.Mine(ctx, 1, 0); err != nil {
+		return "", err
+	}
+	return "0x0", nil
+}
+
+// Snapshot returns an ID to revert the chain to its current state with Revert.
+func (e *EVMAPI) Snapshot() (hexutil.Uint64, error) {
+	id, err := e.sequencer.Snapshot()
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(id), nil
+}
+
+// Revert reverts the chain to the snapshot with the ID. It returns false if there is no such snapshot.
+func (e *EVMAPI) Revert(ctx context.Context, id Quantity) (bool, error) {
+	return e.sequencer.Revert(ctx, uint64(id))
+}
+
+type App interface {
+	Query(context.Context, *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error)
+}
+
+// errImpersonation is returned by the impersonation methods, since the app verifies the signatures of Cosmos txs itself.
+var errImpersonation = errors.New("impersonation is not supported: the app verifies the signatures of cosmos txs")
+
+// AnvilAPI is the anvil namespace, which has the parts of anvil's namespace of the same name that apply to Monomer.
+type AnvilAPI struct {
+	sequencer *Sequencer
+	app       App
+	chainID   *big.Int
+}
+
+func NewAnvilAPI(sequencer *Sequencer, app App, chainID *big.Int) *AnvilAPI {
+	return &AnvilAPI{
+		sequencer: sequencer,
+		app:       app,
+		chainID:   chainID,
+	}
+}
+
+// Mine builds blocks blocks, one if it isn't set. If interval is set, the blocks are timestamped interval seconds apart.
+func (a *AnvilAPI) Mine(ctx context.Context, blocks, interval *Quantity) error {
+	n := uint64(1)
+	if blocks != nil {
+		n = uint64(*blocks)
+	}
+	var seconds uint64
+	if interval != nil {
+		seconds = uint64(*interval)
+	}
+	_, err := a.sequencer.Mine(ctx, n, seconds)
+	return err
+}
+
+// SetBalance sets the ETH balance of the address's Cosmos account, the account L1 deposits from the address are minted
+// to. The difference is minted with a deposit in a new block, so the balance can only be increased.
+func (a *AnvilAPI) SetBalance(ctx context.Context, address common.Address, balance *hexutil.Big) error {
+	current, err := a.balance(ctx, address)
+	if err != nil {
+		return err
+	}
+	mint := new(big.Int).Sub(balance.ToInt(), current)
+	if mint.Sign() < 0 {
+		return fmt.Errorf("balance can only be increased, %s has %s", address, current)
+	} else if mint.Sign() == 0 {
+		return nil
+	}
+
+	// x/rollup only accepts deposits after an L1 attributes tx. Its L1 block info doesn't matter without L1.
+	l1InfoTx, err := derive.L1InfoDeposit(&rollup.Config{
+		L2ChainID: a.chainID,
+	}, eth.SystemConfig{}, 0, eth.HeaderBlockInfo(&ethtypes.Header{
+		Number:     new(big.Int),
+		Difficulty: new(big.Int),
+		BaseFee:    new(big.Int),
+	}), 0)
+	if err != nil {
+		return fmt.Errorf("new l1 attributes tx: %v", err)
+	}
+	head, err := a.sequencer.blockStore.HeadHeader()
+	if err != nil {
+		return fmt.Errorf("get head header: %v", err)
+	}
+	depositTx := &ethtypes.DepositTx{
+		// The source hash makes the deposit's hash unique.
+		SourceHash: crypto.Keccak256Hash(address.Bytes(), current.Bytes(), head.Hash.Bytes()),
+		From:       address,
+		To:         &address,
+		Mint:       mint,
+		Value:      new(big.Int),
+	}
+	txs := make([]hexutil.Bytes, 0, 2)
+	for _, tx := range []*ethtypes.DepositTx{l1InfoTx, depositTx} {
+		txBytes, err := ethtypes.NewTx(tx).MarshalBinary()
+		if err != nil {
+			return fmt.Errorf("marshal deposit tx: %v", err)
+		}
+		txs = append(txs, txBytes)
+	}
+	cosmosTxs, err := monomer.AdaptPayloadTxsToCosmosTxs(txs, nil, "")
+	if err != nil {
+		return fmt.Errorf("adapt deposit txs: %v", err)
+	}
+	if _, err := a.sequencer.BuildBlockWithTxs(ctx, cosmosTxs); err != nil {
+		return err
+	}
+
+	// The block is built even if the deposit fails, e.g., because the app doesn't have x/rollup.
+	if current, err = a.balance(ctx, address); err != nil {
+		return err
+	}
+	if current.Cmp(balance.ToInt()) < 0 {
+		return fmt.Errorf("deposit failed, %s has %s", address, current)
+	}
+	return nil
+}
+
+// balance returns the ETH balance of the address's Cosmos account.
+func (a *AnvilAPI) balance(ctx context.Context, address common.Address) (*big.Int, error) {
+	request, err := (&banktypes.QueryBalanceRequest{
+		Address: utils.EvmToCosmosAddress(address).String(),
+		Denom:   rolluptypes.ETH,
+	}).Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal balance request: %v", err)
+	}
+	resp, err := a.app.Query(ctx, &abcitypes.RequestQuery{
+		Path: "/cosmos.bank.v1beta1.Query/Balance",
+		Data: request,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query balance: %v", err)
+	}
+	if !resp.IsOK() {
+		return nil, fmt.Errorf("query balance: %s", resp.GetLog())
+	}
+	var balance banktypes.QueryBalanceResponse
+	if err := balance.Unmarshal(resp.GetValue()); err != nil {
+		return nil, fmt.Errorf("unmarshal balance response: %v", err)
+	}
+	return balance.GetBalance().Amount.BigInt(), nil
+}
+
+// ImpersonateAccount always fails. Monomer can't send txs from an account without its key, since the app, not the node,
+// verifies the signatures of Cosmos txs. Fund test accounts with SetBalance and sign with their keys instead.
+func (a *AnvilAPI) ImpersonateAccount(common.Address) error {
+	return errImpersonation
+}
+
+// StopImpersonatingAccount always fails, like ImpersonateAccount.
+func (a *AnvilAPI) StopImpersonatingAccount(common.Address) error {
+	return errImpersonation
+}
@@ -0,0 +1,232 @@
+// Package localconsensus drives block production from a timer inside the node instead of op-node's forkchoice updates,
+// so app developers can run a Monomer chain offline, without an OP stack, using the same RPC and app code as a rollup.
+package localconsensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/builder"
+)
+
+type Builder interface {
+	Build(ctx context.Context, payload *builder.Payload) (*monomer.Block, error)
+	Rollback(ctx context.Context, unsafe, safe, finalized common.Hash) error
+}
+
+type DB interface {
+	HeadHeader() (*monomer.Header, error)
+	UpdateLabels(unsafe, safe, finalized common.Hash) error
+}
+
+// Sequencer builds a block every block time. There is no L1 to wait for, so every block is final as soon as it is built.
+type Sequencer struct {
+	builder    Builder
+	blockStore DB
+	blockTime  time.Duration
+	// timeStep is the number of seconds between the timestamps of consecutive blocks. If it is zero, blocks are
+	// timestamped with the wall clock.
+	timeStep uint64
+
+	// mu serializes building blocks, since the builder must only be called from one goroutine at a time. It also guards
+	// the fields below.
+	mu sync.Mutex
+	// offset is the number of seconds the chain's clock was moved forward with IncreaseTime.
+	offset uint64
+	// appliedOffset is the part of offset already in the head's timestamp.
+	appliedOffset uint64
+	// nextTimestamp is the timestamp of the next block, if it was set with SetNextBlockTimestamp.
+	nextTimestamp uint64
+	// snapshots are indexed by snapshot ID.
+	snapshots []snapshot
+}
+
+// snapshot is the chain when Snapshot was called.
+type snapshot struct {
+	head          common.Hash
+	offset        uint64
+	appliedOffset uint64
+}
+
+// NewSequencer returns a sequencer that timestamps blocks timeStep seconds apart, or with the wall clock if
+// timeStep is zero.
+func NewSequencer(b Builder, blockStore DB, blockTime time.Duration, timeStep uint64) *Sequencer {
+	return &Sequencer{
+		builder:    b,
+		blockStore: blockStore,
+		blockTime:  blockTime,
+		timeStep:   timeStep,
+	}
+}
+
+// Run builds a block every block time until ctx is done. It returns an error if a block can't be built, since the
+// builder's state is unknown after a failed build.
+func (s *Sequencer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.blockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := s.BuildBlock(ctx); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+		}
+	}
+}
+
+// BuildBlock builds a block with the txs in the mempool on top of the head and makes it the head, safe, and finalized
+// block.
+func (s *Sequencer) BuildBlock(ctx context.Context) (*monomer.Block, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.buildBlock(ctx, nil)
+}
+
+// BuildBlockWithTxs is like BuildBlock, but the block starts with txs, e.g., deposits.
+func (s *Sequencer) BuildBlockWithTxs(ctx context.Context, txs bfttypes.Txs) (*monomer.Block, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.buildBlock(ctx, txs)
+}
+
+// Mine builds blocks blocks. If interval is positive, the blocks are timestamped interval seconds apart. It returns
+// the last block.
+func (s *Sequencer) Mine(ctx context.Context, blocks, interval uint64) (*monomer.Block, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var block *monomer.Block
+	for range blocks {
+		if interval > 0 {
+			head, err := s.blockStore.HeadHeader()
+			if err != nil {
+				return nil, fmt.Errorf("get head header: %v", err)
+			}
+			s.nextTimestamp = head.Time + interval
+		}
+		var err error
+		if block, err = s.buildBlock(ctx, nil); err != nil {
+			return nil, err
+		}
+	}
+	return block, nil
+}
+
+// buildBlock builds a block starting with injectedTxs. The caller must hold mu.
+func (s *Sequencer) buildBlock(ctx context.Context, injectedTxs bfttypes.Txs) (*monomer.Block, error) {
+	head, err := s.blockStore.HeadHeader()
+	if err != nil {
+		return nil, fmt.Errorf("get head header: %v", err)
+	}
+	block, err := s.builder.Build(ctx, &builder.Payload{
+		InjectedTransactions: injectedTxs,
+		GasLimit:             head.GasLimit,
+		Timestamp:            s.timestamp(head),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("build block %d: %v", head.Height+1, err)
+	}
+	s.nextTimestamp = 0
+	s.appliedOffset = s.offset
+	hash := block.Header.Hash
+	if err := s.blockStore.UpdateLabels(hash, hash, hash); err != nil {
+		return nil, fmt.Errorf("update labels: %v", err)
+	}
+	return block, nil
+}
+
+// timestamp returns the timestamp of the block after head. The caller must hold mu.
+func (s *Sequencer) timestamp(head *monomer.Header) uint64 {
+	if s.nextTimestamp != 0 {
+		return s.nextTimestamp
+	}
+	var timestamp uint64
+	if s.timeStep > 0 {
+		timestamp = head.Time + s.timeStep + s.offset - s.appliedOffset
+	} else {
+		timestamp = uint64(time.Now().Unix()) + s.offset
+	}
+	// Timestamps are in seconds and must increase, so a block built within a second of its parent is timestamped a
+	// second after it.
+	return max(timestamp, head.Time+1)
+}
+
+// SetNextBlockTimestamp sets the timestamp of the next block. It must be after the head's timestamp. Later blocks are
+// timestamped as usual.
+func (s *Sequencer) SetNextBlockTimestamp(timestamp uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	head, err := s.blockStore.HeadHeader()
+	if err != nil {
+		return fmt.Errorf("get head header: %v", err)
+	}
+	if timestamp <= head.Time {
+		return fmt.Errorf("timestamp %d is not after the head's timestamp %d", timestamp, head.Time)
+	}
+	s.nextTimestamp = timestamp
+	return nil
+}
+
+// Snapshot records the head and returns an ID to revert to it with Revert.
+func (s *Sequencer) Snapshot() (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	head, err := s.blockStore.HeadHeader()
+	if err != nil {
+		return 0, fmt.Errorf("get head header: %v", err)
+	}
+	s.snapshots = append(s.snapshots, snapshot{
+		head:          head.Hash,
+		offset:        s.offset,
+		appliedOffset: s.appliedOffset,
+	})
+	return uint64(len(s.snapshots) - 1), nil
+}
+
+// Revert rolls the chain and its clock back to when the snapshot with the ID was taken. The snapshot and later ones
+// can't be reverted to again. Txs in the reverted blocks are dropped. It returns false if there is no such snapshot.
+func (s *Sequencer) Revert(ctx context.Context, id uint64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id >= uint64(len(s.snapshots)) {
+		return false, nil
+	}
+	snap := s.snapshots[id]
+	if err := s.builder.Rollback(ctx, snap.head, snap.head, snap.head); err != nil {
+		return false, fmt.Errorf("roll back to snapshot %d: %v", id, err)
+	}
+	s.snapshots = s.snapshots[:id]
+	s.nextTimestamp = 0
+	s.offset = snap.offset
+	s.appliedOffset = snap.appliedOffset
+	return true, nil
+}
+
+// IncreaseTime moves the chain's clock forward, so the next block is timestamped seconds later than it would have been.
+// It returns the total number of seconds the clock was moved forward.
+func (s *Sequencer) IncreaseTime(seconds uint64) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.offset+seconds < s.offset {
+		return 0, errors.New("time offset overflows")
+	}
+	s.offset += seconds
+	return s.offset, nil
+}
@@ -0,0 +1,205 @@
+package localconsensus_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/builder"
+	"github.com/polymerdao/monomer/localconsensus"
+	"github.com/stretchr/testify/require"
+)
+
+// chain is a Builder and DB that appends a block for every payload.
+type chain struct {
+	headers  []*monomer.Header
+	payloads []*builder.Payload
+	labels   [3]common.Hash
+	err      error
+}
+
+func (c *chain) Build(_ context.Context, payload *builder.Payload) (*monomer.Block, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.payloads = append(c.payloads, payload)
+	head := c.headers[len(c.headers)-1]
+	block, err := monomer.MakeBlock(&monomer.Header{
+		Height:     head.Height + 1,
+		Time:       payload.Timestamp,
+		GasLimit:   payload.GasLimit,
+		ParentHash: head.Hash,
+	}, bfttypes.Txs{})
+	if err != nil {
+		return nil, err
+	}
+	c.headers = append(c.headers, block.Header)
+	return block, nil
+}
+
+func (c *chain) Rollback(_ context.Context, unsafe, safe, finalized common.Hash) error {
+	for i, header := range c.headers {
+		if header.Hash == unsafe {
+			c.headers = c.headers[:i+1]
+			c.labels = [3]common.Hash{unsafe, safe, finalized}
+			return nil
+		}
+	}
+	return errors.New("not found")
+}
+
+func (c *chain) HeadHeader() (*monomer.Header, error) {
+	return c.headers[len(c.headers)-1], nil
+}
+
+func (c *chain) UpdateLabels(unsafe, safe, finalized common.Hash) error {
+	c.labels = [3]common.Hash{unsafe, safe, finalized}
+	return nil
+}
+
+func TestBuildBlock(t *testing.T) {
+	// The genesis is in the future, like a block built within the last second.
+	genesisTime := uint64(time.Now().Add(time.Hour).Unix())
+	c := &chain{
+		headers: []*monomer.Header{{
+			Height:   1,
+			Time:     genesisTime,
+			GasLimit: 30_000_000,
+		}},
+	}
+	sequencer := localconsensus.NewSequencer(c, c, time.Hour, 0)
+
+	block, err := sequencer.BuildBlock(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), block.Header.Height)
+	require.Equal(t, []*builder.Payload{{
+		GasLimit:  30_000_000,
+		Timestamp: genesisTime + 1,
+	}}, c.payloads)
+	// Without L1, blocks are final as soon as they are built.
+	hash := block.Header.Hash
+	require.Equal(t, [3]common.Hash{hash, hash, hash}, c.labels)
+}
+
+func TestTimestamps(t *testing.T) {
+	c := &chain{
+		headers: []*monomer.Header{{
+			Height: 1,
+			Time:   100,
+		}},
+	}
+	sequencer := localconsensus.NewSequencer(c, c, time.Hour, 12)
+	buildBlock := func() uint64 {
+		block, err := sequencer.BuildBlock(context.Background())
+		require.NoError(t, err)
+		return block.Header.Time
+	}
+
+	require.Equal(t, uint64(112), buildBlock())
+
+	offset, err := sequencer.IncreaseTime(30)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), offset)
+	offset, err = sequencer.IncreaseTime(30)
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), offset)
+	require.Equal(t, uint64(184), buildBlock())
+	// The clock only moves forward once.
+	require.Equal(t, uint64(196), buildBlock())
+
+	require.Error(t, sequencer.SetNextBlockTimestamp(196))
+	require.NoError(t, sequencer.SetNextBlockTimestamp(1000))
+	require.Equal(t, uint64(1000), buildBlock())
+	require.Equal(t, uint64(1012), buildBlock())
+}
+
+func TestMine(t *testing.T) {
+	c := &chain{
+		headers: []*monomer.Header{{
+			Height: 1,
+			Time:   100,
+		}},
+	}
+	sequencer := localconsensus.NewSequencer(c, c, time.Hour, 12)
+
+	block, err := sequencer.Mine(context.Background(), 3, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), block.Header.Height)
+	require.Equal(t, uint64(136), block.Header.Time)
+
+	block, err = sequencer.Mine(context.Background(), 2, 60)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), block.Header.Height)
+	require.Equal(t, uint64(256), block.Header.Time)
+}
+
+func TestSnapshot(t *testing.T) {
+	c := &chain{
+		headers: []*monomer.Header{{
+			Height: 1,
+			Time:   100,
+		}},
+	}
+	sequencer := localconsensus.NewSequencer(c, c, time.Hour, 12)
+	ctx := context.Background()
+
+	_, err := sequencer.Mine(ctx, 1, 0)
+	require.NoError(t, err)
+	first, err := sequencer.Snapshot()
+	require.NoError(t, err)
+	snapshotHead := c.headers[len(c.headers)-1]
+	_, err = sequencer.IncreaseTime(1000)
+	require.NoError(t, err)
+	_, err = sequencer.Mine(ctx, 2, 0)
+	require.NoError(t, err)
+	second, err := sequencer.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, first+1, second)
+	_, err = sequencer.Mine(ctx, 1, 0)
+	require.NoError(t, err)
+
+	ok, err := sequencer.Revert(ctx, first)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, snapshotHead, c.headers[len(c.headers)-1])
+	// The clock is reverted too.
+	block, err := sequencer.BuildBlock(ctx)
+	require.NoError(t, err)
+	require.Equal(t, snapshotHead.Time+12, block.Header.Time)
+
+	// Later snapshots are gone.
+	ok, err = sequencer.Revert(ctx, second)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestQuantity(t *testing.T) {
+	for _, data := range []string{`"0x3c"`, `60`} {
+		var q localconsensus.Quantity
+		require.NoError(t, json.Unmarshal([]byte(data), &q))
+		require.Equal(t, localconsensus.Quantity(60), q)
+	}
+	var q localconsensus.Quantity
+	require.Error(t, json.Unmarshal([]byte(`"60"`), &q))
+}
+
+func TestRun(t *testing.T) {
+	c := &chain{
+		headers: []*monomer.Header{{
+			Height: 1,
+		}},
+	}
+	sequencer := localconsensus.NewSequencer(c, c, time.Millisecond, 0)
+
+	c.err = errors.New("build failed")
+	require.ErrorContains(t, sequencer.Run(context.Background()), "build failed")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, sequencer.Run(ctx))
+}
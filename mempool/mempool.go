@@ -1,10 +1,14 @@
 package mempool
 
 import (
+	"cmp"
+	"container/heap"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
 
 	comettypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
@@ -13,178 +17,711 @@ import (
 )
 
 const (
-	poolLengthKey = "poolLength"
-	headKey       = "headKey"
-	tailKey       = "tailKey"
+	elemKeyPrefix = "elem/"
+	nextIDKey     = "nextID"
+
+	// The keys of the linked list the pool was stored in before it was ordered by fee. It is migrated on the first use
+	// of the pool.
+	legacyPoolLengthKey = "poolLength"
+	legacyHeadKey       = "headKey"
+	legacyTailKey       = "tailKey"
+
+	// DefaultMaxSize is the number of elements the pool holds by default.
+	DefaultMaxSize = 10_000
+	// PriceBump is the percentage by which a tx's gas price must exceed the gas price of the tx it replaces.
+	PriceBump = 10
+)
+
+var (
+	// ErrAlreadyKnown is returned for txs the pool already holds.
+	ErrAlreadyKnown = errors.New("tx already in the mempool")
+	// ErrUnderpriced is returned for txs that would replace a tx with the same sender and sequence, but don't pay
+	// PriceBump percent more per unit of gas.
+	ErrUnderpriced = errors.New("replacement tx underpriced")
+	// ErrFull is returned for txs that don't pay more per unit of gas than any tx that can be evicted from the full pool.
+	ErrFull = errors.New("mempool is full")
 )
 
-// Pool stores the transactions in a linked list for its inherent FCFS behavior
+// Batch is an ordered list of transactions that are included contiguously in the same block.
+type Batch struct {
+	Txs comettypes.Txs `json:"txs"`
+	// Atomic batches are only included if all of their transactions succeed.
+	Atomic bool `json:"atomic"`
+}
+
+// storageElem is an element of the pool as it is stored.
 type storageElem struct {
-	Txn      comettypes.Tx `json:"txn"`
-	NextHash []byte        `json:"nextHash"`
+	Txn comettypes.Tx `json:"txn"`
+	// Batch is set instead of Txn if the element is a batch.
+	Batch *Batch `json:"batch,omitempty"`
+	// Infos describe Txn or the txs in Batch, in order.
+	Infos []*TxInfo `json:"infos"`
+}
+
+// elem is an element of the pool with its place in the pool's order.
+type elem struct {
+	*storageElem
+	// id orders elements with the same gas price by when they were enqueued.
+	id uint64
+	// info orders the element. A batch has no sender and pays the fees of all of its txs for their total gas.
+	info *TxInfo
+	// index is the element's index in the ready heap, or -1 if it waits for a tx with a lower sequence from its sender.
+	index int
+}
+
+func (e *elem) txs() comettypes.Txs {
+	if e.Batch != nil {
+		return e.Batch.Txs
+	}
+	return comettypes.Txs{e.Txn}
 }
 
+// setInfo sets the info that orders the element from the infos of its txs.
+func (e *elem) setInfo() {
+	if e.Batch != nil {
+		e.info = batchInfo(e.Infos)
+	} else {
+		e.info = e.Infos[0]
+	}
+}
+
+// Pool orders txs by the gas price they pay, highest first, and by when they were enqueued if they pay the same. A
+// sender's txs are dequeued in the order of their sequences, each when it pays more than the other txs that are ready.
+// Batches have no sender, so the txs in them aren't ordered with the sender's other txs.
+//
+// The pool is kept in memory and stored in its database, from which it is loaded on first use.
 type Pool struct {
 	db dbm.DB
+	// rejectionsMu serializes rejections, which read and update the rejection seqs.
+	rejectionsMu  sync.Mutex
+	maxRejections uint64
+
+	// mu guards the fields below, which are set before the pool is loaded.
+	mu      sync.Mutex
+	txInfo  TxInfoFunc
+	maxSize uint64
+	loaded  bool
+	nextID  uint64
+	elems   map[uint64]*elem
+	// byHash indexes the elements by the hashes of their txs.
+	byHash map[string]*elem
+	// senders are the txs enqueued on their own by each sender, ordered by sequence.
+	senders map[string][]*elem
+	// ready holds the elements that can be dequeued next: every sender's first tx and the elements without a sender.
+	ready readyHeap
+
+	subsMu sync.Mutex
+	subs   map[chan comettypes.Tx]struct{}
 }
 
 func New(db dbm.DB) *Pool {
 	return &Pool{
-		db: db,
+		db:            db,
+		maxRejections: DefaultMaxRejections,
+		maxSize:       DefaultMaxSize,
+		elems:         make(map[uint64]*elem),
+		byHash:        make(map[string]*elem),
+		senders:       make(map[string][]*elem),
+		subs:          make(map[chan comettypes.Tx]struct{}),
 	}
 }
 
-func (p *Pool) Enqueue(userTxn comettypes.Tx) (err error) {
-	// NOTE: we should do reads and writes on the same view. Right now they occur on separate views.
-	// Unfortunately, comet's DB interface doesn't support it.
-	// Moving to a different DB interface is left for future work.
+// SetTxInfoFunc sets how the pool learns the sender, sequence, and fee of the txs enqueued after it is called.
+// Without it, txs have no sender and pay no fee, so they are dequeued in the order they were enqueued.
+func (p *Pool) SetTxInfoFunc(txInfo TxInfoFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.txInfo = txInfo
+}
 
+// SetMaxSize sets the number of elements the pool holds. A batch counts as one element. Once the pool is full, a new
+// element evicts the element with the lowest gas price, as long as it pays more. Zero restores DefaultMaxSize.
+func (p *Pool) SetMaxSize(maxSize uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if maxSize == 0 {
+		maxSize = DefaultMaxSize
+	}
+	p.maxSize = maxSize
+}
+
+// subscriptionBuffer is the number of txs a subscriber can fall behind before it's dropped.
+const subscriptionBuffer = 256
+
+// Subscribe returns a channel that receives the txs added to the pool after it is called, e.g., to notify clients of
+// pending txs. The channel is closed when the subscriber falls behind, rather than holding up the pool. The returned
+// function unsubscribes.
+func (p *Pool) Subscribe() (<-chan comettypes.Tx, func()) {
+	p.subsMu.Lock()
+	defer p.subsMu.Unlock()
+	ch := make(chan comettypes.Tx, subscriptionBuffer)
+	p.subs[ch] = struct{}{}
+	return ch, func() {
+		p.subsMu.Lock()
+		defer p.subsMu.Unlock()
+		if _, ok := p.subs[ch]; ok {
+			delete(p.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+func (p *Pool) publish(txs ...comettypes.Tx) {
+	p.subsMu.Lock()
+	defer p.subsMu.Unlock()
+	for ch := range p.subs {
+		// Only publish sends on the channels, so they can't fill up after the check.
+		if cap(ch)-len(ch) < len(txs) {
+			delete(p.subs, ch)
+			close(ch)
+			continue
+		}
+		for _, tx := range txs {
+			ch <- tx
+		}
+	}
+}
+
+// Enqueue adds userTxn to the pool. If the pool holds a tx with the same sender and sequence, userTxn replaces it if it
+// pays PriceBump percent more per unit of gas, and the replaced tx is rejected with ReasonReplaced.
+func (p *Pool) Enqueue(userTxn comettypes.Tx) error {
+	if err := checkNotDeposit(userTxn); err != nil {
+		return err
+	}
+	if err := p.enqueue(&storageElem{
+		Txn: userTxn,
+	}); err != nil {
+		return err
+	}
+	p.publish(userTxn)
+	return nil
+}
+
+// EnqueueBatch adds the transactions in userBatch to the pool as a single element, so they are dequeued together.
+// The batch is ordered by the fees of its txs per unit of their total gas. It never replaces other txs.
+func (p *Pool) EnqueueBatch(userBatch *Batch) error {
+	if len(userBatch.Txs) == 0 {
+		return errors.New("empty batch")
+	}
+	for _, userTxn := range userBatch.Txs {
+		if err := checkNotDeposit(userTxn); err != nil {
+			return err
+		}
+	}
+	if err := p.enqueue(&storageElem{
+		Batch: userBatch,
+	}); err != nil {
+		return err
+	}
+	p.publish(userBatch.Txs...)
+	return nil
+}
+
+func checkNotDeposit(userTxn comettypes.Tx) error {
 	// Attempt to adapt the Cosmos transaction to an Ethereum deposit transaction.
 	// If the adaptation succeeds, it indicates that the
 	// user transaction is a deposit transaction, which is not allowed in the pool.
 	if _, err := monomer.GetDepositTxs([][]byte{userTxn}); err == nil {
 		return errors.New("deposit txs are not allowed in the pool")
 	}
+	return nil
+}
 
-	batch := p.db.NewBatch()
-	defer func() {
-		err = utils.WrapCloseErr(err, batch)
-	}()
-
-	tail, err := p.db.Get([]byte(tailKey))
+func (p *Pool) enqueue(stored *storageElem) error {
+	p.mu.Lock()
+	rejections, err := p.enqueueLocked(stored)
+	p.mu.Unlock()
 	if err != nil {
 		return err
 	}
-
-	if err = p.putElem(batch, userTxn.Hash(), &storageElem{
-		Txn: userTxn,
-	}); err != nil {
-		return nil
+	for _, rejection := range rejections {
+		if err := p.Reject(rejection); err != nil {
+			return fmt.Errorf("record rejection: %v", err)
+		}
 	}
+	return nil
+}
 
-	if tail != nil {
-		var oldTail *storageElem
-		oldTail, err = p.elem(tail)
+// enqueueLocked adds the element to the pool and returns the rejections of the elements it replaced or evicted.
+func (p *Pool) enqueueLocked(stored *storageElem) (_ []*Rejection, err error) {
+	if err = p.load(); err != nil {
+		return nil, err
+	}
+	txs := (&elem{storageElem: stored}).txs()
+	for _, tx := range txs {
+		if _, ok := p.byHash[string(tx.Hash())]; ok {
+			return nil, ErrAlreadyKnown
+		}
+		info, err := p.describe(tx)
 		if err != nil {
-			return fmt.Errorf("get tail: %v", err)
+			return nil, err
 		}
+		stored.Infos = append(stored.Infos, info)
+	}
+	e := &elem{
+		storageElem: stored,
+		id:          p.nextID,
+		index:       -1,
+	}
+	e.setInfo()
 
-		// update old tail to point to the new item
-		oldTail.NextHash = userTxn.Hash()
-		if err = p.putElem(batch, tail, oldTail); err != nil {
-			return err
+	var removed *elem
+	var rejection *Rejection
+	if replaced := p.sameSequence(e); replaced != nil {
+		if !e.info.bumps(replaced.info) {
+			return nil, ErrUnderpriced
 		}
-	} else {
-		// empty list, make new item both the head and the tail
-		if err = batch.Set([]byte(headKey), userTxn.Hash()); err != nil {
-			return err
+		removed = replaced
+		rejection = &Rejection{
+			Reason:    ReasonReplaced,
+			Code:      CodeReplaced,
+			Codespace: Codespace,
+			Log:       fmt.Sprintf("replaced by tx %X", e.Txn.Hash()),
+		}
+	} else if uint64(len(p.elems)) >= p.maxSize {
+		evicted := p.evictionCandidate()
+		if evicted == nil || e.info.cmpGasPrice(evicted.info) <= 0 {
+			return nil, ErrFull
+		}
+		removed = evicted
+		rejection = &Rejection{
+			Reason:    ReasonEvicted,
+			Code:      CodeFull,
+			Codespace: Codespace,
+			Log:       fmt.Sprintf("evicted from the full mempool by tx %X, which pays a higher gas price", txs[0].Hash()),
 		}
 	}
 
-	if err = batch.Set([]byte(tailKey), userTxn.Hash()); err != nil {
-		return err
+	batch := p.db.NewBatch()
+	defer func() {
+		err = utils.WrapCloseErr(err, batch)
+	}()
+	if err = p.putElem(batch, e); err != nil {
+		return nil, err
+	}
+	if err = batch.Set([]byte(nextIDKey), binary.BigEndian.AppendUint64(nil, e.id+1)); err != nil {
+		return nil, fmt.Errorf("set next id: %v", err)
+	}
+	if removed != nil {
+		if err = batch.Delete(elemKey(removed.id)); err != nil {
+			return nil, fmt.Errorf("delete element: %v", err)
+		}
+	}
+	if err = batch.WriteSync(); err != nil {
+		return nil, err
 	}
 
-	pLen, err := p.Len()
+	p.nextID++
+	var rejections []*Rejection
+	if removed != nil {
+		p.remove(removed)
+		for _, tx := range removed.txs() {
+			txRejection := *rejection
+			txRejection.Tx = tx
+			rejections = append(rejections, &txRejection)
+		}
+	}
+	p.add(e)
+	return rejections, nil
+}
+
+// describe returns the info of tx, which is the zero TxInfo without a TxInfoFunc.
+func (p *Pool) describe(tx comettypes.Tx) (*TxInfo, error) {
+	if p.txInfo == nil {
+		return &TxInfo{}, nil
+	}
+	info, err := p.txInfo(tx)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("describe tx %X: %v", tx.Hash(), err)
 	}
+	return info, nil
+}
 
-	if err := p.updateLen(batch, pLen+1); err != nil { // don't worry about overflows, highly unlikely
-		return err
+// sameSequence returns the tx enqueued on its own with the same sender and sequence as e, or nil if there is none.
+func (p *Pool) sameSequence(e *elem) *elem {
+	if e.info.Sender == nil {
+		return nil
 	}
+	queue := p.senders[string(e.info.Sender)]
+	if i, ok := slices.BinarySearchFunc(queue, e.info.Sequence, func(queued *elem, sequence uint64) int {
+		return cmp.Compare(queued.info.Sequence, sequence)
+	}); ok {
+		return queue[i]
+	}
+	return nil
+}
 
-	return batch.WriteSync()
+// evictionCandidate returns the element with the lowest gas price, the newest if several pay the same, that isn't
+// followed by a tx from the same sender, so evicting it doesn't leave a gap in the sender's sequences.
+func (p *Pool) evictionCandidate() *elem {
+	var candidate *elem
+	for _, e := range p.elems {
+		if e.info.Sender != nil {
+			if queue := p.senders[string(e.info.Sender)]; queue[len(queue)-1] != e {
+				continue
+			}
+		}
+		if candidate == nil || lowerPriority(e, candidate) {
+			candidate = e
+		}
+	}
+	return candidate
 }
 
-// Dequeue returns the transaction with the highest priority from the pool
-func (p *Pool) Dequeue() (_ comettypes.Tx, err error) {
-	pLen, err := p.Len()
+// add adds e to the in-memory pool.
+func (p *Pool) add(e *elem) {
+	p.elems[e.id] = e
+	for _, tx := range e.txs() {
+		p.byHash[string(tx.Hash())] = e
+	}
+	if e.info.Sender == nil {
+		heap.Push(&p.ready, e)
+		return
+	}
+	sender := string(e.info.Sender)
+	queue := p.senders[sender]
+	i, _ := slices.BinarySearchFunc(queue, e.info.Sequence, func(queued *elem, sequence uint64) int {
+		return cmp.Compare(queued.info.Sequence, sequence)
+	})
+	if i == 0 {
+		if len(queue) > 0 {
+			// The sender's previous first tx waits for e now.
+			heap.Remove(&p.ready, queue[0].index)
+		}
+		heap.Push(&p.ready, e)
+	}
+	p.senders[sender] = slices.Insert(queue, i, e)
+}
+
+// remove removes e from the in-memory pool.
+func (p *Pool) remove(e *elem) {
+	delete(p.elems, e.id)
+	for _, tx := range e.txs() {
+		delete(p.byHash, string(tx.Hash()))
+	}
+	if e.index != -1 {
+		heap.Remove(&p.ready, e.index)
+	}
+	if e.info.Sender == nil {
+		return
+	}
+	sender := string(e.info.Sender)
+	queue := slices.DeleteFunc(p.senders[sender], func(queued *elem) bool {
+		return queued == e
+	})
+	if len(queue) == 0 {
+		delete(p.senders, sender)
+		return
+	}
+	p.senders[sender] = queue
+	if queue[0].index == -1 {
+		heap.Push(&p.ready, queue[0])
+	}
+}
+
+// Dequeue returns the transaction with the highest priority from the pool.
+// It returns an error without dequeuing anything if the head of the pool is a batch; use DequeueBatch instead.
+func (p *Pool) Dequeue() (comettypes.Tx, error) {
+	headElem, err := p.dequeue(false)
 	if err != nil {
 		return nil, err
 	}
+	return headElem.Txn, nil
+}
 
-	headHash, err := p.db.Get([]byte(headKey))
+// DequeueBatch returns the batch with the highest priority from the pool.
+// A transaction enqueued on its own is returned as a non-atomic batch of one.
+func (p *Pool) DequeueBatch() (*Batch, error) {
+	headElem, err := p.dequeue(true)
 	if err != nil {
 		return nil, err
-	} else if headHash == nil {
-		return nil, errors.New("head hash not found")
+	} else if headElem.Batch != nil {
+		return headElem.Batch, nil
+	}
+	return &Batch{
+		Txs: comettypes.Txs{headElem.Txn},
+	}, nil
+}
+
+func (p *Pool) dequeue(allowBatch bool) (*elem, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.load(); err != nil {
+		return nil, err
 	}
 
-	headElem, err := p.elem(headHash)
+	if len(p.ready) == 0 {
+		return nil, errors.New("pool is empty")
+	}
+	headElem := p.ready[0]
+	if headElem.Batch != nil && !allowBatch {
+		return nil, errors.New("head elem is a batch")
+	}
+
+	if err := p.db.DeleteSync(elemKey(headElem.id)); err != nil {
+		return nil, fmt.Errorf("delete element: %v", err)
+	}
+	p.remove(headElem)
+	return headElem, nil
+}
+
+// Txs returns up to limit txs in the pool, in the order they are dequeued, with the txs in batches in place. A limit of
+// zero or less returns all of them.
+func (p *Pool) Txs(limit int) (comettypes.Txs, error) {
+	pending, err := p.Pending(limit)
 	if err != nil {
-		return nil, fmt.Errorf("get head element: %v", err)
-	} else if headElem == nil {
-		return nil, errors.New("head elem not found")
+		return nil, err
 	}
+	txs := make(comettypes.Txs, 0, len(pending))
+	for _, pendingTx := range pending {
+		txs = append(txs, pendingTx.Tx)
+	}
+	return txs, nil
+}
 
-	batch := p.db.NewBatch()
-	defer func() {
-		err = utils.WrapCloseErr(err, batch)
-	}()
+// PendingTx is a tx in the pool and what the pool knows about it.
+type PendingTx struct {
+	Tx   comettypes.Tx
+	Info *TxInfo
+}
 
-	if err = batch.Delete(headHash); err != nil {
+// Pending returns up to limit txs in the pool with their info, like Txs.
+func (p *Pool) Pending(limit int) ([]*PendingTx, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.load(); err != nil {
 		return nil, err
 	}
 
-	if headElem.NextHash == nil {
-		// the list is empty now
-		if err = batch.Delete([]byte(headKey)); err != nil {
-			return nil, err
+	// Dequeue from a copy of the ready heap, which doesn't track the indexes of its elements.
+	order := orderHeap(slices.Clone(p.ready))
+	heap.Init(&order)
+	var pending []*PendingTx
+	for order.Len() > 0 && (limit <= 0 || len(pending) < limit) {
+		e := heap.Pop(&order).(*elem) //nolint:forcetypeassert
+		for i, tx := range e.txs() {
+			pending = append(pending, &PendingTx{
+				Tx:   tx,
+				Info: e.Infos[i],
+			})
 		}
-		if err = batch.Delete([]byte(tailKey)); err != nil {
-			return nil, err
-		}
-	} else {
-		if err = batch.Set([]byte(headKey), headElem.NextHash); err != nil {
-			return nil, err
+		if e.info.Sender != nil {
+			queue := p.senders[string(e.info.Sender)]
+			if i := slices.Index(queue, e); i+1 < len(queue) {
+				heap.Push(&order, queue[i+1])
+			}
 		}
 	}
+	if limit > 0 && len(pending) > limit {
+		pending = pending[:limit]
+	}
+	return pending, nil
+}
 
-	if err = p.updateLen(batch, pLen-1); err != nil {
-		return nil, err
+// Len returns the number of elements in the pool. A batch counts as one element.
+func (p *Pool) Len() (uint64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.load(); err != nil {
+		return 0, err
 	}
+	return uint64(len(p.elems)), nil
+}
 
-	if err := batch.WriteSync(); err != nil {
+// Get returns the tx with the given hash if it is in the pool, either on its own or in a batch, and nil otherwise.
+func (p *Pool) Get(hash []byte) (comettypes.Tx, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.load(); err != nil {
 		return nil, err
 	}
-
-	return headElem.Txn, nil
+	e, ok := p.byHash[string(hash)]
+	if !ok {
+		return nil, nil
+	}
+	txs := e.txs()
+	return txs[txs.IndexByHash(hash)], nil
 }
 
-func (p *Pool) Len() (uint64, error) {
-	lengthBytes, err := p.db.Get([]byte(poolLengthKey))
+// load loads the pool from its database the first time it is called, migrating a pool stored as a linked list.
+func (p *Pool) load() (err error) {
+	if p.loaded {
+		return nil
+	}
+	if err := p.migrate(); err != nil {
+		return fmt.Errorf("migrate linked list: %v", err)
+	}
+
+	nextIDBytes, err := p.db.Get([]byte(nextIDKey))
 	if err != nil {
-		return 0, nil
+		return fmt.Errorf("get next id: %v", err)
+	} else if nextIDBytes != nil {
+		p.nextID = binary.BigEndian.Uint64(nextIDBytes)
 	}
-	if lengthBytes == nil {
-		return 0, nil
+	iter, err := p.db.Iterator([]byte(elemKeyPrefix), prefixEnd([]byte(elemKeyPrefix)))
+	if err != nil {
+		return fmt.Errorf("new iterator: %v", err)
 	}
-	return binary.BigEndian.Uint64(lengthBytes), nil
+	defer func() {
+		err = utils.WrapCloseErr(err, iter)
+	}()
+	for ; iter.Valid(); iter.Next() {
+		stored := new(storageElem)
+		if err := json.Unmarshal(iter.Value(), stored); err != nil {
+			return fmt.Errorf("unmarshal element: %v", err)
+		}
+		e := &elem{
+			storageElem: stored,
+			id:          binary.BigEndian.Uint64(iter.Key()[len(elemKeyPrefix):]),
+			index:       -1,
+		}
+		e.setInfo()
+		p.add(e)
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("iterate elements: %v", err)
+	}
+	p.loaded = true
+	return nil
 }
 
-func (p *Pool) updateLen(batch dbm.Batch, l uint64) error {
-	return batch.Set([]byte(poolLengthKey), binary.BigEndian.AppendUint64(nil, l))
+// legacyStorageElem is an element of the linked list the pool was stored in.
+type legacyStorageElem struct {
+	Txn      comettypes.Tx `json:"txn"`
+	Batch    *Batch        `json:"batch,omitempty"`
+	NextHash []byte        `json:"nextHash"`
 }
 
-func (p *Pool) elem(itemKey []byte) (*storageElem, error) {
-	value, err := p.db.Get(itemKey)
+// migrate moves the elements of a pool stored as a linked list to the pool's order, in the order they were enqueued.
+func (p *Pool) migrate() (err error) {
+	key, err := p.db.Get([]byte(legacyHeadKey))
 	if err != nil {
-		return nil, err
-	} else if value == nil {
-		return nil, errors.New("value not found")
+		return fmt.Errorf("get head: %v", err)
+	} else if key == nil {
+		return nil
 	}
-	item := new(storageElem)
-	if err := json.Unmarshal(value, item); err != nil {
-		return nil, err
+
+	batch := p.db.NewBatch()
+	defer func() {
+		err = utils.WrapCloseErr(err, batch)
+	}()
+	var id uint64
+	for ; key != nil; id++ {
+		value, err := p.db.Get(key)
+		if err != nil {
+			return fmt.Errorf("get element: %v", err)
+		} else if value == nil {
+			return errors.New("element not found")
+		}
+		legacy := new(legacyStorageElem)
+		if err := json.Unmarshal(value, legacy); err != nil {
+			return fmt.Errorf("unmarshal element: %v", err)
+		}
+		e := &elem{
+			storageElem: &storageElem{
+				Txn:   legacy.Txn,
+				Batch: legacy.Batch,
+			},
+			id: id,
+		}
+		for _, tx := range e.txs() {
+			info, err := p.describe(tx)
+			if err != nil {
+				return err
+			}
+			e.Infos = append(e.Infos, info)
+		}
+		if err := p.putElem(batch, e); err != nil {
+			return err
+		}
+		if err := batch.Delete(key); err != nil {
+			return fmt.Errorf("delete element: %v", err)
+		}
+		key = legacy.NextHash
+	}
+	for _, legacyKey := range []string{legacyHeadKey, legacyTailKey, legacyPoolLengthKey} {
+		if err := batch.Delete([]byte(legacyKey)); err != nil {
+			return fmt.Errorf("delete %s: %v", legacyKey, err)
+		}
+	}
+	if err := batch.Set([]byte(nextIDKey), binary.BigEndian.AppendUint64(nil, id)); err != nil {
+		return fmt.Errorf("set next id: %v", err)
 	}
-	return item, nil
+	return batch.WriteSync()
 }
 
-func (p *Pool) putElem(batch dbm.Batch, itemKey []byte, item *storageElem) error {
-	itemBytes, err := json.Marshal(item)
+func (p *Pool) putElem(batch dbm.Batch, e *elem) error {
+	elemBytes, err := json.Marshal(e.storageElem)
 	if err != nil {
-		return err
+		return fmt.Errorf("marshal element: %v", err)
 	}
-	return batch.Set(itemKey, itemBytes)
+	if err := batch.Set(elemKey(e.id), elemBytes); err != nil {
+		return fmt.Errorf("set element: %v", err)
+	}
+	return nil
+}
+
+func elemKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(elemKeyPrefix), id)
+}
+
+// higherPriority reports whether a is dequeued before b if both are ready.
+func higherPriority(a, b *elem) bool {
+	if c := a.info.cmpGasPrice(b.info); c != 0 {
+		return c > 0
+	}
+	return a.id < b.id
+}
+
+// lowerPriority reports whether a is evicted before b.
+func lowerPriority(a, b *elem) bool {
+	if c := a.info.cmpGasPrice(b.info); c != 0 {
+		return c < 0
+	}
+	return a.id > b.id
+}
+
+// readyHeap is a max-heap of the ready elements that keeps their indexes up to date.
+type readyHeap []*elem
+
+func (h readyHeap) Len() int           { return len(h) }
+func (h readyHeap) Less(i, j int) bool { return higherPriority(h[i], h[j]) }
+
+func (h readyHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *readyHeap) Push(x any) {
+	e := x.(*elem) //nolint:forcetypeassert
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *readyHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	old[len(old)-1] = nil
+	e.index = -1
+	*h = old[:len(old)-1]
+	return e
+}
+
+// orderHeap is a max-heap of elements that leaves their indexes alone, to list the pool in order without changing it.
+type orderHeap []*elem
+
+func (h orderHeap) Len() int           { return len(h) }
+func (h orderHeap) Less(i, j int) bool { return higherPriority(h[i], h[j]) }
+func (h orderHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *orderHeap) Push(x any) {
+	*h = append(*h, x.(*elem)) //nolint:forcetypeassert
+}
+
+func (h *orderHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
 }
@@ -1,6 +1,9 @@
 package mempool_test
 
 import (
+	"encoding/base64"
+	"fmt"
+	"math/big"
 	"testing"
 
 	comettypes "github.com/cometbft/cometbft/types"
@@ -77,3 +80,390 @@ func TestMempool(t *testing.T) {
 	_, err = pool.Dequeue()
 	require.Error(t, err)
 }
+
+func TestMempoolBatch(t *testing.T) {
+	pool := mempool.New(testutils.NewMemDB(t))
+
+	require.ErrorContains(t, pool.EnqueueBatch(&mempool.Batch{}), "empty batch")
+
+	_, depositTx, _ := testutils.GenerateEthTxs(t)
+	depositTxBytes, err := depositTx.MarshalBinary()
+	require.NoError(t, err)
+	cosmosTxs, err := monomer.AdaptPayloadTxsToCosmosTxs([]hexutil.Bytes{depositTxBytes}, nil, "")
+	require.NoError(t, err)
+	require.ErrorContains(t, pool.EnqueueBatch(&mempool.Batch{
+		Txs: comettypes.Txs{comettypes.Tx{0}, cosmosTxs[0]},
+	}), "deposit txs are not allowed in the pool")
+
+	batch := &mempool.Batch{
+		Txs:    comettypes.Txs{comettypes.Tx{1}, comettypes.Tx{2}},
+		Atomic: true,
+	}
+	require.NoError(t, pool.Enqueue(comettypes.Tx{0}))
+	require.NoError(t, pool.EnqueueBatch(batch))
+	require.NoError(t, pool.Enqueue(comettypes.Tx{3}))
+	l, err := pool.Len()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), l)
+
+	// Txs enqueued on their own are dequeued as batches of one.
+	got, err := pool.DequeueBatch()
+	require.NoError(t, err)
+	require.Equal(t, &mempool.Batch{Txs: comettypes.Txs{comettypes.Tx{0}}}, got)
+
+	// Dequeue refuses to split a batch.
+	_, err = pool.Dequeue()
+	require.Error(t, err)
+	l, err = pool.Len()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), l)
+
+	got, err = pool.DequeueBatch()
+	require.NoError(t, err)
+	require.Equal(t, batch, got)
+
+	txn, err := pool.Dequeue()
+	require.NoError(t, err)
+	require.Equal(t, comettypes.Tx{3}, txn)
+}
+
+func TestGet(t *testing.T) {
+	pool := mempool.New(testutils.NewMemDB(t))
+
+	contains := func(tx comettypes.Tx) bool {
+		got, err := pool.Get(tx.Hash())
+		require.NoError(t, err)
+		if got == nil {
+			return false
+		}
+		require.Equal(t, tx, got)
+		return true
+	}
+	require.False(t, contains(comettypes.Tx{0}))
+
+	require.NoError(t, pool.Enqueue(comettypes.Tx{0}))
+	require.NoError(t, pool.EnqueueBatch(&mempool.Batch{Txs: comettypes.Txs{comettypes.Tx{1}, comettypes.Tx{2}}}))
+	for i := byte(0); i < 3; i++ {
+		require.True(t, contains(comettypes.Tx{i}))
+	}
+	require.False(t, contains(comettypes.Tx{3}))
+
+	_, err := pool.Dequeue()
+	require.NoError(t, err)
+	require.False(t, contains(comettypes.Tx{0}))
+	require.True(t, contains(comettypes.Tx{2}))
+}
+
+func TestTxs(t *testing.T) {
+	pool := mempool.New(testutils.NewMemDB(t))
+
+	txs, err := pool.Txs(0)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	require.NoError(t, pool.Enqueue(comettypes.Tx{0}))
+	require.NoError(t, pool.EnqueueBatch(&mempool.Batch{Txs: comettypes.Txs{comettypes.Tx{1}, comettypes.Tx{2}}}))
+	require.NoError(t, pool.Enqueue(comettypes.Tx{3}))
+	txs, err = pool.Txs(0)
+	require.NoError(t, err)
+	require.Equal(t, comettypes.Txs{{0}, {1}, {2}, {3}}, txs)
+
+	// The limit can split a batch.
+	txs, err = pool.Txs(2)
+	require.NoError(t, err)
+	require.Equal(t, comettypes.Txs{{0}, {1}}, txs)
+
+	_, err = pool.Dequeue()
+	require.NoError(t, err)
+	txs, err = pool.Txs(10)
+	require.NoError(t, err)
+	require.Equal(t, comettypes.Txs{{1}, {2}, {3}}, txs)
+}
+
+func TestRejection(t *testing.T) {
+	pool := mempool.New(testutils.NewMemDB(t))
+	tx := comettypes.Tx{0}
+
+	got, err := pool.Rejection(tx.Hash())
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	require.NoError(t, pool.Reject(&mempool.Rejection{
+		Tx:        tx,
+		Reason:    mempool.ReasonCheckTxFailed,
+		Code:      13,
+		Codespace: "sdk",
+		Log:       "insufficient fee",
+	}))
+	got, err = pool.Rejection(tx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, tx, got.Tx)
+	require.Equal(t, mempool.ReasonCheckTxFailed, got.Reason)
+	require.Equal(t, uint32(13), got.Code)
+	require.Equal(t, "sdk", got.Codespace)
+	require.Equal(t, "insufficient fee", got.Log)
+	require.False(t, got.Time.IsZero())
+
+	// Rejections don't affect the pool.
+	l, err := pool.Len()
+	require.NoError(t, err)
+	require.Zero(t, l)
+}
+
+func TestRejections(t *testing.T) {
+	pool := mempool.New(testutils.NewMemDB(t))
+	pool.SetMaxRejections(3)
+	reject := func(b byte) {
+		require.NoError(t, pool.Reject(&mempool.Rejection{
+			Tx:     comettypes.Tx{b},
+			Reason: mempool.ReasonEvicted,
+		}))
+	}
+	txs := func(rejections []*mempool.Rejection) []comettypes.Tx {
+		var txs []comettypes.Tx
+		for _, rejection := range rejections {
+			txs = append(txs, rejection.Tx)
+		}
+		return txs
+	}
+
+	got, err := pool.Rejections(10, 0)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	reject(1)
+	reject(2)
+	reject(1) // Replaces the first rejection of tx 1.
+	got, err = pool.Rejections(10, 0)
+	require.NoError(t, err)
+	require.Equal(t, []comettypes.Tx{{1}, {2}}, txs(got))
+	require.Equal(t, []uint64{3, 2}, []uint64{got[0].Seq, got[1].Seq})
+
+	// Rejecting tx 3 drops the stale index entry for tx 1; rejecting tx 4 deletes the rejection of tx 2.
+	reject(3)
+	reject(4)
+	got, err = pool.Rejections(10, 0)
+	require.NoError(t, err)
+	require.Equal(t, []comettypes.Tx{{4}, {3}, {1}}, txs(got))
+	rejection, err := pool.Rejection(comettypes.Tx{2}.Hash())
+	require.NoError(t, err)
+	require.Nil(t, rejection)
+
+	// Paginate.
+	got, err = pool.Rejections(2, 0)
+	require.NoError(t, err)
+	require.Equal(t, []comettypes.Tx{{4}, {3}}, txs(got))
+	got, err = pool.Rejections(2, got[1].Seq)
+	require.NoError(t, err)
+	require.Equal(t, []comettypes.Tx{{1}}, txs(got))
+}
+
+func TestSubscribe(t *testing.T) {
+	pool := mempool.New(testutils.NewMemDB(t))
+	txs, unsubscribe := pool.Subscribe()
+
+	require.NoError(t, pool.Enqueue(comettypes.Tx{1}))
+	require.NoError(t, pool.EnqueueBatch(&mempool.Batch{
+		Txs: comettypes.Txs{{2}, {3}},
+	}))
+	for _, want := range []comettypes.Tx{{1}, {2}, {3}} {
+		require.Equal(t, want, <-txs)
+	}
+
+	unsubscribe()
+	_, ok := <-txs
+	require.False(t, ok)
+	// Unsubscribing twice is a no-op.
+	unsubscribe()
+	require.NoError(t, pool.Enqueue(comettypes.Tx{4}))
+}
+
+// txInfos describes the txs in a test by their bytes.
+type txInfos map[string]*mempool.TxInfo
+
+func (infos txInfos) txInfo(tx comettypes.Tx) (*mempool.TxInfo, error) {
+	if info, ok := infos[string(tx)]; ok {
+		return info, nil
+	}
+	return &mempool.TxInfo{}, nil
+}
+
+func TestPriority(t *testing.T) {
+	pool := mempool.New(testutils.NewMemDB(t))
+	alice, bob := []byte("alice"), []byte("bob")
+	infos := txInfos{
+		"alice0": {Sender: alice, Sequence: 0, Fee: big.NewInt(100), Gas: 100},
+		"alice1": {Sender: alice, Sequence: 1, Fee: big.NewInt(500), Gas: 100},
+		"bob0":   {Sender: bob, Sequence: 0, Fee: big.NewInt(300), Gas: 100},
+		"bob1":   {Sender: bob, Sequence: 1, Fee: big.NewInt(200), Gas: 100},
+		// A higher fee, but a lower gas price.
+		"carol": {Sender: []byte("carol"), Fee: big.NewInt(1000), Gas: 1000},
+	}
+	pool.SetTxInfoFunc(infos.txInfo)
+
+	// Enqueue a sender's txs out of order, and a tx without info, which pays no fee.
+	for _, tx := range []string{"alice1", "free", "bob0", "alice0", "carol", "bob1"} {
+		require.NoError(t, pool.Enqueue(comettypes.Tx(tx)))
+	}
+	// Bob's txs pay more than alice's first tx, but alice's second tx waits for her first.
+	want := comettypes.Txs{
+		comettypes.Tx("bob0"),
+		comettypes.Tx("bob1"),
+		comettypes.Tx("alice0"),
+		comettypes.Tx("alice1"),
+		comettypes.Tx("carol"),
+		comettypes.Tx("free"),
+	}
+	txs, err := pool.Txs(0)
+	require.NoError(t, err)
+	require.Equal(t, want, txs)
+
+	pending, err := pool.Pending(1)
+	require.NoError(t, err)
+	require.Equal(t, []*mempool.PendingTx{{Tx: comettypes.Tx("bob0"), Info: infos["bob0"]}}, pending)
+
+	for _, wantTx := range want {
+		tx, err := pool.Dequeue()
+		require.NoError(t, err)
+		require.Equal(t, wantTx, tx)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	pool := mempool.New(testutils.NewMemDB(t))
+	alice := []byte("alice")
+	infos := txInfos{
+		"original":   {Sender: alice, Sequence: 1, Fee: big.NewInt(100), Gas: 100},
+		"next":       {Sender: alice, Sequence: 2, Fee: big.NewInt(100), Gas: 100},
+		"underpaid":  {Sender: alice, Sequence: 1, Fee: big.NewInt(109), Gas: 100},
+		"bumped":     {Sender: alice, Sequence: 1, Fee: big.NewInt(110), Gas: 100},
+		"unrelated":  {Sender: []byte("bob"), Sequence: 1, Fee: big.NewInt(1), Gas: 100},
+		"batchedTx":  {Sender: alice, Sequence: 1, Fee: big.NewInt(1000), Gas: 100},
+		"batchedTx2": {Sender: alice, Sequence: 3, Fee: big.NewInt(1000), Gas: 100},
+	}
+	pool.SetTxInfoFunc(infos.txInfo)
+
+	require.NoError(t, pool.Enqueue(comettypes.Tx("original")))
+	require.NoError(t, pool.Enqueue(comettypes.Tx("next")))
+	require.ErrorIs(t, pool.Enqueue(comettypes.Tx("original")), mempool.ErrAlreadyKnown)
+	require.ErrorIs(t, pool.Enqueue(comettypes.Tx("underpaid")), mempool.ErrUnderpriced)
+	require.NoError(t, pool.Enqueue(comettypes.Tx("unrelated")))
+	require.NoError(t, pool.Enqueue(comettypes.Tx("bumped")))
+	// Batches never replace txs.
+	require.NoError(t, pool.EnqueueBatch(&mempool.Batch{
+		Txs: comettypes.Txs{comettypes.Tx("batchedTx"), comettypes.Tx("batchedTx2")},
+	}))
+
+	got, err := pool.Get(comettypes.Tx("original").Hash())
+	require.NoError(t, err)
+	require.Nil(t, got)
+	rejection, err := pool.Rejection(comettypes.Tx("original").Hash())
+	require.NoError(t, err)
+	require.Equal(t, mempool.ReasonReplaced, rejection.Reason)
+	require.Equal(t, mempool.Codespace, rejection.Codespace)
+	require.Equal(t, mempool.CodeReplaced, rejection.Code)
+
+	// The replacement takes the original's place in the sender's sequence.
+	txs, err := pool.Txs(0)
+	require.NoError(t, err)
+	require.Equal(t, comettypes.Txs{
+		comettypes.Tx("batchedTx"),
+		comettypes.Tx("batchedTx2"),
+		comettypes.Tx("bumped"),
+		comettypes.Tx("next"),
+		comettypes.Tx("unrelated"),
+	}, txs)
+}
+
+func TestMaxSize(t *testing.T) {
+	pool := mempool.New(testutils.NewMemDB(t))
+	alice := []byte("alice")
+	infos := txInfos{
+		"alice0": {Sender: alice, Sequence: 0, Fee: big.NewInt(100), Gas: 100},
+		"alice1": {Sender: alice, Sequence: 1, Fee: big.NewInt(1), Gas: 100},
+		"bob":    {Sender: []byte("bob"), Fee: big.NewInt(50), Gas: 100},
+		"carol":  {Sender: []byte("carol"), Fee: big.NewInt(50), Gas: 100},
+		"dave":   {Sender: []byte("dave"), Fee: big.NewInt(2), Gas: 100},
+	}
+	pool.SetTxInfoFunc(infos.txInfo)
+	pool.SetMaxSize(2)
+
+	require.NoError(t, pool.Enqueue(comettypes.Tx("alice0")))
+	require.NoError(t, pool.Enqueue(comettypes.Tx("alice1")))
+	// A tx must pay more than the tx it evicts.
+	require.ErrorIs(t, pool.Enqueue(comettypes.Tx("free")), mempool.ErrFull)
+
+	// Evicting alice's last tx doesn't leave a gap in her sequences.
+	require.NoError(t, pool.Enqueue(comettypes.Tx("bob")))
+	rejection, err := pool.Rejection(comettypes.Tx("alice1").Hash())
+	require.NoError(t, err)
+	require.Equal(t, mempool.ReasonEvicted, rejection.Reason)
+	require.Equal(t, mempool.CodeFull, rejection.Code)
+
+	// A tx that pays the same as the cheapest tx doesn't evict it.
+	require.ErrorIs(t, pool.Enqueue(comettypes.Tx("carol")), mempool.ErrFull)
+	require.ErrorIs(t, pool.Enqueue(comettypes.Tx("dave")), mempool.ErrFull)
+
+	txs, err := pool.Txs(0)
+	require.NoError(t, err)
+	require.Equal(t, comettypes.Txs{comettypes.Tx("alice0"), comettypes.Tx("bob")}, txs)
+}
+
+func TestReload(t *testing.T) {
+	db := testutils.NewMemDB(t)
+	pool := mempool.New(db)
+	infos := txInfos{
+		"cheap":     {Sender: []byte("alice"), Fee: big.NewInt(1), Gas: 1},
+		"expensive": {Sender: []byte("bob"), Fee: big.NewInt(2), Gas: 1},
+	}
+	pool.SetTxInfoFunc(infos.txInfo)
+	require.NoError(t, pool.Enqueue(comettypes.Tx("cheap")))
+	require.NoError(t, pool.EnqueueBatch(&mempool.Batch{Txs: comettypes.Txs{comettypes.Tx("batched")}}))
+	require.NoError(t, pool.Enqueue(comettypes.Tx("expensive")))
+	_, err := pool.Dequeue()
+	require.NoError(t, err)
+
+	// The pool is loaded from its database with the infos of its txs.
+	reloaded := mempool.New(db)
+	txs, err := reloaded.Txs(0)
+	require.NoError(t, err)
+	require.Equal(t, comettypes.Txs{comettypes.Tx("cheap"), comettypes.Tx("batched")}, txs)
+	require.ErrorIs(t, reloaded.Enqueue(comettypes.Tx("cheap")), mempool.ErrAlreadyKnown)
+	require.NoError(t, reloaded.Enqueue(comettypes.Tx("new")))
+	txs, err = reloaded.Txs(0)
+	require.NoError(t, err)
+	require.Equal(t, comettypes.Txs{comettypes.Tx("cheap"), comettypes.Tx("batched"), comettypes.Tx("new")}, txs)
+}
+
+func TestMigrateLinkedList(t *testing.T) {
+	db := testutils.NewMemDB(t)
+	// A pool stored as a linked list of a tx and a batch.
+	txHash := comettypes.Tx{0}.Hash()
+	batchTxs := comettypes.Txs{{1}, {2}}
+	for key, value := range map[string]string{
+		"headKey":               string(txHash),
+		"tailKey":               string(batchTxs.Hash()),
+		"poolLength":            string([]byte{0, 0, 0, 0, 0, 0, 0, 2}),
+		string(txHash):          fmt.Sprintf(`{"txn":"AA==","nextHash":%q}`, base64.StdEncoding.EncodeToString(batchTxs.Hash())),
+		string(batchTxs.Hash()): `{"txn":null,"batch":{"txs":["AQ==","Ag=="],"atomic":true},"nextHash":null}`,
+	} {
+		require.NoError(t, db.Set([]byte(key), []byte(value)))
+	}
+
+	pool := mempool.New(db)
+	l, err := pool.Len()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), l)
+	tx, err := pool.Dequeue()
+	require.NoError(t, err)
+	require.Equal(t, comettypes.Tx{0}, tx)
+	batch, err := pool.DequeueBatch()
+	require.NoError(t, err)
+	require.Equal(t, &mempool.Batch{Txs: batchTxs, Atomic: true}, batch)
+
+	for _, key := range [][]byte{[]byte("headKey"), []byte("tailKey"), []byte("poolLength"), txHash, batchTxs.Hash()} {
+		has, err := db.Has(key)
+		require.NoError(t, err)
+		require.False(t, has, string(key))
+	}
+}
@@ -0,0 +1,222 @@
+package mempool
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer/utils"
+)
+
+const (
+	rejectionKeyPrefix      = "rejection/"
+	rejectionIndexKeyPrefix = "rejectionIndex/"
+	rejectionSeqsKey        = "rejectionSeqs"
+
+	// DefaultMaxRejections is the number of rejections the pool keeps by default.
+	DefaultMaxRejections = 10_000
+)
+
+// Reason says why a tx was rejected.
+type Reason string
+
+const (
+	// ReasonCheckTxFailed txs failed CheckTx when they were submitted, so they were never added to the pool.
+	ReasonCheckTxFailed Reason = "check_tx_failed"
+	// ReasonEvicted txs were removed from the pool without being included in a block, e.g., because they were in an
+	// atomic batch with a tx that failed, to make room in the full pool for a tx that pays a higher gas price, or because
+	// they were rolled back by a reorg and couldn't be added back.
+	ReasonEvicted Reason = "evicted"
+	// ReasonSequencerRejected txs passed CheckTx on a node that forwards txs to the sequencer, but failed it on the
+	// sequencer.
+	ReasonSequencerRejected Reason = "sequencer_rejected"
+	// ReasonReplaced txs were replaced in the pool by a tx with the same sender and sequence that pays a higher gas price.
+	ReasonReplaced Reason = "replaced"
+)
+
+const (
+	// Codespace is the codespace of the rejections the pool records when it removes txs to make room for others.
+	Codespace = "mempool"
+	// CodeReplaced is the code of ReasonReplaced rejections.
+	CodeReplaced uint32 = 1
+	// CodeFull is the code of the ReasonEvicted rejections of txs evicted from the full pool by a tx that pays a higher
+	// gas price.
+	CodeFull uint32 = 2
+	// CodeReorged is the code of the ReasonEvicted rejections of txs that were rolled back by a reorg but couldn't be
+	// added back to the pool.
+	CodeReorged uint32 = 3
+)
+
+// Rejection records why a tx was not added to the pool, or was removed from it without being included in a block.
+type Rejection struct {
+	// Seq orders rejections by when they were recorded. It is set by Reject.
+	Seq    uint64        `json:"seq"`
+	Tx     comettypes.Tx `json:"tx"`
+	Reason Reason        `json:"reason"`
+	// Code, Codespace, and Log are the result of the check that failed.
+	Code      uint32    `json:"code"`
+	Codespace string    `json:"codespace"`
+	Log       string    `json:"log"`
+	Time      time.Time `json:"time"`
+}
+
+// SetMaxRejections sets the number of rejections the pool keeps. Once there are more, the oldest are deleted.
+// Zero restores DefaultMaxRejections.
+func (p *Pool) SetMaxRejections(maxRejections uint64) {
+	p.rejectionsMu.Lock()
+	defer p.rejectionsMu.Unlock()
+	if maxRejections == 0 {
+		maxRejections = DefaultMaxRejections
+	}
+	p.maxRejections = maxRejections
+}
+
+// Reject records a rejection, replacing any earlier rejection of the same tx, and deletes the oldest rejections if
+// there are too many. Time is set to the current time if it is zero.
+func (p *Pool) Reject(rejection *Rejection) (err error) {
+	p.rejectionsMu.Lock()
+	defer p.rejectionsMu.Unlock()
+
+	if rejection.Time.IsZero() {
+		rejection.Time = time.Now().UTC()
+	}
+	first, next, err := p.rejectionSeqs()
+	if err != nil {
+		return err
+	}
+	rejection.Seq = next
+	next++
+	rejectionBytes, err := json.Marshal(rejection)
+	if err != nil {
+		return fmt.Errorf("marshal rejection: %v", err)
+	}
+
+	batch := p.db.NewBatch()
+	defer func() {
+		err = utils.WrapCloseErr(err, batch)
+	}()
+	hash := rejection.Tx.Hash()
+	if err = batch.Set(rejectionKey(hash), rejectionBytes); err != nil {
+		return fmt.Errorf("set rejection: %v", err)
+	}
+	if err = batch.Set(rejectionIndexKey(rejection.Seq), hash); err != nil {
+		return fmt.Errorf("set rejection index: %v", err)
+	}
+
+	// The index may contain stale entries for txs that were rejected again, so this may delete fewer than
+	// next-first-maxRejections rejections.
+	for ; next-first > p.maxRejections; first++ {
+		var oldest *Rejection
+		oldest, err = p.rejectionAt(first)
+		if err != nil {
+			return err
+		}
+		if oldest != nil && !bytes.Equal(oldest.Tx.Hash(), hash) {
+			if err = batch.Delete(rejectionKey(oldest.Tx.Hash())); err != nil {
+				return fmt.Errorf("delete rejection: %v", err)
+			}
+		}
+		if err = batch.Delete(rejectionIndexKey(first)); err != nil {
+			return fmt.Errorf("delete rejection index: %v", err)
+		}
+	}
+
+	if err = batch.Set([]byte(rejectionSeqsKey), binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, first), next)); err != nil {
+		return fmt.Errorf("set rejection seqs: %v", err)
+	}
+	return batch.WriteSync()
+}
+
+// Rejection returns the latest rejection of the tx with the given hash, or nil if it was never rejected or the
+// rejection was deleted to make room for newer ones.
+func (p *Pool) Rejection(hash []byte) (*Rejection, error) {
+	rejectionBytes, err := p.db.Get(rejectionKey(hash))
+	if err != nil {
+		return nil, fmt.Errorf("get rejection: %v", err)
+	} else if rejectionBytes == nil {
+		return nil, nil
+	}
+	rejection := new(Rejection)
+	if err := json.Unmarshal(rejectionBytes, rejection); err != nil {
+		return nil, fmt.Errorf("unmarshal rejection: %v", err)
+	}
+	return rejection, nil
+}
+
+// Rejections returns up to limit rejections with a Seq less than before, newest first. A before of zero starts from the
+// newest rejection.
+func (p *Pool) Rejections(limit int, before uint64) (_ []*Rejection, err error) {
+	end := prefixEnd([]byte(rejectionIndexKeyPrefix))
+	if before != 0 {
+		end = rejectionIndexKey(before)
+	}
+	iter, err := p.db.ReverseIterator([]byte(rejectionIndexKeyPrefix), end)
+	if err != nil {
+		return nil, fmt.Errorf("new iterator: %v", err)
+	}
+	defer func() {
+		err = utils.WrapCloseErr(err, iter)
+	}()
+
+	var rejections []*Rejection
+	for ; iter.Valid() && len(rejections) < limit; iter.Next() {
+		rejection, err := p.Rejection(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		// Skip stale entries for txs that were rejected again.
+		if rejection != nil && rejection.Seq == binary.BigEndian.Uint64(iter.Key()[len(rejectionIndexKeyPrefix):]) {
+			rejections = append(rejections, rejection)
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("iterate rejections: %v", err)
+	}
+	return rejections, nil
+}
+
+// rejectionAt returns the rejection with the given seq, or nil if it was replaced by a newer rejection of the same tx.
+func (p *Pool) rejectionAt(seq uint64) (*Rejection, error) {
+	hash, err := p.db.Get(rejectionIndexKey(seq))
+	if err != nil {
+		return nil, fmt.Errorf("get rejection index: %v", err)
+	} else if hash == nil {
+		return nil, nil
+	}
+	rejection, err := p.Rejection(hash)
+	if err != nil {
+		return nil, err
+	} else if rejection == nil || rejection.Seq != seq {
+		return nil, nil
+	}
+	return rejection, nil
+}
+
+// rejectionSeqs returns the seq of the oldest entry in the rejection index and the seq of the next rejection.
+func (p *Pool) rejectionSeqs() (first, next uint64, err error) {
+	seqsBytes, err := p.db.Get([]byte(rejectionSeqsKey))
+	if err != nil {
+		return 0, 0, fmt.Errorf("get rejection seqs: %v", err)
+	} else if seqsBytes == nil {
+		return 1, 1, nil
+	}
+	return binary.BigEndian.Uint64(seqsBytes[:8]), binary.BigEndian.Uint64(seqsBytes[8:]), nil
+}
+
+func rejectionKey(hash []byte) []byte {
+	return append([]byte(rejectionKeyPrefix), hash...)
+}
+
+func rejectionIndexKey(seq uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(rejectionIndexKeyPrefix), seq)
+}
+
+// prefixEnd returns the smallest key greater than every key with the given prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	end[len(end)-1]++
+	return end
+}
@@ -0,0 +1,99 @@
+package mempool
+
+import (
+	"fmt"
+	"math/big"
+
+	comettypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// TxInfo is what the pool knows about a tx to order it. The zero TxInfo describes a tx without a sender that pays no
+// fee.
+type TxInfo struct {
+	// Sender is the address of the tx's first signer, or nil if the tx isn't signed.
+	Sender []byte `json:"sender,omitempty"`
+	// Sequence is the sender's account sequence the tx was signed with.
+	Sequence uint64 `json:"sequence"`
+	// Fee is the fee the tx pays for its gas limit, Gas. Txs that pay more per unit of gas are dequeued first.
+	Fee *big.Int `json:"fee"`
+	Gas uint64   `json:"gas"`
+}
+
+// TxInfoFunc describes a tx to the pool.
+type TxInfoFunc func(tx comettypes.Tx) (*TxInfo, error)
+
+// NewTxInfoFunc returns a TxInfoFunc for the Cosmos txs txDecoder decodes. Only the part of a tx's fee in feeDenom
+// counts towards its gas price, like the highest-fee bundle policy.
+func NewTxInfoFunc(txDecoder sdk.TxDecoder, feeDenom string) TxInfoFunc {
+	return func(tx comettypes.Tx) (*TxInfo, error) {
+		sdkTx, err := txDecoder(tx)
+		if err != nil {
+			return nil, fmt.Errorf("decode tx: %v", err)
+		}
+		info := new(TxInfo)
+		if feeTx, ok := sdkTx.(sdk.FeeTx); ok {
+			info.Fee = feeTx.GetFee().AmountOf(feeDenom).BigInt()
+			info.Gas = feeTx.GetGas()
+		}
+		if sigTx, ok := sdkTx.(authsigning.SigVerifiableTx); ok {
+			signers, err := sigTx.GetSigners()
+			if err != nil {
+				return nil, fmt.Errorf("get signers: %v", err)
+			}
+			sigs, err := sigTx.GetSignaturesV2()
+			if err != nil {
+				return nil, fmt.Errorf("get signatures: %v", err)
+			}
+			if len(signers) > 0 && len(sigs) > 0 {
+				info.Sender = signers[0]
+				info.Sequence = sigs[0].Sequence
+			}
+		}
+		return info, nil
+	}
+}
+
+// fee returns the fee, which is zero if it isn't set.
+func (i *TxInfo) fee() *big.Int {
+	if i.Fee == nil {
+		return new(big.Int)
+	}
+	return i.Fee
+}
+
+// gas returns the gas limit, counting a gas limit of zero as one so a fee without gas still has a price.
+func (i *TxInfo) gas() *big.Int {
+	return new(big.Int).SetUint64(max(i.Gas, 1))
+}
+
+// cmpGasPrice compares the fee i pays per unit of gas to the fee other pays.
+func (i *TxInfo) cmpGasPrice(other *TxInfo) int {
+	return new(big.Int).Mul(i.fee(), other.gas()).Cmp(new(big.Int).Mul(other.fee(), i.gas()))
+}
+
+// bumps reports whether i pays enough more per unit of gas than replaced to replace it.
+func (i *TxInfo) bumps(replaced *TxInfo) bool {
+	if i.cmpGasPrice(replaced) <= 0 {
+		return false
+	}
+	price := new(big.Int).Mul(i.fee(), replaced.gas())
+	price.Mul(price, big.NewInt(100))
+	minPrice := new(big.Int).Mul(replaced.fee(), i.gas())
+	minPrice.Mul(minPrice, big.NewInt(100+PriceBump))
+	return price.Cmp(minPrice) >= 0
+}
+
+// batchInfo describes a batch of txs with infos to the pool: it has no sender and pays all of their fees for their
+// total gas.
+func batchInfo(infos []*TxInfo) *TxInfo {
+	info := &TxInfo{
+		Fee: new(big.Int),
+	}
+	for _, txInfo := range infos {
+		info.Fee.Add(info.Fee, txInfo.fee())
+		info.Gas += txInfo.Gas
+	}
+	return info
+}
@@ -0,0 +1,219 @@
+// Package mempoolsync shares pending txs among trusted Monomer nodes, so every node of an RPC fleet reports the same
+// pending txs, e.g., in tx_status, unconfirmed_txs, and pending_sequence, whichever node a client happens to query.
+//
+// Each node serves the txs in its own mempool with local_unconfirmed_txs, and the Syncer polls its peers' for them.
+// Peers only share their own txs, never the ones they synced, so a tx stops being reported as pending once the node
+// whose mempool it's in includes or evicts it.
+package mempoolsync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer/mempool"
+)
+
+const (
+	// DefaultInterval is how often the peers are polled by default.
+	DefaultInterval = time.Second
+	// DefaultMaxTxs is the number of txs synced from each peer by default.
+	DefaultMaxTxs = 10_000
+)
+
+// Config configures the Syncer.
+type Config struct {
+	// Peers are the CometBFT-compatible RPC endpoints of the trusted nodes whose pending txs are synced, e.g.,
+	// http://sequencer:26657.
+	Peers []string
+	// Interval is how often the peers are polled. It defaults to DefaultInterval.
+	Interval time.Duration
+	// MaxTxs is the number of txs synced from each peer. It defaults to DefaultMaxTxs.
+	MaxTxs int
+}
+
+// Syncer polls the peers for their pending txs.
+type Syncer struct {
+	peers    []string
+	clients  []*jsonrpcclient.Client
+	interval time.Duration
+	maxTxs   int
+	metrics  Metrics
+
+	mu sync.RWMutex
+	// txs are the pending txs of each peer, indexed like peers. A peer that couldn't be reached has none.
+	txs []bfttypes.Txs
+	// up reports whether the last poll of each peer succeeded, indexed like peers.
+	up []bool
+}
+
+func NewSyncer(cfg *Config, metrics Metrics) (*Syncer, error) {
+	if len(cfg.Peers) == 0 {
+		return nil, errors.New("no peers")
+	}
+	s := &Syncer{
+		peers:    cfg.Peers,
+		clients:  make([]*jsonrpcclient.Client, 0, len(cfg.Peers)),
+		interval: cfg.Interval,
+		maxTxs:   cfg.MaxTxs,
+		metrics:  metrics,
+		txs:      make([]bfttypes.Txs, len(cfg.Peers)),
+		up:       make([]bool, len(cfg.Peers)),
+	}
+	for _, peer := range cfg.Peers {
+		client, err := jsonrpcclient.New(peer)
+		if err != nil {
+			return nil, fmt.Errorf("new rpc client for peer %s: %v", peer, err)
+		}
+		s.clients = append(s.clients, client)
+	}
+	if s.interval == 0 {
+		s.interval = DefaultInterval
+	}
+	if s.maxTxs == 0 {
+		s.maxTxs = DefaultMaxTxs
+	}
+	return s, nil
+}
+
+// Run polls the peers every interval until ctx is done. Peers that can't be polled are passed to onErr and don't stop
+// it.
+func (s *Syncer) Run(ctx context.Context, onErr func(error)) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Sync(ctx); err != nil && ctx.Err() == nil {
+			onErr(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync polls every peer once. It returns the errors of the peers that couldn't be polled, whose txs are dropped until
+// they can be polled again, so txs aren't reported as pending indefinitely.
+func (s *Syncer) Sync(ctx context.Context) error {
+	var errs []error
+	var wg sync.WaitGroup
+	var errsMu sync.Mutex
+	for i, client := range s.clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := new(rpctypes.ResultUnconfirmedTxs)
+			_, err := client.Call(ctx, "local_unconfirmed_txs", map[string]any{"limit": s.maxTxs}, result)
+			s.metrics.SetPeerUp(s.peers[i], err == nil)
+			if err != nil {
+				result.Txs = nil
+				errsMu.Lock()
+				errs = append(errs, fmt.Errorf("get pending txs of peer %s: %v", s.peers[i], err))
+				errsMu.Unlock()
+			}
+			s.mu.Lock()
+			s.txs[i] = result.Txs
+			s.up[i] = err == nil
+			s.mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	s.metrics.SetTxs(len(s.Txs()))
+	return errors.Join(errs...)
+}
+
+// PeerCount returns the number of peers whose last poll succeeded.
+func (s *Syncer) PeerCount() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var count int
+	for _, up := range s.up {
+		if up {
+			count++
+		}
+	}
+	return count
+}
+
+// Txs returns the peers' pending txs, in the order of the peers and of their mempools. A tx pending with several peers
+// is only returned once.
+func (s *Syncer) Txs() bfttypes.Txs {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	seen := make(map[string]struct{})
+	var txs bfttypes.Txs
+	for _, peerTxs := range s.txs {
+		for _, tx := range peerTxs {
+			if _, ok := seen[string(tx.Hash())]; !ok {
+				seen[string(tx.Hash())] = struct{}{}
+				txs = append(txs, tx)
+			}
+		}
+	}
+	return txs
+}
+
+// Get returns the tx with the given hash if a peer has it pending, and nil otherwise.
+func (s *Syncer) Get(hash []byte) bfttypes.Tx {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, peerTxs := range s.txs {
+		for _, tx := range peerTxs {
+			if bytes.Equal(tx.Hash(), hash) {
+				return tx
+			}
+		}
+	}
+	return nil
+}
+
+// View is the node's mempool together with its peers' pending txs. Rejections are the node's own.
+type View struct {
+	*mempool.Pool
+	syncer *Syncer
+}
+
+func NewView(pool *mempool.Pool, syncer *Syncer) *View {
+	return &View{
+		Pool:   pool,
+		syncer: syncer,
+	}
+}
+
+// Get returns the tx with the given hash if it is pending with the node or one of its peers, and nil otherwise.
+func (v *View) Get(hash []byte) (bfttypes.Tx, error) {
+	tx, err := v.Pool.Get(hash)
+	if err != nil || tx != nil {
+		return tx, err
+	}
+	return v.syncer.Get(hash), nil
+}
+
+// Txs returns up to limit txs pending with the node or its peers, the node's first. A limit of zero or less returns all
+// of them.
+func (v *View) Txs(limit int) (bfttypes.Txs, error) {
+	txs, err := v.Pool.Txs(limit)
+	if err != nil {
+		return nil, err
+	}
+	local := make(map[string]struct{}, len(txs))
+	for _, tx := range txs {
+		local[string(tx.Hash())] = struct{}{}
+	}
+	for _, tx := range v.syncer.Txs() {
+		if limit > 0 && len(txs) >= limit {
+			break
+		}
+		if _, ok := local[string(tx.Hash())]; !ok {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
@@ -0,0 +1,97 @@
+package mempoolsync_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	cometserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	bfttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/polymerdao/monomer/comet"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/mempoolsync"
+	"github.com/stretchr/testify/require"
+)
+
+// newPeer serves the pool's txs with local_unconfirmed_txs.
+func newPeer(t *testing.T, pool *mempool.Pool) *httptest.Server {
+	api := comet.NewUnconfirmedTxsAPI(pool, pool, nil)
+	mux := http.NewServeMux()
+	cometserver.RegisterRPCFuncs(mux, map[string]*cometserver.RPCFunc{
+		"local_unconfirmed_txs": cometserver.NewRPCFunc(api.LocalUnconfirmedTxs, "limit"),
+	}, log.NewNopLogger())
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newPool(t *testing.T, txs ...bfttypes.Tx) *mempool.Pool {
+	pool := mempool.New(dbm.NewMemDB())
+	for _, tx := range txs {
+		require.NoError(t, pool.Enqueue(tx))
+	}
+	return pool
+}
+
+func TestNewSyncerNoPeers(t *testing.T) {
+	_, err := mempoolsync.NewSyncer(&mempoolsync.Config{}, mempoolsync.NewNoopMetrics())
+	require.Error(t, err)
+}
+
+func TestSync(t *testing.T) {
+	peer1 := newPeer(t, newPool(t, bfttypes.Tx("tx1"), bfttypes.Tx("tx2")))
+	peer2 := newPeer(t, newPool(t, bfttypes.Tx("tx2"), bfttypes.Tx("tx3")))
+	syncer, err := mempoolsync.NewSyncer(&mempoolsync.Config{
+		Peers: []string{peer1.URL, peer2.URL},
+	}, mempoolsync.NewNoopMetrics())
+	require.NoError(t, err)
+
+	require.Empty(t, syncer.Txs())
+	require.Zero(t, syncer.PeerCount())
+	require.NoError(t, syncer.Sync(context.Background()))
+	require.Equal(t, 2, syncer.PeerCount())
+	require.Equal(t, bfttypes.Txs{bfttypes.Tx("tx1"), bfttypes.Tx("tx2"), bfttypes.Tx("tx3")}, syncer.Txs())
+	require.Equal(t, bfttypes.Tx("tx3"), syncer.Get(bfttypes.Tx("tx3").Hash()))
+	require.Nil(t, syncer.Get(bfttypes.Tx("tx4").Hash()))
+
+	// The txs of a peer that can't be reached are dropped.
+	peer2.Close()
+	require.Error(t, syncer.Sync(context.Background()))
+	require.Equal(t, 1, syncer.PeerCount())
+	require.Equal(t, bfttypes.Txs{bfttypes.Tx("tx1"), bfttypes.Tx("tx2")}, syncer.Txs())
+	require.Nil(t, syncer.Get(bfttypes.Tx("tx3").Hash()))
+}
+
+func TestView(t *testing.T) {
+	peer := newPeer(t, newPool(t, bfttypes.Tx("tx2"), bfttypes.Tx("tx3")))
+	syncer, err := mempoolsync.NewSyncer(&mempoolsync.Config{
+		Peers: []string{peer.URL},
+	}, mempoolsync.NewNoopMetrics())
+	require.NoError(t, err)
+	require.NoError(t, syncer.Sync(context.Background()))
+
+	view := mempoolsync.NewView(newPool(t, bfttypes.Tx("tx1"), bfttypes.Tx("tx2")), syncer)
+
+	txs, err := view.Txs(0)
+	require.NoError(t, err)
+	require.Equal(t, bfttypes.Txs{bfttypes.Tx("tx1"), bfttypes.Tx("tx2"), bfttypes.Tx("tx3")}, txs)
+
+	txs, err = view.Txs(2)
+	require.NoError(t, err)
+	require.Equal(t, bfttypes.Txs{bfttypes.Tx("tx1"), bfttypes.Tx("tx2")}, txs)
+
+	for _, tx := range txs {
+		got, err := view.Get(tx.Hash())
+		require.NoError(t, err)
+		require.Equal(t, tx, got)
+	}
+	got, err := view.Get(bfttypes.Tx("tx3").Hash())
+	require.NoError(t, err)
+	require.Equal(t, bfttypes.Tx("tx3"), got)
+	got, err = view.Get(bfttypes.Tx("tx4").Hash())
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
@@ -0,0 +1,63 @@
+package mempoolsync
+
+import (
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "mempoolsync"
+
+// Metrics contains metrics collected from the mempoolsync package.
+type Metrics interface {
+	SetPeerUp(peer string, up bool)
+	SetTxs(txs int)
+}
+
+type metrics struct {
+	// Whether the last poll of each peer succeeded.
+	PeerUp *stdprometheus.GaugeVec
+	// Number of pending txs synced from the peers.
+	Txs stdprometheus.Gauge
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		PeerUp: promauto.NewGaugeVec(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_up",
+			Help:      "1 if the last poll of the peer's pending txs succeeded, 0 otherwise",
+		}, []string{"peer"}),
+		Txs: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "txs",
+			Help:      "Number of pending txs synced from the peers",
+		}),
+	}
+}
+
+func (m *metrics) SetPeerUp(peer string, up bool) {
+	m.PeerUp.WithLabelValues(peer).Set(boolToFloat(up))
+}
+
+func (m *metrics) SetTxs(txs int) {
+	m.Txs.Set(float64(txs))
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) SetPeerUp(string, bool) {}
+
+func (*noopMetrics) SetTxs(int) {}
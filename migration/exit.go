@@ -0,0 +1,89 @@
+package migration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// ExitConfig configures ToCometBFT. All fields are optional.
+type ExitConfig struct {
+	// ChainID replaces the rollup's chain ID.
+	ChainID string
+	// GenesisTime replaces the genesis time of the export.
+	GenesisTime time.Time
+	// DropModules are removed from the app state, e.g., rollup, if the CometBFT chain's binary doesn't have them.
+	DropModules []string
+	// Validators are the CometBFT chain's validators if the app doesn't export any, e.g., because it has no staking
+	// module.
+	Validators []cmttypes.GenesisValidator
+}
+
+// ToCometBFT turns the state a Monomer rollup exported with the Cosmos SDK's export command into a genesis a CometBFT
+// chain can launch from. The chain continues at the export's initial height. It is the reverse of Migrate, so teams
+// can always leave the rollup.
+func ToCometBFT(exported *genutiltypes.AppGenesis, cfg *ExitConfig) (*genutiltypes.AppGenesis, error) {
+	if cfg == nil {
+		cfg = &ExitConfig{}
+	}
+	exit := *exported
+	if cfg.ChainID != "" {
+		exit.ChainID = cfg.ChainID
+	}
+	if !cfg.GenesisTime.IsZero() {
+		exit.GenesisTime = cfg.GenesisTime
+	}
+
+	if len(cfg.DropModules) > 0 {
+		var appState map[string]json.RawMessage
+		if err := json.Unmarshal(exported.AppState, &appState); err != nil {
+			return nil, fmt.Errorf("unmarshal exported app state: %v", err)
+		}
+		for _, module := range cfg.DropModules {
+			if _, ok := appState[module]; !ok {
+				return nil, fmt.Errorf("module %q is not in the exported app state", module)
+			}
+			delete(appState, module)
+		}
+		appStateJSON, err := json.Marshal(appState)
+		if err != nil {
+			return nil, fmt.Errorf("marshal app state: %v", err)
+		}
+		exit.AppState = appStateJSON
+	}
+
+	// Monomer doesn't use consensus params or validators, so the export may not have them. Unset params are exported as
+	// zeros, which CometBFT rejects.
+	exit.Consensus = &genutiltypes.ConsensusGenesis{}
+	if exported.Consensus != nil {
+		*exit.Consensus = *exported.Consensus
+	}
+	if exit.Consensus.Params == nil || exit.Consensus.Params.Block.MaxBytes == 0 {
+		exit.Consensus.Params = cmttypes.DefaultConsensusParams()
+	}
+	if len(exit.Consensus.Validators) == 0 {
+		exit.Consensus.Validators = cfg.Validators
+	}
+	// Validation fills in missing addresses, which mustn't change the export or cfg.
+	exit.Consensus.Validators = slices.Clone(exit.Consensus.Validators)
+	if len(exit.Consensus.Validators) == 0 {
+		return nil, errors.New("the export has no validators and none were given")
+	}
+
+	if err := exit.ValidateAndComplete(); err != nil {
+		return nil, fmt.Errorf("validate genesis: %v", err)
+	}
+	genesisDoc, err := exit.ToGenesisDoc()
+	if err != nil {
+		return nil, fmt.Errorf("convert to cometbft genesis: %v", err)
+	}
+	if err := genesisDoc.ValidateAndComplete(); err != nil {
+		return nil, fmt.Errorf("validate cometbft genesis: %v", err)
+	}
+	return &exit, nil
+}
@@ -0,0 +1,67 @@
+package migration_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/polymerdao/monomer/migration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToCometBFT(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator := cmttypes.GenesisValidator{
+		PubKey: pubKey,
+		Power:  1,
+	}
+	exported := &genutiltypes.AppGenesis{
+		ChainID:       "901",
+		GenesisTime:   time.Unix(1, 0).UTC(),
+		InitialHeight: 501,
+		AppState:      json.RawMessage(`{"bank":{},"rollup":{}}`),
+		Consensus: &genutiltypes.ConsensusGenesis{
+			// Monomer doesn't set consensus params, so they are exported as zeros.
+			Params: &cmttypes.ConsensusParams{},
+		},
+	}
+
+	exit, err := migration.ToCometBFT(exported, &migration.ExitConfig{
+		ChainID:     "sovereign-2",
+		GenesisTime: time.Unix(2, 0).UTC(),
+		DropModules: []string{"rollup"},
+		Validators:  []cmttypes.GenesisValidator{validator},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "sovereign-2", exit.ChainID)
+	require.Equal(t, time.Unix(2, 0).UTC(), exit.GenesisTime)
+	require.Equal(t, int64(501), exit.InitialHeight)
+	require.JSONEq(t, `{"bank":{}}`, string(exit.AppState))
+	require.Equal(t, cmttypes.DefaultConsensusParams(), exit.Consensus.Params)
+	require.Len(t, exit.Consensus.Validators, 1)
+	require.Equal(t, pubKey.Address(), exit.Consensus.Validators[0].Address)
+	_, err = exit.ToGenesisDoc()
+	require.NoError(t, err)
+	// The export is left as is.
+	require.Equal(t, "901", exported.ChainID)
+	require.JSONEq(t, `{"bank":{},"rollup":{}}`, string(exported.AppState))
+
+	// Exported validators are kept.
+	exported.Consensus.Validators = []cmttypes.GenesisValidator{validator}
+	exit, err = migration.ToCometBFT(exported, nil)
+	require.NoError(t, err)
+	require.Equal(t, "901", exit.ChainID)
+	require.Equal(t, exported.AppState, exit.AppState)
+
+	exported.Consensus.Validators = nil
+	_, err = migration.ToCometBFT(exported, nil)
+	require.ErrorContains(t, err, "no validators")
+	_, err = migration.ToCometBFT(exported, &migration.ExitConfig{
+		DropModules: []string{"unknown"},
+		Validators:  []cmttypes.GenesisValidator{validator},
+	})
+	require.ErrorContains(t, err, "not in the exported app state")
+}
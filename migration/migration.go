@@ -0,0 +1,135 @@
+// Package migration converts the state of a sovereign Cosmos chain running on CometBFT into the genesis of a Monomer
+// rollup, recording how the two chains line up.
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// Record is the bookkeeping of a migration, kept next to the Monomer genesis.
+type Record struct {
+	// SourceChainID is the chain ID of the CometBFT chain.
+	SourceChainID string `json:"sourceChainId"`
+	// ChainID is the numeric chain ID of the rollup.
+	ChainID string `json:"chainId"`
+	// ExportHeight is the last height of the CometBFT chain. The rollup's genesis block has its state at this height.
+	ExportHeight int64 `json:"exportHeight"`
+	// HeightOffset is added to a rollup height to get the matching height of the CometBFT chain, so the rollup's
+	// genesis block at height 1 matches ExportHeight.
+	HeightOffset int64 `json:"heightOffset"`
+	// SourceGenesisTime is the genesis time of the CometBFT chain. The rollup's genesis time is the base genesis time
+	// instead, since op-node derives the time of each block from it.
+	SourceGenesisTime time.Time `json:"sourceGenesisTime"`
+	// Validators are the CometBFT chain's validators at ExportHeight. The rollup doesn't use them.
+	Validators []*Validator `json:"validators"`
+	// Sequencer is the consensus address of the validator whose operator runs the rollup's sequencer.
+	Sequencer string `json:"sequencer,omitempty"`
+}
+
+// Validator is a validator of the CometBFT chain.
+type Validator struct {
+	// Address is the hex-encoded consensus address.
+	Address string `json:"address"`
+	Name    string `json:"name,omitempty"`
+	Power   int64  `json:"power"`
+}
+
+// Migrate converts the genesis exported from a CometBFT chain into a Monomer genesis. The app state comes from
+// exported. Everything else, including the numeric chain ID and the genesis time, comes from base, usually the genesis
+// `init` writes for the rollup's binary, as do the states of modules the CometBFT chain didn't have, e.g., x/rollup.
+//
+// The sequencer is the consensus address or name of the validator whose operator runs the rollup's sequencer. If it is
+// empty, the validator with the most power is chosen.
+func Migrate(exported, base *genutiltypes.AppGenesis, sequencer string) (*genutiltypes.AppGenesis, *Record, error) {
+	if _, err := strconv.ParseUint(base.ChainID, 10, 64); err != nil {
+		return nil, nil, fmt.Errorf("base genesis chain id %q is not numeric", base.ChainID)
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(base.AppState, &appState); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal base app state: %v", err)
+	}
+	if appState == nil {
+		appState = make(map[string]json.RawMessage)
+	}
+	var exportedAppState map[string]json.RawMessage
+	if err := json.Unmarshal(exported.AppState, &exportedAppState); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal exported app state: %v", err)
+	}
+	for module, moduleState := range exportedAppState {
+		appState[module] = moduleState
+	}
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal app state: %v", err)
+	}
+
+	migrated := &genutiltypes.AppGenesis{
+		AppName:     base.AppName,
+		AppVersion:  base.AppVersion,
+		GenesisTime: base.GenesisTime,
+		ChainID:     base.ChainID,
+		// Monomer always commits the genesis at height 1.
+		InitialHeight: 1,
+		AppState:      appStateJSON,
+	}
+	if base.Consensus != nil {
+		migrated.Consensus = &genutiltypes.ConsensusGenesis{
+			Params: base.Consensus.Params,
+		}
+	}
+
+	record, err := newRecord(exported, base.ChainID, sequencer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return migrated, record, nil
+}
+
+func newRecord(exported *genutiltypes.AppGenesis, chainID, sequencer string) (*Record, error) {
+	exportHeight := exported.InitialHeight - 1
+	if exportHeight < 0 {
+		exportHeight = 0
+	}
+	record := &Record{
+		SourceChainID:     exported.ChainID,
+		ChainID:           chainID,
+		ExportHeight:      exportHeight,
+		HeightOffset:      exportHeight - 1,
+		SourceGenesisTime: exported.GenesisTime,
+		Validators:        []*Validator{},
+	}
+	if exported.Consensus != nil {
+		for _, validator := range exported.Consensus.Validators {
+			record.Validators = append(record.Validators, &Validator{
+				Address: validator.Address.String(),
+				Name:    validator.Name,
+				Power:   validator.Power,
+			})
+		}
+	}
+	sort.SliceStable(record.Validators, func(i, j int) bool {
+		return record.Validators[i].Power > record.Validators[j].Power
+	})
+
+	if sequencer == "" {
+		if len(record.Validators) > 0 {
+			record.Sequencer = record.Validators[0].Address
+		}
+		return record, nil
+	}
+	for _, validator := range record.Validators {
+		if strings.EqualFold(validator.Address, strings.TrimPrefix(sequencer, "0x")) || validator.Name == sequencer {
+			record.Sequencer = validator.Address
+			return record, nil
+		}
+	}
+	return nil, fmt.Errorf("sequencer %q is not a validator of the exported chain", sequencer)
+}
@@ -0,0 +1,75 @@
+package migration_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/polymerdao/monomer/migration"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	small := ed25519.GenPrivKey().PubKey()
+	large := ed25519.GenPrivKey().PubKey()
+	exported := &genutiltypes.AppGenesis{
+		ChainID:       "sovereign-1",
+		GenesisTime:   time.Unix(1, 0).UTC(),
+		InitialHeight: 1001,
+		AppState:      json.RawMessage(`{"bank":{"exported":true},"staking":{"exported":true}}`),
+		Consensus: &genutiltypes.ConsensusGenesis{
+			Validators: []cmttypes.GenesisValidator{
+				{Address: small.Address(), PubKey: small, Power: 1, Name: "small"},
+				{Address: large.Address(), PubKey: large, Power: 10, Name: "large"},
+			},
+		},
+	}
+	base := &genutiltypes.AppGenesis{
+		AppName:       "appd",
+		ChainID:       "901",
+		GenesisTime:   time.Unix(2, 0).UTC(),
+		InitialHeight: 1,
+		AppState:      json.RawMessage(`{"bank":{"exported":false},"rollup":{"default":true}}`),
+		Consensus: &genutiltypes.ConsensusGenesis{
+			Params: cmttypes.DefaultConsensusParams(),
+		},
+	}
+
+	migrated, record, err := migration.Migrate(exported, base, "")
+	require.NoError(t, err)
+	require.Equal(t, "appd", migrated.AppName)
+	require.Equal(t, "901", migrated.ChainID)
+	require.Equal(t, base.GenesisTime, migrated.GenesisTime)
+	require.Equal(t, int64(1), migrated.InitialHeight)
+	require.JSONEq(t, `{"bank":{"exported":true},"staking":{"exported":true},"rollup":{"default":true}}`, string(migrated.AppState))
+	require.Empty(t, migrated.Consensus.Validators)
+	require.Equal(t, base.Consensus.Params, migrated.Consensus.Params)
+	require.Equal(t, &migration.Record{
+		SourceChainID:     "sovereign-1",
+		ChainID:           "901",
+		ExportHeight:      1000,
+		HeightOffset:      999,
+		SourceGenesisTime: exported.GenesisTime,
+		Validators: []*migration.Validator{
+			{Address: large.Address().String(), Name: "large", Power: 10},
+			{Address: small.Address().String(), Name: "small", Power: 1},
+		},
+		Sequencer: large.Address().String(),
+	}, record)
+
+	_, record, err = migration.Migrate(exported, base, "small")
+	require.NoError(t, err)
+	require.Equal(t, small.Address().String(), record.Sequencer)
+	_, record, err = migration.Migrate(exported, base, "0x"+small.Address().String())
+	require.NoError(t, err)
+	require.Equal(t, small.Address().String(), record.Sequencer)
+
+	_, _, err = migration.Migrate(exported, base, "unknown")
+	require.ErrorContains(t, err, "not a validator")
+	base.ChainID = "rollup-1"
+	_, _, err = migration.Migrate(exported, base, "")
+	require.ErrorContains(t, err, "not numeric")
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
@@ -15,16 +16,74 @@ var (
 		Use:   "monogen",
 		Short: "monogen scaffolds a Monomer project.",
 		Long: "monogen scaffolds a Monomer project. " +
-			"The resulting project is compatible with the ignite tool (https://github.com/ignite/cli).",
+			"The resulting project is compatible with the ignite tool (https://github.com/ignite/cli). " +
+			"It includes an e2e smoke test, run with `go test -tags e2e ./e2e`, that starts a single-node chain and checks that it builds blocks and serves the optional modules. " +
+			"Projects with the wasm module also get an e2e test that withdraws from a contract and proves the withdrawal on L1.",
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return monogen.Generate(cmd.Context(), appDirPath, goModulePath, addressPrefix, skipGit, false)
+			features := &monogen.Features{
+				DockerCompose: withDockerCompose,
+				Helm:          withHelm,
+			}
+			if withWasm {
+				features.Modules = append(features.Modules, monogen.ModuleWasm)
+			}
+			if withTokenFactory {
+				features.Modules = append(features.Modules, monogen.ModuleTokenFactory)
+			}
+			if withOracle {
+				features.Modules = append(features.Modules, monogen.ModuleOracle)
+			}
+			return monogen.Generate(cmd.Context(), appDirPath, goModulePath, addressPrefix, skipGit, false, features)
 		},
 	}
 
-	skipGit       bool
-	appDirPath    string
-	goModulePath  string
-	addressPrefix string
+	upgradeCmd = &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade a Monomer project generated by a previous version of monogen.",
+		Long: "Upgrade a Monomer project generated by a previous version of monogen. " +
+			"Files that were not modified since they were generated are updated in place. " +
+			"Changes to modified files are written to a patch file instead, to be reviewed and applied with `git apply`.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			report, err := monogen.Upgrade(cmd.Context(), appDirPath, dryRun, false)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			for _, section := range []struct {
+				name  string
+				paths []string
+			}{
+				{"added", report.Added},
+				{"updated", report.Updated},
+				{"conflict", report.Conflicts},
+				{"obsolete", report.Obsolete},
+			} {
+				for _, path := range section.paths {
+					fmt.Fprintf(out, "%-9s %s\n", section.name, path)
+				}
+			}
+			if len(report.Patch) == 0 {
+				return nil
+			}
+			if err := os.WriteFile(patchFilePath, report.Patch, 0o644); err != nil { //nolint:mnd
+				return fmt.Errorf("write patch file: %v", err)
+			}
+			fmt.Fprintf(out, "wrote unapplied changes to %s\n", patchFilePath)
+			return nil
+		},
+	}
+
+	skipGit           bool
+	withWasm          bool
+	withTokenFactory  bool
+	withOracle        bool
+	withDockerCompose bool
+	withHelm          bool
+	appDirPath        string
+	goModulePath      string
+	addressPrefix     string
+	dryRun            bool
+	patchFilePath     string
 )
 
 func main() {
@@ -35,6 +94,16 @@ func main() {
 	rootCmd.Flags().StringVar(&appDirPath, "app-dir-path", "./testapp", "project directory")
 	rootCmd.Flags().StringVar(&goModulePath, "gomod-path", "github.com/testapp/testapp", "go module path")
 	rootCmd.Flags().StringVar(&addressPrefix, "address-prefix", "cosmos", "address prefix")
+	rootCmd.Flags().BoolVar(&withWasm, "with-wasm", false, "wire the CosmWasm x/wasm module, with the rollup module's custom messages and queries, into the project")
+	rootCmd.Flags().BoolVar(&withTokenFactory, "with-tokenfactory", false, "wire Monomer's x/tokenfactory module, which lets accounts create and mint their own denoms, into the project")
+	rootCmd.Flags().BoolVar(&withOracle, "with-oracle", false, "wire Monomer's x/oracle module, which keeps the prices posted by governance-approved feeders, into the project")
+	rootCmd.Flags().BoolVar(&withDockerCompose, "with-docker-compose", false, "generate a docker-compose deployment of the sequencer and OP Stack services")
+	rootCmd.Flags().BoolVar(&withHelm, "with-helm", false, "generate a Helm chart of the sequencer and OP Stack services")
+
+	upgradeCmd.Flags().StringVar(&appDirPath, "app-dir-path", ".", "project directory")
+	upgradeCmd.Flags().BoolVar(&dryRun, "dry-run", false, "write all changes to the patch file without modifying the project")
+	upgradeCmd.Flags().StringVar(&patchFilePath, "patch-file", "monogen-upgrade.patch", "file to write unapplied changes to")
+	rootCmd.AddCommand(upgradeCmd)
 
 	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		cancel()   // cancel is not called on os.Exit, we have to call it manually
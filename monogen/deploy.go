@@ -0,0 +1,124 @@
+package monogen
+
+import (
+	"bytes"
+	"crypto/rand"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gobuffalo/genny/v2"
+)
+
+const deployTemplatesDir = "templates/deploy"
+
+//go:embed all:templates/deploy
+var deployTemplates embed.FS
+
+type deployTemplateData struct {
+	AppName            string
+	BinaryName         string
+	JWTSecret          string
+	BatcherPrivateKey  string
+	BatcherAddress     string
+	ProposerPrivateKey string
+	ProposerAddress    string
+	Helm               bool
+}
+
+// addDeploymentManifests renders the deployment templates into the deploy directory of the project.
+// Fresh secrets are generated for each project and written to deploy/.env, which is ignored by git.
+func addDeploymentManifests(r *genny.Runner, appDir string, withHelm bool) error {
+	appName := filepath.Base(appDir)
+	data, err := newDeployTemplateData(appName, withHelm)
+	if err != nil {
+		return fmt.Errorf("new deploy template data: %v", err)
+	}
+
+	if err := fs.WalkDir(deployTemplates, deployTemplatesDir, func(templatePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		relPath := strings.TrimPrefix(templatePath, deployTemplatesDir+"/")
+		if chartPath, ok := strings.CutPrefix(relPath, "helm/app/"); ok {
+			if !withHelm {
+				return nil
+			}
+			relPath = path.Join("helm", appName, chartPath)
+		}
+
+		content, err := deployTemplates.ReadFile(templatePath)
+		if err != nil {
+			return fmt.Errorf("read %s: %v", templatePath, err)
+		}
+		// Files without the .tmpl suffix are copied verbatim, e.g., Helm templates that use the same delimiters.
+		if trimmedPath, ok := strings.CutSuffix(relPath, ".tmpl"); ok {
+			relPath = trimmedPath
+			tmpl, err := template.New(relPath).Delims("[[", "]]").Parse(string(content))
+			if err != nil {
+				return fmt.Errorf("parse %s: %v", templatePath, err)
+			}
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, data); err != nil {
+				return fmt.Errorf("execute %s: %v", templatePath, err)
+			}
+			content = buf.Bytes()
+		}
+		if relPath == "env" {
+			relPath = ".env"
+		}
+
+		filePath := filepath.Join(appDir, "deploy", filepath.FromSlash(relPath))
+		if err := r.File(genny.NewFileB(filePath, content)); err != nil {
+			return fmt.Errorf("write %s: %v", filePath, err)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("render deploy templates: %v", err)
+	}
+
+	gitignorePath := filepath.Join(appDir, ".gitignore")
+	gitignore, err := r.Disk.Find(gitignorePath)
+	if err != nil {
+		return fmt.Errorf("find: %v", err)
+	}
+	if err := r.File(genny.NewFileS(gitignorePath, gitignore.String()+"deploy/.env\n")); err != nil {
+		return fmt.Errorf("write %s: %v", gitignorePath, err)
+	}
+	return nil
+}
+
+func newDeployTemplateData(appName string, withHelm bool) (*deployTemplateData, error) {
+	jwtSecret := make([]byte, 32) //nolint:mnd
+	if _, err := rand.Read(jwtSecret); err != nil {
+		return nil, fmt.Errorf("generate jwt secret: %v", err)
+	}
+	batcherKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("generate batcher key: %v", err)
+	}
+	proposerKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("generate proposer key: %v", err)
+	}
+	return &deployTemplateData{
+		AppName:            appName,
+		BinaryName:         appName + "d",
+		JWTSecret:          "0x" + hex.EncodeToString(jwtSecret),
+		BatcherPrivateKey:  hex.EncodeToString(crypto.FromECDSA(batcherKey)),
+		BatcherAddress:     crypto.PubkeyToAddress(batcherKey.PublicKey).Hex(),
+		ProposerPrivateKey: hex.EncodeToString(crypto.FromECDSA(proposerKey)),
+		ProposerAddress:    crypto.PubkeyToAddress(proposerKey.PublicKey).Hex(),
+		Helm:               withHelm,
+	}, nil
+}
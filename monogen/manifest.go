@@ -0,0 +1,157 @@
+package monogen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	cometos "github.com/cometbft/cometbft/libs/os"
+	"golang.org/x/mod/modfile"
+)
+
+const (
+	manifestFileName = ".monogen.json"
+	manifestVersion  = 1
+)
+
+// untrackedPaths are generated once and never touched by upgrades, either because they hold secrets that are unique to
+// the project or because they are derived from other files.
+var untrackedPaths = map[string]bool{
+	"go.sum":      true,
+	"deploy/.env": true,
+}
+
+// manifest records how a project was generated so it can be upgraded later.
+type manifest struct {
+	Version       int       `json:"version"`
+	GoModulePath  string    `json:"goModulePath"`
+	AddressPrefix string    `json:"addressPrefix"`
+	Features      *Features `json:"features"`
+	// Files maps slash-separated paths relative to the project root to the sha256 hash of their generated contents.
+	Files map[string]string `json:"files"`
+}
+
+func newManifest(appDir, goModulePath, addressPrefix string, features *Features) (*manifest, error) {
+	m := &manifest{
+		Version:       manifestVersion,
+		GoModulePath:  goModulePath,
+		AddressPrefix: addressPrefix,
+		Features:      features,
+		Files:         make(map[string]string),
+	}
+	if err := filepath.WalkDir(appDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(appDir, path)
+		if err != nil {
+			return fmt.Errorf("get relative path: %v", err)
+		}
+		relPath = filepath.ToSlash(relPath)
+		if d.IsDir() {
+			if relPath == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if relPath == manifestFileName || untrackedPaths[relPath] || isSecretPath(m, relPath) {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %s: %v", path, err)
+		}
+		m.Files[relPath] = hashContent(content)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("walk %s: %v", appDir, err)
+	}
+	return m, nil
+}
+
+// isSecretPath reports whether the file holds secrets generated for the project, like the Helm chart values.
+func isSecretPath(m *manifest, relPath string) bool {
+	matched, err := filepath.Match("deploy/helm/*/values.yaml", relPath)
+	return m.Features.Helm && err == nil && matched
+}
+
+func readManifest(appDir string) (*manifest, error) {
+	content, err := os.ReadFile(filepath.Join(appDir, manifestFileName))
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+	m := new(manifest)
+	if err := json.Unmarshal(content, m); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %v", err)
+	}
+	if m.Version != manifestVersion {
+		return nil, fmt.Errorf("unsupported manifest version: %d", m.Version)
+	}
+	if m.Features == nil {
+		m.Features = &Features{}
+	}
+	return m, nil
+}
+
+func writeManifest(appDir string, m *manifest) error {
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(appDir, manifestFileName), append(content, '\n'), 0o644); err != nil { //nolint:mnd
+		return fmt.Errorf("write manifest: %v", err)
+	}
+	return nil
+}
+
+var addressPrefixRegexp = regexp.MustCompile(`AccountAddressPrefix\s*=\s*"(\w+)"`)
+
+// loadManifest reads the project's manifest. Projects generated before manifests were introduced have their
+// generation options inferred from the project files and no file hashes, so every difference is treated as a conflict.
+func loadManifest(appDir string) (*manifest, error) {
+	m, err := readManifest(appDir)
+	if err == nil {
+		return m, nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	goMod, err := os.ReadFile(filepath.Join(appDir, "go.mod"))
+	if err != nil {
+		return nil, fmt.Errorf("read go.mod: %v", err)
+	}
+	appGo, err := os.ReadFile(filepath.Join(appDir, "app", "app.go"))
+	if err != nil {
+		return nil, fmt.Errorf("read app.go: %v", err)
+	}
+	matches := addressPrefixRegexp.FindSubmatch(appGo)
+	if matches == nil {
+		return nil, errors.New("find account address prefix in app.go")
+	}
+
+	features := &Features{
+		DockerCompose: cometos.FileExists(filepath.Join(appDir, "deploy", "docker-compose.yml")),
+		Helm:          cometos.FileExists(filepath.Join(appDir, "deploy", "helm")),
+	}
+	if cometos.FileExists(filepath.Join(appDir, "app", "wasm.go")) {
+		features.Modules = append(features.Modules, ModuleWasm)
+	}
+	return &manifest{
+		Version:       manifestVersion,
+		GoModulePath:  modfile.ModulePath(goMod),
+		AddressPrefix: string(matches[1]),
+		Features:      features,
+		Files:         make(map[string]string),
+	}, nil
+}
+
+func hashContent(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
@@ -1,10 +1,15 @@
 package monogen
 
 import (
+	"bytes"
 	"context"
+	_ "embed"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
 
 	cometos "github.com/cometbft/cometbft/libs/os"
 	"github.com/gobuffalo/genny/v2"
@@ -18,10 +23,59 @@ import (
 	"github.com/ignite/cli/v28/ignite/version"
 )
 
-func Generate(ctx context.Context, appDirPath, goModulePath, addressPrefix string, skipGit, isTest bool) error {
+// Module is an optional module that can be wired into the generated project.
+type Module string
+
+const (
+	// ModuleWasm wires the CosmWasm x/wasm module into the generated project.
+	ModuleWasm Module = "wasm"
+	// ModuleTokenFactory wires Monomer's x/tokenfactory module into the generated project.
+	ModuleTokenFactory Module = "tokenfactory"
+	// ModuleOracle wires Monomer's x/oracle module into the generated project.
+	ModuleOracle Module = "oracle"
+)
+
+// Features configures the optional parts of the generated project.
+type Features struct {
+	// Modules are wired into the app in addition to the default modules.
+	Modules []Module `json:"modules,omitempty"`
+	// DockerCompose emits a docker-compose deployment of the sequencer, op-node, op-batcher, and op-proposer.
+	DockerCompose bool `json:"dockerCompose,omitempty"`
+	// Helm emits a Helm chart of the same services alongside the docker-compose deployment.
+	Helm bool `json:"helm,omitempty"`
+}
+
+//go:embed templates/wasm.go.tmpl
+var wasmGoTemplate string
+
+//go:embed templates/smoke_test.go.tmpl
+var smokeTestTemplate string
+
+//go:embed templates/withdrawal_test.go.tmpl
+var withdrawalTestTemplate string
+
+// The contract the withdrawal test deploys and its source.
+var (
+	//go:embed templates/forwarder.wasm
+	forwarderWasm []byte
+	//go:embed templates/forwarder.wat
+	forwarderWat []byte
+)
+
+func Generate(ctx context.Context, appDirPath, goModulePath, addressPrefix string, skipGit, isTest bool, features *Features) error {
 	if cometos.FileExists(appDirPath) {
 		return fmt.Errorf("refusing to overwrite directory: %s", appDirPath)
 	}
+	if features == nil {
+		features = &Features{}
+	}
+	for _, m := range features.Modules {
+		switch m {
+		case ModuleWasm, ModuleTokenFactory, ModuleOracle:
+		default:
+			return fmt.Errorf("unsupported module: %s", m)
+		}
+	}
 
 	igniteRootDir, err := config.DirPath()
 	if err != nil {
@@ -65,12 +119,37 @@ func Generate(ctx context.Context, appDirPath, goModulePath, addressPrefix strin
 			return fmt.Errorf("remove consensus module: %v", err)
 		}
 		appName := filepath.Base(appDir)
+		rootGoPath := filepath.Join(appDir, "cmd", appName+"d", "cmd", "root.go")
+		for _, m := range features.Modules {
+			switch m {
+			case ModuleWasm:
+				if err := addWasmModule(r, appGoPath, appConfigGoPath, rootGoPath); err != nil {
+					return fmt.Errorf("add wasm module: %v", err)
+				}
+			case ModuleTokenFactory:
+				if err := addMonomerModule(r, appGoPath, appConfigGoPath, "tokenfactory", "TokenFactoryKeeper", "authtypes.Minter", "authtypes.Burner"); err != nil {
+					return fmt.Errorf("add tokenfactory module: %v", err)
+				}
+			case ModuleOracle:
+				if err := addMonomerModule(r, appGoPath, appConfigGoPath, "oracle", "OracleKeeper"); err != nil {
+					return fmt.Errorf("add oracle module: %v", err)
+				}
+			}
+		}
+		if err := addE2ETests(r, appDir, features); err != nil {
+			return fmt.Errorf("add e2e tests: %v", err)
+		}
 		if err := addMonomerCommand(r, filepath.Join(appDir, "cmd", appName+"d", "cmd", "commands.go")); err != nil {
 			return fmt.Errorf("add monomer command: %v", err)
 		}
 		if err := addReplaceDirectives(r, filepath.Join(appDir, "go.mod"), isTest); err != nil {
 			return fmt.Errorf("add replace directives: %v", err)
 		}
+		if features.DockerCompose || features.Helm {
+			if err := addDeploymentManifests(r, appDir, features.Helm); err != nil {
+				return fmt.Errorf("add deployment manifests: %v", err)
+			}
+		}
 		return nil
 	})
 	r := genny.WetRunner(ctx)
@@ -91,6 +170,15 @@ func Generate(ctx context.Context, appDirPath, goModulePath, addressPrefix strin
 		return fmt.Errorf("go mod tidy: %v", err)
 	}
 
+	// Record the generated files so the project can be upgraded later.
+	m, err := newManifest(appDir, goModulePath, addressPrefix, features)
+	if err != nil {
+		return fmt.Errorf("new manifest: %v", err)
+	}
+	if err := writeManifest(appDir, m); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -165,6 +253,226 @@ func addRollupModule(r *genny.Runner, appGoPath, appConfigGoPath string) error {
 	return nil
 }
 
+func addWasmModule(r *genny.Runner, appGoPath, appConfigGoPath, rootGoPath string) error {
+	replacer := placeholder.New()
+
+	// Modify the app config, usually at app/app_config.go.
+	appConfigGo, err := r.Disk.Find(appConfigGoPath)
+	if err != nil {
+		return fmt.Errorf("find: %v", err)
+	}
+
+	// 1. Import the wasm types.
+	content, err := xast.AppendImports(
+		appConfigGo.String(),
+		xast.WithLastNamedImport("wasmtypes", "github.com/CosmWasm/wasmd/x/wasm/types"),
+	)
+	if err != nil {
+		return fmt.Errorf("append wasm module imports to %s: %v", appConfigGoPath, err)
+	}
+
+	// 2. Add the wasm module to the genesis, begin blocker, and end blocker orders.
+	for _, p := range []string{module.PlaceholderSgAppInitGenesis, module.PlaceholderSgAppBeginBlockers, module.PlaceholderSgAppEndBlockers} {
+		content = replacer.Replace(content, p, fmt.Sprintf(`wasmtypes.ModuleName,
+		%s`, p))
+	}
+
+	// 3. Modify wasm module account permissions.
+	content = replacer.Replace(
+		content,
+		module.PlaceholderSgAppMaccPerms,
+		fmt.Sprintf(`{Account: wasmtypes.ModuleName, Permissions: []string{authtypes.Burner}},
+		%s`, module.PlaceholderSgAppMaccPerms),
+	)
+
+	if err := r.File(genny.NewFileS(appConfigGoPath, content)); err != nil {
+		return fmt.Errorf("write %s: %v", appConfigGoPath, err)
+	}
+
+	// Modify the main application file, usually at app/app.go.
+	appGo, err := r.Disk.Find(appGoPath)
+	if err != nil {
+		return fmt.Errorf("find: %v", err)
+	}
+
+	// 1. Import the wasm keeper.
+	content, err = xast.AppendImports(
+		appGo.String(),
+		xast.WithLastNamedImport("wasmkeeper", "github.com/CosmWasm/wasmd/x/wasm/keeper"),
+	)
+	if err != nil {
+		return fmt.Errorf("append wasm module imports to %s: %v", appGoPath, err)
+	}
+
+	// 2. Add wasm module keeper declaration.
+	content = replacer.Replace(content, module.PlaceholderSgAppKeeperDeclaration, fmt.Sprintf(`WasmKeeper wasmkeeper.Keeper
+	%s`, module.PlaceholderSgAppKeeperDeclaration))
+
+	// 3. Register the wasm module after the app is built, since it does not support dependency injection.
+	content = replacer.Replace(content, `app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
+`, `app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
+
+	// register legacy modules
+	if err := app.registerWasmModule(appOpts); err != nil {
+		return nil, err
+	}
+`)
+
+	if err := r.File(genny.NewFileS(appGoPath, content)); err != nil {
+		return fmt.Errorf("write %s: %v", appGoPath, err)
+	}
+
+	// Add the wasm module registration, usually at app/wasm.go.
+	wasmGoPath := filepath.Join(filepath.Dir(appGoPath), "wasm.go")
+	if err := r.File(genny.NewFileS(wasmGoPath, wasmGoTemplate)); err != nil {
+		return fmt.Errorf("write %s: %v", wasmGoPath, err)
+	}
+
+	// Modify the root command, usually at cmd/<app>d/cmd/root.go.
+	rootGo, err := r.Disk.Find(rootGoPath)
+	if err != nil {
+		return fmt.Errorf("find: %v", err)
+	}
+
+	// 1. Import the wasm module.
+	content, err = xast.AppendImports(
+		rootGo.String(),
+		xast.WithLastImport("github.com/CosmWasm/wasmd/x/wasm"),
+		xast.WithLastNamedImport("wasmtypes", "github.com/CosmWasm/wasmd/x/wasm/types"),
+	)
+	if err != nil {
+		return fmt.Errorf("append wasm module imports to %s: %v", rootGoPath, err)
+	}
+
+	// 2. Register the wasm module on the client side so it is included in the genesis and the codec.
+	content = replacer.Replace(content, `	initRootCmd(rootCmd, clientCtx.TxConfig, moduleBasicManager)`, `	// Since the wasm module doesn't support dependency injection, we need to
+	// manually register the module on the client side.
+	moduleBasicManager[wasmtypes.ModuleName] = wasm.AppModuleBasic{}
+	wasm.AppModuleBasic{}.RegisterInterfaces(clientCtx.InterfaceRegistry)
+
+	initRootCmd(rootCmd, clientCtx.TxConfig, moduleBasicManager)`)
+
+	if err := r.File(genny.NewFileS(rootGoPath, content)); err != nil {
+		return fmt.Errorf("write %s: %v", rootGoPath, err)
+	}
+
+	return nil
+}
+
+// addMonomerModule wires the Monomer module at x/<name> into the app with dependency injection, like the rollup module.
+// Unlike the rollup module, the module is optional, so it is also added to the genesis order. The module account is
+// registered with permissions.
+func addMonomerModule(r *genny.Runner, appGoPath, appConfigGoPath, name, keeperName string, permissions ...string) error {
+	replacer := placeholder.New()
+	typesAlias := name + "types"
+	moduleAlias := name + "modulev1"
+
+	// Modify the app config, usually at app/app_config.go.
+	appConfigGo, err := r.Disk.Find(appConfigGoPath)
+	if err != nil {
+		return fmt.Errorf("find: %v", err)
+	}
+
+	// 1. Import the module's types and config.
+	content, err := xast.AppendImports(
+		appConfigGo.String(),
+		xast.WithLastNamedImport(typesAlias, "github.com/polymerdao/monomer/x/"+name+"/types"),
+		xast.WithLastNamedImport(moduleAlias, "github.com/polymerdao/monomer/gen/"+name+"/module/v1"),
+	)
+	if err != nil {
+		return fmt.Errorf("append %s module imports to %s: %v", name, appConfigGoPath, err)
+	}
+
+	// 2. Add the module to the genesis order.
+	content = replacer.Replace(content, module.PlaceholderSgAppInitGenesis, fmt.Sprintf(`%s.ModuleName,
+		%s`, typesAlias, module.PlaceholderSgAppInitGenesis))
+
+	// 3. Modify the module account permissions.
+	maccPerm := fmt.Sprintf("{Account: %s.ModuleName}", typesAlias)
+	if len(permissions) > 0 {
+		maccPerm = fmt.Sprintf("{Account: %s.ModuleName, Permissions: []string{%s}}", typesAlias, strings.Join(permissions, ", "))
+	}
+	content = replacer.Replace(content, module.PlaceholderSgAppMaccPerms, fmt.Sprintf(`%s,
+		%s`, maccPerm, module.PlaceholderSgAppMaccPerms))
+
+	// 4. Add the module to the app config.
+	content = replacer.Replace(content, module.PlaceholderSgAppModuleConfig, fmt.Sprintf(`{
+				Name:   %s.ModuleName,
+				Config: appconfig.WrapAny(&%s.Module{}),
+			},
+			%s`, typesAlias, moduleAlias, module.PlaceholderSgAppModuleConfig))
+
+	if err := r.File(genny.NewFileS(appConfigGoPath, content)); err != nil {
+		return fmt.Errorf("write %s: %v", appConfigGoPath, err)
+	}
+
+	// Modify the main application file, usually at app/app.go.
+	appGo, err := r.Disk.Find(appGoPath)
+	if err != nil {
+		return fmt.Errorf("find: %v", err)
+	}
+
+	// 1. Import the module and its keeper.
+	keeperAlias := name + "keeper"
+	content, err = xast.AppendImports(
+		appGo.String(),
+		xast.WithLastNamedImport(keeperAlias, "github.com/polymerdao/monomer/x/"+name+"/keeper"),
+		xast.WithLastNamedImport("_", "github.com/polymerdao/monomer/x/"+name),
+	)
+	if err != nil {
+		return fmt.Errorf("append %s module imports to %s: %v", name, appGoPath, err)
+	}
+
+	// 2. Add the module keeper declaration.
+	content = replacer.Replace(content, module.PlaceholderSgAppKeeperDeclaration, fmt.Sprintf(`%s *%s.Keeper
+	%s`, keeperName, keeperAlias, module.PlaceholderSgAppKeeperDeclaration))
+
+	// 3. Add the module keeper definition.
+	content = replacer.Replace(content, module.PlaceholderSgAppKeeperDefinition, fmt.Sprintf(`&app.%s,
+		%s`, keeperName, module.PlaceholderSgAppKeeperDefinition))
+
+	if err := r.File(genny.NewFileS(appGoPath, content)); err != nil {
+		return fmt.Errorf("write %s: %v", appGoPath, err)
+	}
+
+	return nil
+}
+
+// addE2ETests adds e2e tests to the project's e2e directory. smoke_test.go builds the app, starts a single-node chain
+// with local consensus, and checks that it builds blocks and serves the optional modules. Projects with the wasm module
+// also get withdrawal_test.go, which withdraws from a contract and proves the withdrawal on L1.
+func addE2ETests(r *genny.Runner, appDir string, features *Features) error {
+	wasm := slices.Contains(features.Modules, ModuleWasm)
+	tmpl, err := template.New("smoke_test.go").Delims("[[", "]]").Parse(smokeTestTemplate)
+	if err != nil {
+		return fmt.Errorf("parse smoke test template: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]any{
+		"BinaryName":   filepath.Base(appDir) + "d",
+		"Wasm":         wasm,
+		"TokenFactory": slices.Contains(features.Modules, ModuleTokenFactory),
+		"Oracle":       slices.Contains(features.Modules, ModuleOracle),
+	}); err != nil {
+		return fmt.Errorf("execute smoke test template: %v", err)
+	}
+	e2eDir := filepath.Join(appDir, "e2e")
+	files := []genny.File{genny.NewFileB(filepath.Join(e2eDir, "smoke_test.go"), buf.Bytes())}
+	if wasm {
+		files = append(files,
+			genny.NewFileS(filepath.Join(e2eDir, "withdrawal_test.go"), withdrawalTestTemplate),
+			genny.NewFileB(filepath.Join(e2eDir, "testdata", "forwarder.wasm"), forwarderWasm),
+			genny.NewFileB(filepath.Join(e2eDir, "testdata", "forwarder.wat"), forwarderWat),
+		)
+	}
+	for _, file := range files {
+		if err := r.File(file); err != nil {
+			return fmt.Errorf("write %s: %v", file.Name(), err)
+		}
+	}
+	return nil
+}
+
 func removeConsensusModule(r *genny.Runner, appGoPath, appConfigGoPath string) error {
 	replacer := placeholder.New()
 
@@ -233,7 +541,11 @@ func addMonomerCommand(r *genny.Runner, commandsGoPath string) error {
 
 	content = replacer.Replace(content, `
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, appExport, addModuleInitFlags)`, `
-	integrations.AddMonomerCommand(rootCmd, newApp, app.DefaultNodeHome)`)
+	integrations.AddMonomerCommand(rootCmd, newApp, app.DefaultNodeHome,
+		integrations.InitCommand(basicManager, app.DefaultNodeHome),
+		integrations.GenesisCommand(txConfig, basicManager, app.DefaultNodeHome),
+		integrations.KeysCommand(),
+	)`)
 
 	if err := r.File(genny.NewFileS(commandsGoPath, content)); err != nil {
 		return fmt.Errorf("write %s: %v", commandsGoPath, err)
@@ -14,21 +14,55 @@ import (
 )
 
 func TestGenerate(t *testing.T) {
-	const appName = "testapp"
-	rootDirPath := t.TempDir()
-	appDirPath := filepath.Join(rootDirPath, appName)
-	// Generate project.
-	require.NoError(t, monogen.Generate(context.Background(), appDirPath, "github.com/test/"+appName, "test", true, true))
+	for description, features := range map[string]*monogen.Features{
+		"default": nil,
+		"with wasm": {
+			Modules: []monogen.Module{monogen.ModuleWasm},
+		},
+		"with tokenfactory and oracle": {
+			Modules: []monogen.Module{monogen.ModuleTokenFactory, monogen.ModuleOracle},
+		},
+		"with deployment manifests": {
+			DockerCompose: true,
+			Helm:          true,
+		},
+	} {
+		t.Run(description, func(t *testing.T) {
+			const appName = "testapp"
+			rootDirPath := t.TempDir()
+			appDirPath := filepath.Join(rootDirPath, appName)
+			// Generate project.
+			require.NoError(t, monogen.Generate(context.Background(), appDirPath, "github.com/test/"+appName, "test", true, true, features))
 
-	// Run monogen.sh.
-	scriptPath, err := filepath.Abs("monogen.sh")
-	require.NoError(t, err)
-	require.NoError(t, initCommand(t, exec.Command(scriptPath), appName, rootDirPath, appDirPath).Run())
+			// Run monogen.sh.
+			scriptPath, err := filepath.Abs("monogen.sh")
+			require.NoError(t, err)
+			require.NoError(t, initCommand(t, exec.Command(scriptPath), appName, rootDirPath, appDirPath).Run())
+
+			testApp(t, rootDirPath, appDirPath, appName)
+
+			// Run the generated smoke test.
+			require.NoError(t, initCommand(t, exec.Command("go", "test", "-tags", "e2e", "./e2e"), appName, rootDirPath, appDirPath).Run())
+
+			if features != nil && features.DockerCompose {
+				for _, path := range []string{"docker-compose.yml", "Dockerfile", ".env", filepath.Join("helm", appName, "values.yaml")} {
+					require.FileExists(t, filepath.Join(appDirPath, "deploy", path))
+				}
+			}
 
-	testApp(t, rootDirPath, appDirPath, appName)
+			// Upgrading a freshly generated project is a no-op.
+			report, err := monogen.Upgrade(context.Background(), appDirPath, false, true)
+			require.NoError(t, err)
+			require.Equal(t, &monogen.UpgradeReport{}, report)
 
-	// Cannot overwrite existing directory.
-	require.ErrorContains(t, monogen.Generate(context.Background(), appDirPath, "github.com/test/"+appName, "test", true, true), "refusing to overwrite directory")
+			// Cannot overwrite existing directory.
+			require.ErrorContains(
+				t,
+				monogen.Generate(context.Background(), appDirPath, "github.com/test/"+appName, "test", true, true, features),
+				"refusing to overwrite directory",
+			)
+		})
+	}
 }
 
 func initCommand(t *testing.T, cmd *exec.Cmd, appName, rootDirPath, appDirPath string) *exec.Cmd {
@@ -0,0 +1,68 @@
+;; forwarder is the CosmWasm contract the generated withdrawal test deploys. It has no state: instantiate does nothing,
+;; and execute sends its msg back to the chain as a CosmosMsg::Custom, so the test can send the app's custom messages,
+;; like x/rollup's initiate_withdrawal, from a contract.
+;;
+;; forwarder.wasm is this file assembled, e.g., with `wat2wasm forwarder.wat`.
+(module
+  (memory (export "memory") 1)
+  ;; Memory is bump allocated from the heap and never freed: the VM runs every call in a new instance.
+  (global $heap (mut i32) (i32.const 1024))
+
+  ;; The instantiate response.
+  (data (i32.const 0) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[]}}")
+  ;; The execute response is the prefix, the execute msg, and the suffix.
+  (data (i32.const 128) "{\"ok\":{\"messages\":[{\"id\":0,\"msg\":{\"custom\":")
+  (data (i32.const 256) "},\"reply_on\":\"never\"}],\"attributes\":[],\"events\":[]}}")
+
+  (func (export "interface_version_8"))
+
+  ;; allocate returns a region of size bytes. A region is the offset, capacity, and length of a buffer, as 3 u32s.
+  (func $allocate (export "allocate") (param $size i32) (result i32)
+    (local $region i32)
+    (local $end i32)
+    (local.set $region (global.get $heap))
+    (local.set $end (i32.add (i32.add (local.get $region) (i32.const 12)) (local.get $size)))
+    (if (i32.gt_u (local.get $end) (i32.shl (memory.size) (i32.const 16)))
+      (then
+        (if (i32.eq
+              (memory.grow (i32.sub (i32.shr_u (i32.add (local.get $end) (i32.const 65535)) (i32.const 16)) (memory.size)))
+              (i32.const -1))
+          (then unreachable))))
+    ;; Keep regions aligned.
+    (global.set $heap (i32.and (i32.add (local.get $end) (i32.const 7)) (i32.const -8)))
+    (i32.store (local.get $region) (i32.add (local.get $region) (i32.const 12)))
+    (i32.store offset=4 (local.get $region) (local.get $size))
+    (i32.store offset=8 (local.get $region) (i32.const 0))
+    (local.get $region))
+
+  (func (export "deallocate") (param $region i32))
+
+  (func $copy (param $dst i32) (param $src i32) (param $len i32)
+    (block $done
+      (loop $next
+        (br_if $done (i32.eqz (local.get $len)))
+        (i32.store8 (local.get $dst) (i32.load8_u (local.get $src)))
+        (local.set $dst (i32.add (local.get $dst) (i32.const 1)))
+        (local.set $src (i32.add (local.get $src) (i32.const 1)))
+        (local.set $len (i32.sub (local.get $len) (i32.const 1)))
+        (br $next))))
+
+  (func (export "instantiate") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    (local $region i32)
+    (local.set $region (call $allocate (i32.const 50)))
+    (call $copy (i32.load (local.get $region)) (i32.const 0) (i32.const 50))
+    (i32.store offset=8 (local.get $region) (i32.const 50))
+    (local.get $region))
+
+  (func (export "execute") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    (local $len i32)
+    (local $region i32)
+    (local $dst i32)
+    (local.set $len (i32.load offset=8 (local.get $msg)))
+    (local.set $region (call $allocate (i32.add (local.get $len) (i32.const 95))))
+    (local.set $dst (i32.load (local.get $region)))
+    (call $copy (local.get $dst) (i32.const 128) (i32.const 43))
+    (call $copy (i32.add (local.get $dst) (i32.const 43)) (i32.load (local.get $msg)) (local.get $len))
+    (call $copy (i32.add (i32.add (local.get $dst) (i32.const 43)) (local.get $len)) (i32.const 256) (i32.const 52))
+    (i32.store offset=8 (local.get $region) (i32.add (local.get $len) (i32.const 95)))
+    (local.get $region)))
@@ -0,0 +1,181 @@
+package monogen
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ignite/cli/v28/ignite/pkg/gocmd"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// UpgradeReport describes the changes made to a project by Upgrade.
+type UpgradeReport struct {
+	// Added are files introduced by the current templates.
+	Added []string
+	// Updated are files that were not modified since they were generated and now match the current templates.
+	Updated []string
+	// Conflicts are files that were modified since they were generated and differ from the current templates.
+	// Their changes are included in Patch instead of being applied.
+	Conflicts []string
+	// Obsolete are files that are no longer generated by the current templates. They are left untouched.
+	Obsolete []string
+	// Patch is a unified diff, applicable with `git apply`, of the changes that were not applied to the project.
+	Patch []byte
+}
+
+// Upgrade brings a project generated by a previous version of monogen up to date with the current templates.
+// The project is regenerated in a temporary directory with the options recorded when it was first generated.
+// Files that were not modified since then are updated in place, and changes to modified files are returned as a patch.
+// If dryRun is true, no files are modified and all changes are returned as a patch.
+func Upgrade(ctx context.Context, appDirPath string, dryRun, isTest bool) (*UpgradeReport, error) {
+	appDir, err := filepath.Abs(appDirPath)
+	if err != nil {
+		return nil, fmt.Errorf("get absolute path: %v", err)
+	}
+	base, err := loadManifest(appDir)
+	if err != nil {
+		return nil, fmt.Errorf("load manifest: %v", err)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "monogen-upgrade")
+	if err != nil {
+		return nil, fmt.Errorf("make temporary directory: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	// The directory name must match since it is used in generated paths, e.g., the Helm chart.
+	freshDir := filepath.Join(tmpDir, filepath.Base(appDir))
+	if err := Generate(ctx, freshDir, base.GoModulePath, base.AddressPrefix, true, isTest, base.Features); err != nil {
+		return nil, fmt.Errorf("generate project with current templates: %v", err)
+	}
+	fresh, err := readManifest(freshDir)
+	if err != nil {
+		return nil, fmt.Errorf("read current manifest: %v", err)
+	}
+
+	report, err := upgradeFiles(appDir, freshDir, base, fresh, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	if err := writeManifest(appDir, fresh); err != nil {
+		return nil, err
+	}
+	for _, path := range append(report.Added, report.Updated...) {
+		if path == "go.mod" {
+			if err := gocmd.ModTidy(ctx, appDir); err != nil {
+				return nil, fmt.Errorf("go mod tidy: %v", err)
+			}
+			break
+		}
+	}
+	return report, nil
+}
+
+// upgradeFiles performs a three-way comparison between the files recorded in the base manifest, the files in appDir,
+// and the freshly generated files in freshDir.
+func upgradeFiles(appDir, freshDir string, base, fresh *manifest, dryRun bool) (*UpgradeReport, error) {
+	paths := make([]string, 0, len(fresh.Files))
+	for path := range fresh.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	report := new(UpgradeReport)
+	var patch bytes.Buffer
+	for _, path := range paths {
+		freshContent, err := os.ReadFile(filepath.Join(freshDir, filepath.FromSlash(path)))
+		if err != nil {
+			return nil, fmt.Errorf("read generated %s: %v", path, err)
+		}
+		currentPath := filepath.Join(appDir, filepath.FromSlash(path))
+		currentContent, err := os.ReadFile(currentPath)
+		exists := err == nil
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("read %s: %v", path, err)
+		}
+		if exists && bytes.Equal(currentContent, freshContent) {
+			continue
+		}
+
+		baseHash, tracked := base.Files[path]
+		var applicable bool
+		if exists {
+			applicable = tracked && hashContent(currentContent) == baseHash
+		} else {
+			// Files deleted by the user are conflicts, since they were probably deleted on purpose.
+			applicable = !tracked
+		}
+
+		if !applicable {
+			report.Conflicts = append(report.Conflicts, path)
+		} else if exists {
+			report.Updated = append(report.Updated, path)
+		} else {
+			report.Added = append(report.Added, path)
+		}
+
+		if applicable && !dryRun {
+			if err := os.MkdirAll(filepath.Dir(currentPath), 0o755); err != nil { //nolint:mnd
+				return nil, fmt.Errorf("make directory for %s: %v", path, err)
+			}
+			if err := os.WriteFile(currentPath, freshContent, 0o644); err != nil { //nolint:mnd
+				return nil, fmt.Errorf("write %s: %v", path, err)
+			}
+			continue
+		}
+		diff, err := unifiedDiff(path, currentContent, freshContent, exists)
+		if err != nil {
+			return nil, fmt.Errorf("diff %s: %v", path, err)
+		}
+		patch.WriteString(diff)
+	}
+
+	for path := range base.Files {
+		if _, ok := fresh.Files[path]; !ok {
+			report.Obsolete = append(report.Obsolete, path)
+		}
+	}
+	sort.Strings(report.Obsolete)
+
+	report.Patch = patch.Bytes()
+	return report, nil
+}
+
+func unifiedDiff(path string, from, to []byte, exists bool) (string, error) {
+	fromFile := "a/" + path
+	if !exists {
+		fromFile = "/dev/null"
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(from),
+		B:        splitLines(to),
+		FromFile: fromFile,
+		ToFile:   "b/" + path,
+		Context:  3, //nolint:mnd
+	})
+	if err != nil {
+		return "", err
+	}
+	return diff, nil
+}
+
+// splitLines splits content into newline-terminated lines.
+// Unlike difflib.SplitLines, it does not add an empty line to content that ends with a newline.
+func splitLines(content []byte) []string {
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
@@ -0,0 +1,77 @@
+package monogen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeFiles(t *testing.T) {
+	const (
+		oldContent  = "old\n"
+		newContent  = "new\n"
+		userContent = "user\n"
+	)
+	appDir := t.TempDir()
+	freshDir := t.TempDir()
+	base := &manifest{Files: make(map[string]string)}
+	fresh := &manifest{Files: make(map[string]string)}
+
+	writeFile := func(dir, path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte(content), 0o644))
+	}
+	for path, contents := range map[string]struct {
+		base, current, fresh string
+	}{
+		"unchanged.go":      {oldContent, oldContent, oldContent},
+		"updated.go":        {oldContent, oldContent, newContent},
+		"conflict.go":       {oldContent, userContent, newContent},
+		"up-to-date.go":     {oldContent, newContent, newContent},
+		"deleted.go":        {oldContent, "", newContent},
+		"added/added.go":    {"", "", newContent},
+		"untracked.go":      {"", userContent, newContent},
+		"obsolete/file.go":  {oldContent, oldContent, ""},
+		"user-only/file.go": {"", userContent, ""},
+	} {
+		if contents.base != "" {
+			base.Files[path] = hashContent([]byte(contents.base))
+		}
+		if contents.current != "" {
+			writeFile(appDir, path, contents.current)
+		}
+		if contents.fresh != "" {
+			fresh.Files[path] = hashContent([]byte(contents.fresh))
+			writeFile(freshDir, path, contents.fresh)
+		}
+	}
+
+	report, err := upgradeFiles(appDir, freshDir, base, fresh, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"added/added.go"}, report.Added)
+	require.Equal(t, []string{"updated.go"}, report.Updated)
+	require.Equal(t, []string{"conflict.go", "deleted.go", "untracked.go"}, report.Conflicts)
+	require.Equal(t, []string{"obsolete/file.go"}, report.Obsolete)
+	// Nothing is applied in a dry run.
+	require.NoFileExists(t, filepath.Join(appDir, "added", "added.go"))
+	require.Contains(t, string(report.Patch), "--- /dev/null\n+++ b/added/added.go\n@@ -0,0 +1 @@\n+new\n")
+	require.Contains(t, string(report.Patch), "--- a/updated.go\n+++ b/updated.go\n@@ -1 +1 @@\n-old\n+new\n")
+
+	report, err = upgradeFiles(appDir, freshDir, base, fresh, false)
+	require.NoError(t, err)
+	for path, want := range map[string]string{
+		"added/added.go": newContent,
+		"updated.go":     newContent,
+		"conflict.go":    userContent,
+		"untracked.go":   userContent,
+	} {
+		got, err := os.ReadFile(filepath.Join(appDir, path))
+		require.NoError(t, err)
+		require.Equal(t, want, string(got), path)
+	}
+	require.NoFileExists(t, filepath.Join(appDir, "deleted.go"))
+	require.NotContains(t, string(report.Patch), "updated.go")
+	require.Contains(t, string(report.Patch), "--- a/conflict.go\n+++ b/conflict.go\n@@ -1 +1 @@\n-user\n+new\n")
+}
@@ -23,17 +23,41 @@ import (
 	"github.com/polymerdao/monomer/utils"
 )
 
+// Application is the contract between Monomer and the appchain. It follows semantic versioning: methods are only
+// added or changed in major releases, so integrators can rely on it instead of Monomer's internals.
+// Implementations for Cosmos SDK apps are provided by integrations.NewWrappedApplication.
+//
+// Monomer calls the methods from a single goroutine, except for Info, Query, and CheckTx, which may be called
+// concurrently with the others.
 type Application interface {
+	// Info returns the height and app hash of the last committed block.
 	Info(context.Context, *abcitypes.RequestInfo) (*abcitypes.ResponseInfo, error)
+	// Query serves the abci_query RPC method. ctx is done when the client disconnects or the query's deadline passes,
+	// and Query should stop then.
 	Query(context.Context, *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error)
 
+	// CheckTx validates transactions before they are added to the mempool.
 	CheckTx(context.Context, *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error)
 
+	// InitChain is called once with the genesis app state, before the genesis block is committed.
 	InitChain(context.Context, *abcitypes.RequestInitChain) (*abcitypes.ResponseInitChain, error)
+	// PrepareProposal and ProcessProposal let the app reorder and validate the transactions in a block.
+	// The sequencer is the only block producer, so the app must accept any proposal it prepared itself.
+	PrepareProposal(context.Context, *abcitypes.RequestPrepareProposal) (*abcitypes.ResponsePrepareProposal, error)
+	ProcessProposal(context.Context, *abcitypes.RequestProcessProposal) (*abcitypes.ResponseProcessProposal, error)
+	// FinalizeBlock executes a block. The first transaction is always the x/rollup MsgApplyL1Txs.
 	FinalizeBlock(context.Context, *abcitypes.RequestFinalizeBlock) (*abcitypes.ResponseFinalizeBlock, error)
+	// Commit persists the state changes of the last finalized block.
 	Commit(context.Context, *abcitypes.RequestCommit) (*abcitypes.ResponseCommit, error)
 
+	// RollbackToHeight reverts the committed state to the given height. It is used to recover from crashes and reorgs.
 	RollbackToHeight(context.Context, uint64) error
+
+	// ListSnapshots, LoadSnapshotChunk, OfferSnapshot, and ApplySnapshotChunk serve and restore state sync snapshots.
+	ListSnapshots(context.Context, *abcitypes.RequestListSnapshots) (*abcitypes.ResponseListSnapshots, error)
+	LoadSnapshotChunk(context.Context, *abcitypes.RequestLoadSnapshotChunk) (*abcitypes.ResponseLoadSnapshotChunk, error)
+	OfferSnapshot(context.Context, *abcitypes.RequestOfferSnapshot) (*abcitypes.ResponseOfferSnapshot, error)
+	ApplySnapshotChunk(context.Context, *abcitypes.RequestApplySnapshotChunk) (*abcitypes.ResponseApplySnapshotChunk, error)
 }
 
 type ChainID uint64
@@ -56,8 +80,14 @@ type Header struct {
 	Time       uint64
 	ParentHash common.Hash
 	StateRoot  common.Hash
-	GasLimit   uint64
-	Hash       common.Hash
+	// AppHash is the Cosmos app hash after executing the parent block, following the CometBFT convention.
+	// Proofs returned by queries at height H are verified against the AppHash of the block at height H+1.
+	AppHash  []byte
+	GasLimit uint64
+	// ParentBeaconRoot is the parent beacon block root of the L1 origin, which op-node provides from Ecotone on. It is
+	// nil for blocks built before Ecotone.
+	ParentBeaconRoot *common.Hash `rlp:"nil"`
+	Hash             common.Hash
 }
 
 func (h *Header) ToComet() *bfttypes.Header {
@@ -66,7 +96,7 @@ func (h *Header) ToComet() *bfttypes.Header {
 		Height:      int64(h.Height),
 		Time:        time.Unix(int64(h.Time), 0),
 		LastBlockID: bfttypes.BlockID{Hash: h.ParentHash.Bytes()},
-		AppHash:     h.StateRoot.Bytes(),
+		AppHash:     h.AppHash,
 	}
 }
 
@@ -105,7 +135,7 @@ func MakeBlock(h *Header, txs bfttypes.Txs) (*Block, error) {
 // ToEth converts a partial Monomer Header to an Ethereum Header.
 // Extrinsic properties on the header (like the block hash) need to be set separately by SetHeader.
 func (h *Header) ToEth() *ethtypes.Header {
-	return &ethtypes.Header{
+	header := &ethtypes.Header{
 		ParentHash:      h.ParentHash,
 		Root:            h.StateRoot,
 		Number:          new(big.Int).SetUint64(h.Height),
@@ -118,6 +148,13 @@ func (h *Header) ToEth() *ethtypes.Header {
 		WithdrawalsHash: &ethtypes.EmptyWithdrawalsHash,
 		Difficulty:      common.Big0,
 	}
+	if h.ParentBeaconRoot != nil {
+		// Ecotone blocks are Cancun blocks. Monomer blocks never carry blobs.
+		header.ParentBeaconRoot = h.ParentBeaconRoot
+		header.BlobGasUsed = new(uint64)
+		header.ExcessBlobGas = new(uint64)
+	}
+	return header
 }
 
 func (b *Block) ToEth() (*ethtypes.Block, error) {
@@ -178,6 +215,9 @@ func (p *PayloadAttributes) ID() *engine.PayloadID {
 	hashData(hasher, p.PrevRandao[:])
 	hashData(hasher, p.SuggestedFeeRecipient[:])
 	hashDataAsBinary(hasher, p.GasLimit)
+	if p.ParentBeaconBlockRoot != nil {
+		hashData(hasher, p.ParentBeaconBlockRoot[:])
+	}
 	if p.NoTxPool || len(p.CosmosTxs) == 0 {
 		hashDataAsBinary(hasher, p.NoTxPool)
 		hashDataAsBinary(hasher, uint64(len(p.CosmosTxs)))
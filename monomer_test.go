@@ -36,6 +36,7 @@ func newTestHeader() *monomer.Header {
 		Height:     67890,
 		Time:       uint64(time.Now().Unix()),
 		StateRoot:  common.HexToHash("0x1"),
+		AppHash:    common.HexToHash("0x4").Bytes(),
 		ParentHash: common.HexToHash("0x2"),
 		GasLimit:   3000000,
 		Hash:       common.HexToHash("0x3"),
@@ -50,7 +51,7 @@ func TestToComet(t *testing.T) {
 		ChainID:     header.ChainID.String(),
 		Height:      int64(header.Height),
 		Time:        time.Unix(int64(header.Time), 0),
-		AppHash:     header.StateRoot.Bytes(),
+		AppHash:     header.AppHash,
 		LastBlockID: bfttypes.BlockID{Hash: header.ParentHash.Bytes()},
 	}, cometHeader)
 }
@@ -74,6 +75,20 @@ func TestToEth(t *testing.T) {
 	}, ethHeader)
 }
 
+func TestToEthEcotone(t *testing.T) {
+	header := newTestHeader()
+	header.ParentBeaconRoot = &common.Hash{1}
+	ethHeader := header.ToEth()
+
+	require.Equal(t, header.ParentBeaconRoot, ethHeader.ParentBeaconRoot)
+	require.Equal(t, uint64(0), *ethHeader.BlobGasUsed)
+	require.Equal(t, uint64(0), *ethHeader.ExcessBlobGas)
+
+	// The block hash commits to the parent beacon block root.
+	preEcotoneHeader := newTestHeader()
+	require.NotEqual(t, preEcotoneHeader.ToEth().Hash(), ethHeader.Hash())
+}
+
 func TestBlockNewBlock(t *testing.T) {
 	block := monomer.NewBlock(newTestHeader(), bfttypes.Txs{})
 	ethBlock, err := block.ToEth()
@@ -156,7 +171,7 @@ func TestBlockToCometLikeBlock(t *testing.T) {
 			ChainID: block.Header.ChainID.String(),
 			Time:    time.Unix(int64(block.Header.Time), 0),
 			Height:  int64(block.Header.Height),
-			AppHash: block.Header.StateRoot.Bytes(),
+			AppHash: block.Header.AppHash,
 			LastBlockID: bfttypes.BlockID{
 				Hash: block.Header.ParentHash.Bytes(),
 			},
@@ -167,6 +182,33 @@ func TestBlockToCometLikeBlock(t *testing.T) {
 	}, block.ToCometLikeBlock())
 }
 
+func TestBlockEthTxHashes(t *testing.T) {
+	l1InfoTx, depositTx, cosmosEthTx := testutils.GenerateEthTxs(t)
+	block := testutils.GenerateBlockFromEthTxs(t,
+		l1InfoTx,
+		[]*ethtypes.Transaction{depositTx},
+		[]*ethtypes.Transaction{cosmosEthTx},
+	)
+
+	hashes, err := block.EthTxHashes()
+	require.NoError(t, err)
+	require.Equal(t, map[common.Hash]common.Hash{
+		l1InfoTx.Hash():    monomer.TxHash(block.Txs[0]),
+		depositTx.Hash():   monomer.TxHash(block.Txs[0]),
+		cosmosEthTx.Hash(): monomer.TxHash(block.Txs[1]),
+	}, hashes)
+	// The eth view must use the same hashes.
+	ethBlock, err := block.ToEth()
+	require.NoError(t, err)
+	for _, ethTx := range ethBlock.Transactions() {
+		require.Contains(t, hashes, ethTx.Hash())
+	}
+
+	hashes, err = monomer.NewBlock(newTestHeader(), bfttypes.Txs{}).EthTxHashes()
+	require.NoError(t, err)
+	require.Empty(t, hashes)
+}
+
 func newPayloadAttributes() *monomer.PayloadAttributes {
 	return &monomer.PayloadAttributes{
 		Timestamp:             uint64(time.Now().Unix()),
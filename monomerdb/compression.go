@@ -0,0 +1,88 @@
+package monomerdb
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression is how a store compresses the values it writes.
+type Compression string
+
+const (
+	CompressionNone   Compression = "none"
+	CompressionSnappy Compression = "snappy"
+	CompressionZstd   Compression = "zstd"
+)
+
+// Compressor is a store that compresses the values it writes, e.g., localdb.DB.
+type Compressor interface {
+	SetCompression(Compression)
+}
+
+// codecs are the first bytes of the values Compress returns.
+const (
+	codecNone byte = iota
+	codecSnappy
+	codecZstd
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// ParseCompression parses the name of a compression, e.g., from a flag.
+func ParseCompression(s string) (Compression, error) {
+	switch c := Compression(s); c {
+	case CompressionNone, CompressionSnappy, CompressionZstd:
+		return c, nil
+	default:
+		return "", fmt.Errorf("unknown compression %q: must be none, snappy, or zstd", s)
+	}
+}
+
+// Compress compresses the value and prefixes it with the compression used, so Decompress can read values compressed
+// with any compression.
+func Compress(c Compression, value []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone, "":
+		return append([]byte{codecNone}, value...), nil
+	case CompressionSnappy:
+		encoded := make([]byte, 1+snappy.MaxEncodedLen(len(value)))
+		encoded[0] = codecSnappy
+		return encoded[:1+len(snappy.Encode(encoded[1:], value))], nil
+	case CompressionZstd:
+		return zstdEncoder.EncodeAll(value, []byte{codecZstd}), nil
+	default:
+		return nil, fmt.Errorf("unknown compression %q", c)
+	}
+}
+
+// Decompress returns the value Compress compressed. The value doesn't share memory with compressed.
+func Decompress(compressed []byte) ([]byte, error) {
+	if len(compressed) == 0 {
+		return nil, errors.New("decompress: empty value")
+	}
+	switch codec, value := compressed[0], compressed[1:]; codec {
+	case codecNone:
+		return slices.Clone(value), nil
+	case codecSnappy:
+		decoded, err := snappy.Decode(nil, value)
+		if err != nil {
+			return nil, fmt.Errorf("decompress snappy: %v", err)
+		}
+		return decoded, nil
+	case codecZstd:
+		decoded, err := zstdDecoder.DecodeAll(value, nil)
+		if err != nil {
+			return nil, fmt.Errorf("decompress zstd: %v", err)
+		}
+		return decoded, nil
+	default:
+		return nil, fmt.Errorf("decompress: unknown codec %d", codec)
+	}
+}
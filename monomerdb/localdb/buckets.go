@@ -2,6 +2,7 @@ package localdb
 
 import (
 	"bytes"
+	"fmt"
 )
 
 type dbBucket byte
@@ -13,8 +14,51 @@ const (
 	bucketTxByHeightAndIndex
 	bucketTxHeightAndIndexByHash
 	bucketHeight
+	bucketPrunedHeaderByHeight
+	bucketCompressedTxByHeightAndIndex
 )
 
+// buckets are the buckets in the order of their prefixes.
+var buckets = []dbBucket{
+	bucketHeaderByHeight,
+	bucketHeightByHash,
+	bucketHashByLabel,
+	bucketTxByHeightAndIndex,
+	bucketTxHeightAndIndexByHash,
+	bucketHeight,
+	bucketPrunedHeaderByHeight,
+	bucketCompressedTxByHeightAndIndex,
+}
+
+// cold reports whether the bucket is in the cold keyspace, which holds the block data and its indexes.
+func (b dbBucket) cold() bool {
+	return b != bucketHashByLabel && b != bucketHeight
+}
+
+// String returns the bucket's name, which labels its disk usage.
+func (b dbBucket) String() string {
+	switch b {
+	case bucketHeaderByHeight:
+		return "header_by_height"
+	case bucketHeightByHash:
+		return "height_by_hash"
+	case bucketHashByLabel:
+		return "hash_by_label"
+	case bucketTxByHeightAndIndex:
+		return "tx_by_height_and_index"
+	case bucketTxHeightAndIndexByHash:
+		return "tx_height_and_index_by_hash"
+	case bucketHeight:
+		return "height"
+	case bucketPrunedHeaderByHeight:
+		return "pruned_header_by_height"
+	case bucketCompressedTxByHeightAndIndex:
+		return "compressed_tx_by_height_and_index"
+	default:
+		return fmt.Sprintf("unknown_%d", byte(b))
+	}
+}
+
 // TODO: optimize the buckets with a buffer pool? We can improve type safety by using separate types for each bucket.
 // https://github.com/golang/go/issues/23199#issuecomment-406967375
 
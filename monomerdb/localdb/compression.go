@@ -0,0 +1,87 @@
+package localdb
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/polymerdao/monomer/monomerdb"
+	"github.com/polymerdao/monomer/utils"
+)
+
+// Recompress rewrites the txs of the stored blocks with the compression c and sets it for the blocks appended after it,
+// e.g., to compress the txs written before compression was enabled. It rewrites every tx, so it must not be called while
+// blocks are appended. An interrupted Recompress can be called again.
+func (db *DB) Recompress(c monomerdb.Compression) error {
+	if _, err := monomerdb.Compress(c, nil); err != nil {
+		return err
+	}
+	for _, bucket := range []dbBucket{bucketTxByHeightAndIndex, bucketCompressedTxByHeightAndIndex} {
+		if err := db.recompressBucket(bucket, c); err != nil {
+			return fmt.Errorf("recompress %s: %v", bucket, err)
+		}
+	}
+	db.SetCompression(c)
+	return nil
+}
+
+// recompressBucket rewrites the txs in bucket in batches of about moveBatchSize. Batches end between blocks, so a block's
+// txs are never split across the tx buckets.
+func (db *DB) recompressBucket(bucket dbBucket, c monomerdb.Compression) (err error) {
+	iter, err := db.cold.NewIter(&pebble.IterOptions{
+		LowerBound: bucket.Key(),
+		UpperBound: (bucket + 1).Key(),
+	})
+	if err != nil {
+		return fmt.Errorf("new iterator: %v", err)
+	}
+	defer func() {
+		err = utils.WrapCloseErr(err, iter)
+	}()
+	b := db.cold.NewBatch()
+	defer func() {
+		// b is replaced after each commit.
+		err = utils.WrapCloseErr(err, b)
+	}()
+	var lastHeightBytes []byte
+	for iter.First(); iter.Valid(); iter.Next() {
+		heightAndIndexBytes := iter.Key()[1:]
+		heightBytes := heightAndIndexBytes[:8]
+		if b.Len() >= moveBatchSize && !bytes.Equal(heightBytes, lastHeightBytes) {
+			if err := b.Commit(pebble.Sync); err != nil {
+				return fmt.Errorf("commit: %v", err)
+			}
+			if err := b.Close(); err != nil {
+				return fmt.Errorf("close batch: %v", err)
+			}
+			b = db.cold.NewBatch()
+		}
+		lastHeightBytes = slices.Clone(heightBytes)
+
+		value, err := iter.ValueAndErr()
+		if err != nil {
+			return fmt.Errorf("get value from iterator: %v", err)
+		}
+		tx, err := decodeTx(bucket, value)
+		if err != nil {
+			return err
+		}
+		key, value, err := encodeTx(c, heightAndIndexBytes, tx)
+		if err != nil {
+			return err
+		}
+		if err := b.Set(key, value, nil); err != nil {
+			return fmt.Errorf("set tx: %v", err)
+		}
+		if key[0] != byte(bucket) {
+			if err := b.Delete(iter.Key(), nil); err != nil {
+				return fmt.Errorf("delete tx: %v", err)
+			}
+		}
+	}
+	if err := b.Commit(pebble.Sync); err != nil {
+		return fmt.Errorf("commit: %v", err)
+	}
+	return nil
+}
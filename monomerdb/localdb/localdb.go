@@ -25,16 +25,134 @@ var (
 	heightKey         = bucketHeight.Key()
 )
 
+// DB stores blocks in two keyspaces. The hot keyspace holds the height and labels, which change with every block. The
+// cold keyspace holds the block data and its indexes, which are only appended to, rolled back, or pruned from the
+// start.
 type DB struct {
-	db *pebble.DB
+	hot         *pebble.DB
+	cold        *pebble.DB
+	compression monomerdb.Compression
 }
 
+// New returns a DB that keeps both keyspaces in db.
 func New(db *pebble.DB) *DB {
 	return &DB{
-		db: db,
+		hot:  db,
+		cold: db,
 	}
 }
 
+// NewSplit returns a DB that keeps the hot keyspace in hot and the cold keyspace in cold, so they can be configured
+// differently (see ColdOptions) or stored on different disks. Cold data in hot, e.g., written by New, is moved to cold.
+//
+// Writes to both keyspaces aren't atomic, so they are ordered to keep the height and labels pointing at blocks that
+// exist. Block data above the height left by an interrupted write is deleted.
+func NewSplit(hot, cold *pebble.DB) (*DB, error) {
+	db := &DB{
+		hot:  hot,
+		cold: cold,
+	}
+	if err := db.moveColdData(); err != nil {
+		return nil, fmt.Errorf("move cold data: %v", err)
+	}
+	height, err := db.Height()
+	if errors.Is(err, monomerdb.ErrNotFound) {
+		return db, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("get height: %v", err)
+	}
+	if err := db.write(true, false, func(_, cold *pebble.Batch) error {
+		return deleteFrom(cold, height+1)
+	}); err != nil {
+		return nil, fmt.Errorf("delete blocks above height %d: %v", height, err)
+	}
+	return db, nil
+}
+
+// ColdOptions returns the options of the cold keyspace's pebble DB for NewSplit. Block data is rarely read once it's
+// old, so it's compressed more than pebble does by default.
+func ColdOptions() *pebble.Options {
+	opts := &pebble.Options{
+		Levels: make([]pebble.LevelOptions, 7), //nolint:mnd
+	}
+	for i := range opts.Levels {
+		opts.Levels[i].Compression = pebble.ZstdCompression
+		opts.Levels[i].BlockSize = 32 << 10 //nolint:mnd
+	}
+	return opts
+}
+
+// SetCompression sets how the txs of the blocks appended after it are compressed. Blocks appended before it keep their
+// compression until Recompress rewrites them. It must not be called concurrently with AppendBlock.
+func (db *DB) SetCompression(c monomerdb.Compression) {
+	db.compression = c
+}
+
+// moveBatchSize is the size of the batches cold data is moved to the cold keyspace in.
+const moveBatchSize = 16 << 20
+
+// moveColdData moves the cold buckets in hot to cold. The buckets are deleted from hot after they are copied, so an
+// interrupted move is copied again.
+func (db *DB) moveColdData() error {
+	for _, bucket := range buckets {
+		if !bucket.cold() {
+			continue
+		}
+		if err := db.moveBucket(bucket); err != nil {
+			return fmt.Errorf("move %s: %v", bucket, err)
+		}
+	}
+	return nil
+}
+
+func (db *DB) moveBucket(bucket dbBucket) (err error) {
+	lower := bucket.Key()
+	upper := (bucket + 1).Key()
+	iter, err := db.hot.NewIter(&pebble.IterOptions{
+		LowerBound: lower,
+		UpperBound: upper,
+	})
+	if err != nil {
+		return fmt.Errorf("new iterator: %v", err)
+	}
+	defer func() {
+		err = utils.WrapCloseErr(err, iter)
+	}()
+	if !iter.First() {
+		return nil
+	}
+	for iter.Valid() {
+		if err := db.moveBatch(iter); err != nil {
+			return err
+		}
+	}
+	if err := db.hot.DeleteRange(lower, upper, pebble.Sync); err != nil {
+		return fmt.Errorf("delete range: %v", err)
+	}
+	return nil
+}
+
+// moveBatch copies the keys from the iterator's position to cold in one batch of up to moveBatchSize.
+func (db *DB) moveBatch(iter *pebble.Iterator) (err error) {
+	b := db.cold.NewBatch()
+	defer func() {
+		err = utils.WrapCloseErr(err, b)
+	}()
+	for ; iter.Valid() && b.Len() < moveBatchSize; iter.Next() {
+		value, err := iter.ValueAndErr()
+		if err != nil {
+			return fmt.Errorf("get value from iterator: %v", err)
+		}
+		if err := b.Set(iter.Key(), value, nil); err != nil {
+			return fmt.Errorf("set: %v", err)
+		}
+	}
+	if err := b.Commit(pebble.Sync); err != nil {
+		return fmt.Errorf("commit: %v", err)
+	}
+	return nil
+}
+
 // TODO: optimization - we can use a cbor encoder to write directly to a batch, rather than copying bytes twice.
 
 // AppendBlock does no validity checks and does not update labels.
@@ -44,23 +162,28 @@ func (db *DB) AppendBlock(block *monomer.Block) error {
 		return fmt.Errorf("marshal header into cbor: %v", err)
 	}
 	heightBytes := marshalUint64(block.Header.Height)
-	return db.update(func(b *pebble.Batch) error {
-		if err := b.Set(bucketHeaderByHeight.Key(heightBytes), headerBytes, nil); err != nil {
+	// The block is written before the height points at it.
+	return db.write(false, false, func(hot, cold *pebble.Batch) error {
+		if err := cold.Set(bucketHeaderByHeight.Key(heightBytes), headerBytes, nil); err != nil {
 			return fmt.Errorf("set block by height: %v", err)
 		}
-		if err := b.Set(bucketHeightByHash.Key(block.Header.Hash.Bytes()), heightBytes, nil); err != nil {
+		if err := cold.Set(bucketHeightByHash.Key(block.Header.Hash.Bytes()), heightBytes, nil); err != nil {
 			return fmt.Errorf("set height by hash: %v", err)
 		}
-		if err := b.Set(heightKey, heightBytes, nil); err != nil {
+		if err := hot.Set(heightKey, heightBytes, nil); err != nil {
 			return fmt.Errorf("set height: %v", err)
 		}
 
 		for i, tx := range block.Txs {
 			heightAndIndexBytes := slices.Concat(heightBytes, marshalUint64(uint64(i)))
-			if err := b.Set(bucketTxByHeightAndIndex.Key(heightAndIndexBytes), tx, nil); err != nil {
+			key, value, err := encodeTx(db.compression, heightAndIndexBytes, tx)
+			if err != nil {
+				return err
+			}
+			if err := cold.Set(key, value, nil); err != nil {
 				return fmt.Errorf("set tx by height and index: %v", err)
 			}
-			if err := b.Set(bucketTxHeightAndIndexByHash.Key(tx.Hash()), heightAndIndexBytes, nil); err != nil {
+			if err := cold.Set(bucketTxHeightAndIndexByHash.Key(tx.Hash()), heightAndIndexBytes, nil); err != nil {
 				return fmt.Errorf("set tx height and index by hash: %v", err)
 			}
 		}
@@ -69,8 +192,8 @@ func (db *DB) AppendBlock(block *monomer.Block) error {
 }
 
 func (db *DB) UpdateLabels(unsafe, safe, finalized common.Hash) error {
-	return db.update(func(b *pebble.Batch) error {
-		return updateLabels(b, unsafe, safe, finalized)
+	return db.write(false, false, func(hot, _ *pebble.Batch) error {
+		return updateLabels(hot, unsafe, safe, finalized)
 	})
 }
 
@@ -89,8 +212,9 @@ func updateLabels(b *pebble.Batch, unsafe, safe, finalized common.Hash) error {
 
 // Rollback rolls back the chain and updates labels.
 func (db *DB) Rollback(unsafe, safe, finalized common.Hash) error {
-	return db.updateIndexed(func(b *pebble.Batch) (err error) {
-		unsafeHeightBytesValue, closer, err := get(b, bucketHeightByHash.Key(unsafe.Bytes()))
+	// The height is moved back before the blocks above it are deleted.
+	return db.write(true, true, func(hot, cold *pebble.Batch) (err error) {
+		unsafeHeightBytesValue, closer, err := get(cold, bucketHeightByHash.Key(unsafe.Bytes()))
 		if err != nil {
 			return fmt.Errorf("get height by hash %s: %w", unsafe, err)
 		}
@@ -98,89 +222,62 @@ func (db *DB) Rollback(unsafe, safe, finalized common.Hash) error {
 			err = utils.WrapCloseErr(err, closer)
 		}()
 		unsafeHeight := endian.Uint64(unsafeHeightBytesValue)
-		firstHeightBytesToDelete := marshalUint64(unsafeHeight + 1)
 
-		// Set the new height.
-		if err := b.Set(heightKey, unsafeHeightBytesValue, nil); err != nil {
+		if err := hot.Set(heightKey, unsafeHeightBytesValue, nil); err != nil {
 			return fmt.Errorf("set height: %v", err)
 		}
-
-		// Delete headers.
-		firstHeaderToDelete := bucketHeaderByHeight.Key(firstHeightBytesToDelete)
-		nextBucket := (bucketHeaderByHeight + 1).Key()
-		headerIter, err := b.NewIter(&pebble.IterOptions{
-			LowerBound: firstHeaderToDelete,
-			UpperBound: nextBucket,
-		})
-		if err != nil {
-			return fmt.Errorf("new bucketHeaderByHeight iterator: %v", err)
-		}
-		defer func() {
-			err = utils.WrapCloseErr(err, headerIter)
-		}()
-		header := new(monomer.Header)
-		for headerIter.First(); headerIter.Valid(); headerIter.Next() {
-			value, err := headerIter.ValueAndErr()
-			if err != nil {
-				return fmt.Errorf("get header value from iterator: %v", err)
-			}
-			if err := cbor.Unmarshal(value, &header); err != nil {
-				return fmt.Errorf("unmarshal header from cbor: %v", err)
-			}
-			if err := b.Delete(bucketHeightByHash.Key(header.Hash.Bytes()), nil); err != nil {
-				return fmt.Errorf("delete height by hash %s: %v", header.Hash, err)
-			}
+		if err := deleteFrom(cold, unsafeHeight+1); err != nil {
+			return err
 		}
-		if err := b.DeleteRange(firstHeaderToDelete, nextBucket, nil); err != nil {
-			return fmt.Errorf("delete range of headers: %v", err)
+		if err := updateLabels(hot, unsafe, safe, finalized); err != nil {
+			return fmt.Errorf("update labels: %v", err)
 		}
+		return nil
+	})
+}
 
-		// Delete transactions.
-		firstTxToDelete := bucketTxByHeightAndIndex.Key(firstHeightBytesToDelete)
-		nextBucket = (bucketTxByHeightAndIndex + 1).Key()
-		txIter, err := b.NewIter(&pebble.IterOptions{
-			LowerBound: firstTxToDelete,
-			UpperBound: nextBucket,
-		})
-		if err != nil {
-			return fmt.Errorf("new bucketTxByHeightAndIndex iterator: %v", err)
+// deleteFrom deletes the blocks from height up.
+func deleteFrom(b *pebble.Batch, height uint64) error {
+	heightBytes := marshalUint64(height)
+	if err := deleteIndexed(b, bucketHeaderByHeight, heightBytes, nil, func(value []byte) ([]byte, error) {
+		header := new(monomer.Header)
+		if err := cbor.Unmarshal(value, &header); err != nil {
+			return nil, fmt.Errorf("unmarshal header from cbor: %v", err)
 		}
-		defer func() {
-			err = utils.WrapCloseErr(err, txIter)
-		}()
-		for txIter.First(); txIter.Valid(); txIter.Next() {
-			value, err := txIter.ValueAndErr()
+		return bucketHeightByHash.Key(header.Hash.Bytes()), nil
+	}); err != nil {
+		return fmt.Errorf("delete headers: %v", err)
+	}
+	if err := deleteTxs(b, heightBytes, nil); err != nil {
+		return fmt.Errorf("delete txs: %v", err)
+	}
+	return nil
+}
+
+// deleteTxs deletes the txs from startHeightBytes to endHeightBytes in both tx buckets, like deleteIndexed.
+func deleteTxs(b *pebble.Batch, startHeightBytes, endHeightBytes []byte) error {
+	for _, bucket := range []dbBucket{bucketTxByHeightAndIndex, bucketCompressedTxByHeightAndIndex} {
+		if err := deleteIndexed(b, bucket, startHeightBytes, endHeightBytes, func(value []byte) ([]byte, error) {
+			tx, err := decodeTx(bucket, value)
 			if err != nil {
-				return fmt.Errorf("get tx value from iterator: %v", err)
+				return nil, err
 			}
-			hash := bfttypes.Tx(value).Hash()
-			if err := b.Delete(bucketTxHeightAndIndexByHash.Key(hash), nil); err != nil {
-				return fmt.Errorf("delete tx height and index by hash %v: %v", hash, err)
-			}
-		}
-		if err := b.DeleteRange(
-			bucketTxHeightAndIndexByHash.Key(firstHeightBytesToDelete),
-			marshalUint64(uint64(bucketTxHeightAndIndexByHash)+1),
-			nil,
-		); err != nil {
-			return fmt.Errorf("delete range of txs: %v", err)
+			return bucketTxHeightAndIndexByHash.Key(tx.Hash()), nil
+		}); err != nil {
+			return fmt.Errorf("delete %s: %v", bucket, err)
 		}
-
-		if err := updateLabels(b, unsafe, safe, finalized); err != nil {
-			return fmt.Errorf("update labels: %v", err)
-		}
-		return nil
-	})
+	}
+	return nil
 }
 
 func (db *DB) HeadHeader() (*monomer.Header, error) {
 	var header *monomer.Header
-	if err := db.view(func(s *pebble.Snapshot) error {
-		heightBytes, err := getHeight(s)
+	if err := db.view(func(hot, cold *pebble.Snapshot) error {
+		heightBytes, err := getHeight(hot)
 		if err != nil {
 			return fmt.Errorf("get height: %w", err)
 		}
-		header, err = headerByHeight(s, heightBytes)
+		header, err = headerByHeight(cold, heightBytes)
 		if err != nil {
 			return fmt.Errorf("get header by height: %w", err)
 		}
@@ -193,12 +290,12 @@ func (db *DB) HeadHeader() (*monomer.Header, error) {
 
 func (db *DB) HeadBlock() (*monomer.Block, error) {
 	var block *monomer.Block
-	if err := db.view(func(s *pebble.Snapshot) error {
-		heightBytes, err := getHeight(s)
+	if err := db.view(func(hot, cold *pebble.Snapshot) error {
+		heightBytes, err := getHeight(hot)
 		if err != nil {
 			return fmt.Errorf("get height: %w", err)
 		}
-		block, err = blockByHeight(s, heightBytes)
+		block, err = blockByHeight(cold, heightBytes)
 		if err != nil {
 			return fmt.Errorf("get block by height: %w", err)
 		}
@@ -209,8 +306,8 @@ func (db *DB) HeadBlock() (*monomer.Block, error) {
 	return block, nil
 }
 
-func getHeight(s *pebble.Snapshot) ([]byte, error) {
-	heightBytesValue, closer, err := get(s, heightKey)
+func getHeight(g getter) ([]byte, error) {
+	heightBytesValue, closer, err := get(g, heightKey)
 	if err != nil {
 		return nil, err
 	}
@@ -222,8 +319,74 @@ func getHeight(s *pebble.Snapshot) ([]byte, error) {
 	return heightBytes, nil
 }
 
+// PruneBelow deletes the blocks below height, except for the genesis block, which the status API reports as the
+// earliest block. The headers of the deleted blocks are moved to an archive that only ArchivedHeaderByHeight reads, since
+// output roots commit to them.
+func (db *DB) PruneBelow(height uint64) error {
+	if height <= 2 { //nolint:mnd
+		return nil
+	}
+	firstHeightBytesToDelete := marshalUint64(2) //nolint:mnd
+	heightBytes := marshalUint64(height)
+	return db.write(true, false, func(_, cold *pebble.Batch) error {
+		if err := deleteIndexed(cold, bucketHeaderByHeight, firstHeightBytesToDelete, heightBytes, func(value []byte) ([]byte, error) {
+			header := new(monomer.Header)
+			if err := cbor.Unmarshal(value, &header); err != nil {
+				return nil, fmt.Errorf("unmarshal header from cbor: %v", err)
+			}
+			if err := cold.Set(bucketPrunedHeaderByHeight.Key(marshalUint64(header.Height)), value, nil); err != nil {
+				return nil, fmt.Errorf("archive header: %v", err)
+			}
+			return bucketHeightByHash.Key(header.Hash.Bytes()), nil
+		}); err != nil {
+			return fmt.Errorf("delete headers: %v", err)
+		}
+		if err := deleteTxs(cold, firstHeightBytesToDelete, heightBytes); err != nil {
+			return fmt.Errorf("delete txs: %v", err)
+		}
+		return nil
+	})
+}
+
+// deleteIndexed deletes the keys in bucket from start to end and the index keys indexKey returns for their values. A nil
+// end deletes to the end of the bucket.
+func deleteIndexed(b *pebble.Batch, bucket dbBucket, start, end []byte, indexKey func([]byte) ([]byte, error)) (err error) {
+	lower := bucket.Key(start)
+	upper := bucket.Key(end)
+	if end == nil {
+		upper = (bucket + 1).Key()
+	}
+	iter, err := b.NewIter(&pebble.IterOptions{
+		LowerBound: lower,
+		UpperBound: upper,
+	})
+	if err != nil {
+		return fmt.Errorf("new iterator: %v", err)
+	}
+	defer func() {
+		err = utils.WrapCloseErr(err, iter)
+	}()
+	for iter.First(); iter.Valid(); iter.Next() {
+		value, err := iter.ValueAndErr()
+		if err != nil {
+			return fmt.Errorf("get value from iterator: %v", err)
+		}
+		key, err := indexKey(value)
+		if err != nil {
+			return err
+		}
+		if err := b.Delete(key, nil); err != nil {
+			return fmt.Errorf("delete index key: %v", err)
+		}
+	}
+	if err := b.DeleteRange(lower, upper, nil); err != nil {
+		return fmt.Errorf("delete range: %v", err)
+	}
+	return nil
+}
+
 func (db *DB) Height() (uint64, error) {
-	heightBytesValue, closer, err := get(db.db, heightKey)
+	heightBytesValue, closer, err := get(db.hot, heightKey)
 	if err != nil {
 		return 0, err
 	}
@@ -236,9 +399,9 @@ func (db *DB) Height() (uint64, error) {
 
 func (db *DB) BlockByHeight(height uint64) (*monomer.Block, error) {
 	var block *monomer.Block
-	if err := db.view(func(s *pebble.Snapshot) error {
+	if err := db.view(func(_, cold *pebble.Snapshot) error {
 		var err error
-		block, err = blockByHeight(s, marshalUint64(height))
+		block, err = blockByHeight(cold, marshalUint64(height))
 		return err
 	}); err != nil {
 		return nil, err
@@ -261,12 +424,12 @@ func blockByHeight(s *pebble.Snapshot, heightBytes []byte) (*monomer.Block, erro
 func (db *DB) BlockByHash(hash common.Hash) (*monomer.Block, error) {
 	var header *monomer.Header
 	var txs bfttypes.Txs
-	if err := db.view(func(s *pebble.Snapshot) (err error) {
-		header, err = headerByHash(s, hash)
+	if err := db.view(func(_, cold *pebble.Snapshot) (err error) {
+		header, err = headerByHash(cold, hash)
 		if err != nil {
 			return fmt.Errorf("get header by hash: %w", err)
 		}
-		txs, err = txsInRange(s, marshalUint64(header.Height), marshalUint64(header.Height+1))
+		txs, err = txsInRange(cold, marshalUint64(header.Height), marshalUint64(header.Height+1))
 		if err != nil {
 			return err
 		}
@@ -280,13 +443,13 @@ func (db *DB) BlockByHash(hash common.Hash) (*monomer.Block, error) {
 func (db *DB) BlockByLabel(label eth.BlockLabel) (*monomer.Block, error) {
 	var header *monomer.Header
 	var txs bfttypes.Txs
-	if err := db.view(func(s *pebble.Snapshot) error {
+	if err := db.view(func(hot, cold *pebble.Snapshot) error {
 		var err error
-		header, err = headerByLabel(s, label)
+		header, err = headerByLabel(hot, cold, label)
 		if err != nil {
 			return fmt.Errorf("get header by label: %w", err)
 		}
-		txs, err = txsInRange(s, marshalUint64(header.Height), marshalUint64(header.Height+1))
+		txs, err = txsInRange(cold, marshalUint64(header.Height), marshalUint64(header.Height+1))
 		if err != nil {
 			return err
 		}
@@ -299,9 +462,9 @@ func (db *DB) BlockByLabel(label eth.BlockLabel) (*monomer.Block, error) {
 
 func (db *DB) HeaderByHash(hash common.Hash) (*monomer.Header, error) {
 	var header *monomer.Header
-	if err := db.view(func(s *pebble.Snapshot) error {
+	if err := db.view(func(_, cold *pebble.Snapshot) error {
 		var err error
-		header, err = headerByHash(s, hash)
+		header, err = headerByHash(cold, hash)
 		return err
 	}); err != nil {
 		return nil, err
@@ -311,9 +474,9 @@ func (db *DB) HeaderByHash(hash common.Hash) (*monomer.Header, error) {
 
 func (db *DB) HeaderByLabel(label eth.BlockLabel) (*monomer.Header, error) {
 	var header *monomer.Header
-	if err := db.view(func(s *pebble.Snapshot) error {
+	if err := db.view(func(hot, cold *pebble.Snapshot) error {
 		var err error
-		header, err = headerByLabel(s, label)
+		header, err = headerByLabel(hot, cold, label)
 		return err
 	}); err != nil {
 		return nil, err
@@ -322,13 +485,40 @@ func (db *DB) HeaderByLabel(label eth.BlockLabel) (*monomer.Header, error) {
 }
 
 func (db *DB) HeaderByHeight(height uint64) (*monomer.Header, error) {
-	return headerByHeight(db.db, marshalUint64(height))
+	return headerByHeight(db.cold, marshalUint64(height))
 }
 
-func txsInRange(s *pebble.Snapshot, startHeightBytes, endHeightBytes []byte) (_ bfttypes.Txs, err error) {
+// ArchivedHeaderByHeight returns the header at height, even if PruneBelow deleted its block.
+func (db *DB) ArchivedHeaderByHeight(height uint64) (*monomer.Header, error) {
+	var header *monomer.Header
+	if err := db.view(func(_, cold *pebble.Snapshot) error {
+		heightBytes := marshalUint64(height)
+		var err error
+		header, err = headerByHeight(cold, heightBytes)
+		if errors.Is(err, monomerdb.ErrNotFound) {
+			header, err = decodeHeader(cold, bucketPrunedHeaderByHeight.Key(heightBytes))
+		}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+// txsInRange returns the txs of the blocks from startHeightBytes up to endHeightBytes. Each block's txs are in one of
+// the tx buckets, depending on whether they are compressed, so it's only called with ranges of one block.
+func txsInRange(s *pebble.Snapshot, startHeightBytes, endHeightBytes []byte) (bfttypes.Txs, error) {
+	txs, err := txsInBucket(s, bucketTxByHeightAndIndex, startHeightBytes, endHeightBytes)
+	if err != nil || len(txs) > 0 {
+		return txs, err
+	}
+	return txsInBucket(s, bucketCompressedTxByHeightAndIndex, startHeightBytes, endHeightBytes)
+}
+
+func txsInBucket(s *pebble.Snapshot, bucket dbBucket, startHeightBytes, endHeightBytes []byte) (_ bfttypes.Txs, err error) {
 	iter, err := s.NewIter(&pebble.IterOptions{
-		LowerBound: bucketTxByHeightAndIndex.Key(startHeightBytes),
-		UpperBound: bucketTxByHeightAndIndex.Key(endHeightBytes),
+		LowerBound: bucket.Key(startHeightBytes),
+		UpperBound: bucket.Key(endHeightBytes),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("new iterator: %v", err)
@@ -342,19 +532,50 @@ func txsInRange(s *pebble.Snapshot, startHeightBytes, endHeightBytes []byte) (_
 		if err != nil {
 			return nil, fmt.Errorf("get value from iterator: %v", err)
 		}
-		tx := make([]byte, len(value))
-		copy(tx, value)
+		tx, err := decodeTx(bucket, value)
+		if err != nil {
+			return nil, err
+		}
 		txs = append(txs, tx)
 	}
 	return txs, nil
 }
 
+// encodeTx returns the key and value of the tx at the height and index. Compressed txs are kept in their own bucket,
+// since uncompressed txs can start with any byte.
+func encodeTx(c monomerdb.Compression, heightAndIndexBytes []byte, tx bfttypes.Tx) (key, value []byte, err error) {
+	if c == "" || c == monomerdb.CompressionNone {
+		return bucketTxByHeightAndIndex.Key(heightAndIndexBytes), tx, nil
+	}
+	value, err = monomerdb.Compress(c, tx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("compress tx: %v", err)
+	}
+	return bucketCompressedTxByHeightAndIndex.Key(heightAndIndexBytes), value, nil
+}
+
+// decodeTx returns a copy of the tx in the value of a key in bucket.
+func decodeTx(bucket dbBucket, value []byte) (bfttypes.Tx, error) {
+	if bucket != bucketCompressedTxByHeightAndIndex {
+		return slices.Clone(value), nil
+	}
+	tx, err := monomerdb.Decompress(value)
+	if err != nil {
+		return nil, fmt.Errorf("decompress tx: %v", err)
+	}
+	return tx, nil
+}
+
 type getter interface {
 	Get([]byte) ([]byte, io.Closer, error)
 }
 
-func headerByHeight(g getter, heightBytes []byte) (_ *monomer.Header, err error) {
-	headerBytes, closer, err := get(g, bucketHeaderByHeight.Key(heightBytes))
+func headerByHeight(g getter, heightBytes []byte) (*monomer.Header, error) {
+	return decodeHeader(g, bucketHeaderByHeight.Key(heightBytes))
+}
+
+func decodeHeader(g getter, key []byte) (_ *monomer.Header, err error) {
+	headerBytes, closer, err := get(g, key)
 	if err != nil {
 		return nil, err
 	}
@@ -369,68 +590,93 @@ func headerByHeight(g getter, heightBytes []byte) (_ *monomer.Header, err error)
 	return h, nil
 }
 
-func headerByLabel(s *pebble.Snapshot, label eth.BlockLabel) (_ *monomer.Header, err error) {
-	hashBytes, closer, err := get(s, bucketHashByLabel.Key([]byte(label)))
+func headerByLabel(hot, cold getter, label eth.BlockLabel) (_ *monomer.Header, err error) {
+	hashBytes, closer, err := get(hot, bucketHashByLabel.Key([]byte(label)))
 	if err != nil {
 		return nil, fmt.Errorf("get label hash: %w", err)
 	}
 	defer func() {
 		err = utils.WrapCloseErr(err, closer)
 	}()
-	header, err := headerByHash(s, common.Hash(hashBytes))
+	header, err := headerByHash(cold, common.Hash(hashBytes))
 	if err != nil {
 		return nil, fmt.Errorf("header by hash: %w", err)
 	}
 	return header, nil
 }
 
-func headerByHash(s *pebble.Snapshot, hash common.Hash) (_ *monomer.Header, err error) {
-	heightBytes, closer, err := get(s, bucketHeightByHash.Key(hash.Bytes()))
+func headerByHash(g getter, hash common.Hash) (_ *monomer.Header, err error) {
+	heightBytes, closer, err := get(g, bucketHeightByHash.Key(hash.Bytes()))
 	if err != nil {
 		return nil, fmt.Errorf("get height by hash: %w", err)
 	}
 	defer func() {
 		err = utils.WrapCloseErr(err, closer)
 	}()
-	header, err := headerByHeight(s, heightBytes)
+	header, err := headerByHeight(g, heightBytes)
 	if err != nil {
 		return nil, fmt.Errorf("get header by height: %w", err)
 	}
 	return header, nil
 }
 
-func (db *DB) view(cb func(*pebble.Snapshot) error) (err error) {
-	s := db.db.NewSnapshot()
-	defer func() {
-		err = utils.WrapCloseErr(err, s)
-	}()
-	return cb(s)
+func (db *DB) split() bool {
+	return db.hot != db.cold
 }
 
-func (db *DB) updateIndexed(cb func(*pebble.Batch) error) (err error) {
-	b := db.db.NewIndexedBatch()
+// view calls cb with snapshots of the keyspaces, which are the same snapshot unless the DB is split.
+func (db *DB) view(cb func(hot, cold *pebble.Snapshot) error) (err error) {
+	cold := db.cold.NewSnapshot()
 	defer func() {
-		err = utils.WrapCloseErr(err, b)
+		err = utils.WrapCloseErr(err, cold)
 	}()
-	if err := cb(b); err != nil {
-		return err
-	}
-	if err := b.Commit(pebble.Sync); err != nil {
-		return fmt.Errorf("commit: %v", err)
+	hot := cold
+	if db.split() {
+		hot = db.hot.NewSnapshot()
+		defer func() {
+			err = utils.WrapCloseErr(err, hot)
+		}()
 	}
-	return nil
+	return cb(hot, cold)
 }
 
-func (db *DB) update(cb func(*pebble.Batch) error) (err error) {
-	b := db.db.NewBatch()
+// write calls cb with batches of the keyspaces, which are the same batch unless the DB is split, and commits them.
+// Indexed batches can read their own writes. Split batches are committed one after the other, the hot batch first if
+// hotFirst is set.
+func (db *DB) write(indexed, hotFirst bool, cb func(hot, cold *pebble.Batch) error) (err error) {
+	newBatch := (*pebble.DB).NewBatch
+	if indexed {
+		newBatch = (*pebble.DB).NewIndexedBatch
+	}
+	cold := newBatch(db.cold)
 	defer func() {
-		err = utils.WrapCloseErr(err, b)
+		err = utils.WrapCloseErr(err, cold)
 	}()
-	if err := cb(b); err != nil {
+	hot := cold
+	if db.split() {
+		hot = newBatch(db.hot)
+		defer func() {
+			err = utils.WrapCloseErr(err, hot)
+		}()
+	}
+	if err := cb(hot, cold); err != nil {
 		return err
 	}
-	if err := b.Commit(pebble.Sync); err != nil {
-		return fmt.Errorf("commit: %v", err)
+
+	batches := []*pebble.Batch{cold}
+	if db.split() {
+		batches = append(batches, hot)
+		if hotFirst {
+			slices.Reverse(batches)
+		}
+	}
+	for _, b := range batches {
+		if b.Empty() {
+			continue
+		}
+		if err := b.Commit(pebble.Sync); err != nil {
+			return fmt.Errorf("commit: %v", err)
+		}
 	}
 	return nil
 }
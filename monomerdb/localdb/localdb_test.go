@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/polymerdao/monomer"
@@ -135,3 +137,180 @@ func TestRollback(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneBelow(t *testing.T) {
+	db := testutils.NewLocalMemDB(t)
+	blocks := []*monomer.Block{testutils.GenerateBlockWithParentAndTxs(t, &monomer.Header{}, testapp.ToTestTx(t, "k1", "v1"))}
+	for i := 2; i <= 4; i++ {
+		blocks = append(blocks, testutils.GenerateBlockWithParentAndTxs(
+			t,
+			blocks[len(blocks)-1].Header,
+			testapp.ToTestTx(t, fmt.Sprintf("k%d", i), fmt.Sprintf("v%d", i)),
+		))
+	}
+	for _, block := range blocks {
+		require.NoError(t, db.AppendBlock(block))
+	}
+	head := blocks[len(blocks)-1]
+	require.NoError(t, db.UpdateLabels(head.Header.Hash, head.Header.Hash, head.Header.Hash))
+
+	require.NoError(t, db.PruneBelow(head.Header.Height))
+	testHeadBlock(t, db, head)
+
+	// The genesis block is kept.
+	genesis, err := db.BlockByHeight(1)
+	require.NoError(t, err)
+	require.Equal(t, blocks[0], genesis)
+
+	for _, prunedBlock := range blocks[1:3] {
+		_, err := db.BlockByHeight(prunedBlock.Header.Height)
+		require.ErrorIs(t, err, monomerdb.ErrNotFound)
+		_, err = db.BlockByHash(prunedBlock.Header.Hash)
+		require.ErrorIs(t, err, monomerdb.ErrNotFound)
+	}
+
+	// The headers of every block, pruned or not, are still available from the archive.
+	for _, block := range blocks {
+		header, err := db.ArchivedHeaderByHeight(block.Header.Height)
+		require.NoError(t, err)
+		require.Equal(t, block.Header, header)
+	}
+	_, err = db.ArchivedHeaderByHeight(head.Header.Height + 1)
+	require.ErrorIs(t, err, monomerdb.ErrNotFound)
+}
+
+func TestUsageAndCompact(t *testing.T) {
+	db := testutils.NewLocalMemDB(t)
+	block := testutils.GenerateBlock(t)
+	require.NoError(t, db.AppendBlock(block))
+
+	// Compacting flushes the block to disk, so it's included in the bucket sizes.
+	require.NoError(t, db.Compact(nil, nil))
+	usage, err := db.Usage()
+	require.NoError(t, err)
+	require.Len(t, usage.Buckets, 8)
+	require.NotZero(t, usage.Buckets["header_by_height"])
+	require.NotZero(t, usage.Buckets["tx_by_height_and_index"])
+	require.Zero(t, usage.Buckets["pruned_header_by_height"])
+	require.NotZero(t, usage.DiskBytes)
+	require.NotZero(t, usage.Compactions)
+
+	// Compacting doesn't change the data.
+	got, err := db.BlockByHeight(block.Header.Height)
+	require.NoError(t, err)
+	require.Equal(t, block, got)
+}
+
+func openMemPebble(t *testing.T) *pebble.DB {
+	db, err := pebble.Open("", &pebble.Options{
+		FS: vfs.NewMem(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+func TestSplit(t *testing.T) {
+	hot := openMemPebble(t)
+	cold := openMemPebble(t)
+
+	// Blocks written to a single file are moved to the cold file.
+	genesis := testutils.GenerateBlockWithParentAndTxs(t, nil, testapp.ToTestTx(t, "k1", "v1"))
+	block := testutils.GenerateBlockWithParentAndTxs(t, genesis.Header, testapp.ToTestTx(t, "k2", "v2"))
+	single := localdb.New(hot)
+	require.NoError(t, single.AppendBlock(genesis))
+	require.NoError(t, single.AppendBlock(block))
+	require.NoError(t, single.UpdateLabels(block.Header.Hash, block.Header.Hash, block.Header.Hash))
+
+	db, err := localdb.NewSplit(hot, cold)
+	require.NoError(t, err)
+	testHeadBlock(t, db, block)
+	got, err := db.BlockByHeight(genesis.Header.Height)
+	require.NoError(t, err)
+	require.Equal(t, genesis, got)
+	_, err = single.HeaderByHeight(genesis.Header.Height)
+	require.ErrorIs(t, err, monomerdb.ErrNotFound)
+	_, err = localdb.New(cold).Height()
+	require.ErrorIs(t, err, monomerdb.ErrNotFound)
+
+	// Rolled back blocks are deleted from the cold file.
+	next := testutils.GenerateBlockWithParentAndTxs(t, block.Header, testapp.ToTestTx(t, "k3", "v3"))
+	require.NoError(t, db.AppendBlock(next))
+	require.NoError(t, db.Rollback(block.Header.Hash, block.Header.Hash, block.Header.Hash))
+	testHeadBlock(t, db, block)
+	_, err = db.BlockByHash(next.Header.Hash)
+	require.ErrorIs(t, err, monomerdb.ErrNotFound)
+
+	// Blocks written to the cold file without moving the height, e.g., by an append interrupted between the files, are
+	// deleted when the DB is opened again.
+	require.NoError(t, localdb.New(cold).AppendBlock(next))
+	db, err = localdb.NewSplit(hot, cold)
+	require.NoError(t, err)
+	testHeadBlock(t, db, block)
+	_, err = db.BlockByHash(next.Header.Hash)
+	require.ErrorIs(t, err, monomerdb.ErrNotFound)
+	_, err = db.BlockByHeight(next.Header.Height)
+	require.ErrorIs(t, err, monomerdb.ErrNotFound)
+}
+
+func TestCompression(t *testing.T) {
+	db := testutils.NewLocalMemDB(t)
+	blocks := []*monomer.Block{testutils.GenerateBlockWithParentAndTxs(t, &monomer.Header{}, testapp.ToTestTx(t, "k1", "v1"))}
+	appendBlock := func() {
+		i := len(blocks) + 1
+		block := testutils.GenerateBlockWithParentAndTxs(
+			t,
+			blocks[len(blocks)-1].Header,
+			testapp.ToTestTx(t, fmt.Sprintf("k%d", i), fmt.Sprintf("v%d", i)),
+			testapp.ToTestTx(t, fmt.Sprintf("k%d-2", i), fmt.Sprintf("v%d-2", i)),
+		)
+		require.NoError(t, db.AppendBlock(block))
+		blocks = append(blocks, block)
+	}
+	requireBlocks := func() {
+		for _, block := range blocks {
+			got, err := db.BlockByHeight(block.Header.Height)
+			require.NoError(t, err)
+			require.Equal(t, block, got)
+			got, err = db.BlockByHash(block.Header.Hash)
+			require.NoError(t, err)
+			require.Equal(t, block, got)
+		}
+	}
+	require.NoError(t, db.AppendBlock(blocks[0]))
+
+	// Blocks appended with different compressions are read back.
+	db.SetCompression(monomerdb.CompressionSnappy)
+	appendBlock()
+	db.SetCompression(monomerdb.CompressionZstd)
+	appendBlock()
+	requireBlocks()
+
+	// Recompressing rewrites every block, including the uncompressed ones, and compresses the blocks appended after it.
+	for _, c := range []monomerdb.Compression{monomerdb.CompressionZstd, monomerdb.CompressionNone, monomerdb.CompressionSnappy} {
+		require.NoError(t, db.Recompress(c))
+		requireBlocks()
+	}
+	appendBlock()
+	requireBlocks()
+	require.Error(t, db.Recompress("lz4"))
+
+	// Compacting flushes the txs to disk, so they are included in the bucket sizes.
+	require.NoError(t, db.Compact(nil, nil))
+	usage, err := db.Usage()
+	require.NoError(t, err)
+	require.NotZero(t, usage.Buckets["compressed_tx_by_height_and_index"])
+
+	// Compressed blocks are rolled back and pruned.
+	head := blocks[len(blocks)-2]
+	require.NoError(t, db.UpdateLabels(head.Header.Hash, head.Header.Hash, head.Header.Hash))
+	require.NoError(t, db.Rollback(head.Header.Hash, head.Header.Hash, head.Header.Hash))
+	_, err = db.BlockByHash(blocks[len(blocks)-1].Header.Hash)
+	require.ErrorIs(t, err, monomerdb.ErrNotFound)
+	require.NoError(t, db.PruneBelow(head.Header.Height))
+	_, err = db.BlockByHeight(blocks[1].Header.Height)
+	require.ErrorIs(t, err, monomerdb.ErrNotFound)
+	testHeadBlock(t, db, head)
+}
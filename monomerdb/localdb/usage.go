@@ -0,0 +1,73 @@
+package localdb
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+)
+
+// Usage is the disk usage of a DB. The sizes and compaction stats of a split DB are summed over both of its files.
+type Usage struct {
+	// Buckets is the estimated size on disk of each bucket's keys and values, by bucket name.
+	Buckets map[string]uint64
+	// DiskBytes is the size of the DB's files, including the ones waiting to be deleted after a compaction.
+	DiskBytes uint64
+	// CompactionDebt is the estimated number of bytes that need to be compacted before the DB's files are stable.
+	CompactionDebt uint64
+	// Compactions is the number of compactions since the DB was opened.
+	Compactions int64
+	// CompactionsInProgress is the number of compactions running.
+	CompactionsInProgress int64
+}
+
+// Usage returns the DB's disk usage. Recent writes may still be in memory, so they aren't included in the bucket sizes.
+func (db *DB) Usage() (*Usage, error) {
+	usage := &Usage{
+		Buckets: make(map[string]uint64, len(buckets)),
+	}
+	for _, bucket := range buckets {
+		pebbleDB := db.hot
+		if bucket.cold() {
+			pebbleDB = db.cold
+		}
+		size, err := pebbleDB.EstimateDiskUsage(bucket.Key(), (bucket + 1).Key())
+		if err != nil {
+			return nil, fmt.Errorf("estimate disk usage of %s: %v", bucket, err)
+		}
+		usage.Buckets[bucket.String()] = size
+	}
+	for _, pebbleDB := range db.pebbleDBs() {
+		metrics := pebbleDB.Metrics()
+		usage.DiskBytes += metrics.DiskSpaceUsage()
+		usage.CompactionDebt += metrics.Compact.EstimatedDebt
+		usage.Compactions += metrics.Compact.Count
+		usage.CompactionsInProgress += metrics.Compact.NumInProgress
+	}
+	return usage, nil
+}
+
+// pebbleDBs returns the pebble DBs that hold the keyspaces.
+func (db *DB) pebbleDBs() []*pebble.DB {
+	if db.split() {
+		return []*pebble.DB{db.hot, db.cold}
+	}
+	return []*pebble.DB{db.cold}
+}
+
+// Compact compacts the keys from start up to limit, like geth's ethdb.Compacter. A nil start is the first key and a nil
+// limit is past the last key. It blocks until the compaction is done, but blocks can still be written meanwhile.
+func (db *DB) Compact(start, limit []byte) error {
+	if start == nil {
+		start = []byte{0}
+	}
+	if limit == nil {
+		// Bucket prefixes are small, so no key starts with 0xff.
+		limit = []byte{0xff}
+	}
+	for _, pebbleDB := range db.pebbleDBs() {
+		if err := pebbleDB.Compact(start, limit, true); err != nil {
+			return fmt.Errorf("compact: %v", err)
+		}
+	}
+	return nil
+}
@@ -6,8 +6,11 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/sourcegraph/conc"
 )
 
@@ -46,3 +49,90 @@ func (h *httpService) Run(ctx context.Context) error {
 	}
 	return nil
 }
+
+// websocketOrHTTPHandler serves websocket upgrade requests with ws and all other requests with h.
+func websocketOrHTTPHandler(ws, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+			strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade") {
+			ws.ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// newRPCHandler serves the enabled namespaces of apis, except the excluded ones, over websockets or HTTP. Nil enabled
+// serves every namespace. The local namespaces are only served to clients connecting from a loopback address.
+func newRPCHandler(apis []rpc.API, enabled, local, excluded []string, websocket bool) (http.Handler, error) {
+	newHandler := func(local []string) (http.Handler, error) {
+		server, err := newRPCServer(apis, enabled, slices.Concat(excluded, local))
+		if err != nil {
+			return nil, err
+		}
+		if websocket {
+			return server.WebsocketHandler([]string{}), nil
+		}
+		return server, nil
+	}
+	if err := checkNamespaces(apis, local); err != nil {
+		return nil, err
+	}
+	localHandler, err := newHandler(nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(local) == 0 {
+		return localHandler, nil
+	}
+	publicHandler, err := newHandler(local)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isLoopback(r.RemoteAddr) {
+			localHandler.ServeHTTP(w, r)
+			return
+		}
+		publicHandler.ServeHTTP(w, r)
+	}), nil
+}
+
+// newRPCServer registers the enabled namespaces of apis, except the excluded ones. Nil enabled enables every namespace.
+func newRPCServer(apis []rpc.API, enabled, excluded []string) (*rpc.Server, error) {
+	if err := checkNamespaces(apis, enabled); err != nil {
+		return nil, err
+	}
+	server := rpc.NewServer()
+	for _, api := range apis {
+		if enabled != nil && !slices.Contains(enabled, api.Namespace) || slices.Contains(excluded, api.Namespace) {
+			continue
+		}
+		if err := server.RegisterName(api.Namespace, api.Service); err != nil {
+			return nil, fmt.Errorf("register %s API: %v", api.Namespace, err)
+		}
+	}
+	return server, nil
+}
+
+// checkNamespaces returns an error if a namespace is not in apis.
+func checkNamespaces(apis []rpc.API, namespaces []string) error {
+	for _, namespace := range namespaces {
+		if !slices.ContainsFunc(apis, func(api rpc.API) bool {
+			return api.Namespace == namespace
+		}) {
+			return fmt.Errorf("unknown namespace %q", namespace)
+		}
+	}
+	return nil
+}
+
+// isLoopback reports whether addr, a host:port pair, is a loopback address.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
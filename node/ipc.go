@@ -0,0 +1,38 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ipcService serves JSON-RPC over a unix socket, the transport geth calls IPC.
+type ipcService struct {
+	server   *rpc.Server
+	listener net.Listener
+}
+
+func (i *ipcService) Run(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- i.server.ServeListener(i.listener)
+	}()
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("serve: %v", err)
+	case <-ctx.Done():
+		// Close the listener first so no connections are accepted after the open ones are closed.
+		closeErr := i.listener.Close()
+		i.server.Stop()
+		if err := <-errCh; !errors.Is(err, net.ErrClosed) {
+			return fmt.Errorf("serve: %v", err)
+		}
+		if closeErr != nil {
+			return fmt.Errorf("close listener: %v", closeErr)
+		}
+	}
+	return nil
+}
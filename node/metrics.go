@@ -0,0 +1,29 @@
+package node
+
+import (
+	"time"
+
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "node"
+
+// registerStartMetrics registers the time the node started at and its uptime. Unlike the process metrics, they are
+// about the node, which an embedding binary may start later than the process or more than once.
+func registerStartMetrics(namespace string, start time.Time) {
+	promauto.NewGauge(stdprometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: MetricsSubsystem,
+		Name:      "start_time_seconds",
+		Help:      "Unix time the node started at in seconds",
+	}).Set(float64(start.UnixNano()) / float64(time.Second))
+	promauto.NewGaugeFunc(stdprometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: MetricsSubsystem,
+		Name:      "uptime_seconds",
+		Help:      "Time since the node started in seconds",
+	}, func() float64 {
+		return time.Since(start).Seconds()
+	})
+}
@@ -2,11 +2,20 @@ package node
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
 	cometdb "github.com/cometbft/cometbft-db"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/config"
@@ -17,20 +26,46 @@ import (
 	bfttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	opeth "github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/triedb"
 	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/addrconv"
+	"github.com/polymerdao/monomer/admission"
 	"github.com/polymerdao/monomer/app/peptide/txstore"
+	"github.com/polymerdao/monomer/audit"
+	"github.com/polymerdao/monomer/blockcache"
 	"github.com/polymerdao/monomer/builder"
+	"github.com/polymerdao/monomer/buildinfo"
+	"github.com/polymerdao/monomer/bundles"
 	"github.com/polymerdao/monomer/comet"
+	"github.com/polymerdao/monomer/compaction"
+	"github.com/polymerdao/monomer/crash"
+	"github.com/polymerdao/monomer/depositsla"
 	"github.com/polymerdao/monomer/engine"
 	"github.com/polymerdao/monomer/environment"
 	"github.com/polymerdao/monomer/eth"
+	"github.com/polymerdao/monomer/firehose"
 	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/heads"
+	"github.com/polymerdao/monomer/jwtauth"
+	"github.com/polymerdao/monomer/localconsensus"
 	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/mempoolsync"
 	"github.com/polymerdao/monomer/monomerdb"
+	"github.com/polymerdao/monomer/monomerdb/localdb"
+	"github.com/polymerdao/monomer/opnode"
+	"github.com/polymerdao/monomer/pruning"
+	"github.com/polymerdao/monomer/querycall"
+	"github.com/polymerdao/monomer/surgery"
+	"github.com/polymerdao/monomer/systemconfig"
+	"github.com/polymerdao/monomer/txforward"
+	"github.com/polymerdao/monomer/utils"
+	"github.com/polymerdao/monomer/witness"
 	"github.com/sourcegraph/conc"
 )
 
@@ -38,7 +73,24 @@ type EventListener interface {
 	OnEngineHTTPServeErr(error)
 	OnEngineWebsocketServeErr(error)
 	OnCometServeErr(error)
+	OnIPCServeErr(error)
 	OnPrometheusServeErr(error)
+	OnFirehoseErr(error)
+	OnPruningErr(error)
+	OnBuilderAPIServeErr(error)
+	// OnCrash is called with a *crash.Error when a subsystem panics. The node can't continue and must be stopped.
+	OnCrash(error)
+	OnLocalSequencerErr(error)
+	OnCompactionErr(error)
+	OnOPNodeMonitorErr(error)
+	OnEngineJWTErr(error)
+	OnBuilderJWTErr(error)
+	OnSystemConfigErr(error)
+	OnMempoolSyncErr(error)
+	OnDepositSLAErr(error)
+	// OnEngineCompatibilityWarn is called with a *engine.DeprecationWarning when op-node calls a deprecated Engine API
+	// method version, and with a *engine.CapabilitiesWarning when op-node's methods don't match Monomer's.
+	OnEngineCompatibilityWarn(error)
 }
 
 type DB interface {
@@ -53,56 +105,575 @@ type DB interface {
 	BlockByHeight(uint64) (*monomer.Block, error)
 	BlockByHash(hash common.Hash) (*monomer.Block, error)
 	HeadBlock() (*monomer.Block, error)
+	ArchivedHeaderByHeight(uint64) (*monomer.Header, error)
 }
 
+const (
+	// DefaultEngineAddress is the address of the Engine API websocket server when Config.EngineListener is nil.
+	DefaultEngineAddress = "127.0.0.1:8551"
+	// DefaultCometAddress is the address of the CometBFT-compatible RPC server when Config.CometListener is nil.
+	DefaultCometAddress = "127.0.0.1:26657"
+)
+
+// Config configures a Node. All fields are optional.
+// Unset databases default to in-memory databases, and unset listeners listen on the default addresses.
+type Config struct {
+	// AppchainCtx is used to sign the transactions the Engine API adds to each block.
+	AppchainCtx *client.Context
+	// EngineListener serves the Engine API and the eth, debug, and monomer namespaces over websockets and HTTP.
+	EngineListener net.Listener
+	// CometListener serves the CometBFT-compatible RPC over HTTP and websockets.
+	CometListener net.Listener
+	// IPCListener, usually a unix socket, serves the namespaces EngineListener serves to a co-located op-node or other
+	// client, without exposing them on the network. IPC is disabled by default.
+	IPCListener net.Listener
+	BlockDB     DB
+	MempoolDB   dbm.DB
+	// WALDB stores the payload being built, so a block interrupted by a crash is rebuilt on the next start.
+	WALDB      dbm.DB
+	TxDB       cometdb.DB
+	EthStateDB state.Database
+	// Instrumentation enables the Prometheus metrics server.
+	Instrumentation *config.InstrumentationConfig
+	EventListener   EventListener
+	Hooks           *Hooks
+	// Firehose enables the Firehose extraction mode: every block the node builds is written to it for a Firehose reader.
+	// Usually os.Stdout.
+	Firehose io.Writer
+	// AdmissionPolicy is evaluated on every tx submitted to the mempool. It requires AppchainCtx to decode txs.
+	// The caller must close it after the node stops.
+	AdmissionPolicy *admission.Policy
+	// AuditLog records the node's start and stop and the Engine API's forkchoice updates. It defaults to discarding
+	// entries. The caller must close it after the node stops.
+	AuditLog *audit.Log
+	// MaxRejectedTxs is the number of rejected txs the mempool remembers for tx_status and rejected_txs. It defaults to
+	// mempool.DefaultMaxRejections.
+	MaxRejectedTxs uint64
+	// MempoolSize is the number of txs and batches the mempool holds before it evicts the txs that pay the lowest gas
+	// price. It defaults to mempool.DefaultMaxSize.
+	MempoolSize uint64
+	// MempoolFeeDenom is the fee denom the mempool orders txs by: txs that pay more of it per unit of gas are included
+	// first. If it is empty, txs are included in the order they were submitted. Either way, each sender's txs are included
+	// in the order of their sequences. It requires AppchainCtx to decode txs.
+	MempoolFeeDenom string
+	// BuilderInterceptors add txs to the blocks the node builds, e.g., a forcedinclusion.List.
+	BuilderInterceptors []builder.Interceptor
+	// Pruning prunes old app state and blocks, keeping what fault proofs may still need. It requires an app that
+	// implements pruning.App and a BlockDB that implements pruning.BlockStore. Nothing is pruned by default.
+	Pruning *pruning.Config
+	// Compaction compacts the block store and the eth state db between blocks. It requires a BlockDB that implements
+	// compaction.BlockStore. The block store's disk usage is reported whenever Prometheus is enabled, but nothing is
+	// compacted by default.
+	Compaction *compaction.Config
+	// HTTPAPIs and WSAPIs are the namespaces EngineListener serves over HTTP and websockets, out of engine, eth, debug,
+	// and monomer. Nil serves all of them.
+	HTTPAPIs []string
+	WSAPIs   []string
+	// LocalAPIs are only served to clients connecting from a loopback address, e.g., debug. They must also be in
+	// HTTPAPIs or WSAPIs to be served at all.
+	LocalAPIs []string
+	// QueryTimeout is the deadline of abci_query requests. Queries stop when it passes or the client disconnects.
+	// Zero means queries have no deadline.
+	QueryTimeout time.Duration
+	// QueryCacheSize is the number of successful abci_query and eth_call query responses cached by block hash and request.
+	// Zero disables the cache.
+	QueryCacheSize int
+	// QueryCacheTTL is how long query responses are cached. It defaults to comet.DefaultQueryCacheTTL.
+	QueryCacheTTL time.Duration
+	// BlockCacheSize is the memory budget in bytes of the cache of recent blocks, headers, and tx results the eth and
+	// comet namespaces share. Zero disables the cache.
+	BlockCacheSize int
+	// Compression is how the txs in blocks and the tx results are compressed when they are stored. It requires a BlockDB
+	// that implements monomerdb.Compressor unless it's monomerdb.CompressionNone, the default. Data stored before it was
+	// changed keeps its compression until it's recompressed, e.g., with localdb.DB.Recompress and txstore.Recompress.
+	Compression monomerdb.Compression
+	// IPCAPIs are the namespaces IPCListener serves. Nil serves all of them. Only local clients can connect, so
+	// LocalAPIs don't apply.
+	IPCAPIs []string
+	// Bundles includes the bundles external block builders submit in the blocks the node builds. It is disabled by
+	// default.
+	Bundles *bundles.Market
+	// BuilderAPIListener serves the builder API, where the builders with BuilderSecrets submit bundles to Bundles.
+	// BuilderSecrets are the builders' JWT secrets, keyed by name, which are reloaded while the node runs. The builder
+	// API is disabled by default.
+	BuilderAPIListener net.Listener
+	BuilderSecrets     map[string]*jwtauth.Secrets
+	// CrashDir is where crash dumps are written when a subsystem panics. If it is empty, no dumps are written, but
+	// panics are still recovered and reported to EventListener.OnCrash.
+	CrashDir string
+	// LocalBlockTime enables local consensus: the node builds a block every LocalBlockTime itself, instead of waiting for
+	// op-node, so apps can be developed offline without an OP stack. The engine namespace isn't served then, since the
+	// builder mustn't be driven by op-node at the same time.
+	LocalBlockTime time.Duration
+	// LocalTimeStep is the time between the timestamps of consecutive blocks with local consensus, truncated to
+	// seconds, so time-dependent app logic can be tested deterministically. Zero timestamps blocks with the wall clock.
+	LocalTimeStep time.Duration
+	// GenesisHash is the hash the genesis block must have, e.g., the rollup config's genesis.l2.hash. The node refuses to
+	// start if its genesis block has another hash, since op-node would stall at the next block. It isn't checked if it's
+	// zero.
+	GenesisHash common.Hash
+	// OPNodeMonitor monitors the op-node driving the node and reports derivation lag and stalls through the metrics and
+	// the health route. It is disabled if nil.
+	OPNodeMonitor *opnode.Config
+	// EngineJWT authenticates the engine namespace's JSON-RPC requests to EngineListener, which must carry a token signed
+	// with its secret, as op-node sends them. Requests without a token are served the other namespaces, and requests with
+	// an invalid token are rejected. Its secret file is reloaded while the node runs. The head event streams aren't
+	// authenticated. Nil accepts unauthenticated requests.
+	EngineJWT *jwtauth.Secrets
+	// WitnessDB enables witness recording: the execution witness of every block the node builds is stored in it and
+	// served by monomer_getWitness. Witnesses aren't recorded if it is nil.
+	WitnessDB dbm.DB
+	// SystemConfig checks the chain's SystemConfig on L1 against the expected values and reports the parameters that
+	// diverge through the metrics and EventListener.OnSystemConfigErr. It is disabled if nil.
+	SystemConfig *systemconfig.Config
+	// TxForwarding relays the txs submitted with broadcast_tx and eth_sendRawTransaction to the sequencer instead of
+	// adding them to the node's mempool, for nodes that don't sequence, e.g., public RPC nodes. The txs are still checked
+	// against the node's state first. It can't be used with local consensus. It is disabled if nil.
+	TxForwarding *txforward.Config
+	// MempoolSync polls trusted peers for their pending txs, so tx_status, unconfirmed_txs, and pending_sequence report
+	// them along with the node's own. Errors are reported to EventListener.OnMempoolSyncErr. It is disabled if nil.
+	MempoolSync *mempoolsync.Config
+	// QueryCalls routes eth_call to its reserved addresses to the app's gRPC queries, so Solidity tooling can read
+	// module state. It defaults to querycall.NewDefaultRouter with the app's bech32 account prefix, and apps register
+	// routes for their own modules' queries on it.
+	QueryCalls *querycall.Router
+	// DepositSLA tracks how long deposits take to be included and reports it through the metrics. Breaches of its maximum
+	// inclusion delay are reported to EventListener.OnDepositSLAErr. It is disabled if nil.
+	DepositSLA *depositsla.Config
+	// FreezeHeight halts the chain at a height: blocks above it aren't built, but the node keeps serving the chain, e.g.,
+	// while a StatePatch is prepared. Zero doesn't freeze the chain.
+	FreezeHeight uint64
+	// StatePatch patches the app state before executing the txs of the block after its height, for break-glass recovery
+	// from incidents. It requires an app that implements surgery.State and a patch for the genesis's chain. The caller
+	// must check its approvals with surgery.Patch.Verify. Applying it is recorded in AuditLog. It is ignored once the
+	// chain is past the block it patches, unless that block is rebuilt after a rollback.
+	StatePatch *surgery.Patch
+	// Replica runs the node as a read replica of the sequencer, driven by a verifier op-node that derives the chain from
+	// L1 and, if it follows the sequencer's gossip, inserts its unsafe blocks. The node doesn't sequence: the Engine API rejects payload attributes
+	// that would include the mempool's txs, which only derived attributes exclude. It requires TxForwarding, so the txs
+	// submitted to the node reach the sequencer, and can't be used with local consensus or the builder API.
+	Replica bool
+}
+
+// Hooks are called at points in the node's lifecycle. All fields are optional.
+type Hooks struct {
+	// OnStart is called after all servers have started.
+	// An error aborts the start.
+	OnStart func(context.Context) error
+	// OnStop is called after all servers have stopped and before the databases are closed.
+	OnStop func() error
+}
+
+// Node runs a Monomer node in-process.
+// New, Run, Start, Config, and Hooks follow semantic versioning and are the supported way to embed Monomer in an
+// appchain binary.
 type Node struct {
 	app            monomer.Application
 	appchainCtx    *client.Context
 	genesis        *genesis.Genesis
 	engineWS       net.Listener
 	cometHTTPAndWS net.Listener
+	ipc            net.Listener
 	blockdb        DB
 	txdb           cometdb.DB
 	mempooldb      dbm.DB
+	waldb          dbm.DB
 	ethstatedb     state.Database
 	prometheusCfg  *config.InstrumentationConfig
 	eventListener  EventListener
+	hooks          *Hooks
+	firehose       io.Writer
+	admission      *admission.Policy
+	auditLog       *audit.Log
+	maxRejectedTxs uint64
+	mempoolSize    uint64
+	feeDenom       string
+	interceptors   []builder.Interceptor
+	pruning        *pruning.Config
+	compaction     *compaction.Config
+	httpAPIs       []string
+	wsAPIs         []string
+	localAPIs      []string
+	ipcAPIs        []string
+	queryTimeout   time.Duration
+	queryCacheSize int
+	queryCacheTTL  time.Duration
+	blockCacheSize int
+	compression    monomerdb.Compression
+	bundles        *bundles.Market
+	builderAPI     net.Listener
+	builderSecrets map[string]*jwtauth.Secrets
+	crashDir       string
+	crash          *crash.Handler
+	crashed        chan error
+	localBlockTime time.Duration
+	localTimeStep  time.Duration
+	genesisHash    common.Hash
+	opNodeMonitor  *opnode.Config
+	engineJWT      *jwtauth.Secrets
+	witnessdb      dbm.DB
+	systemConfig   *systemconfig.Config
+	txForwarding   *txforward.Config
+	mempoolSync    *mempoolsync.Config
+	queryCalls     *querycall.Router
+	depositSLA     *depositsla.Config
+	freezeHeight   uint64
+	statePatch     *surgery.Patch
+	replica        bool
 }
 
-func New(
-	app monomer.Application,
-	appchainCtx *client.Context,
-	g *genesis.Genesis,
-	engineWS net.Listener,
-	cometHTTPAndWS net.Listener,
-	blockdb DB,
-	mempooldb dbm.DB,
-	txdb cometdb.DB,
-	ethstatedb state.Database,
-	prometheusCfg *config.InstrumentationConfig,
-	eventListener EventListener,
-) *Node {
-	return &Node{
+// New creates a Node for app. The genesis is committed on the first start. A nil cfg uses the defaults.
+func New(app monomer.Application, g *genesis.Genesis, cfg *Config) *Node {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	n := &Node{
 		app:            app,
-		appchainCtx:    appchainCtx,
+		appchainCtx:    cfg.AppchainCtx,
 		genesis:        g,
-		engineWS:       engineWS,
-		cometHTTPAndWS: cometHTTPAndWS,
-		blockdb:        blockdb,
-		txdb:           txdb,
-		ethstatedb:     ethstatedb,
-		mempooldb:      mempooldb,
-		prometheusCfg:  prometheusCfg,
-		eventListener:  eventListener,
+		engineWS:       cfg.EngineListener,
+		cometHTTPAndWS: cfg.CometListener,
+		ipc:            cfg.IPCListener,
+		blockdb:        cfg.BlockDB,
+		txdb:           cfg.TxDB,
+		ethstatedb:     cfg.EthStateDB,
+		mempooldb:      cfg.MempoolDB,
+		waldb:          cfg.WALDB,
+		prometheusCfg:  cfg.Instrumentation,
+		eventListener:  cfg.EventListener,
+		hooks:          cfg.Hooks,
+		firehose:       cfg.Firehose,
+		admission:      cfg.AdmissionPolicy,
+		auditLog:       cfg.AuditLog,
+		maxRejectedTxs: cfg.MaxRejectedTxs,
+		mempoolSize:    cfg.MempoolSize,
+		feeDenom:       cfg.MempoolFeeDenom,
+		interceptors:   cfg.BuilderInterceptors,
+		pruning:        cfg.Pruning,
+		compaction:     cfg.Compaction,
+		httpAPIs:       cfg.HTTPAPIs,
+		wsAPIs:         cfg.WSAPIs,
+		localAPIs:      cfg.LocalAPIs,
+		ipcAPIs:        cfg.IPCAPIs,
+		queryTimeout:   cfg.QueryTimeout,
+		queryCacheSize: cfg.QueryCacheSize,
+		queryCacheTTL:  cfg.QueryCacheTTL,
+		blockCacheSize: cfg.BlockCacheSize,
+		compression:    cfg.Compression,
+		bundles:        cfg.Bundles,
+		builderAPI:     cfg.BuilderAPIListener,
+		builderSecrets: cfg.BuilderSecrets,
+		crashDir:       cfg.CrashDir,
+		crashed:        make(chan error, 1),
+		localBlockTime: cfg.LocalBlockTime,
+		localTimeStep:  cfg.LocalTimeStep,
+		genesisHash:    cfg.GenesisHash,
+		opNodeMonitor:  cfg.OPNodeMonitor,
+		engineJWT:      cfg.EngineJWT,
+		witnessdb:      cfg.WitnessDB,
+		systemConfig:   cfg.SystemConfig,
+		txForwarding:   cfg.TxForwarding,
+		mempoolSync:    cfg.MempoolSync,
+		queryCalls:     cfg.QueryCalls,
+		depositSLA:     cfg.DepositSLA,
+		freezeHeight:   cfg.FreezeHeight,
+		statePatch:     cfg.StatePatch,
+		replica:        cfg.Replica,
+	}
+	if n.prometheusCfg == nil {
+		n.prometheusCfg = config.DefaultInstrumentationConfig()
+	}
+	if n.eventListener == nil {
+		n.eventListener = &SelectiveListener{}
+	}
+	if n.hooks == nil {
+		n.hooks = &Hooks{}
+	}
+	if n.auditLog == nil {
+		n.auditLog = audit.New(io.Discard)
+	}
+	if n.queryCacheTTL == 0 {
+		n.queryCacheTTL = comet.DefaultQueryCacheTTL
+	}
+	return n
+}
+
+// Run starts the node and blocks until ctx is done or a subsystem crashes. It then stops the node and releases its
+// resources. If a subsystem crashed, Run returns its *crash.Error.
+func (n *Node) Run(ctx context.Context) (err error) {
+	env := environment.New()
+	defer func() {
+		err = utils.WrapCloseErr(err, env)
+	}()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if err := n.Start(ctx, env); err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-n.crashed:
+		return err
+	}
+}
+
+// Start starts the node without blocking. The servers run until ctx is done, and the node's resources are released
+// when env is closed.
+func (n *Node) Start(ctx context.Context, env *environment.Env) error {
+	if err := buildinfo.CheckMinVersion(buildinfo.Version(), n.genesis.MinClientVersion); err != nil {
+		return fmt.Errorf("check the genesis's minimum client version: %v", err)
+	}
+	if err := n.openDefaults(env); err != nil {
+		return err
+	}
+	if err := n.start(ctx, env); err != nil {
+		return err
+	}
+	if n.hooks.OnStop != nil {
+		env.DeferErr("run stop hook", n.hooks.OnStop)
+	}
+	if err := n.recordLifecycle(ctx, env); err != nil {
+		return err
+	}
+	if n.hooks.OnStart != nil {
+		if err := n.hooks.OnStart(ctx); err != nil {
+			return fmt.Errorf("run start hook: %v", err)
+		}
+	}
+	return nil
+}
+
+// setStatePatch makes the builder apply the state patch and record it in the audit log.
+func (n *Node) setStatePatch(ctx context.Context, b *builder.Builder) error {
+	patch := n.statePatch
+	state, ok := n.app.(surgery.State)
+	if !ok {
+		return errors.New("state patches require an app that implements surgery.State")
+	}
+	if patch.ChainID != uint64(n.genesis.ChainID) {
+		return fmt.Errorf("state patch is for chain %d, not %s", patch.ChainID, n.genesis.ChainID)
+	}
+	if err := n.auditLog.Record(ctx, audit.ActionStatePatch, map[string]string{
+		"height":     fmt.Sprint(patch.Height),
+		"patch_hash": patch.Hash,
+		"reason":     patch.Reason,
+		"author":     patch.Author,
+		"approvals":  strings.Join(patch.Approvals, ","),
+		"ops":        fmt.Sprint(len(patch.Ops)),
+	}); err != nil {
+		return fmt.Errorf("record state patch: %v", err)
+	}
+	b.SetStatePatch(patch, state, func(ctx context.Context, block *monomer.Block) error {
+		return n.auditLog.Record(ctx, audit.ActionStatePatchApplied, map[string]string{
+			"height":     fmt.Sprint(block.Header.Height),
+			"hash":       block.Header.Hash.String(),
+			"patch_hash": patch.Hash,
+		})
+	})
+	return nil
+}
+
+// recordLifecycle records the start in the audit log and defers recording the stop.
+func (n *Node) recordLifecycle(ctx context.Context, env *environment.Env) error {
+	height, err := n.blockdb.Height()
+	if err != nil {
+		return fmt.Errorf("get height: %v", err)
+	}
+	if err := n.auditLog.Record(ctx, audit.ActionNodeStart, map[string]string{
+		"chain_id": n.genesis.ChainID.String(),
+		"height":   fmt.Sprint(height),
+	}); err != nil {
+		return fmt.Errorf("record start: %v", err)
+	}
+	env.DeferErr("record stop", func() error {
+		height, err := n.blockdb.Height()
+		if err != nil {
+			return fmt.Errorf("get height: %v", err)
+		}
+		return n.auditLog.Record(context.Background(), audit.ActionNodeStop, map[string]string{
+			"height": fmt.Sprint(height),
+		})
+	})
+	return nil
+}
+
+// openDefaults opens the listeners and in-memory databases that were not provided in the Config.
+func (n *Node) openDefaults(env *environment.Env) error {
+	if n.blockdb == nil {
+		blockPebbleDB, err := pebble.Open("", &pebble.Options{
+			FS: vfs.NewMem(),
+		})
+		if err != nil {
+			return fmt.Errorf("open block db: %v", err)
+		}
+		env.DeferErr("close block db", blockPebbleDB.Close)
+		n.blockdb = localdb.New(blockPebbleDB)
+	}
+	if n.txdb == nil {
+		txdb := cometdb.NewMemDB()
+		env.DeferErr("close tx db", txdb.Close)
+		n.txdb = txdb
+	}
+	if n.mempooldb == nil {
+		mempooldb := dbm.NewMemDB()
+		env.DeferErr("close mempool db", mempooldb.Close)
+		n.mempooldb = mempooldb
+	}
+	if n.waldb == nil {
+		waldb := dbm.NewMemDB()
+		env.DeferErr("close wal db", waldb.Close)
+		n.waldb = waldb
+	}
+	if n.ethstatedb == nil {
+		rawDB := rawdb.NewMemoryDatabase()
+		env.DeferErr("close raw db", rawDB.Close)
+		trieDB := triedb.NewDatabase(rawDB, nil)
+		env.DeferErr("close trieDB", trieDB.Close)
+		n.ethstatedb = state.NewDatabaseWithNodeDB(rawDB, trieDB)
+	}
+	if n.engineWS == nil {
+		engineWS, err := net.Listen("tcp", DefaultEngineAddress)
+		if err != nil {
+			return fmt.Errorf("set up engine listener: %v", err)
+		}
+		n.engineWS = engineWS
 	}
+	if n.cometHTTPAndWS == nil {
+		cometListener, err := net.Listen("tcp", DefaultCometAddress)
+		if err != nil {
+			return fmt.Errorf("set up comet listener: %v", err)
+		}
+		n.cometHTTPAndWS = cometListener
+	}
+	return nil
+}
+
+// newPruner returns a pruner that prunes blockdb, which is the node's block db or a blockcache.BlockStore wrapping it.
+// The cache always implements pruning.BlockStore, so the node's block db is checked instead.
+func (n *Node) newPruner(blockdb DB) (*pruning.Pruner, error) {
+	app, ok := n.app.(pruning.App)
+	if !ok {
+		return nil, errors.New("pruning requires an app that implements pruning.App")
+	}
+	if _, ok := n.blockdb.(pruning.BlockStore); !ok {
+		return nil, errors.New("pruning requires a block db that implements pruning.BlockStore")
+	}
+	return pruning.NewPruner(app, blockdb.(pruning.BlockStore), n.pruning), nil
 }
 
-func (n *Node) Run(ctx context.Context, env *environment.Env) error {
+func (n *Node) start(ctx context.Context, env *environment.Env) error {
+	configHash, err := n.configHash()
+	if err != nil {
+		return err
+	}
+	n.crash = crash.NewHandler(n.crashDir, configHash, func(err error) {
+		n.eventListener.OnCrash(err)
+		n.crashed <- err
+	})
+
 	if err := prepareBlockStoreAndApp(ctx, n.genesis, n.blockdb, n.ethstatedb, n.app); err != nil {
 		return err
 	}
-	txStore := txstore.NewTxStore(n.txdb)
+	if n.genesisHash != (common.Hash{}) {
+		genesisHeader, err := n.blockdb.HeaderByHeight(1)
+		if err != nil {
+			return fmt.Errorf("get genesis block: %v", err)
+		}
+		if genesisHeader.Hash != n.genesisHash {
+			return fmt.Errorf("genesis block hash %s does not match the expected %s: the genesis is for another chain",
+				genesisHeader.Hash, n.genesisHash)
+		}
+	}
+	ethMetrics, engineMetrics, cometMetrics, blockCacheMetrics, compactionMetrics, opNodeMetrics, systemConfigMetrics,
+		txForwardMetrics, mempoolSyncMetrics, depositSLAMetrics, builderMetrics := n.registerMetrics()
+	// The builder's counters continue from the totals of earlier runs, so they don't reset when the node restarts.
+	wal := builder.NewWAL(n.waldb)
+	totals, err := wal.Totals()
+	if err != nil {
+		return fmt.Errorf("get builder totals: %v", err)
+	}
+	builderMetrics.RecordTotals(totals)
+	if compressor, ok := n.blockdb.(monomerdb.Compressor); ok {
+		compressor.SetCompression(n.compression)
+	} else if n.compression != "" && n.compression != monomerdb.CompressionNone {
+		return errors.New("compression requires a block db that implements monomerdb.Compressor")
+	}
+	var blockdb DB = n.blockdb
+	txStore := txstore.NewTxStoreWithCompression(n.txdb, n.compression)
+	if n.blockCacheSize > 0 {
+		cache := blockcache.New(n.blockCacheSize, blockCacheMetrics)
+		blockdb = blockcache.NewBlockStore(blockdb, cache)
+		txStore = blockcache.NewTxStore(txStore, cache)
+	}
+	headFeed := heads.NewFeed()
+	// The engine server can't shut down while head streams are open.
+	env.Go(func() {
+		<-ctx.Done()
+		headFeed.Close()
+	})
+	headStore, err := heads.NewBlockStore(blockdb, headFeed)
+	if err != nil {
+		return fmt.Errorf("new head block store: %v", err)
+	}
+	blockdb = headStore
 	mpool := mempool.New(n.mempooldb)
+	mpool.SetMaxRejections(n.maxRejectedTxs)
+	mpool.SetMaxSize(n.mempoolSize)
+	if n.appchainCtx != nil && n.appchainCtx.TxConfig != nil {
+		mpool.SetTxInfoFunc(mempool.NewTxInfoFunc(n.appchainCtx.TxConfig.TxDecoder(), n.feeDenom))
+	}
+	var checkTxApp comet.AppMempool = n.app
+	// Txs whose addresses don't map to the 0x addresses the eth namespace and withdrawals use are rejected at ingestion.
+	if n.appchainCtx != nil && n.appchainCtx.TxConfig != nil {
+		checkTxApp = addrconv.NewApp(checkTxApp, n.appchainCtx.TxConfig.TxDecoder(), sdk.GetConfig().GetBech32AccountAddrPrefix())
+	}
+	if n.admission != nil {
+		if n.appchainCtx == nil || n.appchainCtx.TxConfig == nil {
+			return errors.New("admission policy requires an appchain ctx with a tx config")
+		}
+		checkTxApp = admission.NewApp(checkTxApp, n.appchainCtx.TxConfig.TxDecoder(), n.admission)
+	}
+	if n.bundles != nil {
+		n.bundles.SetCheckTxApp(checkTxApp)
+	}
+	if n.replica {
+		if n.txForwarding == nil {
+			return errors.New("replica mode requires tx forwarding")
+		} else if n.builderAPI != nil || n.bundles != nil {
+			return errors.New("replica mode can't be used with the builder api")
+		}
+	}
+	// The txs submitted to the node go to submitPool, which is the mempool unless they are forwarded to the sequencer.
+	var submitPool comet.Mempool = mpool
+	if n.txForwarding != nil {
+		if n.localBlockTime > 0 {
+			return errors.New("tx forwarding can't be used with local consensus")
+		}
+		forwarder, err := txforward.NewForwarder(n.txForwarding, mpool, txForwardMetrics)
+		if err != nil {
+			return fmt.Errorf("new tx forwarder: %v", err)
+		}
+		submitPool = forwarder
+	}
+	// pendingTxs are the txs reported as pending, which include the peers' if the mempool is synced.
+	var pendingTxs interface {
+		comet.PendingTxs
+		comet.TxStatusMempool
+	} = mpool
+	// peerCounters count the peers net_peerCount reports.
+	var peerCounters []eth.PeerCounter
+	if n.mempoolSync != nil {
+		syncer, err := mempoolsync.NewSyncer(n.mempoolSync, mempoolSyncMetrics)
+		if err != nil {
+			return fmt.Errorf("new mempool syncer: %v", err)
+		}
+		env.Go(n.crash.Func(crash.SubsystemMempoolSync, func() {
+			syncer.Run(ctx, n.eventListener.OnMempoolSyncErr)
+		}))
+		pendingTxs = mempoolsync.NewView(mpool, syncer)
+		peerCounters = append(peerCounters, syncer)
+	}
 
 	eventBus := bfttypes.NewEventBus()
 	if err := eventBus.Start(); err != nil {
@@ -110,23 +681,126 @@ func (n *Node) Run(ctx context.Context, env *environment.Env) error {
 	}
 	env.DeferErr("stop event bus", eventBus.Stop)
 
+	if n.firehose != nil {
+		extractor := firehose.NewExtractor(n.firehose, blockdb)
+		sub, err := extractor.Subscribe(ctx, eventBus)
+		if err != nil {
+			return fmt.Errorf("subscribe firehose extractor: %v", err)
+		}
+		env.Go(n.crash.Func(crash.SubsystemFirehose, func() {
+			if err := extractor.Run(ctx, sub); err != nil {
+				n.eventListener.OnFirehoseErr(fmt.Errorf("run firehose extractor: %v", err))
+			}
+		}))
+	}
+
 	if err := n.startPrometheusServer(ctx, env); err != nil {
 		return err
 	}
 
-	ethMetrics, engineMetrics := n.registerMetrics()
+	// The crash handler goes first to record the height of every block, even if another interceptor panics.
+	interceptors := append([]builder.Interceptor{n.crash}, n.interceptors...)
+	if n.pruning != nil {
+		pruner, err := n.newPruner(blockdb)
+		if err != nil {
+			return err
+		}
+		env.Go(n.crash.Func(crash.SubsystemPruner, func() {
+			pruner.Run(ctx, n.eventListener.OnPruningErr)
+		}))
+		interceptors = append(slices.Clip(interceptors), pruner)
+	}
+	if n.compaction != nil || n.prometheusCfg.IsPrometheusEnabled() {
+		if blockStore, ok := n.blockdb.(compaction.BlockStore); ok {
+			cfg := n.compaction
+			if cfg == nil {
+				cfg = &compaction.Config{}
+			}
+			scheduler := compaction.NewScheduler(blockStore, map[string]compaction.DB{
+				"ethstate": n.ethstatedb.DiskDB(),
+			}, compactionMetrics, cfg)
+			env.Go(n.crash.Func(crash.SubsystemCompaction, func() {
+				scheduler.Run(ctx, n.eventListener.OnCompactionErr)
+			}))
+			interceptors = append(slices.Clip(interceptors), scheduler)
+		} else if n.compaction != nil {
+			return errors.New("compaction requires a block db that implements compaction.BlockStore")
+		}
+	}
+	var opNodeMonitor *opnode.Monitor
+	if n.opNodeMonitor != nil {
+		opNodeMonitor = opnode.NewMonitor(n.opNodeMonitor, opNodeMetrics)
+		env.Go(n.crash.Func(crash.SubsystemOPNodeMonitor, func() {
+			opNodeMonitor.Run(ctx, n.eventListener.OnOPNodeMonitorErr)
+		}))
+	}
+	if n.systemConfig != nil {
+		checker, err := systemconfig.NewChecker(n.systemConfig, systemConfigMetrics)
+		if err != nil {
+			return fmt.Errorf("new system config checker: %v", err)
+		}
+		env.Go(n.crash.Func(crash.SubsystemSystemConfig, func() {
+			checker.Run(ctx, n.eventListener.OnSystemConfigErr)
+		}))
+	}
+	if n.depositSLA != nil {
+		interceptors = append(slices.Clip(interceptors), depositsla.New(n.depositSLA, depositSLAMetrics, n.eventListener.OnDepositSLAErr))
+	}
+	if n.bundles != nil {
+		interceptors = append(slices.Clip(interceptors), n.bundles)
+	}
+
+	b := builder.New(mpool, n.app, blockdb, txStore, eventBus, n.genesis.ChainID, n.ethstatedb, wal, interceptors...)
+	b.SetCrashHandler(n.crash)
+	b.SetMetrics(builderMetrics)
+	b.SetFreezeHeight(n.freezeHeight)
+	if n.statePatch != nil {
+		if err := n.setStatePatch(ctx, b); err != nil {
+			return err
+		}
+	}
+	var witnesses *witness.Store
+	if n.witnessdb != nil {
+		witnesses = witness.NewStore(n.witnessdb)
+		b.SetWitnessStore(witnesses)
+	}
+	if block, err := b.Replay(ctx); err != nil {
+		return fmt.Errorf("replay wal: %v", err)
+	} else if block != nil {
+		if err := n.auditLog.Record(ctx, audit.ActionReplay, map[string]string{
+			"height": fmt.Sprint(block.Header.Height),
+			"hash":   block.Header.Hash.String(),
+		}); err != nil {
+			return fmt.Errorf("record replay: %v", err)
+		}
+	}
 
-	rpcServer := rpc.NewServer()
-	for _, api := range []rpc.API{
+	queryCalls := n.queryCalls
+	if queryCalls == nil {
+		// The app sets the global bech32 prefix when it's initialized.
+		if queryCalls, err = querycall.NewDefaultRouter(sdk.GetConfig().GetBech32AccountAddrPrefix()); err != nil {
+			return fmt.Errorf("new query call router: %v", err)
+		}
+	}
+	// abci_query and eth_call share the query cache.
+	var queryCache *comet.QueryCache
+	if n.queryCacheSize > 0 {
+		queryCache = comet.NewQueryCache(blockdb, n.queryCacheTTL, n.queryCacheSize, cometMetrics)
+	}
+	engineAPI := engine.NewEngineAPI(
+		b,
+		n.app,
+		blockdb,
+		nil,
+		engineMetrics,
+		n.auditLog,
+		n.eventListener.OnEngineCompatibilityWarn,
+	)
+	engineAPI.SetReplica(n.replica)
+	apis := []rpc.API{
 		{
 			Namespace: "engine",
-			Service: engine.NewEngineAPI(
-				builder.New(mpool, n.app, n.blockdb, txStore, eventBus, n.genesis.ChainID, n.ethstatedb),
-				n.app,
-				n.blockdb,
-				n.appchainCtx,
-				engineMetrics,
-			),
+			Service:   engineAPI,
 		},
 		{
 			Namespace: "eth",
@@ -134,37 +808,181 @@ func (n *Node) Run(ctx context.Context, env *environment.Env) error {
 				*eth.ChainIDAPI
 				*eth.BlockAPI
 				*eth.ProofAPI
+				*eth.StateAPI
+				*eth.TxAPI
+				*eth.SendTxAPI
+				*eth.CallAPI
+				*eth.FilterAPI
+				*eth.SubscriptionAPI
 			}{
 				ChainIDAPI: eth.NewChainIDAPI(n.genesis.ChainID.HexBig(), ethMetrics),
-				BlockAPI:   eth.NewBlockAPI(n.blockdb, n.genesis.ChainID.Big(), ethMetrics),
-				ProofAPI:   eth.NewProofAPI(n.ethstatedb, n.blockdb),
+				BlockAPI:   eth.NewBlockAPI(blockdb, txStore, n.genesis.ChainID.Big(), ethMetrics),
+				ProofAPI:   eth.NewProofAPI(n.ethstatedb, blockdb),
+				StateAPI:   eth.NewStateAPI(n.ethstatedb, blockdb, ethMetrics),
+				TxAPI:      eth.NewTxAPI(blockdb, txStore, n.genesis.ChainID.Big(), ethMetrics),
+				SendTxAPI:  eth.NewSendTxAPI(checkTxApp, submitPool, ethMetrics),
+				CallAPI:    eth.NewCallAPI(n.app, queryCalls, blockdb, queryCache, ethMetrics),
+				FilterAPI:  eth.NewFilterAPI(blockdb, txStore, n.genesis.ChainID.Big(), ethMetrics),
+				SubscriptionAPI: eth.NewSubscriptionAPI(
+					headFeed,
+					mpool,
+					blockdb,
+					txStore,
+					n.genesis.ChainID.Big(),
+					ethMetrics,
+				),
 			},
 		},
-	} {
-		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
-			return fmt.Errorf("register %s API: %v", api.Namespace, err)
+		{
+			Namespace: "net",
+			Service:   eth.NewNetAPI(n.genesis.ChainID.Big(), ethMetrics, peerCounters...),
+		},
+		{
+			Namespace: "txpool",
+			Service:   eth.NewTxPoolAPI(mpool, n.genesis.ChainID.Big(), ethMetrics),
+		},
+		{
+			Namespace: "debug",
+			Service:   eth.NewTraceAPI(blockdb, txStore, n.genesis.ChainID.Big(), ethMetrics),
+		},
+		{
+			Namespace: "monomer",
+			Service:   eth.NewOutputAPI(blockdb, n.ethstatedb, ethMetrics),
+		},
+	}
+	apis = append(apis, rpc.API{
+		Namespace: "monomer",
+		Service:   buildinfo.NewAPI(),
+	}, rpc.API{
+		Namespace: "monomer",
+		// The app sets the global bech32 prefix when it's initialized.
+		Service: addrconv.NewAPI(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+	})
+	if witnesses != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "monomer",
+			Service:   witness.NewAPI(witnesses),
+		})
+	}
+	httpAPIs, wsAPIs, localAPIs, ipcAPIs := n.httpAPIs, n.wsAPIs, n.localAPIs, n.ipcAPIs
+	var sequencer *localconsensus.Sequencer
+	if n.localBlockTime > 0 {
+		sequencer = localconsensus.NewSequencer(b, blockdb, n.localBlockTime, uint64(n.localTimeStep/time.Second))
+		// The evm and anvil namespaces take the engine namespace's place, since they all drive block production.
+		apis = slices.DeleteFunc(apis, func(api rpc.API) bool {
+			return api.Namespace == "engine"
+		})
+		apis = append(apis, rpc.API{
+			Namespace: "evm",
+			Service:   localconsensus.NewEVMAPI(sequencer),
+		}, rpc.API{
+			Namespace: "anvil",
+			Service:   localconsensus.NewAnvilAPI(sequencer, n.app, n.genesis.ChainID.Big()),
+		})
+		httpAPIs = replaceNamespace(httpAPIs, "engine", "evm", "anvil")
+		wsAPIs = replaceNamespace(wsAPIs, "engine", "evm", "anvil")
+		localAPIs = replaceNamespace(localAPIs, "engine", "evm", "anvil")
+		ipcAPIs = replaceNamespace(ipcAPIs, "engine", "evm", "anvil")
+	}
+	newEngineRPCHandler := func(excluded []string) (http.Handler, error) {
+		httpHandler, err := newRPCHandler(apis, httpAPIs, localAPIs, excluded, false)
+		if err != nil {
+			return nil, fmt.Errorf("new http rpc handler: %v", err)
 		}
+		wsHandler, err := newRPCHandler(apis, wsAPIs, localAPIs, excluded, true)
+		if err != nil {
+			return nil, fmt.Errorf("new websocket rpc handler: %v", err)
+		}
+		// Block explorers and other JSON-RPC clients often only speak HTTP, so serve it on the same listener.
+		return websocketOrHTTPHandler(wsHandler, httpHandler), nil
+	}
+	rpcHandler, err := newEngineRPCHandler(nil)
+	if err != nil {
+		return err
 	}
 
-	engineWS := makeHTTPService(rpcServer.WebsocketHandler([]string{}), n.engineWS)
+	engineMux := http.NewServeMux()
+	if n.engineJWT != nil {
+		// Only the namespaces that drive block production require a token. Clients that only read the chain, like the
+		// batcher, don't send one.
+		unauthenticatedHandler, err := newEngineRPCHandler(authenticatedNamespaces)
+		if err != nil {
+			return err
+		}
+		rpcHandler = n.engineJWT.Handler(rpcHandler, unauthenticatedHandler)
+		env.Go(n.crash.Func(crash.SubsystemEngineJWT, func() {
+			n.engineJWT.Run(ctx, n.eventListener.OnEngineJWTErr)
+		}))
+	}
+	engineMux.Handle("/", rpcHandler)
+	// Server-sent events for clients that can't hold websockets open.
+	engineMux.Handle("/events/new-heads", heads.NewHandler(headFeed, heads.EventNewHead))
+	engineMux.Handle("/events/finalized-heads", heads.NewHandler(headFeed, heads.EventFinalizedHead))
+	engineHandler := n.crash.HTTPHandler(crash.SubsystemEngineRPC, engineMux)
+	engineWS := makeHTTPService(engineHandler, n.engineWS)
 	env.Go(func() {
 		if err := engineWS.Run(ctx); err != nil {
 			n.eventListener.OnEngineWebsocketServeErr(fmt.Errorf("run engine ws server: %v", err))
 		}
 	})
 
+	if n.builderAPI != nil {
+		if n.bundles == nil {
+			return errors.New("the builder api requires bundles")
+		}
+		builderHandler, err := bundles.NewHandler(n.bundles, n.builderSecrets)
+		if err != nil {
+			return fmt.Errorf("new builder api handler: %v", err)
+		}
+		for builder, secrets := range n.builderSecrets {
+			env.Go(n.crash.Func(crash.SubsystemBuilderJWT, func() {
+				secrets.Run(ctx, func(err error) {
+					n.eventListener.OnBuilderJWTErr(fmt.Errorf("reload secret of builder %q: %v", builder, err))
+				})
+			}))
+		}
+		builderAPI := makeHTTPService(n.crash.HTTPHandler(crash.SubsystemBuilderAPI, builderHandler), n.builderAPI)
+		env.Go(func() {
+			if err := builderAPI.Run(ctx); err != nil {
+				n.eventListener.OnBuilderAPIServeErr(fmt.Errorf("run builder api server: %v", err))
+			}
+		})
+	}
+
+	if n.ipc != nil {
+		ipcServer, err := newRPCServer(apis, ipcAPIs, nil)
+		if err != nil {
+			return fmt.Errorf("new ipc rpc server: %v", err)
+		}
+		ipc := &ipcService{
+			server:   ipcServer,
+			listener: n.ipc,
+		}
+		env.Go(func() {
+			if err := ipc.Run(ctx); err != nil {
+				n.eventListener.OnIPCServeErr(fmt.Errorf("run ipc server: %v", err))
+			}
+		})
+	}
+
 	// Run Comet server.
 
-	abci := comet.NewABCI(n.app)
-	broadcastTxAPI := comet.NewBroadcastTxAPI(n.app, mpool)
+	abci := comet.NewABCI(n.app, n.queryTimeout, queryCache)
+	broadcastTxAPI := comet.NewBroadcastTxAPI(checkTxApp, submitPool)
 	txAPI := comet.NewTxAPI(txStore)
+	txStatusAPI := comet.NewTxStatusAPI(txStore, pendingTxs)
+	var txDecoder sdk.TxDecoder
+	if n.appchainCtx != nil && n.appchainCtx.TxConfig != nil {
+		txDecoder = n.appchainCtx.TxConfig.TxDecoder()
+	}
+	unconfirmedTxsAPI := comet.NewUnconfirmedTxsAPI(mpool, pendingTxs, txDecoder)
 	subscribeWg := conc.NewWaitGroup()
 	env.Defer(subscribeWg.Wait)
 	subscribeAPI := comet.NewSubscriberAPI(eventBus, subscribeWg, &comet.SelectiveListener{})
-	blockAPI := comet.NewBlockAPI(n.blockdb)
+	blockAPI := comet.NewBlockAPI(blockdb)
 
 	// Since the block DB doesn't have pruning, we can just use the genesis block as the earliest block for the status API.
-	startBlock, err := n.blockdb.BlockByHeight(1)
+	startBlock, err := blockdb.BlockByHeight(1)
 	if err != nil {
 		return fmt.Errorf("get start block: %v", err)
 	}
@@ -175,22 +993,36 @@ func (n *Node) Run(ctx context.Context, env *environment.Env) error {
 			return msg, nil
 		}, "msg"),
 		"health": cometserver.NewRPCFunc(func(_ *jsonrpctypes.Context) (*rpctypes.ResultHealth, error) {
+			if opNodeMonitor != nil {
+				if err := opNodeMonitor.Healthy(); err != nil {
+					return nil, err
+				}
+			}
 			return &rpctypes.ResultHealth{}, nil
 		}, ""),
-		"status": cometserver.NewRPCFunc(comet.NewStatusAPI(n.blockdb, startBlock.ToCometLikeBlock()).Status, ""),
+		"status": cometserver.NewRPCFunc(comet.NewStatusAPI(blockdb, startBlock.ToCometLikeBlock()).Status, ""),
 
 		"abci_query": cometserver.NewRPCFunc(abci.Query, "path,data,height,prove"),
 		"abci_info":  cometserver.NewRPCFunc(abci.Info, "", cometserver.Cacheable()),
 
 		"broadcast_tx_sync":  cometserver.NewRPCFunc(broadcastTxAPI.BroadcastTx, "tx"),
 		"broadcast_tx_async": cometserver.NewRPCFunc(broadcastTxAPI.BroadcastTx, "tx"),
+		"broadcast_tx_batch": cometserver.NewRPCFunc(broadcastTxAPI.BroadcastTxBatch, "txs,atomic"),
+
+		"tx":           cometserver.NewRPCFunc(txAPI.ByHash, "hash,prove"),
+		"tx_search":    cometserver.NewRPCFunc(txAPI.Search, "query,prove,page,per_page,order_by"),
+		"tx_status":    cometserver.NewRPCFunc(txStatusAPI.TxStatus, "hash"),
+		"tx_statuses":  cometserver.NewRPCFunc(txStatusAPI.TxStatuses, "hashes"),
+		"rejected_txs": cometserver.NewRPCFunc(txStatusAPI.RejectedTxs, "limit,before"),
 
-		"tx":        cometserver.NewRPCFunc(txAPI.ByHash, "hash,prove"),
-		"tx_search": cometserver.NewRPCFunc(txAPI.Search, "query,prove,page,per_page,order_by"),
+		"unconfirmed_txs":       cometserver.NewRPCFunc(unconfirmedTxsAPI.UnconfirmedTxs, "limit"),
+		"num_unconfirmed_txs":   cometserver.NewRPCFunc(unconfirmedTxsAPI.NumUnconfirmedTxs, ""),
+		"local_unconfirmed_txs": cometserver.NewRPCFunc(unconfirmedTxsAPI.LocalUnconfirmedTxs, "limit"),
+		"pending_sequence":      cometserver.NewRPCFunc(unconfirmedTxsAPI.PendingSequence, "address"),
 
-		"subscribe":       cometserver.NewRPCFunc(subscribeAPI.Subscribe, "query"),
-		"unsubscribe":     cometserver.NewRPCFunc(subscribeAPI.Unsubscribe, "query"),
-		"unsubscribe_all": cometserver.NewRPCFunc(subscribeAPI.UnsubscribeAll, ""),
+		"subscribe":       cometserver.NewWSRPCFunc(subscribeAPI.Subscribe, "query"),
+		"unsubscribe":     cometserver.NewWSRPCFunc(subscribeAPI.Unsubscribe, "query"),
+		"unsubscribe_all": cometserver.NewWSRPCFunc(subscribeAPI.UnsubscribeAll, ""),
 
 		"block":         cometserver.NewRPCFunc(blockAPI.ByHeight, "height"),
 		"block_by_hash": cometserver.NewRPCFunc(blockAPI.ByHash, "hash"),
@@ -199,17 +1031,126 @@ func (n *Node) Run(ctx context.Context, env *environment.Env) error {
 	cometMux := http.NewServeMux()
 	cometserver.RegisterRPCFuncs(cometMux, routes, log.NewNopLogger())
 	// We want to match cometbft's behavior, which puts the websocket endpoints under the /websocket route.
-	cometMux.HandleFunc("/websocket", cometserver.NewWebsocketManager(routes).WebsocketHandler)
-	cometServer := makeHTTPService(cometMux, n.cometHTTPAndWS)
+	cometMux.HandleFunc("/websocket", cometserver.NewWebsocketManager(routes, cometserver.OnDisconnect(subscribeAPI.Disconnect)).WebsocketHandler)
+	cometServer := makeHTTPService(n.crash.HTTPHandler(crash.SubsystemCometRPC, cometMux), n.cometHTTPAndWS)
 	env.Go(func() {
 		if err := cometServer.Run(ctx); err != nil {
 			n.eventListener.OnCometServeErr(fmt.Errorf("run comet server: %v", err))
 		}
 	})
 
+	if sequencer != nil {
+		env.Go(n.crash.Func(crash.SubsystemLocalSequencer, func() {
+			if err := sequencer.Run(ctx); err != nil {
+				n.eventListener.OnLocalSequencerErr(fmt.Errorf("run local sequencer: %v", err))
+			}
+		}))
+	}
+
 	return nil
 }
 
+// authenticatedNamespaces are the namespaces that require a token signed with Config.EngineJWT's secret.
+var authenticatedNamespaces = []string{"engine", "evm", "anvil"}
+
+// replaceNamespace returns namespaces with namespace replaced by replacements, if it is there. Nil, which enables every
+// namespace, stays nil.
+func replaceNamespace(namespaces []string, namespace string, replacements ...string) []string {
+	if namespaces == nil {
+		return nil
+	}
+	replaced := make([]string, 0, len(namespaces)+len(replacements))
+	for _, ns := range namespaces {
+		if ns != namespace {
+			replaced = append(replaced, ns)
+			continue
+		}
+		for _, replacement := range replacements {
+			if !slices.Contains(namespaces, replacement) {
+				replaced = append(replaced, replacement)
+			}
+		}
+	}
+	return replaced
+}
+
+// configHash identifies the node's configuration in crash dumps, so crashes can be grouped by configuration without
+// revealing it.
+func (n *Node) configHash() (string, error) {
+	configJSON, err := json.Marshal(struct {
+		ChainID        monomer.ChainID
+		HTTPAPIs       []string
+		WSAPIs         []string
+		LocalAPIs      []string
+		IPCAPIs        []string
+		QueryTimeout   time.Duration
+		QueryCacheSize int
+		QueryCacheTTL  time.Duration
+		BlockCacheSize int
+		Compression    monomerdb.Compression
+		MaxRejectedTxs uint64
+		MempoolSize    uint64
+		FeeDenom       string
+		Interceptors   int
+		Pruning        bool
+		Compaction     bool
+		Firehose       bool
+		Admission      bool
+		Bundles        bool
+		IPC            bool
+		BuilderAPI     bool
+		LocalBlockTime time.Duration
+		LocalTimeStep  time.Duration
+		OPNodeMonitor  bool
+		EngineJWT      bool
+		Witness        bool
+		SystemConfig   bool
+		TxForwarding   bool
+		MempoolSync    bool
+		QueryCalls     bool
+		DepositSLA     bool
+		Replica        bool
+	}{
+		ChainID:        n.genesis.ChainID,
+		HTTPAPIs:       n.httpAPIs,
+		WSAPIs:         n.wsAPIs,
+		LocalAPIs:      n.localAPIs,
+		IPCAPIs:        n.ipcAPIs,
+		QueryTimeout:   n.queryTimeout,
+		QueryCacheSize: n.queryCacheSize,
+		QueryCacheTTL:  n.queryCacheTTL,
+		BlockCacheSize: n.blockCacheSize,
+		Compression:    n.compression,
+		MaxRejectedTxs: n.maxRejectedTxs,
+		MempoolSize:    n.mempoolSize,
+		FeeDenom:       n.feeDenom,
+		Interceptors:   len(n.interceptors),
+		Pruning:        n.pruning != nil,
+		Compaction:     n.compaction != nil,
+		Firehose:       n.firehose != nil,
+		Admission:      n.admission != nil,
+		Bundles:        n.bundles != nil,
+		IPC:            n.ipc != nil,
+		BuilderAPI:     n.builderAPI != nil,
+		LocalBlockTime: n.localBlockTime,
+		LocalTimeStep:  n.localTimeStep,
+		OPNodeMonitor:  n.opNodeMonitor != nil,
+		EngineJWT:      n.engineJWT != nil,
+		Witness:        n.witnessdb != nil,
+		SystemConfig:   n.systemConfig != nil,
+		TxForwarding:   n.txForwarding != nil,
+		MempoolSync:    n.mempoolSync != nil,
+		QueryCalls:     n.queryCalls != nil,
+		DepositSLA:     n.depositSLA != nil,
+		Replica:        n.replica,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal config: %v", err)
+	}
+	hash := sha256.Sum256(configJSON)
+	return hex.EncodeToString(hash[:]), nil
+}
+
 func prepareBlockStoreAndApp(
 	ctx context.Context,
 	g *genesis.Genesis,
@@ -1,21 +1,49 @@
 package node_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"io"
+	"math/big"
 	"net"
 	"net/http"
+	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 
+	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/config"
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	bfttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	gethnode "github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/addrconv"
+	"github.com/polymerdao/monomer/audit"
+	"github.com/polymerdao/monomer/buildinfo"
+	"github.com/polymerdao/monomer/comet"
 	"github.com/polymerdao/monomer/environment"
 	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/jwtauth"
+	"github.com/polymerdao/monomer/mempoolsync"
 	"github.com/polymerdao/monomer/node"
+	"github.com/polymerdao/monomer/opnode"
 	"github.com/polymerdao/monomer/testapp"
 	"github.com/polymerdao/monomer/testutils"
+	"github.com/polymerdao/monomer/txforward"
+	"github.com/polymerdao/monomer/utils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,31 +64,33 @@ func TestRun(t *testing.T) {
 	ethstatedb := testutils.NewEthStateDB(t)
 	n := node.New(
 		app,
-		nil,
 		&genesis.Genesis{
 			ChainID:  chainID,
 			AppState: testapp.MakeGenesisAppState(t, app),
 		},
-		engineWS,
-		cometListener,
-		testutils.NewLocalMemDB(t),
-		testutils.NewMemDB(t),
-		testutils.NewCometMemDB(t),
-		ethstatedb,
-		&config.InstrumentationConfig{
-			Prometheus:           true,
-			PrometheusListenAddr: prometheusHTTPAddress,
-			MaxOpenConnections:   1,
-			Namespace:            prometheusNamespace,
-		},
-		&node.SelectiveListener{
-			OnEngineHTTPServeErrCb: func(err error) {
-				require.NoError(t, err)
+		&node.Config{
+			EngineListener: engineWS,
+			CometListener:  cometListener,
+			BlockDB:        testutils.NewLocalMemDB(t),
+			MempoolDB:      testutils.NewMemDB(t),
+			TxDB:           testutils.NewCometMemDB(t),
+			EthStateDB:     ethstatedb,
+			Instrumentation: &config.InstrumentationConfig{
+				Prometheus:           true,
+				PrometheusListenAddr: prometheusHTTPAddress,
+				MaxOpenConnections:   1,
+				Namespace:            prometheusNamespace,
 			},
-			OnEngineWebsocketServeErrCb: func(err error) {
-				require.NoError(t, err)
+			EventListener: &node.SelectiveListener{
+				OnEngineHTTPServeErrCb: func(err error) {
+					require.NoError(t, err)
+				},
+				OnEngineWebsocketServeErrCb: func(err error) {
+					require.NoError(t, err)
+				},
 			},
-		})
+		},
+	)
 
 	env := environment.New()
 	defer func() {
@@ -68,7 +98,7 @@ func TestRun(t *testing.T) {
 	}()
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	require.NoError(t, n.Run(ctx, env))
+	require.NoError(t, n.Start(ctx, env))
 
 	client, err := rpc.DialContext(ctx, "ws://"+engineWS.Addr().String())
 	require.NoError(t, err)
@@ -97,4 +127,784 @@ func TestRun(t *testing.T) {
 	}()
 	respBody := string(respBodyBz)
 	require.Contains(t, respBody, "monomer_eth_method_call_count{method=\"chainId\"} 1")
+	require.Contains(t, respBody, "monomer_builder_build_duration_seconds_count 0")
+}
+
+func TestRunWithDefaults(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	app := testapp.NewTest(t, chainID.String())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var started, stopped bool
+	n := node.New(
+		app,
+		&genesis.Genesis{
+			ChainID:  chainID,
+			AppState: testapp.MakeGenesisAppState(t, app),
+		},
+		&node.Config{
+			EngineListener: engineWS,
+			CometListener:  cometListener,
+			Hooks: &node.Hooks{
+				OnStart: func(ctx context.Context) error {
+					started = true
+					client, err := rpc.DialContext(ctx, "ws://"+engineWS.Addr().String())
+					require.NoError(t, err)
+					defer client.Close()
+					chainIDBig, err := ethclient.NewClient(client).ChainID(ctx)
+					require.NoError(t, err)
+					require.Equal(t, uint64(chainID), chainIDBig.Uint64())
+					cancel()
+					return nil
+				},
+				OnStop: func() error {
+					stopped = true
+					return nil
+				},
+			},
+		},
+	)
+	require.NoError(t, n.Run(ctx))
+	require.True(t, started)
+	require.True(t, stopped)
+}
+
+func TestGenesisHash(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	app := testapp.NewTest(t, chainID.String())
+	g := &genesis.Genesis{
+		ChainID:  chainID,
+		AppState: testapp.MakeGenesisAppState(t, app),
+	}
+	blockdb := testutils.NewLocalMemDB(t)
+	ethstatedb := testutils.NewEthStateDB(t)
+	run := func(genesisHash common.Hash) error {
+		engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		return node.New(app, g, &node.Config{
+			EngineListener: engineWS,
+			CometListener:  cometListener,
+			BlockDB:        blockdb,
+			EthStateDB:     ethstatedb,
+			GenesisHash:    genesisHash,
+			Hooks: &node.Hooks{
+				OnStart: func(context.Context) error {
+					cancel()
+					return nil
+				},
+			},
+		}).Run(ctx)
+	}
+
+	err := run(common.Hash{1})
+	require.ErrorContains(t, err, "does not match")
+	genesisHeader, err := blockdb.HeaderByHeight(1)
+	require.NoError(t, err)
+	require.NoError(t, run(genesisHeader.Hash))
+}
+
+type syncStatusClient struct{}
+
+func (syncStatusClient) SyncStatus(context.Context) (*eth.SyncStatus, error) {
+	return nil, errors.New("op-node is down")
+}
+
+func TestOPNodeMonitor(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	app := testapp.NewTest(t, chainID.String())
+	monitorErrs := make(chan error, 1)
+	n := node.New(
+		app,
+		&genesis.Genesis{
+			ChainID:  chainID,
+			AppState: testapp.MakeGenesisAppState(t, app),
+		},
+		&node.Config{
+			EngineListener: engineWS,
+			CometListener:  cometListener,
+			OPNodeMonitor: &opnode.Config{
+				Client: syncStatusClient{},
+			},
+			EventListener: &node.SelectiveListener{
+				OnOPNodeMonitorErrCb: func(err error) {
+					select {
+					case monitorErrs <- err:
+					default:
+					}
+				},
+			},
+		},
+	)
+
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, n.Start(ctx, env))
+	require.ErrorContains(t, <-monitorErrs, "op-node is down")
+
+	cometClient, err := rpc.DialContext(ctx, "http://"+cometListener.Addr().String())
+	require.NoError(t, err)
+	defer cometClient.Close()
+	var health json.RawMessage
+	err = cometClient.Call(&health, "health")
+	var dataErr rpc.DataError
+	require.ErrorAs(t, err, &dataErr)
+	require.Contains(t, dataErr.ErrorData(), "op-node is down")
+}
+
+func TestEngineJWT(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "jwt.hex")
+	secret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	require.NoError(t, jwtauth.WriteSecretFile(secretPath, secret))
+	secrets, err := jwtauth.NewSecrets(&jwtauth.Config{
+		Path: secretPath,
+	})
+	require.NoError(t, err)
+
+	chainID := monomer.ChainID(0)
+	engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	app := testapp.NewTest(t, chainID.String())
+	n := node.New(
+		app,
+		&genesis.Genesis{
+			ChainID:  chainID,
+			AppState: testapp.MakeGenesisAppState(t, app),
+		},
+		&node.Config{
+			EngineListener: engineWS,
+			CometListener:  cometListener,
+			EngineJWT:      secrets,
+		},
+	)
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, n.Start(ctx, env))
+
+	otherSecret, err := jwtauth.Generate()
+	require.NoError(t, err)
+	for _, scheme := range []string{"http", "ws"} {
+		t.Run(scheme, func(t *testing.T) {
+			dial := func(auth rpc.HTTPAuth) *rpc.Client {
+				var opts []rpc.ClientOption
+				if auth != nil {
+					opts = append(opts, rpc.WithHTTPAuth(auth))
+				}
+				client, err := rpc.DialOptions(ctx, scheme+"://"+engineWS.Addr().String(), opts...)
+				if err != nil {
+					// The websocket handshake fails if the token is rejected.
+					return nil
+				}
+				t.Cleanup(client.Close)
+				return client
+			}
+			engineCall := func(client *rpc.Client) error {
+				var payload any
+				return client.CallContext(ctx, &payload, "engine_getPayloadV3", eth.PayloadID{})
+			}
+
+			// The engine namespace requires a token, but the other namespaces are served without one.
+			client := dial(nil)
+			_, err := ethclient.NewClient(client).ChainID(ctx)
+			require.NoError(t, err)
+			require.ErrorContains(t, engineCall(client), "does not exist")
+
+			client = dial(gethnode.NewJWTAuth([32]byte(secret)))
+			_, err = ethclient.NewClient(client).ChainID(ctx)
+			require.NoError(t, err)
+			require.NotContains(t, engineCall(client).Error(), "does not exist")
+
+			// Requests with an invalid token are rejected.
+			if client := dial(gethnode.NewJWTAuth([32]byte(otherSecret))); client != nil {
+				_, err = ethclient.NewClient(client).ChainID(ctx)
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTxForwarding(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	start := func(txForwarding *txforward.Config) net.Listener {
+		engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		app := testapp.NewTest(t, chainID.String())
+		require.NoError(t, node.New(
+			app,
+			&genesis.Genesis{
+				ChainID:  chainID,
+				AppState: testapp.MakeGenesisAppState(t, app),
+			},
+			&node.Config{
+				EngineListener: engineWS,
+				CometListener:  cometListener,
+				TxForwarding:   txForwarding,
+			},
+		).Start(ctx, env))
+		return cometListener
+	}
+	sequencerURL := "http://" + start(nil).Addr().String()
+	rpcURL := "http://" + start(&txforward.Config{
+		SequencerURL: sequencerURL,
+	}).Addr().String()
+
+	txStatus := func(url string, tx bfttypes.Tx) string {
+		client, err := jsonrpcclient.New(url)
+		require.NoError(t, err)
+		status := new(comet.ResultTxStatus)
+		_, err = client.Call(ctx, "tx_status", map[string]any{"hash": tx.Hash()}, status)
+		require.NoError(t, err)
+		return status.Status
+	}
+
+	client, err := jsonrpcclient.New(rpcURL)
+	require.NoError(t, err)
+	tx := bfttypes.Tx(testapp.ToTestTx(t, "k", "v"))
+	result := new(rpctypes.ResultBroadcastTx)
+	_, err = client.Call(ctx, "broadcast_tx_sync", map[string]any{"tx": tx}, result)
+	require.NoError(t, err)
+	require.Zero(t, result.Code)
+	// The tx is in the sequencer's mempool, not the node's.
+	require.Equal(t, comet.TxStatusPending, txStatus(sequencerURL, tx))
+	require.Equal(t, comet.TxStatusUnknown, txStatus(rpcURL, tx))
+
+	// Txs that fail CheckTx on the node aren't forwarded.
+	badTx := bfttypes.Tx{1, 2, 3}
+	_, err = client.Call(ctx, "broadcast_tx_sync", map[string]any{"tx": badTx}, result)
+	require.NoError(t, err)
+	require.NotZero(t, result.Code)
+	require.Equal(t, comet.TxStatusUnknown, txStatus(sequencerURL, badTx))
+	require.Equal(t, comet.TxStatusRejected, txStatus(rpcURL, badTx))
+}
+
+func TestMempoolSync(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	start := func(cfg *node.Config) string {
+		engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		cfg.EngineListener = engineWS
+		cfg.CometListener = cometListener
+		app := testapp.NewTest(t, chainID.String())
+		require.NoError(t, node.New(
+			app,
+			&genesis.Genesis{
+				ChainID:  chainID,
+				AppState: testapp.MakeGenesisAppState(t, app),
+			},
+			cfg,
+		).Start(ctx, env))
+		return "http://" + cometListener.Addr().String()
+	}
+	sequencerURL := start(&node.Config{})
+	rpcURL := start(&node.Config{
+		TxForwarding: &txforward.Config{
+			SequencerURL: sequencerURL,
+		},
+		MempoolSync: &mempoolsync.Config{
+			Peers:    []string{sequencerURL},
+			Interval: 10 * time.Millisecond,
+		},
+	})
+
+	client, err := jsonrpcclient.New(rpcURL)
+	require.NoError(t, err)
+	tx := bfttypes.Tx(testapp.ToTestTx(t, "k", "v"))
+	result := new(rpctypes.ResultBroadcastTx)
+	_, err = client.Call(ctx, "broadcast_tx_sync", map[string]any{"tx": tx}, result)
+	require.NoError(t, err)
+	require.Zero(t, result.Code)
+
+	// The forwarded tx is in the sequencer's mempool, and the node reports it as pending once it's synced.
+	require.Eventually(t, func() bool {
+		status := new(comet.ResultTxStatus)
+		_, err := client.Call(ctx, "tx_status", map[string]any{"hash": tx.Hash()}, status)
+		require.NoError(t, err)
+		return status.Status == comet.TxStatusPending
+	}, 5*time.Second, 10*time.Millisecond)
+	unconfirmed := new(rpctypes.ResultUnconfirmedTxs)
+	_, err = client.Call(ctx, "unconfirmed_txs", map[string]any{}, unconfirmed)
+	require.NoError(t, err)
+	require.Equal(t, []bfttypes.Tx{tx}, unconfirmed.Txs)
+	// The node's own mempool is empty, so its peers don't sync the tx back from it.
+	_, err = client.Call(ctx, "local_unconfirmed_txs", map[string]any{}, unconfirmed)
+	require.NoError(t, err)
+	require.Empty(t, unconfirmed.Txs)
+}
+
+func TestWitness(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	app := testapp.NewTest(t, chainID.String())
+	n := node.New(
+		app,
+		&genesis.Genesis{
+			ChainID:  chainID,
+			AppState: testapp.MakeGenesisAppState(t, app),
+		},
+		&node.Config{
+			EngineListener: engineWS,
+			CometListener:  cometListener,
+			WitnessDB:      testutils.NewMemDB(t),
+		},
+	)
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, n.Start(ctx, env))
+
+	client, err := rpc.DialContext(ctx, "http://"+engineWS.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	// The genesis block isn't built, so it has no witness, but the method is served alongside the rest of the monomer
+	// namespace.
+	var encoded hexutil.Bytes
+	require.ErrorContains(t, client.Call(&encoded, "monomer_getWitness", hexutil.Uint64(1)), "no witness recorded for block 1")
+	var outputs []json.RawMessage
+	require.NoError(t, client.Call(&outputs, "monomer_outputsAtBlocks", []hexutil.Uint64{1}))
+}
+
+func TestBuildInfo(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	newNode := func(minClientVersion string) (*node.Node, net.Listener) {
+		engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		app := testapp.NewTest(t, chainID.String())
+		return node.New(
+			app,
+			&genesis.Genesis{
+				ChainID:          chainID,
+				AppState:         testapp.MakeGenesisAppState(t, app),
+				MinClientVersion: minClientVersion,
+			},
+			&node.Config{
+				EngineListener: engineWS,
+				CometListener:  cometListener,
+			},
+		), engineWS
+	}
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Test binaries have an unknown version, which can't satisfy a minimum.
+	n, engineWS := newNode("v0.1.0")
+	require.ErrorContains(t, n.Start(ctx, env), "minimum client version")
+	require.NoError(t, engineWS.Close())
+
+	n, engineWS = newNode("")
+	require.NoError(t, n.Start(ctx, env))
+	client, err := rpc.DialContext(ctx, "http://"+engineWS.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	info := new(buildinfo.Info)
+	require.NoError(t, client.Call(info, "monomer_buildInfo"))
+	require.Equal(t, buildinfo.Read(), info)
+
+	addr := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	conversion := new(addrconv.Conversion)
+	require.NoError(t, client.Call(conversion, "monomer_convertAddress", addr.Hex()))
+	require.Equal(t, addr, conversion.Hex)
+	require.Equal(t, sdk.AccAddress(addr.Bytes()).String(), conversion.Bech32)
+}
+
+func TestAuditLog(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	app := testapp.NewTest(t, chainID.String())
+	blockDB := testutils.NewLocalMemDB(t)
+	var log bytes.Buffer
+	n := node.New(
+		app,
+		&genesis.Genesis{
+			ChainID:  chainID,
+			AppState: testapp.MakeGenesisAppState(t, app),
+		},
+		&node.Config{
+			EngineListener: engineWS,
+			CometListener:  cometListener,
+			BlockDB:        blockDB,
+			AuditLog:       audit.New(&log),
+		},
+	)
+
+	env := environment.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, n.Start(ctx, env))
+
+	client, err := rpc.DialContext(ctx, "ws://"+engineWS.Addr().String())
+	require.NoError(t, err)
+	genesisHeader, err := blockDB.HeadHeader()
+	require.NoError(t, err)
+	fcs := &eth.ForkchoiceState{
+		HeadBlockHash:      genesisHeader.Hash,
+		SafeBlockHash:      genesisHeader.Hash,
+		FinalizedBlockHash: genesisHeader.Hash,
+	}
+	// Only the first update changes the labels.
+	for range 2 {
+		var fcuResult eth.ForkchoiceUpdatedResult
+		require.NoError(t, client.CallContext(ctx, &fcuResult, "engine_forkchoiceUpdatedV3", fcs, nil))
+	}
+	client.Close()
+	cancel()
+	require.NoError(t, env.Close())
+
+	var entries []*audit.Entry
+	decoder := json.NewDecoder(&log)
+	for decoder.More() {
+		entry := new(audit.Entry)
+		require.NoError(t, decoder.Decode(entry))
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 3)
+	require.Equal(t, audit.ActionNodeStart, entries[0].Action)
+	require.Equal(t, audit.ActorNode, entries[0].Actor)
+	require.Equal(t, audit.ActionForkchoiceUpdate, entries[1].Action)
+	require.Contains(t, entries[1].Actor, "ws://")
+	require.Equal(t, genesisHeader.Hash.String(), entries[1].Details["unsafe"])
+	require.Equal(t, audit.ActionNodeStop, entries[2].Action)
+}
+
+func TestRPCNamespaces(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	newNode := func(t *testing.T, cfg *node.Config) (*node.Node, net.Listener) {
+		engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		cfg.EngineListener = engineWS
+		cfg.CometListener = cometListener
+		app := testapp.NewTest(t, chainID.String())
+		return node.New(
+			app,
+			&genesis.Genesis{
+				ChainID:  chainID,
+				AppState: testapp.MakeGenesisAppState(t, app),
+			},
+			cfg,
+		), engineWS
+	}
+
+	t.Run("per transport", func(t *testing.T) {
+		n, engineWS := newNode(t, &node.Config{
+			HTTPAPIs:  []string{"eth", "debug"},
+			WSAPIs:    []string{"engine"},
+			LocalAPIs: []string{"debug"},
+		})
+		env := environment.New()
+		defer func() {
+			require.NoError(t, env.Close())
+		}()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		require.NoError(t, n.Start(ctx, env))
+
+		modules := func(url string) map[string]string {
+			client, err := rpc.DialContext(ctx, url)
+			require.NoError(t, err)
+			defer client.Close()
+			modules, err := client.SupportedModules()
+			require.NoError(t, err)
+			return modules
+		}
+		// Local namespaces are served to this test, which connects from a loopback address.
+		require.Equal(t, []string{"debug", "eth", "rpc"}, sortedKeys(modules("http://"+engineWS.Addr().String())))
+		require.Equal(t, []string{"engine", "rpc"}, sortedKeys(modules("ws://"+engineWS.Addr().String())))
+	})
+
+	t.Run("unknown namespace", func(t *testing.T) {
+		n, _ := newNode(t, &node.Config{
+			HTTPAPIs: []string{"eth", "admin"},
+		})
+		env := environment.New()
+		defer func() {
+			require.NoError(t, env.Close())
+		}()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		require.ErrorContains(t, n.Start(ctx, env), `unknown namespace "admin"`)
+	})
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func TestIPC(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ipcPath := filepath.Join(t.TempDir(), "monomer.ipc")
+	ipcListener, err := net.Listen("unix", ipcPath)
+	require.NoError(t, err)
+	app := testapp.NewTest(t, chainID.String())
+	n := node.New(
+		app,
+		&genesis.Genesis{
+			ChainID:  chainID,
+			AppState: testapp.MakeGenesisAppState(t, app),
+		},
+		&node.Config{
+			EngineListener: engineWS,
+			CometListener:  cometListener,
+			IPCListener:    ipcListener,
+			IPCAPIs:        []string{"engine", "eth"},
+			EventListener: &node.SelectiveListener{
+				OnIPCServeErrCb: func(err error) {
+					require.NoError(t, err)
+				},
+			},
+		},
+	)
+
+	env := environment.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, n.Start(ctx, env))
+
+	client, err := rpc.DialIPC(ctx, ipcPath)
+	require.NoError(t, err)
+	modules, err := client.SupportedModules()
+	require.NoError(t, err)
+	require.Equal(t, []string{"engine", "eth", "rpc"}, sortedKeys(modules))
+	chainIDBig, err := ethclient.NewClient(client).ChainID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(chainID), chainIDBig.Uint64())
+
+	// Stopping the node closes open connections and removes the socket.
+	cancel()
+	require.NoError(t, env.Close())
+	_, err = ethclient.NewClient(client).ChainID(context.Background())
+	require.Error(t, err)
+	client.Close()
+	require.NoFileExists(t, ipcPath)
+}
+
+func TestLocalConsensus(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	app := testapp.NewTest(t, chainID.String())
+	n := node.New(
+		app,
+		&genesis.Genesis{
+			ChainID:  chainID,
+			AppState: testapp.MakeGenesisAppState(t, app),
+		},
+		&node.Config{
+			EngineListener: engineWS,
+			CometListener:  cometListener,
+			HTTPAPIs:       []string{"engine", "eth"},
+			LocalBlockTime: 10 * time.Millisecond,
+			LocalTimeStep:  12 * time.Second,
+			EventListener: &node.SelectiveListener{
+				OnLocalSequencerErrCb: func(err error) {
+					require.NoError(t, err)
+				},
+			},
+		},
+	)
+
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, n.Start(ctx, env))
+
+	client, err := rpc.DialContext(ctx, "http://"+engineWS.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	// The evm and anvil namespaces are served instead of the engine namespace, since the node builds blocks itself.
+	modules, err := client.SupportedModules()
+	require.NoError(t, err)
+	require.Equal(t, []string{"anvil", "eth", "evm", "rpc"}, sortedKeys(modules))
+
+	ethClient := ethclient.NewClient(client)
+	require.Eventually(t, func() bool {
+		height, err := ethClient.BlockNumber(ctx)
+		require.NoError(t, err)
+		return height >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+	finalized, err := ethClient.HeaderByNumber(ctx, big.NewInt(rpc.FinalizedBlockNumber.Int64()))
+	require.NoError(t, err)
+	require.Positive(t, finalized.Number.Uint64())
+
+	// Blocks are timestamped LocalTimeStep apart.
+	parent, err := ethClient.HeaderByNumber(ctx, big.NewInt(2))
+	require.NoError(t, err)
+	child, err := ethClient.HeaderByNumber(ctx, big.NewInt(3))
+	require.NoError(t, err)
+	require.Equal(t, parent.Time+12, child.Time)
+
+	head, err := ethClient.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	nextTimestamp := head.Time + 1000
+	require.NoError(t, client.CallContext(ctx, nil, "evm_setNextBlockTimestamp", nextTimestamp))
+	var jumped *ethtypes.Header
+	require.Eventually(t, func() bool {
+		header, err := ethClient.HeaderByNumber(ctx, nil)
+		require.NoError(t, err)
+		for number := head.Number.Uint64() + 1; number <= header.Number.Uint64(); number++ {
+			jumped, err = ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+			require.NoError(t, err)
+			if jumped.Time == nextTimestamp {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+	// Later blocks are timestamped as usual.
+	require.Eventually(t, func() bool {
+		header, err := ethClient.HeaderByNumber(ctx, new(big.Int).Add(jumped.Number, big.NewInt(1)))
+		if err != nil {
+			return false
+		}
+		require.Equal(t, nextTimestamp+12, header.Time)
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestLocalConsensusDevAPIs(t *testing.T) {
+	chainID := monomer.ChainID(0)
+	engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	app := testapp.NewTest(t, chainID.String())
+	n := node.New(
+		app,
+		&genesis.Genesis{
+			ChainID:  chainID,
+			AppState: testapp.MakeGenesisAppState(t, app),
+		},
+		&node.Config{
+			EngineListener: engineWS,
+			CometListener:  cometListener,
+			// Only the dev APIs build blocks.
+			LocalBlockTime: time.Hour,
+			LocalTimeStep:  time.Second,
+			// Reverts roll back the chain through the block cache.
+			BlockCacheSize: 1 << 20,
+		},
+	)
+
+	env := environment.New()
+	defer func() {
+		require.NoError(t, env.Close())
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, n.Start(ctx, env))
+
+	client, err := rpc.DialContext(ctx, "ws://"+engineWS.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	ethClient := ethclient.NewClient(client)
+	requireHeight := func(want uint64) {
+		height, err := ethClient.BlockNumber(ctx)
+		require.NoError(t, err)
+		require.Equal(t, want, height)
+	}
+	requireHeight(1)
+
+	require.NoError(t, client.CallContext(ctx, nil, "anvil_mine", 3))
+	requireHeight(4)
+	var snapshot hexutil.Uint64
+	require.NoError(t, client.CallContext(ctx, &snapshot, "evm_snapshot"))
+	require.NoError(t, client.CallContext(ctx, nil, "evm_mine"))
+	requireHeight(5)
+
+	address := common.HexToAddress("0x1234")
+	balance := func() *big.Int {
+		request, err := (&banktypes.QueryBalanceRequest{
+			Address: utils.EvmToCosmosAddress(address).String(),
+			Denom:   rolluptypes.ETH,
+		}).Marshal()
+		require.NoError(t, err)
+		resp, err := app.Query(ctx, &abcitypes.RequestQuery{
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+			Data: request,
+		})
+		require.NoError(t, err)
+		var balance banktypes.QueryBalanceResponse
+		require.NoError(t, balance.Unmarshal(resp.GetValue()))
+		return balance.GetBalance().Amount.BigInt()
+	}
+	require.NoError(t, client.CallContext(ctx, nil, "anvil_setBalance", address, (*hexutil.Big)(big.NewInt(100))))
+	require.Equal(t, big.NewInt(100), balance())
+	requireHeight(6)
+	require.Error(t, client.CallContext(ctx, nil, "anvil_setBalance", address, (*hexutil.Big)(big.NewInt(99))))
+
+	var reverted bool
+	require.NoError(t, client.CallContext(ctx, &reverted, "evm_revert", snapshot))
+	require.True(t, reverted)
+	requireHeight(4)
+	require.Zero(t, balance().Sign())
+
+	require.Error(t, client.CallContext(ctx, nil, "anvil_impersonateAccount", address))
 }
@@ -5,10 +5,20 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"time"
 
+	"github.com/polymerdao/monomer/blockcache"
+	"github.com/polymerdao/monomer/builder"
+	"github.com/polymerdao/monomer/comet"
+	"github.com/polymerdao/monomer/compaction"
+	"github.com/polymerdao/monomer/depositsla"
 	"github.com/polymerdao/monomer/engine"
 	"github.com/polymerdao/monomer/environment"
 	"github.com/polymerdao/monomer/eth"
+	"github.com/polymerdao/monomer/mempoolsync"
+	"github.com/polymerdao/monomer/opnode"
+	"github.com/polymerdao/monomer/systemconfig"
+	"github.com/polymerdao/monomer/txforward"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -39,12 +49,67 @@ func (n *Node) startPrometheusServer(ctx context.Context, env *environment.Env)
 	return nil
 }
 
-func (n *Node) registerMetrics() (eth.Metrics, engine.Metrics) {
+func (n *Node) registerMetrics() (
+	eth.Metrics,
+	engine.Metrics,
+	comet.Metrics,
+	blockcache.Metrics,
+	compaction.Metrics,
+	opnode.Metrics,
+	systemconfig.Metrics,
+	txforward.Metrics,
+	mempoolsync.Metrics,
+	depositsla.Metrics,
+	builder.Metrics,
+) {
 	if n.prometheusCfg.IsPrometheusEnabled() {
 		namespace := n.prometheusCfg.Namespace
+		registerStartMetrics(namespace, time.Now())
+		blockCacheMetrics := blockcache.NewNoopMetrics()
+		if n.blockCacheSize > 0 {
+			blockCacheMetrics = blockcache.NewMetrics(namespace)
+		}
+		opNodeMetrics := opnode.NewNoopMetrics()
+		if n.opNodeMonitor != nil {
+			opNodeMetrics = opnode.NewMetrics(namespace)
+		}
+		systemConfigMetrics := systemconfig.NewNoopMetrics()
+		if n.systemConfig != nil {
+			systemConfigMetrics = systemconfig.NewMetrics(namespace)
+		}
+		txForwardMetrics := txforward.NewNoopMetrics()
+		if n.txForwarding != nil {
+			txForwardMetrics = txforward.NewMetrics(namespace)
+		}
+		mempoolSyncMetrics := mempoolsync.NewNoopMetrics()
+		if n.mempoolSync != nil {
+			mempoolSyncMetrics = mempoolsync.NewMetrics(namespace)
+		}
+		depositSLAMetrics := depositsla.NewNoopMetrics()
+		if n.depositSLA != nil {
+			depositSLAMetrics = depositsla.NewMetrics(namespace)
+		}
 		return eth.NewMetrics(namespace),
-			engine.NewMetrics(namespace)
+			engine.NewMetrics(namespace),
+			comet.NewMetrics(namespace),
+			blockCacheMetrics,
+			compaction.NewMetrics(namespace),
+			opNodeMetrics,
+			systemConfigMetrics,
+			txForwardMetrics,
+			mempoolSyncMetrics,
+			depositSLAMetrics,
+			builder.NewMetrics(namespace)
 	}
 	return eth.NewNoopMetrics(),
-		engine.NewNoopMetrics()
+		engine.NewNoopMetrics(),
+		comet.NewNoopMetrics(),
+		blockcache.NewNoopMetrics(),
+		compaction.NewNoopMetrics(),
+		opnode.NewNoopMetrics(),
+		systemconfig.NewNoopMetrics(),
+		txforward.NewNoopMetrics(),
+		mempoolsync.NewNoopMetrics(),
+		depositsla.NewNoopMetrics(),
+		builder.NewNoopMetrics()
 }
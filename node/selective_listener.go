@@ -4,7 +4,21 @@ type SelectiveListener struct {
 	OnEngineHTTPServeErrCb      func(error)
 	OnEngineWebsocketServeErrCb func(error)
 	OnCometServeErrCb           func(error)
+	OnIPCServeErrCb             func(error)
 	OnPrometheusServeErrCb      func(error)
+	OnFirehoseErrCb             func(error)
+	OnPruningErrCb              func(error)
+	OnBuilderAPIServeErrCb      func(error)
+	OnCrashCb                   func(error)
+	OnLocalSequencerErrCb       func(error)
+	OnCompactionErrCb           func(error)
+	OnOPNodeMonitorErrCb        func(error)
+	OnEngineJWTErrCb            func(error)
+	OnBuilderJWTErrCb           func(error)
+	OnSystemConfigErrCb         func(error)
+	OnMempoolSyncErrCb          func(error)
+	OnDepositSLAErrCb           func(error)
+	OnEngineCompatibilityWarnCb func(error)
 }
 
 func (s *SelectiveListener) OnEngineHTTPServeErr(err error) {
@@ -25,8 +39,92 @@ func (s *SelectiveListener) OnCometServeErr(err error) {
 	}
 }
 
+func (s *SelectiveListener) OnIPCServeErr(err error) {
+	if s.OnIPCServeErrCb != nil {
+		s.OnIPCServeErrCb(err)
+	}
+}
+
 func (s *SelectiveListener) OnPrometheusServeErr(err error) {
 	if s.OnPrometheusServeErrCb != nil {
 		s.OnPrometheusServeErrCb(err)
 	}
 }
+
+func (s *SelectiveListener) OnFirehoseErr(err error) {
+	if s.OnFirehoseErrCb != nil {
+		s.OnFirehoseErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnPruningErr(err error) {
+	if s.OnPruningErrCb != nil {
+		s.OnPruningErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnBuilderAPIServeErr(err error) {
+	if s.OnBuilderAPIServeErrCb != nil {
+		s.OnBuilderAPIServeErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnCrash(err error) {
+	if s.OnCrashCb != nil {
+		s.OnCrashCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnLocalSequencerErr(err error) {
+	if s.OnLocalSequencerErrCb != nil {
+		s.OnLocalSequencerErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnCompactionErr(err error) {
+	if s.OnCompactionErrCb != nil {
+		s.OnCompactionErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnOPNodeMonitorErr(err error) {
+	if s.OnOPNodeMonitorErrCb != nil {
+		s.OnOPNodeMonitorErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnEngineJWTErr(err error) {
+	if s.OnEngineJWTErrCb != nil {
+		s.OnEngineJWTErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnBuilderJWTErr(err error) {
+	if s.OnBuilderJWTErrCb != nil {
+		s.OnBuilderJWTErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnSystemConfigErr(err error) {
+	if s.OnSystemConfigErrCb != nil {
+		s.OnSystemConfigErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnMempoolSyncErr(err error) {
+	if s.OnMempoolSyncErrCb != nil {
+		s.OnMempoolSyncErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnDepositSLAErr(err error) {
+	if s.OnDepositSLAErrCb != nil {
+		s.OnDepositSLAErrCb(err)
+	}
+}
+
+func (s *SelectiveListener) OnEngineCompatibilityWarn(err error) {
+	if s.OnEngineCompatibilityWarnCb != nil {
+		s.OnEngineCompatibilityWarnCb(err)
+	}
+}
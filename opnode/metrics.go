@@ -0,0 +1,92 @@
+package opnode
+
+import (
+	"time"
+
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "opnode"
+
+// Metrics contains metrics collected from the opnode package.
+type Metrics interface {
+	SetUp(up bool)
+	SetStatus(derivationLag, l1HeadLag uint64, safeHeadAge time.Duration, stalled bool)
+}
+
+type metrics struct {
+	// Whether the last poll of op-node's sync status succeeded.
+	Up stdprometheus.Gauge
+	// Number of unsafe blocks that aren't safe yet.
+	DerivationLag stdprometheus.Gauge
+	// Number of L1 blocks op-node hasn't derived from yet.
+	L1HeadLag stdprometheus.Gauge
+	// Time since the safe head last advanced.
+	SafeHeadAge stdprometheus.Gauge
+	// Whether derivation is stalled.
+	Stalled stdprometheus.Gauge
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		Up: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "up",
+			Help:      "1 if the last poll of op-node's sync status succeeded, 0 otherwise",
+		}),
+		DerivationLag: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "derivation_lag_blocks",
+			Help:      "Number of unsafe blocks that aren't safe yet",
+		}),
+		L1HeadLag: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "l1_head_lag_blocks",
+			Help:      "Number of L1 blocks op-node hasn't derived from yet",
+		}),
+		SafeHeadAge: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "safe_head_age_seconds",
+			Help:      "Time since the safe head last advanced",
+		}),
+		Stalled: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "derivation_stalled",
+			Help:      "1 if the safe head stayed behind the unsafe head without advancing for the stall timeout, 0 otherwise",
+		}),
+	}
+}
+
+func (m *metrics) SetUp(up bool) {
+	m.Up.Set(boolToFloat(up))
+}
+
+func (m *metrics) SetStatus(derivationLag, l1HeadLag uint64, safeHeadAge time.Duration, stalled bool) {
+	m.DerivationLag.Set(float64(derivationLag))
+	m.L1HeadLag.Set(float64(l1HeadLag))
+	m.SafeHeadAge.Set(safeHeadAge.Seconds())
+	m.Stalled.Set(boolToFloat(stalled))
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) SetUp(bool) {}
+
+func (*noopMetrics) SetStatus(uint64, uint64, time.Duration, bool) {}
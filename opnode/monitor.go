@@ -0,0 +1,180 @@
+// Package opnode monitors the op-node driving Monomer. Monomer only learns of derivation through the forkchoice updates
+// op-node sends, so a stalled op-node looks like a quiet chain from the inside. The monitor polls op-node's sync status
+// instead and reports how far derivation lags behind the unsafe head and the L1 head.
+package opnode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const (
+	// DefaultInterval is how often op-node's sync status is polled by default.
+	DefaultInterval = 10 * time.Second
+	// DefaultStallTimeout is how long the safe head can stay behind the unsafe head without advancing by default.
+	DefaultStallTimeout = 5 * time.Minute
+)
+
+// Config configures the monitor.
+type Config struct {
+	// Client reads op-node's sync status, e.g., sources.RollupClient.
+	Client SyncStatusClient
+	// Interval is how often the sync status is polled. It defaults to DefaultInterval.
+	Interval time.Duration
+	// StallTimeout is how long the safe head can stay behind the unsafe head without advancing before derivation is
+	// considered stalled. It defaults to DefaultStallTimeout.
+	StallTimeout time.Duration
+	// MaxDerivationLag is the number of unsafe blocks the safe head can lag behind before the node is unhealthy. Zero
+	// disables the check.
+	MaxDerivationLag uint64
+	// MaxL1HeadLag is the number of L1 blocks op-node's derivation can lag behind the L1 head before the node is
+	// unhealthy. Zero disables the check.
+	MaxL1HeadLag uint64
+}
+
+type SyncStatusClient interface {
+	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
+}
+
+// Monitor polls op-node's sync status and reports it through its metrics and Healthy.
+type Monitor struct {
+	client           SyncStatusClient
+	metrics          Metrics
+	interval         time.Duration
+	stallTimeout     time.Duration
+	maxDerivationLag uint64
+	maxL1HeadLag     uint64
+
+	mu        sync.Mutex
+	checked   bool
+	err       error
+	status    *eth.SyncStatus
+	safe      uint64
+	safeSince time.Time
+	stalled   bool
+}
+
+func NewMonitor(cfg *Config, metrics Metrics) *Monitor {
+	interval := cfg.Interval
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+	stallTimeout := cfg.StallTimeout
+	if stallTimeout == 0 {
+		stallTimeout = DefaultStallTimeout
+	}
+	return &Monitor{
+		client:           cfg.Client,
+		metrics:          metrics,
+		interval:         interval,
+		stallTimeout:     stallTimeout,
+		maxDerivationLag: cfg.MaxDerivationLag,
+		maxL1HeadLag:     cfg.MaxL1HeadLag,
+	}
+}
+
+// Run polls op-node every interval until ctx is done. Failed polls and stalls are passed to onErr and don't stop it. A
+// stall is passed to onErr once, when it's detected.
+func (m *Monitor) Run(ctx context.Context, onErr func(error)) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		wasStalled := m.Stalled()
+		if err := m.Check(ctx); err != nil && ctx.Err() == nil {
+			onErr(err)
+		} else if err == nil && !wasStalled && m.Stalled() {
+			onErr(m.Healthy())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check polls op-node's sync status once and updates the metrics.
+func (m *Monitor) Check(ctx context.Context) error {
+	status, err := m.client.SyncStatus(ctx)
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checked = true
+	if err != nil {
+		m.err = fmt.Errorf("get op-node sync status: %v", err)
+		m.metrics.SetUp(false)
+		return m.err
+	}
+	m.err = nil
+	m.status = status
+	m.metrics.SetUp(true)
+
+	if status.SafeL2.Number != m.safe || m.safeSince.IsZero() {
+		m.safe = status.SafeL2.Number
+		m.safeSince = now
+	}
+	// Derivation only has something to do if the sequencer built blocks past the safe head. A quiet chain isn't stalled.
+	m.stalled = derivationLag(status) > 0 && now.Sub(m.safeSince) >= m.stallTimeout
+	m.metrics.SetStatus(derivationLag(status), l1HeadLag(status), now.Sub(m.safeSince), m.stalled)
+	return nil
+}
+
+// Stalled reports whether the safe head stayed behind the unsafe head without advancing for the stall timeout.
+func (m *Monitor) Stalled() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.stalled
+}
+
+// SyncStatus returns the last sync status op-node reported, or nil if it wasn't read yet.
+func (m *Monitor) SyncStatus() *eth.SyncStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status
+}
+
+// Healthy returns an error if the last poll failed, derivation is stalled, or it lags more than the configured limits.
+// It returns nil before the first poll.
+func (m *Monitor) Healthy() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.checked {
+		return nil
+	}
+	if m.err != nil {
+		return m.err
+	}
+	var errs []error
+	if m.stalled {
+		errs = append(errs, fmt.Errorf("derivation stalled: the safe head %d hasn't advanced in %s", m.safe, m.stallTimeout))
+	}
+	if lag := derivationLag(m.status); m.maxDerivationLag > 0 && lag > m.maxDerivationLag {
+		errs = append(errs, fmt.Errorf("the safe head lags %d blocks behind the unsafe head, more than %d", lag, m.maxDerivationLag))
+	}
+	if lag := l1HeadLag(m.status); m.maxL1HeadLag > 0 && lag > m.maxL1HeadLag {
+		errs = append(errs, fmt.Errorf("derivation lags %d blocks behind the L1 head, more than %d", lag, m.maxL1HeadLag))
+	}
+	return errors.Join(errs...)
+}
+
+// derivationLag is the number of unsafe blocks that aren't safe yet.
+func derivationLag(status *eth.SyncStatus) uint64 {
+	if status.UnsafeL2.Number < status.SafeL2.Number {
+		return 0
+	}
+	return status.UnsafeL2.Number - status.SafeL2.Number
+}
+
+// l1HeadLag is the number of L1 blocks op-node hasn't derived from yet.
+func l1HeadLag(status *eth.SyncStatus) uint64 {
+	if status.HeadL1.Number < status.CurrentL1.Number {
+		return 0
+	}
+	return status.HeadL1.Number - status.CurrentL1.Number
+}
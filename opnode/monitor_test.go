@@ -0,0 +1,129 @@
+package opnode_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/polymerdao/monomer/opnode"
+	"github.com/stretchr/testify/require"
+)
+
+type mockClient struct {
+	mu     sync.Mutex
+	status *eth.SyncStatus
+	err    error
+}
+
+func (c *mockClient) SyncStatus(context.Context) (*eth.SyncStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.status, c.err
+}
+
+func (c *mockClient) set(status *eth.SyncStatus, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.status = status
+	c.err = err
+}
+
+func newStatus(unsafe, safe, currentL1, headL1 uint64) *eth.SyncStatus {
+	return &eth.SyncStatus{
+		UnsafeL2:  eth.L2BlockRef{Number: unsafe},
+		SafeL2:    eth.L2BlockRef{Number: safe},
+		CurrentL1: eth.L1BlockRef{Number: currentL1},
+		HeadL1:    eth.L1BlockRef{Number: headL1},
+	}
+}
+
+func TestMonitor(t *testing.T) {
+	ctx := context.Background()
+	client := new(mockClient)
+	monitor := opnode.NewMonitor(&opnode.Config{
+		Client:           client,
+		StallTimeout:     time.Hour,
+		MaxDerivationLag: 10,
+		MaxL1HeadLag:     5,
+	}, opnode.NewNoopMetrics())
+	require.NoError(t, monitor.Healthy())
+	require.Nil(t, monitor.SyncStatus())
+
+	status := newStatus(20, 15, 100, 102)
+	client.set(status, nil)
+	require.NoError(t, monitor.Check(ctx))
+	require.NoError(t, monitor.Healthy())
+	require.Equal(t, status, monitor.SyncStatus())
+
+	client.set(newStatus(30, 15, 100, 102), nil)
+	require.NoError(t, monitor.Check(ctx))
+	require.ErrorContains(t, monitor.Healthy(), "lags 15 blocks behind the unsafe head")
+
+	client.set(newStatus(30, 25, 100, 110), nil)
+	require.NoError(t, monitor.Check(ctx))
+	require.ErrorContains(t, monitor.Healthy(), "lags 10 blocks behind the L1 head")
+
+	client.set(nil, errors.New("connection refused"))
+	require.Error(t, monitor.Check(ctx))
+	require.ErrorContains(t, monitor.Healthy(), "connection refused")
+}
+
+func TestMonitorStall(t *testing.T) {
+	ctx := context.Background()
+	client := new(mockClient)
+	monitor := opnode.NewMonitor(&opnode.Config{
+		Client:       client,
+		StallTimeout: time.Nanosecond,
+	}, opnode.NewNoopMetrics())
+
+	// A quiet chain isn't stalled.
+	client.set(newStatus(10, 10, 100, 100), nil)
+	require.NoError(t, monitor.Check(ctx))
+	time.Sleep(time.Millisecond)
+	require.NoError(t, monitor.Check(ctx))
+	require.False(t, monitor.Stalled())
+	require.NoError(t, monitor.Healthy())
+
+	// The safe head stays behind the unsafe head.
+	client.set(newStatus(12, 10, 100, 100), nil)
+	time.Sleep(time.Millisecond)
+	require.NoError(t, monitor.Check(ctx))
+	require.True(t, monitor.Stalled())
+	require.ErrorContains(t, monitor.Healthy(), "derivation stalled")
+
+	// Derivation catches up.
+	client.set(newStatus(12, 11, 100, 100), nil)
+	require.NoError(t, monitor.Check(ctx))
+	require.False(t, monitor.Stalled())
+	require.NoError(t, monitor.Healthy())
+}
+
+func TestMonitorRun(t *testing.T) {
+	client := new(mockClient)
+	client.set(newStatus(12, 10, 100, 100), nil)
+	monitor := opnode.NewMonitor(&opnode.Config{
+		Client:       client,
+		Interval:     time.Millisecond,
+		StallTimeout: 10 * time.Millisecond,
+	}, opnode.NewNoopMetrics())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errs := make(chan error, 100)
+	go monitor.Run(ctx, func(err error) {
+		select {
+		case errs <- err:
+		default:
+		}
+	})
+	// The stall is reported once.
+	require.ErrorContains(t, <-errs, "derivation stalled")
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, errs)
+
+	client.set(nil, errors.New("connection refused"))
+	require.ErrorContains(t, <-errs, "connection refused")
+}
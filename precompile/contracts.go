@@ -0,0 +1,209 @@
+package precompile
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+)
+
+var (
+	// BankAddress is the address of the bank precompile, see NewBankContract.
+	BankAddress = common.HexToAddress("0x0000000000000000000000000000000000000c01")
+	// StakingAddress is the address of the staking precompile, see NewStakingContract.
+	StakingAddress = common.HexToAddress("0x0000000000000000000000000000000000000c02")
+	// RollupAddress is the address of the rollup precompile, see NewRollupContract.
+	RollupAddress = common.HexToAddress("0x0000000000000000000000000000000000000c03")
+)
+
+const (
+	readGas  = 2_600
+	writeGas = 20_000
+)
+
+const bankABI = `[{
+	"type": "function",
+	"name": "balanceOf",
+	"stateMutability": "view",
+	"inputs": [{"name": "account", "type": "address"}, {"name": "denom", "type": "string"}],
+	"outputs": [{"name": "", "type": "uint256"}]
+}, {
+	"type": "function",
+	"name": "send",
+	"stateMutability": "nonpayable",
+	"inputs": [{"name": "to", "type": "address"}, {"name": "denom", "type": "string"}, {"name": "amount", "type": "uint256"}],
+	"outputs": [{"name": "", "type": "bool"}]
+}]`
+
+// NewBankContract returns the bank precompile:
+//
+//	function balanceOf(address account, string denom) view returns (uint256)
+//	function send(address to, string denom, uint256 amount) returns (bool)
+//
+// send moves coins from the caller's Cosmos account to to's.
+func NewBankContract() *Contract {
+	return mustNewContract(BankAddress, bankABI, map[string]*Method{
+		"balanceOf": {
+			Gas:      readGas,
+			ReadOnly: true,
+			Run: func(ctx sdk.Context, call *Call, args []any) ([]any, error) { //nolint:gocritic // hugeParam
+				account, err := call.Account(args[0].(common.Address))
+				if err != nil {
+					return nil, err
+				}
+				resp := new(banktypes.QueryBalanceResponse)
+				if err := call.Query(ctx, "/cosmos.bank.v1beta1.Query/Balance", &banktypes.QueryBalanceRequest{
+					Address: account,
+					Denom:   args[1].(string),
+				}, resp); err != nil {
+					return nil, err
+				}
+				if resp.Balance == nil {
+					return nil, errors.New("response has no balance")
+				}
+				return []any{resp.Balance.Amount.BigInt()}, nil
+			},
+		},
+		"send": {
+			Gas: writeGas,
+			Run: func(ctx sdk.Context, call *Call, args []any) ([]any, error) { //nolint:gocritic // hugeParam
+				from, err := call.Account(call.Caller)
+				if err != nil {
+					return nil, err
+				}
+				to, err := call.Account(args[0].(common.Address))
+				if err != nil {
+					return nil, err
+				}
+				if _, err := call.RunMsg(ctx, &banktypes.MsgSend{
+					FromAddress: from,
+					ToAddress:   to,
+					Amount:      sdk.NewCoins(sdk.NewCoin(args[1].(string), sdkmath.NewIntFromBigInt(args[2].(*big.Int)))),
+				}); err != nil {
+					return nil, err
+				}
+				return []any{true}, nil
+			},
+		},
+	})
+}
+
+const stakingABI = `[{
+	"type": "function",
+	"name": "delegate",
+	"stateMutability": "nonpayable",
+	"inputs": [{"name": "validator", "type": "string"}, {"name": "amount", "type": "uint256"}],
+	"outputs": [{"name": "", "type": "bool"}]
+}, {
+	"type": "function",
+	"name": "undelegate",
+	"stateMutability": "nonpayable",
+	"inputs": [{"name": "validator", "type": "string"}, {"name": "amount", "type": "uint256"}],
+	"outputs": [{"name": "", "type": "bool"}]
+}]`
+
+// NewStakingContract returns the staking precompile:
+//
+//	function delegate(string validator, uint256 amount) returns (bool)
+//	function undelegate(string validator, uint256 amount) returns (bool)
+//
+// validator is the validator's bech32 operator address, and amount is in the bond denom.
+func NewStakingContract() *Contract {
+	stake := func(newMsg func(delegator, validator string, amount sdk.Coin) sdk.Msg) *Method {
+		return &Method{
+			Gas: writeGas,
+			Run: func(ctx sdk.Context, call *Call, args []any) ([]any, error) { //nolint:gocritic // hugeParam
+				delegator, err := call.Account(call.Caller)
+				if err != nil {
+					return nil, err
+				}
+				params := new(stakingtypes.QueryParamsResponse)
+				if err := call.Query(ctx, "/cosmos.staking.v1beta1.Query/Params", &stakingtypes.QueryParamsRequest{}, params); err != nil {
+					return nil, err
+				}
+				amount := sdk.NewCoin(params.Params.BondDenom, sdkmath.NewIntFromBigInt(args[1].(*big.Int)))
+				if _, err := call.RunMsg(ctx, newMsg(delegator, args[0].(string), amount)); err != nil {
+					return nil, err
+				}
+				return []any{true}, nil
+			},
+		}
+	}
+	return mustNewContract(StakingAddress, stakingABI, map[string]*Method{
+		"delegate": stake(func(delegator, validator string, amount sdk.Coin) sdk.Msg {
+			return stakingtypes.NewMsgDelegate(delegator, validator, amount)
+		}),
+		"undelegate": stake(func(delegator, validator string, amount sdk.Coin) sdk.Msg {
+			return stakingtypes.NewMsgUndelegate(delegator, validator, amount)
+		}),
+	})
+}
+
+const rollupABI = `[{
+	"type": "function",
+	"name": "initiateWithdrawal",
+	"stateMutability": "nonpayable",
+	"inputs": [
+		{"name": "target", "type": "address"},
+		{"name": "value", "type": "uint256"},
+		{"name": "gasLimit", "type": "uint256"},
+		{"name": "data", "type": "bytes"}
+	],
+	"outputs": [{"name": "withdrawalHash", "type": "bytes32"}]
+}]`
+
+// NewRollupContract returns the rollup precompile:
+//
+//	function initiateWithdrawal(address target, uint256 value, uint256 gasLimit, bytes data) returns (bytes32 withdrawalHash)
+//
+// initiateWithdrawal burns value wei of ETH from the caller's Cosmos account and initiates a withdrawal of it to target
+// on L1, like MsgInitiateWithdrawal. It returns the hash the withdrawal is proven and finalized with.
+func NewRollupContract() *Contract {
+	return mustNewContract(RollupAddress, rollupABI, map[string]*Method{
+		"initiateWithdrawal": {
+			Gas: writeGas,
+			Run: func(ctx sdk.Context, call *Call, args []any) ([]any, error) { //nolint:gocritic // hugeParam
+				sender, err := call.Account(call.Caller)
+				if err != nil {
+					return nil, err
+				}
+				res, err := call.RunMsg(ctx, &rolluptypes.MsgInitiateWithdrawal{
+					Sender:   sender,
+					Target:   args[0].(common.Address).Hex(),
+					Value:    sdkmath.NewIntFromBigInt(args[1].(*big.Int)),
+					GasLimit: args[2].(*big.Int).Bytes(),
+					Data:     args[3].([]byte),
+				})
+				if err != nil {
+					return nil, err
+				}
+				for _, event := range res.GetEvents() {
+					if event.Type != rolluptypes.EventTypeWithdrawalInitiated {
+						continue
+					}
+					for _, attr := range event.Attributes {
+						if attr.Key == rolluptypes.AttributeKeyWithdrawalHash {
+							return []any{common.HexToHash(attr.Value)}, nil
+						}
+					}
+				}
+				return nil, fmt.Errorf("no %s event with the withdrawal hash", rolluptypes.EventTypeWithdrawalInitiated)
+			},
+		},
+	})
+}
+
+// mustNewContract returns the contract of a built-in ABI, which is known to be valid.
+func mustNewContract(addr common.Address, abiJSON string, methods map[string]*Method) *Contract {
+	c, err := NewContract(addr, abiJSON, methods)
+	if err != nil {
+		panic(fmt.Errorf("new contract at %s: %v", addr, err))
+	}
+	return c
+}
@@ -0,0 +1,275 @@
+// Package precompile exposes Cosmos modules to an EVM embedded in an appchain as stateful precompiles, so Solidity
+// contracts on the rollup can, e.g., initiate withdrawals to L1 natively.
+//
+// Monomer's own EVM only runs the L2ToL1MessagePasser, so this package is for appchains that embed a general-purpose
+// EVM. A precompile is a Contract at a fixed address with an ABI: the EVM hands a call to it to Precompiles.Run with the
+// app's sdk.Context, the method's handler reads state with gRPC queries and changes it with msgs signed by the caller,
+// and the return values are ABI-encoded. The EVM is responsible for charging RequiredGas before the call and the SDK
+// gas the call consumes from the context's gas meter after it.
+package precompile
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/addrconv"
+)
+
+// MsgRouter routes msgs to their module's handler. It is implemented by *baseapp.MsgServiceRouter.
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// QueryRouter routes gRPC queries to their module's handler. It is implemented by *baseapp.GRPCQueryRouter.
+type QueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
+
+// Method is a method of a Contract's ABI.
+type Method struct {
+	// Gas is the gas the EVM charges before the call, on top of the SDK gas the call consumes.
+	Gas uint64
+	// ReadOnly methods don't change state, so they may be called with STATICCALL. Other methods fail then.
+	ReadOnly bool
+	// Run serves a call with the method's unpacked arguments and returns the values the method returns.
+	Run func(ctx sdk.Context, call *Call, args []any) ([]any, error)
+}
+
+type method struct {
+	*Method
+	abi gethabi.Method
+}
+
+// Contract is a precompile serving the methods of an ABI at an address.
+type Contract struct {
+	address common.Address
+	methods map[[4]byte]*method
+}
+
+// NewContract returns a contract serving the methods of abiJSON at addr. Every method must be in methods.
+func NewContract(addr common.Address, abiJSON string, methods map[string]*Method) (*Contract, error) {
+	abi, err := gethabi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("parse abi: %v", err)
+	}
+	if len(abi.Methods) != len(methods) {
+		return nil, fmt.Errorf("abi has %d methods, but %d are implemented", len(abi.Methods), len(methods))
+	}
+	c := &Contract{
+		address: addr,
+		methods: make(map[[4]byte]*method, len(methods)),
+	}
+	for name, abiMethod := range abi.Methods {
+		m, ok := methods[name]
+		if !ok {
+			return nil, fmt.Errorf("method %s is not implemented", name)
+		}
+		c.methods[[4]byte(abiMethod.ID)] = &method{
+			Method: m,
+			abi:    abiMethod,
+		}
+	}
+	return c, nil
+}
+
+func (c *Contract) Address() common.Address {
+	return c.address
+}
+
+func (c *Contract) method(input []byte) (*method, error) {
+	if len(input) < 4 { //nolint:mnd
+		return nil, fmt.Errorf("input is %d bytes long, but a method selector is 4 bytes", len(input))
+	}
+	m, ok := c.methods[[4]byte(input[:4])]
+	if !ok {
+		return nil, fmt.Errorf("no method with selector %x at %s", input[:4], c.address)
+	}
+	return m, nil
+}
+
+// Precompiles are the contracts an app exposes to its EVM.
+type Precompiles struct {
+	contracts map[common.Address]*Contract
+	msgs      MsgRouter
+	queries   QueryRouter
+	cdc       codec.Codec
+	prefix    string
+}
+
+// New returns an empty set of precompiles that run msgs with msgs, queries with queries, and find the signers of msgs
+// with cdc. prefix is the app's bech32 account prefix.
+func New(msgs MsgRouter, queries QueryRouter, cdc codec.Codec, prefix string) *Precompiles {
+	return &Precompiles{
+		contracts: make(map[common.Address]*Contract),
+		msgs:      msgs,
+		queries:   queries,
+		cdc:       cdc,
+		prefix:    prefix,
+	}
+}
+
+// Register adds the contracts. Their addresses must be unique.
+func (p *Precompiles) Register(contracts ...*Contract) error {
+	for _, c := range contracts {
+		if _, ok := p.contracts[c.address]; ok {
+			return fmt.Errorf("%s is already registered", c.address)
+		}
+		p.contracts[c.address] = c
+	}
+	return nil
+}
+
+// Addresses returns the addresses of the contracts in ascending order, e.g., for the EVM's list of active precompiles.
+func (p *Precompiles) Addresses() []common.Address {
+	addrs := make([]common.Address, 0, len(p.contracts))
+	for addr := range p.contracts {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Cmp(addrs[j]) < 0
+	})
+	return addrs
+}
+
+// IsPrecompile reports whether a contract is registered at addr.
+func (p *Precompiles) IsPrecompile(addr common.Address) bool {
+	_, ok := p.contracts[addr]
+	return ok
+}
+
+// RequiredGas returns the gas the EVM charges before a call of the contract at addr with input. Calls that will fail
+// cost nothing up front.
+func (p *Precompiles) RequiredGas(addr common.Address, input []byte) uint64 {
+	c, ok := p.contracts[addr]
+	if !ok {
+		return 0
+	}
+	m, err := c.method(input)
+	if err != nil {
+		return 0
+	}
+	return m.Gas
+}
+
+// Run serves a call of the contract at addr by caller with value and input. readOnly is true for STATICCALLs. The
+// call's state changes and events are only written to ctx if it succeeds.
+func (p *Precompiles) Run(
+	ctx sdk.Context, //nolint:gocritic // hugeParam
+	addr, caller common.Address,
+	value *big.Int,
+	input []byte,
+	readOnly bool,
+) ([]byte, error) {
+	c, ok := p.contracts[addr]
+	if !ok {
+		return nil, fmt.Errorf("no precompile at %s", addr)
+	}
+	m, err := c.method(input)
+	if err != nil {
+		return nil, err
+	}
+	// The EVM would credit the value to the precompile's address, where no one could spend it.
+	if value != nil && value.Sign() != 0 {
+		return nil, fmt.Errorf("%s does not accept value", m.abi.Name)
+	}
+	if readOnly && !m.ReadOnly {
+		return nil, fmt.Errorf("%s changes state and can't be called with STATICCALL", m.abi.Name)
+	}
+	args, err := m.abi.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, fmt.Errorf("unpack arguments of %s: %v", m.abi.Name, err)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	values, err := m.Run(cacheCtx, &Call{
+		precompiles: p,
+		Caller:      caller,
+	}, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", m.abi.Name, err)
+	}
+	out, err := m.abi.Outputs.Pack(values...)
+	if err != nil {
+		return nil, fmt.Errorf("pack return values of %s: %v", m.abi.Name, err)
+	}
+	write()
+	return out, nil
+}
+
+// Call is a call of a precompile's method.
+type Call struct {
+	precompiles *Precompiles
+	// Caller is the address that called the precompile, e.g., a contract. Msgs the call runs must be signed by its
+	// Cosmos account.
+	Caller common.Address
+}
+
+// Account returns the bech32 Cosmos account of addr, see the addrconv package.
+func (c *Call) Account(addr common.Address) (string, error) {
+	account, err := addrconv.Bech32(addr, c.precompiles.prefix)
+	if err != nil {
+		return "", fmt.Errorf("encode bech32 account: %v", err)
+	}
+	return account, nil
+}
+
+// RunMsg runs msg like a tx would. Its signers must be the caller's Cosmos account, so a contract can only act on its
+// own behalf.
+func (c *Call) RunMsg(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) { //nolint:gocritic // hugeParam
+	handler := c.precompiles.msgs.Handler(msg)
+	if handler == nil {
+		return nil, fmt.Errorf("no handler for %s", sdk.MsgTypeURL(msg))
+	}
+	signers, _, err := c.precompiles.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, fmt.Errorf("get signers: %v", err)
+	}
+	caller := addrconv.ToCosmos(c.Caller)
+	for _, signer := range signers {
+		if !caller.Equals(sdk.AccAddress(signer)) {
+			return nil, fmt.Errorf("%s is signed by %s, not by the caller %s", sdk.MsgTypeURL(msg), sdk.AccAddress(signer), caller)
+		}
+	}
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, msg)
+}
+
+// Query runs the gRPC query at path with req and decodes the response into resp.
+func (c *Call) Query(ctx sdk.Context, path string, req, resp proto.Message) error { //nolint:gocritic // hugeParam
+	handler := c.precompiles.queries.Route(path)
+	if handler == nil {
+		return fmt.Errorf("no handler for query %s", path)
+	}
+	reqBytes, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %v", err)
+	}
+	res, err := handler(ctx, &abcitypes.RequestQuery{
+		Path: path,
+		Data: reqBytes,
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return errors.New("query returned no response")
+	}
+	if err := proto.Unmarshal(res.Value, resp); err != nil {
+		return fmt.Errorf("unmarshal response: %v", err)
+	}
+	return nil
+}
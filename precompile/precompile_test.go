@@ -0,0 +1,347 @@
+package precompile_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/precompile"
+	"github.com/polymerdao/monomer/testapp"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	chainID = "0"
+	prefix  = "cosmos"
+
+	transferABI = `[{
+		"type": "function",
+		"name": "transfer",
+		"stateMutability": "nonpayable",
+		"inputs": [{"name": "from", "type": "address"}, {"name": "to", "type": "address"}, {"name": "fail", "type": "bool"}],
+		"outputs": []
+	}]`
+)
+
+var transferAddress = common.HexToAddress("0x0000000000000000000000000000000000000cff")
+
+// newApp returns a test app with its genesis state committed and a context to run precompiles against its state.
+func newApp(t *testing.T) (*testapp.App, sdk.Context) {
+	app := testapp.NewTest(t, chainID)
+	_, err := app.InitChain(context.Background(), &abcitypes.RequestInitChain{
+		ChainId: chainID,
+		AppStateBytes: func() []byte {
+			got, err := json.Marshal(app.DefaultGenesis())
+			require.NoError(t, err)
+			return got
+		}(),
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+	// The genesis state is committed with the first block.
+	_, err = app.FinalizeBlock(context.Background(), &abcitypes.RequestFinalizeBlock{
+		Height: 1,
+	})
+	require.NoError(t, err)
+	_, err = app.Commit(context.Background(), &abcitypes.RequestCommit{})
+	require.NoError(t, err)
+	return app, app.BaseApp().NewUncachedContext(false, cmtproto.Header{
+		ChainID: chainID,
+		Height:  2,
+	})
+}
+
+// newPrecompiles returns the app's precompiles with the built-in contracts and a transfer contract, which sends 1 wei
+// from one account to another and fails afterwards if asked to.
+func newPrecompiles(t *testing.T, app *testapp.App) *precompile.Precompiles {
+	transfer, err := precompile.NewContract(transferAddress, transferABI, map[string]*precompile.Method{
+		"transfer": {
+			Gas: 1,
+			Run: func(ctx sdk.Context, call *precompile.Call, args []any) ([]any, error) { //nolint:gocritic // hugeParam
+				from, err := call.Account(args[0].(common.Address))
+				require.NoError(t, err)
+				to, err := call.Account(args[1].(common.Address))
+				require.NoError(t, err)
+				if _, err := call.RunMsg(ctx, banktypes.NewMsgSend(
+					sdk.MustAccAddressFromBech32(from),
+					sdk.MustAccAddressFromBech32(to),
+					sdk.NewCoins(sdk.NewCoin(rolluptypes.ETH, math.OneInt())),
+				)); err != nil {
+					return nil, err
+				}
+				if args[2].(bool) {
+					return nil, errors.New("transfer failed")
+				}
+				return nil, nil
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	p := precompile.New(app.BaseApp().MsgServiceRouter(), app.BaseApp().GRPCQueryRouter(), app.AppCodec(), prefix)
+	require.NoError(t, p.Register(precompile.NewBankContract(), precompile.NewRollupContract(), transfer))
+	return p
+}
+
+func parseABI(t *testing.T, abiJSON string) gethabi.ABI {
+	abi, err := gethabi.JSON(strings.NewReader(abiJSON))
+	require.NoError(t, err)
+	return abi
+}
+
+func pack(t *testing.T, abiJSON, method string, args ...any) []byte {
+	input, err := parseABI(t, abiJSON).Pack(method, args...)
+	require.NoError(t, err)
+	return input
+}
+
+func balance(t *testing.T, p *precompile.Precompiles, ctx sdk.Context, addr common.Address) *big.Int { //nolint:gocritic // hugeParam
+	abiJSON := precompileABI(t, precompile.BankAddress)
+	out, err := p.Run(ctx, precompile.BankAddress, common.Address{}, nil, pack(t, abiJSON, "balanceOf", addr, rolluptypes.ETH), true)
+	require.NoError(t, err)
+	values, err := parseABI(t, abiJSON).Unpack("balanceOf", out)
+	require.NoError(t, err)
+	return values[0].(*big.Int)
+}
+
+func precompileABI(t *testing.T, addr common.Address) string {
+	switch addr {
+	case precompile.BankAddress:
+		return `[{
+			"type": "function",
+			"name": "balanceOf",
+			"stateMutability": "view",
+			"inputs": [{"name": "account", "type": "address"}, {"name": "denom", "type": "string"}],
+			"outputs": [{"name": "", "type": "uint256"}]
+		}, {
+			"type": "function",
+			"name": "send",
+			"stateMutability": "nonpayable",
+			"inputs": [{"name": "to", "type": "address"}, {"name": "denom", "type": "string"}, {"name": "amount", "type": "uint256"}],
+			"outputs": [{"name": "", "type": "bool"}]
+		}]`
+	case precompile.StakingAddress:
+		return `[{
+			"type": "function",
+			"name": "delegate",
+			"stateMutability": "nonpayable",
+			"inputs": [{"name": "validator", "type": "string"}, {"name": "amount", "type": "uint256"}],
+			"outputs": [{"name": "", "type": "bool"}]
+		}]`
+	case precompile.RollupAddress:
+		return `[{
+			"type": "function",
+			"name": "initiateWithdrawal",
+			"stateMutability": "nonpayable",
+			"inputs": [
+				{"name": "target", "type": "address"},
+				{"name": "value", "type": "uint256"},
+				{"name": "gasLimit", "type": "uint256"},
+				{"name": "data", "type": "bytes"}
+			],
+			"outputs": [{"name": "withdrawalHash", "type": "bytes32"}]
+		}]`
+	}
+	t.Fatalf("no abi for %s", addr)
+	return ""
+}
+
+func TestNewContract(t *testing.T) {
+	run := func(sdk.Context, *precompile.Call, []any) ([]any, error) { //nolint:gocritic // hugeParam
+		return nil, nil
+	}
+	_, err := precompile.NewContract(transferAddress, "not json", nil)
+	require.ErrorContains(t, err, "parse abi")
+	_, err = precompile.NewContract(transferAddress, transferABI, nil)
+	require.ErrorContains(t, err, "1 methods, but 0 are implemented")
+	_, err = precompile.NewContract(transferAddress, transferABI, map[string]*precompile.Method{"other": {Run: run}})
+	require.ErrorContains(t, err, "method transfer is not implemented")
+
+	c, err := precompile.NewContract(transferAddress, transferABI, map[string]*precompile.Method{"transfer": {Run: run}})
+	require.NoError(t, err)
+	require.Equal(t, transferAddress, c.Address())
+}
+
+func TestRegister(t *testing.T) {
+	p := precompile.New(nil, nil, nil, prefix)
+	require.NoError(t, p.Register(precompile.NewRollupContract(), precompile.NewBankContract()))
+	require.ErrorContains(t, p.Register(precompile.NewBankContract()), "already registered")
+	require.Equal(t, []common.Address{precompile.BankAddress, precompile.RollupAddress}, p.Addresses())
+	require.True(t, p.IsPrecompile(precompile.BankAddress))
+	require.False(t, p.IsPrecompile(precompile.StakingAddress))
+
+	input := pack(t, precompileABI(t, precompile.BankAddress), "balanceOf", common.Address{}, rolluptypes.ETH)
+	require.Equal(t, uint64(2_600), p.RequiredGas(precompile.BankAddress, input))
+	require.Zero(t, p.RequiredGas(precompile.StakingAddress, input))
+	require.Zero(t, p.RequiredGas(precompile.BankAddress, input[:3]))
+	require.Zero(t, p.RequiredGas(precompile.BankAddress, []byte{1, 2, 3, 4}))
+}
+
+func TestRun(t *testing.T) {
+	app, ctx := newApp(t)
+	p := newPrecompiles(t, app)
+	from := common.BytesToAddress(testapp.GetAccount(0).Address)
+	to := common.BytesToAddress(testapp.GetAccount(1).Address)
+
+	tests := map[string]struct {
+		addr     common.Address
+		caller   common.Address
+		value    *big.Int
+		input    []byte
+		readOnly bool
+		err      string
+	}{
+		"no precompile": {
+			addr:  precompile.StakingAddress,
+			input: pack(t, transferABI, "transfer", from, to, false),
+			err:   "no precompile",
+		},
+		"no method": {
+			addr:  transferAddress,
+			input: []byte{1, 2, 3, 4},
+			err:   "no method with selector",
+		},
+		"value": {
+			addr:   transferAddress,
+			caller: from,
+			value:  big.NewInt(1),
+			input:  pack(t, transferABI, "transfer", from, to, false),
+			err:    "does not accept value",
+		},
+		"static call": {
+			addr:     transferAddress,
+			caller:   from,
+			input:    pack(t, transferABI, "transfer", from, to, false),
+			readOnly: true,
+			err:      "can't be called with STATICCALL",
+		},
+		"bad arguments": {
+			addr:   transferAddress,
+			caller: from,
+			input:  pack(t, transferABI, "transfer", from, to, false)[:40],
+			err:    "unpack arguments",
+		},
+		"signed by another account": {
+			addr:   transferAddress,
+			caller: to,
+			input:  pack(t, transferABI, "transfer", from, to, false),
+			err:    "not by the caller",
+		},
+		"method fails": {
+			addr:   transferAddress,
+			caller: from,
+			input:  pack(t, transferABI, "transfer", from, to, true),
+			err:    "transfer failed",
+		},
+	}
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			_, err := p.Run(ctx, test.addr, test.caller, test.value, test.input, test.readOnly)
+			require.ErrorContains(t, err, test.err)
+			// Failed calls don't change state, even if they ran msgs.
+			require.Equal(t, testapp.AccountBalance.BigInt(), balance(t, p, ctx, from))
+			require.Equal(t, testapp.AccountBalance.BigInt(), balance(t, p, ctx, to))
+		})
+	}
+
+	_, err := p.Run(ctx, transferAddress, from, new(big.Int), pack(t, transferABI, "transfer", from, to, false), false)
+	require.NoError(t, err)
+	require.Equal(t, testapp.AccountBalance.SubRaw(1).BigInt(), balance(t, p, ctx, from))
+	require.Equal(t, testapp.AccountBalance.AddRaw(1).BigInt(), balance(t, p, ctx, to))
+}
+
+func TestBankContract(t *testing.T) {
+	app, ctx := newApp(t)
+	p := newPrecompiles(t, app)
+	from := common.BytesToAddress(testapp.GetAccount(0).Address)
+	to := common.Address{1}
+
+	require.Equal(t, testapp.AccountBalance.BigInt(), balance(t, p, ctx, from))
+	require.Zero(t, balance(t, p, ctx, to).Sign())
+
+	abiJSON := precompileABI(t, precompile.BankAddress)
+	out, err := p.Run(ctx, precompile.BankAddress, from, nil, pack(t, abiJSON, "send", to, rolluptypes.ETH, big.NewInt(100)), false)
+	require.NoError(t, err)
+	values, err := parseABI(t, abiJSON).Unpack("send", out)
+	require.NoError(t, err)
+	require.Equal(t, true, values[0])
+	require.Equal(t, testapp.AccountBalance.SubRaw(100).BigInt(), balance(t, p, ctx, from))
+	require.Equal(t, big.NewInt(100), balance(t, p, ctx, to))
+
+	// The caller can only spend its own coins.
+	_, err = p.Run(ctx, precompile.BankAddress, to, nil, pack(t, abiJSON, "send", from, rolluptypes.ETH, big.NewInt(101)), false)
+	require.ErrorContains(t, err, "insufficient funds")
+}
+
+func TestRollupContract(t *testing.T) {
+	app, ctx := newApp(t)
+	p := newPrecompiles(t, app)
+	sender := common.BytesToAddress(testapp.GetAccount(0).Address)
+	target := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	abiJSON := precompileABI(t, precompile.RollupAddress)
+
+	out, err := p.Run(ctx, precompile.RollupAddress, sender, nil, pack(t, abiJSON, "initiateWithdrawal", target, big.NewInt(1000), big.NewInt(100_000), []byte{}), false)
+	require.NoError(t, err)
+	values, err := parseABI(t, abiJSON).Unpack("initiateWithdrawal", out)
+	require.NoError(t, err)
+	require.NotEqual(t, [32]byte{}, values[0])
+	require.Equal(t, testapp.AccountBalance.SubRaw(1000).BigInt(), balance(t, p, ctx, sender))
+
+	// Withdrawals are validated like MsgInitiateWithdrawal.
+	_, err = p.Run(ctx, precompile.RollupAddress, sender, nil, pack(t, abiJSON, "initiateWithdrawal", target, big.NewInt(1000), big.NewInt(1), []byte{}), false)
+	require.ErrorContains(t, err, "gas limit")
+}
+
+// stakingRouter records the msgs it handles and serves the staking params query.
+type stakingRouter struct {
+	msgs []sdk.Msg
+}
+
+func (r *stakingRouter) Handler(sdk.Msg) baseapp.MsgServiceHandler {
+	return func(_ sdk.Context, msg sdk.Msg) (*sdk.Result, error) { //nolint:gocritic // hugeParam
+		r.msgs = append(r.msgs, msg)
+		return &sdk.Result{}, nil
+	}
+}
+
+func (r *stakingRouter) Route(string) baseapp.GRPCQueryHandler {
+	return func(sdk.Context, *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error) { //nolint:gocritic // hugeParam
+		value, err := (&stakingtypes.QueryParamsResponse{
+			Params: stakingtypes.Params{BondDenom: "stake"},
+		}).Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return &abcitypes.ResponseQuery{Value: value}, nil
+	}
+}
+
+func TestStakingContract(t *testing.T) {
+	// The test app has no staking module.
+	app, ctx := newApp(t)
+	router := new(stakingRouter)
+	p := precompile.New(router, router, app.AppCodec(), prefix)
+	require.NoError(t, p.Register(precompile.NewStakingContract()))
+
+	delegator := testapp.GetAccount(0).Address
+	validator := sdk.ValAddress(common.Address{1}.Bytes()).String()
+	_, err := p.Run(ctx, precompile.StakingAddress, common.BytesToAddress(delegator), nil,
+		pack(t, precompileABI(t, precompile.StakingAddress), "delegate", validator, big.NewInt(5)), false)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{
+		stakingtypes.NewMsgDelegate(delegator.String(), validator, sdk.NewCoin("stake", math.NewInt(5))),
+	}, router.msgs)
+}
@@ -0,0 +1,244 @@
+package pruning
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	bindings "github.com/polymerdao/monomer/bindings/generated"
+)
+
+// Boundary derives from L1 the lowest L2 height fault proofs may still need.
+type Boundary interface {
+	// ProtectedHeight returns the lowest L2 height whose app state and block data must be kept.
+	ProtectedHeight(ctx context.Context) (uint64, error)
+}
+
+// L1 is the part of an L1 client the boundaries use to read the current L1 time.
+type L1 interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// callOpts pins the calls to the L1 head so the contracts' state is read consistently.
+// It returns the L1 head's timestamp, which the challenge windows are measured against.
+func callOpts(ctx context.Context, l1 L1) (*bind.CallOpts, uint64, error) {
+	head, err := l1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get l1 head: %v", err)
+	}
+	return &bind.CallOpts{
+		Context:     ctx,
+		BlockNumber: head.Number,
+	}, head.Time, nil
+}
+
+// OutputOracle is the part of the L2OutputOracle the OutputOracleBoundary reads.
+// It is implemented by the op-bindings L2OutputOracleCaller.
+type OutputOracle interface {
+	FinalizationPeriodSeconds(opts *bind.CallOpts) (*big.Int, error)
+	StartingBlockNumber(opts *bind.CallOpts) (*big.Int, error)
+	NextOutputIndex(opts *bind.CallOpts) (*big.Int, error)
+	GetL2Output(opts *bind.CallOpts, index *big.Int) (opbindings.TypesOutputProposal, error)
+}
+
+// OutputOracleBoundary protects the blocks covered by the L2OutputOracle outputs that can still be challenged.
+// An output can be challenged until its finalization period has passed, and checking it replays the blocks since the
+// previous output, so the previous output's block is protected too.
+type OutputOracleBoundary struct {
+	l1     L1
+	oracle OutputOracle
+}
+
+var _ Boundary = (*OutputOracleBoundary)(nil)
+
+func NewOutputOracleBoundary(l1 L1, oracle OutputOracle) *OutputOracleBoundary {
+	return &OutputOracleBoundary{
+		l1:     l1,
+		oracle: oracle,
+	}
+}
+
+func (b *OutputOracleBoundary) ProtectedHeight(ctx context.Context) (uint64, error) {
+	opts, now, err := callOpts(ctx, b.l1)
+	if err != nil {
+		return 0, err
+	}
+	finalizationPeriod, err := b.oracle.FinalizationPeriodSeconds(opts)
+	if err != nil {
+		return 0, fmt.Errorf("get finalization period: %v", err)
+	}
+	nextIndex, err := b.oracle.NextOutputIndex(opts)
+	if err != nil {
+		return 0, fmt.Errorf("get next output index: %v", err)
+	}
+
+	// Outputs are proposed in order, so the outputs that can still be challenged are the newest ones.
+	var searchErr error
+	numOutputs := int(nextIndex.Int64())
+	oldestChallengeable := sort.Search(numOutputs, func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		output, err := b.oracle.GetL2Output(opts, big.NewInt(int64(i)))
+		if err != nil {
+			searchErr = fmt.Errorf("get output %d: %v", i, err)
+			return true
+		}
+		return output.Timestamp.Uint64()+finalizationPeriod.Uint64() > now
+	})
+	if searchErr != nil {
+		return 0, searchErr
+	}
+
+	if oldestChallengeable == 0 {
+		startingBlockNumber, err := b.oracle.StartingBlockNumber(opts)
+		if err != nil {
+			return 0, fmt.Errorf("get starting block number: %v", err)
+		}
+		return startingBlockNumber.Uint64(), nil
+	}
+	previous, err := b.oracle.GetL2Output(opts, big.NewInt(int64(oldestChallengeable-1)))
+	if err != nil {
+		return 0, fmt.Errorf("get output %d: %v", oldestChallengeable-1, err)
+	}
+	return previous.L2BlockNumber.Uint64(), nil
+}
+
+// Game is the part of a dispute game's state that decides whether the blocks it covers may still be needed.
+type Game struct {
+	CreatedAt uint64
+	// ResolvedAt is zero while the game is in progress.
+	ResolvedAt uint64
+	// StartingBlockNumber is the block of the anchor state the game's claim is checked from.
+	StartingBlockNumber uint64
+	MaxClockDuration    uint64
+}
+
+// Games reads the dispute games created by a DisputeGameFactory.
+type Games interface {
+	GameCount(opts *bind.CallOpts) (uint64, error)
+	Game(opts *bind.CallOpts, index uint64) (*Game, error)
+}
+
+// Portal is the part of the OptimismPortal2 the DisputeGameBoundary reads.
+type Portal interface {
+	DisputeGameFinalityDelaySeconds(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// DisputeGameBoundary protects the blocks covered by the dispute games that may still need proofs: games in progress and
+// games in the portal's finality delay, during which the guardian can blacklist a game and a new one is played from the
+// anchor state. New games start from the anchor state, which is never below the newest game's starting block, so that
+// block is always protected.
+type DisputeGameBoundary struct {
+	l1     L1
+	portal Portal
+	games  Games
+}
+
+var _ Boundary = (*DisputeGameBoundary)(nil)
+
+func NewDisputeGameBoundary(l1 L1, portal Portal, games Games) *DisputeGameBoundary {
+	return &DisputeGameBoundary{
+		l1:     l1,
+		portal: portal,
+		games:  games,
+	}
+}
+
+func (b *DisputeGameBoundary) ProtectedHeight(ctx context.Context) (uint64, error) {
+	opts, now, err := callOpts(ctx, b.l1)
+	if err != nil {
+		return 0, err
+	}
+	finalityDelay, err := b.portal.DisputeGameFinalityDelaySeconds(opts)
+	if err != nil {
+		return 0, fmt.Errorf("get dispute game finality delay: %v", err)
+	}
+	count, err := b.games.GameCount(opts)
+	if err != nil {
+		return 0, fmt.Errorf("get game count: %v", err)
+	}
+	if count == 0 {
+		// The first game starts from the anchor state in the genesis of the fault proof system.
+		return 0, nil
+	}
+
+	var protected uint64
+	for index := count; index > 0; index-- {
+		game, err := b.games.Game(opts, index-1)
+		if err != nil {
+			return 0, fmt.Errorf("get game %d: %v", index-1, err)
+		}
+		if index == count {
+			protected = game.StartingBlockNumber
+		}
+		// A game's clocks run out at most two max clock durations after it's created. No more moves can be made after that,
+		// but the game stays protected until it's resolved and its finality delay has passed.
+		end := game.CreatedAt + 2*game.MaxClockDuration
+		if game.ResolvedAt != 0 {
+			end = game.ResolvedAt
+		}
+		if end+finalityDelay.Uint64() <= now {
+			// Games are created in order, so the older games' windows have passed too.
+			break
+		}
+		protected = min(protected, game.StartingBlockNumber)
+	}
+	return protected, nil
+}
+
+// L1Games reads the dispute games created by a DisputeGameFactory from L1.
+type L1Games struct {
+	factory *bindings.DisputeGameFactoryCaller
+	caller  bind.ContractCaller
+}
+
+var _ Games = (*L1Games)(nil)
+
+func NewL1Games(factory *bindings.DisputeGameFactoryCaller, caller bind.ContractCaller) *L1Games {
+	return &L1Games{
+		factory: factory,
+		caller:  caller,
+	}
+}
+
+func (g *L1Games) GameCount(opts *bind.CallOpts) (uint64, error) {
+	count, err := g.factory.GameCount(opts)
+	if err != nil {
+		return 0, err
+	}
+	return count.Uint64(), nil
+}
+
+func (g *L1Games) Game(opts *bind.CallOpts, index uint64) (*Game, error) {
+	gameAtIndex, err := g.factory.GameAtIndex(opts, new(big.Int).SetUint64(index))
+	if err != nil {
+		return nil, fmt.Errorf("get game at index: %v", err)
+	}
+	game, err := opbindings.NewFaultDisputeGameCaller(gameAtIndex.Proxy, g.caller)
+	if err != nil {
+		return nil, fmt.Errorf("new fault dispute game caller: %v", err)
+	}
+	resolvedAt, err := game.ResolvedAt(opts)
+	if err != nil {
+		return nil, fmt.Errorf("get resolved at: %v", err)
+	}
+	startingBlockNumber, err := game.StartingBlockNumber(opts)
+	if err != nil {
+		return nil, fmt.Errorf("get starting block number: %v", err)
+	}
+	maxClockDuration, err := game.MaxClockDuration(opts)
+	if err != nil {
+		return nil, fmt.Errorf("get max clock duration: %v", err)
+	}
+	return &Game{
+		CreatedAt:           gameAtIndex.Timestamp,
+		ResolvedAt:          resolvedAt,
+		StartingBlockNumber: startingBlockNumber.Uint64(),
+		MaxClockDuration:    maxClockDuration,
+	}, nil
+}
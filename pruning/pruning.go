@@ -0,0 +1,141 @@
+// Package pruning prunes old app state and block data without pruning what fault proofs may still need.
+// Output proposals can be challenged for a while after they are proposed, and challenging or defending one replays the
+// blocks it covers, so the blocks and app state versions still inside the challenge window are kept no matter how few
+// recent blocks the operator asks to keep.
+package pruning
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/builder"
+)
+
+// DefaultInterval is how often the boundary is read from L1 by default.
+const DefaultInterval = 10 * time.Minute
+
+// Config configures pruning.
+type Config struct {
+	// KeepRecent is the number of recent blocks to keep, like the Cosmos SDK's pruning-keep-recent.
+	KeepRecent uint64
+	// Interval is how often the boundary is read from L1. It defaults to DefaultInterval.
+	Interval time.Duration
+	// Boundary protects the blocks still inside the challenge window.
+	Boundary Boundary
+}
+
+// App prunes app state versions, e.g., integrations.WrappedApplication.
+type App interface {
+	// PruneStatesBelow deletes the app state versions below height.
+	PruneStatesBelow(ctx context.Context, height uint64) error
+}
+
+// BlockStore prunes blocks, e.g., localdb.DB.
+type BlockStore interface {
+	BlockByLabel(eth.BlockLabel) (*monomer.Block, error)
+	// PruneBelow deletes the blocks below height.
+	PruneBelow(height uint64) error
+}
+
+// Pruner prunes the app state and blocks below the lowest of the heights the operator asks to keep, the boundary, and
+// the finalized block, which reorgs never roll back past.
+//
+// Pruner is a builder.Interceptor so it prunes between blocks from the builder's goroutine, since Monomer only calls the
+// app from one goroutine. Reading the boundary from L1 is slow, so Run refreshes it in the background. Until it's read,
+// nothing is pruned, and if reading it fails, the last boundary read is used; the boundary only moves forward, so a stale
+// one prunes less.
+type Pruner struct {
+	app        App
+	blocks     BlockStore
+	boundary   Boundary
+	keepRecent uint64
+	interval   time.Duration
+
+	mu          sync.Mutex
+	protected   uint64
+	prunedBelow uint64
+}
+
+var _ builder.Interceptor = (*Pruner)(nil)
+
+func NewPruner(app App, blocks BlockStore, cfg *Config) *Pruner {
+	interval := cfg.Interval
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+	return &Pruner{
+		app:        app,
+		blocks:     blocks,
+		boundary:   cfg.Boundary,
+		keepRecent: cfg.KeepRecent,
+		interval:   interval,
+	}
+}
+
+// Run refreshes the boundary every interval until ctx is done. Errors are passed to onErr and don't stop it.
+func (p *Pruner) Run(ctx context.Context, onErr func(error)) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		if err := p.Refresh(ctx); err != nil && ctx.Err() == nil {
+			onErr(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh reads the boundary from L1.
+func (p *Pruner) Refresh(ctx context.Context) error {
+	protected, err := p.boundary.ProtectedHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("get protected height: %v", err)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.protected = max(p.protected, protected)
+	return nil
+}
+
+// Protected returns the last protected height read from L1.
+func (p *Pruner) Protected() uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.protected
+}
+
+// Intercept never adds txs.
+func (p *Pruner) Intercept(context.Context, uint64) (bfttypes.Txs, error) {
+	return nil, nil
+}
+
+// OnBlock prunes the app state and blocks the new block made prunable.
+func (p *Pruner) OnBlock(ctx context.Context, block *monomer.Block) error {
+	if block.Header.Height <= p.keepRecent {
+		return nil
+	}
+	finalized, err := p.blocks.BlockByLabel(eth.Finalized)
+	if err != nil {
+		return fmt.Errorf("get finalized block: %v", err)
+	}
+	target := min(block.Header.Height-p.keepRecent, p.Protected(), finalized.Header.Height)
+	if target <= p.prunedBelow {
+		return nil
+	}
+	if err := p.app.PruneStatesBelow(ctx, target); err != nil {
+		return fmt.Errorf("prune app states below %d: %v", target, err)
+	}
+	if err := p.blocks.PruneBelow(target); err != nil {
+		return fmt.Errorf("prune blocks below %d: %v", target, err)
+	}
+	p.prunedBelow = target
+	return nil
+}
@@ -0,0 +1,227 @@
+package pruning_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/pruning"
+	"github.com/stretchr/testify/require"
+)
+
+type l1 struct {
+	time uint64
+}
+
+func (l *l1) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{
+		Number: big.NewInt(100),
+		Time:   l.time,
+	}, nil
+}
+
+type outputOracle struct {
+	finalizationPeriod uint64
+	outputs            []opbindings.TypesOutputProposal
+}
+
+func (o *outputOracle) FinalizationPeriodSeconds(*bind.CallOpts) (*big.Int, error) {
+	return new(big.Int).SetUint64(o.finalizationPeriod), nil
+}
+
+func (o *outputOracle) StartingBlockNumber(*bind.CallOpts) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (o *outputOracle) NextOutputIndex(*bind.CallOpts) (*big.Int, error) {
+	return big.NewInt(int64(len(o.outputs))), nil
+}
+
+func (o *outputOracle) GetL2Output(_ *bind.CallOpts, index *big.Int) (opbindings.TypesOutputProposal, error) {
+	return o.outputs[index.Int64()], nil
+}
+
+func output(timestamp, l2BlockNumber int64) opbindings.TypesOutputProposal {
+	return opbindings.TypesOutputProposal{
+		Timestamp:     big.NewInt(timestamp),
+		L2BlockNumber: big.NewInt(l2BlockNumber),
+	}
+}
+
+func TestOutputOracleBoundary(t *testing.T) {
+	oracle := &outputOracle{
+		finalizationPeriod: 100,
+		outputs: []opbindings.TypesOutputProposal{
+			output(1000, 10),
+			output(1050, 20),
+			output(1100, 30),
+		},
+	}
+	tests := map[string]struct {
+		now  uint64
+		want uint64
+	}{
+		"all outputs can be challenged": {
+			now:  1050,
+			want: 1,
+		},
+		"first output finalized": {
+			now:  1120,
+			want: 10,
+		},
+		"all outputs finalized": {
+			now:  1300,
+			want: 30,
+		},
+	}
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			protected, err := pruning.NewOutputOracleBoundary(&l1{time: test.now}, oracle).ProtectedHeight(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, test.want, protected)
+		})
+	}
+
+	t.Run("no outputs", func(t *testing.T) {
+		protected, err := pruning.NewOutputOracleBoundary(&l1{time: 1000}, &outputOracle{}).ProtectedHeight(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), protected)
+	})
+}
+
+type portal struct {
+	finalityDelay uint64
+}
+
+func (p *portal) DisputeGameFinalityDelaySeconds(*bind.CallOpts) (*big.Int, error) {
+	return new(big.Int).SetUint64(p.finalityDelay), nil
+}
+
+type games []*pruning.Game
+
+func (g games) GameCount(*bind.CallOpts) (uint64, error) {
+	return uint64(len(g)), nil
+}
+
+func (g games) Game(_ *bind.CallOpts, index uint64) (*pruning.Game, error) {
+	return g[index], nil
+}
+
+func TestDisputeGameBoundary(t *testing.T) {
+	const maxClockDuration = 100
+	allGames := games{
+		// Resolved and finalized.
+		{CreatedAt: 1000, ResolvedAt: 1200, StartingBlockNumber: 5, MaxClockDuration: maxClockDuration},
+		// Resolved and in the finality delay.
+		{CreatedAt: 1100, ResolvedAt: 1300, StartingBlockNumber: 10, MaxClockDuration: maxClockDuration},
+		// In progress.
+		{CreatedAt: 1200, StartingBlockNumber: 20, MaxClockDuration: maxClockDuration},
+		{CreatedAt: 1300, StartingBlockNumber: 30, MaxClockDuration: maxClockDuration},
+	}
+	tests := map[string]struct {
+		games games
+		now   uint64
+		want  uint64
+	}{
+		"no games": {
+			now:  1000,
+			want: 0,
+		},
+		"games in progress and in the finality delay": {
+			games: allGames,
+			now:   1350,
+			want:  10,
+		},
+		"only the newest game's starting block is protected": {
+			games: allGames,
+			now:   10_000,
+			want:  30,
+		},
+	}
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			boundary := pruning.NewDisputeGameBoundary(&l1{time: test.now}, &portal{finalityDelay: 100}, test.games)
+			protected, err := boundary.ProtectedHeight(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, test.want, protected)
+		})
+	}
+}
+
+type boundary struct {
+	protected uint64
+	err       error
+}
+
+func (b *boundary) ProtectedHeight(context.Context) (uint64, error) {
+	return b.protected, b.err
+}
+
+type app struct {
+	prunedBelow uint64
+}
+
+func (a *app) PruneStatesBelow(_ context.Context, height uint64) error {
+	a.prunedBelow = height
+	return nil
+}
+
+type blockStore struct {
+	finalized   uint64
+	prunedBelow uint64
+}
+
+func (b *blockStore) BlockByLabel(eth.BlockLabel) (*monomer.Block, error) {
+	return &monomer.Block{Header: &monomer.Header{Height: b.finalized}}, nil
+}
+
+func (b *blockStore) PruneBelow(height uint64) error {
+	b.prunedBelow = height
+	return nil
+}
+
+func TestPruner(t *testing.T) {
+	ctx := context.Background()
+	newBlock := func(height uint64) *monomer.Block {
+		return &monomer.Block{Header: &monomer.Header{Height: height}}
+	}
+
+	b := &boundary{protected: 50}
+	a := &app{}
+	blocks := &blockStore{finalized: 90}
+	pruner := pruning.NewPruner(a, blocks, &pruning.Config{
+		KeepRecent: 10,
+		Boundary:   b,
+	})
+
+	// Nothing is pruned before the boundary is read.
+	require.NoError(t, pruner.OnBlock(ctx, newBlock(100)))
+	require.Zero(t, a.prunedBelow)
+	require.Zero(t, blocks.prunedBelow)
+
+	// The boundary limits pruning.
+	require.NoError(t, pruner.Refresh(ctx))
+	require.NoError(t, pruner.OnBlock(ctx, newBlock(101)))
+	require.Equal(t, uint64(50), a.prunedBelow)
+	require.Equal(t, uint64(50), blocks.prunedBelow)
+
+	// A failed read keeps the last boundary.
+	b.err = errors.New("l1 unavailable")
+	require.Error(t, pruner.Refresh(ctx))
+	require.Equal(t, uint64(50), pruner.Protected())
+
+	// KeepRecent and the finalized block limit pruning.
+	b.protected, b.err = 1000, nil
+	require.NoError(t, pruner.Refresh(ctx))
+	require.NoError(t, pruner.OnBlock(ctx, newBlock(80)))
+	require.Equal(t, uint64(70), a.prunedBelow)
+	require.NoError(t, pruner.OnBlock(ctx, newBlock(110)))
+	require.Equal(t, uint64(90), a.prunedBelow)
+	require.Equal(t, uint64(90), blocks.prunedBelow)
+}
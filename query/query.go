@@ -0,0 +1,113 @@
+// Package query serves ABCI queries to Cosmos SDK apps so they stop when the client gives up.
+package query
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// App is the part of a Cosmos SDK app Serve uses. It is implemented by apps that embed *baseapp.BaseApp.
+type App interface {
+	Query(context.Context, *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error)
+}
+
+// grpcQueryApp is implemented by apps that embed *baseapp.BaseApp.
+type grpcQueryApp interface {
+	GRPCQueryRouter() *baseapp.GRPCQueryRouter
+	CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+	LastBlockHeight() int64
+}
+
+// Serve serves req like app.Query, but stops gRPC queries when ctx is done, e.g., when the client disconnects or the
+// request's deadline passes. The Cosmos SDK ignores the context of queries, so an abandoned query would otherwise run to
+// completion.
+//
+// Queries read state through a gas meter, so the query stops at its next read after ctx is done. Other queries, and
+// simulations, which run in a context of their own, are served by app.Query and only stop when they complete.
+func Serve(ctx context.Context, app App, req *abcitypes.RequestQuery) (resp *abcitypes.ResponseQuery, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	grpcApp, ok := app.(grpcQueryApp)
+	if !ok {
+		return app.Query(ctx, req)
+	}
+	handler := grpcApp.GRPCQueryRouter().Route(req.Path)
+	if handler == nil || ctx.Done() == nil {
+		return app.Query(ctx, req)
+	}
+
+	// The rest of this function follows BaseApp.Query and BaseApp.handleQueryGRPC.
+	defer func() {
+		if r := recover(); r != nil {
+			if aborted, ok := r.(queryAborted); ok {
+				resp, err = nil, aborted.err
+				return
+			}
+			resp = sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r), false)
+		}
+	}()
+	if req.Height == 0 {
+		req.Height = grpcApp.LastBlockHeight()
+	}
+	sdkCtx, err := grpcApp.CreateQueryContext(req.Height, req.Prove)
+	if err != nil {
+		return sdkerrors.QueryResult(err, false), nil
+	}
+	sdkCtx = sdkCtx.WithContext(ctx).WithGasMeter(&contextGasMeter{
+		GasMeter: sdkCtx.GasMeter(),
+		ctx:      ctx,
+	})
+	resp, err = handler(sdkCtx, req)
+	if err != nil {
+		resp = sdkerrors.QueryResult(grpcErrorToSDKError(err), false)
+		resp.Height = req.Height
+	}
+	return resp, nil
+}
+
+type queryAborted struct {
+	err error
+}
+
+// contextGasMeter aborts the query when ctx is done.
+type contextGasMeter struct {
+	storetypes.GasMeter
+	ctx context.Context
+}
+
+func (g *contextGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	if err := g.ctx.Err(); err != nil {
+		panic(queryAborted{
+			err: fmt.Errorf("query aborted: %w", err),
+		})
+	}
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// grpcErrorToSDKError maps the error of a gRPC query handler to the SDK error BaseApp.Query responds with.
+func grpcErrorToSDKError(err error) error {
+	status, ok := grpcstatus.FromError(err)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	switch status.Code() { //nolint:exhaustive
+	case codes.NotFound:
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, err.Error())
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	case codes.Unauthenticated:
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	default:
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+}
@@ -0,0 +1,97 @@
+package query_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testapp/x/testmodule/types"
+	"github.com/stretchr/testify/require"
+)
+
+// canceledWhileRunning is canceled after its error is first checked, i.e., once the query started.
+type canceledWhileRunning struct {
+	context.Context
+	checked bool
+}
+
+func (c *canceledWhileRunning) Err() error {
+	if c.checked {
+		return context.Canceled
+	}
+	c.checked = true
+	return nil
+}
+
+func TestServe(t *testing.T) {
+	chainID := monomer.ChainID(0).String()
+	app := testapp.NewTest(t, chainID)
+	appStateBytes, err := json.Marshal(testapp.MakeGenesisAppState(t, app, "k", "v"))
+	require.NoError(t, err)
+	_, err = app.InitChain(context.Background(), &abcitypes.RequestInitChain{
+		ChainId:       chainID,
+		AppStateBytes: appStateBytes,
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(context.Background(), &abcitypes.RequestFinalizeBlock{
+		Height: 1,
+	})
+	require.NoError(t, err)
+	_, err = app.Commit(context.Background(), &abcitypes.RequestCommit{})
+	require.NoError(t, err)
+
+	data, err := (&types.QueryValueRequest{
+		Key: "k",
+	}).Marshal()
+	require.NoError(t, err)
+	req := func() *abcitypes.RequestQuery {
+		return &abcitypes.RequestQuery{
+			Path: testapp.QueryPath,
+			Data: data,
+		}
+	}
+
+	t.Run("serves queries", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		resp, err := app.Query(ctx, req())
+		require.NoError(t, err)
+		require.Zero(t, resp.Code, resp.Log)
+		require.Equal(t, int64(1), resp.Height)
+		var val types.QueryValueResponse
+		require.NoError(t, val.Unmarshal(resp.Value))
+		require.Equal(t, "v", val.Value)
+	})
+
+	t.Run("done before the query starts", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := app.Query(ctx, req())
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("done while the query runs", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, err := app.Query(&canceledWhileRunning{Context: ctx}, req())
+		require.ErrorIs(t, err, context.Canceled)
+		require.ErrorContains(t, err, "query aborted")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		resp, err := app.Query(ctx, &abcitypes.RequestQuery{
+			Path:   testapp.QueryPath,
+			Data:   data,
+			Height: 100,
+		})
+		require.NoError(t, err)
+		require.NotZero(t, resp.Code)
+		require.Contains(t, resp.Log, "cannot query with height in the future")
+	})
+}
@@ -0,0 +1,144 @@
+// Package querycall routes eth_call to reserved pseudo-addresses to the app's gRPC queries, so Solidity-centric tooling
+// can read module state with the ABI it already speaks instead of a Cosmos client.
+//
+// The reserved addresses start with the 12 ASCII bytes "monomerquery" and end with an 8-byte route number, e.g.,
+// 0x6d6f6e6f6d657271756572790000000000000001 for the bank module. No key or contract can have such an address in
+// practice, so a route never shadows a real account. Each address serves the methods of an ABI: the call data is
+// ABI-encoded like a call to a contract, the method's handler turns the arguments into a gRPC query, and its response is
+// ABI-encoded as the method's return values.
+package querycall
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// addressPrefix starts every reserved address.
+var addressPrefix = []byte("monomerquery")
+
+// Address returns the reserved address of route number n.
+func Address(n uint64) common.Address {
+	var addr common.Address
+	copy(addr[:], addressPrefix)
+	binary.BigEndian.PutUint64(addr[len(addressPrefix):], n)
+	return addr
+}
+
+// IsReserved reports whether addr is a reserved address.
+func IsReserved(addr common.Address) bool {
+	return bytes.HasPrefix(addr.Bytes(), addressPrefix)
+}
+
+// Querier runs a gRPC query, e.g., against the app's state at the block an eth_call names.
+type Querier interface {
+	// Query runs the query at path, e.g., /cosmos.bank.v1beta1.Query/Balance, with the protobuf-encoded request and
+	// returns the protobuf-encoded response.
+	Query(ctx context.Context, path string, data []byte) ([]byte, error)
+}
+
+// QueryProto runs the query at path with req and decodes the response into resp.
+func QueryProto(ctx context.Context, q Querier, path string, req, resp proto.Message) error {
+	reqBytes, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %v", err)
+	}
+	respBytes, err := q.Query(ctx, path, reqBytes)
+	if err != nil {
+		return err
+	}
+	if err := proto.Unmarshal(respBytes, resp); err != nil {
+		return fmt.Errorf("unmarshal response: %v", err)
+	}
+	return nil
+}
+
+// Handler serves a call of a method with its unpacked arguments and returns the values the method returns.
+type Handler func(ctx context.Context, q Querier, args []any) ([]any, error)
+
+type route struct {
+	method  gethabi.Method
+	handler Handler
+}
+
+// Router maps the methods of the reserved addresses to their handlers.
+type Router struct {
+	routes map[common.Address]map[[4]byte]*route
+}
+
+func NewRouter() *Router {
+	return &Router{
+		routes: make(map[common.Address]map[[4]byte]*route),
+	}
+}
+
+// Register serves the methods of abiJSON at addr, which must be a reserved address, with their handlers. Every method
+// must have a handler.
+func (r *Router) Register(addr common.Address, abiJSON string, handlers map[string]Handler) error {
+	if !IsReserved(addr) {
+		return fmt.Errorf("%s is not a reserved address", addr)
+	}
+	if _, ok := r.routes[addr]; ok {
+		return fmt.Errorf("%s is already registered", addr)
+	}
+	abi, err := gethabi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return fmt.Errorf("parse abi: %v", err)
+	}
+	if len(abi.Methods) != len(handlers) {
+		return fmt.Errorf("abi has %d methods, but there are %d handlers", len(abi.Methods), len(handlers))
+	}
+	routes := make(map[[4]byte]*route, len(abi.Methods))
+	for name, method := range abi.Methods {
+		handler, ok := handlers[name]
+		if !ok {
+			return fmt.Errorf("method %s has no handler", name)
+		}
+		routes[[4]byte(method.ID)] = &route{
+			method:  method,
+			handler: handler,
+		}
+	}
+	r.routes[addr] = routes
+	return nil
+}
+
+// Routes reports whether calls to addr are routed.
+func (r *Router) Routes(addr common.Address) bool {
+	_, ok := r.routes[addr]
+	return ok
+}
+
+// Call serves a call of the method in data at to with q and returns the ABI-encoded return values.
+func (r *Router) Call(ctx context.Context, q Querier, to common.Address, data []byte) ([]byte, error) {
+	routes, ok := r.routes[to]
+	if !ok {
+		return nil, fmt.Errorf("no queries are routed to %s", to)
+	}
+	if len(data) < 4 { //nolint:mnd
+		return nil, fmt.Errorf("call data is %d bytes long, but a method selector is 4 bytes", len(data))
+	}
+	route, ok := routes[[4]byte(data[:4])]
+	if !ok {
+		return nil, fmt.Errorf("no method with selector %x is routed to %s", data[:4], to)
+	}
+	args, err := route.method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("unpack arguments of %s: %v", route.method.Name, err)
+	}
+	values, err := route.handler(ctx, q, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", route.method.Name, err)
+	}
+	out, err := route.method.Outputs.Pack(values...)
+	if err != nil {
+		return nil, fmt.Errorf("pack return values of %s: %v", route.method.Name, err)
+	}
+	return out, nil
+}
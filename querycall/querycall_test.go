@@ -0,0 +1,121 @@
+package querycall_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/addrconv"
+	"github.com/polymerdao/monomer/querycall"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	prefix = "cosmos"
+
+	echoABI = `[{
+		"type": "function",
+		"name": "echo",
+		"stateMutability": "view",
+		"inputs": [{"name": "value", "type": "uint256"}],
+		"outputs": [{"name": "", "type": "uint256"}]
+	}]`
+)
+
+// querier records the last query and responds with resp.
+type querier struct {
+	path string
+	data []byte
+	resp []byte
+}
+
+func (q *querier) Query(_ context.Context, path string, data []byte) ([]byte, error) {
+	q.path, q.data = path, data
+	if q.resp == nil {
+		return nil, errors.New("query failed")
+	}
+	return q.resp, nil
+}
+
+func TestAddress(t *testing.T) {
+	require.Equal(t, common.HexToAddress("0x6d6f6e6f6d657271756572790000000000000001"), querycall.BankAddress)
+	require.True(t, querycall.IsReserved(querycall.Address(42)))
+	require.False(t, querycall.IsReserved(common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")))
+}
+
+func TestRegister(t *testing.T) {
+	echo := func(_ context.Context, _ querycall.Querier, args []any) ([]any, error) {
+		return args, nil
+	}
+	r := querycall.NewRouter()
+	require.ErrorContains(t, r.Register(common.Address{1}, echoABI, map[string]querycall.Handler{"echo": echo}), "not a reserved address")
+	require.ErrorContains(t, r.Register(querycall.Address(42), echoABI, map[string]querycall.Handler{"other": echo}), "no handler")
+	require.ErrorContains(t, r.Register(querycall.Address(42), echoABI, nil), "handlers")
+	require.False(t, r.Routes(querycall.Address(42)))
+
+	require.NoError(t, r.Register(querycall.Address(42), echoABI, map[string]querycall.Handler{"echo": echo}))
+	require.True(t, r.Routes(querycall.Address(42)))
+	require.ErrorContains(t, r.Register(querycall.Address(42), echoABI, map[string]querycall.Handler{"echo": echo}), "already registered")
+
+	abi, err := gethabi.JSON(strings.NewReader(echoABI))
+	require.NoError(t, err)
+	data, err := abi.Pack("echo", big.NewInt(7))
+	require.NoError(t, err)
+	out, err := r.Call(context.Background(), new(querier), querycall.Address(42), data)
+	require.NoError(t, err)
+	values, err := abi.Unpack("echo", out)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), values[0])
+
+	_, err = r.Call(context.Background(), new(querier), querycall.Address(42), data[:3])
+	require.ErrorContains(t, err, "method selector")
+	_, err = r.Call(context.Background(), new(querier), querycall.Address(42), []byte{1, 2, 3, 4})
+	require.ErrorContains(t, err, "no method with selector")
+	_, err = r.Call(context.Background(), new(querier), querycall.Address(43), data)
+	require.ErrorContains(t, err, "no queries are routed")
+}
+
+func TestBankBalanceOf(t *testing.T) {
+	r, err := querycall.NewDefaultRouter(prefix)
+	require.NoError(t, err)
+	abi, err := gethabi.JSON(strings.NewReader(`[{
+		"type": "function",
+		"name": "balanceOf",
+		"inputs": [{"name": "account", "type": "address"}, {"name": "denom", "type": "string"}],
+		"outputs": [{"name": "", "type": "uint256"}]
+	}]`))
+	require.NoError(t, err)
+
+	account := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	resp, err := (&banktypes.QueryBalanceResponse{
+		Balance: &sdk.Coin{Denom: "stake", Amount: math.NewInt(100)},
+	}).Marshal()
+	require.NoError(t, err)
+	q := &querier{resp: resp}
+	data, err := abi.Pack("balanceOf", account, "stake")
+	require.NoError(t, err)
+	out, err := r.Call(context.Background(), q, querycall.BankAddress, data)
+	require.NoError(t, err)
+	values, err := abi.Unpack("balanceOf", out)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), values[0])
+
+	// The account is queried with the chain's prefix.
+	require.Equal(t, "/cosmos.bank.v1beta1.Query/Balance", q.path)
+	req := new(banktypes.QueryBalanceRequest)
+	require.NoError(t, req.Unmarshal(q.data))
+	bech32, err := addrconv.Bech32(account, prefix)
+	require.NoError(t, err)
+	require.Equal(t, &banktypes.QueryBalanceRequest{Address: bech32, Denom: "stake"}, req)
+
+	// Query errors fail the call.
+	_, err = r.Call(context.Background(), new(querier), querycall.BankAddress, data)
+	require.ErrorContains(t, err, "query failed")
+}
@@ -0,0 +1,109 @@
+package querycall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymerdao/monomer/addrconv"
+)
+
+var (
+	// RawAddress serves any gRPC query with a protobuf-encoded request, for queries without a route of their own:
+	//
+	//	function query(string path, bytes request) view returns (bytes response)
+	RawAddress = Address(0)
+	// BankAddress serves the bank module's balances:
+	//
+	//	function balanceOf(address account, string denom) view returns (uint256)
+	//	function totalSupply(string denom) view returns (uint256)
+	//
+	// account is the 0x address of a Cosmos account, see the addrconv package.
+	BankAddress = Address(1)
+)
+
+const rawABI = `[{
+	"type": "function",
+	"name": "query",
+	"stateMutability": "view",
+	"inputs": [{"name": "path", "type": "string"}, {"name": "request", "type": "bytes"}],
+	"outputs": [{"name": "response", "type": "bytes"}]
+}]`
+
+const bankABI = `[{
+	"type": "function",
+	"name": "balanceOf",
+	"stateMutability": "view",
+	"inputs": [{"name": "account", "type": "address"}, {"name": "denom", "type": "string"}],
+	"outputs": [{"name": "", "type": "uint256"}]
+}, {
+	"type": "function",
+	"name": "totalSupply",
+	"stateMutability": "view",
+	"inputs": [{"name": "denom", "type": "string"}],
+	"outputs": [{"name": "", "type": "uint256"}]
+}]`
+
+// NewDefaultRouter returns a router serving RawAddress and BankAddress, for an app whose bech32 account prefix is
+// prefix. Apps register routes for their own modules' queries on it.
+func NewDefaultRouter(prefix string) (*Router, error) {
+	r := NewRouter()
+	if err := r.Register(RawAddress, rawABI, map[string]Handler{
+		"query": rawQuery,
+	}); err != nil {
+		return nil, fmt.Errorf("register raw queries: %v", err)
+	}
+	if err := r.Register(BankAddress, bankABI, map[string]Handler{
+		"balanceOf":   balanceOf(prefix),
+		"totalSupply": totalSupply,
+	}); err != nil {
+		return nil, fmt.Errorf("register bank queries: %v", err)
+	}
+	return r, nil
+}
+
+func rawQuery(ctx context.Context, q Querier, args []any) ([]any, error) {
+	path := args[0].(string)
+	// Other ABCI query paths, like app/simulate and store queries, aren't gRPC queries.
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("path %q is not a gRPC query", path)
+	}
+	resp, err := q.Query(ctx, path, args[1].([]byte))
+	if err != nil {
+		return nil, err
+	}
+	return []any{resp}, nil
+}
+
+func balanceOf(prefix string) Handler {
+	return func(ctx context.Context, q Querier, args []any) ([]any, error) {
+		account, err := addrconv.Bech32(args[0].(common.Address), prefix)
+		if err != nil {
+			return nil, fmt.Errorf("encode bech32 account: %v", err)
+		}
+		resp := new(banktypes.QueryBalanceResponse)
+		if err := QueryProto(ctx, q, "/cosmos.bank.v1beta1.Query/Balance", &banktypes.QueryBalanceRequest{
+			Address: account,
+			Denom:   args[1].(string),
+		}, resp); err != nil {
+			return nil, err
+		}
+		if resp.Balance == nil {
+			return nil, errors.New("response has no balance")
+		}
+		return []any{resp.Balance.Amount.BigInt()}, nil
+	}
+}
+
+func totalSupply(ctx context.Context, q Querier, args []any) ([]any, error) {
+	resp := new(banktypes.QuerySupplyOfResponse)
+	if err := QueryProto(ctx, q, "/cosmos.bank.v1beta1.Query/SupplyOf", &banktypes.QuerySupplyOfRequest{
+		Denom: args[0].(string),
+	}, resp); err != nil {
+		return nil, err
+	}
+	return []any{resp.Amount.Amount.BigInt()}, nil
+}
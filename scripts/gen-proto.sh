@@ -7,6 +7,11 @@ MONOMER_DIR=$(cd "$SCRIPTS_DIR/.." && pwd)
 GEN_DIR=$(cd "$MONOMER_DIR/gen" && pwd)
 ROLLUP_DIR=$(cd "$MONOMER_DIR/x/rollup" && pwd)
 TESTMODULE_DIR=$(cd "$MONOMER_DIR/testapp/x/testmodule" && pwd)
+WORKLOAD_DIR=$(cd "$MONOMER_DIR/testapp/x/workload" && pwd)
+FIREHOSE_DIR=$(cd "$MONOMER_DIR/firehose" && pwd)
+FEETOKEN_DIR=$(cd "$MONOMER_DIR/x/feetoken" && pwd)
+TOKENFACTORY_DIR=$(cd "$MONOMER_DIR/x/tokenfactory" && pwd)
+ORACLE_DIR=$(cd "$MONOMER_DIR/x/oracle" && pwd)
 
 # generate cosmos proto code
 buf generate
@@ -15,6 +20,26 @@ buf generate
 cp -r $GEN_DIR/rollup/v1/* $ROLLUP_DIR/types
 rm -rf $GEN_DIR/rollup/v1
 
+# move the generated feetoken module message types to the x/feetoken module
+cp -r $GEN_DIR/feetoken/v1/* $FEETOKEN_DIR/types
+rm -rf $GEN_DIR/feetoken/v1
+
+# move the generated tokenfactory module message types to the x/tokenfactory module
+cp -r $GEN_DIR/tokenfactory/v1/* $TOKENFACTORY_DIR/types
+rm -rf $GEN_DIR/tokenfactory/v1
+
+# move the generated oracle module message types to the x/oracle module
+cp -r $GEN_DIR/oracle/v1/* $ORACLE_DIR/types
+rm -rf $GEN_DIR/oracle/v1
+
 # move the generated testapp module message types to the testapp/x/testmodule module
 cp -r $GEN_DIR/testapp/v1/* $TESTMODULE_DIR/types
 rm -rf $GEN_DIR/testapp/v1
+
+# move the generated workload module message types to the testapp/x/workload module
+cp -r $GEN_DIR/workload/v1/* $WORKLOAD_DIR/types
+rm -rf $GEN_DIR/workload/v1
+
+# move the generated firehose block types to the firehose package
+cp -r $GEN_DIR/firehose/v1/* $FIREHOSE_DIR/types
+rm -rf $GEN_DIR/firehose/v1
@@ -9,6 +9,7 @@ CONTRACTS_DIR=$(cd "$CONTRACTS_ROOT_DIR/src" && pwd)
 BINDINGS_DIR=$(cd "$MONOMER_DIR/bindings" && pwd)
 OPTIMISM_CONTRACTS="$MONOMER_DIR/contracts/optimism-contracts.json"
 OPTIMISM_CONTRACTS_DIR="$MONOMER_DIR/e2e/optimism/packages/contracts-bedrock/src"
+OPTIMISM_ABIS_DIR="$MONOMER_DIR/e2e/optimism/packages/contracts-bedrock/snapshots/abi"
 
 # Create a directory to store temporary copies of the optimism contracts
 mkdir -p "$CONTRACTS_ROOT_DIR/optimism/src"
@@ -89,3 +90,16 @@ find $FORGE_ARTIFACTS_DIR -name "*.json" | while read -r forge_artifact; do
 
     echo "Generated Go bindings for ${contract_name}.sol: ${go_binding_file}"
 done
+
+# Generate Go bindings for the optimism contracts that are only needed on L1 from their ABI snapshots.
+# These contracts pull in too many dependencies to be compiled alongside the monomer contracts.
+jq -c '.abis | to_entries[]' $OPTIMISM_CONTRACTS | while read -r contract; do
+    contract_name=$(echo $contract | jq -r '.key')
+    abi_file="${BINDINGS_DIR}/artifacts/${contract_name}.abi"
+    jq -c "." "$OPTIMISM_ABIS_DIR/$(echo $contract | jq -r '.value')" > $abi_file
+
+    go_binding_file="${BINDINGS_DIR}/generated/${contract_name}.go"
+    abigen --abi=$abi_file --pkg="bindings" --type=$contract_name --out=$go_binding_file
+
+    echo "Generated Go bindings for ${contract_name}: ${go_binding_file}"
+done
@@ -0,0 +1,76 @@
+// Package sessionkey builds the messages that let a short-lived session key act on behalf of a user's main account.
+//
+// The main account grants the session key x/authz authorizations for a set of msg types and an x/feegrant allowance to
+// pay for them. The session key then signs txs that wrap the user's msgs in an authz MsgExec and name the main account
+// as their fee granter, so the user's funds never leave the main account. The chain must include x/authz and x/feegrant.
+package sessionkey
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// Grant describes what a session key may do on behalf of a user.
+type Grant struct {
+	// User is the main account that holds the funds and signs the grant.
+	User sdk.AccAddress
+	// SessionKey is the address of the session key.
+	SessionKey sdk.AccAddress
+	// MsgTypeURLs are the type URLs of the msgs the session key may execute, e.g., "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeURLs []string
+	// SpendLimit is the most the session key may spend on fees. It is unlimited if empty.
+	SpendLimit sdk.Coins
+	// Expiration is when the session key stops being valid.
+	Expiration time.Time
+}
+
+// Msgs returns the msgs the user signs to register the session key.
+func (g *Grant) Msgs() ([]sdk.Msg, error) {
+	if len(g.MsgTypeURLs) == 0 {
+		return nil, errors.New("no msg type URLs")
+	}
+	msgs := make([]sdk.Msg, 0, len(g.MsgTypeURLs)+1)
+	for _, msgTypeURL := range g.MsgTypeURLs {
+		msg, err := authz.NewMsgGrant(g.User, g.SessionKey, authz.NewGenericAuthorization(msgTypeURL), &g.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	// The session key can only spend the user's funds on fees for the msgs it was authorized to execute.
+	allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{
+		SpendLimit: g.SpendLimit,
+		Expiration: &g.Expiration,
+	}, []string{sdk.MsgTypeURL(&authz.MsgExec{})})
+	if err != nil {
+		return nil, err
+	}
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, g.User, g.SessionKey)
+	if err != nil {
+		return nil, err
+	}
+	return append(msgs, msg), nil
+}
+
+// RevokeMsgs returns the msgs the user signs to revoke the session key before it expires.
+func (g *Grant) RevokeMsgs() []sdk.Msg {
+	msgs := make([]sdk.Msg, 0, len(g.MsgTypeURLs)+1)
+	for _, msgTypeURL := range g.MsgTypeURLs {
+		msg := authz.NewMsgRevoke(g.User, g.SessionKey, msgTypeURL)
+		msgs = append(msgs, &msg)
+	}
+	msg := feegrant.NewMsgRevokeAllowance(g.User, g.SessionKey)
+	return append(msgs, &msg)
+}
+
+// Exec wraps msgs, which must be signed by the user, in a msg the session key signs instead.
+// The tx containing it must set the user as its fee granter.
+func Exec(sessionKey sdk.AccAddress, msgs ...sdk.Msg) *authz.MsgExec {
+	msg := authz.NewMsgExec(sessionKey, msgs)
+	return &msg
+}
@@ -0,0 +1,72 @@
+package sessionkey_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/polymerdao/monomer/sessionkey"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrant(t *testing.T) {
+	user := sdk.AccAddress("user")
+	sessionKey := sdk.AccAddress("session key")
+	expiration := time.Unix(100, 0).UTC()
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	grant := &sessionkey.Grant{
+		User:        user,
+		SessionKey:  sessionKey,
+		MsgTypeURLs: []string{msgTypeURL},
+		SpendLimit:  sdk.NewCoins(sdk.NewInt64Coin("ETH", 100)),
+		Expiration:  expiration,
+	}
+
+	msgs, err := grant.Msgs()
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	msgGrant, ok := msgs[0].(*authz.MsgGrant)
+	require.True(t, ok)
+	require.Equal(t, user.String(), msgGrant.Granter)
+	require.Equal(t, sessionKey.String(), msgGrant.Grantee)
+	require.Equal(t, &expiration, msgGrant.Grant.Expiration)
+	authorization, err := msgGrant.GetAuthorization()
+	require.NoError(t, err)
+	require.Equal(t, msgTypeURL, authorization.MsgTypeURL())
+
+	msgGrantAllowance, ok := msgs[1].(*feegrant.MsgGrantAllowance)
+	require.True(t, ok)
+	require.Equal(t, user.String(), msgGrantAllowance.Granter)
+	require.Equal(t, sessionKey.String(), msgGrantAllowance.Grantee)
+	allowance, err := msgGrantAllowance.GetFeeAllowanceI()
+	require.NoError(t, err)
+	allowedMsgAllowance, ok := allowance.(*feegrant.AllowedMsgAllowance)
+	require.True(t, ok)
+	require.Equal(t, []string{sdk.MsgTypeURL(&authz.MsgExec{})}, allowedMsgAllowance.AllowedMessages)
+	require.NoError(t, allowedMsgAllowance.ValidateBasic())
+	basicAllowance, err := allowedMsgAllowance.GetAllowance()
+	require.NoError(t, err)
+	require.Equal(t, grant.SpendLimit, basicAllowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	revokeMsgs := grant.RevokeMsgs()
+	require.Len(t, revokeMsgs, 2)
+	require.Equal(t, msgTypeURL, revokeMsgs[0].(*authz.MsgRevoke).MsgTypeUrl)
+	require.Equal(t, sessionKey.String(), revokeMsgs[1].(*feegrant.MsgRevokeAllowance).Grantee)
+
+	_, err = (&sessionkey.Grant{User: user, SessionKey: sessionKey}).Msgs()
+	require.Error(t, err)
+}
+
+func TestExec(t *testing.T) {
+	sessionKey := sdk.AccAddress("session key")
+	send := &banktypes.MsgSend{FromAddress: sdk.AccAddress("user").String()}
+	msg := sessionkey.Exec(sessionKey, send)
+	require.Equal(t, sessionKey.String(), msg.Grantee)
+	msgs, err := msg.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+}
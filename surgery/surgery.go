@@ -0,0 +1,207 @@
+// Package surgery patches the app state of a halted chain, for break-glass recovery from incidents that can't be fixed
+// with txs, e.g., a module whose state was corrupted by a bug.
+//
+// A Patch is a reviewable artifact: it names the height the chain was frozen at, the app hash at that height, and every
+// key it writes along with the key's value before the patch. It is hashed so reviewers approve exactly the patch that
+// is applied, and the node refuses it unless the state it is applied to is the state it was created against. The node
+// applies it before executing the txs of the block after its height, so the patched state is committed by that block
+// and every node that applies the same patch derives the same chain.
+package surgery
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// State reads and writes the app's key-value stores, e.g., integrations.WrappedApplication. Writes are committed with
+// the next block.
+type State interface {
+	// StateValue returns the value of the key in the named store, or nil if it is unset.
+	StateValue(store string, key []byte) ([]byte, error)
+	// SetStateValue sets the value of the key in the named store. A nil value deletes the key.
+	SetStateValue(store string, key, value []byte) error
+}
+
+// Op writes or deletes a key.
+type Op struct {
+	// Store is the name of the store, e.g., "bank".
+	Store string        `json:"store"`
+	Key   hexutil.Bytes `json:"key"`
+	// Value is the key's new value. It is ignored if Delete is set.
+	Value  hexutil.Bytes `json:"value,omitempty"`
+	Delete bool          `json:"delete,omitempty"`
+	// Prev is the key's value before the patch. It is empty if the key is unset, since stores don't distinguish unset
+	// keys from empty values.
+	Prev hexutil.Bytes `json:"prev,omitempty"`
+}
+
+// Patch is the artifact reviewers approve and the node applies.
+type Patch struct {
+	ChainID uint64 `json:"chain_id"`
+	// Height is the height the chain is frozen at. The patch is applied to the block after it.
+	Height uint64 `json:"height"`
+	// AppHash is the app hash at Height.
+	AppHash hexutil.Bytes `json:"app_hash"`
+	Reason  string        `json:"reason"`
+	Author  string        `json:"author"`
+	Ops     []Op          `json:"ops"`
+	// Hash is the hex-encoded SHA-256 hash of the patch's JSON encoding with Hash and Approvals unset.
+	Hash string `json:"hash"`
+	// Approvals are the reviewers who approved the patch with Hash.
+	Approvals []string `json:"approvals,omitempty"`
+}
+
+func (p *Patch) computeHash() (string, error) {
+	patch := *p
+	patch.Hash = ""
+	patch.Approvals = nil
+	patchBytes, err := json.Marshal(&patch)
+	if err != nil {
+		return "", fmt.Errorf("marshal patch: %v", err)
+	}
+	hash := sha256.Sum256(patchBytes)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Seal sets the patch's hash. Approvals given before it was sealed are removed, since they approved another patch.
+func (p *Patch) Seal() error {
+	hash, err := p.computeHash()
+	if err != nil {
+		return err
+	}
+	if hash != p.Hash {
+		p.Approvals = nil
+	}
+	p.Hash = hash
+	return nil
+}
+
+// Approve adds the reviewer's approval.
+func (p *Patch) Approve(reviewer string) error {
+	if err := p.checkHash(); err != nil {
+		return err
+	}
+	if reviewer == "" {
+		return errors.New("reviewer is empty")
+	} else if reviewer == p.Author {
+		return errors.New("the author can't approve their own patch")
+	} else if slices.Contains(p.Approvals, reviewer) {
+		return fmt.Errorf("%s already approved the patch", reviewer)
+	}
+	p.Approvals = append(p.Approvals, reviewer)
+	return nil
+}
+
+// Verify checks that the patch is well-formed, wasn't edited after it was sealed, and was approved by at least
+// minApprovals reviewers other than its author.
+func (p *Patch) Verify(minApprovals int) error {
+	if err := p.checkHash(); err != nil {
+		return err
+	}
+	if p.Reason == "" {
+		return errors.New("reason is empty")
+	} else if len(p.Ops) == 0 {
+		return errors.New("patch has no ops")
+	}
+	for i, op := range p.Ops {
+		if op.Store == "" || len(op.Key) == 0 {
+			return fmt.Errorf("op %d: store or key is empty", i)
+		}
+	}
+	var approvals int
+	for _, reviewer := range p.Approvals {
+		if reviewer != p.Author {
+			approvals++
+		}
+	}
+	if approvals < minApprovals {
+		return fmt.Errorf("patch has %d approvals, needs %d", approvals, minApprovals)
+	}
+	return nil
+}
+
+func (p *Patch) checkHash() error {
+	hash, err := p.computeHash()
+	if err != nil {
+		return err
+	}
+	if hash != p.Hash {
+		return fmt.Errorf("patch hash is %s, but the patch hashes to %s: it was edited after it was sealed", p.Hash, hash)
+	}
+	return nil
+}
+
+// FillPrev sets the Prev of every op to the key's value in state.
+func (p *Patch) FillPrev(state State) error {
+	for i := range p.Ops {
+		op := &p.Ops[i]
+		prev, err := state.StateValue(op.Store, op.Key)
+		if err != nil {
+			return fmt.Errorf("get %s key %s: %v", op.Store, op.Key, err)
+		}
+		op.Prev = prev
+	}
+	return nil
+}
+
+// Apply applies the patch to state, whose app hash is appHash. It fails without writing anything unless the patch
+// was created against appHash and every key still has its Prev value. It doesn't verify the patch's approvals.
+func Apply(state State, p *Patch, appHash []byte) error {
+	if !bytes.Equal(appHash, p.AppHash) {
+		return fmt.Errorf("app hash is %s, but the patch was created at app hash %s", hexutil.Bytes(appHash), p.AppHash)
+	}
+	for i, op := range p.Ops {
+		value, err := state.StateValue(op.Store, op.Key)
+		if err != nil {
+			return fmt.Errorf("op %d: get %s key %s: %v", i, op.Store, op.Key, err)
+		}
+		if !bytes.Equal(value, op.Prev) {
+			return fmt.Errorf("op %d: %s key %s is %s, but the patch expects %s", i, op.Store, op.Key, hexutil.Bytes(value), op.Prev)
+		}
+	}
+	for i, op := range p.Ops {
+		var value []byte
+		if !op.Delete {
+			// Stores don't accept nil values.
+			value = append([]byte{}, op.Value...)
+		}
+		if err := state.SetStateValue(op.Store, op.Key, value); err != nil {
+			return fmt.Errorf("op %d: set %s key %s: %v", i, op.Store, op.Key, err)
+		}
+	}
+	return nil
+}
+
+// Read reads a patch from a JSON file.
+func Read(path string) (*Patch, error) {
+	patchBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read patch: %v", err)
+	}
+	var p Patch
+	if err := json.Unmarshal(patchBytes, &p); err != nil {
+		return nil, fmt.Errorf("unmarshal patch: %v", err)
+	}
+	return &p, nil
+}
+
+// Write writes the patch's indented JSON encoding to w.
+func (p *Patch) Write(w io.Writer) error {
+	patchBytes, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal patch: %v", err)
+	}
+	if _, err := w.Write(append(patchBytes, '\n')); err != nil {
+		return fmt.Errorf("write patch: %v", err)
+	}
+	return nil
+}
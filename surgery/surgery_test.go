@@ -0,0 +1,99 @@
+package surgery_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/polymerdao/monomer/surgery"
+	"github.com/stretchr/testify/require"
+)
+
+type state map[string]map[string][]byte
+
+func (s state) StateValue(store string, key []byte) ([]byte, error) {
+	return s[store][string(key)], nil
+}
+
+func (s state) SetStateValue(store string, key, value []byte) error {
+	if s[store] == nil {
+		s[store] = make(map[string][]byte)
+	}
+	if value == nil {
+		delete(s[store], string(key))
+	} else {
+		s[store][string(key)] = value
+	}
+	return nil
+}
+
+func newPatch(t *testing.T, s state) *surgery.Patch {
+	p := &surgery.Patch{
+		ChainID: 1,
+		Height:  10,
+		AppHash: []byte{1},
+		Reason:  "fix corrupted balance",
+		Author:  "alice",
+		Ops: []surgery.Op{
+			{Store: "bank", Key: []byte("a"), Value: []byte("new")},
+			{Store: "bank", Key: []byte("b"), Delete: true},
+			{Store: "rollup", Key: []byte("c"), Value: []byte("created")},
+		},
+	}
+	require.NoError(t, p.FillPrev(s))
+	require.NoError(t, p.Seal())
+	return p
+}
+
+func TestApply(t *testing.T) {
+	s := state{"bank": {"a": []byte("old"), "b": []byte("gone")}}
+	p := newPatch(t, s)
+	require.Equal(t, []byte("old"), []byte(p.Ops[0].Prev))
+	require.Empty(t, p.Ops[2].Prev)
+
+	require.ErrorContains(t, surgery.Apply(s, p, []byte{2}), "app hash")
+	require.NoError(t, surgery.Apply(s, p, []byte{1}))
+	require.Equal(t, state{
+		"bank":   {"a": []byte("new")},
+		"rollup": {"c": []byte("created")},
+	}, s)
+
+	// The keys no longer have their Prev values, so the patch can't be applied twice.
+	require.ErrorContains(t, surgery.Apply(s, p, []byte{1}), "but the patch expects")
+	require.Equal(t, []byte("new"), s["bank"]["a"])
+}
+
+func TestVerify(t *testing.T) {
+	p := newPatch(t, state{})
+	require.NoError(t, p.Verify(0))
+	require.ErrorContains(t, p.Verify(1), "0 approvals")
+
+	require.Error(t, p.Approve("alice"))
+	require.NoError(t, p.Approve("bob"))
+	require.Error(t, p.Approve("bob"))
+	require.NoError(t, p.Verify(1))
+
+	// Approvals don't change the hash, but edits do.
+	p.Ops[0].Value = []byte("other")
+	require.ErrorContains(t, p.Verify(1), "edited")
+	require.Error(t, p.Approve("carol"))
+
+	// Resealing an edited patch drops the approvals.
+	require.NoError(t, p.Seal())
+	require.Empty(t, p.Approvals)
+}
+
+func TestReadWrite(t *testing.T) {
+	p := newPatch(t, state{"bank": {"a": []byte("old")}})
+	require.NoError(t, p.Approve("bob"))
+	var buf bytes.Buffer
+	require.NoError(t, p.Write(&buf))
+	path := filepath.Join(t.TempDir(), "patch.json")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+
+	got, err := surgery.Read(path)
+	require.NoError(t, err)
+	require.NoError(t, got.Verify(1))
+	require.Equal(t, p.Hash, got.Hash)
+}
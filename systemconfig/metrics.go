@@ -0,0 +1,63 @@
+package systemconfig
+
+import (
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "systemconfig"
+
+// Metrics contains metrics collected from the systemconfig package.
+type Metrics interface {
+	SetUp(up bool)
+	SetDiverged(param string, diverged bool)
+}
+
+type metrics struct {
+	// Whether the last read of the SystemConfig succeeded.
+	Up stdprometheus.Gauge
+	// Whether each parameter diverged in the last successful read.
+	Diverged *stdprometheus.GaugeVec
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		Up: promauto.NewGauge(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "up",
+			Help:      "1 if the last read of the SystemConfig on L1 succeeded, 0 otherwise",
+		}),
+		Diverged: promauto.NewGaugeVec(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "diverged",
+			Help:      "1 if the SystemConfig parameter on L1 diverges from the node's config, 0 otherwise",
+		}, []string{"param"}),
+	}
+}
+
+func (m *metrics) SetUp(up bool) {
+	m.Up.Set(boolToFloat(up))
+}
+
+func (m *metrics) SetDiverged(param string, diverged bool) {
+	m.Diverged.WithLabelValues(param).Set(boolToFloat(diverged))
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) SetUp(bool) {}
+
+func (*noopMetrics) SetDiverged(string, bool) {}
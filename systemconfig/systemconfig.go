@@ -0,0 +1,197 @@
+// Package systemconfig checks the chain's SystemConfig contract on L1 against the node's configuration. A rollup config
+// that doesn't match the deployment, e.g., one copied from another chain or from before a batcher rotation, makes
+// op-node derive from the wrong batches or drop the sequencer's gossip, which is hard to trace back from the symptoms.
+// The checker reads the SystemConfig periodically and reports every parameter that diverges.
+package systemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	bindings "github.com/polymerdao/monomer/bindings/generated"
+)
+
+// DefaultInterval is how often the SystemConfig is read by default.
+const DefaultInterval = time.Minute
+
+const (
+	ParamBatcher           = "batcher"
+	ParamGasLimit          = "gas_limit"
+	ParamUnsafeBlockSigner = "unsafe_block_signer"
+	ParamBatchInbox        = "batch_inbox"
+	ParamOptimismPortal    = "optimism_portal"
+)
+
+// Params are the SystemConfig parameters that are checked.
+var Params = []string{ParamBatcher, ParamGasLimit, ParamUnsafeBlockSigner, ParamBatchInbox, ParamOptimismPortal}
+
+// Values are the values the SystemConfig should have. Zero values aren't checked.
+type Values struct {
+	// Batcher is the batcher address, the rollup config's genesis.system_config.batcherAddr.
+	Batcher common.Address
+	// GasLimit is the rollup config's genesis.system_config.gasLimit.
+	GasLimit uint64
+	// UnsafeBlockSigner is the address op-node accepts gossiped blocks from, op-node's --p2p.sequencer.key.
+	UnsafeBlockSigner common.Address
+	// BatchInbox is the rollup config's batch_inbox_address.
+	BatchInbox common.Address
+	// OptimismPortal is the rollup config's deposit_contract_address.
+	OptimismPortal common.Address
+}
+
+// Config configures the checker.
+type Config struct {
+	// Caller reads L1, e.g., an l1.Reader.
+	Caller bind.ContractCaller
+	// Address is the SystemConfig's address, the rollup config's l1_system_config_address.
+	Address common.Address
+	// Expected are the values the SystemConfig should have.
+	Expected Values
+	// Interval is how often the SystemConfig is read. It defaults to DefaultInterval.
+	Interval time.Duration
+}
+
+// Checker reads the SystemConfig and reports the parameters that diverge from the expected values through its metrics
+// and Diverged.
+type Checker struct {
+	address  common.Address
+	caller   *bindings.SystemConfigCaller
+	expected Values
+	interval time.Duration
+	metrics  Metrics
+
+	mu          sync.Mutex
+	divergences map[string]error
+}
+
+func NewChecker(cfg *Config, metrics Metrics) (*Checker, error) {
+	caller, err := bindings.NewSystemConfigCaller(cfg.Address, cfg.Caller)
+	if err != nil {
+		return nil, fmt.Errorf("new system config caller: %v", err)
+	}
+	interval := cfg.Interval
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+	return &Checker{
+		address:  cfg.Address,
+		caller:   caller,
+		expected: cfg.Expected,
+		interval: interval,
+		metrics:  metrics,
+	}, nil
+}
+
+// Run reads the SystemConfig every interval until ctx is done. Failed reads and divergences are passed to onErr and
+// don't stop it. Divergences are passed to onErr when they are first detected and whenever they change.
+func (c *Checker) Run(ctx context.Context, onErr func(error)) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		before := c.Diverged()
+		if err := c.Check(ctx); err != nil {
+			if ctx.Err() == nil {
+				onErr(err)
+			}
+		} else if after := c.Diverged(); after != nil && (before == nil || before.Error() != after.Error()) {
+			onErr(after)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check reads the SystemConfig once and updates the metrics. It returns an error if the SystemConfig can't be read, in
+// which case the divergences found by the last successful read are kept.
+func (c *Checker) Check(ctx context.Context) error {
+	opts := &bind.CallOpts{
+		Context: ctx,
+	}
+	divergences := make(map[string]error)
+	diverge := func(param string, expected, actual any) {
+		divergences[param] = fmt.Errorf("%s is %v on L1, but %v is configured", param, actual, expected)
+	}
+	var errs []error
+	if c.expected.Batcher != (common.Address{}) {
+		batcherHash, err := c.caller.BatcherHash(opts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("get batcher hash: %v", err))
+		} else if batcher := common.BytesToAddress(batcherHash[:]); batcher != c.expected.Batcher {
+			diverge(ParamBatcher, c.expected.Batcher, batcher)
+		}
+	}
+	if c.expected.GasLimit != 0 {
+		gasLimit, err := c.caller.GasLimit(opts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("get gas limit: %v", err))
+		} else if gasLimit != c.expected.GasLimit {
+			diverge(ParamGasLimit, c.expected.GasLimit, gasLimit)
+		}
+	}
+	if c.expected.UnsafeBlockSigner != (common.Address{}) {
+		signer, err := c.caller.UnsafeBlockSigner(opts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("get unsafe block signer: %v", err))
+		} else if signer != c.expected.UnsafeBlockSigner {
+			diverge(ParamUnsafeBlockSigner, c.expected.UnsafeBlockSigner, signer)
+		}
+	}
+	if c.expected.BatchInbox != (common.Address{}) {
+		inbox, err := c.caller.BatchInbox(opts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("get batch inbox: %v", err))
+		} else if inbox != c.expected.BatchInbox {
+			diverge(ParamBatchInbox, c.expected.BatchInbox, inbox)
+		}
+	}
+	if c.expected.OptimismPortal != (common.Address{}) {
+		portal, err := c.caller.OptimismPortal(opts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("get optimism portal: %v", err))
+		} else if portal != c.expected.OptimismPortal {
+			diverge(ParamOptimismPortal, c.expected.OptimismPortal, portal)
+		}
+	}
+	if len(errs) > 0 {
+		c.metrics.SetUp(false)
+		return fmt.Errorf("read system config %s: %v", c.address, errors.Join(errs...))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.divergences = divergences
+	c.metrics.SetUp(true)
+	for _, param := range Params {
+		_, diverged := divergences[param]
+		c.metrics.SetDiverged(param, diverged)
+	}
+	return nil
+}
+
+// Diverged returns an error describing every parameter that diverged in the last successful read, or nil if none did.
+func (c *Checker) Diverged() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	params := make([]string, 0, len(c.divergences))
+	for param := range c.divergences {
+		params = append(params, param)
+	}
+	slices.Sort(params)
+	errs := make([]error, 0, len(params))
+	for _, param := range params {
+		errs = append(errs, c.divergences[param])
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("system config %s diverges from the node's config: %v", c.address, errors.Join(errs...))
+}
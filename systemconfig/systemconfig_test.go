@@ -0,0 +1,128 @@
+package systemconfig_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	bindings "github.com/polymerdao/monomer/bindings/generated"
+	"github.com/polymerdao/monomer/systemconfig"
+	"github.com/stretchr/testify/require"
+)
+
+// mockCaller serves the SystemConfig's getters from values.
+type mockCaller struct {
+	t      *testing.T
+	abi    *abi.ABI
+	mu     sync.Mutex
+	values map[string]any
+	err    error
+}
+
+func newMockCaller(t *testing.T, values systemconfig.Values) *mockCaller {
+	contractABI, err := bindings.SystemConfigMetaData.GetAbi()
+	require.NoError(t, err)
+	c := &mockCaller{
+		t:   t,
+		abi: contractABI,
+	}
+	c.set(values)
+	return c
+}
+
+func (c *mockCaller) set(values systemconfig.Values) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values = map[string]any{
+		"batcherHash":       common.BytesToHash(values.Batcher.Bytes()),
+		"gasLimit":          values.GasLimit,
+		"unsafeBlockSigner": values.UnsafeBlockSigner,
+		"batchInbox":        values.BatchInbox,
+		"optimismPortal":    values.OptimismPortal,
+	}
+}
+
+func (c *mockCaller) setErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
+
+func (c *mockCaller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *mockCaller) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	method, err := c.abi.MethodById(call.Data)
+	require.NoError(c.t, err)
+	value, ok := c.values[method.Name]
+	require.True(c.t, ok, method.Name)
+	return method.Outputs.Pack(value)
+}
+
+func TestChecker(t *testing.T) {
+	ctx := context.Background()
+	expected := systemconfig.Values{
+		Batcher:           common.Address{1},
+		GasLimit:          30_000_000,
+		UnsafeBlockSigner: common.Address{2},
+		BatchInbox:        common.Address{3},
+		OptimismPortal:    common.Address{4},
+	}
+	caller := newMockCaller(t, expected)
+	checker, err := systemconfig.NewChecker(&systemconfig.Config{
+		Caller:   caller,
+		Address:  common.Address{5},
+		Expected: expected,
+	}, systemconfig.NewNoopMetrics())
+	require.NoError(t, err)
+
+	require.NoError(t, checker.Check(ctx))
+	require.NoError(t, checker.Diverged())
+
+	actual := expected
+	actual.Batcher = common.Address{6}
+	actual.GasLimit = 60_000_000
+	caller.set(actual)
+	require.NoError(t, checker.Check(ctx))
+	err = checker.Diverged()
+	require.ErrorContains(t, err, systemconfig.ParamBatcher)
+	require.ErrorContains(t, err, systemconfig.ParamGasLimit)
+	require.NotContains(t, err.Error(), systemconfig.ParamUnsafeBlockSigner)
+
+	// Failed reads keep the divergences found by the last successful read.
+	caller.setErr(errors.New("unavailable"))
+	require.ErrorContains(t, checker.Check(ctx), "unavailable")
+	require.Equal(t, err, checker.Diverged())
+
+	caller.setErr(nil)
+	caller.set(expected)
+	require.NoError(t, checker.Check(ctx))
+	require.NoError(t, checker.Diverged())
+}
+
+func TestCheckerSkipsZeroValues(t *testing.T) {
+	caller := newMockCaller(t, systemconfig.Values{
+		Batcher:  common.Address{1},
+		GasLimit: 30_000_000,
+	})
+	checker, err := systemconfig.NewChecker(&systemconfig.Config{
+		Caller: caller,
+		Expected: systemconfig.Values{
+			GasLimit: 30_000_000,
+		},
+	}, systemconfig.NewNoopMetrics())
+	require.NoError(t, err)
+	require.NoError(t, checker.Check(context.Background()))
+	require.NoError(t, checker.Diverged())
+}
@@ -0,0 +1,181 @@
+// Package telemetry sends opt-in usage and crash reports to an endpoint chosen by the operator, so maintainers can see
+// which configurations crash in the wild. Reports are anonymous: they identify the chain by a hash of its chain ID and
+// never contain keys, addresses, or txs.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/builder"
+	"github.com/polymerdao/monomer/buildinfo"
+)
+
+const (
+	// KindStart is the kind of the report sent when the node starts.
+	KindStart = "start"
+	// KindHeartbeat is the kind of the reports sent periodically while the node runs.
+	KindHeartbeat = "heartbeat"
+	// KindPanic is the kind of the report sent when the node panics.
+	KindPanic = "panic"
+)
+
+// sendTimeout bounds each request, so an unresponsive endpoint can't hold up a crashing node.
+const sendTimeout = 5 * time.Second
+
+// Report is the JSON body of a request to the telemetry endpoint.
+type Report struct {
+	Kind      string `json:"kind"`
+	Version   string `json:"version"`
+	GoVersion string `json:"goVersion"`
+	OS        string `json:"os"`
+	Arch      string `json:"arch"`
+	// ChainIDHash is the hex-encoded SHA-256 hash of the decimal chain ID.
+	ChainIDHash string `json:"chainIdHash"`
+	// Features are the optional features the node runs with, e.g., "pruning".
+	Features []string `json:"features"`
+	Counters Counters `json:"counters"`
+	// Panic is only set in panic reports.
+	Panic *Panic `json:"panic,omitempty"`
+}
+
+// Counters are basic performance counters of the node since it started.
+type Counters struct {
+	UptimeSeconds uint64 `json:"uptimeSeconds"`
+	BlocksBuilt   uint64 `json:"blocksBuilt"`
+	TxsIncluded   uint64 `json:"txsIncluded"`
+	Goroutines    int    `json:"goroutines"`
+	HeapBytes     uint64 `json:"heapBytes"`
+	NumGC         uint32 `json:"numGC"`
+}
+
+type Panic struct {
+	Value string `json:"value"`
+	Stack string `json:"stack"`
+}
+
+// Reporter sends reports to the telemetry endpoint. It counts the blocks the node builds as a builder.Interceptor that
+// never adds txs.
+type Reporter struct {
+	endpoint    string
+	client      *http.Client
+	version     string
+	chainIDHash string
+	features    []string
+	start       time.Time
+	blocksBuilt atomic.Uint64
+	txsIncluded atomic.Uint64
+}
+
+var _ builder.Interceptor = (*Reporter)(nil)
+
+// New returns a Reporter that POSTs reports to endpoint for the chain with the given ID.
+func New(endpoint string, chainID monomer.ChainID, features []string) *Reporter {
+	chainIDHash := sha256.Sum256([]byte(strconv.FormatUint(uint64(chainID), 10)))
+	return &Reporter{
+		endpoint: endpoint,
+		client: &http.Client{
+			Timeout: sendTimeout,
+		},
+		version:     buildinfo.Version(),
+		chainIDHash: hex.EncodeToString(chainIDHash[:]),
+		features:    features,
+		start:       time.Now(),
+	}
+}
+
+// Run sends a start report and then a heartbeat every interval until ctx is done. Failed requests are passed to onErr
+// and otherwise ignored, so an unreachable endpoint never affects the node.
+func (r *Reporter) Run(ctx context.Context, interval time.Duration, onErr func(error)) {
+	if err := r.send(ctx, r.report(KindStart)); err != nil {
+		onErr(err)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.send(ctx, r.report(KindHeartbeat)); err != nil && ctx.Err() == nil {
+				onErr(err)
+			}
+		}
+	}
+}
+
+// ReportPanic sends a panic report with the recovered value and the stack of the panicking goroutine.
+func (r *Reporter) ReportPanic(value any, stack []byte) error {
+	report := r.report(KindPanic)
+	report.Panic = &Panic{
+		Value: fmt.Sprint(value),
+		Stack: string(stack),
+	}
+	return r.send(context.Background(), report)
+}
+
+func (*Reporter) Intercept(context.Context, uint64) (bfttypes.Txs, error) {
+	return nil, nil
+}
+
+func (r *Reporter) OnBlock(_ context.Context, block *monomer.Block) error {
+	r.blocksBuilt.Add(1)
+	r.txsIncluded.Add(uint64(block.Txs.Len()))
+	return nil
+}
+
+func (r *Reporter) report(kind string) *Report {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	return &Report{
+		Kind:        kind,
+		Version:     r.version,
+		GoVersion:   runtime.Version(),
+		OS:          runtime.GOOS,
+		Arch:        runtime.GOARCH,
+		ChainIDHash: r.chainIDHash,
+		Features:    r.features,
+		Counters: Counters{
+			UptimeSeconds: uint64(time.Since(r.start).Seconds()),
+			BlocksBuilt:   r.blocksBuilt.Load(),
+			TxsIncluded:   r.txsIncluded.Load(),
+			Goroutines:    runtime.NumGoroutine(),
+			HeapBytes:     memStats.HeapAlloc,
+			NumGC:         memStats.NumGC,
+		},
+	}
+}
+
+func (r *Reporter) send(ctx context.Context, report *Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("marshal %s report: %v", report.Kind, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("new request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send %s report: %v", report.Kind, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("send %s report: unexpected status %s", report.Kind, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package telemetry_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/buildinfo"
+	"github.com/polymerdao/monomer/telemetry"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// newEndpoint returns the URL of an endpoint that sends the reports it receives on the returned channel.
+func newEndpoint(t *testing.T) (string, <-chan *telemetry.Report) {
+	reports := make(chan *telemetry.Report, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		var report telemetry.Report
+		if err := json.NewDecoder(r.Body).Decode(&report); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reports <- &report
+	}))
+	t.Cleanup(server.Close)
+	return server.URL, reports
+}
+
+func TestRun(t *testing.T) {
+	endpoint, reports := newEndpoint(t)
+	reporter := telemetry.New(endpoint, 1, []string{"pruning"})
+	block := testutils.GenerateBlockWithParentAndTxs(t, &monomer.Header{}, bfttypes.Tx("tx"))
+	require.NoError(t, reporter.OnBlock(context.Background(), block))
+	txs, err := reporter.Intercept(context.Background(), 1)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		reporter.Run(ctx, 10*time.Millisecond, func(err error) {
+			require.NoError(t, err)
+		})
+	}()
+
+	chainIDHash := sha256.Sum256([]byte("1"))
+	for _, kind := range []string{telemetry.KindStart, telemetry.KindHeartbeat} {
+		report := <-reports
+		require.Equal(t, kind, report.Kind)
+		require.Equal(t, buildinfo.Version(), report.Version)
+		require.Equal(t, runtime.Version(), report.GoVersion)
+		require.Equal(t, hex.EncodeToString(chainIDHash[:]), report.ChainIDHash)
+		require.Equal(t, []string{"pruning"}, report.Features)
+		require.Equal(t, uint64(1), report.Counters.BlocksBuilt)
+		require.Equal(t, uint64(block.Txs.Len()), report.Counters.TxsIncluded)
+		require.Positive(t, report.Counters.Goroutines)
+		require.Nil(t, report.Panic)
+	}
+	cancel()
+	<-done
+}
+
+func TestReportPanic(t *testing.T) {
+	endpoint, reports := newEndpoint(t)
+	reporter := telemetry.New(endpoint, 1, nil)
+
+	require.NoError(t, reporter.ReportPanic("boom", []byte("stack")))
+	report := <-reports
+	require.Equal(t, telemetry.KindPanic, report.Kind)
+	require.Equal(t, &telemetry.Panic{Value: "boom", Stack: "stack"}, report.Panic)
+}
+
+func TestReportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	require.ErrorContains(t, telemetry.New(server.URL, 1, nil).ReportPanic("boom", nil), "unexpected status")
+}
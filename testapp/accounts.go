@@ -0,0 +1,120 @@
+package testapp
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/polymerdao/monomer/testapp/x/testmodule/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Mnemonic derives the test accounts. It is public and must never hold real funds.
+	Mnemonic = "test test test test test test test test test test test junk"
+	// NumAccounts is the number of test accounts funded at genesis.
+	NumAccounts = 10
+)
+
+// AccountBalance is the amount of ETH (in wei) each test account is funded with at genesis.
+var AccountBalance = math.NewIntWithDecimal(1000, 18) //nolint:mnd
+
+// Account is a test account derived from Mnemonic.
+type Account struct {
+	PrivKey *secp256k1.PrivKey
+	Address sdk.AccAddress
+}
+
+var accounts = sync.OnceValue(func() []*Account {
+	accs := make([]*Account, 0, NumAccounts)
+	for i := range uint32(NumAccounts) {
+		privKeyBytes, err := hd.Secp256k1.Derive()(Mnemonic, "", hd.CreateHDPath(sdk.CoinType, 0, i).String())
+		if err != nil {
+			panic(fmt.Errorf("derive private key %d: %v", i, err))
+		}
+		privKey := &secp256k1.PrivKey{Key: privKeyBytes}
+		accs = append(accs, &Account{
+			PrivKey: privKey,
+			Address: sdk.AccAddress(privKey.PubKey().Address()),
+		})
+	}
+	return accs
+})
+
+// Accounts returns the test accounts. They are derived from Mnemonic with the standard Cosmos HD path, so they are
+// the same in every run and can be imported into a keyring with `keys add --recover`.
+func Accounts() []*Account {
+	return accounts()
+}
+
+// GetAccount returns the i-th test account. Tests that run concurrently should use different accounts to avoid
+// sequence conflicts.
+func GetAccount(i int) *Account {
+	return accounts()[i]
+}
+
+// SignTx builds a transaction with msgs and signs it with SIGN_MODE_DIRECT.
+func (a *Account) SignTx(t *testing.T, chainID string, accountNumber, sequence uint64, msgs ...proto.Message) []byte {
+	anys := make([]*codectypes.Any, 0, len(msgs))
+	for _, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys = append(anys, msgAny)
+	}
+	bodyBytes, err := (&sdktx.TxBody{
+		Messages: anys,
+	}).Marshal()
+	require.NoError(t, err)
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(a.PrivKey.PubKey())
+	require.NoError(t, err)
+	authInfoBytes, err := (&sdktx.AuthInfo{
+		SignerInfos: []*sdktx.SignerInfo{
+			{
+				PublicKey: pubKeyAny,
+				ModeInfo: &sdktx.ModeInfo{
+					Sum: &sdktx.ModeInfo_Single_{
+						Single: &sdktx.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT},
+					},
+				},
+				Sequence: sequence,
+			},
+		},
+		Fee: &sdktx.Fee{},
+	}).Marshal()
+	require.NoError(t, err)
+
+	signBytes, err := (&sdktx.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       chainID,
+		AccountNumber: accountNumber,
+	}).Marshal()
+	require.NoError(t, err)
+	signature, err := a.PrivKey.Sign(signBytes)
+	require.NoError(t, err)
+
+	txBytes, err := (&sdktx.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{signature},
+	}).Marshal()
+	require.NoError(t, err)
+	return txBytes
+}
+
+// ToFaucetTx creates a transaction that mints amount ETH (in wei) to the recipient.
+func ToFaucetTx(t *testing.T, recipient sdk.AccAddress, amount math.Int) []byte {
+	return ToTx(t, &types.MsgFaucet{
+		ToAddress: recipient.String(),
+		Amount:    amount,
+	})
+}
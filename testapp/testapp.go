@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/core/appconfig"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -30,10 +31,15 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	feetokenmodulev1 "github.com/polymerdao/monomer/gen/feetoken/module/v1"
 	rollupmodulev1 "github.com/polymerdao/monomer/gen/rollup/module/v1"
 	testappmodulev1 "github.com/polymerdao/monomer/gen/testapp/module/v1"
+	workloadmodulev1 "github.com/polymerdao/monomer/gen/workload/module/v1"
+	"github.com/polymerdao/monomer/query"
 	"github.com/polymerdao/monomer/testapp/x/testmodule"
 	testmodulekeeper "github.com/polymerdao/monomer/testapp/x/testmodule/keeper"
+	"github.com/polymerdao/monomer/testapp/x/workload"
+	"github.com/polymerdao/monomer/x/feetoken"
 	_ "github.com/polymerdao/monomer/x/rollup"
 	rollupkeeper "github.com/polymerdao/monomer/x/rollup/keeper"
 	"github.com/polymerdao/monomer/x/rollup/tx/helpers"
@@ -41,11 +47,12 @@ import (
 )
 
 // App is an app with the absolute minimum amount of configuration required to have the Monomer rollup module.
-// It also has a dummy test module for easy transaction testing.
+// It also has the Monomer feetoken module, a dummy test module for easy transaction testing, and a workload module for benchmarking.
 // The test module will initialize a single validator to satisfy the module manager's InitChain invariant that the validator set must be non-empty
 // (the requirement doesn't make sense to me since that's a consensus-layer concern).
 type App struct {
 	app            *runtime.App
+	appCodec       codec.Codec
 	defaultGenesis map[string]json.RawMessage
 }
 
@@ -54,7 +61,7 @@ func (a *App) Info(_ context.Context, r *abcitypes.RequestInfo) (*abcitypes.Resp
 }
 
 func (a *App) Query(ctx context.Context, r *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error) {
-	return a.app.Query(ctx, r)
+	return query.Serve(ctx, a.app, r)
 }
 
 func (a *App) CheckTx(_ context.Context, r *abcitypes.RequestCheckTx) (*abcitypes.ResponseCheckTx, error) {
@@ -65,6 +72,14 @@ func (a *App) InitChain(_ context.Context, r *abcitypes.RequestInitChain) (*abci
 	return a.app.InitChain(r)
 }
 
+func (a *App) PrepareProposal(_ context.Context, r *abcitypes.RequestPrepareProposal) (*abcitypes.ResponsePrepareProposal, error) {
+	return a.app.PrepareProposal(r)
+}
+
+func (a *App) ProcessProposal(_ context.Context, r *abcitypes.RequestProcessProposal) (*abcitypes.ResponseProcessProposal, error) {
+	return a.app.ProcessProposal(r)
+}
+
 func (a *App) FinalizeBlock(_ context.Context, r *abcitypes.RequestFinalizeBlock) (*abcitypes.ResponseFinalizeBlock, error) {
 	return a.app.FinalizeBlock(r)
 }
@@ -77,11 +92,54 @@ func (a *App) RollbackToHeight(_ context.Context, targetHeight uint64) error {
 	return a.app.CommitMultiStore().RollbackToVersion(int64(targetHeight))
 }
 
+func (a *App) ListSnapshots(_ context.Context, r *abcitypes.RequestListSnapshots) (*abcitypes.ResponseListSnapshots, error) {
+	return a.app.ListSnapshots(r)
+}
+
+func (a *App) LoadSnapshotChunk(
+	_ context.Context,
+	r *abcitypes.RequestLoadSnapshotChunk,
+) (*abcitypes.ResponseLoadSnapshotChunk, error) {
+	return a.app.LoadSnapshotChunk(r)
+}
+
+func (a *App) OfferSnapshot(_ context.Context, r *abcitypes.RequestOfferSnapshot) (*abcitypes.ResponseOfferSnapshot, error) {
+	return a.app.OfferSnapshot(r)
+}
+
+func (a *App) ApplySnapshotChunk(
+	_ context.Context,
+	r *abcitypes.RequestApplySnapshotChunk,
+) (*abcitypes.ResponseApplySnapshotChunk, error) {
+	return a.app.ApplySnapshotChunk(r)
+}
+
+// SetABCIListeners streams the blocks the app executes and the changes to all of its stores to listeners, like the
+// streaming services an app registers from app.toml's [streaming.abci] section.
+func (a *App) SetABCIListeners(listeners ...storetypes.ABCIListener) {
+	a.app.CommitMultiStore().AddListeners(a.app.GetStoreKeys())
+	a.app.SetStreamingManager(storetypes.StreamingManager{
+		ABCIListeners: listeners,
+	})
+}
+
+// BaseApp returns the app's BaseApp, so tests can run msgs and queries against its state directly.
+func (a *App) BaseApp() *baseapp.BaseApp {
+	return a.app.BaseApp
+}
+
+// AppCodec returns the app's codec.
+func (a *App) AppCodec() codec.Codec {
+	return a.appCodec
+}
+
 var modules = []string{
 	authtypes.ModuleName,
 	banktypes.ModuleName,
 	govtypes.ModuleName,
+	feetoken.ModuleName,
 	testmodule.ModuleName,
+	workload.ModuleName,
 	rolluptypes.ModuleName,
 }
 
@@ -110,6 +168,13 @@ func New(appdb dbm.DB, chainID string) (*App, error) {
 							Account:     rolluptypes.ModuleName,
 							Permissions: []string{authtypes.Minter, authtypes.Burner},
 						},
+						{
+							Account:     testmodule.ModuleName,
+							Permissions: []string{authtypes.Minter},
+						},
+						{
+							Account: feetoken.ModuleName,
+						},
 					},
 				}),
 			},
@@ -123,10 +188,18 @@ func New(appdb dbm.DB, chainID string) (*App, error) {
 					SkipAnteHandler: true, // Ignore signatures and gas for testing.
 				}),
 			},
+			{
+				Name:   feetoken.ModuleName,
+				Config: appconfig.WrapAny(&feetokenmodulev1.Module{}),
+			},
 			{
 				Name:   testmodule.ModuleName,
 				Config: appconfig.WrapAny(&testappmodulev1.Module{}),
 			},
+			{
+				Name:   workload.ModuleName,
+				Config: appconfig.WrapAny(&workloadmodulev1.Module{}),
+			},
 			{
 				Name:   rolluptypes.ModuleName,
 				Config: appconfig.WrapAny(&rollupmodulev1.Module{}),
@@ -175,12 +248,56 @@ func New(appdb dbm.DB, chainID string) (*App, error) {
 		return nil, fmt.Errorf("load latest version: %v", err)
 	}
 
+	defaultGenesis, err := fundAccounts(appCodec, appBuilder.DefaultGenesis())
+	if err != nil {
+		return nil, fmt.Errorf("fund test accounts: %v", err)
+	}
+
 	return &App{
 		app:            runtimeApp,
-		defaultGenesis: appBuilder.DefaultGenesis(),
+		appCodec:       appCodec,
+		defaultGenesis: defaultGenesis,
 	}, nil
 }
 
+// fundAccounts adds the test accounts to the auth and bank genesis states.
+func fundAccounts(appCodec codec.Codec, genesis map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	var authGenesis authtypes.GenesisState
+	if err := appCodec.UnmarshalJSON(genesis[authtypes.ModuleName], &authGenesis); err != nil {
+		return nil, fmt.Errorf("unmarshal auth genesis: %v", err)
+	}
+	var bankGenesis banktypes.GenesisState
+	if err := appCodec.UnmarshalJSON(genesis[banktypes.ModuleName], &bankGenesis); err != nil {
+		return nil, fmt.Errorf("unmarshal bank genesis: %v", err)
+	}
+
+	genesisAccounts := make(authtypes.GenesisAccounts, 0, NumAccounts)
+	for _, acc := range Accounts() {
+		genesisAccounts = append(genesisAccounts, authtypes.NewBaseAccount(acc.Address, nil, 0, 0))
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+			Address: acc.Address.String(),
+			Coins:   sdktypes.NewCoins(sdktypes.NewCoin(rolluptypes.ETH, AccountBalance)),
+		})
+	}
+	packedAccounts, err := authtypes.PackAccounts(genesisAccounts)
+	if err != nil {
+		return nil, fmt.Errorf("pack accounts: %v", err)
+	}
+	authGenesis.Accounts = append(authGenesis.Accounts, packedAccounts...)
+
+	authGenesisBytes, err := appCodec.MarshalJSON(&authGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("marshal auth genesis: %v", err)
+	}
+	bankGenesisBytes, err := appCodec.MarshalJSON(&bankGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("marshal bank genesis: %v", err)
+	}
+	genesis[authtypes.ModuleName] = authGenesisBytes
+	genesis[banktypes.ModuleName] = bankGenesisBytes
+	return genesis, nil
+}
+
 // DefaultGenesis returns the app's default genesis state. It must be cloned before it is modified.
 func (a *App) DefaultGenesis() map[string]json.RawMessage {
 	return a.defaultGenesis
@@ -6,9 +6,15 @@ import (
 	"fmt"
 	"testing"
 
+	"cosmossdk.io/math"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/polymerdao/monomer"
 	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testapp/x/testmodule/types"
+	workloadtypes "github.com/polymerdao/monomer/testapp/x/workload/types"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -76,3 +82,130 @@ func build(t *testing.T, app *testapp.App, chainID string, height int64) (string
 	require.NoError(t, err)
 	return key, value
 }
+
+func TestAccounts(t *testing.T) {
+	accounts := testapp.Accounts()
+	require.Len(t, accounts, testapp.NumAccounts)
+	addresses := make(map[string]struct{}, len(accounts))
+	for _, acc := range accounts {
+		addresses[acc.Address.String()] = struct{}{}
+	}
+	require.Len(t, addresses, testapp.NumAccounts)
+	// The accounts must not change between releases.
+	require.Equal(t, "cosmos15yk64u7zc9g9k2yr2wmzeva5qgwxps6yxj00e7", testapp.GetAccount(0).Address.String())
+
+	chainID := monomer.ChainID(0).String()
+	app := testapp.NewTest(t, chainID)
+	_, err := app.InitChain(context.Background(), &abcitypes.RequestInitChain{
+		ChainId: chainID,
+		AppStateBytes: func() []byte {
+			got, err := json.Marshal(app.DefaultGenesis())
+			require.NoError(t, err)
+			return got
+		}(),
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+	// The genesis state is committed with the first block.
+	_, err = app.FinalizeBlock(context.Background(), &abcitypes.RequestFinalizeBlock{
+		Height: 1,
+	})
+	require.NoError(t, err)
+	_, err = app.Commit(context.Background(), &abcitypes.RequestCommit{})
+	require.NoError(t, err)
+	recipient := testapp.GetAccount(1)
+	require.Equal(t, testapp.AccountBalance, balance(t, app, recipient.Address))
+
+	amount := math.NewInt(100)
+	resp, err := app.FinalizeBlock(context.Background(), &abcitypes.RequestFinalizeBlock{
+		Txs: [][]byte{
+			testapp.ToFaucetTx(t, recipient.Address, amount),
+			recipient.SignTx(t, chainID, 0, 0, &types.MsgSetValue{
+				FromAddress: recipient.Address.String(),
+				Key:         "k",
+				Value:       "v",
+			}),
+		},
+		Height: 2,
+	})
+	require.NoError(t, err)
+	for _, txResult := range resp.GetTxResults() {
+		require.True(t, txResult.IsOK(), txResult.GetLog())
+	}
+	_, err = app.Commit(context.Background(), &abcitypes.RequestCommit{})
+	require.NoError(t, err)
+	require.Equal(t, testapp.AccountBalance.Add(amount), balance(t, app, recipient.Address))
+	app.StateContains(t, 2, map[string]string{"k": "v"})
+}
+
+func balance(t *testing.T, app *testapp.App, address sdk.AccAddress) math.Int {
+	requestBytes, err := (&banktypes.QueryBalanceRequest{
+		Address: address.String(),
+		Denom:   rolluptypes.ETH,
+	}).Marshal()
+	require.NoError(t, err)
+	resp, err := app.Query(context.Background(), &abcitypes.RequestQuery{
+		Path: "/cosmos.bank.v1beta1.Query/Balance",
+		Data: requestBytes,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.IsOK(), resp.GetLog())
+	var balanceResp banktypes.QueryBalanceResponse
+	require.NoError(t, balanceResp.Unmarshal(resp.GetValue()))
+	return balanceResp.GetBalance().Amount
+}
+
+func TestWorkload(t *testing.T) {
+	chainID := monomer.ChainID(0).String()
+	app := testapp.NewTest(t, chainID)
+	_, err := app.InitChain(context.Background(), &abcitypes.RequestInitChain{
+		ChainId: chainID,
+		AppStateBytes: func() []byte {
+			got, err := json.Marshal(app.DefaultGenesis())
+			require.NoError(t, err)
+			return got
+		}(),
+		InitialHeight: 1,
+	})
+	require.NoError(t, err)
+
+	sender := testapp.GetAccount(0).Address
+	const (
+		numWrites = 3
+		valueSize = 100
+	)
+	resp, err := app.FinalizeBlock(context.Background(), &abcitypes.RequestFinalizeBlock{
+		Txs: [][]byte{
+			testapp.ToWriteTx(t, sender, numWrites, valueSize),
+			testapp.ToWriteTx(t, sender, numWrites, valueSize),
+			testapp.ToComputeTx(t, sender, 1000),
+			testapp.ToWriteTx(t, sender, workloadtypes.MaxNumWrites+1, valueSize),
+		},
+		Height: 1,
+	})
+	require.NoError(t, err)
+	txResults := resp.GetTxResults()
+	require.Len(t, txResults, 4)
+	for _, txResult := range txResults[:3] {
+		require.True(t, txResult.IsOK(), txResult.GetLog())
+	}
+	require.False(t, txResults[3].IsOK())
+	_, err = app.Commit(context.Background(), &abcitypes.RequestCommit{})
+	require.NoError(t, err)
+
+	for i := range uint64(2 * numWrites) {
+		queryResp, err := app.Query(context.Background(), &abcitypes.RequestQuery{
+			Path: "/store/workload/key",
+			Data: workloadtypes.ValueKey(i),
+		})
+		require.NoError(t, err)
+		require.True(t, queryResp.IsOK(), queryResp.GetLog())
+		require.Len(t, queryResp.GetValue(), valueSize)
+	}
+	queryResp, err := app.Query(context.Background(), &abcitypes.RequestQuery{
+		Path: "/store/workload/key",
+		Data: workloadtypes.ValueKey(2 * numWrites),
+	})
+	require.NoError(t, err)
+	require.Empty(t, queryResp.GetValue())
+}
@@ -0,0 +1,25 @@
+package testapp
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polymerdao/monomer/testapp/x/workload/types"
+)
+
+// ToWriteTx creates a transaction that writes numWrites new values of valueSize bytes to the workload module's state.
+func ToWriteTx(t *testing.T, sender sdk.AccAddress, numWrites, valueSize uint64) []byte {
+	return ToTx(t, &types.MsgWrite{
+		FromAddress: sender.String(),
+		NumWrites:   numWrites,
+		ValueSize:   valueSize,
+	})
+}
+
+// ToComputeTx creates a transaction that performs iterations rounds of sha256 hashing.
+func ToComputeTx(t *testing.T, sender sdk.AccAddress, iterations uint64) []byte {
+	return ToTx(t, &types.MsgCompute{
+		FromAddress: sender.String(),
+		Iterations:  iterations,
+	})
+}
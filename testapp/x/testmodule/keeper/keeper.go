@@ -6,16 +6,20 @@ import (
 	"fmt"
 
 	"cosmossdk.io/core/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/polymerdao/monomer/testapp/x/testmodule/types"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
 )
 
 type Keeper struct {
 	storeService store.KVStoreService
+	bankKeeper   types.BankKeeper
 }
 
-func New(storeService store.KVStoreService) *Keeper {
+func New(storeService store.KVStoreService, bankKeeper types.BankKeeper) *Keeper {
 	return &Keeper{
 		storeService: storeService,
+		bankKeeper:   bankKeeper,
 	}
 }
 
@@ -56,3 +60,22 @@ func (m *Keeper) SetValue(ctx context.Context, req *types.MsgSetValue) (*types.M
 	}
 	return &types.MsgSetValueResponse{}, nil
 }
+
+// Faucet mints ETH to the recipient. It is only meant for tests and examples.
+func (m *Keeper) Faucet(ctx context.Context, req *types.MsgFaucet) (*types.MsgFaucetResponse, error) {
+	recipient, err := sdk.AccAddressFromBech32(req.GetToAddress())
+	if err != nil {
+		return nil, fmt.Errorf("parse recipient address: %v", err)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, errors.New("amount must be positive")
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(rolluptypes.ETH, req.Amount))
+	if err := m.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, fmt.Errorf("mint coins: %v", err)
+	}
+	if err := m.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return nil, fmt.Errorf("send coins to recipient: %v", err)
+	}
+	return &types.MsgFaucetResponse{}, nil
+}
@@ -26,6 +26,7 @@ type ModuleInputs struct {
 	depinject.In
 
 	StoreService store.KVStoreService
+	BankKeeper   types.BankKeeper
 }
 
 type ModuleOutputs struct {
@@ -40,7 +41,7 @@ func init() {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.New(in.StoreService)
+	k := keeper.New(in.StoreService, in.BankKeeper)
 	return ModuleOutputs{
 		Keeper: k,
 		Module: New(k),
@@ -48,7 +49,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 }
 
 const (
-	ModuleName = "testmodule"
+	ModuleName = types.ModuleName
 	StoreKey   = ModuleName
 )
 
@@ -0,0 +1,3 @@
+package types
+
+const ModuleName = "testmodule"
@@ -5,8 +5,12 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -126,33 +130,130 @@ func (m *MsgSetValueResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetValueResponse proto.InternalMessageInfo
 
+// MsgFaucet defines the Msg/Faucet request type for minting ETH to an account.
+type MsgFaucet struct {
+	// The cosmos address of the account receiving the ETH.
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// The amount of ETH (in wei) to mint.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgFaucet) Reset()         { *m = MsgFaucet{} }
+func (m *MsgFaucet) String() string { return proto.CompactTextString(m) }
+func (*MsgFaucet) ProtoMessage()    {}
+func (*MsgFaucet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68fb7859256a2e79, []int{2}
+}
+func (m *MsgFaucet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFaucet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFaucet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFaucet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFaucet.Merge(m, src)
+}
+func (m *MsgFaucet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFaucet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFaucet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFaucet proto.InternalMessageInfo
+
+func (m *MsgFaucet) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+// MsgFaucetResponse defines the Msg/Faucet response type.
+type MsgFaucetResponse struct {
+}
+
+func (m *MsgFaucetResponse) Reset()         { *m = MsgFaucetResponse{} }
+func (m *MsgFaucetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFaucetResponse) ProtoMessage()    {}
+func (*MsgFaucetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68fb7859256a2e79, []int{3}
+}
+func (m *MsgFaucetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFaucetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFaucetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFaucetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFaucetResponse.Merge(m, src)
+}
+func (m *MsgFaucetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFaucetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFaucetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFaucetResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetValue)(nil), "testapp.v1.MsgSetValue")
 	proto.RegisterType((*MsgSetValueResponse)(nil), "testapp.v1.MsgSetValueResponse")
+	proto.RegisterType((*MsgFaucet)(nil), "testapp.v1.MsgFaucet")
+	proto.RegisterType((*MsgFaucetResponse)(nil), "testapp.v1.MsgFaucetResponse")
 }
 
 func init() { proto.RegisterFile("testapp/v1/tx.proto", fileDescriptor_68fb7859256a2e79) }
 
 var fileDescriptor_68fb7859256a2e79 = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2e, 0x49, 0x2d, 0x2e,
-	0x49, 0x2c, 0x28, 0xd0, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0xe2, 0x82, 0x0a, 0xea, 0x95, 0x19, 0x4a, 0x89, 0x27, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb, 0xe7,
-	0x16, 0xa7, 0x83, 0xd4, 0xe4, 0x16, 0xa7, 0x43, 0x14, 0x29, 0x65, 0x73, 0x71, 0xfb, 0x16, 0xa7,
-	0x07, 0xa7, 0x96, 0x84, 0x25, 0xe6, 0x94, 0xa6, 0x0a, 0x29, 0x72, 0xf1, 0xa4, 0x15, 0xe5, 0xe7,
-	0xc6, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x71,
-	0x83, 0xc4, 0x1c, 0x21, 0x42, 0x42, 0x02, 0x5c, 0xcc, 0xd9, 0xa9, 0x95, 0x12, 0x4c, 0x60, 0x19,
-	0x10, 0x53, 0x48, 0x84, 0x8b, 0xb5, 0x0c, 0xa4, 0x5b, 0x82, 0x19, 0x2c, 0x06, 0xe1, 0x58, 0x09,
-	0x36, 0x3d, 0xdf, 0xa0, 0x85, 0x62, 0x9a, 0x92, 0x28, 0x97, 0x30, 0x92, 0x65, 0x41, 0xa9, 0xc5,
-	0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0x21, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9, 0x42, 0x6e, 0x5c, 0x1c,
-	0x70, 0x77, 0x88, 0xeb, 0x21, 0x1c, 0xaf, 0x87, 0xa4, 0x47, 0x4a, 0x1e, 0x87, 0x04, 0xcc, 0x30,
-	0x25, 0x06, 0x29, 0xd6, 0x86, 0xe7, 0x1b, 0xb4, 0x18, 0x9d, 0x82, 0x4e, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x22, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
-	0x57, 0xbf, 0x20, 0x3f, 0xa7, 0x32, 0x37, 0xb5, 0x28, 0x25, 0x31, 0x5f, 0x3f, 0x37, 0x3f, 0x2f,
-	0x3f, 0x37, 0xb5, 0x48, 0x1f, 0x16, 0x96, 0x15, 0x60, 0x56, 0x6e, 0x7e, 0x4a, 0x69, 0x4e, 0xaa,
-	0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xd0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x14, 0xa5, 0xb2, 0x45, 0x70, 0x01, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x44, 0x8d, 0xc8, 0x2b, 0x12, 0xc4, 0x49, 0x54, 0x63, 0x09, 0x07, 0x3c, 0xa1,
+	0x4a, 0xf5, 0x51, 0x18, 0x40, 0x9d, 0x20, 0x43, 0x45, 0x87, 0x2c, 0xae, 0xc4, 0xc0, 0x52, 0x5d,
+	0xe3, 0xe3, 0x6a, 0x25, 0xe7, 0x67, 0xf9, 0xce, 0x51, 0xb3, 0x21, 0x3e, 0x01, 0x13, 0x2b, 0x2b,
+	0x63, 0x87, 0x7e, 0x88, 0x8e, 0x55, 0x27, 0xc4, 0x50, 0xa1, 0x64, 0xe8, 0xd7, 0x40, 0xf6, 0x5d,
+	0xd2, 0x14, 0xc1, 0x62, 0xbd, 0xf7, 0xff, 0xfb, 0x3d, 0xff, 0xde, 0xf3, 0x83, 0x8e, 0xe6, 0x4a,
+	0xb3, 0x3c, 0xa7, 0xd3, 0x5d, 0xaa, 0x4f, 0xa3, 0xbc, 0x40, 0x8d, 0x2e, 0x58, 0x31, 0x9a, 0xee,
+	0xfa, 0x6d, 0x26, 0xd3, 0x0c, 0x69, 0xfd, 0x34, 0xb6, 0xbf, 0x35, 0x42, 0x25, 0x51, 0x51, 0xa9,
+	0x44, 0x55, 0x26, 0x95, 0xb0, 0xc6, 0x63, 0x63, 0x1c, 0xd5, 0x19, 0x35, 0x89, 0xb5, 0xba, 0x02,
+	0x05, 0x1a, 0xbd, 0x8a, 0x8c, 0x1a, 0x8e, 0x61, 0x73, 0xa8, 0xc4, 0x21, 0xd7, 0x1f, 0xd8, 0xa4,
+	0xe4, 0xee, 0x33, 0x78, 0xf0, 0xa9, 0x40, 0x79, 0xc4, 0x92, 0xa4, 0xe0, 0x4a, 0x79, 0xe4, 0x29,
+	0x79, 0xde, 0x8a, 0x37, 0x2b, 0xed, 0x9d, 0x91, 0xdc, 0x47, 0xd0, 0x18, 0xf3, 0x99, 0x77, 0xaf,
+	0x76, 0xaa, 0xd0, 0xed, 0xc2, 0xc6, 0xb4, 0xaa, 0xf6, 0x1a, 0xb5, 0x66, 0x92, 0xbd, 0xf6, 0x97,
+	0x9b, 0xb3, 0xed, 0x3b, 0xdd, 0xc2, 0x1e, 0x74, 0xd6, 0x3e, 0x16, 0x73, 0x95, 0x63, 0xa6, 0x78,
+	0xf8, 0x9d, 0x40, 0x6b, 0xa8, 0xc4, 0x3e, 0x2b, 0x47, 0x5c, 0xbb, 0xaf, 0x01, 0x34, 0xde, 0x05,
+	0x18, 0x78, 0x57, 0xe7, 0x3b, 0x5d, 0x3b, 0x8d, 0xe5, 0x38, 0xd4, 0x45, 0x9a, 0x89, 0xb8, 0xa5,
+	0x71, 0x09, 0xf6, 0x1e, 0x9a, 0x4c, 0x62, 0x99, 0x69, 0xc3, 0x36, 0x78, 0x71, 0x71, 0xdd, 0x77,
+	0x7e, 0x5d, 0xf7, 0x7b, 0xa6, 0x50, 0x25, 0xe3, 0x28, 0x45, 0x2a, 0x99, 0x3e, 0x89, 0x0e, 0x32,
+	0x7d, 0x75, 0xbe, 0x03, 0xb6, 0xe3, 0x41, 0xa6, 0x7f, 0xdc, 0x9c, 0x6d, 0x93, 0xd8, 0xd6, 0xef,
+	0x3d, 0xac, 0xd0, 0xd7, 0x28, 0xc2, 0x0e, 0xb4, 0x57, 0x80, 0x4b, 0xec, 0x97, 0xdf, 0x08, 0x34,
+	0x86, 0x4a, 0xb8, 0xfb, 0x70, 0x7f, 0xb5, 0xbf, 0xad, 0xe8, 0xf6, 0xc7, 0x45, 0x6b, 0xb3, 0xfa,
+	0xfd, 0xff, 0x18, 0xab, 0x25, 0x38, 0xee, 0x5b, 0x68, 0xda, 0x15, 0xf4, 0xfe, 0x7a, 0xd9, 0xc8,
+	0xfe, 0x93, 0x7f, 0xca, 0xb7, 0x1d, 0xfc, 0x8d, 0xcf, 0xd5, 0x18, 0x83, 0xf8, 0x62, 0x1e, 0x90,
+	0xcb, 0x79, 0x40, 0x7e, 0xcf, 0x03, 0xf2, 0x75, 0x11, 0x38, 0x97, 0x8b, 0xc0, 0xf9, 0xb9, 0x08,
+	0x9c, 0x8f, 0x6f, 0x44, 0xaa, 0x4f, 0xca, 0xe3, 0x68, 0x84, 0x92, 0xe6, 0x38, 0x99, 0x49, 0x5e,
+	0x24, 0x0c, 0xa9, 0xc4, 0x0c, 0x25, 0x2f, 0xe8, 0xf2, 0x12, 0x4f, 0xeb, 0x48, 0x62, 0x52, 0x4e,
+	0x38, 0xd5, 0xb3, 0x9c, 0xab, 0xe3, 0x66, 0x7d, 0x2e, 0xaf, 0xfe, 0x0c, 0x00, 0xec, 0xfc, 0xc6,
+	0xc6, 0xae, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -169,6 +270,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// SetValue defines a method for setting a key-value pair.
 	SetValue(ctx context.Context, in *MsgSetValue, opts ...grpc.CallOption) (*MsgSetValueResponse, error)
+	// Faucet defines a method for minting ETH to an account.
+	Faucet(ctx context.Context, in *MsgFaucet, opts ...grpc.CallOption) (*MsgFaucetResponse, error)
 }
 
 type msgClient struct {
@@ -188,10 +291,21 @@ func (c *msgClient) SetValue(ctx context.Context, in *MsgSetValue, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) Faucet(ctx context.Context, in *MsgFaucet, opts ...grpc.CallOption) (*MsgFaucetResponse, error) {
+	out := new(MsgFaucetResponse)
+	err := c.cc.Invoke(ctx, "/testapp.v1.Msg/Faucet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetValue defines a method for setting a key-value pair.
 	SetValue(context.Context, *MsgSetValue) (*MsgSetValueResponse, error)
+	// Faucet defines a method for minting ETH to an account.
+	Faucet(context.Context, *MsgFaucet) (*MsgFaucetResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -201,6 +315,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SetValue(ctx context.Context, req *MsgSetValue) (*MsgSetValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetValue not implemented")
 }
+func (*UnimplementedMsgServer) Faucet(ctx context.Context, req *MsgFaucet) (*MsgFaucetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Faucet not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -224,6 +341,24 @@ func _Msg_SetValue_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Faucet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFaucet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Faucet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/testapp.v1.Msg/Faucet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Faucet(ctx, req.(*MsgFaucet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "testapp.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -232,6 +367,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetValue",
 			Handler:    _Msg_SetValue_Handler,
 		},
+		{
+			MethodName: "Faucet",
+			Handler:    _Msg_Faucet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "testapp/v1/tx.proto",
@@ -304,6 +443,69 @@ func (m *MsgSetValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFaucet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFaucet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFaucet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFaucetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFaucetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFaucetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -345,6 +547,30 @@ func (m *MsgSetValueResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFaucet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFaucetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -547,6 +773,172 @@ func (m *MsgSetValueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFaucet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFaucet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFaucet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFaucetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFaucetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFaucetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"github.com/polymerdao/monomer/testapp/x/workload/types"
+)
+
+type Keeper struct {
+	storeService store.KVStoreService
+}
+
+func New(storeService store.KVStoreService) *Keeper {
+	return &Keeper{
+		storeService: storeService,
+	}
+}
+
+// Write appends NumWrites new values of ValueSize bytes to the store, so the state grows with every message.
+// Values are derived from their index by hashing, so they are deterministic but not compressible.
+func (k *Keeper) Write(ctx context.Context, req *types.MsgWrite) (*types.MsgWriteResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	kvStore := k.storeService.OpenKVStore(ctx)
+	totalWritesBytes, err := kvStore.Get(types.TotalWritesKey)
+	if err != nil {
+		return nil, fmt.Errorf("get total writes: %v", err)
+	}
+	var totalWrites uint64
+	if totalWritesBytes != nil {
+		totalWrites = binary.BigEndian.Uint64(totalWritesBytes)
+	}
+
+	for range req.GetNumWrites() {
+		if err := kvStore.Set(types.ValueKey(totalWrites), makeValue(totalWrites, req.GetValueSize())); err != nil {
+			return nil, fmt.Errorf("set value %d: %v", totalWrites, err)
+		}
+		totalWrites++
+	}
+
+	if err := kvStore.Set(types.TotalWritesKey, binary.BigEndian.AppendUint64(nil, totalWrites)); err != nil {
+		return nil, fmt.Errorf("set total writes: %v", err)
+	}
+	return &types.MsgWriteResponse{
+		TotalWrites: totalWrites,
+	}, nil
+}
+
+// Compute hashes the sender's address Iterations times. It does not touch the store.
+func (k *Keeper) Compute(_ context.Context, req *types.MsgCompute) (*types.MsgComputeResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256([]byte(req.GetFromAddress()))
+	for range req.GetIterations() {
+		hash = sha256.Sum256(hash[:])
+	}
+	return &types.MsgComputeResponse{
+		Hash: hash[:],
+	}, nil
+}
+
+func makeValue(index, size uint64) []byte {
+	value := make([]byte, 0, size+sha256.Size)
+	hash := sha256.Sum256(binary.BigEndian.AppendUint64(nil, index))
+	for uint64(len(value)) < size {
+		value = append(value, hash[:]...)
+		hash = sha256.Sum256(hash[:])
+	}
+	return value[:size]
+}
@@ -0,0 +1,84 @@
+package workload
+
+import (
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/polymerdao/monomer/gen/workload/module/v1"
+	"github.com/polymerdao/monomer/testapp/x/workload/keeper"
+	"github.com/polymerdao/monomer/testapp/x/workload/types"
+)
+
+type ModuleInputs struct {
+	depinject.In
+
+	StoreService store.KVStoreService
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	Keeper *keeper.Keeper
+	Module appmodule.AppModule
+}
+
+func init() {
+	appmodule.Register(&modulev1.Module{}, appmodule.Provide(ProvideModule))
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	k := keeper.New(in.StoreService)
+	return ModuleOutputs{
+		Keeper: k,
+		Module: New(k),
+	}
+}
+
+const (
+	ModuleName = types.ModuleName
+	StoreKey   = ModuleName
+)
+
+// Module generates configurable load for benchmarks: state growth with MsgWrite and CPU-heavy execution with MsgCompute.
+type Module struct {
+	keeper *keeper.Keeper
+}
+
+var (
+	_ module.AppModule   = (*Module)(nil)
+	_ module.HasServices = (*Module)(nil)
+)
+
+func New(k *keeper.Keeper) *Module {
+	return &Module{
+		keeper: k,
+	}
+}
+
+func (m *Module) IsOnePerModuleType() {}
+
+func (m *Module) IsAppModule() {}
+
+func (*Module) Name() string {
+	return ModuleName
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
+func (*Module) RegisterGRPCGatewayRoutes(_ client.Context, _ *grpcruntime.ServeMux) {
+}
+
+// RegisterInterfaces registers the module's interface types
+func (*Module) RegisterInterfaces(r codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(r)
+}
+
+func (*Module) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+
+func (m *Module) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), m.keeper)
+}
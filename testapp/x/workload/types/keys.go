@@ -0,0 +1,17 @@
+package types
+
+import "encoding/binary"
+
+const ModuleName = "workload"
+
+var (
+	// TotalWritesKey stores the number of values written by the module.
+	TotalWritesKey = []byte{0x00}
+	// ValuePrefix prefixes the values written by the module. Values are keyed by their big-endian write index.
+	ValuePrefix = []byte{0x01}
+)
+
+// ValueKey returns the key of the index-th value written by the module.
+func ValueKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, ValuePrefix...), index)
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The limits keep a single message from stalling block building. Larger workloads can be split across messages.
+const (
+	MaxNumWrites  = 10_000
+	MaxValueSize  = 64 * 1024
+	MaxIterations = 10_000_000
+)
+
+var (
+	_ sdktypes.Msg = (*MsgWrite)(nil)
+	_ sdktypes.Msg = (*MsgCompute)(nil)
+)
+
+func (m *MsgWrite) ValidateBasic() error {
+	if m.NumWrites > MaxNumWrites {
+		return fmt.Errorf("number of writes %d exceeds maximum %d", m.NumWrites, MaxNumWrites)
+	}
+	if m.ValueSize > MaxValueSize {
+		return fmt.Errorf("value size %d exceeds maximum %d", m.ValueSize, MaxValueSize)
+	}
+	return nil
+}
+
+func (m *MsgCompute) ValidateBasic() error {
+	if m.Iterations > MaxIterations {
+		return fmt.Errorf("iterations %d exceed maximum %d", m.Iterations, MaxIterations)
+	}
+	return nil
+}
@@ -0,0 +1,1061 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: workload/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgWrite defines the Msg/Write request type for writing num_writes new values of value_size bytes to the store.
+type MsgWrite struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	NumWrites   uint64 `protobuf:"varint,2,opt,name=num_writes,json=numWrites,proto3" json:"num_writes,omitempty"`
+	ValueSize   uint64 `protobuf:"varint,3,opt,name=value_size,json=valueSize,proto3" json:"value_size,omitempty"`
+}
+
+func (m *MsgWrite) Reset()         { *m = MsgWrite{} }
+func (m *MsgWrite) String() string { return proto.CompactTextString(m) }
+func (*MsgWrite) ProtoMessage()    {}
+func (*MsgWrite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6200284ce9d1fae1, []int{0}
+}
+func (m *MsgWrite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrite.Merge(m, src)
+}
+func (m *MsgWrite) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrite) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrite proto.InternalMessageInfo
+
+func (m *MsgWrite) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgWrite) GetNumWrites() uint64 {
+	if m != nil {
+		return m.NumWrites
+	}
+	return 0
+}
+
+func (m *MsgWrite) GetValueSize() uint64 {
+	if m != nil {
+		return m.ValueSize
+	}
+	return 0
+}
+
+// MsgWriteResponse defines the Msg/Write response type.
+type MsgWriteResponse struct {
+	// The total number of values written by the module, including this message's writes.
+	TotalWrites uint64 `protobuf:"varint,1,opt,name=total_writes,json=totalWrites,proto3" json:"total_writes,omitempty"`
+}
+
+func (m *MsgWriteResponse) Reset()         { *m = MsgWriteResponse{} }
+func (m *MsgWriteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWriteResponse) ProtoMessage()    {}
+func (*MsgWriteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6200284ce9d1fae1, []int{1}
+}
+func (m *MsgWriteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWriteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWriteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWriteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWriteResponse.Merge(m, src)
+}
+func (m *MsgWriteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWriteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWriteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWriteResponse proto.InternalMessageInfo
+
+func (m *MsgWriteResponse) GetTotalWrites() uint64 {
+	if m != nil {
+		return m.TotalWrites
+	}
+	return 0
+}
+
+// MsgCompute defines the Msg/Compute request type for hashing a value repeatedly.
+type MsgCompute struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Iterations  uint64 `protobuf:"varint,2,opt,name=iterations,proto3" json:"iterations,omitempty"`
+}
+
+func (m *MsgCompute) Reset()         { *m = MsgCompute{} }
+func (m *MsgCompute) String() string { return proto.CompactTextString(m) }
+func (*MsgCompute) ProtoMessage()    {}
+func (*MsgCompute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6200284ce9d1fae1, []int{2}
+}
+func (m *MsgCompute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCompute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompute.Merge(m, src)
+}
+func (m *MsgCompute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCompute proto.InternalMessageInfo
+
+func (m *MsgCompute) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgCompute) GetIterations() uint64 {
+	if m != nil {
+		return m.Iterations
+	}
+	return 0
+}
+
+// MsgComputeResponse defines the Msg/Compute response type.
+type MsgComputeResponse struct {
+	// The result of the final iteration.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *MsgComputeResponse) Reset()         { *m = MsgComputeResponse{} }
+func (m *MsgComputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgComputeResponse) ProtoMessage()    {}
+func (*MsgComputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6200284ce9d1fae1, []int{3}
+}
+func (m *MsgComputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgComputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgComputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgComputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgComputeResponse.Merge(m, src)
+}
+func (m *MsgComputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgComputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgComputeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgComputeResponse proto.InternalMessageInfo
+
+func (m *MsgComputeResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgWrite)(nil), "workload.v1.MsgWrite")
+	proto.RegisterType((*MsgWriteResponse)(nil), "workload.v1.MsgWriteResponse")
+	proto.RegisterType((*MsgCompute)(nil), "workload.v1.MsgCompute")
+	proto.RegisterType((*MsgComputeResponse)(nil), "workload.v1.MsgComputeResponse")
+}
+
+func init() { proto.RegisterFile("workload/v1/tx.proto", fileDescriptor_6200284ce9d1fae1) }
+
+var fileDescriptor_6200284ce9d1fae1 = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xae, 0xd2, 0x40,
+	0x18, 0xc5, 0x3b, 0x02, 0x2a, 0x03, 0x0b, 0x6d, 0x34, 0x90, 0x26, 0x54, 0xec, 0x8a, 0xb0, 0xe8,
+	0x04, 0x8d, 0x2e, 0xdc, 0x18, 0x35, 0x2e, 0x49, 0x4c, 0x5d, 0x98, 0xb8, 0x21, 0x03, 0x1d, 0x4b,
+	0x63, 0xa7, 0x5f, 0x33, 0xdf, 0x94, 0x7f, 0x0b, 0x63, 0x7c, 0x02, 0x17, 0x3e, 0x08, 0x8f, 0xe1,
+	0x92, 0xa5, 0x4b, 0x03, 0x0b, 0x5e, 0xc3, 0x30, 0xb5, 0xc0, 0xbd, 0xe1, 0x26, 0x77, 0x37, 0xf9,
+	0x9d, 0x93, 0x73, 0xce, 0x4c, 0x86, 0x3e, 0x9a, 0x83, 0xfa, 0x9a, 0x00, 0x0f, 0xd9, 0x6c, 0xc0,
+	0xf4, 0xc2, 0xcf, 0x14, 0x68, 0xb0, 0x1b, 0x25, 0xf5, 0x67, 0x03, 0xa7, 0x35, 0x01, 0x94, 0x80,
+	0x4c, 0x62, 0x74, 0x30, 0x49, 0x8c, 0x0a, 0x97, 0xf7, 0x8d, 0xde, 0x1f, 0x62, 0xf4, 0x49, 0xc5,
+	0x5a, 0xd8, 0x4f, 0x69, 0xf3, 0x8b, 0x02, 0x39, 0xe2, 0x61, 0xa8, 0x04, 0x62, 0x9b, 0x74, 0x49,
+	0xaf, 0x1e, 0x34, 0x0e, 0xec, 0x4d, 0x81, 0xec, 0x0e, 0xa5, 0x69, 0x2e, 0x47, 0xf3, 0x83, 0x1f,
+	0xdb, 0x77, 0xba, 0xa4, 0x57, 0x0d, 0xea, 0x69, 0x2e, 0x4d, 0x80, 0x91, 0x67, 0x3c, 0xc9, 0xc5,
+	0x08, 0xe3, 0x95, 0x68, 0x57, 0x0a, 0xd9, 0x90, 0x8f, 0xf1, 0x4a, 0xbc, 0x7a, 0xf8, 0x63, 0xbf,
+	0xee, 0x5f, 0xe9, 0xf0, 0x5e, 0xd0, 0x07, 0x65, 0x7f, 0x20, 0x30, 0x83, 0x14, 0xcd, 0x0e, 0x0d,
+	0x9a, 0x27, 0x65, 0x0d, 0x31, 0x39, 0x0d, 0xc3, 0x8a, 0x22, 0x6f, 0x4c, 0xe9, 0x10, 0xa3, 0x77,
+	0x20, 0xb3, 0xfc, 0x76, 0xc3, 0x5d, 0x4a, 0x63, 0x2d, 0x14, 0xd7, 0x31, 0xa4, 0xe5, 0xf0, 0x33,
+	0x72, 0x69, 0x5a, 0x8f, 0xda, 0xa7, 0x8e, 0xe3, 0x38, 0x9b, 0x56, 0xa7, 0x1c, 0xa7, 0xa6, 0xa3,
+	0x19, 0x98, 0xf3, 0xb3, 0x5f, 0x84, 0x56, 0x86, 0x18, 0xd9, 0xaf, 0x69, 0xad, 0x78, 0xc9, 0xc7,
+	0xfe, 0xd9, 0xe3, 0xfb, 0xe5, 0x05, 0x9d, 0xce, 0x45, 0x5c, 0x46, 0x7b, 0x96, 0xfd, 0x9e, 0xde,
+	0x2b, 0xef, 0xd4, 0xba, 0xee, 0xfd, 0x2f, 0x38, 0x4f, 0x6e, 0x10, 0x4e, 0x31, 0x4e, 0xed, 0xfb,
+	0x7e, 0xdd, 0x27, 0x6f, 0x3f, 0xfc, 0xde, 0xba, 0x64, 0xb3, 0x75, 0xc9, 0xdf, 0xad, 0x4b, 0x7e,
+	0xee, 0x5c, 0x6b, 0xb3, 0x73, 0xad, 0x3f, 0x3b, 0xd7, 0xfa, 0xfc, 0x32, 0x8a, 0xf5, 0x34, 0x1f,
+	0xfb, 0x13, 0x90, 0x2c, 0x83, 0x64, 0x29, 0x85, 0x0a, 0x39, 0x30, 0x09, 0x29, 0x48, 0xa1, 0x98,
+	0x16, 0xa8, 0x79, 0x96, 0xb1, 0x05, 0x3b, 0xfe, 0x2c, 0xbd, 0xcc, 0x04, 0x8e, 0xef, 0x9a, 0x4f,
+	0xf3, 0xfc, 0xdf, 0x00, 0xa0, 0xa6, 0xce, 0x91, 0x72, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Write defines a method for growing the module's state.
+	Write(ctx context.Context, in *MsgWrite, opts ...grpc.CallOption) (*MsgWriteResponse, error)
+	// Compute defines a method for performing CPU-heavy work.
+	Compute(ctx context.Context, in *MsgCompute, opts ...grpc.CallOption) (*MsgComputeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Write(ctx context.Context, in *MsgWrite, opts ...grpc.CallOption) (*MsgWriteResponse, error) {
+	out := new(MsgWriteResponse)
+	err := c.cc.Invoke(ctx, "/workload.v1.Msg/Write", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Compute(ctx context.Context, in *MsgCompute, opts ...grpc.CallOption) (*MsgComputeResponse, error) {
+	out := new(MsgComputeResponse)
+	err := c.cc.Invoke(ctx, "/workload.v1.Msg/Compute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Write defines a method for growing the module's state.
+	Write(context.Context, *MsgWrite) (*MsgWriteResponse, error)
+	// Compute defines a method for performing CPU-heavy work.
+	Compute(context.Context, *MsgCompute) (*MsgComputeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Write(ctx context.Context, req *MsgWrite) (*MsgWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
+}
+func (*UnimplementedMsgServer) Compute(ctx context.Context, req *MsgCompute) (*MsgComputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compute not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWrite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Write(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workload.v1.Msg/Write",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Write(ctx, req.(*MsgWrite))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Compute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Compute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/workload.v1.Msg/Compute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Compute(ctx, req.(*MsgCompute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "workload.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Write",
+			Handler:    _Msg_Write_Handler,
+		},
+		{
+			MethodName: "Compute",
+			Handler:    _Msg_Compute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "workload/v1/tx.proto",
+}
+
+func (m *MsgWrite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValueSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValueSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumWrites != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumWrites))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalWrites != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalWrites))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCompute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Iterations != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Iterations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgComputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgComputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgComputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWrite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NumWrites != 0 {
+		n += 1 + sovTx(uint64(m.NumWrites))
+	}
+	if m.ValueSize != 0 {
+		n += 1 + sovTx(uint64(m.ValueSize))
+	}
+	return n
+}
+
+func (m *MsgWriteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalWrites != 0 {
+		n += 1 + sovTx(uint64(m.TotalWrites))
+	}
+	return n
+}
+
+func (m *MsgCompute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Iterations != 0 {
+		n += 1 + sovTx(uint64(m.Iterations))
+	}
+	return n
+}
+
+func (m *MsgComputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWrite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWrites", wireType)
+			}
+			m.NumWrites = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWrites |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSize", wireType)
+			}
+			m.ValueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWrites", wireType)
+			}
+			m.TotalWrites = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalWrites |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCompute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCompute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCompute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iterations", wireType)
+			}
+			m.Iterations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iterations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgComputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgComputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgComputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,164 @@
+package testutils
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+
+	bftclient "github.com/cometbft/cometbft/rpc/client/http"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/environment"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/monomerdb/localdb"
+	"github.com/polymerdao/monomer/node"
+	"github.com/stretchr/testify/require"
+)
+
+const instantNodeGasLimit = 1_000_000_000_000
+
+// InstantNode runs the Monomer engine, builder, and RPC servers in-memory and produces blocks on demand.
+// It replaces op-node and the L1 in tests: every block contains a synthetic L1 attributes transaction.
+type InstantNode struct {
+	t           *testing.T
+	blockdb     *localdb.DB
+	engine      *rpc.Client
+	comet       *bftclient.HTTP
+	engineAddr  string
+	cometAddr   string
+	l2ChainID   *big.Int
+	l1Block     *gethtypes.Block
+	sequenceNum uint64
+}
+
+// NewInstantNode starts an in-memory node for app. The node is stopped when the test finishes.
+func NewInstantNode(t *testing.T, app monomer.Application, g *genesis.Genesis) *InstantNode {
+	engineWS, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cometListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	blockdb := NewLocalMemDB(t)
+
+	env := environment.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, env.Close())
+	})
+	require.NoError(t, node.New(app, g, &node.Config{
+		EngineListener: engineWS,
+		CometListener:  cometListener,
+		BlockDB:        blockdb,
+		EventListener: &node.SelectiveListener{
+			OnEngineWebsocketServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+			OnCometServeErrCb: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}).Start(ctx, env))
+
+	engineClient, err := rpc.DialContext(ctx, "ws://"+engineWS.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(engineClient.Close)
+	cometClient, err := bftclient.New("http://"+cometListener.Addr().String(), "/websocket")
+	require.NoError(t, err)
+
+	return &InstantNode{
+		t:          t,
+		blockdb:    blockdb,
+		engine:     engineClient,
+		comet:      cometClient,
+		engineAddr: engineWS.Addr().String(),
+		cometAddr:  cometListener.Addr().String(),
+		l2ChainID:  g.ChainID.Big(),
+		l1Block:    GenerateL1Block(),
+	}
+}
+
+// EngineAddr returns the address of the Engine API and eth namespace websocket server.
+func (n *InstantNode) EngineAddr() string {
+	return n.engineAddr
+}
+
+// CometAddr returns the address of the CometBFT-compatible RPC server.
+func (n *InstantNode) CometAddr() string {
+	return n.cometAddr
+}
+
+// Head returns the header of the latest block.
+func (n *InstantNode) Head() *monomer.Header {
+	header, err := n.blockdb.HeadHeader()
+	require.NoError(n.t, err)
+	return header
+}
+
+// SubmitTx adds tx to the mempool and builds a block containing it.
+func (n *InstantNode) SubmitTx(tx bfttypes.Tx) *monomer.Block {
+	result, err := n.comet.BroadcastTxSync(context.Background(), tx)
+	require.NoError(n.t, err)
+	require.Zero(n.t, result.Code, result.Log)
+	return n.BuildBlock()
+}
+
+// BuildBlock builds a block with the transactions in the mempool, preceded by the deposit transactions.
+// The new block becomes the unsafe, safe, and finalized head.
+func (n *InstantNode) BuildBlock(depositTxs ...*gethtypes.Transaction) *monomer.Block {
+	ctx := context.Background()
+	head := n.Head()
+	timestamp := head.Time + 1
+
+	l1InfoRawTx, err := derive.L1InfoDeposit(&rollup.Config{
+		Genesis:   rollup.Genesis{L2: eth.BlockID{Number: 0}},
+		L2ChainID: n.l2ChainID,
+	}, eth.SystemConfig{}, n.sequenceNum, eth.BlockToInfo(n.l1Block), timestamp)
+	require.NoError(n.t, err)
+	n.sequenceNum++
+	txs := []hexutil.Bytes{TxToBytes(n.t, gethtypes.NewTx(l1InfoRawTx))}
+	for _, depositTx := range depositTxs {
+		txs = append(txs, TxToBytes(n.t, depositTx))
+	}
+
+	gasLimit := hexutil.Uint64(instantNodeGasLimit)
+	var fcuResult eth.ForkchoiceUpdatedResult
+	payloadAttributes := &eth.PayloadAttributes{
+		Timestamp:             hexutil.Uint64(timestamp),
+		Transactions:          txs,
+		GasLimit:              &gasLimit,
+		ParentBeaconBlockRoot: &common.Hash{},
+	}
+	require.NoError(n.t, n.engine.CallContext(ctx, &fcuResult, "engine_forkchoiceUpdatedV3", forkchoiceState(head.Hash), payloadAttributes))
+	require.Equal(n.t, eth.ExecutionValid, fcuResult.PayloadStatus.Status)
+	require.NotNil(n.t, fcuResult.PayloadID)
+
+	var envelope eth.ExecutionPayloadEnvelope
+	require.NoError(n.t, n.engine.CallContext(ctx, &envelope, "engine_getPayloadV3", fcuResult.PayloadID))
+	var payloadStatus eth.PayloadStatusV1
+	require.NoError(n.t, n.engine.CallContext(ctx, &payloadStatus, "engine_newPayloadV3", envelope.ExecutionPayload, []common.Hash{},
+		envelope.ParentBeaconBlockRoot))
+	require.Equal(n.t, eth.ExecutionValid, payloadStatus.Status)
+	blockHash := envelope.ExecutionPayload.BlockHash
+	require.NoError(n.t, n.engine.CallContext(ctx, &fcuResult, "engine_forkchoiceUpdatedV3", forkchoiceState(blockHash), nil))
+	require.Equal(n.t, eth.ExecutionValid, fcuResult.PayloadStatus.Status)
+
+	block, err := n.blockdb.BlockByHash(blockHash)
+	require.NoError(n.t, err)
+	return block
+}
+
+func forkchoiceState(head common.Hash) *eth.ForkchoiceState {
+	return &eth.ForkchoiceState{
+		HeadBlockHash:      head,
+		SafeBlockHash:      head,
+		FinalizedBlockHash: head,
+	}
+}
@@ -0,0 +1,31 @@
+package testutils_test
+
+import (
+	"testing"
+
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/genesis"
+	"github.com/polymerdao/monomer/testapp"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstantNode(t *testing.T) {
+	chainID := monomer.ChainID(1)
+	app := testapp.NewTest(t, chainID.String())
+	n := testutils.NewInstantNode(t, app, &genesis.Genesis{
+		ChainID:  chainID,
+		AppState: testapp.MakeGenesisAppState(t, app),
+	})
+	require.Equal(t, uint64(1), n.Head().Height)
+
+	block := n.BuildBlock()
+	require.Equal(t, uint64(2), block.Header.Height)
+	require.Equal(t, block.Header.Hash, n.Head().Hash)
+
+	kvs := map[string]string{"k": "v"}
+	block = n.SubmitTx(testapp.ToTestTx(t, "k", "v"))
+	require.Equal(t, uint64(3), block.Header.Height)
+	require.Len(t, block.Txs, 2)
+	app.StateContains(t, block.Header.Height, kvs)
+}
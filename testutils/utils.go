@@ -1,10 +1,8 @@
 package testutils
 
 import (
-	"fmt"
 	"math/big"
 	"math/rand"
-	"strings"
 	"testing"
 
 	"github.com/cockroachdb/pebble"
@@ -12,17 +10,11 @@ import (
 	cometdb "github.com/cometbft/cometbft-db"
 	bfttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
-	"github.com/cosmos/gogoproto/proto"
-	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
-	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -30,7 +22,10 @@ import (
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/bindings"
 	"github.com/polymerdao/monomer/monomerdb/localdb"
+	"github.com/polymerdao/monomer/utils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -92,41 +87,50 @@ func GenerateEthTxs(t *testing.T) (*gethtypes.Transaction, *gethtypes.Transactio
 	return l1InfoTx, depositTx, cosmosEthTx
 }
 
-func GenerateERC20DepositTx(t *testing.T, tokenAddr, userAddr common.Address, amount *big.Int) *gethtypes.Transaction {
-	crossDomainMessengerABI, err := abi.JSON(strings.NewReader(opbindings.CrossDomainMessengerMetaData.ABI))
-	require.NoError(t, err)
-	standardBridgeABI, err := abi.JSON(strings.NewReader(opbindings.StandardBridgeMetaData.ABI))
+// GenerateEcotoneL1InfoTx generates an L1 attributes tx in the Ecotone format for the block GenerateL1Block returns.
+func GenerateEcotoneL1InfoTx(t *testing.T) *gethtypes.Transaction {
+	l1Block := GenerateL1Block()
+	const l2BlockTime = 2
+	l1InfoRawTx, err := derive.L1InfoDeposit(&rollup.Config{
+		Genesis:     rollup.Genesis{L2: eth.BlockID{Number: 0}},
+		L2ChainID:   big.NewInt(1234),
+		BlockTime:   l2BlockTime,
+		EcotoneTime: utils.Ptr(uint64(0)),
+	}, eth.SystemConfig{}, 0, eth.BlockToInfo(l1Block), l2BlockTime) // The first block after the Ecotone activation block.
 	require.NoError(t, err)
+	return gethtypes.NewTx(l1InfoRawTx)
+}
+
+// L1StandardBridge is the L1StandardBridge GenerateERC20DepositTx relays deposits from. Rollup modules must have it in
+// their params to accept the deposits.
+var L1StandardBridge = common.HexToAddress("0x0b1d6e")
 
+func GenerateERC20DepositTx(t *testing.T, tokenAddr, userAddr common.Address, amount *big.Int) *gethtypes.Transaction {
 	rng := rand.New(rand.NewSource(1234))
 
-	finalizeBridgeERC20Bz, err := standardBridgeABI.Pack(
-		"finalizeBridgeERC20",
-		tokenAddr,                    // l1 token address
-		testutils.RandomAddress(rng), // l2 token address
-		testutils.RandomAddress(rng), // from
-		userAddr,                     // to
-		amount,                       // amount
-		[]byte{},                     // extra data
-	)
+	finalizeBridgeERC20Bz, err := (&bindings.FinalizeBridgeERC20Args{
+		RemoteToken: tokenAddr,                    // l1 token address
+		LocalToken:  testutils.RandomAddress(rng), // l2 token address
+		From:        testutils.RandomAddress(rng),
+		To:          userAddr,
+		Amount:      amount,
+		ExtraData:   []byte{},
+	}).Pack()
 	require.NoError(t, err)
 
-	relayMessageBz, err := crossDomainMessengerABI.Pack(
-		"relayMessage",
-		big.NewInt(0),                // nonce
-		testutils.RandomAddress(rng), // sender
-		testutils.RandomAddress(rng), // target
-		amount,                       // value
-		big.NewInt(0),                // min gas limit
-		finalizeBridgeERC20Bz,        // message
-	)
+	relayMessageBz, err := (&bindings.RelayMessageArgs{
+		Nonce:       big.NewInt(0),
+		Sender:      L1StandardBridge,
+		Target:      predeploys.L2StandardBridgeAddr,
+		Value:       amount,
+		MinGasLimit: big.NewInt(0),
+		Message:     finalizeBridgeERC20Bz,
+	}).Pack()
 	require.NoError(t, err)
 
 	to := testutils.RandomAddress(rng)
 	depositTx := &gethtypes.DepositTx{
-		// TODO: remove hardcoded address once a genesis state is configured
-		// L2 aliased L1CrossDomainMessenger proxy address
-		From: crossdomain.ApplyL1ToL2Alias(common.HexToAddress("0x9A9f2CCfdE556A7E9Ff0848998Aa4a0CFD8863AE")),
+		From: rolluptypes.AliasedL1CrossDomainMessengerAddress,
 		To:   &to,
 		Data: relayMessageBz,
 	}
@@ -153,7 +157,7 @@ func cosmosTxsFromEthTxs(t *testing.T, l1InfoTx *gethtypes.Transaction, depositT
 		require.NoError(t, err)
 		ethTxBytes = append(ethTxBytes, cosmosEthTxBytes)
 	}
-	cosmosTxs, err := monomer.AdaptPayloadTxsToCosmosTxs(ethTxBytes, generateSignTx, sdk.AccAddress("addr").String())
+	cosmosTxs, err := monomer.AdaptPayloadTxsToCosmosTxs(ethTxBytes, nil, "")
 	require.NoError(t, err)
 	return cosmosTxs
 }
@@ -193,26 +197,3 @@ func GenerateL1Block() *gethtypes.Block {
 		Time:       uint64(0),
 	}, nil, nil, nil, trie.NewStackTrie(nil))
 }
-
-func generateSignTx(msgs []proto.Message) (bfttypes.Tx, error) {
-	var anys []*codectypes.Any
-	for _, msg := range msgs {
-		anyMsg, err := codectypes.NewAnyWithValue(msg)
-		if err != nil {
-			return nil, fmt.Errorf("new any with value: %v", err)
-		}
-		anys = append(anys, anyMsg)
-	}
-	txBytes, err := (&sdktx.Tx{
-		AuthInfo: &sdktx.AuthInfo{
-			Fee: &sdktx.Fee{},
-		},
-		Body: &sdktx.TxBody{
-			Messages: anys,
-		},
-	}).Marshal()
-	if err != nil {
-		return nil, fmt.Errorf("marshal: %v", err)
-	}
-	return txBytes, nil
-}
@@ -0,0 +1,56 @@
+package txforward
+
+import (
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const MetricsSubsystem = "txforward"
+
+// Metrics contains metrics collected from the txforward package.
+type Metrics interface {
+	RecordForward(result string)
+	RecordRetry()
+}
+
+type metrics struct {
+	// Number of txs and batches submitted for forwarding, by result.
+	Forwards *stdprometheus.CounterVec
+	// Number of times a call to the sequencer was retried.
+	Retries stdprometheus.Counter
+}
+
+func NewMetrics(namespace string) Metrics {
+	return &metrics{
+		Forwards: promauto.NewCounterVec(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "forwards_total",
+			Help:      "Number of txs and batches submitted for forwarding to the sequencer, by result",
+		}, []string{"result"}),
+		Retries: promauto.NewCounter(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "retries_total",
+			Help:      "Number of times a call to the sequencer was retried after a transport error",
+		}),
+	}
+}
+
+func (m *metrics) RecordForward(result string) {
+	m.Forwards.WithLabelValues(result).Inc()
+}
+
+func (m *metrics) RecordRetry() {
+	m.Retries.Inc()
+}
+
+type noopMetrics struct{}
+
+func NewNoopMetrics() Metrics {
+	return &noopMetrics{}
+}
+
+func (*noopMetrics) RecordForward(string) {}
+
+func (*noopMetrics) RecordRetry() {}
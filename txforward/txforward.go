@@ -0,0 +1,260 @@
+// Package txforward relays the txs submitted to a node that doesn't sequence, e.g., a public RPC node, to the
+// sequencer. The txs such a node adds to its own mempool are never included, since only the sequencer's blocks are,
+// so the Forwarder takes the mempool's place in the node's broadcast_tx and eth_sendRawTransaction endpoints. The txs
+// are still checked against the node's state first, so invalid txs are rejected without a round trip.
+package txforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	jsonrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/polymerdao/monomer/mempool"
+)
+
+const (
+	// DefaultMaxAttempts is how many times a tx is sent to the sequencer by default before forwarding fails.
+	DefaultMaxAttempts = 3
+	// DefaultRetryBackoff is how long the first retry waits by default. Each retry waits twice as long as the last.
+	DefaultRetryBackoff = 100 * time.Millisecond
+	// DefaultTimeout is the default deadline of each attempt.
+	DefaultTimeout = 5 * time.Second
+	// DefaultDedupeTTL is how long a forwarded tx isn't forwarded again by default.
+	DefaultDedupeTTL = time.Minute
+)
+
+const (
+	ResultForwarded = "forwarded"
+	ResultDuplicate = "duplicate"
+	ResultRejected  = "rejected"
+	ResultFailed    = "failed"
+)
+
+// Config configures the Forwarder.
+type Config struct {
+	// SequencerURL is the sequencer's CometBFT-compatible RPC endpoint, e.g., http://sequencer:26657.
+	SequencerURL string
+	// MaxAttempts is how many times a tx is sent before forwarding fails. It defaults to DefaultMaxAttempts.
+	MaxAttempts int
+	// RetryBackoff is how long the first retry waits. It defaults to DefaultRetryBackoff.
+	RetryBackoff time.Duration
+	// Timeout is the deadline of each attempt. It defaults to DefaultTimeout.
+	Timeout time.Duration
+	// DedupeTTL is how long a forwarded tx isn't forwarded again, so clients that resubmit txs don't flood the
+	// sequencer. It defaults to DefaultDedupeTTL.
+	DedupeTTL time.Duration
+}
+
+// RejectedError is returned when the sequencer rejects a forwarded tx in CheckTx. Index is the index of the tx that
+// failed in a batch.
+type RejectedError struct {
+	Index     int
+	Code      uint32
+	Codespace string
+	Log       string
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("sequencer check tx failed with code %d (codespace %q): %s", e.Code, e.Codespace, e.Log)
+}
+
+// Rejecter records rejections, e.g., a mempool.Pool.
+type Rejecter interface {
+	Reject(rejection *mempool.Rejection) error
+}
+
+// Forwarder sends txs to the sequencer in place of adding them to the node's mempool. It implements the mempool
+// interfaces of the comet and eth packages.
+//
+// Transport errors are retried with exponential backoff; the sequencer's responses are not. Txs the sequencer rejects
+// are recorded with the node's rejections, so tx_status explains them. Batches aren't deduplicated.
+type Forwarder struct {
+	client       *jsonrpcclient.Client
+	rejecter     Rejecter
+	maxAttempts  int
+	retryBackoff time.Duration
+	timeout      time.Duration
+	dedupeTTL    time.Duration
+	metrics      Metrics
+
+	mu sync.Mutex
+	// forwarded maps the hashes of the txs forwarded or being forwarded within the dedupe TTL to when they expire.
+	// expiries orders them by when they expire.
+	forwarded map[string]time.Time
+	expiries  []expiry
+}
+
+type expiry struct {
+	hash string
+	at   time.Time
+}
+
+func NewForwarder(cfg *Config, rejecter Rejecter, metrics Metrics) (*Forwarder, error) {
+	client, err := jsonrpcclient.New(cfg.SequencerURL)
+	if err != nil {
+		return nil, fmt.Errorf("new sequencer rpc client: %v", err)
+	}
+	f := &Forwarder{
+		client:       client,
+		rejecter:     rejecter,
+		maxAttempts:  cfg.MaxAttempts,
+		retryBackoff: cfg.RetryBackoff,
+		timeout:      cfg.Timeout,
+		dedupeTTL:    cfg.DedupeTTL,
+		metrics:      metrics,
+		forwarded:    make(map[string]time.Time),
+	}
+	if f.maxAttempts == 0 {
+		f.maxAttempts = DefaultMaxAttempts
+	}
+	if f.retryBackoff == 0 {
+		f.retryBackoff = DefaultRetryBackoff
+	}
+	if f.timeout == 0 {
+		f.timeout = DefaultTimeout
+	}
+	if f.dedupeTTL == 0 {
+		f.dedupeTTL = DefaultDedupeTTL
+	}
+	return f, nil
+}
+
+// Enqueue forwards the tx to the sequencer with broadcast_tx_sync. It returns a *RejectedError if the sequencer
+// rejects it. A tx forwarded within the dedupe TTL isn't forwarded again.
+func (f *Forwarder) Enqueue(tx bfttypes.Tx) error {
+	hash := string(tx.Hash())
+	if !f.claim(hash) {
+		f.metrics.RecordForward(ResultDuplicate)
+		return nil
+	}
+	result := new(rpctypes.ResultBroadcastTx)
+	if err := f.call("broadcast_tx_sync", map[string]any{"tx": tx}, result); err != nil {
+		f.release(hash)
+		f.metrics.RecordForward(ResultFailed)
+		return err
+	}
+	if result.Code != 0 {
+		f.release(hash)
+		return f.reject(tx, &RejectedError{
+			Code:      result.Code,
+			Codespace: result.Codespace,
+			Log:       result.Log,
+		})
+	}
+	f.metrics.RecordForward(ResultForwarded)
+	return nil
+}
+
+// batchResult is the part of comet.ResultBroadcastTxBatch the Forwarder needs.
+type batchResult struct {
+	Code      uint32 `json:"code"`
+	Log       string `json:"log"`
+	Codespace string `json:"codespace"`
+	Index     int    `json:"index"`
+}
+
+// EnqueueBatch forwards the batch to the sequencer with broadcast_tx_batch. It returns a *RejectedError if the
+// sequencer rejects one of its txs.
+func (f *Forwarder) EnqueueBatch(batch *mempool.Batch) error {
+	result := new(batchResult)
+	if err := f.call("broadcast_tx_batch", map[string]any{"txs": batch.Txs, "atomic": batch.Atomic}, result); err != nil {
+		f.metrics.RecordForward(ResultFailed)
+		return err
+	}
+	if result.Code != 0 {
+		if result.Index < 0 || result.Index >= len(batch.Txs) {
+			f.metrics.RecordForward(ResultFailed)
+			return fmt.Errorf("sequencer rejected tx %d of a batch of %d", result.Index, len(batch.Txs))
+		}
+		return f.reject(batch.Txs[result.Index], &RejectedError{
+			Index:     result.Index,
+			Code:      result.Code,
+			Codespace: result.Codespace,
+			Log:       result.Log,
+		})
+	}
+	f.metrics.RecordForward(ResultForwarded)
+	return nil
+}
+
+// Reject records a rejection with the node's rejections.
+func (f *Forwarder) Reject(rejection *mempool.Rejection) error {
+	return f.rejecter.Reject(rejection)
+}
+
+// reject records the sequencer's rejection of tx and returns rejectedErr.
+func (f *Forwarder) reject(tx bfttypes.Tx, rejectedErr *RejectedError) error {
+	f.metrics.RecordForward(ResultRejected)
+	if err := f.rejecter.Reject(&mempool.Rejection{
+		Tx:        tx,
+		Reason:    mempool.ReasonSequencerRejected,
+		Code:      rejectedErr.Code,
+		Codespace: rejectedErr.Codespace,
+		Log:       rejectedErr.Log,
+	}); err != nil {
+		return fmt.Errorf("record rejection: %v", err)
+	}
+	return rejectedErr
+}
+
+// call calls the sequencer, retrying transport errors.
+func (f *Forwarder) call(method string, params map[string]any, result any) error {
+	backoff := f.retryBackoff
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+		_, err := f.client.Call(ctx, method, params, result)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		// The sequencer answered, so retrying won't change its answer.
+		var rpcErr *jsonrpctypes.RPCError
+		if errors.As(err, &rpcErr) {
+			return fmt.Errorf("sequencer: %v", err)
+		}
+		if attempt == f.maxAttempts {
+			return fmt.Errorf("forward to sequencer after %d attempts: %v", attempt, err)
+		}
+		f.metrics.RecordRetry()
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// claim marks the tx as forwarded and reports whether it wasn't already.
+func (f *Forwarder) claim(hash string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := time.Now()
+	for len(f.expiries) > 0 && !f.expiries[0].at.After(now) {
+		// A tx that was released and claimed again has a later expiry.
+		if e := f.expiries[0]; f.forwarded[e.hash].Equal(e.at) {
+			delete(f.forwarded, e.hash)
+		}
+		f.expiries = f.expiries[1:]
+	}
+	if _, ok := f.forwarded[hash]; ok {
+		return false
+	}
+	at := now.Add(f.dedupeTTL)
+	f.forwarded[hash] = at
+	f.expiries = append(f.expiries, expiry{
+		hash: hash,
+		at:   at,
+	})
+	return true
+}
+
+// release unmarks a tx that wasn't forwarded, so it can be submitted again. Its expiry is left in place and is skipped
+// once it passes.
+func (f *Forwarder) release(hash string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.forwarded, hash)
+}
@@ -0,0 +1,211 @@
+package txforward_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	rpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cometserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	jsonrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	bfttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/polymerdao/monomer/comet"
+	"github.com/polymerdao/monomer/mempool"
+	"github.com/polymerdao/monomer/txforward"
+	"github.com/stretchr/testify/require"
+)
+
+const rejectCode = 5
+
+// mockSequencer serves broadcast_tx_sync and broadcast_tx_batch, rejecting the txs in reject.
+type mockSequencer struct {
+	mu sync.Mutex
+	// unavailable is how many more requests fail before the sequencer responds.
+	unavailable int
+	txs         []bfttypes.Tx
+	batches     [][]bfttypes.Tx
+	reject      map[string]bool
+}
+
+func newMockSequencer(t *testing.T) (*mockSequencer, string) {
+	s := &mockSequencer{
+		reject: make(map[string]bool),
+	}
+	mux := http.NewServeMux()
+	cometserver.RegisterRPCFuncs(mux, map[string]*cometserver.RPCFunc{
+		"broadcast_tx_sync":  cometserver.NewRPCFunc(s.broadcastTx, "tx"),
+		"broadcast_tx_batch": cometserver.NewRPCFunc(s.broadcastTxBatch, "txs,atomic"),
+	}, log.NewNopLogger())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		unavailable := s.unavailable > 0
+		if unavailable {
+			s.unavailable--
+		}
+		s.mu.Unlock()
+		if unavailable {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return s, server.URL
+}
+
+func (s *mockSequencer) broadcastTx(_ *jsonrpctypes.Context, tx bfttypes.Tx) (*rpctypes.ResultBroadcastTx, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.txs = append(s.txs, tx)
+	result := &rpctypes.ResultBroadcastTx{
+		Hash: tx.Hash(),
+	}
+	if s.reject[string(tx)] {
+		result.Code = rejectCode
+		result.Codespace = "sdk"
+		result.Log = "insufficient fee"
+	}
+	return result, nil
+}
+
+func (s *mockSequencer) broadcastTxBatch(_ *jsonrpctypes.Context, txs []bfttypes.Tx, _ bool) (*comet.ResultBroadcastTxBatch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.batches = append(s.batches, txs)
+	result := new(comet.ResultBroadcastTxBatch)
+	for i, tx := range txs {
+		if s.reject[string(tx)] {
+			result.Code = rejectCode
+			result.Log = "insufficient fee"
+			result.Index = i
+			break
+		}
+	}
+	return result, nil
+}
+
+func (s *mockSequencer) setUnavailable(requests int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unavailable = requests
+}
+
+func (s *mockSequencer) numTxs() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.txs)
+}
+
+func newForwarder(t *testing.T, url string, cfg *txforward.Config) (*txforward.Forwarder, *mempool.Pool) {
+	pool := mempool.New(dbm.NewMemDB())
+	cfg.SequencerURL = url
+	if cfg.RetryBackoff == 0 {
+		cfg.RetryBackoff = time.Millisecond
+	}
+	forwarder, err := txforward.NewForwarder(cfg, pool, txforward.NewNoopMetrics())
+	require.NoError(t, err)
+	return forwarder, pool
+}
+
+func TestEnqueue(t *testing.T) {
+	sequencer, url := newMockSequencer(t)
+	forwarder, pool := newForwarder(t, url, &txforward.Config{})
+
+	tx := bfttypes.Tx("tx")
+	require.NoError(t, forwarder.Enqueue(tx))
+	require.Equal(t, []bfttypes.Tx{tx}, sequencer.txs)
+	// The tx is forwarded, not added to the node's mempool.
+	poolLen, err := pool.Len()
+	require.NoError(t, err)
+	require.Zero(t, poolLen)
+
+	// Resubmissions aren't forwarded again.
+	require.NoError(t, forwarder.Enqueue(tx))
+	require.Equal(t, 1, sequencer.numTxs())
+}
+
+func TestEnqueueDedupeTTL(t *testing.T) {
+	sequencer, url := newMockSequencer(t)
+	forwarder, _ := newForwarder(t, url, &txforward.Config{
+		DedupeTTL: 10 * time.Millisecond,
+	})
+
+	tx := bfttypes.Tx("tx")
+	require.NoError(t, forwarder.Enqueue(tx))
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, forwarder.Enqueue(tx))
+	require.Equal(t, 2, sequencer.numTxs())
+}
+
+func TestEnqueueRejected(t *testing.T) {
+	sequencer, url := newMockSequencer(t)
+	forwarder, pool := newForwarder(t, url, &txforward.Config{})
+
+	tx := bfttypes.Tx("tx")
+	sequencer.reject[string(tx)] = true
+	err := forwarder.Enqueue(tx)
+	var rejectedErr *txforward.RejectedError
+	require.ErrorAs(t, err, &rejectedErr)
+	require.Equal(t, &txforward.RejectedError{
+		Code:      rejectCode,
+		Codespace: "sdk",
+		Log:       "insufficient fee",
+	}, rejectedErr)
+
+	rejection, err := pool.Rejection(tx.Hash())
+	require.NoError(t, err)
+	require.NotNil(t, rejection)
+	require.Equal(t, mempool.ReasonSequencerRejected, rejection.Reason)
+	require.Equal(t, uint32(rejectCode), rejection.Code)
+
+	// Rejected txs can be resubmitted, e.g., with a higher fee.
+	require.ErrorAs(t, forwarder.Enqueue(tx), &rejectedErr)
+	require.Equal(t, 2, sequencer.numTxs())
+}
+
+func TestEnqueueRetries(t *testing.T) {
+	sequencer, url := newMockSequencer(t)
+	forwarder, _ := newForwarder(t, url, &txforward.Config{
+		MaxAttempts: 3,
+	})
+
+	sequencer.setUnavailable(2)
+	require.NoError(t, forwarder.Enqueue(bfttypes.Tx("tx1")))
+	require.Equal(t, 1, sequencer.numTxs())
+
+	sequencer.setUnavailable(3)
+	tx := bfttypes.Tx("tx2")
+	err := forwarder.Enqueue(tx)
+	require.Error(t, err)
+	require.False(t, errors.As(err, new(*txforward.RejectedError)))
+	require.Equal(t, 1, sequencer.numTxs())
+
+	// Txs that failed to be forwarded can be resubmitted.
+	require.NoError(t, forwarder.Enqueue(tx))
+	require.Equal(t, 2, sequencer.numTxs())
+}
+
+func TestEnqueueBatch(t *testing.T) {
+	sequencer, url := newMockSequencer(t)
+	forwarder, pool := newForwarder(t, url, &txforward.Config{})
+
+	batch := &mempool.Batch{
+		Txs: []bfttypes.Tx{bfttypes.Tx("tx1"), bfttypes.Tx("tx2")},
+	}
+	require.NoError(t, forwarder.EnqueueBatch(batch))
+	require.Equal(t, [][]bfttypes.Tx{batch.Txs}, sequencer.batches)
+
+	sequencer.reject["tx2"] = true
+	err := forwarder.EnqueueBatch(batch)
+	var rejectedErr *txforward.RejectedError
+	require.ErrorAs(t, err, &rejectedErr)
+	require.Equal(t, 1, rejectedErr.Index)
+	rejection, err := pool.Rejection(batch.Txs[1].Hash())
+	require.NoError(t, err)
+	require.NotNil(t, rejection)
+}
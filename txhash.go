@@ -0,0 +1,38 @@
+package monomer
+
+import (
+	"fmt"
+
+	bfttypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TxHash returns the canonical hash of a Cosmos tx: the SHA-256 hash of its bytes, as computed by CometBFT.
+// It is the hash returned by /broadcast_tx_*, the hash in /tx and /tx_search results, and the value of the tx.hash event attribute.
+//
+// The eth namespace represents Cosmos txs as Ethereum txs, which have different (Keccak-256) hashes.
+// Use EthTxHashes to convert between the two. Endpoints that look up txs by hash accept both forms.
+func TxHash(tx bfttypes.Tx) common.Hash {
+	return common.BytesToHash(tx.Hash())
+}
+
+// EthTxHashes maps the hashes of the txs in the block's Ethereum representation to the canonical hashes of the Cosmos txs that
+// contain them. Every deposit tx maps to the Cosmos tx that applies the block's deposits.
+func (b *Block) EthTxHashes() (map[common.Hash]common.Hash, error) {
+	if b.Txs.Len() == 0 {
+		return map[common.Hash]common.Hash{}, nil
+	}
+	depositTxs, err := GetDepositTxs(b.Txs.ToSliceOfBytes())
+	if err != nil {
+		return nil, fmt.Errorf("get deposit txs: %v", err)
+	}
+	hashes := make(map[common.Hash]common.Hash, len(depositTxs)+b.Txs.Len()-1)
+	depositsHash := TxHash(b.Txs[0])
+	for _, depositTx := range depositTxs {
+		hashes[depositTx.Hash()] = depositsHash
+	}
+	for _, tx := range b.Txs[1:] {
+		hashes[AdaptNonDepositCosmosTxToEthTx(tx).Hash()] = TxHash(tx)
+	}
+	return hashes, nil
+}
@@ -7,6 +7,7 @@ import (
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-multierror"
+	"github.com/polymerdao/monomer/addrconv"
 )
 
 func Ptr[T any](x T) *T {
@@ -24,7 +25,7 @@ func WrapCloseErr(err error, closer io.Closer) error {
 	return nil
 }
 
-// EvmToCosmosAddress converts an EVM address to a sdktypes.AccAddress
+// EvmToCosmosAddress converts an EVM address to a sdktypes.AccAddress. See addrconv.ToCosmos.
 func EvmToCosmosAddress(addr common.Address) sdktypes.AccAddress {
-	return addr.Bytes()
+	return addrconv.ToCosmos(addr)
 }
@@ -0,0 +1,29 @@
+package witness
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// API serves the stored witnesses.
+type API struct {
+	store *Store
+}
+
+func NewAPI(store *Store) *API {
+	return &API{
+		store: store,
+	}
+}
+
+// GetWitness returns the encoded witness of the block at number. See Decode.
+func (a *API) GetWitness(number hexutil.Uint64) (hexutil.Bytes, error) {
+	encoded, err := a.store.Get(uint64(number))
+	if err != nil {
+		return nil, err
+	} else if encoded == nil {
+		return nil, fmt.Errorf("no witness recorded for block %d", number)
+	}
+	return encoded, nil
+}
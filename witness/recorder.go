@@ -0,0 +1,123 @@
+package witness
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/holiman/uint256"
+)
+
+// Recorder is a vm.StateDB that records the accounts and storage slots the EVM accesses. Writes are recorded as well as
+// reads, since proving the post-state requires the pre-state of the written paths.
+type Recorder struct {
+	*state.StateDB
+	accessed map[common.Address]map[common.Hash]struct{}
+}
+
+// NewRecorder records the accesses to stateDB.
+func NewRecorder(stateDB *state.StateDB) *Recorder {
+	return &Recorder{
+		StateDB:  stateDB,
+		accessed: make(map[common.Address]map[common.Hash]struct{}),
+	}
+}
+
+func (r *Recorder) account(address common.Address) {
+	if _, ok := r.accessed[address]; !ok {
+		r.accessed[address] = make(map[common.Hash]struct{})
+	}
+}
+
+func (r *Recorder) slot(address common.Address, key common.Hash) {
+	r.account(address)
+	r.accessed[address][key] = struct{}{}
+}
+
+func (r *Recorder) CreateAccount(address common.Address) {
+	r.account(address)
+	r.StateDB.CreateAccount(address)
+}
+
+func (r *Recorder) SubBalance(address common.Address, amount *uint256.Int) {
+	r.account(address)
+	r.StateDB.SubBalance(address, amount)
+}
+
+func (r *Recorder) AddBalance(address common.Address, amount *uint256.Int) {
+	r.account(address)
+	r.StateDB.AddBalance(address, amount)
+}
+
+func (r *Recorder) GetBalance(address common.Address) *uint256.Int {
+	r.account(address)
+	return r.StateDB.GetBalance(address)
+}
+
+func (r *Recorder) GetNonce(address common.Address) uint64 {
+	r.account(address)
+	return r.StateDB.GetNonce(address)
+}
+
+func (r *Recorder) SetNonce(address common.Address, nonce uint64) {
+	r.account(address)
+	r.StateDB.SetNonce(address, nonce)
+}
+
+func (r *Recorder) GetCodeHash(address common.Address) common.Hash {
+	r.account(address)
+	return r.StateDB.GetCodeHash(address)
+}
+
+func (r *Recorder) GetCode(address common.Address) []byte {
+	r.account(address)
+	return r.StateDB.GetCode(address)
+}
+
+func (r *Recorder) SetCode(address common.Address, code []byte) {
+	r.account(address)
+	r.StateDB.SetCode(address, code)
+}
+
+func (r *Recorder) GetCodeSize(address common.Address) int {
+	r.account(address)
+	return r.StateDB.GetCodeSize(address)
+}
+
+func (r *Recorder) GetCommittedState(address common.Address, key common.Hash) common.Hash {
+	r.slot(address, key)
+	return r.StateDB.GetCommittedState(address, key)
+}
+
+func (r *Recorder) GetState(address common.Address, key common.Hash) common.Hash {
+	r.slot(address, key)
+	return r.StateDB.GetState(address, key)
+}
+
+func (r *Recorder) SetState(address common.Address, key, value common.Hash) {
+	r.slot(address, key)
+	r.StateDB.SetState(address, key, value)
+}
+
+func (r *Recorder) SelfDestruct(address common.Address) {
+	r.account(address)
+	r.StateDB.SelfDestruct(address)
+}
+
+func (r *Recorder) HasSelfDestructed(address common.Address) bool {
+	r.account(address)
+	return r.StateDB.HasSelfDestructed(address)
+}
+
+func (r *Recorder) Selfdestruct6780(address common.Address) {
+	r.account(address)
+	r.StateDB.Selfdestruct6780(address)
+}
+
+func (r *Recorder) Exist(address common.Address) bool {
+	r.account(address)
+	return r.StateDB.Exist(address)
+}
+
+func (r *Recorder) Empty(address common.Address) bool {
+	r.account(address)
+	return r.StateDB.Empty(address)
+}
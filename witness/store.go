@@ -0,0 +1,73 @@
+package witness
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+var keyPrefix = []byte("witness/")
+
+// Store stores encoded witnesses by height.
+type Store struct {
+	db dbm.DB
+}
+
+func NewStore(db dbm.DB) *Store {
+	return &Store{
+		db: db,
+	}
+}
+
+func key(height uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, keyPrefix...), height)
+}
+
+// Put stores w, replacing the witness of a block at the same height.
+func (s *Store) Put(w *Witness) error {
+	encoded, err := Encode(w)
+	if err != nil {
+		return err
+	}
+	if err := s.db.Set(key(w.Header.Height), encoded); err != nil {
+		return fmt.Errorf("set witness: %v", err)
+	}
+	return nil
+}
+
+// Get returns the encoded witness of the block at height, or nil if there is none.
+func (s *Store) Get(height uint64) ([]byte, error) {
+	encoded, err := s.db.Get(key(height))
+	if err != nil {
+		return nil, fmt.Errorf("get witness: %v", err)
+	}
+	return encoded, nil
+}
+
+// RollbackToHeight deletes the witnesses of the blocks above height.
+func (s *Store) RollbackToHeight(height uint64) error {
+	iter, err := s.db.Iterator(key(height+1), storetypes.PrefixEndBytes(keyPrefix))
+	if err != nil {
+		return fmt.Errorf("iterate witnesses: %v", err)
+	}
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return fmt.Errorf("close iterator: %v", err)
+	}
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for _, k := range keys {
+		if err := batch.Delete(k); err != nil {
+			return fmt.Errorf("delete witness: %v", err)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("write batch: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Package witness records the input of each block the builder builds, so the block's execution can be re-run or proven
+// without access to the node's databases, e.g., by teams experimenting with validity proofs.
+//
+// A Witness holds the parent and block headers, whose state roots and app hashes commit to the pre- and post-state,
+// the block's txs, the L1 attributes deposit tx, and Merkle proofs against the parent's state root of every account and
+// storage slot of the Ethereum state the block read or wrote. Witnesses are RLP-encoded, which is canonical: a witness
+// has a single encoding.
+//
+// The Cosmos app state isn't part of the witness. ABCI doesn't expose the keys the app reads, so a witness commits to
+// it only through the parent's app hash.
+package witness
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/polymerdao/monomer"
+)
+
+// Version is the version of the witness format. It changes whenever a field is added, removed, or reinterpreted.
+const Version = 1
+
+// Witness is the input of a block's execution.
+type Witness struct {
+	Version uint64
+	// Parent is the header of the parent block. Its StateRoot commits to the Ethereum pre-state and, following the
+	// CometBFT convention, Header's AppHash commits to the Cosmos pre-state.
+	Parent *monomer.Header
+	Header *monomer.Header
+	// L1Attributes is the binary encoding of the L1 attributes deposit tx, the first tx in the block's MsgApplyL1Txs.
+	L1Attributes []byte
+	// Txs are the block's txs, in order. The first one is the MsgApplyL1Txs with the block's deposits.
+	Txs [][]byte
+	// Accounts are the accessed accounts of the Ethereum state, sorted by address.
+	Accounts []*Account
+}
+
+// Account is an account of the Ethereum pre-state, proven against the parent's state root. The account proof of an
+// account that doesn't exist is a proof of absence.
+type Account struct {
+	Address common.Address
+	Proof   [][]byte
+	// Code is the account's code. It is empty for accounts without code.
+	Code []byte
+	// Storage are the accessed slots, sorted by key.
+	Storage []*Slot
+}
+
+// Slot is a storage slot of the Ethereum pre-state, proven against its account's storage root.
+type Slot struct {
+	Key   common.Hash
+	Value common.Hash
+	Proof [][]byte
+}
+
+// New returns the witness of block, whose parent is parent, from the state accesses recorder saw while the block was
+// built. db must still have the parent's state.
+func New(db state.Database, parent *monomer.Header, block *monomer.Block, recorder *Recorder) (*Witness, error) {
+	depositTxs, err := monomer.GetDepositTxs(block.Txs.ToSliceOfBytes())
+	if err != nil {
+		return nil, fmt.Errorf("get deposit txs: %v", err)
+	}
+	l1Attributes, err := depositTxs[0].MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal l1 attributes tx: %v", err)
+	}
+
+	parentState, err := state.New(parent.StateRoot, db, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open parent state: %v", err)
+	}
+	accountTrie, err := trie.NewStateTrie(trie.StateTrieID(parent.StateRoot), db.TrieDB())
+	if err != nil {
+		return nil, fmt.Errorf("open account trie: %v", err)
+	}
+	accounts := make([]*Account, 0, len(recorder.accessed))
+	for _, address := range sortedKeys(recorder.accessed, func(a, b common.Address) int { return a.Cmp(b) }) {
+		account, err := proveAccount(db, parent.StateRoot, accountTrie, address, recorder.accessed[address])
+		if err != nil {
+			return nil, fmt.Errorf("prove account %s: %v", address, err)
+		}
+		account.Code = parentState.GetCode(address)
+		accounts = append(accounts, account)
+	}
+
+	return &Witness{
+		Version:      Version,
+		Parent:       parent,
+		Header:       block.Header,
+		L1Attributes: l1Attributes,
+		Txs:          block.Txs.ToSliceOfBytes(),
+		Accounts:     accounts,
+	}, nil
+}
+
+func proveAccount(
+	db state.Database,
+	root common.Hash,
+	accountTrie *trie.StateTrie,
+	address common.Address,
+	slots map[common.Hash]struct{},
+) (*Account, error) {
+	var accountProof proofList
+	if err := accountTrie.Prove(crypto.Keccak256(address.Bytes()), &accountProof); err != nil {
+		return nil, fmt.Errorf("prove account: %v", err)
+	}
+	account := &Account{
+		Address: address,
+		Proof:   accountProof,
+		Storage: make([]*Slot, 0, len(slots)),
+	}
+	if len(slots) == 0 {
+		return account, nil
+	}
+
+	stateAccount, err := accountTrie.GetAccount(address)
+	if err != nil {
+		return nil, fmt.Errorf("get account: %v", err)
+	}
+	var storageTrie *trie.StateTrie
+	if stateAccount != nil && stateAccount.Root != types.EmptyRootHash {
+		id := trie.StorageTrieID(root, crypto.Keccak256Hash(address.Bytes()), stateAccount.Root)
+		if storageTrie, err = trie.NewStateTrie(id, db.TrieDB()); err != nil {
+			return nil, fmt.Errorf("open storage trie: %v", err)
+		}
+	}
+	for _, key := range sortedKeys(slots, func(a, b common.Hash) int { return a.Cmp(b) }) {
+		slot := &Slot{
+			Key: key,
+		}
+		// A slot of an account without storage is empty, and the account proof proves it.
+		if storageTrie != nil {
+			value, err := storageTrie.GetStorage(address, key.Bytes())
+			if err != nil {
+				return nil, fmt.Errorf("get slot %s: %v", key, err)
+			}
+			// Values are stored RLP-encoded, but GetStorage decodes them.
+			slot.Value = common.BytesToHash(value)
+			var proof proofList
+			if err := storageTrie.Prove(crypto.Keccak256(key.Bytes()), &proof); err != nil {
+				return nil, fmt.Errorf("prove slot %s: %v", key, err)
+			}
+			slot.Proof = proof
+		}
+		account.Storage = append(account.Storage, slot)
+	}
+	return account, nil
+}
+
+// proofList collects the nodes of a Merkle proof.
+type proofList [][]byte
+
+func (l *proofList) Put(_, value []byte) error {
+	*l = append(*l, bytes.Clone(value))
+	return nil
+}
+
+func (l *proofList) Delete([]byte) error {
+	return errors.New("not supported")
+}
+
+func sortedKeys[K comparable, V any](m map[K]V, cmp func(a, b K) int) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, cmp)
+	return keys
+}
+
+// Encode returns the canonical encoding of w.
+func Encode(w *Witness) ([]byte, error) {
+	encoded, err := rlp.EncodeToBytes(w)
+	if err != nil {
+		return nil, fmt.Errorf("encode witness: %v", err)
+	}
+	return encoded, nil
+}
+
+// Decode decodes a witness encoded by Encode.
+func Decode(encoded []byte) (*Witness, error) {
+	w := new(Witness)
+	if err := rlp.DecodeBytes(encoded, w); err != nil {
+		return nil, fmt.Errorf("decode witness: %v", err)
+	}
+	if w.Version != Version {
+		return nil, fmt.Errorf("witness version %d is not %d", w.Version, Version)
+	}
+	return w, nil
+}
@@ -0,0 +1,74 @@
+package witness_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/polymerdao/monomer"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/polymerdao/monomer/witness"
+	"github.com/stretchr/testify/require"
+)
+
+func newWitness(height uint64) *witness.Witness {
+	return &witness.Witness{
+		Version: witness.Version,
+		Parent: &monomer.Header{
+			Height:  height - 1,
+			AppHash: []byte{},
+		},
+		Header: &monomer.Header{
+			Height:  height,
+			AppHash: []byte{1},
+		},
+		L1Attributes: []byte{2},
+		Txs:          [][]byte{{3}},
+		Accounts: []*witness.Account{{
+			Address: common.HexToAddress("0x1"),
+			Proof:   [][]byte{{4}},
+			Code:    []byte{},
+			Storage: []*witness.Slot{{
+				Key:   common.HexToHash("0x5"),
+				Value: common.HexToHash("0x6"),
+				Proof: [][]byte{{7}},
+			}},
+		}},
+	}
+}
+
+func TestEncode(t *testing.T) {
+	w := newWitness(2)
+	encoded, err := witness.Encode(w)
+	require.NoError(t, err)
+	got, err := witness.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, w, got)
+
+	w.Version++
+	encoded, err = witness.Encode(w)
+	require.NoError(t, err)
+	_, err = witness.Decode(encoded)
+	require.ErrorContains(t, err, "version")
+}
+
+func TestStore(t *testing.T) {
+	store := witness.NewStore(testutils.NewMemDB(t))
+	api := witness.NewAPI(store)
+	for height := uint64(2); height <= 4; height++ {
+		require.NoError(t, store.Put(newWitness(height)))
+	}
+	encoded, err := api.GetWitness(3)
+	require.NoError(t, err)
+	got, err := witness.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, newWitness(3), got)
+
+	require.NoError(t, store.RollbackToHeight(2))
+	_, err = api.GetWitness(2)
+	require.NoError(t, err)
+	for _, height := range []hexutil.Uint64{3, 4} {
+		_, err = api.GetWitness(height)
+		require.ErrorContains(t, err, "no witness")
+	}
+}
@@ -0,0 +1,154 @@
+package feetoken
+
+import (
+	"context"
+	"math"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/polymerdao/monomer/x/feetoken/types"
+)
+
+// ConversionRateProvider returns the amount of the canonical fee denom one unit of denom is worth, or false if fees can't
+// be paid in denom. The keeper provides the rates set by governance; apps can provide rates from an oracle instead.
+type ConversionRateProvider interface {
+	ConversionRate(ctx context.Context, denom string) (sdkmath.LegacyDec, bool, error)
+}
+
+// NewTxFeeChecker returns a fee checker for the auth ante handler that accepts fees in canonicalDenom and in any denom
+// rates can convert. Set it as the TxFeeChecker in the ante handler options.
+//
+// During CheckTx, the fee's value in canonicalDenom must cover the validator's minimum gas price in canonicalDenom. The
+// tx's priority is the fee's value in canonicalDenom per unit of gas.
+//
+// The part of the fee paid in other denoms is swapped for canonicalDenom through the module account before the fee is
+// deducted: the module account takes the fee tokens from the account that pays the fee and sends it their value in
+// canonicalDenom from its reserve. The fee collector only ever receives canonicalDenom, so fee burn and vault logic
+// don't have to handle other denoms. Txs fail if the reserve can't cover the swap. Every tx that pays in other denoms
+// emits a fee_conversion event with the fee as paid and its value in canonicalDenom.
+func NewTxFeeChecker(rates ConversionRateProvider, bankKeeper types.BankKeeper, canonicalDenom string) authante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) { //nolint:gocritic // hugeParam
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, sdkerrors.Wrap(sdkerrortypes.ErrTxDecode, "tx must be a FeeTx")
+		}
+		fee := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		canonicalFee, err := ConvertFee(ctx, rates, canonicalDenom, fee)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if ctx.IsCheckTx() {
+			if minGasPrice := ctx.MinGasPrices().AmountOf(canonicalDenom); minGasPrice.IsPositive() {
+				requiredFee := minGasPrice.MulInt64(int64(gas)).Ceil().RoundInt() //nolint:gosec
+				if canonicalFee.LT(requiredFee) {
+					return nil, 0, sdkerrors.Wrapf(
+						sdkerrortypes.ErrInsufficientFee,
+						"insufficient fee; got %s worth %s%s, required %s%s",
+						fee,
+						canonicalFee,
+						canonicalDenom,
+						requiredFee,
+						canonicalDenom,
+					)
+				}
+			}
+		}
+
+		if !paysInOtherDenoms(fee, canonicalDenom) {
+			return fee, priority(canonicalFee, gas), nil
+		}
+		// The auth ante handler deducts the fee from the granter if there is one.
+		payer := sdk.AccAddress(feeTx.FeePayer())
+		if granter := feeTx.FeeGranter(); granter != nil {
+			payer = granter
+		}
+		if err := swapFee(ctx, bankKeeper, payer, fee, canonicalFee, canonicalDenom); err != nil {
+			return nil, 0, err
+		}
+		if !ctx.IsCheckTx() {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeFeeConversion,
+				sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+				sdk.NewAttribute(types.AttributeKeyCanonicalFee, sdk.NewCoin(canonicalDenom, canonicalFee).String()),
+			))
+		}
+		return sdk.NewCoins(sdk.NewCoin(canonicalDenom, canonicalFee)), priority(canonicalFee, gas), nil
+	}
+}
+
+// swapFee swaps the part of fee that isn't paid in canonicalDenom for its value in canonicalDenom through the module
+// account, so payer holds canonicalFee in canonicalDenom to pay the fee with.
+func swapFee(
+	ctx context.Context,
+	bankKeeper types.BankKeeper,
+	payer sdk.AccAddress,
+	fee sdk.Coins,
+	canonicalFee sdkmath.Int,
+	canonicalDenom string,
+) error {
+	var feeTokens sdk.Coins
+	for _, coin := range fee {
+		if coin.Denom != canonicalDenom {
+			feeTokens = feeTokens.Add(coin)
+		}
+	}
+	converted := sdk.NewCoin(canonicalDenom, canonicalFee.Sub(fee.AmountOf(canonicalDenom)))
+	if reserve := bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), canonicalDenom); reserve.IsLT(converted) {
+		return sdkerrors.Wrapf(types.ErrInsufficientReserve, "%s is worth %s, but the reserve holds %s", feeTokens, converted, reserve)
+	}
+	if err := bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, feeTokens); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInsufficientFunds, "send fee tokens to module: %v", err)
+	}
+	if converted.IsPositive() {
+		if err := bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, sdk.NewCoins(converted)); err != nil {
+			return sdkerrors.Wrapf(err, "send converted fee to payer")
+		}
+	}
+	return nil
+}
+
+// ConvertFee returns the value of fee in canonicalDenom. Conversions are rounded down.
+func ConvertFee(ctx context.Context, rates ConversionRateProvider, canonicalDenom string, fee sdk.Coins) (sdkmath.Int, error) {
+	total := sdkmath.ZeroInt()
+	for _, coin := range fee {
+		if coin.Denom == canonicalDenom {
+			total = total.Add(coin.Amount)
+			continue
+		}
+		rate, ok, err := rates.ConversionRate(ctx, coin.Denom)
+		if err != nil {
+			return sdkmath.Int{}, sdkerrors.Wrapf(err, "get conversion rate of %s", coin.Denom)
+		} else if !ok {
+			return sdkmath.Int{}, sdkerrors.Wrapf(types.ErrUnsupportedDenom, "fees can't be paid in %s", coin.Denom)
+		}
+		total = total.Add(rate.MulInt(coin.Amount).TruncateInt())
+	}
+	return total, nil
+}
+
+func paysInOtherDenoms(fee sdk.Coins, canonicalDenom string) bool {
+	for _, coin := range fee {
+		if coin.Denom != canonicalDenom {
+			return true
+		}
+	}
+	return false
+}
+
+func priority(canonicalFee sdkmath.Int, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+	gasPrice := canonicalFee.Quo(sdkmath.NewIntFromUint64(gas))
+	if !gasPrice.IsInt64() {
+		return math.MaxInt64
+	}
+	return gasPrice.Int64()
+}
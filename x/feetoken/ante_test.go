@@ -0,0 +1,214 @@
+package feetoken_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/polymerdao/monomer/x/feetoken"
+	"github.com/polymerdao/monomer/x/feetoken/types"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/stretchr/testify/require"
+)
+
+type rates map[string]math.LegacyDec
+
+func (r rates) ConversionRate(_ context.Context, denom string) (math.LegacyDec, bool, error) {
+	rate, ok := r[denom]
+	return rate, ok, nil
+}
+
+// bank holds balances by address.
+type bank map[string]sdk.Coins
+
+func (b bank) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b[addr.String()].AmountOf(denom))
+}
+
+func (b bank) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b bank) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b bank) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrortypes.ErrInsufficientFunds
+	}
+	b[from.String()] = balance
+	b[to.String()] = b[to.String()].Add(amt...)
+	return nil
+}
+
+type feeTx struct {
+	sdk.FeeTx
+	fee     sdk.Coins
+	gas     uint64
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx *feeTx) FeePayer() []byte {
+	return tx.payer
+}
+
+func (tx *feeTx) FeeGranter() []byte {
+	return tx.granter
+}
+
+func (tx *feeTx) GetFee() sdk.Coins {
+	return tx.fee
+}
+
+func (tx *feeTx) GetGas() uint64 {
+	return tx.gas
+}
+
+var (
+	payer   = sdk.AccAddress("payer")
+	granter = sdk.AccAddress("granter")
+	reserve = authtypes.NewModuleAddress(types.ModuleName)
+)
+
+// newBank returns a bank where the payer and the granter hold 1000 of each denom and the reserve holds 2000 of the
+// canonical denom.
+func newBank() bank {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 1000), sdk.NewInt64Coin("uusdc", 1000), sdk.NewInt64Coin("uatom", 1000))
+	return bank{
+		payer.String():   coins,
+		granter.String(): coins,
+		reserve.String(): sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 2000)),
+	}
+}
+
+func TestTxFeeChecker(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).Ctx.
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(rolluptypes.ETH, 10)))
+
+	tests := map[string]struct {
+		fee          sdk.Coins
+		granter      sdk.AccAddress
+		reserve      sdk.Coins
+		wantErr      error
+		wantFee      sdk.Coins
+		wantPriority int64
+		// wantBalances are the balances after the fee checker ran, before the auth ante handler deducts the fee.
+		wantBalances bank
+	}{
+		"canonical denom": {
+			fee:          sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 1000)),
+			wantFee:      sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 1000)),
+			wantPriority: 10,
+			wantBalances: newBank(),
+		},
+		"approved denom": {
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000)),
+			wantFee:      sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 2000)),
+			wantPriority: 20,
+			wantBalances: bank{
+				payer.String():   sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 3000), sdk.NewInt64Coin("uatom", 1000)),
+				granter.String(): newBank()[granter.String()],
+				reserve.String(): sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1000)),
+			},
+		},
+		"mixed denoms": {
+			fee:          sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 500), sdk.NewInt64Coin("uusdc", 250)),
+			wantFee:      sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 1000)),
+			wantPriority: 10,
+			wantBalances: bank{
+				payer.String(): sdk.NewCoins(
+					sdk.NewInt64Coin(rolluptypes.ETH, 1500),
+					sdk.NewInt64Coin("uusdc", 750),
+					sdk.NewInt64Coin("uatom", 1000),
+				),
+				granter.String(): newBank()[granter.String()],
+				reserve.String(): sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 1500), sdk.NewInt64Coin("uusdc", 250)),
+			},
+		},
+		"granter pays": {
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500)),
+			granter:      granter,
+			wantFee:      sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 1000)),
+			wantPriority: 10,
+			wantBalances: bank{
+				payer.String(): newBank()[payer.String()],
+				granter.String(): sdk.NewCoins(
+					sdk.NewInt64Coin(rolluptypes.ETH, 2000),
+					sdk.NewInt64Coin("uusdc", 500),
+					sdk.NewInt64Coin("uatom", 1000),
+				),
+				reserve.String(): sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 1000), sdk.NewInt64Coin("uusdc", 500)),
+			},
+		},
+		"unapproved denom": {
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)),
+			wantErr: types.ErrUnsupportedDenom,
+		},
+		"insufficient converted fee": {
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uusdc", 499)),
+			wantErr: sdkerrortypes.ErrInsufficientFee,
+		},
+		"insufficient reserve": {
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1001)),
+			wantErr: types.ErrInsufficientReserve,
+		},
+		"insufficient fee tokens": {
+			fee:     sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 1000), sdk.NewInt64Coin("uusdc", 1001)),
+			reserve: sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 5000)),
+			wantErr: sdkerrortypes.ErrInsufficientFunds,
+		},
+	}
+	for description, test := range tests {
+		t.Run(description, func(t *testing.T) {
+			balances := newBank()
+			if test.reserve != nil {
+				balances[reserve.String()] = test.reserve
+			}
+			checkFee := feetoken.NewTxFeeChecker(rates{"uusdc": math.LegacyNewDec(2)}, balances, rolluptypes.ETH)
+			fee, priority, err := checkFee(ctx.WithIsCheckTx(true), &feeTx{fee: test.fee, gas: 100, payer: payer, granter: test.granter})
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.wantFee, fee)
+			require.Equal(t, test.wantPriority, priority)
+			require.Equal(t, test.wantBalances, balances)
+		})
+	}
+}
+
+func TestTxFeeCheckerDeliverTx(t *testing.T) {
+	balances := newBank()
+	checkFee := feetoken.NewTxFeeChecker(rates{"uusdc": math.LegacyNewDec(2)}, balances, rolluptypes.ETH)
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test")).Ctx.
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(rolluptypes.ETH, 10)))
+
+	// Minimum gas prices only apply to CheckTx, but approved denoms always do.
+	fee, _, err := checkFee(ctx, &feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1)), gas: 100, payer: payer})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 2)), fee)
+	_, _, err = checkFee(ctx, &feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), gas: 100, payer: payer})
+	require.ErrorIs(t, err, types.ErrUnsupportedDenom)
+
+	// The payer holds the converted fee for the auth ante handler to deduct, and the reserve holds the fee tokens.
+	require.Equal(t, "1002", balances.GetBalance(ctx, payer, rolluptypes.ETH).Amount.String())
+	require.Equal(t, "999", balances.GetBalance(ctx, payer, "uusdc").Amount.String())
+	require.Equal(t, "1998", balances.GetBalance(ctx, reserve, rolluptypes.ETH).Amount.String())
+	require.Equal(t, "1", balances.GetBalance(ctx, reserve, "uusdc").Amount.String())
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeFeeConversion, events[0].Type)
+	canonicalFee, ok := events[0].GetAttribute(types.AttributeKeyCanonicalFee)
+	require.True(t, ok)
+	require.Equal(t, "2"+rolluptypes.ETH, canonicalFee.Value)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/polymerdao/monomer/x/feetoken/types"
+)
+
+type Keeper struct {
+	storeService store.KVStoreService
+	// authority is the address that can update the fee tokens, usually the governance module account.
+	authority string
+}
+
+func NewKeeper(storeService store.KVStoreService, authority string) *Keeper {
+	return &Keeper{
+		storeService: storeService,
+		authority:    authority,
+	}
+}
+
+// Authority returns the address that can update the fee tokens.
+func (k *Keeper) Authority() string {
+	return k.authority
+}
+
+func (k *Keeper) InitGenesis(ctx context.Context, genesis *types.GenesisState) error {
+	if err := genesis.Validate(); err != nil {
+		return fmt.Errorf("validate genesis: %v", err)
+	}
+	return k.SetFeeTokens(ctx, genesis.FeeTokens)
+}
+
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	feeTokens, err := k.GetFeeTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GenesisState{
+		FeeTokens: feeTokens,
+	}, nil
+}
+
+// ConversionRate returns the amount of the canonical fee denom one unit of denom is worth.
+// It returns false if denom is not an approved fee token.
+func (k *Keeper) ConversionRate(ctx context.Context, denom string) (math.LegacyDec, bool, error) {
+	rateBytes, err := k.storeService.OpenKVStore(ctx).Get(types.FeeTokenKey(denom))
+	if err != nil {
+		return math.LegacyDec{}, false, fmt.Errorf("get conversion rate: %v", err)
+	} else if rateBytes == nil {
+		return math.LegacyDec{}, false, nil
+	}
+	var rate math.LegacyDec
+	if err := rate.Unmarshal(rateBytes); err != nil {
+		return math.LegacyDec{}, false, fmt.Errorf("unmarshal conversion rate: %v", err)
+	}
+	return rate, true, nil
+}
+
+// GetFeeTokens returns the approved fee tokens, sorted by denom.
+func (k *Keeper) GetFeeTokens(ctx context.Context) ([]types.FeeToken, error) {
+	prefix := []byte(types.KeyPrefixFeeToken)
+	iterator, err := k.storeService.OpenKVStore(ctx).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, fmt.Errorf("new iterator: %v", err)
+	}
+	defer iterator.Close()
+
+	feeTokens := []types.FeeToken{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rate math.LegacyDec
+		if err := rate.Unmarshal(iterator.Value()); err != nil {
+			return nil, fmt.Errorf("unmarshal conversion rate: %v", err)
+		}
+		feeTokens = append(feeTokens, types.FeeToken{
+			Denom:          string(iterator.Key()[len(prefix):]),
+			ConversionRate: rate,
+		})
+	}
+	return feeTokens, nil
+}
+
+// SetFeeTokens replaces the approved fee tokens. The fee tokens must be valid.
+func (k *Keeper) SetFeeTokens(ctx context.Context, feeTokens []types.FeeToken) error {
+	oldFeeTokens, err := k.GetFeeTokens(ctx)
+	if err != nil {
+		return fmt.Errorf("get fee tokens: %v", err)
+	}
+	kvStore := k.storeService.OpenKVStore(ctx)
+	for i := range oldFeeTokens {
+		if err := kvStore.Delete(types.FeeTokenKey(oldFeeTokens[i].Denom)); err != nil {
+			return fmt.Errorf("delete fee token: %v", err)
+		}
+	}
+	for i := range feeTokens {
+		rateBytes, err := feeTokens[i].ConversionRate.Marshal()
+		if err != nil {
+			return fmt.Errorf("marshal conversion rate: %v", err)
+		}
+		if err := kvStore.Set(types.FeeTokenKey(feeTokens[i].Denom), rateBytes); err != nil {
+			return fmt.Errorf("set fee token: %v", err)
+		}
+	}
+	return nil
+}
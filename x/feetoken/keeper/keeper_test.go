@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/polymerdao/monomer/x/feetoken/keeper"
+	"github.com/polymerdao/monomer/x/feetoken/types"
+	"github.com/stretchr/testify/require"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+func setup(t *testing.T) (context.Context, *keeper.Keeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	return ctx, keeper.NewKeeper(runtime.NewKVStoreService(storeKey), authority)
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k := setup(t)
+	genesis := &types.GenesisState{
+		FeeTokens: []types.FeeToken{
+			{Denom: "uatom", ConversionRate: math.LegacyMustNewDecFromStr("0.5")},
+			{Denom: "ibc/usdc", ConversionRate: math.LegacyNewDec(2)},
+		},
+	}
+	require.NoError(t, k.InitGenesis(ctx, genesis))
+
+	rate, ok, err := k.ConversionRate(ctx, "uatom")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), rate)
+	_, ok, err = k.ConversionRate(ctx, "uosmo")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, genesis.FeeTokens, exported.FeeTokens)
+
+	require.Error(t, k.InitGenesis(ctx, &types.GenesisState{
+		FeeTokens: []types.FeeToken{{Denom: "uatom", ConversionRate: math.LegacyZeroDec()}},
+	}))
+}
+
+func TestUpdateFeeTokens(t *testing.T) {
+	ctx, k := setup(t)
+	require.NoError(t, k.SetFeeTokens(ctx, []types.FeeToken{{Denom: "uatom", ConversionRate: math.LegacyOneDec()}}))
+	newFeeTokens := []types.FeeToken{{Denom: "uosmo", ConversionRate: math.LegacyNewDec(3)}}
+
+	_, err := k.UpdateFeeTokens(ctx, &types.MsgUpdateFeeTokens{
+		Authority: authtypes.NewModuleAddress("other").String(),
+		FeeTokens: newFeeTokens,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = k.UpdateFeeTokens(ctx, &types.MsgUpdateFeeTokens{
+		Authority: authority,
+		FeeTokens: append(newFeeTokens, newFeeTokens...),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidFeeToken)
+
+	_, err = k.UpdateFeeTokens(ctx, &types.MsgUpdateFeeTokens{
+		Authority: authority,
+		FeeTokens: newFeeTokens,
+	})
+	require.NoError(t, err)
+	resp, err := k.FeeTokens(ctx, &types.QueryFeeTokensRequest{})
+	require.NoError(t, err)
+	require.Equal(t, newFeeTokens, resp.FeeTokens)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/polymerdao/monomer/x/feetoken/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// UpdateFeeTokens implements types.MsgServer.
+func (k *Keeper) UpdateFeeTokens(ctx context.Context, msg *types.MsgUpdateFeeTokens) (*types.MsgUpdateFeeTokensResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, types.ErrUnauthorized.Wrapf("expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := types.ValidateFeeTokens(msg.FeeTokens); err != nil {
+		return nil, err
+	}
+	if err := k.SetFeeTokens(ctx, msg.FeeTokens); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateFeeTokensResponse{}, nil
+}
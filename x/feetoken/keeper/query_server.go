@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/polymerdao/monomer/x/feetoken/types"
+)
+
+var _ types.QueryServer = &Keeper{}
+
+// FeeTokens implements types.QueryServer.
+func (k *Keeper) FeeTokens(ctx context.Context, _ *types.QueryFeeTokensRequest) (*types.QueryFeeTokensResponse, error) {
+	feeTokens, err := k.GetFeeTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFeeTokensResponse{
+		FeeTokens: feeTokens,
+	}, nil
+}
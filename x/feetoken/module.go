@@ -0,0 +1,126 @@
+package feetoken
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	modulev1 "github.com/polymerdao/monomer/gen/feetoken/module/v1"
+	"github.com/polymerdao/monomer/x/feetoken/keeper"
+	"github.com/polymerdao/monomer/x/feetoken/types"
+)
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config       *modulev1.Module
+	Codec        codec.Codec
+	StoreService store.KVStoreService
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	Keeper *keeper.Keeper
+	Module appmodule.AppModule
+}
+
+func init() { //nolint:gochecknoinits
+	appmodule.Register(&modulev1.Module{}, appmodule.Provide(ProvideModule))
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.GetAuthority() != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.GetAuthority())
+	}
+	k := keeper.NewKeeper(in.StoreService, authority.String())
+	return ModuleOutputs{
+		Keeper: k,
+		Module: NewAppModule(in.Codec, k),
+	}
+}
+
+const ModuleName = types.ModuleName
+
+// AppModule lets fees be paid in denoms other than the canonical fee denom at conversion rates set by governance.
+// The rates are applied by the fee checker returned by NewTxFeeChecker.
+type AppModule struct {
+	cdc    codec.Codec
+	keeper *keeper.Keeper
+}
+
+var (
+	_ module.AppModule   = (*AppModule)(nil)
+	_ module.HasGenesis  = (*AppModule)(nil)
+	_ module.HasServices = (*AppModule)(nil)
+)
+
+func NewAppModule(cdc codec.Codec, k *keeper.Keeper) *AppModule {
+	return &AppModule{
+		cdc:    cdc,
+		keeper: k,
+	}
+}
+
+func (*AppModule) IsOnePerModuleType() {}
+
+func (*AppModule) IsAppModule() {}
+
+func (*AppModule) Name() string {
+	return ModuleName
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
+func (*AppModule) RegisterGRPCGatewayRoutes(_ client.Context, _ *grpcruntime.ServeMux) {
+}
+
+// RegisterInterfaces registers the module's interface types
+func (*AppModule) RegisterInterfaces(r codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(r)
+}
+
+func (*AppModule) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+
+func (am *AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
+
+func (*AppModule) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+func (*AppModule) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, data json.RawMessage) error {
+	var genesis types.GenesisState
+	if err := cdc.UnmarshalJSON(data, &genesis); err != nil {
+		return fmt.Errorf("unmarshal genesis: %v", err)
+	}
+	return genesis.Validate()
+}
+
+func (am *AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) { //nolint:gocritic // hugeParam
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesis)
+	if err := am.keeper.InitGenesis(ctx, &genesis); err != nil {
+		panic(fmt.Errorf("init genesis: %v", err))
+	}
+}
+
+func (am *AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage { //nolint:gocritic // hugeParam
+	genesis, err := am.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(fmt.Errorf("export genesis: %v", err))
+	}
+	return cdc.MustMarshalJSON(genesis)
+}
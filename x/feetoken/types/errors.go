@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidFeeToken     = sdkerrors.Register(ModuleName, 1, "invalid fee token")
+	ErrUnauthorized        = sdkerrors.Register(ModuleName, 2, "unauthorized")
+	ErrUnsupportedDenom    = sdkerrors.Register(ModuleName, 3, "fee denom not supported")
+	ErrInsufficientReserve = sdkerrors.Register(ModuleName, 4, "insufficient reserve to convert fee")
+)
@@ -0,0 +1,8 @@
+package types
+
+const (
+	AttributeKeyFee          = "fee"
+	AttributeKeyCanonicalFee = "canonical_fee"
+
+	EventTypeFeeConversion = "fee_conversion"
+)
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (t *FeeToken) Validate() error {
+	if err := sdktypes.ValidateDenom(t.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidFeeToken, "%v", err)
+	}
+	if t.ConversionRate.IsNil() || !t.ConversionRate.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidFeeToken, "conversion rate of %s must be positive", t.Denom)
+	}
+	return nil
+}
+
+// ValidateFeeTokens checks that every fee token is valid and that no denom is repeated.
+func ValidateFeeTokens(feeTokens []FeeToken) error {
+	denoms := make(map[string]struct{}, len(feeTokens))
+	for i := range feeTokens {
+		feeToken := &feeTokens[i]
+		if err := feeToken.Validate(); err != nil {
+			return err
+		}
+		if _, ok := denoms[feeToken.Denom]; ok {
+			return sdkerrors.Wrapf(ErrInvalidFeeToken, "duplicate denom %s", feeToken.Denom)
+		}
+		denoms[feeToken.Denom] = struct{}{}
+	}
+	return nil
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		FeeTokens: []FeeToken{},
+	}
+}
+
+func (g *GenesisState) Validate() error {
+	if err := ValidateFeeTokens(g.FeeTokens); err != nil {
+		return fmt.Errorf("validate fee tokens: %w", err)
+	}
+	return nil
+}
+
+var _ sdktypes.Msg = (*MsgUpdateFeeTokens)(nil)
+
+func (m *MsgUpdateFeeTokens) ValidateBasic() error {
+	if _, err := sdktypes.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(ErrUnauthorized, "invalid authority address: %v", err)
+	}
+	return ValidateFeeTokens(m.FeeTokens)
+}
@@ -0,0 +1,17 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feetoken"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// KeyPrefixFeeToken is the key prefix for the conversion rates of the approved fee tokens, keyed by denom.
+	KeyPrefixFeeToken = "FeeToken/"
+)
+
+// FeeTokenKey returns the store key of the conversion rate of denom.
+func FeeTokenKey(denom string) []byte {
+	return append([]byte(KeyPrefixFeeToken), denom...)
+}
@@ -0,0 +1,602 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: feetoken/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateFeeTokens defines the message for replacing the approved fee tokens. It can only be sent by the module authority.
+type MsgUpdateFeeTokens struct {
+	// The module authority, usually the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The new set of approved fee tokens.
+	FeeTokens []FeeToken `protobuf:"bytes,2,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+}
+
+func (m *MsgUpdateFeeTokens) Reset()         { *m = MsgUpdateFeeTokens{} }
+func (m *MsgUpdateFeeTokens) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeTokens) ProtoMessage()    {}
+func (*MsgUpdateFeeTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da30cdfea4c55b49, []int{0}
+}
+func (m *MsgUpdateFeeTokens) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeTokens.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeTokens.Merge(m, src)
+}
+func (m *MsgUpdateFeeTokens) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeTokens proto.InternalMessageInfo
+
+func (m *MsgUpdateFeeTokens) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateFeeTokens) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+// MsgUpdateFeeTokensResponse defines the Msg/UpdateFeeTokens response type.
+type MsgUpdateFeeTokensResponse struct {
+}
+
+func (m *MsgUpdateFeeTokensResponse) Reset()         { *m = MsgUpdateFeeTokensResponse{} }
+func (m *MsgUpdateFeeTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeTokensResponse) ProtoMessage()    {}
+func (*MsgUpdateFeeTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da30cdfea4c55b49, []int{1}
+}
+func (m *MsgUpdateFeeTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeTokensResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeeTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeeTokensResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateFeeTokens)(nil), "feetoken.v1.MsgUpdateFeeTokens")
+	proto.RegisterType((*MsgUpdateFeeTokensResponse)(nil), "feetoken.v1.MsgUpdateFeeTokensResponse")
+}
+
+func init() { proto.RegisterFile("feetoken/v1/tx.proto", fileDescriptor_da30cdfea4c55b49) }
+
+var fileDescriptor_da30cdfea4c55b49 = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x14, 0xc7, 0x5b, 0x89, 0x26, 0x3d, 0x12, 0x8d, 0x0d, 0x46, 0x6c, 0x4c, 0x21, 0x2c, 0x12, 0x12,
+	0x7b, 0x01, 0x13, 0x07, 0x17, 0x23, 0x83, 0x71, 0x61, 0x41, 0x5d, 0x74, 0x20, 0x85, 0x3e, 0x8e,
+	0x46, 0xaf, 0xaf, 0xe9, 0x1d, 0x04, 0x36, 0xe3, 0x27, 0xf0, 0x0b, 0xb8, 0x3b, 0x32, 0xf8, 0x21,
+	0x18, 0x89, 0x93, 0x93, 0x31, 0x30, 0xf0, 0x35, 0x4c, 0x5b, 0x0a, 0x28, 0x83, 0xcb, 0xe5, 0xde,
+	0xfb, 0xbf, 0xf7, 0xff, 0xbd, 0x77, 0x47, 0x32, 0x6d, 0x00, 0x89, 0x0f, 0xe0, 0xd1, 0x5e, 0x99,
+	0xca, 0xbe, 0xe5, 0x07, 0x28, 0x51, 0x4f, 0x27, 0x59, 0xab, 0x57, 0x36, 0x76, 0x6d, 0xee, 0x7a,
+	0x48, 0xa3, 0x33, 0xd6, 0x8d, 0xfd, 0x16, 0x0a, 0x8e, 0x82, 0x72, 0xc1, 0xc2, 0x3e, 0x2e, 0xd8,
+	0x5c, 0x38, 0x88, 0x85, 0x46, 0x14, 0xd1, 0x38, 0x98, 0x4b, 0xc6, 0x2a, 0x69, 0xe1, 0x1f, 0x6b,
+	0x19, 0x86, 0x0c, 0xe3, 0x9e, 0xf0, 0x16, 0x67, 0x0b, 0xaf, 0x2a, 0xd1, 0x6b, 0x82, 0xdd, 0xfa,
+	0x8e, 0x2d, 0xe1, 0x12, 0xe0, 0x26, 0xec, 0x10, 0xfa, 0x29, 0xd1, 0xec, 0xae, 0xec, 0x60, 0xe0,
+	0xca, 0x41, 0x56, 0xcd, 0xab, 0x45, 0xad, 0x9a, 0xfd, 0x78, 0x3f, 0xce, 0xcc, 0x69, 0x17, 0x8e,
+	0x13, 0x80, 0x10, 0xd7, 0x32, 0x70, 0x3d, 0x56, 0x5f, 0x96, 0xea, 0xe7, 0x84, 0xb4, 0x01, 0x1a,
+	0x11, 0x57, 0x64, 0x37, 0xf2, 0xa9, 0x62, 0xba, 0xb2, 0x67, 0xad, 0x6c, 0x6a, 0x25, 0x8c, 0xaa,
+	0x36, 0xfa, 0xca, 0x29, 0x6f, 0xb3, 0x61, 0x49, 0xad, 0x6b, 0xed, 0x04, 0x7c, 0xb6, 0xfd, 0x3c,
+	0x1b, 0x96, 0x96, 0x86, 0x85, 0x43, 0x62, 0xac, 0x8f, 0x57, 0x07, 0xe1, 0xa3, 0x27, 0xa0, 0xe2,
+	0x92, 0x54, 0x4d, 0x30, 0xfd, 0x9e, 0xec, 0xfc, 0x5d, 0x20, 0xf7, 0x0b, 0xba, 0x6e, 0x61, 0x1c,
+	0xfd, 0x53, 0x90, 0x30, 0x8c, 0xcd, 0xa7, 0x70, 0xc6, 0xea, 0xd5, 0x68, 0x62, 0xaa, 0xe3, 0x89,
+	0xa9, 0x7e, 0x4f, 0x4c, 0xf5, 0x65, 0x6a, 0x2a, 0xe3, 0xa9, 0xa9, 0x7c, 0x4e, 0x4d, 0xe5, 0xce,
+	0x62, 0xae, 0xec, 0x74, 0x9b, 0x56, 0x0b, 0x39, 0xf5, 0xf1, 0x71, 0xc0, 0x21, 0x70, 0x6c, 0xa4,
+	0x1c, 0x3d, 0xe4, 0x10, 0xd0, 0xfe, 0xe2, 0x23, 0xa8, 0x1c, 0xf8, 0x20, 0x9a, 0x5b, 0xd1, 0xcb,
+	0x9f, 0xfc, 0x0c, 0x00, 0xac, 0x28, 0xf1, 0x97, 0x17, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateFeeTokens defines a method for replacing the approved fee tokens.
+	UpdateFeeTokens(ctx context.Context, in *MsgUpdateFeeTokens, opts ...grpc.CallOption) (*MsgUpdateFeeTokensResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateFeeTokens(ctx context.Context, in *MsgUpdateFeeTokens, opts ...grpc.CallOption) (*MsgUpdateFeeTokensResponse, error) {
+	out := new(MsgUpdateFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/feetoken.v1.Msg/UpdateFeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateFeeTokens defines a method for replacing the approved fee tokens.
+	UpdateFeeTokens(context.Context, *MsgUpdateFeeTokens) (*MsgUpdateFeeTokensResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateFeeTokens(ctx context.Context, req *MsgUpdateFeeTokens) (*MsgUpdateFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeTokens not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateFeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feetoken.v1.Msg/UpdateFeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeTokens(ctx, req.(*MsgUpdateFeeTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "feetoken.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateFeeTokens",
+			Handler:    _Msg_UpdateFeeTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feetoken/v1/tx.proto",
+}
+
+func (m *MsgUpdateFeeTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateFeeTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
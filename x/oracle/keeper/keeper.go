@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/polymerdao/monomer/x/oracle/types"
+)
+
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	// authority is the address that can update the module parameters, usually the governance module account.
+	authority string
+}
+
+func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authority string) *Keeper {
+	return &Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+	}
+}
+
+// Authority returns the address that can update the module parameters.
+func (k *Keeper) Authority() string {
+	return k.authority
+}
+
+func (k *Keeper) InitGenesis(ctx context.Context, genesis *types.GenesisState) error {
+	if err := genesis.Validate(); err != nil {
+		return fmt.Errorf("validate genesis: %v", err)
+	}
+	if err := k.SetParams(ctx, &genesis.Params); err != nil {
+		return err
+	}
+	for i := range genesis.Prices {
+		if err := k.SetPrice(ctx, &genesis.Prices[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prices, err := k.GetPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GenesisState{
+		Params: *params,
+		Prices: prices,
+	}, nil
+}
+
+// GetParams returns the module parameters, or the default parameters if they were never set.
+func (k *Keeper) GetParams(ctx context.Context) (*types.Params, error) {
+	paramsBytes, err := k.storeService.OpenKVStore(ctx).Get([]byte(types.KeyParams))
+	if err != nil {
+		return nil, fmt.Errorf("get params: %v", err)
+	} else if paramsBytes == nil {
+		params := types.DefaultParams()
+		return &params, nil
+	}
+	var params types.Params
+	if err := k.cdc.Unmarshal(paramsBytes, &params); err != nil {
+		return nil, fmt.Errorf("unmarshal params: %v", err)
+	}
+	return &params, nil
+}
+
+// SetParams sets the module parameters. The parameters must be valid.
+func (k *Keeper) SetParams(ctx context.Context, params *types.Params) error {
+	paramsBytes, err := k.cdc.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("marshal params: %v", err)
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set([]byte(types.KeyParams), paramsBytes); err != nil {
+		return fmt.Errorf("set params: %v", err)
+	}
+	return nil
+}
+
+// GetPrice returns the latest price of pair. It returns false if no price was posted for pair.
+func (k *Keeper) GetPrice(ctx context.Context, pair string) (*types.Price, bool, error) {
+	priceBytes, err := k.storeService.OpenKVStore(ctx).Get(types.PriceKey(pair))
+	if err != nil {
+		return nil, false, fmt.Errorf("get price: %v", err)
+	} else if priceBytes == nil {
+		return nil, false, nil
+	}
+	var price types.Price
+	if err := k.cdc.Unmarshal(priceBytes, &price); err != nil {
+		return nil, false, fmt.Errorf("unmarshal price: %v", err)
+	}
+	return &price, true, nil
+}
+
+// SetPrice replaces the latest price of its pair. The price must be valid.
+func (k *Keeper) SetPrice(ctx context.Context, price *types.Price) error {
+	priceBytes, err := k.cdc.Marshal(price)
+	if err != nil {
+		return fmt.Errorf("marshal price: %v", err)
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set(types.PriceKey(price.Pair), priceBytes); err != nil {
+		return fmt.Errorf("set price: %v", err)
+	}
+	return nil
+}
+
+// GetPrices returns the latest prices, sorted by pair.
+func (k *Keeper) GetPrices(ctx context.Context) ([]types.Price, error) {
+	prefix := []byte(types.KeyPrefixPrice)
+	iterator, err := k.storeService.OpenKVStore(ctx).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, fmt.Errorf("new iterator: %v", err)
+	}
+	defer iterator.Close()
+
+	prices := []types.Price{}
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.Price
+		if err := k.cdc.Unmarshal(iterator.Value(), &price); err != nil {
+			return nil, fmt.Errorf("unmarshal price: %v", err)
+		}
+		prices = append(prices, price)
+	}
+	return prices, nil
+}
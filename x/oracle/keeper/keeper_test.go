@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/polymerdao/monomer/x/oracle/keeper"
+	"github.com/polymerdao/monomer/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	alice     = authtypes.NewModuleAddress("alice").String()
+	bob       = authtypes.NewModuleAddress("bob").String()
+)
+
+func setup(t *testing.T) (sdk.Context, *keeper.Keeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	return ctx, keeper.NewKeeper(cdc, runtime.NewKVStoreService(storeKey), authority)
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k := setup(t)
+	genesis := &types.GenesisState{
+		Params: types.Params{
+			Feeders: []string{alice},
+		},
+		Prices: []types.Price{
+			{Pair: "ATOM/USD", Price: math.LegacyNewDec(5), Feeder: alice, Height: 1, Time: time.Unix(1, 0).UTC()},
+			{Pair: "ETH/USD", Price: math.LegacyNewDec(2500), Feeder: alice, Height: 2, Time: time.Unix(2, 0).UTC()},
+		},
+	}
+	require.NoError(t, k.InitGenesis(ctx, genesis))
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesis, exported)
+
+	for name, genesis := range map[string]*types.GenesisState{
+		"invalid feeder": {Params: types.Params{Feeders: []string{"alice"}}},
+		"invalid pair": {
+			Params: types.DefaultParams(),
+			Prices: []types.Price{{Pair: "ETH", Price: math.LegacyOneDec(), Feeder: alice}},
+		},
+		"zero price": {
+			Params: types.DefaultParams(),
+			Prices: []types.Price{{Pair: "ETH/USD", Price: math.LegacyZeroDec(), Feeder: alice}},
+		},
+		"duplicate pair": {
+			Params: types.DefaultParams(),
+			Prices: []types.Price{
+				{Pair: "ETH/USD", Price: math.LegacyOneDec(), Feeder: alice},
+				{Pair: "ETH/USD", Price: math.LegacyOneDec(), Feeder: alice},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, k.InitGenesis(ctx, genesis))
+		})
+	}
+}
+
+func TestPostPrice(t *testing.T) {
+	ctx, k := setup(t)
+	require.NoError(t, k.SetParams(ctx, &types.Params{Feeders: []string{alice}}))
+	blockTime := time.Unix(100, 0).UTC()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+
+	_, err := k.PostPrice(ctx, &types.MsgPostPrice{Feeder: bob, Pair: "ETH/USD", Price: math.LegacyNewDec(2500)})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = k.PostPrice(ctx, &types.MsgPostPrice{Feeder: alice, Pair: "ETH-USD", Price: math.LegacyNewDec(2500)})
+	require.ErrorIs(t, err, types.ErrInvalidPair)
+	_, err = k.PostPrice(ctx, &types.MsgPostPrice{Feeder: alice, Pair: "ETH/USD", Price: math.LegacyNewDec(-1)})
+	require.ErrorIs(t, err, types.ErrInvalidPrice)
+
+	_, err = k.Price(ctx, &types.QueryPriceRequest{Pair: "ETH/USD"})
+	require.ErrorIs(t, err, types.ErrPriceNotFound)
+
+	_, err = k.PostPrice(ctx, &types.MsgPostPrice{Feeder: alice, Pair: "ETH/USD", Price: math.LegacyNewDec(2500)})
+	require.NoError(t, err)
+	_, err = k.PostPrice(ctx, &types.MsgPostPrice{Feeder: alice, Pair: "ETH/USD", Price: math.LegacyNewDec(2600)})
+	require.NoError(t, err)
+	want := types.Price{Pair: "ETH/USD", Price: math.LegacyNewDec(2600), Feeder: alice, Height: 10, Time: blockTime}
+	resp, err := k.Price(ctx, &types.QueryPriceRequest{Pair: "ETH/USD"})
+	require.NoError(t, err)
+	require.Equal(t, want, resp.Price)
+
+	_, err = k.PostPrice(ctx, &types.MsgPostPrice{Feeder: alice, Pair: "ATOM/USD", Price: math.LegacyNewDec(5)})
+	require.NoError(t, err)
+	prices, err := k.Prices(ctx, &types.QueryPricesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Price{
+		{Pair: "ATOM/USD", Price: math.LegacyNewDec(5), Feeder: alice, Height: 10, Time: blockTime},
+		want,
+	}, prices.Prices)
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k := setup(t)
+	params := types.Params{Feeders: []string{alice, bob}}
+
+	_, err := k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: alice, Params: params})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: types.Params{Feeders: []string{alice, alice}}})
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	resp, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, resp.Params)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polymerdao/monomer/x/oracle/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// PostPrice implements types.MsgServer.
+func (k *Keeper) PostPrice(ctx context.Context, msg *types.MsgPostPrice) (*types.MsgPostPriceResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.IsFeeder(msg.Feeder) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not a feeder", msg.Feeder)
+	}
+	if err := types.ValidatePair(msg.Pair); err != nil {
+		return nil, err
+	}
+	if err := types.ValidatePrice(msg.Price); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.SetPrice(ctx, &types.Price{
+		Pair:   msg.Pair,
+		Price:  msg.Price,
+		Feeder: msg.Feeder,
+		Height: sdkCtx.BlockHeight(),
+		Time:   sdkCtx.BlockTime(),
+	}); err != nil {
+		return nil, err
+	}
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePostPrice,
+		sdk.NewAttribute(types.AttributeKeyPair, msg.Pair),
+		sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+		sdk.NewAttribute(types.AttributeKeyFeeder, msg.Feeder),
+	))
+	return &types.MsgPostPriceResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k *Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	if err := k.SetParams(ctx, &msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/polymerdao/monomer/x/oracle/types"
+)
+
+var _ types.QueryServer = &Keeper{}
+
+// Params implements types.QueryServer.
+func (k *Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{
+		Params: *params,
+	}, nil
+}
+
+// Price implements types.QueryServer.
+func (k *Keeper) Price(ctx context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	price, ok, err := k.GetPrice(ctx, req.GetPair())
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrPriceNotFound, "%s", req.GetPair())
+	}
+	return &types.QueryPriceResponse{
+		Price: *price,
+	}, nil
+}
+
+// Prices implements types.QueryServer.
+func (k *Keeper) Prices(ctx context.Context, _ *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	prices, err := k.GetPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPricesResponse{
+		Prices: prices,
+	}, nil
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidPair    = sdkerrors.Register(ModuleName, 1, "invalid pair")
+	ErrInvalidPrice   = sdkerrors.Register(ModuleName, 2, "invalid price")
+	ErrUnauthorized   = sdkerrors.Register(ModuleName, 3, "unauthorized")
+	ErrInvalidParams  = sdkerrors.Register(ModuleName, 4, "invalid params")
+	ErrPriceNotFound  = sdkerrors.Register(ModuleName, 5, "price not found")
+	ErrInvalidGenesis = sdkerrors.Register(ModuleName, 6, "invalid genesis")
+)
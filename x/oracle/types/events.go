@@ -0,0 +1,9 @@
+package types
+
+const (
+	AttributeKeyPair   = "pair"
+	AttributeKeyPrice  = "price"
+	AttributeKeyFeeder = "feeder"
+
+	EventTypePostPrice = "post_price"
+)
@@ -0,0 +1,19 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "oracle"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// KeyParams is the key for the module Params
+	KeyParams = "Params"
+	// KeyPrefixPrice is the key prefix for the latest prices, keyed by pair.
+	KeyPrefixPrice = "Price/"
+)
+
+// PriceKey returns the store key of the latest price of pair.
+func PriceKey(pair string) []byte {
+	return append([]byte(KeyPrefixPrice), pair...)
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = (*MsgPostPrice)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+)
+
+func (m *MsgPostPrice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Feeder); err != nil {
+		return sdkerrors.Wrapf(ErrUnauthorized, "invalid feeder address: %v", err)
+	}
+	if err := ValidatePair(m.Pair); err != nil {
+		return err
+	}
+	return ValidatePrice(m.Price)
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(ErrUnauthorized, "invalid authority address: %v", err)
+	}
+	return m.Params.Validate()
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"slices"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns the default parameters, which have no feeders. Governance must add feeders before prices can
+// be posted.
+func DefaultParams() Params {
+	return Params{
+		Feeders: []string{},
+	}
+}
+
+func (p *Params) Validate() error {
+	for i, feeder := range p.Feeders {
+		if _, err := sdk.AccAddressFromBech32(feeder); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidParams, "invalid feeder address: %v", err)
+		}
+		if slices.Contains(p.Feeders[:i], feeder) {
+			return sdkerrors.Wrapf(ErrInvalidParams, "duplicate feeder %s", feeder)
+		}
+	}
+	return nil
+}
+
+// IsFeeder reports whether addr can post prices.
+func (p *Params) IsFeeder(addr string) bool {
+	return slices.Contains(p.Feeders, addr)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var pairRegex = regexp.MustCompile(`^[A-Za-z0-9.\-]{1,32}/[A-Za-z0-9.\-]{1,32}$`)
+
+// ValidatePair checks that pair is of the form {base}/{quote}, e.g., ETH/USD.
+func ValidatePair(pair string) error {
+	if !pairRegex.MatchString(pair) {
+		return sdkerrors.Wrapf(ErrInvalidPair, "%s is not of the form {base}/{quote}", pair)
+	}
+	return nil
+}
+
+// ValidatePrice checks that price is positive.
+func ValidatePrice(price math.LegacyDec) error {
+	if price.IsNil() || !price.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidPrice, "price must be positive")
+	}
+	return nil
+}
+
+func (p *Price) Validate() error {
+	if err := ValidatePair(p.Pair); err != nil {
+		return err
+	}
+	if err := ValidatePrice(p.Price); err != nil {
+		return sdkerrors.Wrapf(err, "pair %s", p.Pair)
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Feeder); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidPrice, "invalid feeder address of %s: %v", p.Pair, err)
+	}
+	return nil
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Prices: []Price{},
+	}
+}
+
+func (g *GenesisState) Validate() error {
+	if err := g.Params.Validate(); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+	pairs := make(map[string]struct{}, len(g.Prices))
+	for i := range g.Prices {
+		price := &g.Prices[i]
+		if err := price.Validate(); err != nil {
+			return fmt.Errorf("validate price: %w", err)
+		}
+		if _, ok := pairs[price.Pair]; ok {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate pair %s", price.Pair)
+		}
+		pairs[price.Pair] = struct{}{}
+	}
+	return nil
+}
@@ -2,17 +2,16 @@ package keeper
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
-	"reflect"
-	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	opbindings "github.com/ethereum-optimism/optimism/op-bindings/bindings"
-	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -23,6 +22,9 @@ import (
 	"github.com/samber/lo"
 )
 
+// ecotoneRollupCfg makes derive.L1BlockInfoFromBytes decode L1 attributes txs in the Ecotone format.
+var ecotoneRollupCfg = &rollup.Config{EcotoneTime: utils.Ptr(uint64(0))}
+
 // setL1BlockInfo sets the L1 block info to the app state
 //
 // Persisted data conforms to optimism specs on L1 attributes:
@@ -38,22 +40,47 @@ func (k *Keeper) setL1BlockInfo(ctx sdk.Context, info types.L1BlockInfo) error {
 	return nil
 }
 
-// processL1AttributesTx processes the L1 Attributes tx and returns the L1 block info.
-func (k *Keeper) processL1AttributesTx(ctx sdk.Context, txBytes []byte) (*types.L1BlockInfo, error) { //nolint:gocritic // hugeParam
+// GetL1BlockInfo returns the info of the latest L1 block, or nil if no block has been applied yet.
+func (k *Keeper) GetL1BlockInfo(ctx context.Context) (*types.L1BlockInfo, error) {
+	infoBytes, err := k.storeService.OpenKVStore(ctx).Get([]byte(types.KeyL1BlockInfo))
+	if err != nil {
+		return nil, types.WrapError(err, "get latest L1 block info")
+	} else if infoBytes == nil {
+		return nil, nil
+	}
+	var info types.L1BlockInfo
+	if err := info.Unmarshal(infoBytes); err != nil {
+		return nil, types.WrapError(err, "unmarshal L1 block info")
+	}
+	return &info, nil
+}
+
+// processL1AttributesTx processes the L1 Attributes tx and returns the L1 block info and the tx's deposit event.
+func (k *Keeper) processL1AttributesTx(ctx sdk.Context, txBytes []byte) (*types.L1BlockInfo, *sdk.Event, error) { //nolint:gocritic // hugeParam
 	var tx ethtypes.Transaction
 	if err := tx.UnmarshalBinary(txBytes); err != nil {
 		ctx.Logger().Error("Failed to unmarshal L1 attributes transaction", "index", 0, "err", err, "txBytes", txBytes)
-		return nil, types.WrapError(types.ErrInvalidL1Txs, "failed to unmarshal L1 attributes transaction: %v", err)
+		return nil, nil, types.WrapError(types.ErrInvalidL1Txs, "failed to unmarshal L1 attributes transaction: %v", err)
 	}
 	if !tx.IsDepositTx() {
 		ctx.Logger().Error("First L1 tx must be a L1 attributes tx", "type", tx.Type())
-		return nil, types.WrapError(types.ErrInvalidL1Txs, "first L1 tx must be a L1 attributes tx, but got type %d", tx.Type())
+		return nil, nil, types.WrapError(types.ErrInvalidL1Txs, "first L1 tx must be a L1 attributes tx, but got type %d", tx.Type())
 	}
 
-	l1blockInfo, err := derive.L1BlockInfoFromBytes(k.rollupCfg, uint64(ctx.BlockTime().Unix()), tx.Data())
+	// The keeper doesn't know when the rollup activates Ecotone, so the format is picked by the function selector.
+	rollupCfg := k.rollupCfg
+	if bytes.HasPrefix(tx.Data(), derive.L1InfoFuncEcotoneBytes4) {
+		rollupCfg = ecotoneRollupCfg
+	}
+	l1blockInfo, err := derive.L1BlockInfoFromBytes(rollupCfg, uint64(ctx.BlockTime().Unix()), tx.Data())
 	if err != nil {
 		ctx.Logger().Error("Failed to derive L1 block info from L1 Info Deposit tx", "err", err, "txBytes", txBytes)
-		return nil, types.WrapError(types.ErrInvalidL1Txs, "failed to derive L1 block info from L1 Info Deposit tx: %v", err)
+		return nil, nil, types.WrapError(types.ErrInvalidL1Txs, "failed to derive L1 block info from L1 Info Deposit tx: %v", err)
+	}
+
+	depositEvent, err := k.depositEvent(ctx, &tx, derive.L1InfoDepositerAddress)
+	if err != nil {
+		return nil, nil, types.WrapError(types.ErrInvalidL1Txs, "L1 attributes tx: %v", err)
 	}
 
 	// Convert derive.L1BlockInfo to types.L1BlockInfo
@@ -75,7 +102,7 @@ func (k *Keeper) processL1AttributesTx(ctx sdk.Context, txBytes []byte) (*types.
 	if l1blockInfo.BlobBaseFee != nil {
 		protoL1BlockInfo.BlobBaseFee = l1blockInfo.BlobBaseFee.Bytes()
 	}
-	return protoL1BlockInfo, nil
+	return protoL1BlockInfo, depositEvent, nil
 }
 
 // processL1UserDepositTxs processes the L1 user deposit txs, mints ETH to the user's cosmos address,
@@ -85,7 +112,7 @@ func (k *Keeper) processL1UserDepositTxs(
 	txs [][]byte,
 	l1blockInfo *types.L1BlockInfo,
 ) (sdk.Events, error) {
-	mintEvents := sdk.Events{}
+	depositEvents := sdk.Events{}
 
 	// skip the first tx - it is the L1 attributes tx
 	for i := 1; i < len(txs); i++ {
@@ -104,12 +131,6 @@ func (k *Keeper) processL1UserDepositTxs(
 			return nil, types.WrapError(types.ErrInvalidL1Txs, "L1 tx must be a user deposit tx, type %d", tx.Type())
 		}
 		ctx.Logger().Debug("User deposit tx", "index", i, "tx", string(lo.Must(tx.MarshalJSON())))
-		// if the receipient is nil, it means the tx is creating a contract which we don't support, so return an error.
-		// see https://github.com/ethereum-optimism/op-geth/blob/v1.101301.0-rc.2/core/state_processor.go#L154
-		if tx.To() == nil {
-			ctx.Logger().Error("Contract creation txs are not supported", "index", i)
-			return nil, types.WrapError(types.ErrInvalidL1Txs, "Contract creation txs are not supported, index:%d", i)
-		}
 
 		// Get the sender's address from the transaction
 		from, err := ethtypes.MakeSigner(
@@ -121,94 +142,226 @@ func (k *Keeper) processL1UserDepositTxs(
 			ctx.Logger().Error("Failed to get sender address", "evmAddress", from, "err", err)
 			return nil, types.WrapError(types.ErrInvalidL1Txs, "failed to get sender address: %v", err)
 		}
-		mintAddr := utils.EvmToCosmosAddress(from)
-		mintAmount := sdkmath.NewIntFromBigInt(tx.Mint())
-		recipientAddr := utils.EvmToCosmosAddress(*tx.To())
-		transferAmount := sdkmath.NewIntFromBigInt(tx.Value())
-
-		mintEvent, err := k.mintETH(ctx, mintAddr, recipientAddr, mintAmount, transferAmount)
+		depositEvent, err := k.depositEvent(ctx, &tx, from)
 		if err != nil {
+			return nil, types.WrapError(types.ErrInvalidL1Txs, "user deposit tx %d: %v", i, err)
+		}
+		depositEvents = append(depositEvents, *depositEvent)
+
+		mintAddr := utils.EvmToCosmosAddress(from)
+		mintAmount := sdkmath.ZeroInt()
+		if tx.Mint() != nil {
+			mintAmount = sdkmath.NewIntFromBigInt(tx.Mint())
+		}
+		// As in op-geth, the mint is credited even if the deposit fails, so it is minted outside the deposit's execution.
+		if err := k.mintETH(ctx, mintAmount); err != nil {
 			ctx.Logger().Error("Failed to mint ETH", "evmAddress", from, "cosmosAddress", mintAddr, "err", err)
 			return nil, types.WrapError(types.ErrMintETH, "failed to mint ETH for cosmosAddress: %v; err: %v", mintAddr, err)
 		}
-		mintEvents = append(mintEvents, *mintEvent)
 
-		// TODO: remove hardcoded address once a genesis state is configured
-		// Convert the L1CrossDomainMessenger address to its L2 aliased address
-		aliasedL1CrossDomainMessengerAddress := crossdomain.ApplyL1ToL2Alias(common.HexToAddress("0x9A9f2CCfdE556A7E9Ff0848998Aa4a0CFD8863AE"))
-
-		// Check if the tx is a cross domain message from the aliased L1CrossDomainMessenger address
-		if from == aliasedL1CrossDomainMessengerAddress && tx.Data() != nil {
-			erc20mintEvent, err := k.parseAndExecuteCrossDomainMessage(ctx, tx.Data())
-			// TODO: Investigate when to return an error if a cross domain message can't be parsed or executed - look at OP Spec
+		// Execute the deposit in a cached context so its state transitions are discarded if it fails.
+		cacheCtx, write := ctx.CacheContext()
+		executionEvents, err := k.executeUserDeposit(cacheCtx, &tx, from, mintAddr, mintAmount)
+		if err == nil {
+			write()
+		} else {
+			ctx.Logger().Info("User deposit failed", "index", i, "txHash", tx.Hash(), "err", err)
+			executionEvents, err = k.failDeposit(ctx, &tx, from, mintAddr, mintAmount, l1blockInfo.Number, uint64(i), err)
 			if err != nil {
-				ctx.Logger().Error("Failed to parse or execute cross domain message", "err", err)
-				return nil, types.WrapError(types.ErrInvalidL1Txs, "failed to parse or execute cross domain message: %v", err)
-			} else {
-				mintEvents = append(mintEvents, *erc20mintEvent)
+				ctx.Logger().Error("Failed to credit the mint of a failed deposit", "evmAddress", from, "cosmosAddress", mintAddr, "err", err)
+				return nil, types.WrapError(types.ErrMintETH, "failed to credit the mint of a failed deposit to cosmosAddress: %v; err: %v", mintAddr, err)
 			}
 		}
+		depositEvents = append(depositEvents, executionEvents...)
 	}
 
-	return mintEvents, nil
+	return depositEvents, nil
 }
 
-// parseAndExecuteCrossDomainMessage parses the tx data of a cross domain message and applies state transitions for recognized messages.
-// Currently, only finalizeBridgeERC20 messages from the L1StandardBridge are recognized for minting ERC-20 tokens on the Cosmos chain.
-// If a message is not recognized, it returns nil and does not error.
-func (k *Keeper) parseAndExecuteCrossDomainMessage(ctx sdk.Context, txData []byte) (*sdk.Event, error) { //nolint:gocritic // hugeParam
-	crossDomainMessengerABI, err := abi.JSON(strings.NewReader(opbindings.CrossDomainMessengerMetaData.ABI))
+// executeUserDeposit applies the state transitions of a user deposit after its mint: the transfer of its value to its
+// recipient and the cross domain message it carries, if any. The rest of the mint is sent to the deposit's sender. It
+// returns an error if the deposit fails, in which case its state transitions must be discarded.
+func (k *Keeper) executeUserDeposit(
+	ctx sdk.Context, //nolint:gocritic // hugeParam
+	tx *ethtypes.Transaction,
+	from common.Address,
+	mintAddr sdk.AccAddress,
+	mintAmount sdkmath.Int,
+) (sdk.Events, error) {
+	// if the receipient is nil, it means the tx is creating a contract which we don't support.
+	// see https://github.com/ethereum-optimism/op-geth/blob/v1.101301.0-rc.2/core/state_processor.go#L154
+	if tx.To() == nil {
+		return nil, errors.New("contract creation deposits are not supported")
+	}
+
+	recipientAddr := utils.EvmToCosmosAddress(*tx.To())
+	transferAmount := sdkmath.NewIntFromBigInt(tx.Value())
+	mintEvent, err := k.sendMintedETH(ctx, mintAddr, recipientAddr, mintAmount, transferAmount)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse CrossDomainMessenger ABI: %v", err)
+		return nil, err
 	}
-	standardBridgeABI, err := abi.JSON(strings.NewReader(opbindings.StandardBridgeMetaData.ABI))
+	events := sdk.Events{*mintEvent}
+
+	// Check if the tx is a cross domain message from the aliased L1CrossDomainMessenger address
+	if from == types.AliasedL1CrossDomainMessengerAddress && tx.Data() != nil {
+		erc20mintEvent, err := k.parseAndExecuteCrossDomainMessage(ctx, tx.Data())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse or execute cross domain message: %v", err)
+		}
+		events = append(events, *erc20mintEvent)
+	}
+
+	return events, nil
+}
+
+// failDeposit credits the whole mint of a failed deposit to its sender, records the deposit so it can be queried, and
+// returns the associated events.
+func (k *Keeper) failDeposit(
+	ctx sdk.Context, //nolint:gocritic // hugeParam
+	tx *ethtypes.Transaction,
+	from common.Address,
+	mintAddr sdk.AccAddress,
+	mintAmount sdkmath.Int,
+	l1BlockNumber uint64,
+	index uint64,
+	reason error,
+) (sdk.Events, error) {
+	mintEvent, err := k.sendMintedETH(ctx, mintAddr, mintAddr, mintAmount, sdkmath.ZeroInt())
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse StandardBridge ABI: %v", err)
+		return nil, err
 	}
 
-	var relayMessage bindings.RelayMessageArgs
-	if err = unpackInputsIntoInterface(&crossDomainMessengerABI, "relayMessage", txData, &relayMessage); err != nil {
-		return nil, fmt.Errorf("failed to unpack tx data into relayMessage interface: %v", err)
+	failedDeposit := types.FailedDeposit{
+		TxHash:        tx.Hash().Hex(),
+		From:          from.Hex(),
+		MintAddress:   mintAddr.String(),
+		Mint:          mintAmount,
+		Reason:        reason.Error(),
+		L1BlockNumber: l1BlockNumber,
+	}
+	failedDepositBytes, err := failedDeposit.Marshal()
+	if err != nil {
+		return nil, types.WrapError(err, "marshal failed deposit")
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set(types.FailedDepositKey(l1BlockNumber, index), failedDepositBytes); err != nil {
+		return nil, types.WrapError(err, "set failed deposit")
 	}
 
-	// Check if the relayed message is a finalizeBridgeERC20 message from the L1StandardBridge
-	if bytes.Equal(relayMessage.Message[:4], standardBridgeABI.Methods["finalizeBridgeERC20"].ID) {
-		var finalizeBridgeERC20 bindings.FinalizeBridgeERC20Args
-		if err = unpackInputsIntoInterface(
-			&standardBridgeABI,
-			"finalizeBridgeERC20",
-			relayMessage.Message,
-			&finalizeBridgeERC20,
-		); err != nil {
-			return nil, fmt.Errorf("failed to unpack relay message into finalizeBridgeERC20 interface: %v", err)
-		}
+	return sdk.Events{
+		*mintEvent,
+		sdk.NewEvent(
+			types.EventTypeDepositFailed,
+			sdk.NewAttribute(types.AttributeKeyTxHash, failedDeposit.TxHash),
+			sdk.NewAttribute(types.AttributeKeyMintCosmosAddress, failedDeposit.MintAddress),
+			sdk.NewAttribute(types.AttributeKeyMint, hexutil.Encode(mintAmount.BigInt().Bytes())),
+			sdk.NewAttribute(types.AttributeKeyReason, failedDeposit.Reason),
+		),
+	}, nil
+}
 
-		// Mint the ERC-20 token to the specified Cosmos address
-		mintEvent, err := k.mintERC20(
-			ctx,
-			utils.EvmToCosmosAddress(finalizeBridgeERC20.To),
-			finalizeBridgeERC20.RemoteToken.String(),
-			sdkmath.NewIntFromBigInt(finalizeBridgeERC20.Amount),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to mint ERC-20 token: %v", err)
-		}
+// DepositNonce returns the number of deposits the L1 address has sent. Like the nonce of an L1 address that sends deposits
+// to op-geth, it is the depositNonce of the address's next deposit.
+func (k *Keeper) DepositNonce(ctx context.Context, from common.Address) (uint64, error) {
+	nonceBytes, err := k.storeService.OpenKVStore(ctx).Get(types.DepositNonceKey(from))
+	if err != nil {
+		return 0, types.WrapError(err, "get deposit nonce")
+	}
+	if nonceBytes == nil {
+		return 0, nil
+	}
+	return sdk.BigEndianToUint64(nonceBytes), nil
+}
+
+// depositEvent increments the deposit nonce of the deposit tx's sender and returns an event with the tx's depositNonce,
+// which Monomer adds to the tx's receipt.
+func (k *Keeper) depositEvent(ctx sdk.Context, tx *ethtypes.Transaction, from common.Address) (*sdk.Event, error) { //nolint:gocritic
+	nonce, err := k.DepositNonce(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set(types.DepositNonceKey(from), sdk.Uint64ToBigEndian(nonce+1)); err != nil {
+		return nil, types.WrapError(err, "set deposit nonce")
+	}
+	depositEvent := sdk.NewEvent(
+		types.EventTypeDeposit,
+		sdk.NewAttribute(types.AttributeKeyTxHash, tx.Hash().Hex()),
+		sdk.NewAttribute(types.AttributeKeyNonce, hexutil.EncodeUint64(nonce)),
+	)
+	return &depositEvent, nil
+}
+
+// parseAndExecuteCrossDomainMessage parses the tx data of a cross domain message and applies state transitions for recognized messages.
+// Currently, only finalizeBridgeERC20 messages from the L1StandardBridge in the module params to the L2StandardBridge are
+// recognized for minting ERC-20 tokens on the Cosmos chain. Anyone can relay other messages through the
+// L1CrossDomainMessenger, so they are rejected.
+// The token addresses of the first deposit of a token are registered, and later deposits of the token must match them.
+func (k *Keeper) parseAndExecuteCrossDomainMessage(ctx sdk.Context, txData []byte) (*sdk.Event, error) { //nolint:gocritic // hugeParam
+	relayMessage, finalizeBridgeERC20, err := bindings.UnpackRelayedFinalizeBridgeERC20(txData)
+	if errors.Is(err, bindings.ErrUnexpectedSelector) {
+		return nil, fmt.Errorf("tx data not recognized as a cross domain message: %v", txData)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to unpack cross domain message: %v", err)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.L1StandardBridge == "" {
+		return nil, errors.New("ERC-20 deposits are disabled: no L1 standard bridge in the module params")
+	}
+	if l1StandardBridge := common.HexToAddress(params.L1StandardBridge); relayMessage.Sender != l1StandardBridge {
+		return nil, fmt.Errorf("cross domain message sent by %s, not the L1 standard bridge %s", relayMessage.Sender, l1StandardBridge)
+	}
+	if relayMessage.Target != predeploys.L2StandardBridgeAddr {
+		return nil, fmt.Errorf("cross domain message sent to %s, not the L2 standard bridge %s", relayMessage.Target, predeploys.L2StandardBridgeAddr)
+	}
+
+	denom, err := k.registerERC20Token(ctx, relayMessage.Sender, finalizeBridgeERC20)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register ERC-20 token: %v", err)
+	}
 
-		return mintEvent, nil
+	// Mint the ERC-20 token to the specified Cosmos address
+	mintEvent, err := k.mintERC20(
+		ctx,
+		utils.EvmToCosmosAddress(finalizeBridgeERC20.To),
+		denom,
+		finalizeBridgeERC20.RemoteToken.String(),
+		sdkmath.NewIntFromBigInt(finalizeBridgeERC20.Amount),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mint ERC-20 token: %v", err)
 	}
 
-	return nil, fmt.Errorf("tx data not recognized as a cross domain message: %v", txData)
+	return mintEvent, nil
 }
 
-// mintETH mints ETH to an account where the amount is in wei and returns the associated event.
-func (k *Keeper) mintETH(
+// mintETH mints the ETH of a deposit to the rollup module where the amount is in wei.
+func (k *Keeper) mintETH(ctx sdk.Context, mintAmount sdkmath.Int) error { //nolint:gocritic // hugeParam
+	if !mintAmount.IsPositive() {
+		return nil
+	}
+	denom, err := k.ETHDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.bankkeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, mintAmount))); err != nil {
+		return fmt.Errorf("failed to mint ETH deposit coins to the rollup module: %v", err)
+	}
+	return nil
+}
+
+// sendMintedETH sends the ETH minted for a deposit from the rollup module to the deposit's recipient and sender where
+// the amounts are in wei, and returns the associated event.
+func (k *Keeper) sendMintedETH(
 	ctx sdk.Context, //nolint:gocritic // hugeParam
 	mintAddr, recipientAddr sdk.AccAddress,
 	mintAmount, transferAmount sdkmath.Int,
 ) (*sdk.Event, error) {
-	// Mint the deposit amount to the rollup module
-	if err := k.bankkeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.ETH, mintAmount))); err != nil {
-		return nil, fmt.Errorf("failed to mint ETH deposit coins to the rollup module: %v", err)
+	denom, err := k.ETHDenom(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	if transferAmount.GT(mintAmount) {
@@ -221,7 +374,7 @@ func (k *Keeper) mintETH(
 			ctx,
 			types.ModuleName,
 			recipientAddr,
-			sdk.NewCoins(sdk.NewCoin(types.ETH, transferAmount)),
+			sdk.NewCoins(sdk.NewCoin(denom, transferAmount)),
 		); err != nil {
 			return nil, fmt.Errorf("failed to send ETH deposit coins from rollup module to user account %v: %v", recipientAddr, err)
 		}
@@ -234,7 +387,7 @@ func (k *Keeper) mintETH(
 			ctx,
 			types.ModuleName,
 			mintAddr,
-			sdk.NewCoins(sdk.NewCoin(types.ETH, remainingCoins)),
+			sdk.NewCoins(sdk.NewCoin(denom, remainingCoins)),
 		); err != nil {
 			return nil, fmt.Errorf("failed to send ETH deposit coins from rollup module to user account %v: %v", mintAddr, err)
 		}
@@ -256,11 +409,11 @@ func (k *Keeper) mintETH(
 func (k *Keeper) mintERC20(
 	ctx sdk.Context, //nolint:gocritic // hugeParam
 	userAddr sdk.AccAddress,
+	denom string,
 	erc20addr string,
 	amount sdkmath.Int,
 ) (*sdk.Event, error) {
-	// use the "erc20/{l1erc20addr}" format for the coin denom
-	coin := sdk.NewCoin("erc20/"+erc20addr[2:], amount)
+	coin := sdk.NewCoin(denom, amount)
 	if err := k.bankkeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
 		return nil, fmt.Errorf("failed to mint ERC-20 deposit coins to the rollup module: %v", err)
 	}
@@ -278,32 +431,3 @@ func (k *Keeper) mintERC20(
 
 	return &mintEvent, nil
 }
-
-// unpackInputsIntoInterface unpacks the input data of a function call into an interface. This function behaves
-// similarly to the geth abi UnpackIntoInterface function but unpacks method inputs instead of outputs.
-func unpackInputsIntoInterface(contractABI *abi.ABI, methodName string, inputData []byte, outputInterface interface{}) error {
-	method := contractABI.Methods[methodName]
-
-	// Check if the function selector matches the method ID
-	functionSelector := inputData[:4]
-	if !bytes.Equal(functionSelector, method.ID) {
-		return fmt.Errorf("unexpected function selector: got %x, expected %x", functionSelector, method.ID)
-	}
-
-	inputs, err := method.Inputs.Unpack(inputData[4:])
-	if err != nil {
-		return fmt.Errorf("failed to unpack input data: %v", err)
-	}
-
-	outputVal := reflect.ValueOf(outputInterface).Elem()
-	for i, input := range inputs {
-		field := outputVal.Field(i)
-		if field.CanSet() {
-			val := reflect.ValueOf(input)
-			field.Set(val)
-		} else {
-			return fmt.Errorf("field %d can not be set for method %v", i, methodName)
-		}
-	}
-	return nil
-}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/polymerdao/monomer/bindings"
+	"github.com/polymerdao/monomer/x/rollup/types"
+)
+
+// Gas overheads of CrossDomainMessenger.baseGas, which the L2CrossDomainMessenger uses to set the gas limit of the
+// withdrawals relaying its messages.
+const (
+	relayConstantOverhead    = 200_000
+	minGasCalldataOverhead   = 16
+	minGasDynamicOverheadNum = 64
+	minGasDynamicOverheadDen = 63
+	relayCallOverhead        = 40_000
+	relayReservedGas         = 40_000
+	relayGasCheckBuffer      = 5_000
+)
+
+// crossDomainMessageVersion is the message version used by the CrossDomainMessengers.
+var crossDomainMessageVersion = big.NewInt(1)
+
+// GetERC20Token returns the token addresses of a bridged ERC-20 denom, or nil if the token was never deposited.
+func (k *Keeper) GetERC20Token(ctx context.Context, denom string) (*types.ERC20Token, error) {
+	tokenBytes, err := k.storeService.OpenKVStore(ctx).Get(types.ERC20TokenKey(denom))
+	if err != nil {
+		return nil, types.WrapError(err, "get ERC-20 token")
+	} else if tokenBytes == nil {
+		return nil, nil
+	}
+	var token types.ERC20Token
+	if err := token.Unmarshal(tokenBytes); err != nil {
+		return nil, types.WrapError(err, "unmarshal ERC-20 token")
+	}
+	return &token, nil
+}
+
+// registerERC20Token records the token addresses of an ERC-20 deposit the first time its token is deposited and returns
+// the denom of the token's coins. Later deposits of the token must carry the same addresses, so the token can always be
+// withdrawn to where it came from.
+func (k *Keeper) registerERC20Token(
+	ctx sdk.Context, //nolint:gocritic // hugeParam
+	l1Bridge common.Address,
+	finalizeBridgeERC20 *bindings.FinalizeBridgeERC20Args,
+) (string, error) {
+	denom := types.ERC20Denom(finalizeBridgeERC20.RemoteToken)
+	depositedToken := types.ERC20Token{
+		Denom:    denom,
+		L1Token:  finalizeBridgeERC20.RemoteToken.Hex(),
+		L2Token:  finalizeBridgeERC20.LocalToken.Hex(),
+		L1Bridge: l1Bridge.Hex(),
+	}
+
+	token, err := k.GetERC20Token(ctx, denom)
+	if err != nil {
+		return "", err
+	} else if token != nil {
+		if *token != depositedToken {
+			return "", fmt.Errorf("deposit of %s doesn't match its token: got L2 token %s from bridge %s, want L2 token %s from bridge %s",
+				denom, depositedToken.L2Token, depositedToken.L1Bridge, token.L2Token, token.L1Bridge)
+		}
+		return denom, nil
+	}
+
+	tokenBytes, err := depositedToken.Marshal()
+	if err != nil {
+		return "", types.WrapError(err, "marshal ERC-20 token")
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set(types.ERC20TokenKey(denom), tokenBytes); err != nil {
+		return "", types.WrapError(err, "set ERC-20 token")
+	}
+	return denom, nil
+}
+
+// initiateERC20Withdrawal burns bridged ERC-20 coins and initiates the withdrawal the L2StandardBridge would send to
+// unlock the token on L1: a finalizeBridgeERC20 message to the L1StandardBridge, relayed by the L1CrossDomainMessenger.
+// It returns the associated events.
+func (k *Keeper) initiateERC20Withdrawal(
+	ctx sdk.Context, //nolint:gocritic // hugeParam
+	sender sdk.AccAddress,
+	msg *types.MsgInitiateWithdrawal,
+) (sdk.Events, error) {
+	token, err := k.GetERC20Token(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	} else if token == nil {
+		return nil, fmt.Errorf("unknown ERC-20 denom: %s", msg.Denom)
+	}
+
+	if err := k.burnCoins(ctx, sender, sdk.NewCoins(sdk.NewCoin(msg.Denom, msg.Value))); err != nil {
+		return nil, err
+	}
+
+	// The L1StandardBridge finalizes the withdrawal with its own token first, so the tokens are swapped from the deposit.
+	finalizeBridgeERC20, err := (&bindings.FinalizeBridgeERC20Args{
+		RemoteToken: common.HexToAddress(token.L2Token),
+		LocalToken:  common.HexToAddress(token.L1Token),
+		From:        common.BytesToAddress(sender.Bytes()),
+		To:          common.HexToAddress(msg.Target),
+		Amount:      msg.Value.BigInt(),
+		ExtraData:   msg.Data,
+	}).Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := k.crossDomainMessageNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+	minGasLimit := new(big.Int).SetBytes(msg.GasLimit).Uint64()
+	relayMessage, err := (&bindings.RelayMessageArgs{
+		Nonce:       crossdomain.EncodeVersionedNonce(nonce, crossDomainMessageVersion),
+		Sender:      predeploys.L2StandardBridgeAddr,
+		Target:      common.HexToAddress(token.L1Bridge),
+		Value:       new(big.Int),
+		MinGasLimit: new(big.Int).SetUint64(minGasLimit),
+		Message:     finalizeBridgeERC20,
+	}).Pack()
+	if err != nil {
+		return nil, err
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set(
+		[]byte(types.KeyCrossDomainMessageNonce),
+		new(big.Int).Add(nonce, big.NewInt(1)).Bytes(),
+	); err != nil {
+		return nil, types.WrapError(err, "set cross domain message nonce")
+	}
+
+	messenger := sdk.AccAddress(predeploys.L2CrossDomainMessengerAddr.Bytes())
+	withdrawal := &types.MsgInitiateWithdrawal{
+		Sender:   messenger.String(),
+		Target:   types.L1CrossDomainMessengerAddress.Hex(),
+		Value:    sdkmath.ZeroInt(),
+		GasLimit: new(big.Int).SetUint64(relayBaseGas(len(finalizeBridgeERC20), minGasLimit)).Bytes(),
+		Data:     relayMessage,
+	}
+	withdrawalHash, err := k.commitWithdrawal(ctx, messenger, withdrawal)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.Events{
+		withdrawalInitiatedEvent(withdrawal, withdrawalHash),
+		sdk.NewEvent(
+			types.EventTypeBurnERC20,
+			sdk.NewAttribute(types.AttributeKeyL2WithdrawalTx, types.EventTypeWithdrawalInitiated),
+			sdk.NewAttribute(types.AttributeKeyFromCosmosAddress, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Address, token.L1Token),
+			sdk.NewAttribute(types.AttributeKeyValue, hexutil.Encode(msg.Value.BigInt().Bytes())),
+		),
+	}, nil
+}
+
+// crossDomainMessageNonce returns the nonce of the next cross domain message sent to L1. It doesn't include the message
+// version.
+func (k *Keeper) crossDomainMessageNonce(ctx context.Context) (*big.Int, error) {
+	nonceBytes, err := k.storeService.OpenKVStore(ctx).Get([]byte(types.KeyCrossDomainMessageNonce))
+	if err != nil {
+		return nil, types.WrapError(err, "get cross domain message nonce")
+	}
+	return new(big.Int).SetBytes(nonceBytes), nil
+}
+
+// relayBaseGas returns the gas limit CrossDomainMessenger.baseGas sets for the withdrawal relaying a message, so the
+// message is relayed on L1 with at least its minimum gas limit.
+func relayBaseGas(messageLen int, minGasLimit uint64) uint64 {
+	return relayConstantOverhead +
+		uint64(messageLen)*minGasCalldataOverhead +
+		minGasLimit*minGasDynamicOverheadNum/minGasDynamicOverheadDen +
+		relayCallOverhead +
+		relayReservedGas +
+		relayGasCheckBuffer
+}
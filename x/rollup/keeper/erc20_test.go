@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymerdao/monomer/bindings"
+	"github.com/polymerdao/monomer/testutils"
+	"github.com/polymerdao/monomer/x/rollup/types"
+)
+
+func (s *KeeperTestSuite) TestERC20() {
+	l1AttributesTx, _, _ := testutils.GenerateEthTxs(s.T())
+	l1Token := common.HexToAddress("0x04")
+	userAddr := common.HexToAddress("0x03")
+	depositTx := testutils.GenerateERC20DepositTx(s.T(), l1Token, userAddr, big.NewInt(100))
+	relayMessage, finalizeBridgeERC20, err := bindings.UnpackRelayedFinalizeBridgeERC20(depositTx.Data())
+	s.Require().NoError(err)
+	denom := types.ERC20Denom(l1Token)
+
+	enableERC20Deposits := func() {
+		params := types.DefaultParams()
+		params.L1StandardBridge = testutils.L1StandardBridge.Hex()
+		s.Require().NoError(s.rollupKeeper.SetParams(s.ctx, &params))
+	}
+
+	relay := func(relayMessage *bindings.RelayMessageArgs) *gethtypes.Transaction {
+		data, err := relayMessage.Pack()
+		s.Require().NoError(err)
+		return gethtypes.NewTx(&gethtypes.DepositTx{
+			From:  types.AliasedL1CrossDomainMessengerAddress,
+			To:    depositTx.To(),
+			Value: new(big.Int),
+			Gas:   depositTx.Gas(),
+			Data:  data,
+		})
+	}
+
+	requireDepositFailed := func() {
+		var eventTypes []string
+		for _, event := range s.eventManger.Events() {
+			eventTypes = append(eventTypes, event.Type)
+		}
+		s.Require().Contains(eventTypes, types.EventTypeDepositFailed)
+		s.Require().NotContains(eventTypes, types.EventTypeMintERC20)
+	}
+
+	applyL1Txs := func(txs ...*gethtypes.Transaction) {
+		s.mockMintETH()
+		txBytes := [][]byte{testutils.TxToBytes(s.T(), l1AttributesTx)}
+		for _, tx := range txs {
+			txBytes = append(txBytes, testutils.TxToBytes(s.T(), tx))
+		}
+		s.eventManger = sdk.NewEventManager()
+		_, err := s.rollupKeeper.ApplyL1Txs(sdk.UnwrapSDKContext(s.ctx).WithEventManager(s.eventManger), &types.MsgApplyL1Txs{
+			TxBytes: txBytes,
+		})
+		s.Require().NoError(err)
+	}
+
+	s.Run("the first deposit of a token registers it", func() {
+		enableERC20Deposits()
+		applyL1Txs(depositTx)
+
+		token, err := s.rollupKeeper.GetERC20Token(s.ctx, denom)
+		s.Require().NoError(err)
+		s.Require().Equal(&types.ERC20Token{
+			Denom:    denom,
+			L1Token:  l1Token.Hex(),
+			L2Token:  finalizeBridgeERC20.LocalToken.Hex(),
+			L1Bridge: testutils.L1StandardBridge.Hex(),
+		}, token)
+	})
+
+	s.Run("spoofed deposits don't register the token", func() {
+		enableERC20Deposits()
+		// Anyone can send a finalizeBridgeERC20 message through the L1CrossDomainMessenger.
+		spoofedRelayMessage := *relayMessage
+		spoofedRelayMessage.Sender = common.HexToAddress("0x0bad")
+		applyL1Txs(relay(&spoofedRelayMessage))
+		requireDepositFailed()
+
+		token, err := s.rollupKeeper.GetERC20Token(s.ctx, denom)
+		s.Require().NoError(err)
+		s.Require().Nil(token)
+
+		// The token is registered with the L1StandardBridge once it is deposited through it.
+		applyL1Txs(depositTx)
+		token, err = s.rollupKeeper.GetERC20Token(s.ctx, denom)
+		s.Require().NoError(err)
+		s.Require().Equal(testutils.L1StandardBridge.Hex(), token.L1Bridge)
+	})
+
+	s.Run("deposits to another target than the L2StandardBridge fail", func() {
+		enableERC20Deposits()
+		misdirectedRelayMessage := *relayMessage
+		misdirectedRelayMessage.Target = common.HexToAddress("0x07")
+		applyL1Txs(relay(&misdirectedRelayMessage))
+		requireDepositFailed()
+	})
+
+	s.Run("deposits fail without an L1StandardBridge in the params", func() {
+		applyL1Txs(depositTx)
+		requireDepositFailed()
+	})
+
+	s.Run("deposits that don't match the registered token fail", func() {
+		enableERC20Deposits()
+		applyL1Txs(depositTx)
+
+		mismatchedFinalizeBridgeERC20 := *finalizeBridgeERC20
+		mismatchedFinalizeBridgeERC20.LocalToken = common.HexToAddress("0x05")
+		message, err := mismatchedFinalizeBridgeERC20.Pack()
+		s.Require().NoError(err)
+		mismatchedRelayMessage := *relayMessage
+		mismatchedRelayMessage.Message = message
+		applyL1Txs(relay(&mismatchedRelayMessage))
+		requireDepositFailed()
+	})
+
+	s.Run("withdrawals unlock the token through the standard bridge", func() {
+		enableERC20Deposits()
+		applyL1Txs(depositTx)
+		s.mockBurnETH()
+		target := common.HexToAddress("0x06")
+		s.eventManger = sdk.NewEventManager()
+		_, err := s.rollupKeeper.InitiateWithdrawal(sdk.UnwrapSDKContext(s.ctx).WithEventManager(s.eventManger), &types.MsgInitiateWithdrawal{
+			Sender:   sdk.AccAddress(userAddr.Bytes()).String(),
+			Target:   target.Hex(),
+			Value:    math.NewInt(60),
+			GasLimit: big.NewInt(100_000).Bytes(),
+			Denom:    denom,
+		})
+		s.Require().NoError(err)
+
+		events := s.eventManger.Events()
+		expectedEventTypes := []string{
+			sdk.EventTypeMessage,
+			types.EventTypeWithdrawalInitiated,
+			types.EventTypeBurnERC20,
+		}
+		for i, event := range events {
+			s.Require().Equal(expectedEventTypes[i], event.Type)
+		}
+
+		withdrawalEvent := events[1]
+		sender, ok := withdrawalEvent.GetAttribute(types.AttributeKeySender)
+		s.Require().True(ok)
+		s.Require().Equal(sdk.AccAddress(predeploys.L2CrossDomainMessengerAddr.Bytes()).String(), sender.Value)
+		l1Target, ok := withdrawalEvent.GetAttribute(types.AttributeKeyL1Target)
+		s.Require().True(ok)
+		s.Require().Equal(types.L1CrossDomainMessengerAddress.Hex(), l1Target.Value)
+
+		data, ok := withdrawalEvent.GetAttribute(types.AttributeKeyData)
+		s.Require().True(ok)
+		withdrawalRelayMessage := new(bindings.RelayMessageArgs)
+		s.Require().NoError(withdrawalRelayMessage.Unpack(common.FromHex(data.Value)))
+		s.Require().Equal(predeploys.L2StandardBridgeAddr, withdrawalRelayMessage.Sender)
+		s.Require().Equal(relayMessage.Sender, withdrawalRelayMessage.Target)
+		s.Require().Equal(big.NewInt(100_000), withdrawalRelayMessage.MinGasLimit)
+		withdrawalFinalizeBridgeERC20 := new(bindings.FinalizeBridgeERC20Args)
+		s.Require().NoError(withdrawalFinalizeBridgeERC20.Unpack(withdrawalRelayMessage.Message))
+		s.Require().Equal(&bindings.FinalizeBridgeERC20Args{
+			RemoteToken: finalizeBridgeERC20.LocalToken,
+			LocalToken:  l1Token,
+			From:        userAddr,
+			To:          target,
+			Amount:      big.NewInt(60),
+			ExtraData:   []byte{},
+		}, withdrawalFinalizeBridgeERC20)
+
+		withdrawalHash, ok := withdrawalEvent.GetAttribute(types.AttributeKeyWithdrawalHash)
+		s.Require().True(ok)
+		committed, err := s.rollupKeeper.WithdrawalCommitted(s.ctx, common.HexToHash(withdrawalHash.Value))
+		s.Require().NoError(err)
+		s.Require().True(committed)
+	})
+
+	s.Run("withdrawals of unknown denoms fail", func() {
+		s.mockBurnETH()
+		_, err := s.rollupKeeper.InitiateWithdrawal(s.ctx, &types.MsgInitiateWithdrawal{
+			Sender:   sdk.AccAddress(userAddr.Bytes()).String(),
+			Target:   common.HexToAddress("0x06").Hex(),
+			Value:    math.NewInt(60),
+			GasLimit: big.NewInt(100_000).Bytes(),
+			Denom:    denom,
+		})
+		s.Require().ErrorIs(err, types.ErrERC20Withdrawal)
+	})
+}
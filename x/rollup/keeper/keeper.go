@@ -14,23 +14,35 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 	storeService store.KVStoreService
 	rollupCfg    *rollup.Config
-	bankkeeper   types.BankKeeper
+	// authority is the address that can update the module parameters, usually the governance module account.
+	authority     string
+	bankkeeper    types.BankKeeper
+	accountkeeper types.AccountKeeper
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
+	authority string,
 	// dependencies
 	bankKeeper types.BankKeeper,
+	accountKeeper types.AccountKeeper,
 ) *Keeper {
 	return &Keeper{
-		cdc:          cdc,
-		storeService: storeService,
-		bankkeeper:   bankKeeper,
-		rollupCfg:    &rollup.Config{},
+		cdc:           cdc,
+		storeService:  storeService,
+		authority:     authority,
+		bankkeeper:    bankKeeper,
+		accountkeeper: accountKeeper,
+		rollupCfg:     &rollup.Config{},
 	}
 }
 
+// Authority returns the address that can update the module parameters.
+func (k *Keeper) Authority() string {
+	return k.authority
+}
+
 // Helper. Prepares a `message` event with the module name and emits it
 // along with the provided events.
 func (k *Keeper) EmitEvents(goCtx context.Context, events sdk.Events) {
@@ -9,6 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/polymerdao/monomer/x/rollup/keeper"
 	rolluptestutil "github.com/polymerdao/monomer/x/rollup/testutil"
 	"github.com/polymerdao/monomer/x/rollup/types"
@@ -18,11 +20,12 @@ import (
 
 type KeeperTestSuite struct {
 	suite.Suite
-	ctx          context.Context
-	rollupKeeper *keeper.Keeper
-	bankKeeper   *rolluptestutil.MockBankKeeper
-	rollupStore  storetypes.KVStore
-	eventManger  sdk.EventManagerI
+	ctx           context.Context
+	rollupKeeper  *keeper.Keeper
+	bankKeeper    *rolluptestutil.MockBankKeeper
+	accountKeeper *rolluptestutil.MockAccountKeeper
+	rollupStore   storetypes.KVStore
+	eventManger   sdk.EventManagerI
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -35,11 +38,15 @@ func (s *KeeperTestSuite) SetupSubTest() {
 		s.T(),
 		storeKey,
 		storetypes.NewTransientStoreKey("transient_test")).Ctx
-	s.bankKeeper = rolluptestutil.NewMockBankKeeper(gomock.NewController(s.T()))
+	ctrl := gomock.NewController(s.T())
+	s.bankKeeper = rolluptestutil.NewMockBankKeeper(ctrl)
+	s.accountKeeper = rolluptestutil.NewMockAccountKeeper(ctrl)
 	s.rollupKeeper = keeper.NewKeeper(
 		moduletestutil.MakeTestEncodingConfig().Codec,
 		runtime.NewKVStoreService(storeKey),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		s.bankKeeper,
+		s.accountKeeper,
 	)
 	sdkCtx := sdk.UnwrapSDKContext(s.ctx)
 	s.rollupStore = sdkCtx.KVStore(storeKey)
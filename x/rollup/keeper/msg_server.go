@@ -18,7 +18,7 @@ func (k *Keeper) ApplyL1Txs(goCtx context.Context, msg *types.MsgApplyL1Txs) (*t
 	ctx.Logger().Debug("Processing L1 txs", "txCount", len(msg.TxBytes))
 
 	// process L1 attributes tx and get L1 block info
-	l1blockInfo, err := k.processL1AttributesTx(ctx, msg.TxBytes[0])
+	l1blockInfo, l1AttributesEvent, err := k.processL1AttributesTx(ctx, msg.TxBytes[0])
 	if err != nil {
 		ctx.Logger().Error("Failed to process L1 system deposit tx", "err", err)
 		return nil, types.WrapError(types.ErrProcessL1SystemDepositTx, "err: %v", err)
@@ -33,13 +33,13 @@ func (k *Keeper) ApplyL1Txs(goCtx context.Context, msg *types.MsgApplyL1Txs) (*t
 	ctx.Logger().Info("Save L1 block info", "l1blockInfo", string(lo.Must(l1blockInfo.Marshal())))
 
 	// process L1 user deposit txs
-	mintEvents, err := k.processL1UserDepositTxs(ctx, msg.TxBytes, l1blockInfo)
+	depositEvents, err := k.processL1UserDepositTxs(ctx, msg.TxBytes, l1blockInfo)
 	if err != nil {
 		ctx.Logger().Error("Failed to process L1 user deposit txs", "err", err)
 		return nil, types.WrapError(types.ErrProcessL1UserDepositTxs, "err: %v", err)
 	}
 
-	k.EmitEvents(goCtx, mintEvents)
+	k.EmitEvents(goCtx, append(sdk.Events{*l1AttributesEvent}, depositEvents...))
 
 	return &types.MsgApplyL1TxsResponse{}, nil
 }
@@ -57,29 +57,100 @@ func (k *Keeper) InitiateWithdrawal(
 		return nil, types.WrapError(types.ErrInvalidSender, "failed to create cosmos address for sender: %v; error: %v", msg.Sender, err)
 	}
 
+	// Withdrawals with a denom withdraw bridged ERC-20 tokens through the L1StandardBridge.
+	if msg.Denom != "" {
+		events, err := k.initiateERC20Withdrawal(ctx, cosmAddr, msg)
+		if err != nil {
+			ctx.Logger().Error("Failed to initiate ERC-20 withdrawal", "cosmosAddress", cosmAddr, "denom", msg.Denom, "err", err)
+			return nil, types.WrapError(types.ErrERC20Withdrawal, "failed to initiate ERC-20 withdrawal for cosmosAddress: %v; err: %v", cosmAddr, err)
+		}
+		k.EmitEvents(ctx, events)
+		return &types.MsgInitiateWithdrawalResponse{}, nil
+	}
+
+	var params *types.Params
+	if msg.Batched {
+		if params, err = k.GetParams(ctx); err != nil {
+			return nil, err
+		}
+		if params.WithdrawalClaimer == "" {
+			return nil, types.WrapError(types.ErrWithdrawalBatching, "withdrawal batching is disabled")
+		}
+	}
+
 	if err = k.burnETH(ctx, cosmAddr, msg.Value); err != nil {
 		ctx.Logger().Error("Failed to burn ETH", "cosmosAddress", cosmAddr, "evmAddress", msg.Target, "err", err)
 		return nil, types.WrapError(types.ErrBurnETH, "failed to burn ETH for cosmosAddress: %v; err: %v", cosmAddr, err)
 	}
 
 	withdrawalValueHex := hexutil.Encode(msg.Value.BigInt().Bytes())
-	k.EmitEvents(ctx, sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeWithdrawalInitiated,
-			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
-			sdk.NewAttribute(types.AttributeKeyL1Target, msg.Target),
-			sdk.NewAttribute(types.AttributeKeyValue, withdrawalValueHex),
-			sdk.NewAttribute(types.AttributeKeyGasLimit, hexutil.Encode(msg.GasLimit)),
-			sdk.NewAttribute(types.AttributeKeyData, hexutil.Encode(msg.Data)),
-			// The nonce attribute will be set by Monomer
-		),
+	events := sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeBurnETH,
 			sdk.NewAttribute(types.AttributeKeyL2WithdrawalTx, types.EventTypeWithdrawalInitiated),
 			sdk.NewAttribute(types.AttributeKeyFromCosmosAddress, msg.Sender),
 			sdk.NewAttribute(types.AttributeKeyValue, withdrawalValueHex),
 		),
-	})
+	}
+
+	if msg.Batched {
+		batchEvents, err := k.batchWithdrawal(ctx, params, msg)
+		if err != nil {
+			ctx.Logger().Error("Failed to batch withdrawal", "cosmosAddress", cosmAddr, "err", err)
+			return nil, types.WrapError(types.ErrWithdrawalBatching, "failed to batch withdrawal for cosmosAddress: %v; err: %v", cosmAddr, err)
+		}
+		k.EmitEvents(ctx, append(batchEvents, events...))
+		return &types.MsgInitiateWithdrawalResponse{}, nil
+	}
+
+	withdrawalHash, err := k.commitWithdrawal(ctx, cosmAddr, msg)
+	if err != nil {
+		ctx.Logger().Error("Failed to commit withdrawal", "cosmosAddress", cosmAddr, "err", err)
+		return nil, types.WrapError(types.ErrCommitWithdrawal, "failed to commit withdrawal for cosmosAddress: %v; err: %v", cosmAddr, err)
+	}
+
+	k.EmitEvents(ctx, append(sdk.Events{withdrawalInitiatedEvent(msg, withdrawalHash)}, events...))
 
 	return &types.MsgInitiateWithdrawalResponse{}, nil
 }
+
+// FlushWithdrawalBatch implements types.MsgServer. It lets anyone flush the pending withdrawal batch to L1 before it is
+// full.
+func (k *Keeper) FlushWithdrawalBatch(
+	goCtx context.Context,
+	msg *types.MsgFlushWithdrawalBatch,
+) (*types.MsgFlushWithdrawalBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.Logger().Debug("Flushing withdrawal batch", "sender", msg.Sender)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.WithdrawalClaimer == "" {
+		return nil, types.WrapError(types.ErrWithdrawalBatching, "withdrawal batching is disabled")
+	}
+
+	events, err := k.flushWithdrawalBatch(ctx, params)
+	if err != nil {
+		ctx.Logger().Error("Failed to flush withdrawal batch", "err", err)
+		return nil, types.WrapError(types.ErrWithdrawalBatching, "failed to flush withdrawal batch: %v", err)
+	}
+	k.EmitEvents(ctx, events)
+
+	return &types.MsgFlushWithdrawalBatchResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k *Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, types.WrapError(types.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	if err := k.SetParams(ctx, &msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
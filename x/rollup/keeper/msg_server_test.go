@@ -1,13 +1,17 @@
 package keeper_test
 
 import (
+	"math/big"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/golang/mock/gomock"
 	"github.com/polymerdao/monomer/testutils"
+	"github.com/polymerdao/monomer/utils"
 	"github.com/polymerdao/monomer/x/rollup/types"
 )
 
@@ -17,6 +21,7 @@ func (s *KeeperTestSuite) TestApplyL1Txs() {
 	contractCreationTx := gethtypes.NewTx(&gethtypes.DepositTx{})
 
 	l1AttributesTxBz := testutils.TxToBytes(s.T(), l1AttributesTx)
+	ecotoneL1AttributesTxBz := testutils.TxToBytes(s.T(), testutils.GenerateEcotoneL1InfoTx(s.T()))
 	depositTxBz := testutils.TxToBytes(s.T(), depositTx)
 	cosmosEthTxBz := testutils.TxToBytes(s.T(), cosmosEthTx)
 	contractCreationTxBz := testutils.TxToBytes(s.T(), contractCreationTx)
@@ -33,6 +38,15 @@ func (s *KeeperTestSuite) TestApplyL1Txs() {
 			shouldError: false,
 			expectedEventTypes: []string{
 				sdk.EventTypeMessage,
+				types.EventTypeDeposit,
+			},
+		},
+		"successful message with an Ecotone l1 attributes tx": {
+			txBytes:     [][]byte{ecotoneL1AttributesTxBz},
+			shouldError: false,
+			expectedEventTypes: []string{
+				sdk.EventTypeMessage,
+				types.EventTypeDeposit,
 			},
 		},
 		"successful message with single user deposit tx": {
@@ -40,6 +54,8 @@ func (s *KeeperTestSuite) TestApplyL1Txs() {
 			shouldError: false,
 			expectedEventTypes: []string{
 				sdk.EventTypeMessage,
+				types.EventTypeDeposit,
+				types.EventTypeDeposit,
 				types.EventTypeMintETH,
 			},
 		},
@@ -48,7 +64,10 @@ func (s *KeeperTestSuite) TestApplyL1Txs() {
 			shouldError: false,
 			expectedEventTypes: []string{
 				sdk.EventTypeMessage,
+				types.EventTypeDeposit,
+				types.EventTypeDeposit,
 				types.EventTypeMintETH,
+				types.EventTypeDeposit,
 				types.EventTypeMintETH,
 			},
 		},
@@ -76,9 +95,16 @@ func (s *KeeperTestSuite) TestApplyL1Txs() {
 			txBytes:     [][]byte{l1AttributesTxBz, l1AttributesTxBz},
 			shouldError: true,
 		},
-		"contract creation tx passed in as user deposit tx": {
+		"contract creation tx passed in as user deposit tx fails the deposit": {
 			txBytes:     [][]byte{l1AttributesTxBz, contractCreationTxBz},
-			shouldError: true,
+			shouldError: false,
+			expectedEventTypes: []string{
+				sdk.EventTypeMessage,
+				types.EventTypeDeposit,
+				types.EventTypeDeposit,
+				types.EventTypeMintETH,
+				types.EventTypeDepositFailed,
+			},
 		},
 		"one valid l1 user deposit tx and an invalid tx passed in as user deposit txs": {
 			txBytes:     [][]byte{l1AttributesTxBz, depositTxBz, invalidTxBz},
@@ -91,11 +117,25 @@ func (s *KeeperTestSuite) TestApplyL1Txs() {
 			},
 			shouldError: true,
 		},
-		"bank keeper send coins failure": {
+		"bank keeper send coins failure fails the deposit": {
 			txBytes: [][]byte{l1AttributesTxBz, depositTxBz},
 			setupMocks: func() {
 				s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(sdkerrors.ErrUnknownRequest)
 			},
+			shouldError: false,
+			expectedEventTypes: []string{
+				sdk.EventTypeMessage,
+				types.EventTypeDeposit,
+				types.EventTypeDeposit,
+				types.EventTypeMintETH,
+				types.EventTypeDepositFailed,
+			},
+		},
+		"bank keeper send coins failure when crediting a failed deposit": {
+			txBytes: [][]byte{l1AttributesTxBz, depositTxBz},
+			setupMocks: func() {
+				s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(sdkerrors.ErrUnknownRequest).Times(2)
+			},
 			shouldError: true,
 		},
 	}
@@ -141,6 +181,80 @@ func (s *KeeperTestSuite) TestApplyL1Txs() {
 	}
 }
 
+func (s *KeeperTestSuite) TestDepositNonces() {
+	l1AttributesTx, depositTx, _ := testutils.GenerateEthTxs(s.T())
+	l1AttributesTxBz := testutils.TxToBytes(s.T(), l1AttributesTx)
+	depositTxBz := testutils.TxToBytes(s.T(), depositTx)
+	depositor, err := gethtypes.NewCancunSigner(depositTx.ChainId()).Sender(depositTx)
+	s.Require().NoError(err)
+
+	s.Run("nonces count the deposits of each L1 address", func() {
+		s.mockMintETH()
+		applyL1Txs := func() map[common.Hash][]string {
+			s.eventManger = sdk.NewEventManager()
+			_, err := s.rollupKeeper.ApplyL1Txs(sdk.UnwrapSDKContext(s.ctx).WithEventManager(s.eventManger), &types.MsgApplyL1Txs{
+				TxBytes: [][]byte{l1AttributesTxBz, depositTxBz, depositTxBz},
+			})
+			s.Require().NoError(err)
+			nonces := make(map[common.Hash][]string)
+			for _, event := range s.eventManger.Events() {
+				if event.Type != types.EventTypeDeposit {
+					continue
+				}
+				txHash, ok := event.GetAttribute(types.AttributeKeyTxHash)
+				s.Require().True(ok)
+				nonce, ok := event.GetAttribute(types.AttributeKeyNonce)
+				s.Require().True(ok)
+				hash := common.HexToHash(txHash.Value)
+				nonces[hash] = append(nonces[hash], nonce.Value)
+			}
+			return nonces
+		}
+
+		s.Require().Equal(map[common.Hash][]string{
+			l1AttributesTx.Hash(): {"0x0"},
+			depositTx.Hash():      {"0x0", "0x1"},
+		}, applyL1Txs())
+		s.Require().Equal(map[common.Hash][]string{
+			l1AttributesTx.Hash(): {"0x1"},
+			depositTx.Hash():      {"0x2", "0x3"},
+		}, applyL1Txs())
+
+		nonce, err := s.rollupKeeper.DepositNonce(s.ctx, depositor)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(4), nonce)
+		nonce, err = s.rollupKeeper.DepositNonce(s.ctx, common.Address{1})
+		s.Require().NoError(err)
+		s.Require().Zero(nonce)
+	})
+}
+
+func (s *KeeperTestSuite) TestFailedDeposits() {
+	l1AttributesTx, depositTx, _ := testutils.GenerateEthTxs(s.T())
+	contractCreationTx := gethtypes.NewTx(&gethtypes.DepositTx{Mint: big.NewInt(100)})
+	s.mockMintETH()
+
+	_, err := s.rollupKeeper.ApplyL1Txs(s.ctx, &types.MsgApplyL1Txs{
+		TxBytes: [][]byte{
+			testutils.TxToBytes(s.T(), l1AttributesTx),
+			testutils.TxToBytes(s.T(), depositTx),
+			testutils.TxToBytes(s.T(), contractCreationTx),
+		},
+	})
+	s.Require().NoError(err)
+
+	resp, err := s.rollupKeeper.FailedDeposits(s.ctx, &types.QueryFailedDepositsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.FailedDeposit{{
+		TxHash:        contractCreationTx.Hash().Hex(),
+		From:          common.Address{}.Hex(),
+		MintAddress:   utils.EvmToCosmosAddress(common.Address{}).String(),
+		Mint:          math.NewInt(100),
+		Reason:        "contract creation deposits are not supported",
+		L1BlockNumber: eth.BlockToInfo(testutils.GenerateL1Block()).NumberU64(),
+	}}, resp.FailedDeposits)
+}
+
 func (s *KeeperTestSuite) TestInitiateWithdrawal() {
 	sender := sdk.AccAddress("addr").String()
 	l1Target := "0x12345abcde"
@@ -204,6 +318,74 @@ func (s *KeeperTestSuite) TestInitiateWithdrawal() {
 				for i, event := range s.eventManger.Events() {
 					s.Require().Equal(expectedEventTypes[i], event.Type)
 				}
+
+				// Verify that the withdrawal hash is committed to the store
+				withdrawalEvent := s.eventManger.Events()[1]
+				withdrawalHash, ok := withdrawalEvent.GetAttribute(types.AttributeKeyWithdrawalHash)
+				s.Require().True(ok)
+				s.Require().Equal(
+					types.WithdrawalCommitmentValue,
+					s.rollupStore.Get(types.WithdrawalCommitmentKey(common.HexToHash(withdrawalHash.Value))),
+				)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.Params{
+		Sponsor:              sdk.AccAddress("sponsor").String(),
+		SponsoredMsgTypeUrls: []string{sdk.MsgTypeURL(&types.MsgInitiateWithdrawal{})},
+		MaxSponsoredFee:      sdk.NewCoins(sdk.NewInt64Coin(types.ETH, 100)),
+	}
+
+	tests := map[string]struct {
+		authority   string
+		params      types.Params
+		shouldError bool
+	}{
+		"successful message": {
+			authority: s.rollupKeeper.Authority(),
+			params:    params,
+		},
+		"unauthorized": {
+			authority:   sdk.AccAddress("addr").String(),
+			params:      params,
+			shouldError: true,
+		},
+		"invalid params": {
+			authority: s.rollupKeeper.Authority(),
+			params: types.Params{
+				SponsoredMsgTypeUrls: []string{"not a type URL"},
+			},
+			shouldError: true,
+		},
+		"invalid withdrawal batch size": {
+			authority: s.rollupKeeper.Authority(),
+			params: types.Params{
+				WithdrawalClaimer: common.Address{1}.Hex(),
+			},
+			shouldError: true,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			resp, err := s.rollupKeeper.UpdateParams(s.ctx, &types.MsgUpdateParams{
+				Authority: test.authority,
+				Params:    test.params,
+			})
+
+			got, getErr := s.rollupKeeper.GetParams(s.ctx)
+			s.Require().NoError(getErr)
+			if test.shouldError {
+				s.Require().Error(err)
+				s.Require().Nil(resp)
+				s.Require().Equal(types.DefaultParams(), *got)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotNil(resp)
+				s.Require().Equal(test.params, *got)
 			}
 		})
 	}
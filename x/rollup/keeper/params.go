@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/polymerdao/monomer/x/rollup/types"
+)
+
+func (k *Keeper) InitGenesis(ctx context.Context, genesis *types.GenesisState) error {
+	if err := genesis.Validate(); err != nil {
+		return fmt.Errorf("validate genesis: %v", err)
+	}
+	if err := k.SetParams(ctx, &genesis.Params); err != nil {
+		return err
+	}
+	metadata := genesis.ETHDenomMetadata()
+	k.bankkeeper.SetDenomMetaData(ctx, metadata)
+	if err := k.storeService.OpenKVStore(ctx).Set([]byte(types.KeyETHDenom), []byte(metadata.Base)); err != nil {
+		return fmt.Errorf("set eth denom: %v", err)
+	}
+	return nil
+}
+
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis := &types.GenesisState{
+		Params: *params,
+	}
+	denom, err := k.ETHDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if metadata, ok := k.bankkeeper.GetDenomMetaData(ctx, denom); ok {
+		genesis.EthDenomMetadata = &metadata
+	}
+	return genesis, nil
+}
+
+// ETHDenom returns the denom deposits mint and withdrawals burn, or the default ETH denom if it was never set.
+func (k *Keeper) ETHDenom(ctx context.Context) (string, error) {
+	denom, err := k.storeService.OpenKVStore(ctx).Get([]byte(types.KeyETHDenom))
+	if err != nil {
+		return "", fmt.Errorf("get eth denom: %v", err)
+	} else if denom == nil {
+		return types.ETH, nil
+	}
+	return string(denom), nil
+}
+
+// GetParams returns the module parameters, or the default parameters if they were never set.
+func (k *Keeper) GetParams(ctx context.Context) (*types.Params, error) {
+	paramsBytes, err := k.storeService.OpenKVStore(ctx).Get([]byte(types.KeyParams))
+	if err != nil {
+		return nil, fmt.Errorf("get params: %v", err)
+	} else if paramsBytes == nil {
+		params := types.DefaultParams()
+		return &params, nil
+	}
+	var params types.Params
+	if err := k.cdc.Unmarshal(paramsBytes, &params); err != nil {
+		return nil, fmt.Errorf("unmarshal params: %v", err)
+	}
+	return &params, nil
+}
+
+// SetParams sets the module parameters. The parameters must be valid.
+func (k *Keeper) SetParams(ctx context.Context, params *types.Params) error {
+	paramsBytes, err := k.cdc.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("marshal params: %v", err)
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set([]byte(types.KeyParams), paramsBytes); err != nil {
+		return fmt.Errorf("set params: %v", err)
+	}
+	return nil
+}
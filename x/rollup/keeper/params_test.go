@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/polymerdao/monomer/x/rollup/types"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *KeeperTestSuite) TestGenesis() {
+	weth := banktypes.Metadata{
+		Description: "Wrapped Ether",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "aweth", Exponent: 0},
+			{Denom: "weth", Exponent: 18},
+		},
+		Base:    "aweth",
+		Display: "weth",
+		Name:    "Wrapped Ether",
+		Symbol:  "WETH",
+	}
+
+	tests := map[string]struct {
+		genesis     *types.GenesisState
+		metadata    banktypes.Metadata
+		shouldError bool
+	}{
+		"default genesis": {
+			genesis:  types.DefaultGenesisState(),
+			metadata: types.DefaultETHDenomMetadata(),
+		},
+		"unset eth denom metadata": {
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+			},
+			metadata: types.DefaultETHDenomMetadata(),
+		},
+		"custom eth denom metadata": {
+			genesis: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				EthDenomMetadata: &weth,
+			},
+			metadata: weth,
+		},
+		"invalid eth denom metadata": {
+			genesis: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EthDenomMetadata: &banktypes.Metadata{
+					Base: "aweth",
+				},
+			},
+			shouldError: true,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			// The denom defaults to ETH before genesis.
+			denom, err := s.rollupKeeper.ETHDenom(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(types.ETH, denom)
+
+			if test.shouldError {
+				s.Require().Error(s.rollupKeeper.InitGenesis(s.ctx, test.genesis))
+				return
+			}
+			s.bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), test.metadata)
+			s.Require().NoError(s.rollupKeeper.InitGenesis(s.ctx, test.genesis))
+
+			denom, err = s.rollupKeeper.ETHDenom(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(test.metadata.Base, denom)
+
+			s.bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), test.metadata.Base).Return(test.metadata, true)
+			exported, err := s.rollupKeeper.ExportGenesis(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(&test.metadata, exported.EthDenomMetadata)
+
+			// Withdrawals burn the configured denom.
+			coins := sdk.NewCoins(sdk.NewCoin(test.metadata.Base, math.NewInt(100)))
+			s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, coins)
+			s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, coins)
+			_, err = s.rollupKeeper.InitiateWithdrawal(s.ctx, &types.MsgInitiateWithdrawal{
+				Sender: sdk.AccAddress("addr").String(),
+				Target: "0x12345abcde",
+				Value:  math.NewInt(100),
+			})
+			s.Require().NoError(err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/polymerdao/monomer/x/rollup/types"
+)
+
+var _ types.QueryServer = &Keeper{}
+
+// Params implements types.QueryServer.
+func (k *Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{
+		Params: *params,
+	}, nil
+}
+
+// FailedDeposits implements types.QueryServer.
+func (k *Keeper) FailedDeposits(ctx context.Context, req *types.QueryFailedDepositsRequest) (*types.QueryFailedDepositsResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte(types.KeyPrefixFailedDeposit))
+	var failedDeposits []types.FailedDeposit
+	pageRes, err := query.Paginate(store, req.GetPagination(), func(_, value []byte) error {
+		var failedDeposit types.FailedDeposit
+		if err := failedDeposit.Unmarshal(value); err != nil {
+			return types.WrapError(err, "unmarshal failed deposit")
+		}
+		failedDeposits = append(failedDeposits, failedDeposit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFailedDepositsResponse{
+		FailedDeposits: failedDeposits,
+		Pagination:     pageRes,
+	}, nil
+}
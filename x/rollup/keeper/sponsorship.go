@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/polymerdao/monomer/x/rollup/types"
+)
+
+// SponsorshipFeegrantKeeper lets the sponsor in the module parameters pay the fees of txs that name it as their fee
+// granter, as long as the params sponsor the tx. It is passed to the auth ante handler as its FeegrantKeeper.
+type SponsorshipFeegrantKeeper struct {
+	keeper *Keeper
+	// next handles fee grants that aren't sponsorships, e.g., x/feegrant allowances. It may be nil.
+	next authante.FeegrantKeeper
+}
+
+var _ authante.FeegrantKeeper = (*SponsorshipFeegrantKeeper)(nil)
+
+func NewSponsorshipFeegrantKeeper(k *Keeper, next authante.FeegrantKeeper) *SponsorshipFeegrantKeeper {
+	return &SponsorshipFeegrantKeeper{
+		keeper: k,
+		next:   next,
+	}
+}
+
+// UseGrantedFees lets granter pay fee for grantee's tx with msgs if the sponsorship params allow it, otherwise it
+// defers to the next FeegrantKeeper.
+//
+// Sponsored users need not hold any funds, so grantee's account is created if it doesn't exist yet. This lets users
+// sign txs before they bridge.
+func (s *SponsorshipFeegrantKeeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	params, err := s.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.Sponsor != granter.String() {
+		if s.next == nil {
+			return types.WrapError(types.ErrNotSponsored, "%s is not the sponsor", granter)
+		}
+		return s.next.UseGrantedFees(ctx, granter, grantee, fee, msgs)
+	}
+	if err := params.Sponsors(msgs, fee); err != nil {
+		return err
+	}
+
+	if !s.keeper.accountkeeper.HasAccount(ctx, grantee) {
+		s.keeper.accountkeeper.SetAccount(ctx, s.keeper.accountkeeper.NewAccountWithAddress(ctx, grantee))
+	}
+	s.keeper.EmitEvents(ctx, sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSponsoredFee,
+			sdk.NewAttribute(types.AttributeKeySponsor, params.Sponsor),
+			sdk.NewAttribute(types.AttributeKeySponsoredAccount, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	})
+	return nil
+}
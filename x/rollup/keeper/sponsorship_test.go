@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/polymerdao/monomer/x/rollup/keeper"
+	"github.com/polymerdao/monomer/x/rollup/types"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *KeeperTestSuite) TestSponsorshipFeegrantKeeper() {
+	sponsor := sdk.AccAddress("sponsor")
+	user := sdk.AccAddress("user")
+	sponsoredMsg := &types.MsgInitiateWithdrawal{}
+	maxFee := sdk.NewCoins(sdk.NewInt64Coin(types.ETH, 100))
+
+	tests := map[string]struct {
+		sponsor     string
+		granter     sdk.AccAddress
+		fee         sdk.Coins
+		msgs        []sdk.Msg
+		hasAccount  bool
+		shouldError bool
+	}{
+		"sponsored tx from new account": {
+			sponsor: sponsor.String(),
+			granter: sponsor,
+			fee:     maxFee,
+			msgs:    []sdk.Msg{sponsoredMsg},
+		},
+		"sponsored tx from existing account": {
+			sponsor:    sponsor.String(),
+			granter:    sponsor,
+			fee:        maxFee,
+			msgs:       []sdk.Msg{sponsoredMsg, sponsoredMsg},
+			hasAccount: true,
+		},
+		"sponsorship disabled": {
+			granter:     sponsor,
+			fee:         maxFee,
+			msgs:        []sdk.Msg{sponsoredMsg},
+			shouldError: true,
+		},
+		"granter is not the sponsor": {
+			sponsor:     sponsor.String(),
+			granter:     user,
+			fee:         maxFee,
+			msgs:        []sdk.Msg{sponsoredMsg},
+			shouldError: true,
+		},
+		"msg is not sponsored": {
+			sponsor:     sponsor.String(),
+			granter:     sponsor,
+			fee:         maxFee,
+			msgs:        []sdk.Msg{sponsoredMsg, &types.MsgApplyL1Txs{}},
+			shouldError: true,
+		},
+		"fee exceeds max sponsored fee": {
+			sponsor:     sponsor.String(),
+			granter:     sponsor,
+			fee:         maxFee.Add(sdk.NewInt64Coin(types.ETH, 1)),
+			msgs:        []sdk.Msg{sponsoredMsg},
+			shouldError: true,
+		},
+	}
+
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.Require().NoError(s.rollupKeeper.SetParams(s.ctx, &types.Params{
+				Sponsor:              test.sponsor,
+				SponsoredMsgTypeUrls: []string{sdk.MsgTypeURL(sponsoredMsg)},
+				MaxSponsoredFee:      maxFee,
+			}))
+			if !test.shouldError {
+				s.accountKeeper.EXPECT().HasAccount(gomock.Any(), user).Return(test.hasAccount)
+				if !test.hasAccount {
+					account := authtypes.NewBaseAccountWithAddress(user)
+					s.accountKeeper.EXPECT().NewAccountWithAddress(gomock.Any(), user).Return(account)
+					s.accountKeeper.EXPECT().SetAccount(gomock.Any(), account)
+				}
+			}
+
+			err := keeper.NewSponsorshipFeegrantKeeper(s.rollupKeeper, nil).UseGrantedFees(s.ctx, test.granter, user, test.fee, test.msgs)
+
+			if test.shouldError {
+				s.Require().ErrorIs(err, types.ErrNotSponsored)
+				s.Require().Empty(s.eventManger.Events())
+			} else {
+				s.Require().NoError(err)
+				events := s.eventManger.Events()
+				s.Require().Len(events, 2)
+				s.Require().Equal(types.EventTypeSponsoredFee, events[1].Type)
+				feeAttr, ok := events[1].GetAttribute(types.AttributeKeyFee)
+				s.Require().True(ok)
+				s.Require().Equal(test.fee.String(), feeAttr.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/polymerdao/monomer/bindings"
+	"github.com/polymerdao/monomer/x/rollup/types"
+)
+
+// registerBatchGasLimit is the minimum gas limit of the withdrawal registering a batch in the WithdrawalClaimer.
+var registerBatchGasLimit = big.NewInt(100_000)
+
+// BatchedWithdrawal is a withdrawal in the pending withdrawal batch.
+type BatchedWithdrawal struct {
+	Target common.Address
+	Value  *big.Int
+}
+
+// batchWithdrawal adds a withdrawal to the pending withdrawal batch and flushes the batch to L1 once it is full. The
+// withdrawn ETH must already be burned.
+func (k *Keeper) batchWithdrawal(
+	ctx sdk.Context, //nolint:gocritic // hugeParam
+	params *types.Params,
+	msg *types.MsgInitiateWithdrawal,
+) (sdk.Events, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	batchNonce, err := k.WithdrawalBatchNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index, err := k.pendingWithdrawalBatchSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	target := common.HexToAddress(msg.Target)
+	value := msg.Value.BigInt()
+	if err := store.Set(types.PendingBatchedWithdrawalKey(index), append(target.Bytes(), value.Bytes()...)); err != nil {
+		return nil, types.WrapError(err, "set batched withdrawal")
+	}
+	size := index + 1
+	if err := store.Set([]byte(types.KeyPendingWithdrawalBatchSize), binary.BigEndian.AppendUint64(nil, size)); err != nil {
+		return nil, types.WrapError(err, "set pending withdrawal batch size")
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawalBatched,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyL1Target, msg.Target),
+			sdk.NewAttribute(types.AttributeKeyValue, hexutil.Encode(value.Bytes())),
+			sdk.NewAttribute(types.AttributeKeyBatchNonce, hexutil.EncodeUint64(batchNonce)),
+			sdk.NewAttribute(types.AttributeKeyBatchIndex, hexutil.EncodeUint64(index)),
+			sdk.NewAttribute(types.AttributeKeyLeafHash, bindings.WithdrawalBatchLeaf(batchNonce, index, target, value).Hex()),
+		),
+	}
+
+	if size >= params.MaxWithdrawalBatchSize {
+		flushEvents, err := k.flushWithdrawalBatch(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, flushEvents...)
+	}
+
+	return events, nil
+}
+
+// flushWithdrawalBatch merkleizes the pending withdrawal batch into a single withdrawal to the WithdrawalClaimer that
+// registers the batch root and carries the ETH of every withdrawal in the batch. The pending batch must not be empty.
+func (k *Keeper) flushWithdrawalBatch(ctx sdk.Context, params *types.Params) (sdk.Events, error) { //nolint:gocritic // hugeParam
+	store := k.storeService.OpenKVStore(ctx)
+
+	batchNonce, err := k.WithdrawalBatchNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+	withdrawals, err := k.PendingBatchedWithdrawals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(withdrawals) == 0 {
+		return nil, types.WrapError(types.ErrWithdrawalBatching, "pending withdrawal batch is empty")
+	}
+
+	leaves := make([]common.Hash, 0, len(withdrawals))
+	total := new(big.Int)
+	for i, withdrawal := range withdrawals {
+		leaves = append(leaves, bindings.WithdrawalBatchLeaf(batchNonce, uint64(i), withdrawal.Target, withdrawal.Value))
+		total.Add(total, withdrawal.Value)
+	}
+	root := bindings.WithdrawalBatchRoot(leaves)
+
+	data, err := (&bindings.RegisterBatchArgs{
+		BatchNonce: new(big.Int).SetUint64(batchNonce),
+		Root:       root,
+	}).Pack()
+	if err != nil {
+		return nil, types.WrapError(err, "pack registerBatch")
+	}
+	// The module account sends the withdrawal, which the WithdrawalClaimer authenticates the batch with on L1.
+	msg := &types.MsgInitiateWithdrawal{
+		Sender:   authtypes.NewModuleAddress(types.ModuleName).String(),
+		Target:   params.WithdrawalClaimer,
+		Value:    sdkmath.NewIntFromBigInt(total),
+		GasLimit: registerBatchGasLimit.Bytes(),
+		Data:     data,
+	}
+	withdrawalHash, err := k.commitWithdrawal(ctx, authtypes.NewModuleAddress(types.ModuleName), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range withdrawals {
+		if err := store.Delete(types.PendingBatchedWithdrawalKey(uint64(i))); err != nil {
+			return nil, types.WrapError(err, "delete batched withdrawal")
+		}
+	}
+	if err := store.Delete([]byte(types.KeyPendingWithdrawalBatchSize)); err != nil {
+		return nil, types.WrapError(err, "delete pending withdrawal batch size")
+	}
+	if err := store.Set([]byte(types.KeyWithdrawalBatchNonce), binary.BigEndian.AppendUint64(nil, batchNonce+1)); err != nil {
+		return nil, types.WrapError(err, "set withdrawal batch nonce")
+	}
+
+	return sdk.Events{
+		withdrawalInitiatedEvent(msg, withdrawalHash),
+		sdk.NewEvent(
+			types.EventTypeWithdrawalBatch,
+			sdk.NewAttribute(types.AttributeKeyBatchNonce, hexutil.EncodeUint64(batchNonce)),
+			sdk.NewAttribute(types.AttributeKeyBatchRoot, root.Hex()),
+			sdk.NewAttribute(types.AttributeKeyBatchSize, hexutil.EncodeUint64(uint64(len(withdrawals)))),
+			sdk.NewAttribute(types.AttributeKeyValue, hexutil.Encode(total.Bytes())),
+			sdk.NewAttribute(types.AttributeKeyWithdrawalHash, withdrawalHash.Hex()),
+		),
+	}, nil
+}
+
+// WithdrawalBatchNonce returns the nonce of the pending withdrawal batch, the number of batches flushed so far.
+func (k *Keeper) WithdrawalBatchNonce(ctx context.Context) (uint64, error) {
+	nonceBytes, err := k.storeService.OpenKVStore(ctx).Get([]byte(types.KeyWithdrawalBatchNonce))
+	if err != nil {
+		return 0, types.WrapError(err, "get withdrawal batch nonce")
+	} else if nonceBytes == nil {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint64(nonceBytes), nil
+}
+
+// PendingBatchedWithdrawals returns the withdrawals in the pending withdrawal batch in the order they were batched.
+func (k *Keeper) PendingBatchedWithdrawals(ctx context.Context) ([]BatchedWithdrawal, error) {
+	iterator, err := k.storeService.OpenKVStore(ctx).Iterator(
+		[]byte(types.KeyPrefixPendingBatchedWithdrawal),
+		storetypes.PrefixEndBytes([]byte(types.KeyPrefixPendingBatchedWithdrawal)),
+	)
+	if err != nil {
+		return nil, types.WrapError(err, "iterate batched withdrawals")
+	}
+	defer iterator.Close()
+
+	var withdrawals []BatchedWithdrawal
+	for ; iterator.Valid(); iterator.Next() {
+		value := iterator.Value()
+		withdrawals = append(withdrawals, BatchedWithdrawal{
+			Target: common.BytesToAddress(value[:common.AddressLength]),
+			Value:  new(big.Int).SetBytes(value[common.AddressLength:]),
+		})
+	}
+	return withdrawals, nil
+}
+
+func (k *Keeper) pendingWithdrawalBatchSize(ctx context.Context) (uint64, error) {
+	sizeBytes, err := k.storeService.OpenKVStore(ctx).Get([]byte(types.KeyPendingWithdrawalBatchSize))
+	if err != nil {
+		return 0, types.WrapError(err, "get pending withdrawal batch size")
+	} else if sizeBytes == nil {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint64(sizeBytes), nil
+}
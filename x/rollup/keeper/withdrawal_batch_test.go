@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/polymerdao/monomer/bindings"
+	"github.com/polymerdao/monomer/x/rollup/types"
+)
+
+func (s *KeeperTestSuite) TestWithdrawalBatching() {
+	sender := sdk.AccAddress("addr").String()
+	claimer := common.Address{0xc1}
+
+	enableBatching := func(maxBatchSize uint64) {
+		params := types.DefaultParams()
+		params.WithdrawalClaimer = claimer.Hex()
+		params.MaxWithdrawalBatchSize = maxBatchSize
+		s.Require().NoError(s.rollupKeeper.SetParams(s.ctx, &params))
+	}
+	initiateBatchedWithdrawal := func(target common.Address, value int64) error {
+		_, err := s.rollupKeeper.InitiateWithdrawal(s.ctx, &types.MsgInitiateWithdrawal{
+			Sender:  sender,
+			Target:  target.Hex(),
+			Value:   math.NewInt(value),
+			Batched: true,
+		})
+		return err
+	}
+	requireBatchCommitted := func(events sdk.Events, wantRoot common.Hash, wantTotal int64) {
+		var withdrawalEvent, batchEvent *sdk.Event
+		for i := range events {
+			switch events[i].Type {
+			case types.EventTypeWithdrawalInitiated:
+				withdrawalEvent = &events[i]
+			case types.EventTypeWithdrawalBatch:
+				batchEvent = &events[i]
+			}
+		}
+		s.Require().NotNil(withdrawalEvent)
+		s.Require().NotNil(batchEvent)
+
+		root, ok := batchEvent.GetAttribute(types.AttributeKeyBatchRoot)
+		s.Require().True(ok)
+		s.Require().Equal(wantRoot.Hex(), root.Value)
+
+		target, ok := withdrawalEvent.GetAttribute(types.AttributeKeyL1Target)
+		s.Require().True(ok)
+		s.Require().Equal(claimer.Hex(), target.Value)
+		value, ok := withdrawalEvent.GetAttribute(types.AttributeKeyValue)
+		s.Require().True(ok)
+		s.Require().Equal(hexutil.Encode(big.NewInt(wantTotal).Bytes()), value.Value)
+		data, ok := withdrawalEvent.GetAttribute(types.AttributeKeyData)
+		s.Require().True(ok)
+		registerBatch := new(bindings.RegisterBatchArgs)
+		s.Require().NoError(registerBatch.Unpack(common.FromHex(data.Value)))
+		s.Require().Equal(wantRoot, registerBatch.Root)
+
+		withdrawalHash, ok := withdrawalEvent.GetAttribute(types.AttributeKeyWithdrawalHash)
+		s.Require().True(ok)
+		committed, err := s.rollupKeeper.WithdrawalCommitted(s.ctx, common.HexToHash(withdrawalHash.Value))
+		s.Require().NoError(err)
+		s.Require().True(committed)
+	}
+
+	s.Run("batched withdrawals fail when batching is disabled", func() {
+		s.mockBurnETH()
+		s.Require().ErrorIs(initiateBatchedWithdrawal(common.Address{1}, 100), types.ErrWithdrawalBatching)
+	})
+
+	s.Run("a full batch is flushed to the claimer", func() {
+		s.mockBurnETH()
+		enableBatching(2)
+
+		s.Require().NoError(initiateBatchedWithdrawal(common.Address{1}, 100))
+		pending, err := s.rollupKeeper.PendingBatchedWithdrawals(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(pending, 1)
+		s.Require().Equal(common.Address{1}, pending[0].Target)
+		s.Require().Equal(big.NewInt(100), pending[0].Value)
+		for _, event := range s.eventManger.Events() {
+			s.Require().NotEqual(types.EventTypeWithdrawalInitiated, event.Type)
+		}
+
+		s.Require().NoError(initiateBatchedWithdrawal(common.Address{2}, 200))
+		requireBatchCommitted(s.eventManger.Events(), bindings.WithdrawalBatchRoot([]common.Hash{
+			bindings.WithdrawalBatchLeaf(0, 0, common.Address{1}, big.NewInt(100)),
+			bindings.WithdrawalBatchLeaf(0, 1, common.Address{2}, big.NewInt(200)),
+		}), 300)
+
+		pending, err = s.rollupKeeper.PendingBatchedWithdrawals(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(pending)
+		batchNonce, err := s.rollupKeeper.WithdrawalBatchNonce(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), batchNonce)
+	})
+
+	s.Run("a partial batch can be flushed", func() {
+		s.mockBurnETH()
+		enableBatching(types.MaxWithdrawalBatchSize)
+
+		_, err := s.rollupKeeper.FlushWithdrawalBatch(s.ctx, &types.MsgFlushWithdrawalBatch{Sender: sender})
+		s.Require().ErrorIs(err, types.ErrWithdrawalBatching)
+
+		s.Require().NoError(initiateBatchedWithdrawal(common.Address{1}, 100))
+		_, err = s.rollupKeeper.FlushWithdrawalBatch(s.ctx, &types.MsgFlushWithdrawalBatch{Sender: sender})
+		s.Require().NoError(err)
+		requireBatchCommitted(s.eventManger.Events(), bindings.WithdrawalBatchLeaf(0, 0, common.Address{1}, big.NewInt(100)), 100)
+	})
+}
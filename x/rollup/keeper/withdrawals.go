@@ -1,26 +1,113 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
+	"math/big"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/polymerdao/monomer/x/rollup/types"
 )
 
+// withdrawalMessageVersion is the message version used by the L2ToL1MessagePasser.
+var withdrawalMessageVersion = big.NewInt(1)
+
 // burnETH burns ETH from an account where the amount is in wei.
 func (k *Keeper) burnETH(ctx sdk.Context, addr sdk.AccAddress, amount sdkmath.Int) error { //nolint:gocritic // hugeParam
-	coins := sdk.NewCoins(sdk.NewCoin(types.ETH, amount))
+	denom, err := k.ETHDenom(ctx)
+	if err != nil {
+		return err
+	}
+	return k.burnCoins(ctx, addr, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+}
 
+// burnCoins burns the coins of a withdrawal from an account.
+func (k *Keeper) burnCoins(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error { //nolint:gocritic // hugeParam
 	// Transfer the coins to withdraw from the user account to the rollup module
 	if err := k.bankkeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins); err != nil {
 		return fmt.Errorf("failed to send withdrawal coins from user account %v to rollup module: %v", addr, err)
 	}
 
-	// Burn the ETH coins from the rollup module
+	// Burn the coins from the rollup module
 	if err := k.bankkeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
 		return fmt.Errorf("failed to burn withdrawal coins from rollup module: %v", err)
 	}
 
 	return nil
 }
+
+// commitWithdrawal commits the withdrawal hash to the module store so it can be proven against the app hash with ICS-23
+// proofs. The nonce is versioned the same way as the L2ToL1MessagePasser nonce, so both commit to the same hash.
+func (k *Keeper) commitWithdrawal(
+	ctx sdk.Context, //nolint:gocritic // hugeParam
+	sender sdk.AccAddress,
+	msg *types.MsgInitiateWithdrawal,
+) (common.Hash, error) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	nonce, err := k.WithdrawalNonce(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	senderAddr := common.BytesToAddress(sender.Bytes())
+	targetAddr := common.HexToAddress(msg.Target)
+	withdrawalHash, err := crossdomain.NewWithdrawal(
+		crossdomain.EncodeVersionedNonce(nonce, withdrawalMessageVersion),
+		&senderAddr,
+		&targetAddr,
+		msg.Value.BigInt(),
+		new(big.Int).SetBytes(msg.GasLimit),
+		msg.Data,
+	).Hash()
+	if err != nil {
+		return common.Hash{}, types.WrapError(err, "hash withdrawal")
+	}
+
+	if err := store.Set(types.WithdrawalCommitmentKey(withdrawalHash), types.WithdrawalCommitmentValue); err != nil {
+		return common.Hash{}, types.WrapError(err, "set withdrawal commitment")
+	}
+	if err := store.Set([]byte(types.KeyWithdrawalNonce), new(big.Int).Add(nonce, big.NewInt(1)).Bytes()); err != nil {
+		return common.Hash{}, types.WrapError(err, "set withdrawal nonce")
+	}
+
+	return withdrawalHash, nil
+}
+
+// withdrawalInitiatedEvent returns the event Monomer registers a committed withdrawal in the L2ToL1MessagePasser from.
+func withdrawalInitiatedEvent(msg *types.MsgInitiateWithdrawal, withdrawalHash common.Hash) sdk.Event {
+	return sdk.NewEvent(
+		types.EventTypeWithdrawalInitiated,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyL1Target, msg.Target),
+		sdk.NewAttribute(types.AttributeKeyValue, hexutil.Encode(msg.Value.BigInt().Bytes())),
+		sdk.NewAttribute(types.AttributeKeyGasLimit, hexutil.Encode(msg.GasLimit)),
+		sdk.NewAttribute(types.AttributeKeyData, hexutil.Encode(msg.Data)),
+		sdk.NewAttribute(types.AttributeKeyWithdrawalHash, withdrawalHash.Hex()),
+		// The nonce attribute will be set by Monomer
+	)
+}
+
+// WithdrawalNonce returns the nonce of the next withdrawal, the number of withdrawals initiated so far. It doesn't include
+// the message version.
+func (k *Keeper) WithdrawalNonce(ctx context.Context) (*big.Int, error) {
+	nonceBytes, err := k.storeService.OpenKVStore(ctx).Get([]byte(types.KeyWithdrawalNonce))
+	if err != nil {
+		return nil, types.WrapError(err, "get withdrawal nonce")
+	}
+	return new(big.Int).SetBytes(nonceBytes), nil
+}
+
+// WithdrawalCommitted reports whether a withdrawal with the hash was initiated, i.e., whether its commitment can be
+// proven on L1.
+func (k *Keeper) WithdrawalCommitted(ctx context.Context, withdrawalHash common.Hash) (bool, error) {
+	committed, err := k.storeService.OpenKVStore(ctx).Has(types.WithdrawalCommitmentKey(withdrawalHash))
+	if err != nil {
+		return false, types.WrapError(err, "get withdrawal commitment")
+	}
+	return committed, nil
+}
@@ -2,6 +2,7 @@ package rollup
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -12,7 +13,10 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	protov1 "github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -27,9 +31,11 @@ import (
 type ModuleInputs struct {
 	depinject.In
 
-	Codec        codec.Codec
-	StoreService store.KVStoreService
-	BankKeeper   bankkeeper.Keeper
+	Config        *modulev1.Module
+	Codec         codec.Codec
+	StoreService  store.KVStoreService
+	BankKeeper    bankkeeper.Keeper
+	AccountKeeper authkeeper.AccountKeeper
 }
 
 type ModuleOutputs struct {
@@ -54,7 +60,11 @@ func ProvideCustomGetSigner() signing.CustomGetSigner {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Codec, in.StoreService, in.BankKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.GetAuthority() != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.GetAuthority())
+	}
+	k := keeper.NewKeeper(in.Codec, in.StoreService, authority.String(), in.BankKeeper, in.AccountKeeper)
 	return ModuleOutputs{
 		Keeper: k,
 		Module: NewAppModule(in.Codec, k),
@@ -64,6 +74,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 var (
 	_ module.AppModule      = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.HasGenesis     = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -97,16 +108,20 @@ func (a AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 
 // DefaultGenesis returns the capability module's default genesis state.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return json.RawMessage(`{}`)
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
 // ValidateGenesis performs genesis state validation for the capability module.
 func (AppModuleBasic) ValidateGenesis(
-	_ codec.JSONCodec,
+	cdc codec.JSONCodec,
 	_ client.TxEncodingConfig,
-	_ json.RawMessage,
+	data json.RawMessage,
 ) error {
-	return nil
+	var genesis types.GenesisState
+	if err := cdc.UnmarshalJSON(data, &genesis); err != nil {
+		return fmt.Errorf("unmarshal genesis: %v", err)
+	}
+	return genesis.Validate()
 }
 
 // RegisterRESTRoutes registers the capability module's REST service handlers.
@@ -163,14 +178,28 @@ func (AppModule) QuerierRoute() string { return types.QuerierRoute }
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
 // RegisterInvariants registers the capability module's invariants.
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
+// InitGenesis sets the module parameters from the genesis state.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) { //nolint:gocritic
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesis)
+	if err := am.keeper.InitGenesis(ctx, &genesis); err != nil {
+		panic(fmt.Errorf("init genesis: %v", err))
+	}
+}
+
 // ExportGenesis returns the capability module's exported genesis state as raw JSON bytes.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage { //nolint:gocritic
-	return json.RawMessage(`{}`)
+	genesis, err := am.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(fmt.Errorf("export genesis: %v", err))
+	}
+	return cdc.MustMarshalJSON(genesis)
 }
 
 // ConsensusVersion implements ConsensusVersion.
@@ -9,12 +9,14 @@ import (
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/integration"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -106,9 +108,132 @@ func TestRollup(t *testing.T) {
 
 	// query the recipient address ETH balance and assert it's zero
 	require.Equal(t, math.ZeroInt(), queryUserETHBalance(t, queryClient, recipientAddr, integrationApp))
+
+	// withdraw the user's ERC20 tokens through the standard bridge
+	_, err = integrationApp.RunMsg(&rolluptypes.MsgInitiateWithdrawal{
+		Sender:   utils.EvmToCosmosAddress(erc20userAddr).String(),
+		Target:   l1WithdrawalAddr,
+		Value:    math.NewIntFromBigInt(erc20depositAmount),
+		GasLimit: new(big.Int).SetUint64(100_000).Bytes(),
+		Denom:    rolluptypes.ERC20Denom(erc20tokenAddr),
+	})
+	require.NoError(t, err)
+
+	// query the user's ERC20 balance and assert it's zero
+	require.Equal(t, math.ZeroInt(), queryUserERC20Balance(t, queryClient, utils.EvmToCosmosAddress(erc20userAddr), erc20tokenAddr, integrationApp))
+}
+
+func TestFailedDeposit(t *testing.T) {
+	integrationApp := setupIntegrationApp(t)
+	queryClient := banktypes.NewQueryClient(integrationApp.QueryHelper())
+
+	l1AttributesTx, _, _ := monomertestutils.GenerateEthTxs(t)
+	from := common.HexToAddress("0x123456abcdef")
+	mintAmount := big.NewInt(100)
+	// Contract creation deposits aren't supported, so the deposit fails but its mint is credited to the sender.
+	failedDepositTx := gethtypes.NewTx(&gethtypes.DepositTx{
+		From:  from,
+		Mint:  mintAmount,
+		Value: big.NewInt(50),
+	})
+	mintAddr := utils.EvmToCosmosAddress(from)
+
+	_, err := integrationApp.RunMsg(&rolluptypes.MsgApplyL1Txs{
+		TxBytes: [][]byte{monomertestutils.TxToBytes(t, l1AttributesTx), monomertestutils.TxToBytes(t, failedDepositTx)},
+	})
+	require.NoError(t, err)
+
+	// query the mint address ETH balance and assert it's equal to the whole mint amount
+	require.Equal(t, mintAmount, queryUserETHBalance(t, queryClient, mintAddr, integrationApp).BigInt())
+
+	// query the failed deposits and assert the deposit is recorded
+	resp, err := rolluptypes.NewQueryClient(integrationApp.QueryHelper()).FailedDeposits(
+		integrationApp.Context(),
+		&rolluptypes.QueryFailedDepositsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.FailedDeposits, 1)
+	require.Equal(t, failedDepositTx.Hash().Hex(), resp.FailedDeposits[0].TxHash)
+	require.Equal(t, mintAddr.String(), resp.FailedDeposits[0].MintAddress)
+	require.Equal(t, math.NewIntFromBigInt(mintAmount), resp.FailedDeposits[0].Mint)
+}
+
+func TestSponsorship(t *testing.T) {
+	integrationApp, keepers := setupIntegrationAppWithKeepers(t)
+	ctx := sdk.UnwrapSDKContext(integrationApp.Context())
+	queryClient := banktypes.NewQueryClient(integrationApp.QueryHelper())
+
+	sponsor := sdk.AccAddress("sponsor")
+	user := sdk.AccAddress("user")
+	fee := sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 100))
+	require.NoError(t, keepers.bank.MintCoins(ctx, rolluptypes.ModuleName, fee))
+	require.NoError(t, keepers.bank.SendCoinsFromModuleToAccount(ctx, rolluptypes.ModuleName, sponsor, fee))
+
+	_, err := integrationApp.RunMsg(&rolluptypes.MsgUpdateParams{
+		Authority: keepers.rollup.Authority(),
+		Params: rolluptypes.Params{
+			Sponsor:              sponsor.String(),
+			SponsoredMsgTypeUrls: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+			MaxSponsoredFee:      fee,
+		},
+	})
+	require.NoError(t, err)
+
+	deductFee := ante.NewDeductFeeDecorator(
+		keepers.account,
+		keepers.bank,
+		rollupkeeper.NewSponsorshipFeegrantKeeper(keepers.rollup, nil),
+		nil,
+	)
+	newTx := func(msg sdk.Msg) sdk.Tx {
+		txBuilder := keepers.txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetFeeGranter(sponsor)
+		txBuilder.SetGasLimit(100_000)
+		return txBuilder.GetTx()
+	}
+	noopAnteHandler := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	// txs with msgs that aren't sponsored are rejected
+	_, err = deductFee.AnteHandle(ctx, newTx(&rolluptypes.MsgInitiateWithdrawal{Sender: user.String()}), false, noopAnteHandler)
+	require.ErrorIs(t, err, rolluptypes.ErrNotSponsored)
+
+	// the sponsor pays the fee of a user without an account or funds
+	require.False(t, keepers.account.HasAccount(ctx, user))
+	_, err = deductFee.AnteHandle(ctx, newTx(&banktypes.MsgSend{
+		FromAddress: user.String(),
+		ToAddress:   sponsor.String(),
+	}), false, noopAnteHandler)
+	require.NoError(t, err)
+	require.True(t, keepers.account.HasAccount(ctx, user))
+	require.Equal(t, math.ZeroInt(), queryUserETHBalance(t, queryClient, sponsor, integrationApp))
+
+	var sponsored bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == rolluptypes.EventTypeSponsoredFee {
+			sponsored = true
+			account, ok := event.GetAttribute(rolluptypes.AttributeKeySponsoredAccount)
+			require.True(t, ok)
+			require.Equal(t, user.String(), account.Value)
+		}
+	}
+	require.True(t, sponsored)
+}
+
+type integrationKeepers struct {
+	account  authkeeper.AccountKeeper
+	bank     bankkeeper.Keeper
+	rollup   *rollupkeeper.Keeper
+	txConfig client.TxConfig
 }
 
 func setupIntegrationApp(t *testing.T) *integration.App {
+	integrationApp, _ := setupIntegrationAppWithKeepers(t)
+	return integrationApp
+}
+
+func setupIntegrationAppWithKeepers(t *testing.T) (*integration.App, *integrationKeepers) {
 	encodingCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, rollup.AppModuleBasic{})
 	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, rolluptypes.StoreKey)
 	authority := authtypes.NewModuleAddress("gov").String()
@@ -121,7 +246,10 @@ func setupIntegrationApp(t *testing.T) *integration.App {
 		encodingCfg.Codec,
 		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
 		authtypes.ProtoBaseAccount,
-		map[string][]string{rolluptypes.ModuleName: {authtypes.Minter, authtypes.Burner}},
+		map[string][]string{
+			rolluptypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+			authtypes.FeeCollectorName: nil,
+		},
 		addresscodec.NewBech32Codec("cosmos"),
 		"cosmos",
 		authority,
@@ -137,7 +265,9 @@ func setupIntegrationApp(t *testing.T) *integration.App {
 	rollupKeeper := rollupkeeper.NewKeeper(
 		encodingCfg.Codec,
 		runtime.NewKVStoreService(keys[rolluptypes.StoreKey]),
+		authority,
 		bankKeeper,
+		accountKeeper,
 	)
 
 	authModule := auth.NewAppModule(encodingCfg.Codec, accountKeeper, authsims.RandomGenesisAccounts, nil)
@@ -155,10 +285,19 @@ func setupIntegrationApp(t *testing.T) *integration.App {
 			rolluptypes.ModuleName: rollupModule,
 		},
 	)
+	params := rolluptypes.DefaultParams()
+	params.L1StandardBridge = monomertestutils.L1StandardBridge.Hex()
+	require.NoError(t, rollupKeeper.SetParams(integrationApp.Context(), &params))
 	rolluptypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), rollupKeeper)
+	rolluptypes.RegisterQueryServer(integrationApp.QueryHelper(), rollupKeeper)
 	banktypes.RegisterQueryServer(integrationApp.QueryHelper(), bankkeeper.NewQuerier(&bankKeeper))
 
-	return integrationApp
+	return integrationApp, &integrationKeepers{
+		account:  accountKeeper,
+		bank:     bankKeeper,
+		rollup:   rollupKeeper,
+		txConfig: encodingCfg.TxConfig,
+	}
 }
 
 func queryUserBalance(t *testing.T, queryClient banktypes.QueryClient, userAddr sdk.AccAddress, denom string, app *integration.App) math.Int {
@@ -175,5 +314,5 @@ func queryUserETHBalance(t *testing.T, queryClient banktypes.QueryClient, userAd
 }
 
 func queryUserERC20Balance(t *testing.T, queryClient banktypes.QueryClient, userAddr sdk.AccAddress, erc20addr common.Address, app *integration.App) math.Int {
-	return queryUserBalance(t, queryClient, userAddr, "erc20/"+erc20addr.String()[2:], app)
+	return queryUserBalance(t, queryClient, userAddr, rolluptypes.ERC20Denom(erc20addr), app)
 }
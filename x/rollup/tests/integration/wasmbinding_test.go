@@ -0,0 +1,109 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	monomertestutils "github.com/polymerdao/monomer/testutils"
+	rolluptypes "github.com/polymerdao/monomer/x/rollup/types"
+	"github.com/polymerdao/monomer/x/rollup/wasmbinding"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWasmBinding bridges ETH out from a contract the way wasmd dispatches its custom messages and queries: the msgs
+// EncodeMsg returns are run with the contract as their sender, and queries are served by the Querier.
+func TestWasmBinding(t *testing.T) {
+	integrationApp, keepers := setupIntegrationAppWithKeepers(t)
+	ctx := sdk.UnwrapSDKContext(integrationApp.Context())
+	queryClient := banktypes.NewQueryClient(integrationApp.QueryHelper())
+	querier := wasmbinding.NewQuerier(keepers.rollup)
+	query := func(request string, resp any) error {
+		respBytes, err := querier.Query(ctx, json.RawMessage(request))
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(respBytes, resp)
+	}
+
+	// No L1 block has been applied yet.
+	var l1BlockInfo wasmbinding.L1BlockInfoResponse
+	require.NoError(t, query(`{"l1_block_info": {}}`, &l1BlockInfo))
+	require.Equal(t, wasmbinding.L1BlockInfoResponse{Hash: common.Hash{}.Hex()}, l1BlockInfo)
+
+	l1AttributesTx, depositTx, _ := monomertestutils.GenerateEthTxs(t)
+	_, err := integrationApp.RunMsg(&rolluptypes.MsgApplyL1Txs{
+		TxBytes: [][]byte{monomertestutils.TxToBytes(t, l1AttributesTx), monomertestutils.TxToBytes(t, depositTx)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, query(`{"l1_block_info": {}}`, &l1BlockInfo))
+	require.NotEqual(t, common.Hash{}.Hex(), l1BlockInfo.Hash)
+
+	from, err := gethtypes.NewCancunSigner(depositTx.ChainId()).Sender(depositTx)
+	require.NoError(t, err)
+	var depositNonce wasmbinding.DepositNonceResponse
+	require.NoError(t, query(`{"deposit_nonce": {"from": "`+from.Hex()+`"}}`, &depositNonce))
+	require.Equal(t, uint64(1), depositNonce.Nonce)
+
+	// Contract addresses are 32 bytes long.
+	contract := sdk.AccAddress(address.Module("wasm", []byte("contract")))
+	require.Len(t, contract, 32)
+	funds := sdk.NewCoins(sdk.NewInt64Coin(rolluptypes.ETH, 100))
+	require.NoError(t, keepers.bank.MintCoins(ctx, rolluptypes.ModuleName, funds))
+	require.NoError(t, keepers.bank.SendCoinsFromModuleToAccount(ctx, rolluptypes.ModuleName, contract, funds))
+
+	msgs, err := wasmbinding.EncodeMsg(contract, json.RawMessage(`{"initiate_withdrawal": {
+		"target": "0x0000000000000000000000000000000000112233",
+		"value": "60",
+		"gas_limit": "100000",
+		"data": "AQID"
+	}}`))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{&rolluptypes.MsgInitiateWithdrawal{
+		Sender:   contract.String(),
+		Target:   "0x0000000000000000000000000000000000112233",
+		Value:    math.NewInt(60),
+		GasLimit: []byte{0x01, 0x86, 0xa0},
+		Data:     []byte{1, 2, 3},
+	}}, msgs)
+	// wasmd dispatches the msgs to their handlers and emits the handlers' events.
+	var withdrawalHash string
+	for _, msg := range msgs {
+		result, err := integrationApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+		require.NoError(t, err)
+		for _, event := range result.GetEvents() {
+			if event.Type != rolluptypes.EventTypeWithdrawalInitiated {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key == rolluptypes.AttributeKeyWithdrawalHash {
+					withdrawalHash = attr.Value
+				}
+			}
+		}
+	}
+	require.Equal(t, math.NewInt(40), queryUserETHBalance(t, queryClient, contract, integrationApp))
+	require.NotEmpty(t, withdrawalHash)
+	var committed wasmbinding.WithdrawalCommittedResponse
+	require.NoError(t, query(`{"withdrawal_committed": {"withdrawal_hash": "`+withdrawalHash+`"}}`, &committed))
+	require.True(t, committed.Committed)
+	require.NoError(t, query(`{"withdrawal_committed": {"withdrawal_hash": "`+common.Hash{1}.Hex()+`"}}`, &committed))
+	require.False(t, committed.Committed)
+	var withdrawalNonce wasmbinding.WithdrawalNonceResponse
+	require.NoError(t, query(`{"withdrawal_nonce": {}}`, &withdrawalNonce))
+	require.Equal(t, math.OneInt(), withdrawalNonce.Nonce)
+
+	// Invalid messages and queries fail.
+	_, err = wasmbinding.EncodeMsg(contract, json.RawMessage(`{"other": {}}`))
+	require.ErrorContains(t, err, "unknown rollup msg")
+	_, err = wasmbinding.EncodeMsg(contract, json.RawMessage(`{"initiate_withdrawal": {"target": "0x0000000000000000000000000000000000112233"}}`))
+	require.ErrorContains(t, err, "value is required")
+	require.ErrorContains(t, query(`{"other": {}}`, new(any)), "unknown rollup query")
+	require.ErrorContains(t, query(`{"withdrawal_committed": {"withdrawal_hash": "0x1234"}}`, new(any)), "invalid withdrawal hash")
+	require.ErrorContains(t, query(`{"deposit_nonce": {"from": "cosmos1"}}`, new(any)), "invalid address")
+}
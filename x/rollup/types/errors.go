@@ -17,6 +17,12 @@ var (
 	ErrL1BlockInfo              = registerErr("L1 block info")
 	ErrProcessL1UserDepositTxs  = registerErr("failed to process L1 user deposit txs")
 	ErrProcessL1SystemDepositTx = registerErr("failed to process L1 system deposit tx")
+	ErrCommitWithdrawal         = registerErr("failed to commit withdrawal")
+	ErrInvalidParams            = registerErr("invalid params")
+	ErrUnauthorized             = registerErr("unauthorized")
+	ErrNotSponsored             = registerErr("tx is not sponsored")
+	ErrWithdrawalBatching       = registerErr("withdrawal batching")
+	ErrERC20Withdrawal          = registerErr("ERC-20 withdrawal")
 )
 
 // register new errors without hard-coding error codes
@@ -13,12 +13,30 @@ const (
 	AttributeKeyGasLimit          = "gas_limit"
 	AttributeKeyData              = "data"
 	AttributeKeyNonce             = "nonce"
+	AttributeKeyWithdrawalHash    = "withdrawal_hash"
 	AttributeKeyERC20Address      = "erc20_address"
+	AttributeKeySponsor           = "sponsor"
+	AttributeKeySponsoredAccount  = "account"
+	AttributeKeyFee               = "fee"
+	AttributeKeyTxHash            = "tx_hash"
+	AttributeKeyBatchNonce        = "batch_nonce"
+	AttributeKeyBatchIndex        = "batch_index"
+	AttributeKeyBatchSize         = "batch_size"
+	AttributeKeyBatchRoot         = "batch_root"
+	AttributeKeyLeafHash          = "leaf_hash"
+	AttributeKeyReason            = "reason"
+	AttributeKeyDenom             = "denom"
 
 	L1UserDepositTxType = "l1_user_deposit"
 
 	EventTypeMintETH             = "mint_eth"
 	EventTypeMintERC20           = "mint_erc20"
 	EventTypeBurnETH             = "burn_eth"
+	EventTypeBurnERC20           = "burn_erc20"
 	EventTypeWithdrawalInitiated = "withdrawal_initiated"
+	EventTypeWithdrawalBatched   = "withdrawal_batched"
+	EventTypeWithdrawalBatch     = "withdrawal_batch"
+	EventTypeSponsoredFee        = "sponsored_fee"
+	EventTypeDeposit             = "deposit"
+	EventTypeDepositFailed       = "deposit_failed"
 )
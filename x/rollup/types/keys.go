@@ -1,5 +1,12 @@
 package types
 
+import (
+	"encoding/binary"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	"github.com/ethereum/go-ethereum/common"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "rollup"
@@ -15,8 +22,75 @@ const (
 )
 
 const (
-	// wrapped Ethers; cannonically bridged from Ethereum
+	// wrapped Ethers; cannonically bridged from Ethereum. The default bridged ETH denom, see GenesisState.EthDenomMetadata.
 	ETH = "ETH"
+	// KeyParams is the key for the module Params
+	KeyParams = "Params"
+	// KeyETHDenom is the key for the bridged ETH denom
+	KeyETHDenom = "ETHDenom"
 	// KeyL1BlockInfo is the key for the L1BlockInfo
 	KeyL1BlockInfo = "L1BlockInfo"
+	// KeyWithdrawalNonce is the key for the nonce of the next withdrawal
+	KeyWithdrawalNonce = "WithdrawalNonce"
+	// KeyPrefixWithdrawalCommitment is the key prefix for withdrawal commitments
+	KeyPrefixWithdrawalCommitment = "WithdrawalCommitment/"
+	// KeyPrefixDepositNonce is the key prefix for the number of deposits each L1 address has sent
+	KeyPrefixDepositNonce = "DepositNonce/"
+	// KeyWithdrawalBatchNonce is the key for the nonce of the pending withdrawal batch
+	KeyWithdrawalBatchNonce = "WithdrawalBatchNonce"
+	// KeyPendingWithdrawalBatchSize is the key for the number of withdrawals in the pending withdrawal batch
+	KeyPendingWithdrawalBatchSize = "PendingWithdrawalBatchSize"
+	// KeyPrefixPendingBatchedWithdrawal is the key prefix for the withdrawals in the pending withdrawal batch
+	KeyPrefixPendingBatchedWithdrawal = "PendingBatchedWithdrawal/"
+	// KeyPrefixFailedDeposit is the key prefix for the user deposits whose execution failed
+	KeyPrefixFailedDeposit = "FailedDeposit/"
+	// KeyPrefixERC20Token is the key prefix for the token addresses of bridged ERC-20 denoms
+	KeyPrefixERC20Token = "ERC20Token/"
+	// KeyCrossDomainMessageNonce is the key for the nonce of the next cross domain message sent to L1
+	KeyCrossDomainMessageNonce = "CrossDomainMessageNonce"
 )
+
+// L1CrossDomainMessengerAddress is the address of the L1CrossDomainMessenger, which relays the cross domain messages
+// withdrawals send to L1.
+// TODO: remove hardcoded address once a genesis state is configured
+var L1CrossDomainMessengerAddress = common.HexToAddress("0x9A9f2CCfdE556A7E9Ff0848998Aa4a0CFD8863AE")
+
+// AliasedL1CrossDomainMessengerAddress is the L2 aliased address of the L1CrossDomainMessenger. Deposits it sends are
+// cross domain messages.
+var AliasedL1CrossDomainMessengerAddress = crossdomain.ApplyL1ToL2Alias(L1CrossDomainMessengerAddress)
+
+// WithdrawalCommitmentValue is the value stored for each withdrawal commitment.
+var WithdrawalCommitmentValue = []byte{1}
+
+// WithdrawalCommitmentKey returns the store key committing to a withdrawal hash. The commitment can be proven against
+// the app hash with ICS-23 proofs, which is what the CosmosWithdrawalPortal contract verifies on L1.
+func WithdrawalCommitmentKey(withdrawalHash common.Hash) []byte {
+	return append([]byte(KeyPrefixWithdrawalCommitment), withdrawalHash.Bytes()...)
+}
+
+// PendingBatchedWithdrawalKey returns the store key of the withdrawal at an index of the pending withdrawal batch.
+func PendingBatchedWithdrawalKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(KeyPrefixPendingBatchedWithdrawal), index)
+}
+
+// DepositNonceKey returns the store key of the deposit nonce of an L1 address, the number of deposits it has sent.
+func DepositNonceKey(from common.Address) []byte {
+	return append([]byte(KeyPrefixDepositNonce), from.Bytes()...)
+}
+
+// FailedDepositKey returns the store key of a failed deposit, ordered by the L1 block that included it and its index
+// among the L1 txs applied with the block.
+func FailedDepositKey(l1BlockNumber, index uint64) []byte {
+	return binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64([]byte(KeyPrefixFailedDeposit), l1BlockNumber), index)
+}
+
+// ERC20TokenKey returns the store key of the token addresses of a bridged ERC-20 denom.
+func ERC20TokenKey(denom string) []byte {
+	return append([]byte(KeyPrefixERC20Token), denom...)
+}
+
+// ERC20Denom returns the denom of the coins minted for deposits of an ERC-20 token, "erc20/{l1 token address}" without
+// the 0x prefix.
+func ERC20Denom(l1Token common.Address) string {
+	return "erc20/" + l1Token.String()[2:]
+}
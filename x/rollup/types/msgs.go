@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -38,6 +39,16 @@ func (m *MsgInitiateWithdrawal) ValidateBasic() error {
 	if !common.IsHexAddress(m.Target) {
 		return fmt.Errorf("invalid Ethereum address: %s", m.Target)
 	}
+	// Batched withdrawals are paid out by the WithdrawalClaimer, which doesn't call the target with gas or data.
+	if m.Batched {
+		if len(m.GasLimit) != 0 || len(m.Data) != 0 {
+			return errors.New("batched withdrawals can't have a gas limit or data")
+		}
+		if m.Denom != "" {
+			return errors.New("batched withdrawals can only withdraw ETH")
+		}
+		return nil
+	}
 	// Check if the gas limit is within the allowed range.
 	gasLimit := new(big.Int).SetBytes(m.GasLimit).Uint64()
 	if gasLimit < MinTxGasLimit || gasLimit > MaxTxGasLimit {
@@ -54,3 +65,21 @@ func (*MsgInitiateWithdrawal) Type() string {
 func (*MsgInitiateWithdrawal) Route() string {
 	return "rollup"
 }
+
+var _ sdktypes.Msg = (*MsgUpdateParams)(nil)
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdktypes.AccAddressFromBech32(m.Authority); err != nil {
+		return WrapError(ErrUnauthorized, "invalid authority address: %v", err)
+	}
+	return m.Params.Validate()
+}
+
+var _ sdktypes.Msg = (*MsgFlushWithdrawalBatch)(nil)
+
+func (m *MsgFlushWithdrawalBatch) ValidateBasic() error {
+	if _, err := sdktypes.AccAddressFromBech32(m.Sender); err != nil {
+		return WrapError(ErrInvalidSender, "invalid sender address: %v", err)
+	}
+	return nil
+}
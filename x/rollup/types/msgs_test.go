@@ -57,6 +57,40 @@ func TestMsgInitiateWithdrawalValidateBasic(t *testing.T) {
 			},
 			errMsg: outOfRangeGasLimitErrorMsg,
 		},
+		{
+			name: "Valid batched request",
+			request: &types.MsgInitiateWithdrawal{
+				Target:  validAddress,
+				Batched: true,
+			},
+		},
+		{
+			name: "Batched request with a gas limit",
+			request: &types.MsgInitiateWithdrawal{
+				Target:   validAddress,
+				GasLimit: validGasLimit,
+				Batched:  true,
+			},
+			errMsg: "batched withdrawals can't have a gas limit or data",
+		},
+		{
+			name: "Batched request with data",
+			request: &types.MsgInitiateWithdrawal{
+				Target:  validAddress,
+				Data:    []byte{1},
+				Batched: true,
+			},
+			errMsg: "batched withdrawals can't have a gas limit or data",
+		},
+		{
+			name: "Batched request with a denom",
+			request: &types.MsgInitiateWithdrawal{
+				Target:  validAddress,
+				Batched: true,
+				Denom:   "erc20/0000000000000000000000000000000000000001",
+			},
+			errMsg: "batched withdrawals can only withdraw ETH",
+		},
 	}
 
 	for _, tc := range testCases {
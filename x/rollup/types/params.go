@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxWithdrawalBatchSize is the largest allowed max withdrawal batch size. It bounds the work of flushing a batch,
+// which is paid for by the tx that fills or flushes it.
+const MaxWithdrawalBatchSize = 1024
+
+// DefaultParams returns the default module parameters, which disable sponsorship, withdrawal batching, and ERC-20 deposits.
+func DefaultParams() Params {
+	return Params{
+		SponsoredMsgTypeUrls: []string{},
+		MaxSponsoredFee:      sdktypes.Coins{},
+	}
+}
+
+func (p *Params) Validate() error {
+	if p.Sponsor != "" {
+		if _, err := sdktypes.AccAddressFromBech32(p.Sponsor); err != nil {
+			return WrapError(ErrInvalidParams, "invalid sponsor address: %v", err)
+		}
+	}
+	typeURLs := make(map[string]struct{}, len(p.SponsoredMsgTypeUrls))
+	for _, typeURL := range p.SponsoredMsgTypeUrls {
+		if !strings.HasPrefix(typeURL, "/") {
+			return WrapError(ErrInvalidParams, "sponsored msg type URL %q must start with /", typeURL)
+		}
+		if _, ok := typeURLs[typeURL]; ok {
+			return WrapError(ErrInvalidParams, "duplicate sponsored msg type URL %s", typeURL)
+		}
+		typeURLs[typeURL] = struct{}{}
+	}
+	if err := p.MaxSponsoredFee.Validate(); err != nil {
+		return WrapError(ErrInvalidParams, "invalid max sponsored fee: %v", err)
+	}
+	if p.L1StandardBridge != "" && !common.IsHexAddress(p.L1StandardBridge) {
+		return WrapError(ErrInvalidParams, "invalid L1 standard bridge address: %s", p.L1StandardBridge)
+	}
+	if p.WithdrawalClaimer != "" {
+		if !common.IsHexAddress(p.WithdrawalClaimer) {
+			return WrapError(ErrInvalidParams, "invalid withdrawal claimer address: %s", p.WithdrawalClaimer)
+		}
+		if p.MaxWithdrawalBatchSize == 0 || p.MaxWithdrawalBatchSize > MaxWithdrawalBatchSize {
+			return WrapError(
+				ErrInvalidParams,
+				"max withdrawal batch size must be between 1 and %d: %d",
+				MaxWithdrawalBatchSize,
+				p.MaxWithdrawalBatchSize,
+			)
+		}
+	}
+	return nil
+}
+
+// Sponsors returns nil if the sponsor pays fee for a tx with msgs, i.e., sponsorship is enabled, the tx only contains
+// sponsored msgs, and the fee is at most the max sponsored fee.
+func (p *Params) Sponsors(msgs []sdktypes.Msg, fee sdktypes.Coins) error {
+	if p.Sponsor == "" {
+		return WrapError(ErrNotSponsored, "sponsorship is disabled")
+	}
+	if len(msgs) == 0 {
+		return WrapError(ErrNotSponsored, "tx has no msgs")
+	}
+	for _, msg := range msgs {
+		if typeURL := sdktypes.MsgTypeURL(msg); !slices.Contains(p.SponsoredMsgTypeUrls, typeURL) {
+			return WrapError(ErrNotSponsored, "msg %s is not sponsored", typeURL)
+		}
+	}
+	if !fee.IsAllLTE(p.MaxSponsoredFee) {
+		return WrapError(ErrNotSponsored, "fee %s exceeds max sponsored fee %s", fee, p.MaxSponsoredFee)
+	}
+	return nil
+}
+
+// DefaultETHDenomMetadata returns the metadata of the default bridged ETH denom, ETH, whose unit is wei. It is displayed
+// in ether.
+func DefaultETHDenomMetadata() banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "Ether bridged from L1",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: ETH, Exponent: 0, Aliases: []string{"wei"}},
+			{Denom: "gwei", Exponent: 9},   //nolint:mnd
+			{Denom: "ether", Exponent: 18}, //nolint:mnd
+		},
+		Base:    ETH,
+		Display: "ether",
+		Name:    "Ether",
+		Symbol:  "ETH",
+	}
+}
+
+func DefaultGenesisState() *GenesisState {
+	metadata := DefaultETHDenomMetadata()
+	return &GenesisState{
+		Params:           DefaultParams(),
+		EthDenomMetadata: &metadata,
+	}
+}
+
+func (g *GenesisState) Validate() error {
+	if err := g.Params.Validate(); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+	if g.EthDenomMetadata != nil {
+		if err := g.EthDenomMetadata.Validate(); err != nil {
+			return fmt.Errorf("validate eth denom metadata: %w", err)
+		}
+	}
+	return nil
+}
+
+// ETHDenomMetadata returns the metadata of the bridged ETH denom, or the default metadata if it is unset.
+func (g *GenesisState) ETHDenomMetadata() banktypes.Metadata {
+	if g.EthDenomMetadata == nil {
+		return DefaultETHDenomMetadata()
+	}
+	return *g.EthDenomMetadata
+}
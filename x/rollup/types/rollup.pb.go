@@ -0,0 +1,1599 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rollup/v1/rollup.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the x/rollup module's parameters.
+type Params struct {
+	// The account that pays the fees of sponsored txs, e.g., the sequencer's account. Sponsorship is disabled if empty.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// The type URLs of the messages a tx may contain to be sponsored, e.g., "/cosmos.bank.v1beta1.MsgSend".
+	SponsoredMsgTypeUrls []string `protobuf:"bytes,2,rep,name=sponsored_msg_type_urls,json=sponsoredMsgTypeUrls,proto3" json:"sponsored_msg_type_urls,omitempty"`
+	// The largest fee the sponsor pays for a single tx.
+	MaxSponsoredFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_sponsored_fee,json=maxSponsoredFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_sponsored_fee"`
+	// The L1 address of the WithdrawalClaimer contract batched withdrawals are claimed from. Withdrawal batching is
+	// disabled if empty.
+	WithdrawalClaimer string `protobuf:"bytes,4,opt,name=withdrawal_claimer,json=withdrawalClaimer,proto3" json:"withdrawal_claimer,omitempty"`
+	// The number of batched withdrawals after which the pending batch is flushed to L1.
+	MaxWithdrawalBatchSize uint64 `protobuf:"varint,5,opt,name=max_withdrawal_batch_size,json=maxWithdrawalBatchSize,proto3" json:"max_withdrawal_batch_size,omitempty"`
+	// The L1 address of the L1StandardBridge whose finalizeBridgeERC20 messages mint bridged ERC-20 tokens. ERC-20
+	// deposits fail while it is empty.
+	L1StandardBridge string `protobuf:"bytes,6,opt,name=l1_standard_bridge,json=l1StandardBridge,proto3" json:"l1_standard_bridge,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b51d0d5c8e6e30d5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *Params) GetSponsoredMsgTypeUrls() []string {
+	if m != nil {
+		return m.SponsoredMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetMaxSponsoredFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxSponsoredFee
+	}
+	return nil
+}
+
+func (m *Params) GetWithdrawalClaimer() string {
+	if m != nil {
+		return m.WithdrawalClaimer
+	}
+	return ""
+}
+
+func (m *Params) GetMaxWithdrawalBatchSize() uint64 {
+	if m != nil {
+		return m.MaxWithdrawalBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetL1StandardBridge() string {
+	if m != nil {
+		return m.L1StandardBridge
+	}
+	return ""
+}
+
+// GenesisState defines the x/rollup module's genesis state.
+type GenesisState struct {
+	// The module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// The metadata of the bridged ETH denom, registered in x/bank at genesis. Deposits mint and withdrawals burn its base
+	// denom, whose unit is wei. The default metadata is used if unset.
+	EthDenomMetadata *types1.Metadata `protobuf:"bytes,2,opt,name=eth_denom_metadata,json=ethDenomMetadata,proto3" json:"eth_denom_metadata,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b51d0d5c8e6e30d5, []int{1}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetEthDenomMetadata() *types1.Metadata {
+	if m != nil {
+		return m.EthDenomMetadata
+	}
+	return nil
+}
+
+// FailedDeposit is a user deposit whose execution failed. As on OP Stack chains, its mint was still credited to the L2
+// account of the L1 address that sent it, so the funds can be recovered.
+type FailedDeposit struct {
+	// The hash of the deposit tx.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// The L1 address that sent the deposit.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// The cosmos address the mint was credited to.
+	MintAddress string `protobuf:"bytes,3,opt,name=mint_address,json=mintAddress,proto3" json:"mint_address,omitempty"`
+	// The amount of ETH (in wei) credited to the mint address.
+	Mint cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=mint,proto3,customtype=cosmossdk.io/math.Int" json:"mint"`
+	// Why the deposit failed.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The number of the L1 block that included the deposit.
+	L1BlockNumber uint64 `protobuf:"varint,6,opt,name=l1_block_number,json=l1BlockNumber,proto3" json:"l1_block_number,omitempty"`
+}
+
+func (m *FailedDeposit) Reset()         { *m = FailedDeposit{} }
+func (m *FailedDeposit) String() string { return proto.CompactTextString(m) }
+func (*FailedDeposit) ProtoMessage()    {}
+func (*FailedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b51d0d5c8e6e30d5, []int{2}
+}
+func (m *FailedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedDeposit.Merge(m, src)
+}
+func (m *FailedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedDeposit proto.InternalMessageInfo
+
+func (m *FailedDeposit) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *FailedDeposit) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *FailedDeposit) GetMintAddress() string {
+	if m != nil {
+		return m.MintAddress
+	}
+	return ""
+}
+
+func (m *FailedDeposit) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FailedDeposit) GetL1BlockNumber() uint64 {
+	if m != nil {
+		return m.L1BlockNumber
+	}
+	return 0
+}
+
+// ERC20Token maps the denom of an ERC-20 token bridged through the L1StandardBridge to the token addresses its deposits
+// carry, so withdrawals can be relayed back to the same token on L1.
+type ERC20Token struct {
+	// The denom of the token's coins.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The address of the token on L1.
+	L1Token string `protobuf:"bytes,2,opt,name=l1_token,json=l1Token,proto3" json:"l1_token,omitempty"`
+	// The address the L1StandardBridge bridges the token to on L2.
+	L2Token string `protobuf:"bytes,3,opt,name=l2_token,json=l2Token,proto3" json:"l2_token,omitempty"`
+	// The address of the L1StandardBridge the token was deposited through.
+	L1Bridge string `protobuf:"bytes,4,opt,name=l1_bridge,json=l1Bridge,proto3" json:"l1_bridge,omitempty"`
+}
+
+func (m *ERC20Token) Reset()         { *m = ERC20Token{} }
+func (m *ERC20Token) String() string { return proto.CompactTextString(m) }
+func (*ERC20Token) ProtoMessage()    {}
+func (*ERC20Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b51d0d5c8e6e30d5, []int{3}
+}
+func (m *ERC20Token) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Token.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Token.Merge(m, src)
+}
+func (m *ERC20Token) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Token.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Token proto.InternalMessageInfo
+
+func (m *ERC20Token) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ERC20Token) GetL1Token() string {
+	if m != nil {
+		return m.L1Token
+	}
+	return ""
+}
+
+func (m *ERC20Token) GetL2Token() string {
+	if m != nil {
+		return m.L2Token
+	}
+	return ""
+}
+
+func (m *ERC20Token) GetL1Bridge() string {
+	if m != nil {
+		return m.L1Bridge
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "rollup.v1.Params")
+	proto.RegisterType((*GenesisState)(nil), "rollup.v1.GenesisState")
+	proto.RegisterType((*FailedDeposit)(nil), "rollup.v1.FailedDeposit")
+	proto.RegisterType((*ERC20Token)(nil), "rollup.v1.ERC20Token")
+}
+
+func init() { proto.RegisterFile("rollup/v1/rollup.proto", fileDescriptor_b51d0d5c8e6e30d5) }
+
+var fileDescriptor_b51d0d5c8e6e30d5 = []byte{
+	// 725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x6e, 0xeb, 0x44,
+	0x14, 0xc6, 0xe3, 0x9b, 0xdc, 0xf4, 0x66, 0x7a, 0xaf, 0xee, 0xcd, 0x28, 0xb4, 0x4e, 0x11, 0x69,
+	0x94, 0x05, 0x8a, 0x50, 0x63, 0xd7, 0x41, 0x5d, 0x20, 0x56, 0xb8, 0xa5, 0x50, 0xa1, 0x22, 0xe4,
+	0x14, 0x21, 0xb1, 0x19, 0x8d, 0xe3, 0x69, 0x6c, 0x65, 0xfe, 0x58, 0x33, 0x93, 0x36, 0xad, 0x78,
+	0x01, 0x24, 0x16, 0x3c, 0x06, 0x62, 0xc5, 0xa2, 0x0f, 0xd1, 0x65, 0xd5, 0x15, 0x62, 0x51, 0x50,
+	0xbb, 0xe0, 0x31, 0x40, 0x9e, 0x99, 0xa6, 0x95, 0x58, 0xb0, 0x49, 0xe6, 0x9c, 0xdf, 0xf9, 0x3c,
+	0x73, 0xe6, 0x7c, 0x36, 0xd8, 0x90, 0x82, 0xd2, 0x45, 0x19, 0x9e, 0x45, 0xa1, 0x5d, 0x05, 0xa5,
+	0x14, 0x5a, 0xc0, 0x96, 0x8b, 0xce, 0xa2, 0xad, 0x36, 0x66, 0x05, 0x17, 0xa1, 0xf9, 0xb5, 0x74,
+	0xab, 0x37, 0x15, 0x8a, 0x09, 0x15, 0xa6, 0x98, 0xcf, 0xc3, 0xb3, 0x28, 0x25, 0x1a, 0x47, 0x26,
+	0xf8, 0x0f, 0x57, 0x64, 0xc5, 0xa7, 0xa2, 0xe0, 0x8e, 0x77, 0x2d, 0x47, 0x26, 0x0a, 0x6d, 0xe0,
+	0x50, 0x67, 0x26, 0x66, 0xc2, 0xe6, 0xab, 0x95, 0xcd, 0x0e, 0x7e, 0xac, 0x83, 0xe6, 0x37, 0x58,
+	0x62, 0xa6, 0xe0, 0x18, 0xac, 0xa9, 0x52, 0x70, 0x25, 0xa4, 0xef, 0xf5, 0xbd, 0x61, 0x2b, 0xf6,
+	0x6f, 0xaf, 0x46, 0x1d, 0xf7, 0x8c, 0xcf, 0xb2, 0x4c, 0x12, 0xa5, 0x26, 0x5a, 0x16, 0x7c, 0x96,
+	0x3c, 0x16, 0xc2, 0x3d, 0xb0, 0xe9, 0x96, 0x24, 0x43, 0x4c, 0xcd, 0x90, 0xbe, 0x28, 0x09, 0x5a,
+	0x48, 0xaa, 0xfc, 0x17, 0xfd, 0xfa, 0xb0, 0x95, 0x74, 0x56, 0xf8, 0x58, 0xcd, 0x4e, 0x2e, 0x4a,
+	0xf2, 0xad, 0xa4, 0x0a, 0xfe, 0x00, 0xda, 0x0c, 0x2f, 0xd1, 0x93, 0xf4, 0x94, 0x10, 0xbf, 0xde,
+	0xaf, 0x0f, 0xd7, 0xc7, 0xdd, 0xc0, 0xed, 0x58, 0xb5, 0x18, 0xb8, 0x16, 0x83, 0x7d, 0x51, 0xf0,
+	0x78, 0xef, 0xfa, 0x6e, 0xbb, 0xf6, 0xeb, 0x9f, 0xdb, 0xc3, 0x59, 0xa1, 0xf3, 0x45, 0x1a, 0x4c,
+	0x05, 0x73, 0x2d, 0xba, 0xbf, 0x91, 0xca, 0xe6, 0x61, 0x75, 0x02, 0x65, 0x04, 0xea, 0x97, 0xbf,
+	0x7f, 0xfb, 0xc8, 0x4b, 0xde, 0x32, 0xbc, 0x9c, 0x3c, 0xee, 0x74, 0x48, 0x08, 0x1c, 0x01, 0x78,
+	0x5e, 0xe8, 0x3c, 0x93, 0xf8, 0x1c, 0x53, 0x34, 0xa5, 0xb8, 0x60, 0x44, 0xfa, 0x8d, 0xaa, 0xe7,
+	0xa4, 0xfd, 0x44, 0xf6, 0x2d, 0x80, 0x9f, 0x80, 0x6e, 0x75, 0xd8, 0x67, 0x92, 0x14, 0xeb, 0x69,
+	0x8e, 0x54, 0x71, 0x49, 0xfc, 0x97, 0x7d, 0x6f, 0xd8, 0x48, 0x36, 0x18, 0x5e, 0x7e, 0xb7, 0xe2,
+	0x71, 0x85, 0x27, 0xc5, 0x25, 0x81, 0x3b, 0x00, 0xd2, 0x08, 0x29, 0x8d, 0x79, 0x86, 0x65, 0x86,
+	0x52, 0x59, 0x64, 0x33, 0xe2, 0x37, 0xcd, 0x4e, 0xef, 0x68, 0x34, 0x71, 0x20, 0x36, 0xf9, 0xc1,
+	0x4f, 0x1e, 0x78, 0xfd, 0x05, 0xe1, 0x44, 0x15, 0x6a, 0xa2, 0xb1, 0x26, 0x30, 0x04, 0xcd, 0xd2,
+	0xcc, 0xc6, 0x0c, 0x64, 0x7d, 0xdc, 0x0e, 0x56, 0xe6, 0x09, 0xec, 0xd0, 0xe2, 0x46, 0x75, 0x27,
+	0x89, 0x2b, 0x83, 0x5f, 0x01, 0x48, 0x74, 0x8e, 0x32, 0xc2, 0x05, 0x43, 0x8c, 0x68, 0x9c, 0x61,
+	0x8d, 0xfd, 0x17, 0x46, 0xfc, 0xc1, 0xd3, 0xc5, 0xf2, 0xf9, 0xea, 0x62, 0x8f, 0x5d, 0x51, 0xf2,
+	0x8e, 0xe8, 0xfc, 0xa0, 0xd2, 0x3d, 0x66, 0x06, 0xff, 0x78, 0xe0, 0xcd, 0x21, 0x2e, 0x28, 0xc9,
+	0x0e, 0x48, 0x29, 0x54, 0xa1, 0xe1, 0x26, 0x58, 0xd3, 0x4b, 0x94, 0x63, 0x95, 0x5b, 0x87, 0x24,
+	0x4d, 0xbd, 0xfc, 0x12, 0xab, 0x1c, 0x42, 0xd0, 0x38, 0x95, 0x82, 0x99, 0x9d, 0x5a, 0x89, 0x59,
+	0xc3, 0x4f, 0xc1, 0x6b, 0x56, 0x70, 0x8d, 0xb0, 0x75, 0x8e, 0x5f, 0xff, 0x1f, 0x4f, 0xad, 0x57,
+	0xd5, 0x2e, 0x05, 0x0f, 0x40, 0xa3, 0x0a, 0xed, 0x50, 0xe2, 0xdd, 0xaa, 0xc9, 0x3f, 0xee, 0xb6,
+	0xdf, 0xb3, 0x42, 0x95, 0xcd, 0x83, 0x42, 0x84, 0x0c, 0xeb, 0x3c, 0x38, 0xe2, 0xfa, 0xf6, 0x6a,
+	0x04, 0xdc, 0x13, 0x8f, 0xb8, 0xb6, 0x33, 0x37, 0x6a, 0xb8, 0x01, 0x9a, 0x92, 0x60, 0x25, 0xb8,
+	0x19, 0x53, 0x2b, 0x71, 0x11, 0xfc, 0x10, 0xbc, 0xa5, 0x11, 0x4a, 0xa9, 0x98, 0xce, 0x11, 0x5f,
+	0xb0, 0x94, 0x48, 0x33, 0x93, 0x46, 0xf2, 0x86, 0x46, 0x71, 0x95, 0xfd, 0xda, 0x24, 0x07, 0x0b,
+	0x00, 0x3e, 0x4f, 0xf6, 0xc7, 0xbb, 0x27, 0x62, 0x4e, 0x38, 0xec, 0x80, 0x97, 0xe6, 0x62, 0x5d,
+	0xef, 0x36, 0x80, 0x5d, 0xf0, 0x8a, 0x46, 0x48, 0x57, 0x15, 0xae, 0xfd, 0x35, 0x1a, 0x59, 0x41,
+	0x85, 0xc6, 0x0e, 0xd5, 0x1d, 0x1a, 0x5b, 0xf4, 0x3e, 0x68, 0x55, 0x27, 0xb0, 0x7e, 0xb0, 0xce,
+	0x7b, 0x45, 0x23, 0xeb, 0x83, 0xf8, 0xf0, 0xfa, 0xbe, 0xe7, 0xdd, 0xdc, 0xf7, 0xbc, 0xbf, 0xee,
+	0x7b, 0xde, 0xcf, 0x0f, 0xbd, 0xda, 0xcd, 0x43, 0xaf, 0xf6, 0xfb, 0x43, 0xaf, 0xf6, 0xfd, 0xce,
+	0x33, 0xe7, 0x97, 0x82, 0x5e, 0x30, 0x22, 0x33, 0x2c, 0x42, 0x26, 0xb8, 0x60, 0x44, 0x86, 0x4b,
+	0xf7, 0xa9, 0xb1, 0xef, 0x40, 0xda, 0x34, 0xaf, 0xf8, 0xc7, 0xff, 0x0e, 0x00, 0xda, 0x11, 0x2e,
+	0x70, 0x8b, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.L1StandardBridge) > 0 {
+		i -= len(m.L1StandardBridge)
+		copy(dAtA[i:], m.L1StandardBridge)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.L1StandardBridge)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxWithdrawalBatchSize != 0 {
+		i = encodeVarintRollup(dAtA, i, uint64(m.MaxWithdrawalBatchSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WithdrawalClaimer) > 0 {
+		i -= len(m.WithdrawalClaimer)
+		copy(dAtA[i:], m.WithdrawalClaimer)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.WithdrawalClaimer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxSponsoredFee) > 0 {
+		for iNdEx := len(m.MaxSponsoredFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxSponsoredFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SponsoredMsgTypeUrls) > 0 {
+		for iNdEx := len(m.SponsoredMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SponsoredMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.SponsoredMsgTypeUrls[iNdEx])
+			i = encodeVarintRollup(dAtA, i, uint64(len(m.SponsoredMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthDenomMetadata != nil {
+		{
+			size, err := m.EthDenomMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollup(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRollup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.L1BlockNumber != 0 {
+		i = encodeVarintRollup(dAtA, i, uint64(m.L1BlockNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Mint.Size()
+		i -= size
+		if _, err := m.Mint.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRollup(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MintAddress) > 0 {
+		i -= len(m.MintAddress)
+		copy(dAtA[i:], m.MintAddress)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.MintAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.L1Bridge) > 0 {
+		i -= len(m.L1Bridge)
+		copy(dAtA[i:], m.L1Bridge)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.L1Bridge)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.L2Token) > 0 {
+		i -= len(m.L2Token)
+		copy(dAtA[i:], m.L2Token)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.L2Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.L1Token) > 0 {
+		i -= len(m.L1Token)
+		copy(dAtA[i:], m.L1Token)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.L1Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRollup(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRollup(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRollup(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	if len(m.SponsoredMsgTypeUrls) > 0 {
+		for _, s := range m.SponsoredMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovRollup(uint64(l))
+		}
+	}
+	if len(m.MaxSponsoredFee) > 0 {
+		for _, e := range m.MaxSponsoredFee {
+			l = e.Size()
+			n += 1 + l + sovRollup(uint64(l))
+		}
+	}
+	l = len(m.WithdrawalClaimer)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	if m.MaxWithdrawalBatchSize != 0 {
+		n += 1 + sovRollup(uint64(m.MaxWithdrawalBatchSize))
+	}
+	l = len(m.L1StandardBridge)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovRollup(uint64(l))
+	if m.EthDenomMetadata != nil {
+		l = m.EthDenomMetadata.Size()
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	l = len(m.MintAddress)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	l = m.Mint.Size()
+	n += 1 + l + sovRollup(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	if m.L1BlockNumber != 0 {
+		n += 1 + sovRollup(uint64(m.L1BlockNumber))
+	}
+	return n
+}
+
+func (m *ERC20Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	l = len(m.L1Token)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	l = len(m.L2Token)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	l = len(m.L1Bridge)
+	if l > 0 {
+		n += 1 + l + sovRollup(uint64(l))
+	}
+	return n
+}
+
+func sovRollup(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRollup(x uint64) (n int) {
+	return sovRollup(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsoredMsgTypeUrls = append(m.SponsoredMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSponsoredFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSponsoredFee = append(m.MaxSponsoredFee, types.Coin{})
+			if err := m.MaxSponsoredFee[len(m.MaxSponsoredFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalClaimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalClaimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawalBatchSize", wireType)
+			}
+			m.MaxWithdrawalBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWithdrawalBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L1StandardBridge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L1StandardBridge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthDenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EthDenomMetadata == nil {
+				m.EthDenomMetadata = &types1.Metadata{}
+			}
+			if err := m.EthDenomMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L1BlockNumber", wireType)
+			}
+			m.L1BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.L1BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L1Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L1Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L2Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L1Bridge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollup
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L1Bridge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipRollup(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRollup
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRollup
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRollup
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRollup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRollup
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRollup        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRollup          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRollup = fmt.Errorf("proto: unexpected end of group")
+)
@@ -122,12 +122,19 @@ type MsgInitiateWithdrawal struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// The ethereum address on L1 that the user wants to withdraw to.
 	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	// The amount of ETH (in wei) that the user wants to withdraw.
+	// The amount of ETH (in wei) or ERC-20 tokens that the user wants to withdraw.
 	Value cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value"`
 	// Minimum gas limit for executing the message on L1.
 	GasLimit []byte `protobuf:"bytes,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	// Data to forward to L1 target.
 	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// Whether to add the withdrawal to the pending withdrawal batch instead of sending it to L1 on its own. Batched
+	// withdrawals are claimed from the WithdrawalClaimer on L1 and can't have a gas limit or data.
+	Batched bool `protobuf:"varint,6,opt,name=batched,proto3" json:"batched,omitempty"`
+	// The denom of the coins to withdraw. ETH is withdrawn if it is empty. The denom of a bridged ERC-20 token withdraws the
+	// token through the L1StandardBridge, with gas_limit as the minimum gas limit of its finalization on L1 and data as its
+	// extra data.
+	Denom string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgInitiateWithdrawal) Reset()         { *m = MsgInitiateWithdrawal{} }
@@ -191,6 +198,20 @@ func (m *MsgInitiateWithdrawal) GetData() []byte {
 	return nil
 }
 
+func (m *MsgInitiateWithdrawal) GetBatched() bool {
+	if m != nil {
+		return m.Batched
+	}
+	return false
+}
+
+func (m *MsgInitiateWithdrawal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // MsgInitiateWithdrawalResponse defines the Msg/InitiateWithdrawal response type.
 type MsgInitiateWithdrawalResponse struct {
 }
@@ -228,46 +249,234 @@ func (m *MsgInitiateWithdrawalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInitiateWithdrawalResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines the message for updating the module parameters. It can only be sent by the module authority.
+type MsgUpdateParams struct {
+	// The module authority, usually the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The new module parameters.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106533843870de0f, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106533843870de0f, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgFlushWithdrawalBatch defines the message for flushing the pending withdrawal batch to L1 before it is full.
+type MsgFlushWithdrawalBatch struct {
+	// The cosmos address of the account flushing the batch. Anyone can flush the pending batch.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgFlushWithdrawalBatch) Reset()         { *m = MsgFlushWithdrawalBatch{} }
+func (m *MsgFlushWithdrawalBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgFlushWithdrawalBatch) ProtoMessage()    {}
+func (*MsgFlushWithdrawalBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106533843870de0f, []int{6}
+}
+func (m *MsgFlushWithdrawalBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlushWithdrawalBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlushWithdrawalBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlushWithdrawalBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlushWithdrawalBatch.Merge(m, src)
+}
+func (m *MsgFlushWithdrawalBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlushWithdrawalBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlushWithdrawalBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlushWithdrawalBatch proto.InternalMessageInfo
+
+func (m *MsgFlushWithdrawalBatch) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgFlushWithdrawalBatchResponse defines the Msg/FlushWithdrawalBatch response type.
+type MsgFlushWithdrawalBatchResponse struct {
+}
+
+func (m *MsgFlushWithdrawalBatchResponse) Reset()         { *m = MsgFlushWithdrawalBatchResponse{} }
+func (m *MsgFlushWithdrawalBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlushWithdrawalBatchResponse) ProtoMessage()    {}
+func (*MsgFlushWithdrawalBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106533843870de0f, []int{7}
+}
+func (m *MsgFlushWithdrawalBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlushWithdrawalBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlushWithdrawalBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlushWithdrawalBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlushWithdrawalBatchResponse.Merge(m, src)
+}
+func (m *MsgFlushWithdrawalBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlushWithdrawalBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlushWithdrawalBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlushWithdrawalBatchResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgApplyL1Txs)(nil), "rollup.v1.MsgApplyL1Txs")
 	proto.RegisterType((*MsgApplyL1TxsResponse)(nil), "rollup.v1.MsgApplyL1TxsResponse")
 	proto.RegisterType((*MsgInitiateWithdrawal)(nil), "rollup.v1.MsgInitiateWithdrawal")
 	proto.RegisterType((*MsgInitiateWithdrawalResponse)(nil), "rollup.v1.MsgInitiateWithdrawalResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "rollup.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "rollup.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgFlushWithdrawalBatch)(nil), "rollup.v1.MsgFlushWithdrawalBatch")
+	proto.RegisterType((*MsgFlushWithdrawalBatchResponse)(nil), "rollup.v1.MsgFlushWithdrawalBatchResponse")
 }
 
 func init() { proto.RegisterFile("rollup/v1/tx.proto", fileDescriptor_106533843870de0f) }
 
 var fileDescriptor_106533843870de0f = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xbd, 0x6e, 0x13, 0x31,
-	0x1c, 0x8f, 0x49, 0x13, 0x1a, 0x53, 0x06, 0xac, 0x96, 0x5e, 0x0f, 0x71, 0x89, 0x32, 0x45, 0x11,
-	0x3d, 0x37, 0xb0, 0xb1, 0x35, 0x43, 0x45, 0xa4, 0x76, 0x39, 0x90, 0x90, 0x58, 0x82, 0xd3, 0xb3,
-	0x1c, 0x8b, 0xf3, 0xf9, 0x64, 0xff, 0x13, 0xee, 0x36, 0xc4, 0x13, 0xf0, 0x18, 0x8c, 0x1d, 0x3a,
-	0xf0, 0x08, 0x1d, 0xab, 0x4e, 0x88, 0xa1, 0x42, 0xc9, 0x90, 0xd7, 0x40, 0xf7, 0x11, 0x44, 0xa1,
-	0xa8, 0xcb, 0xc9, 0xbf, 0xaf, 0xbf, 0x7d, 0x3f, 0x1b, 0x13, 0xa3, 0xa3, 0x68, 0x96, 0xd0, 0xf9,
-	0x80, 0x42, 0xea, 0x27, 0x46, 0x83, 0x26, 0xad, 0x92, 0xf3, 0xe7, 0x03, 0xf7, 0x11, 0x53, 0x32,
-	0xd6, 0xb4, 0xf8, 0x96, 0xaa, 0xbb, 0x7b, 0xaa, 0xad, 0xd2, 0x96, 0x2a, 0x2b, 0xf2, 0x94, 0xb2,
-	0xa2, 0x12, 0xf6, 0x4a, 0x61, 0x5c, 0x20, 0x5a, 0x82, 0x4a, 0xda, 0x16, 0x5a, 0xe8, 0x92, 0xcf,
-	0x57, 0x25, 0xdb, 0xed, 0xe3, 0x87, 0x27, 0x56, 0x1c, 0x26, 0x49, 0x94, 0x1d, 0x0f, 0xde, 0xa4,
-	0x96, 0xec, 0xe1, 0x4d, 0x48, 0xc7, 0x93, 0x0c, 0xb8, 0x75, 0x50, 0xa7, 0xde, 0xdb, 0x0a, 0xee,
-	0x43, 0x3a, 0xcc, 0x61, 0x77, 0x17, 0xef, 0xdc, 0xf0, 0x06, 0xdc, 0x26, 0x3a, 0xb6, 0xbc, 0xbb,
-	0x42, 0x85, 0x32, 0x8a, 0x25, 0x48, 0x06, 0xfc, 0xad, 0x84, 0x69, 0x68, 0xd8, 0x47, 0x16, 0x91,
-	0x03, 0xdc, 0xb4, 0x3c, 0x0e, 0xb9, 0x71, 0x50, 0x07, 0xf5, 0x5a, 0x43, 0xe7, 0xea, 0x7c, 0x7f,
-	0xbb, 0x3a, 0xd6, 0x61, 0x18, 0x1a, 0x6e, 0xed, 0x6b, 0x30, 0x32, 0x16, 0x41, 0xe5, 0x23, 0x8f,
-	0x71, 0x13, 0x98, 0x11, 0x1c, 0x9c, 0x7b, 0x79, 0x22, 0xa8, 0x10, 0x39, 0xc2, 0x8d, 0x39, 0x8b,
-	0x66, 0xdc, 0xa9, 0x17, 0x83, 0x0e, 0x2e, 0xae, 0xdb, 0xb5, 0x1f, 0xd7, 0xed, 0x9d, 0x72, 0x98,
-	0x0d, 0x3f, 0xf8, 0x52, 0x53, 0xc5, 0x60, 0xea, 0x8f, 0x62, 0xb8, 0x3a, 0xdf, 0xc7, 0xd5, 0x2e,
-	0xa3, 0x18, 0xbe, 0xae, 0xce, 0xfa, 0x28, 0x28, 0xe3, 0xe4, 0x09, 0x6e, 0x09, 0x66, 0xc7, 0x91,
-	0x54, 0x12, 0x9c, 0x8d, 0x0e, 0xea, 0x6d, 0x05, 0x9b, 0x82, 0xd9, 0xe3, 0x1c, 0x13, 0x82, 0x37,
-	0x42, 0x06, 0xcc, 0x69, 0x14, 0x7c, 0xb1, 0x7e, 0xf9, 0xe0, 0xf3, 0xea, 0xac, 0x5f, 0x9d, 0xae,
-	0xdb, 0xc6, 0x4f, 0x6f, 0xfd, 0xd1, 0x75, 0x15, 0xcf, 0xbf, 0x21, 0x5c, 0x3f, 0xb1, 0x82, 0xbc,
-	0xc2, 0xf8, 0x8f, 0x52, 0x1d, 0xff, 0xf7, 0x75, 0xfa, 0x37, 0x2a, 0x74, 0x3b, 0xff, 0x53, 0xd6,
-	0x13, 0xc9, 0x7b, 0x4c, 0x6e, 0x29, 0xf6, 0xaf, 0xdc, 0xbf, 0x0e, 0xb7, 0x77, 0x97, 0x63, 0xbd,
-	0x83, 0xdb, 0xf8, 0x94, 0x17, 0x34, 0x3c, 0xba, 0x58, 0x78, 0xe8, 0x72, 0xe1, 0xa1, 0x9f, 0x0b,
-	0x0f, 0x7d, 0x59, 0x7a, 0xb5, 0xcb, 0xa5, 0x57, 0xfb, 0xbe, 0xf4, 0x6a, 0xef, 0x9e, 0x09, 0x09,
-	0xd3, 0xd9, 0xc4, 0x3f, 0xd5, 0x8a, 0x26, 0x3a, 0xca, 0x14, 0x37, 0x21, 0xd3, 0x54, 0xe9, 0x58,
-	0x2b, 0x6e, 0x68, 0x4a, 0xab, 0x07, 0x0c, 0x59, 0xc2, 0xed, 0xa4, 0x59, 0xbc, 0xac, 0x17, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x45, 0x06, 0xeb, 0x1e, 0xd7, 0x02, 0x00, 0x00,
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x95, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x6e, 0x9a, 0xe6, 0x6f, 0x1a, 0x40, 0x5d, 0xa5, 0x8d, 0x63, 0x44, 0x53, 0x7c, 0x8a, 0x22,
+	0xb0, 0x9b, 0x82, 0x38, 0xf4, 0xd6, 0x1c, 0x22, 0x2a, 0x15, 0x84, 0x0c, 0x08, 0xc4, 0x25, 0x6c,
+	0xe2, 0xc5, 0xb1, 0xb0, 0xbd, 0x96, 0x77, 0x13, 0x92, 0x1b, 0xe2, 0x05, 0xe0, 0xc6, 0x2b, 0x70,
+	0xe4, 0xc0, 0x43, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0x2a, 0x04, 0x07, 0xae, 0x3c, 0x02, 0xeb, 0xb5,
+	0xf3, 0x4b, 0xa2, 0x88, 0xc3, 0xda, 0x3b, 0x33, 0xdf, 0x7c, 0xdf, 0xec, 0xec, 0xd8, 0x80, 0x42,
+	0xea, 0xba, 0xfd, 0xc0, 0x18, 0x34, 0x0c, 0x3e, 0xd4, 0x83, 0x90, 0x72, 0x8a, 0x0a, 0xb1, 0x4f,
+	0x1f, 0x34, 0xd4, 0x1d, 0xec, 0x39, 0x3e, 0x35, 0xe4, 0x33, 0x8e, 0xaa, 0xe5, 0x2e, 0x65, 0x1e,
+	0x65, 0x86, 0xc7, 0xec, 0x28, 0x4b, 0xbc, 0x92, 0x40, 0x25, 0x0e, 0xb4, 0xa5, 0x65, 0xc4, 0x46,
+	0x12, 0x2a, 0xd9, 0xd4, 0xa6, 0xb1, 0x3f, 0xda, 0x25, 0xde, 0xbd, 0xa9, 0x76, 0xa2, 0x28, 0xfd,
+	0x5a, 0x1d, 0xae, 0x3c, 0x60, 0xf6, 0x49, 0x10, 0xb8, 0xa3, 0xb3, 0xc6, 0x93, 0x21, 0x43, 0x15,
+	0xc8, 0xf3, 0x61, 0xbb, 0x33, 0xe2, 0x84, 0x29, 0xa9, 0x83, 0x74, 0xad, 0x68, 0xe6, 0xf8, 0xb0,
+	0x19, 0x99, 0x5a, 0x19, 0x76, 0xe7, 0xb0, 0x26, 0x61, 0x01, 0xf5, 0x19, 0xd1, 0x3e, 0x6e, 0xca,
+	0xc8, 0xa9, 0xef, 0x70, 0x07, 0x73, 0xf2, 0xcc, 0xe1, 0x3d, 0x2b, 0xc4, 0x6f, 0xb0, 0x8b, 0x0e,
+	0x21, 0xcb, 0x88, 0x6f, 0x91, 0x50, 0x70, 0xa5, 0x6a, 0x85, 0xa6, 0xf2, 0xf5, 0xcb, 0xed, 0x52,
+	0x52, 0xee, 0x89, 0x65, 0x85, 0x84, 0xb1, 0xc7, 0x3c, 0x74, 0x7c, 0xdb, 0x4c, 0x70, 0x68, 0x0f,
+	0xb2, 0x1c, 0x87, 0x36, 0xe1, 0xca, 0x66, 0x94, 0x61, 0x26, 0x16, 0x6a, 0x41, 0x66, 0x80, 0xdd,
+	0x3e, 0x51, 0xd2, 0x92, 0xe8, 0xf0, 0xfc, 0xb2, 0xba, 0xf1, 0xfd, 0xb2, 0xba, 0x1b, 0x93, 0x31,
+	0xeb, 0xb5, 0xee, 0x50, 0xc3, 0xc3, 0xbc, 0xa7, 0x9f, 0xfa, 0x5c, 0xa8, 0x40, 0xa2, 0x22, 0xac,
+	0x4f, 0xbf, 0x3f, 0xd7, 0x53, 0x66, 0x9c, 0x8e, 0xae, 0x43, 0xc1, 0xc6, 0xac, 0xed, 0x3a, 0x9e,
+	0xc3, 0x95, 0x2d, 0xc1, 0x55, 0x34, 0xf3, 0xc2, 0x71, 0x16, 0xd9, 0x08, 0xc1, 0x96, 0x85, 0x39,
+	0x56, 0x32, 0xd2, 0x2f, 0xf7, 0x48, 0x81, 0x5c, 0x07, 0xf3, 0x6e, 0x8f, 0x58, 0x4a, 0x56, 0xb8,
+	0xf3, 0xe6, 0xd8, 0x44, 0x25, 0xc8, 0x58, 0xc4, 0xa7, 0x9e, 0x92, 0x93, 0x95, 0xc6, 0xc6, 0xf1,
+	0xf6, 0x3b, 0x21, 0x97, 0x9c, 0x46, 0xab, 0xc2, 0x8d, 0xa5, 0x8d, 0x99, 0xb4, 0xee, 0x7d, 0x0a,
+	0xae, 0x09, 0xc4, 0xd3, 0x40, 0x68, 0x91, 0x47, 0x38, 0xc4, 0x1e, 0x43, 0xf7, 0xa0, 0x80, 0xfb,
+	0xbc, 0x47, 0x43, 0x87, 0x8f, 0xd6, 0xf6, 0x6d, 0x0a, 0x45, 0x77, 0x21, 0x1b, 0x48, 0x06, 0xd9,
+	0xba, 0xed, 0xa3, 0x1d, 0x7d, 0x32, 0x5c, 0x7a, 0x4c, 0xdd, 0x2c, 0x44, 0x6d, 0x8b, 0xfb, 0x91,
+	0x60, 0x8f, 0xaf, 0x46, 0xf5, 0x4e, 0x59, 0xb4, 0x0a, 0x94, 0x17, 0x0a, 0x9a, 0x14, 0xfb, 0x5c,
+	0x86, 0x5a, 0x6e, 0x9f, 0xf5, 0xa6, 0x47, 0x69, 0x46, 0xdd, 0xf8, 0xff, 0x8b, 0x9e, 0xef, 0xd3,
+	0x4d, 0xa8, 0xae, 0x60, 0x1e, 0x8b, 0x1f, 0xfd, 0xd9, 0x84, 0xb4, 0xc0, 0xa0, 0xfb, 0x00, 0x33,
+	0xe3, 0xaa, 0xcc, 0x9c, 0x71, 0x6e, 0x38, 0xd5, 0x83, 0x55, 0x91, 0x31, 0x23, 0x7a, 0x09, 0x68,
+	0xc9, 0xc8, 0x2e, 0xe4, 0xfd, 0x8b, 0x50, 0x6b, 0xeb, 0x10, 0x13, 0x85, 0x87, 0x50, 0x9c, 0xbb,
+	0x59, 0x75, 0x3e, 0x73, 0x36, 0xa6, 0x6a, 0xab, 0x63, 0x13, 0xbe, 0x57, 0x50, 0x5a, 0xda, 0xfd,
+	0x85, 0xdc, 0x65, 0x18, 0xb5, 0xbe, 0x1e, 0x33, 0xd6, 0x51, 0x33, 0x6f, 0xa3, 0x11, 0x69, 0xb6,
+	0xce, 0x7f, 0xee, 0xa7, 0x2e, 0xc4, 0xfa, 0x21, 0xd6, 0x87, 0x5f, 0xfb, 0x1b, 0x17, 0x62, 0x7d,
+	0x13, 0xeb, 0xc5, 0x2d, 0x5b, 0x64, 0xf6, 0x3b, 0x7a, 0x97, 0x7a, 0x46, 0x40, 0xdd, 0x91, 0x47,
+	0x42, 0x0b, 0x8b, 0xef, 0x8f, 0x8a, 0x2f, 0x80, 0x84, 0xc6, 0x30, 0xf9, 0xc9, 0x18, 0x7c, 0x14,
+	0x10, 0xd6, 0xc9, 0xca, 0x7f, 0xcd, 0x9d, 0xbf, 0xb3, 0xaa, 0xdb, 0xbb, 0x01, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -286,6 +495,10 @@ type MsgClient interface {
 	ApplyL1Txs(ctx context.Context, in *MsgApplyL1Txs, opts ...grpc.CallOption) (*MsgApplyL1TxsResponse, error)
 	// InitiateWithdrawal defines a method for initiating a withdrawal from L2 to L1.
 	InitiateWithdrawal(ctx context.Context, in *MsgInitiateWithdrawal, opts ...grpc.CallOption) (*MsgInitiateWithdrawalResponse, error)
+	// UpdateParams defines a method for updating the module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// FlushWithdrawalBatch defines a method for flushing the pending withdrawal batch to L1.
+	FlushWithdrawalBatch(ctx context.Context, in *MsgFlushWithdrawalBatch, opts ...grpc.CallOption) (*MsgFlushWithdrawalBatchResponse, error)
 }
 
 type msgClient struct {
@@ -314,12 +527,34 @@ func (c *msgClient) InitiateWithdrawal(ctx context.Context, in *MsgInitiateWithd
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/rollup.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FlushWithdrawalBatch(ctx context.Context, in *MsgFlushWithdrawalBatch, opts ...grpc.CallOption) (*MsgFlushWithdrawalBatchResponse, error) {
+	out := new(MsgFlushWithdrawalBatchResponse)
+	err := c.cc.Invoke(ctx, "/rollup.v1.Msg/FlushWithdrawalBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ApplyL1Txs defines a method for applying applying all L1 system and user deposit txs.
 	ApplyL1Txs(context.Context, *MsgApplyL1Txs) (*MsgApplyL1TxsResponse, error)
 	// InitiateWithdrawal defines a method for initiating a withdrawal from L2 to L1.
 	InitiateWithdrawal(context.Context, *MsgInitiateWithdrawal) (*MsgInitiateWithdrawalResponse, error)
+	// UpdateParams defines a method for updating the module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// FlushWithdrawalBatch defines a method for flushing the pending withdrawal batch to L1.
+	FlushWithdrawalBatch(context.Context, *MsgFlushWithdrawalBatch) (*MsgFlushWithdrawalBatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -332,6 +567,12 @@ func (*UnimplementedMsgServer) ApplyL1Txs(ctx context.Context, req *MsgApplyL1Tx
 func (*UnimplementedMsgServer) InitiateWithdrawal(ctx context.Context, req *MsgInitiateWithdrawal) (*MsgInitiateWithdrawalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitiateWithdrawal not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) FlushWithdrawalBatch(ctx context.Context, req *MsgFlushWithdrawalBatch) (*MsgFlushWithdrawalBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushWithdrawalBatch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -373,6 +614,42 @@ func _Msg_InitiateWithdrawal_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollup.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FlushWithdrawalBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlushWithdrawalBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlushWithdrawalBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rollup.v1.Msg/FlushWithdrawalBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlushWithdrawalBatch(ctx, req.(*MsgFlushWithdrawalBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rollup.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -385,6 +662,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InitiateWithdrawal",
 			Handler:    _Msg_InitiateWithdrawal_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "FlushWithdrawalBatch",
+			Handler:    _Msg_FlushWithdrawalBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rollup/v1/tx.proto",
@@ -465,6 +750,23 @@ func (m *MsgInitiateWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Batched {
+		i--
+		if m.Batched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -529,79 +831,248 @@ func (m *MsgInitiateWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgApplyL1Txs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TxBytes) > 0 {
-		for _, b := range m.TxBytes {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgApplyL1TxsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgInitiateWithdrawal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Value.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.GasLimit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgInitiateWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgFlushWithdrawalBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlushWithdrawalBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlushWithdrawalBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlushWithdrawalBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlushWithdrawalBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlushWithdrawalBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgApplyL1Txs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		for _, b := range m.TxBytes {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgApplyL1TxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInitiateWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.GasLimit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Batched {
+		n += 2
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitiateWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFlushWithdrawalBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFlushWithdrawalBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -933,6 +1404,58 @@ func (m *MsgInitiateWithdrawal) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batched = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1004,6 +1527,303 @@ func (m *MsgInitiateWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFlushWithdrawalBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFlushWithdrawalBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFlushWithdrawalBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFlushWithdrawalBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFlushWithdrawalBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFlushWithdrawalBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
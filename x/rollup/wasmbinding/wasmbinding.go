@@ -0,0 +1,205 @@
+// Package wasmbinding lets CosmWasm contracts initiate withdrawals to L1 and query the rollup module's bridging state
+// through wasmd's custom message and query plugins.
+//
+// It doesn't depend on wasmd: EncodeMsg is a wasmkeeper.CustomEncoder and Querier.Query is a wasmkeeper.CustomQuerier,
+// so an app with the wasm module wires them up with
+//
+//	wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{Custom: wasmbinding.EncodeMsg})
+//	wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{Custom: wasmbinding.NewQuerier(rollupKeeper).Query})
+//
+// Contracts send the messages as CosmosMsg::Custom and the queries as QueryRequest::Custom. The JSON follows CosmWasm's
+// conventions: fields are snake_case, integers that may not fit in 53 bits are decimal strings, and bytes are base64.
+package wasmbinding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/polymerdao/monomer/x/rollup/types"
+)
+
+// Msg is a contract's custom message. Exactly one field must be set.
+type Msg struct {
+	InitiateWithdrawal *InitiateWithdrawal `json:"initiate_withdrawal,omitempty"`
+}
+
+// InitiateWithdrawal burns ETH from the contract's balance and initiates a withdrawal of it to an L1 address, like
+// MsgInitiateWithdrawal sent by the contract.
+//
+// The withdrawal's sender on L1 is the last 20 bytes of the contract's address, since L1 addresses are shorter than
+// contract addresses. L1 contracts must not authenticate messages from the contract by their sender.
+type InitiateWithdrawal struct {
+	// Target is the hex address of the L1 recipient.
+	Target string `json:"target"`
+	// Value is the amount of ETH in wei to withdraw.
+	Value sdkmath.Int `json:"value"`
+	// GasLimit is the gas limit of the withdrawal's call to Target on L1.
+	GasLimit uint64 `json:"gas_limit,string"`
+	// Data is the calldata of the call to Target.
+	Data []byte `json:"data,omitempty"`
+}
+
+// EncodeMsg returns the SDK msgs of a custom message sent by the contract at sender.
+func EncodeMsg(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	var m Msg
+	if err := json.Unmarshal(msg, &m); err != nil {
+		return nil, fmt.Errorf("unmarshal rollup msg: %v", err)
+	}
+	switch {
+	case m.InitiateWithdrawal != nil:
+		w := m.InitiateWithdrawal
+		if w.Value.IsNil() {
+			return nil, errors.New("initiate_withdrawal: value is required")
+		}
+		return []sdk.Msg{&types.MsgInitiateWithdrawal{
+			Sender:   sender.String(),
+			Target:   w.Target,
+			Value:    w.Value,
+			GasLimit: new(big.Int).SetUint64(w.GasLimit).Bytes(),
+			Data:     w.Data,
+		}}, nil
+	default:
+		return nil, errors.New("unknown rollup msg")
+	}
+}
+
+// Query is a contract's custom query. Exactly one field must be set.
+type Query struct {
+	// L1BlockInfo returns an L1BlockInfoResponse.
+	L1BlockInfo *struct{} `json:"l1_block_info,omitempty"`
+	// WithdrawalNonce returns a WithdrawalNonceResponse.
+	WithdrawalNonce *struct{} `json:"withdrawal_nonce,omitempty"`
+	// WithdrawalCommitted returns a WithdrawalCommittedResponse.
+	WithdrawalCommitted *WithdrawalCommittedQuery `json:"withdrawal_committed,omitempty"`
+	// DepositNonce returns a DepositNonceResponse.
+	DepositNonce *DepositNonceQuery `json:"deposit_nonce,omitempty"`
+}
+
+type WithdrawalCommittedQuery struct {
+	// WithdrawalHash is the hex hash of the withdrawal, from the withdrawal_initiated event's withdrawal_hash attribute.
+	WithdrawalHash string `json:"withdrawal_hash"`
+}
+
+type DepositNonceQuery struct {
+	// From is the hex address of the L1 account.
+	From string `json:"from"`
+}
+
+// L1BlockInfoResponse describes the latest L1 block the rollup derived from. It is zero before the first block.
+type L1BlockInfoResponse struct {
+	Number uint64 `json:"number"`
+	Time   uint64 `json:"time"`
+	// Hash is the block's hex hash.
+	Hash string `json:"hash"`
+}
+
+type WithdrawalNonceResponse struct {
+	// Nonce is the number of withdrawals initiated so far, without the message version.
+	Nonce sdkmath.Int `json:"nonce"`
+}
+
+type WithdrawalCommittedResponse struct {
+	// Committed reports whether the withdrawal was initiated and can be proven on L1.
+	Committed bool `json:"committed"`
+}
+
+type DepositNonceResponse struct {
+	// Nonce is the number of deposits the L1 account has sent.
+	Nonce uint64 `json:"nonce"`
+}
+
+// Keeper is the part of the rollup keeper the queries read.
+type Keeper interface {
+	GetL1BlockInfo(ctx context.Context) (*types.L1BlockInfo, error)
+	WithdrawalNonce(ctx context.Context) (*big.Int, error)
+	WithdrawalCommitted(ctx context.Context, withdrawalHash common.Hash) (bool, error)
+	DepositNonce(ctx context.Context, from common.Address) (uint64, error)
+}
+
+// Querier serves contracts' custom queries.
+type Querier struct {
+	keeper Keeper
+}
+
+func NewQuerier(keeper Keeper) *Querier {
+	return &Querier{
+		keeper: keeper,
+	}
+}
+
+// Query returns the JSON response to a custom query.
+func (q *Querier) Query(ctx sdk.Context, request json.RawMessage) ([]byte, error) { //nolint:gocritic // hugeParam
+	var query Query
+	if err := json.Unmarshal(request, &query); err != nil {
+		return nil, fmt.Errorf("unmarshal rollup query: %v", err)
+	}
+	resp, err := q.query(ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+	respBytes, err := json.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("marshal response: %v", err)
+	}
+	return respBytes, nil
+}
+
+func (q *Querier) query(ctx context.Context, query *Query) (any, error) {
+	switch {
+	case query.L1BlockInfo != nil:
+		info, err := q.keeper.GetL1BlockInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if info == nil {
+			return &L1BlockInfoResponse{
+				Hash: common.Hash{}.Hex(),
+			}, nil
+		}
+		return &L1BlockInfoResponse{
+			Number: info.Number,
+			Time:   info.Time,
+			Hash:   common.BytesToHash(info.BlockHash).Hex(),
+		}, nil
+	case query.WithdrawalNonce != nil:
+		nonce, err := q.keeper.WithdrawalNonce(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &WithdrawalNonceResponse{
+			Nonce: sdkmath.NewIntFromBigInt(nonce),
+		}, nil
+	case query.WithdrawalCommitted != nil:
+		withdrawalHash, err := hexutil.Decode(query.WithdrawalCommitted.WithdrawalHash)
+		if err != nil || len(withdrawalHash) != common.HashLength {
+			return nil, fmt.Errorf("withdrawal_committed: invalid withdrawal hash %q", query.WithdrawalCommitted.WithdrawalHash)
+		}
+		committed, err := q.keeper.WithdrawalCommitted(ctx, common.BytesToHash(withdrawalHash))
+		if err != nil {
+			return nil, err
+		}
+		return &WithdrawalCommittedResponse{
+			Committed: committed,
+		}, nil
+	case query.DepositNonce != nil:
+		if !common.IsHexAddress(query.DepositNonce.From) {
+			return nil, fmt.Errorf("deposit_nonce: invalid address %q", query.DepositNonce.From)
+		}
+		nonce, err := q.keeper.DepositNonce(ctx, common.HexToAddress(query.DepositNonce.From))
+		if err != nil {
+			return nil, err
+		}
+		return &DepositNonceResponse{
+			Nonce: nonce,
+		}, nil
+	default:
+		return nil, errors.New("unknown rollup query")
+	}
+}
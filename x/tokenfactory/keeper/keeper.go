@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/polymerdao/monomer/x/tokenfactory/types"
+)
+
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	// authority is the address that can update the module parameters, usually the governance module account.
+	authority  string
+	bankkeeper types.BankKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	authority string,
+	// dependencies
+	bankKeeper types.BankKeeper,
+) *Keeper {
+	return &Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+		bankkeeper:   bankKeeper,
+	}
+}
+
+// Authority returns the address that can update the module parameters.
+func (k *Keeper) Authority() string {
+	return k.authority
+}
+
+func (k *Keeper) InitGenesis(ctx context.Context, genesis *types.GenesisState) error {
+	if err := genesis.Validate(); err != nil {
+		return fmt.Errorf("validate genesis: %v", err)
+	}
+	if err := k.SetParams(ctx, &genesis.Params); err != nil {
+		return err
+	}
+	for i := range genesis.FactoryDenoms {
+		if err := k.SetFactoryDenom(ctx, &genesis.FactoryDenoms[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	factoryDenoms, err := k.getFactoryDenoms(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return &types.GenesisState{
+		Params:        *params,
+		FactoryDenoms: factoryDenoms,
+	}, nil
+}
+
+// GetParams returns the module parameters, or the default parameters if they were never set.
+func (k *Keeper) GetParams(ctx context.Context) (*types.Params, error) {
+	paramsBytes, err := k.storeService.OpenKVStore(ctx).Get([]byte(types.KeyParams))
+	if err != nil {
+		return nil, fmt.Errorf("get params: %v", err)
+	} else if paramsBytes == nil {
+		params := types.DefaultParams()
+		return &params, nil
+	}
+	var params types.Params
+	if err := k.cdc.Unmarshal(paramsBytes, &params); err != nil {
+		return nil, fmt.Errorf("unmarshal params: %v", err)
+	}
+	return &params, nil
+}
+
+// SetParams sets the module parameters. The parameters must be valid.
+func (k *Keeper) SetParams(ctx context.Context, params *types.Params) error {
+	paramsBytes, err := k.cdc.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("marshal params: %v", err)
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set([]byte(types.KeyParams), paramsBytes); err != nil {
+		return fmt.Errorf("set params: %v", err)
+	}
+	return nil
+}
+
+// GetFactoryDenom returns a denom created with the module and its admin. It returns false if the denom was not created
+// with the module.
+func (k *Keeper) GetFactoryDenom(ctx context.Context, denom string) (*types.FactoryDenom, bool, error) {
+	factoryDenomBytes, err := k.storeService.OpenKVStore(ctx).Get(types.DenomKey(denom))
+	if err != nil {
+		return nil, false, fmt.Errorf("get factory denom: %v", err)
+	} else if factoryDenomBytes == nil {
+		return nil, false, nil
+	}
+	var factoryDenom types.FactoryDenom
+	if err := k.cdc.Unmarshal(factoryDenomBytes, &factoryDenom); err != nil {
+		return nil, false, fmt.Errorf("unmarshal factory denom: %v", err)
+	}
+	return &factoryDenom, true, nil
+}
+
+// SetFactoryDenom creates or updates a denom created with the module. The denom must be valid.
+func (k *Keeper) SetFactoryDenom(ctx context.Context, factoryDenom *types.FactoryDenom) error {
+	factoryDenomBytes, err := k.cdc.Marshal(factoryDenom)
+	if err != nil {
+		return fmt.Errorf("marshal factory denom: %v", err)
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set(types.DenomKey(factoryDenom.Denom), factoryDenomBytes); err != nil {
+		return fmt.Errorf("set factory denom: %v", err)
+	}
+	return nil
+}
+
+// GetDenomsFromCreator returns the denoms creator created, sorted.
+func (k *Keeper) GetDenomsFromCreator(ctx context.Context, creator string) ([]string, error) {
+	factoryDenoms, err := k.getFactoryDenoms(ctx, types.DenomPrefix+"/"+creator+"/")
+	if err != nil {
+		return nil, err
+	}
+	denoms := make([]string, 0, len(factoryDenoms))
+	for i := range factoryDenoms {
+		denoms = append(denoms, factoryDenoms[i].Denom)
+	}
+	return denoms, nil
+}
+
+// getFactoryDenoms returns the denoms created with the module that start with denomPrefix, sorted by denom.
+func (k *Keeper) getFactoryDenoms(ctx context.Context, denomPrefix string) ([]types.FactoryDenom, error) {
+	prefix := types.DenomKey(denomPrefix)
+	iterator, err := k.storeService.OpenKVStore(ctx).Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, fmt.Errorf("new iterator: %v", err)
+	}
+	defer iterator.Close()
+
+	factoryDenoms := []types.FactoryDenom{}
+	for ; iterator.Valid(); iterator.Next() {
+		var factoryDenom types.FactoryDenom
+		if err := k.cdc.Unmarshal(iterator.Value(), &factoryDenom); err != nil {
+			return nil, fmt.Errorf("unmarshal factory denom: %v", err)
+		}
+		factoryDenoms = append(factoryDenoms, factoryDenom)
+	}
+	return factoryDenoms, nil
+}
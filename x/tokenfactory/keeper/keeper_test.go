@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/polymerdao/monomer/x/tokenfactory/keeper"
+	"github.com/polymerdao/monomer/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	alice     = authtypes.NewModuleAddress("alice").String()
+	bob       = authtypes.NewModuleAddress("bob").String()
+)
+
+var errInsufficientFunds = errors.New("insufficient funds")
+
+// bankKeeper keeps balances in memory. Module accounts are keyed by module name.
+type bankKeeper struct {
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+}
+
+var _ types.BankKeeper = (*bankKeeper)(nil)
+
+func (b *bankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(senderModule, recipientAddr.String(), amt)
+}
+
+func (b *bankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr.String(), recipientModule, amt)
+}
+
+func (b *bankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	b.balances[moduleName] = b.balances[moduleName].Add(amt...)
+	b.supply = b.supply.Add(amt...)
+	return nil
+}
+
+func (b *bankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	balance, negative := b.balances[moduleName].SafeSub(amt...)
+	if negative {
+		return errInsufficientFunds
+	}
+	b.balances[moduleName] = balance
+	b.supply = b.supply.Sub(amt...)
+	return nil
+}
+
+// fund mints amt to addr.
+func (b *bankKeeper) fund(addr string, amt sdk.Coins) {
+	b.balances[addr] = b.balances[addr].Add(amt...)
+	b.supply = b.supply.Add(amt...)
+}
+
+func (b *bankKeeper) HasSupply(_ context.Context, denom string) bool {
+	return b.supply.AmountOf(denom).IsPositive()
+}
+
+func (b *bankKeeper) send(from, to string, amt sdk.Coins) error {
+	balance, negative := b.balances[from].SafeSub(amt...)
+	if negative {
+		return errInsufficientFunds
+	}
+	b.balances[from] = balance
+	b.balances[to] = b.balances[to].Add(amt...)
+	return nil
+}
+
+func setup(t *testing.T) (context.Context, *keeper.Keeper, *bankKeeper) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	bank := &bankKeeper{
+		balances: make(map[string]sdk.Coins),
+	}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	return ctx, keeper.NewKeeper(cdc, runtime.NewKVStoreService(storeKey), authority, bank), bank
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k, _ := setup(t)
+	denom, err := types.NewDenom(alice, "coin")
+	require.NoError(t, err)
+	genesis := &types.GenesisState{
+		Params: types.Params{
+			DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		},
+		FactoryDenoms: []types.FactoryDenom{{Denom: denom, Admin: alice}},
+	}
+	require.NoError(t, k.InitGenesis(ctx, genesis))
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesis, exported)
+
+	for name, factoryDenom := range map[string]types.FactoryDenom{
+		"not a factory denom": {Denom: "stake", Admin: alice},
+		"invalid creator":     {Denom: "factory/alice/coin", Admin: alice},
+		"invalid admin":       {Denom: denom, Admin: "alice"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, k.InitGenesis(ctx, &types.GenesisState{
+				Params:        types.DefaultParams(),
+				FactoryDenoms: []types.FactoryDenom{factoryDenom},
+			}))
+		})
+	}
+	require.Error(t, k.InitGenesis(ctx, &types.GenesisState{
+		Params:        types.DefaultParams(),
+		FactoryDenoms: []types.FactoryDenom{{Denom: denom}, {Denom: denom}},
+	}))
+}
+
+func TestCreateDenom(t *testing.T) {
+	ctx, k, bank := setup(t)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, k.SetParams(ctx, &types.Params{DenomCreationFee: fee}))
+
+	// The creator can't pay the fee.
+	_, err := k.CreateDenom(ctx, &types.MsgCreateDenom{Sender: alice, Subdenom: "coin"})
+	require.ErrorIs(t, err, errInsufficientFunds)
+
+	bank.fund(alice, fee)
+	resp, err := k.CreateDenom(ctx, &types.MsgCreateDenom{Sender: alice, Subdenom: "coin"})
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+alice+"/coin", resp.NewTokenDenom)
+	// The fee is burned.
+	require.True(t, bank.balances[alice].IsZero())
+	require.True(t, bank.balances[types.ModuleName].IsZero())
+
+	bank.fund(alice, fee)
+	_, err = k.CreateDenom(ctx, &types.MsgCreateDenom{Sender: alice, Subdenom: "coin"})
+	require.ErrorIs(t, err, types.ErrDenomExists)
+
+	admin, err := k.DenomAdmin(ctx, &types.QueryDenomAdminRequest{Denom: resp.NewTokenDenom})
+	require.NoError(t, err)
+	require.Equal(t, alice, admin.Admin)
+	_, err = k.CreateDenom(ctx, &types.MsgCreateDenom{Sender: alice, Subdenom: "another"})
+	require.NoError(t, err)
+	denoms, err := k.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: alice})
+	require.NoError(t, err)
+	require.Equal(t, []string{"factory/" + alice + "/another", resp.NewTokenDenom}, denoms.Denoms)
+	denoms, err = k.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: bob})
+	require.NoError(t, err)
+	require.Empty(t, denoms.Denoms)
+}
+
+func TestMintBurnAndChangeAdmin(t *testing.T) {
+	ctx, k, bank := setup(t)
+	resp, err := k.CreateDenom(ctx, &types.MsgCreateDenom{Sender: alice, Subdenom: "coin"})
+	require.NoError(t, err)
+	coin := sdk.NewCoin(resp.NewTokenDenom, math.NewInt(100))
+
+	_, err = k.Mint(ctx, &types.MsgMint{Sender: bob, Amount: coin})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = k.Mint(ctx, &types.MsgMint{Sender: alice, Amount: sdk.NewInt64Coin("factory/"+alice+"/other", 1)})
+	require.ErrorIs(t, err, types.ErrDenomNotFound)
+
+	_, err = k.Mint(ctx, &types.MsgMint{Sender: alice, Amount: coin})
+	require.NoError(t, err)
+	_, err = k.Mint(ctx, &types.MsgMint{Sender: alice, Amount: coin, MintToAddress: bob})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(coin), bank.balances[alice])
+	require.Equal(t, sdk.NewCoins(coin), bank.balances[bob])
+
+	_, err = k.Burn(ctx, &types.MsgBurn{Sender: bob, Amount: coin})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = k.Burn(ctx, &types.MsgBurn{Sender: alice, Amount: coin})
+	require.NoError(t, err)
+	require.True(t, bank.balances[alice].IsZero())
+	require.Equal(t, sdk.NewCoins(coin), bank.supply)
+
+	_, err = k.ChangeAdmin(ctx, &types.MsgChangeAdmin{Sender: bob, Denom: coin.Denom, NewAdmin: bob})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = k.ChangeAdmin(ctx, &types.MsgChangeAdmin{Sender: alice, Denom: coin.Denom, NewAdmin: bob})
+	require.NoError(t, err)
+	_, err = k.Mint(ctx, &types.MsgMint{Sender: alice, Amount: coin})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = k.Burn(ctx, &types.MsgBurn{Sender: bob, Amount: coin})
+	require.NoError(t, err)
+
+	// Renouncing the admin fixes the supply.
+	_, err = k.ChangeAdmin(ctx, &types.MsgChangeAdmin{Sender: bob, Denom: coin.Denom})
+	require.NoError(t, err)
+	_, err = k.Mint(ctx, &types.MsgMint{Sender: bob, Amount: coin})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k, _ := setup(t)
+	params := types.Params{DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}
+
+	_, err := k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: alice, Params: params})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.Params{DenomCreationFee: sdk.Coins{{Denom: "stake", Amount: math.NewInt(-1)}}},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	resp, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, resp.Params)
+}
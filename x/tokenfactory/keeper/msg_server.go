@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polymerdao/monomer/x/tokenfactory/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateDenom implements types.MsgServer.
+func (k *Keeper) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	denom, err := types.NewDenom(msg.Sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok, err := k.GetFactoryDenom(ctx, denom); err != nil {
+		return nil, err
+	} else if ok || k.bankkeeper.HasSupply(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomExists, "%s", denom)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.DenomCreationFee.IsZero() {
+		sender, err := sdk.AccAddressFromBech32(msg.Sender)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidAddress, "invalid sender address: %v", err)
+		}
+		if err := k.bankkeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, params.DenomCreationFee); err != nil {
+			return nil, sdkerrors.Wrapf(err, "pay denom creation fee")
+		}
+		if err := k.bankkeeper.BurnCoins(ctx, types.ModuleName, params.DenomCreationFee); err != nil {
+			return nil, sdkerrors.Wrapf(err, "burn denom creation fee")
+		}
+	}
+
+	if err := k.SetFactoryDenom(ctx, &types.FactoryDenom{
+		Denom: denom,
+		Admin: msg.Sender,
+	}); err != nil {
+		return nil, err
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateDenom,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+	))
+	return &types.MsgCreateDenomResponse{
+		NewTokenDenom: denom,
+	}, nil
+}
+
+// Mint implements types.MsgServer.
+func (k *Keeper) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	if err := k.checkAdmin(ctx, msg.Amount.Denom, msg.Sender); err != nil {
+		return nil, err
+	}
+	mintTo := msg.MintToAddress
+	if mintTo == "" {
+		mintTo = msg.Sender
+	}
+	mintToAddr, err := sdk.AccAddressFromBech32(mintTo)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAddress, "invalid mint to address: %v", err)
+	}
+
+	coins := sdk.NewCoins(msg.Amount)
+	if err := k.bankkeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, sdkerrors.Wrapf(err, "mint coins")
+	}
+	if err := k.bankkeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintToAddr, coins); err != nil {
+		return nil, sdkerrors.Wrapf(err, "send minted coins")
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMint,
+		sdk.NewAttribute(types.AttributeKeyMintTo, mintTo),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+	))
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn implements types.MsgServer.
+func (k *Keeper) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	if err := k.checkAdmin(ctx, msg.Amount.Denom, msg.Sender); err != nil {
+		return nil, err
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	coins := sdk.NewCoins(msg.Amount)
+	if err := k.bankkeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return nil, sdkerrors.Wrapf(err, "send coins to burn")
+	}
+	if err := k.bankkeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, sdkerrors.Wrapf(err, "burn coins")
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurn,
+		sdk.NewAttribute(types.AttributeKeyBurnFrom, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+	))
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin implements types.MsgServer.
+func (k *Keeper) ChangeAdmin(ctx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	if err := k.checkAdmin(ctx, msg.Denom, msg.Sender); err != nil {
+		return nil, err
+	}
+	if err := k.SetFactoryDenom(ctx, &types.FactoryDenom{
+		Denom: msg.Denom,
+		Admin: msg.NewAdmin,
+	}); err != nil {
+		return nil, err
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeChangeAdmin,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeyAdmin, msg.NewAdmin),
+	))
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// UpdateParams implements types.MsgServer.
+func (k *Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	if err := k.SetParams(ctx, &msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// checkAdmin checks that denom was created with the module and that sender is its admin.
+func (k *Keeper) checkAdmin(ctx context.Context, denom, sender string) error {
+	factoryDenom, ok, err := k.GetFactoryDenom(ctx, denom)
+	if err != nil {
+		return err
+	} else if !ok {
+		return sdkerrors.Wrapf(types.ErrDenomNotFound, "%s", denom)
+	} else if factoryDenom.Admin == "" || factoryDenom.Admin != sender {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/polymerdao/monomer/x/tokenfactory/types"
+)
+
+var _ types.QueryServer = &Keeper{}
+
+// Params implements types.QueryServer.
+func (k *Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{
+		Params: *params,
+	}, nil
+}
+
+// DenomAdmin implements types.QueryServer.
+func (k *Keeper) DenomAdmin(ctx context.Context, req *types.QueryDenomAdminRequest) (*types.QueryDenomAdminResponse, error) {
+	factoryDenom, ok, err := k.GetFactoryDenom(ctx, req.GetDenom())
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotFound, "%s", req.GetDenom())
+	}
+	return &types.QueryDenomAdminResponse{
+		Admin: factoryDenom.Admin,
+	}, nil
+}
+
+// DenomsFromCreator implements types.QueryServer.
+func (k *Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	denoms, err := k.GetDenomsFromCreator(ctx, req.GetCreator())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDenomsFromCreatorResponse{
+		Denoms: denoms,
+	}, nil
+}
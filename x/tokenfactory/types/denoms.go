@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DenomPrefix is the first part of every denom created with the module.
+	DenomPrefix = "factory"
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+)
+
+// NewDenom returns the denom factory/{creator}/{subdenom}.
+func NewDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "subdenom is longer than %d characters", MaxSubdenomLength)
+	}
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address: %v", err)
+	}
+	denom := strings.Join([]string{DenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidDenom, "%v", err)
+	}
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and subdenom of a denom created with the module.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	parts := strings.SplitN(denom, "/", 3) //nolint:mnd
+	if len(parts) != 3 || parts[0] != DenomPrefix {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "%s is not of the form %s/{creator}/{subdenom}", denom, DenomPrefix)
+	}
+	if _, err := NewDenom(parts[1], parts[2]); err != nil {
+		return "", "", err
+	}
+	return parts[1], parts[2], nil
+}
+
+func (d *FactoryDenom) Validate() error {
+	if _, _, err := DeconstructDenom(d.Denom); err != nil {
+		return err
+	}
+	if d.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(d.Admin); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidDenom, "invalid admin address of %s: %v", d.Denom, err)
+		}
+	}
+	return nil
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:        DefaultParams(),
+		FactoryDenoms: []FactoryDenom{},
+	}
+}
+
+func (g *GenesisState) Validate() error {
+	if err := g.Params.Validate(); err != nil {
+		return fmt.Errorf("validate params: %w", err)
+	}
+	denoms := make(map[string]struct{}, len(g.FactoryDenoms))
+	for i := range g.FactoryDenoms {
+		factoryDenom := &g.FactoryDenoms[i]
+		if err := factoryDenom.Validate(); err != nil {
+			return fmt.Errorf("validate factory denom: %w", err)
+		}
+		if _, ok := denoms[factoryDenom.Denom]; ok {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate denom %s", factoryDenom.Denom)
+		}
+		denoms[factoryDenom.Denom] = struct{}{}
+	}
+	return nil
+}
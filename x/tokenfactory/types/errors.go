@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidDenom   = sdkerrors.Register(ModuleName, 1, "invalid denom")
+	ErrDenomExists    = sdkerrors.Register(ModuleName, 2, "denom already exists")
+	ErrUnauthorized   = sdkerrors.Register(ModuleName, 3, "unauthorized")
+	ErrInvalidParams  = sdkerrors.Register(ModuleName, 4, "invalid params")
+	ErrDenomNotFound  = sdkerrors.Register(ModuleName, 5, "denom not found")
+	ErrInvalidGenesis = sdkerrors.Register(ModuleName, 6, "invalid genesis")
+	ErrInvalidAddress = sdkerrors.Register(ModuleName, 7, "invalid address")
+	ErrInvalidAmount  = sdkerrors.Register(ModuleName, 8, "invalid amount")
+)
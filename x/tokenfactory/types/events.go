@@ -0,0 +1,15 @@
+package types
+
+const (
+	AttributeKeyDenom    = "denom"
+	AttributeKeyCreator  = "creator"
+	AttributeKeyAdmin    = "admin"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyMintTo   = "mint_to"
+	AttributeKeyBurnFrom = "burn_from"
+
+	EventTypeCreateDenom = "create_denom"
+	EventTypeMint        = "tf_mint"
+	EventTypeBurn        = "tf_burn"
+	EventTypeChangeAdmin = "change_admin"
+)
@@ -0,0 +1,19 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// KeyParams is the key for the module Params
+	KeyParams = "Params"
+	// KeyPrefixDenom is the key prefix for the admins of the denoms created with the module, keyed by denom.
+	KeyPrefixDenom = "Denom/"
+)
+
+// DenomKey returns the store key of the admin of denom.
+func DenomKey(denom string) []byte {
+	return append([]byte(KeyPrefixDenom), denom...)
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = (*MsgCreateDenom)(nil)
+	_ sdk.Msg = (*MsgMint)(nil)
+	_ sdk.Msg = (*MsgBurn)(nil)
+	_ sdk.Msg = (*MsgChangeAdmin)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+)
+
+func (m *MsgCreateDenom) ValidateBasic() error {
+	_, err := NewDenom(m.Sender, m.Subdenom)
+	return err
+}
+
+func (m *MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrUnauthorized, "invalid sender address: %v", err)
+	}
+	if m.MintToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.MintToAddress); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAddress, "invalid mint to address: %v", err)
+		}
+	}
+	return validateAmount(m.Amount)
+}
+
+func (m *MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrUnauthorized, "invalid sender address: %v", err)
+	}
+	return validateAmount(m.Amount)
+}
+
+func (m *MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrUnauthorized, "invalid sender address: %v", err)
+	}
+	if m.NewAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAddress, "invalid new admin address: %v", err)
+		}
+	}
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(ErrUnauthorized, "invalid authority address: %v", err)
+	}
+	return m.Params.Validate()
+}
+
+func validateAmount(amount sdk.Coin) error {
+	if err := amount.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "%v", err)
+	} else if !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "amount must be positive")
+	}
+	_, _, err := DeconstructDenom(amount.Denom)
+	return err
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns the default parameters, which let denoms be created for free. Chains open to the public should
+// set a denom creation fee to keep the state from being spammed with denoms.
+func DefaultParams() Params {
+	return Params{
+		DenomCreationFee: sdk.NewCoins(),
+	}
+}
+
+func (p *Params) Validate() error {
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidParams, "invalid denom creation fee: %v", err)
+	}
+	return nil
+}